@@ -690,14 +690,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/booking/attractions": {
+        "/v1/attraction/{id}/add-ons": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for List Attractions",
+                "description": "Api for listing the add-ons guests can book with the establishment",
                 "consumes": [
                     "application/json"
                 ],
@@ -705,32 +705,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_ATTRACTION"
+                    "ADD_ON"
                 ],
-                "summary": "List Attractions",
+                "summary": "LIST ADD-ONS OF ESTABLISHMENT",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.List"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.StandartError"
+                            "$ref": "#/definitions/models.ListAddOnsModel"
                         }
                     },
                     "500": {
@@ -741,13 +732,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Update Booked Attraction",
+                "description": "Api for the owner of the establishment to add an extra such as breakfast, parking or a transfer to the add-ons the establishment sells with its bookings",
                 "consumes": [
                     "application/json"
                 ],
@@ -755,25 +746,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_ATTRACTION"
+                    "ADD_ON"
                 ],
-                "summary": "Update Booked Attraction",
+                "summary": "CREATE ADD-ON",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "models.UpdateBookingReq",
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "AddOn",
+                        "name": "AddOn",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateBookingReq"
+                            "$ref": "#/definitions/models.CreateAddOn"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingRes"
+                            "$ref": "#/definitions/models.AddOnModel"
                         }
                     },
                     "400": {
@@ -782,6 +780,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -789,14 +805,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/attraction/{id}/add-ons/{add_on_id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Create Attraction Booking",
+                "description": "Api for the owner of the establishment to change one of its add-ons, bookings made before keep the price they were made at",
                 "consumes": [
                     "application/json"
                 ],
@@ -804,17 +822,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_ATTRACTION"
+                    "ADD_ON"
                 ],
-                "summary": "Create Attraction Booking",
+                "summary": "UPDATE ADD-ON",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "CreateBookingReq",
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "add_on_id",
+                        "name": "add_on_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "AddOn",
+                        "name": "AddOn",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateBookingReq"
+                            "$ref": "#/definitions/models.UpdateAddOn"
                         }
                     }
                 ],
@@ -822,7 +854,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingRes"
+                            "$ref": "#/definitions/models.AddOnModel"
                         }
                     },
                     "400": {
@@ -831,6 +863,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -838,16 +888,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/booking/attractions/deleted": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for List Deleted Attractions",
+                "description": "Api for the owner of the establishment to stop selling one of its add-ons, bookings made with it keep it",
                 "consumes": [
                     "application/json"
                 ],
@@ -855,30 +903,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_ATTRACTION"
+                    "ADD_ON"
                 ],
-                "summary": "List Deleted Attractions",
+                "summary": "DELETE ADD-ON",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "add_on_id",
+                        "name": "add_on_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.List"
+                            "$ref": "#/definitions/models.DeleteResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -892,14 +950,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/booking/attractions/{id}": {
+        "/v1/attraction/{id}/booking-mode": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get All Attractions By User Id",
+                "description": "Api for getting how the establishment takes bookings, establishments that never chose take them instantly",
                 "consumes": [
                     "application/json"
                 ],
@@ -907,39 +965,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_ATTRACTION"
+                    "BOOKING_MODE"
                 ],
-                "summary": "Get All Attractions By User Id",
+                "summary": "GET BOOKING MODE",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "establishment_id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.IdRes"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.StandartError"
+                            "$ref": "#/definitions/models.BookingModeModel"
                         }
                     },
                     "500": {
@@ -950,13 +992,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Delete Attraction",
+                "description": "Api for the owner of the establishment to choose how it takes bookings. In instant mode bookings are taken as soon as they fit, in request mode they stay pending until the owner accepts or declines them and are declined when nobody answered in time",
                 "consumes": [
                     "application/json"
                 ],
@@ -964,23 +1006,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_ATTRACTION"
+                    "BOOKING_MODE"
                 ],
-                "summary": "Delete Attraction",
+                "summary": "SET BOOKING MODE",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "establishment_id",
                         "name": "id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "instant or request",
+                        "name": "BookingMode",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetBookingMode"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.StandartError"
+                            "$ref": "#/definitions/models.BookingModeModel"
                         }
                     },
                     "400": {
@@ -989,6 +1040,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -998,45 +1061,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/booking/hotels": {
+        "/v1/attraction/{id}/calendar-feed": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for List Hotels",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Api for getting the secret link of the establishment booking calendar. Calendar apps subscribed to the link see every booking of the establishment without signing in, so the link should only be shared with its staff",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_HOTEL"
+                    "CALENDAR"
                 ],
-                "summary": "List Hotels",
+                "summary": "ESTABLISHMENT CALENDAR FEED",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.List"
+                            "$ref": "#/definitions/models.CalendarFeedRes"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -1048,44 +1105,49 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Api for Update Booked Hotel",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/v1/attraction/{id}/calendar.ics": {
+            "get": {
+                "description": "Api for the iCalendar (.ics) feed of the establishment bookings from the last 30 days on, calendar apps subscribe to it with the link from calendar-feed",
                 "produces": [
-                    "application/json"
+                    "text/calendar"
                 ],
                 "tags": [
-                    "BOOKING_HOTEL"
+                    "CALENDAR"
                 ],
-                "summary": "Update Booked Hotel",
+                "summary": "ESTABLISHMENT CALENDAR",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "models.UpdateBookingReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdateBookingReq"
-                        }
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "token of the calendar-feed link",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "text/calendar",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingRes"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -1097,14 +1159,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/attraction/{id}/cancellation-policy": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Create Hotel Booking",
+                "description": "Api for getting the cancellation policy of the establishment, establishments without a policy allow free cancellation until arrival",
                 "consumes": [
                     "application/json"
                 ],
@@ -1112,31 +1176,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_HOTEL"
+                    "CANCELLATION_POLICY"
                 ],
-                "summary": "Create Hotel Booking",
+                "summary": "GET CANCELLATION POLICY",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "CreateBookingReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateBookingReq"
-                        }
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingRes"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.StandartError"
+                            "$ref": "#/definitions/models.CancellationPolicyModel"
                         }
                     },
                     "500": {
@@ -1146,16 +1202,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/booking/hotels/deleted": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for List Deleted Hotels",
+                "description": "Api for replacing the cancellation policy of the establishment. Guests cancelling at least hours_before hours before arrival get refund_percent of the price back, the rule with the latest deadline that was not missed applies and cancellations after every deadline are rejected.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1163,26 +1217,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_HOTEL"
+                    "CANCELLATION_POLICY"
                 ],
-                "summary": "List Deleted Hotels",
+                "summary": "SET CANCELLATION POLICY",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "description": "CancellationPolicy",
+                        "name": "CancellationPolicy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetCancellationPolicy"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.List"
+                            "$ref": "#/definitions/models.CancellationPolicyModel"
                         }
                     },
                     "400": {
@@ -1191,6 +1251,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1198,16 +1270,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/booking/hotels/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get All Hotels By User Id",
+                "description": "Api for deleting the cancellation policy of the establishment, bookings fall back to free cancellation until arrival",
                 "consumes": [
                     "application/json"
                 ],
@@ -1215,35 +1285,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_HOTEL"
+                    "CANCELLATION_POLICY"
                 ],
-                "summary": "Get All Hotels By User Id",
+                "summary": "DELETE CANCELLATION POLICY",
                 "parameters": [
                     {
                         "type": "string",
+                        "description": "establishment_id",
                         "name": "id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.IdRes"
+                            "$ref": "#/definitions/models.DeleteResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -1255,14 +1323,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/attraction/{id}/closures": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Delete Hotel",
+                "description": "Api for listing the closure calendar of the establishment, the days it takes no bookings on",
                 "consumes": [
                     "application/json"
                 ],
@@ -1270,15 +1340,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_HOTEL"
+                    "CLOSURE"
                 ],
-                "summary": "Delete Hotel",
+                "summary": "LIST CLOSURES OF ESTABLISHMENT",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "establishment_id",
                         "name": "id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -1286,13 +1356,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.StandartError"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.StandartError"
+                            "$ref": "#/definitions/models.ListClosuresModel"
                         }
                     },
                     "500": {
@@ -1302,16 +1366,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/booking/restaurants": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for List Restaurants",
+                "description": "Api for the owner of the establishment to close it for bookings. Without weekdays it is closed every day from start_date to end_date, with weekdays such as \"monday\" only on those days of the week, between start_date and end_date when they are given. Dates are in YYYY-MM-DD format and both ends are included. Bookings on closed days are rejected",
                 "consumes": [
                     "application/json"
                 ],
@@ -1319,26 +1381,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_RESTAURANT"
+                    "CLOSURE"
                 ],
-                "summary": "List Restaurants",
+                "summary": "CREATE CLOSURE",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Closure",
+                        "name": "Closure",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateClosure"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.List"
+                            "$ref": "#/definitions/models.ClosureModel"
                         }
                     },
                     "400": {
@@ -1347,6 +1415,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1354,14 +1434,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/attraction/{id}/closures/{closure_id}": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Update Booked Restaurant",
+                "description": "Api for the owner of the establishment to change the dates, weekdays or reason of one of its closures, bookings made before are kept",
                 "consumes": [
                     "application/json"
                 ],
@@ -1369,17 +1451,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_RESTAURANT"
+                    "CLOSURE"
                 ],
-                "summary": "Update Booked Restaurant",
+                "summary": "UPDATE CLOSURE",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "models.UpdateBookingReq",
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "closure_id",
+                        "name": "closure_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Closure",
+                        "name": "Closure",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateBookingReq"
+                            "$ref": "#/definitions/models.UpdateClosure"
                         }
                     }
                 ],
@@ -1387,7 +1483,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingRes"
+                            "$ref": "#/definitions/models.ClosureModel"
                         }
                     },
                     "400": {
@@ -1396,6 +1492,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1404,13 +1512,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Create Restaurant Booking",
+                "description": "Api for the owner of the establishment to remove one of its closures, its days can be booked again",
                 "consumes": [
                     "application/json"
                 ],
@@ -1418,29 +1526,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_RESTAURANT"
+                    "CLOSURE"
                 ],
-                "summary": "Create Restaurant Booking",
+                "summary": "DELETE CLOSURE",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "CreateBookingReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateBookingReq"
-                        }
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "closure_id",
+                        "name": "closure_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.BookingRes"
+                            "$ref": "#/definitions/models.DeleteResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -1454,14 +1573,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/booking/restaurants/deleted": {
+        "/v1/attraction/{id}/quota": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for List Deleted Restaurants",
+                "description": "Api for getting the tickets still available for a day and for each entry window of an attraction",
                 "consumes": [
                     "application/json"
                 ],
@@ -1469,26 +1588,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_RESTAURANT"
+                    "ATTRACTION"
                 ],
-                "summary": "List Deleted Restaurants",
+                "summary": "GET REMAINING TICKET QUOTA OF AN ATTRACTION FOR A DATE",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "attraction_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.List"
+                            "$ref": "#/definitions/models.AttractionQuotaModel"
                         }
                     },
                     "400": {
@@ -1497,6 +1620,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1506,14 +1635,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/booking/restaurants/{id}": {
+        "/v1/attraction/{id}/tickets": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get All Restaurants By User Id",
+                "description": "Api for listing ticket types of the attraction",
                 "consumes": [
                     "application/json"
                 ],
@@ -1521,39 +1650,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_RESTAURANT"
+                    "TICKET_TYPE"
                 ],
-                "summary": "Get All Restaurants By User Id",
+                "summary": "LIST TICKET TYPES OF ATTRACTION",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "attraction_id",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.IdRes"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/models.StandartError"
+                            "$ref": "#/definitions/models.ListTicketTypesModel"
                         }
                     },
                     "500": {
@@ -1564,13 +1677,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Delete Restaurant",
+                "description": "Api for creating an adult or child ticket type of the attraction",
                 "consumes": [
                     "application/json"
                 ],
@@ -1578,23 +1691,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_RESTAURANT"
+                    "TICKET_TYPE"
                 ],
-                "summary": "Delete Restaurant",
+                "summary": "CREATE TICKET TYPE",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "attraction_id",
                         "name": "id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "TicketType",
+                        "name": "TicketType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateTicketType"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.StandartError"
+                            "$ref": "#/definitions/models.TicketTypeModel"
                         }
                     },
                     "400": {
@@ -1603,6 +1725,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1612,14 +1746,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/booking/users/attraction/{id}": {
-            "get": {
+        "/v1/attraction/{id}/tickets/{ticket_type_id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get All Users By Attraction Id",
+                "description": "Api for updating the price of a ticket type of the attraction",
                 "consumes": [
                     "application/json"
                 ],
@@ -1627,33 +1761,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_ATTRACTION"
+                    "TICKET_TYPE"
                 ],
-                "summary": "Get All Users By Attraction Id",
+                "summary": "UPDATE TICKET TYPE",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "attraction_id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ticket_type_id",
+                        "name": "ticket_type_id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "description": "TicketType",
+                        "name": "TicketType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateTicketType"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.IdRes"
+                            "$ref": "#/definitions/models.TicketTypeModel"
                         }
                     },
                     "400": {
@@ -1662,6 +1802,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1669,16 +1815,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/booking/users/restaurant/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get All Users By Restaurant Id",
+                "description": "Api for deleting a ticket type of the attraction",
                 "consumes": [
                     "application/json"
                 ],
@@ -1686,37 +1830,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_RESTAURANT"
+                    "TICKET_TYPE"
                 ],
-                "summary": "Get All Users By Restaurant Id",
+                "summary": "DELETE TICKET TYPE",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "attraction_id",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ticket_type_id",
+                        "name": "ticket_type_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.IdRes"
+                            "$ref": "#/definitions/models.DeleteResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -1730,14 +1871,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/booking/users/room/{id}": {
+        "/v1/booking/attractions": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get All Users By Room Id",
+                "description": "Api for List Attractions",
                 "consumes": [
                     "application/json"
                 ],
@@ -1745,17 +1886,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "BOOKING_HOTEL"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "Get All Users By Room Id",
+                "summary": "List Attractions",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "integer",
                         "name": "limit",
@@ -1771,7 +1905,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.IdRes"
+                            "$ref": "#/definitions/models.List"
                         }
                     },
                     "400": {
@@ -1787,16 +1921,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/favourite/add": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for adding establishment to favourites",
+                "description": "Api for Update Booked Attraction",
                 "consumes": [
                     "application/json"
                 ],
@@ -1804,30 +1936,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FAVOURITE"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "ADD ESTABLISHMENT TO FAVOURITES",
+                "summary": "Update Booked Attraction",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "establishment_id",
-                        "name": "establishment_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "user_id",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "createModel",
+                        "name": "models.UpdateBookingReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateBookingReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.FavouriteModel"
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -1836,6 +1969,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1843,16 +1982,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/favourite/list": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for listing favourites by favourite_id",
+                "description": "Api for Create Attraction Booking",
                 "consumes": [
                     "application/json"
                 ],
@@ -1860,23 +1997,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FAVOURITE"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "LIST FAVOURITES BY USER_ID",
+                "summary": "Create Attraction Booking",
                 "parameters": [
+                    {
+                        "description": "createModel",
+                        "name": "CreateBookingReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateBookingReq"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "user_id",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListFavouritesModel"
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -1885,6 +2036,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1894,14 +2051,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/favourite/remove": {
-            "delete": {
+        "/v1/booking/attractions/deleted": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for removing favourite by favourite_id",
+                "description": "Api for List Deleted Attractions",
                 "consumes": [
                     "application/json"
                 ],
@@ -1909,27 +2066,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FAVOURITE"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "REMOVE FROM FAVOURITES BY FAVOURITE_ID",
+                "summary": "List Deleted Attractions",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "favourite_id",
-                        "name": "favourite_id",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RemoveResponse"
+                            "$ref": "#/definitions/models.List"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -1943,14 +2103,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/hotel": {
+        "/v1/booking/attractions/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for getting hotel by hotel_id",
+                "description": "Api for Get All Attractions By User Id",
                 "consumes": [
                     "application/json"
                 ],
@@ -1958,27 +2118,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HOTEL"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "GET HOTEL BY HOTEL_ID",
+                "summary": "Get All Attractions By User Id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "hotel_id",
-                        "name": "hotel_id",
-                        "in": "query",
-                        "required": true
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.HotelModel"
+                            "$ref": "#/definitions/models.IdRes"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -1991,13 +2159,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for updating hotel by hotel_id",
+                "description": "Api for Delete Attraction",
                 "consumes": [
                     "application/json"
                 ],
@@ -2005,36 +2173,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HOTEL"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "UPDATE HOTEL",
+                "summary": "Delete Attraction",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "hotel_id",
-                        "name": "hotel_id",
+                        "description": "ID",
+                        "name": "id",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "description": "UpdatingHotel",
-                        "name": "UpdatingHotel",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UpdateHotel"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.HotelModel"
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -2046,14 +2205,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/booking/attractions/{id}/accept": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for creating hotel",
+                "description": "Api for the owner of a attraction taking bookings on request to accept a booking that waits for approval. The booking is confirmed",
                 "consumes": [
                     "application/json"
                 ],
@@ -2061,17 +2222,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HOTEL"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "CREATE HOTEL",
+                "summary": "ACCEPT ATTRACTION BOOKING",
                 "parameters": [
                     {
-                        "description": "Hotel",
-                        "name": "Hotel",
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateHotel"
+                            "$ref": "#/definitions/models.StatusReq"
                         }
                     }
                 ],
@@ -2079,7 +2246,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.HotelModel"
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2088,6 +2267,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2095,14 +2280,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/booking/attractions/{id}/cancel": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for deleting hotel by hotel_id",
+                "description": "Api for the owner of the attraction to cancel a pending or confirmed booking. Users can also cancel their own bookings and are then held to the cancellation policy, which sets the refund and rejects late cancellations with 409",
                 "consumes": [
                     "application/json"
                 ],
@@ -2110,23 +2297,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HOTEL"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "DELETE HOTEL BY HOTEL_ID",
+                "summary": "CANCEL ATTRACTION BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "hotel_id",
-                        "name": "hotel_id",
-                        "in": "query",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DeleteResponse"
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2135,6 +2348,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2144,14 +2363,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/hotel/find": {
-            "get": {
+        "/v1/booking/attractions/{id}/check-in": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for listing hotels by name",
+                "description": "Api for the owner of the attraction to check in the guest of a confirmed booking",
                 "consumes": [
                     "application/json"
                 ],
@@ -2159,21 +2378,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HOTEL"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "FIND HOTELS BY NAME",
+                "summary": "CHECK IN ATTRACTION BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "name",
-                        "in": "query"
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListHotelsModel"
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2182,6 +2423,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2191,14 +2438,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/hotel/list": {
-            "get": {
+        "/v1/booking/attractions/{id}/confirm": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for listing hotels by page and limit",
+                "description": "Api for the owner of the attraction to confirm a pending booking",
                 "consumes": [
                     "application/json"
                 ],
@@ -2206,30 +2453,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HOTEL"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "LIST HOTELS BY PAGE AND LIMIT",
+                "summary": "CONFIRM ATTRACTION BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "limit",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListHotelsModel"
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2238,6 +2498,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2247,14 +2513,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/hotel/listlocation": {
-            "get": {
+        "/v1/booking/attractions/{id}/decline": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for listing hotels by page, limit, country, city and state_province",
+                "description": "Api for the owner of a attraction taking bookings on request to decline a booking that waits for approval, the booking is cancelled",
                 "consumes": [
                     "application/json"
                 ],
@@ -2262,45 +2528,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "HOTEL"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "LIST HOTELS BY PAGE, LIMIT, COUNTRY, CITY AND STATE_PROVINCE",
+                "summary": "DECLINE ATTRACTION BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "limit",
-                        "name": "limit",
-                        "in": "query",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "name": "city",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "country",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "province",
-                        "in": "query"
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListHotelsModel"
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2309,6 +2573,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2318,14 +2588,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/media/establishment/{id}": {
-            "post": {
+        "/v1/booking/attractions/{id}/history": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Through this api frontent can upload establishment photo and get the link to the media.",
+                "description": "Api for getting the current status of a attraction booking and every change that led to it",
                 "consumes": [
                     "application/json"
                 ],
@@ -2333,20 +2603,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MEDIA"
+                    "BOOKING_ATTRACTION"
                 ],
-                "summary": "Upload Establishment photo",
+                "summary": "ATTRACTION BOOKING STATUS HISTORY",
                 "parameters": [
-                    {
-                        "type": "file",
-                        "description": "Image",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
-                    },
                     {
                         "type": "string",
-                        "description": "Establishment_ID",
+                        "description": "booking_id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2356,32 +2619,38 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.EstablishmentImageRespons"
+                            "$ref": "#/definitions/models.StatusHistoryModel"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.Error"
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.Error"
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     }
                 }
             }
         },
-        "/v1/media/user-photo": {
-            "post": {
+        "/v1/booking/hotels": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Through this api frontent can upload user photo and get the link to the media.",
+                "description": "Api for List Hotels",
                 "consumes": [
                     "application/json"
                 ],
@@ -2389,48 +2658,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "MEDIA"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "Upload User photo",
+                "summary": "List Hotels",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Image",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/models.List"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.Error"
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/models.Error"
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     }
                 }
-            }
-        },
-        "/v1/restaurant": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for getting restaurant by restaurant_id",
+                "description": "Api for Update Booked Hotel",
                 "consumes": [
                     "application/json"
                 ],
@@ -2438,23 +2708,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RESTAURANT"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "GET RESTAURANT BY RESTAURANT_ID",
+                "summary": "Update Booked Hotel",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "restaurant_id",
-                        "name": "restaurant_id",
-                        "in": "query",
-                        "required": true
+                        "description": "createModel",
+                        "name": "models.UpdateBookingReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateBookingReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RestaurantModel"
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2471,13 +2749,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for updating restaurant by restaurant_id",
+                "description": "Api for Create Hotel Booking",
                 "consumes": [
                     "application/json"
                 ],
@@ -2485,32 +2763,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RESTAURANT"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "UPDATE RESTAURANT",
+                "summary": "Create Hotel Booking",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "restaurant_id",
-                        "name": "restaurant_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "description": "UpdatingRestaurant",
-                        "name": "UpdatingRestaurant",
+                        "description": "createModel",
+                        "name": "CreateBookingReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UpdateRestaurant"
+                            "$ref": "#/definitions/models.CreateBookingReq"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RestaurantModel"
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2519,6 +2802,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2526,14 +2815,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/booking/hotels/deleted": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for creating restaurant",
+                "description": "Api for List Deleted Hotels",
                 "consumes": [
                     "application/json"
                 ],
@@ -2541,29 +2832,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RESTAURANT"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "CREATE RESTAURANT",
+                "summary": "List Deleted Hotels",
                 "parameters": [
                     {
-                        "description": "Restaurant",
-                        "name": "Restaurant",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.CreateRestaurant"
-                        }
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RestaurantModel"
+                            "$ref": "#/definitions/models.List"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -2575,14 +2867,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/booking/hotels/quote": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for deleting restaurant by restaurant_id",
+                "description": "Api for pricing a hotel stay without booking it: nights at the room price, holiday surcharges and the room discount",
                 "consumes": [
                     "application/json"
                 ],
@@ -2590,23 +2884,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RESTAURANT"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "DELETE RESTAURANT BY RESTAURANT_ID",
+                "summary": "Quote Hotel Booking",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "restaurant_id",
-                        "name": "restaurant_id",
-                        "in": "query",
-                        "required": true
+                        "description": "quoteModel",
+                        "name": "QuoteReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.QuoteReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DeleteResponse"
+                            "$ref": "#/definitions/models.PriceQuoteModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2624,14 +2926,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/restaurant/find": {
+        "/v1/booking/hotels/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for listing restaurants by name",
+                "description": "Api for Get All Hotels By User Id",
                 "consumes": [
                     "application/json"
                 ],
@@ -2639,13 +2941,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RESTAURANT"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "FIND RESTAURANTS BY NAME",
+                "summary": "Get All Hotels By User Id",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "name",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
                         "in": "query"
                     }
                 ],
@@ -2653,11 +2965,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListRestaurantsModel"
+                            "$ref": "#/definitions/models.IdRes"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -2669,16 +2981,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/restaurant/list": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for listing restaurants by page and limit",
+                "description": "Api for Delete Hotel",
                 "consumes": [
                     "application/json"
                 ],
@@ -2686,21 +2996,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RESTAURANT"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "LIST RESTAURANTS BY PAGE AND LIMIT",
+                "summary": "Delete Hotel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "limit",
-                        "name": "limit",
+                        "description": "ID",
+                        "name": "id",
                         "in": "query",
                         "required": true
                     }
@@ -2709,11 +3012,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListRestaurantsModel"
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -2727,14 +3036,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/restaurant/listlocation": {
-            "get": {
+        "/v1/booking/hotels/{id}/accept": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for listing restaurants by page, limit, country, city and state_province",
+                "description": "Api for the owner of a hotel taking bookings on request to accept a booking that waits for approval. The booking then waits for the payment",
                 "consumes": [
                     "application/json"
                 ],
@@ -2742,45 +3051,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RESTAURANT"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "LIST RESTAURANTS BY PAGE, LIMIT, COUNTRY, CITY AND STATE_PROVINCE",
+                "summary": "ACCEPT HOTEL BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "limit",
-                        "name": "limit",
-                        "in": "query",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "name": "city",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "country",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "province",
-                        "in": "query"
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListRestaurantsModel"
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2789,6 +3096,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2798,14 +3111,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/review/create": {
+        "/v1/booking/hotels/{id}/cancel": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for creating review",
+                "description": "Api for the owner of the hotel to cancel a pending or confirmed booking. Users can also cancel their own bookings and are then held to the cancellation policy, which sets the refund and rejects late cancellations with 409",
                 "consumes": [
                     "application/json"
                 ],
@@ -2813,44 +3126,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "REVIEW"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "CREATE REVIEW",
+                "summary": "CANCEL HOTEL BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "establishment_id",
-                        "name": "establishment_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "user_id",
-                        "name": "user_id",
-                        "in": "query",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Review",
-                        "name": "Review",
+                        "description": "reason",
+                        "name": "StatusReq",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.CreateReview"
+                            "$ref": "#/definitions/models.StatusReq"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ReviewModel"
+                            "$ref": "#/definitions/models.StatusChangeModel"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
@@ -2863,14 +3192,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/review/delete": {
-            "delete": {
+        "/v1/booking/hotels/{id}/check-in": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for deleting review by review_id",
+                "description": "Api for the owner of the hotel to check in the guest of a confirmed booking",
                 "consumes": [
                     "application/json"
                 ],
@@ -2878,23 +3207,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "REVIEW"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "DELETE REVIEW BY REVIEW_ID",
+                "summary": "CHECK IN HOTEL BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "review_id",
-                        "name": "review_id",
-                        "in": "query",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.DeleteResponse"
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2903,6 +3252,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2912,14 +3267,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/review/list": {
-            "get": {
+        "/v1/booking/hotels/{id}/confirm": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for listing reviews by establishment_id",
+                "description": "Api for the owner of the hotel to confirm a pending booking",
                 "consumes": [
                     "application/json"
                 ],
@@ -2927,23 +3282,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "REVIEW"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "LIST REVIEWS BY ESTABLISHMENT_ID",
+                "summary": "CONFIRM HOTEL BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "establishment_id",
-                        "name": "establishment_id",
-                        "in": "query",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListReviews"
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "404": {
@@ -2952,6 +3327,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2961,14 +3342,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/token/{refresh}": {
-            "get": {
+        "/v1/booking/hotels/{id}/decline": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for updated acces token",
+                "description": "Api for the owner of a hotel taking bookings on request to decline a booking that waits for approval, the booking is cancelled",
                 "consumes": [
                     "application/json"
                 ],
@@ -2976,23 +3357,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "TOKEN"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "UPDATE TOKEN",
+                "summary": "DECLINE HOTEL BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Refresh Token",
-                        "name": "refresh",
+                        "description": "booking_id",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.TokenResp"
+                            "$ref": "#/definitions/models.StatusChangeModel"
                         }
                     },
                     "400": {
@@ -3001,6 +3390,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3010,14 +3417,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users": {
-            "put": {
+        "/v1/booking/hotels/{id}/history": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Update",
+                "description": "Api for getting the current status of a hotel booking and every change that led to it",
                 "consumes": [
                     "application/json"
                 ],
@@ -3025,25 +3432,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "BOOKING_HOTEL"
                 ],
-                "summary": "UPDATE",
+                "summary": "HOTEL BOOKING STATUS HISTORY",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "User",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.UserReq"
-                        }
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserRes"
+                            "$ref": "#/definitions/models.StatusHistoryModel"
                         }
                     },
                     "400": {
@@ -3052,6 +3457,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3059,14 +3470,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/booking/hotels/{id}/pay": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Create",
+                "description": "Api for charging the total price of a hotel booking, a declined card leaves the payment pending so it can be retried",
                 "consumes": [
                     "application/json"
                 ],
@@ -3074,25 +3487,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "PAYMENT"
                 ],
-                "summary": "CREATE",
+                "summary": "PAY FOR A HOTEL BOOKING",
                 "parameters": [
                     {
-                        "description": "createModel",
-                        "name": "User",
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "PayReq",
+                        "name": "PayReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.UserReq"
+                            "$ref": "#/definitions/models.PayReq"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserRes"
+                            "$ref": "#/definitions/models.PaymentModel"
                         }
                     },
                     "400": {
@@ -3101,6 +3527,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "402": {
+                        "description": "Payment Required",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3110,14 +3554,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/code": {
+        "/v1/booking/hotels/{id}/payment": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for verify new password code",
+                "description": "Api for getting the payment of a hotel booking with all its attempts, guests get the payments of their own bookings and owners those of bookings at their hotels",
                 "consumes": [
                     "application/json"
                 ],
@@ -3125,30 +3569,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SET-PASSWORD"
+                    "PAYMENT"
                 ],
-                "summary": "FORGET PASSWORD CODE",
+                "summary": "GET PAYMENT OF A HOTEL BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "code",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "email",
-                        "in": "query"
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "$ref": "#/definitions/models.PaymentModel"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -3162,14 +3603,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/list": {
-            "get": {
+        "/v1/booking/hotels/{id}/refund": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for ListUsers",
+                "description": "Api for the owner of the hotel to refund the payment of a cancelled booking, the refund is what the cancellation policy granted when the booking was cancelled",
                 "consumes": [
                     "application/json"
                 ],
@@ -3177,40 +3618,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "PAYMENT"
                 ],
-                "summary": "LIST USERS",
+                "summary": "REFUND A HOTEL BOOKING",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "name": "column",
-                        "in": "query"
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "name": "value",
-                        "in": "query"
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListUsersRes"
+                            "$ref": "#/definitions/models.PaymentModel"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/models.StandartError"
                         }
@@ -3224,14 +3670,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/list/deleted": {
+        "/v1/booking/restaurants": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for ListDeletedUsers",
+                "description": "Api for List Restaurants",
                 "consumes": [
                     "application/json"
                 ],
@@ -3239,9 +3685,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "BOOKING_RESTAURANT"
                 ],
-                "summary": "LIST DELETED USERS",
+                "summary": "List Restaurants",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3252,23 +3698,13 @@ const docTemplate = `{
                         "type": "integer",
                         "name": "page",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "column",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "value",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ListUsersRes"
+                            "$ref": "#/definitions/models.List"
                         }
                     },
                     "400": {
@@ -3284,16 +3720,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/users/login": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for login user",
+                "description": "Api for Update Booked Restaurant",
                 "consumes": [
                     "application/json"
                 ],
@@ -3301,26 +3735,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "LOGIN"
+                    "BOOKING_RESTAURANT"
                 ],
-                "summary": "LOGIN",
+                "summary": "Update Booked Restaurant",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "email",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "password",
-                        "in": "query"
+                        "description": "createModel",
+                        "name": "models.UpdateBookingReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateBookingReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserResCreate"
+                            "$ref": "#/definitions/models.BookingRes"
                         }
                     },
                     "400": {
@@ -3336,16 +3769,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/users/password": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for update new password",
+                "description": "Api for Create Restaurant Booking",
                 "consumes": [
                     "application/json"
                 ],
@@ -3353,26 +3784,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SET-PASSWORD"
+                    "BOOKING_RESTAURANT"
                 ],
-                "summary": "SET NEW PASSWORD",
+                "summary": "Create Restaurant Booking",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "email",
-                        "in": "query"
+                        "description": "createModel (will_arrive as YYYY-MM-DD HH:MM)",
+                        "name": "CreateBookingReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateBookingReq"
+                        }
                     },
                     {
                         "type": "string",
-                        "name": "password",
-                        "in": "query"
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserResCreate"
+                            "$ref": "#/definitions/models.BookingRes"
                         }
                     },
                     "400": {
@@ -3381,6 +3817,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3390,14 +3838,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/register": {
-            "post": {
+        "/v1/booking/restaurants/deleted": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for register a new user",
+                "description": "Api for List Deleted Restaurants",
                 "consumes": [
                     "application/json"
                 ],
@@ -3405,25 +3853,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SIGNUP"
+                    "BOOKING_RESTAURANT"
                 ],
-                "summary": "REGISTER USER",
+                "summary": "List Deleted Restaurants",
                 "parameters": [
                     {
-                        "description": "RegisterUser",
-                        "name": "User",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/models.RegisterReq"
-                        }
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "$ref": "#/definitions/models.List"
                         }
                     },
                     "400": {
@@ -3441,14 +3890,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/set/{email}": {
+        "/v1/booking/restaurants/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for set new password",
+                "description": "Api for Get All Restaurants By User Id",
                 "consumes": [
                     "application/json"
                 ],
@@ -3456,23 +3905,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SET-PASSWORD"
+                    "BOOKING_RESTAURANT"
                 ],
-                "summary": "FORGET PASSWORD",
+                "summary": "Get All Restaurants By User Id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "EMAIL",
-                        "name": "email",
-                        "in": "query",
-                        "required": true
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "$ref": "#/definitions/models.IdRes"
                         }
                     },
                     "400": {
@@ -3488,16 +3945,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/users/token": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get user by token",
+                "description": "Api for Delete Restaurant",
                 "consumes": [
                     "application/json"
                 ],
@@ -3505,14 +3960,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "BOOKING_RESTAURANT"
+                ],
+                "summary": "Delete Restaurant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "GET BY TOKEN",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserRes"
+                            "$ref": "#/definitions/models.StandartError"
                         }
                     },
                     "400": {
@@ -3530,14 +3994,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/verify": {
-            "get": {
+        "/v1/booking/restaurants/{id}/accept": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for verify a new user",
+                "description": "Api for the owner of a restaurant taking bookings on request to accept a booking that waits for approval. The booking is confirmed",
                 "consumes": [
                     "application/json"
                 ],
@@ -3545,26 +4009,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SIGNUP"
+                    "BOOKING_RESTAURANT"
                 ],
-                "summary": "VERIFICATION",
+                "summary": "ACCEPT RESTAURANT BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "code",
-                        "in": "query"
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "name": "email",
-                        "in": "query"
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserResCreate"
+                            "$ref": "#/definitions/models.StatusChangeModel"
                         }
                     },
                     "400": {
@@ -3573,6 +4042,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3582,14 +4069,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/{id}": {
-            "get": {
+        "/v1/booking/restaurants/{id}/cancel": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Get",
+                "description": "Api for the owner of the restaurant to cancel a pending or confirmed booking. Users can also cancel their own bookings and are then held to the cancellation policy, which sets the refund and rejects late cancellations with 409",
                 "consumes": [
                     "application/json"
                 ],
@@ -3597,23 +4084,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "BOOKING_RESTAURANT"
                 ],
-                "summary": "GET",
+                "summary": "CANCEL RESTAURANT BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "booking_id",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.UserRes"
+                            "$ref": "#/definitions/models.StatusChangeModel"
                         }
                     },
                     "400": {
@@ -3622,6 +4123,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3629,14 +4148,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/booking/restaurants/{id}/check-in": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Api for Delete",
+                "description": "Api for the owner of the restaurant to check in the guest of a confirmed booking",
                 "consumes": [
                     "application/json"
                 ],
@@ -3644,23 +4165,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "USER"
+                    "BOOKING_RESTAURANT"
                 ],
-                "summary": "DELETE",
+                "summary": "CHECK IN RESTAURANT BOOKING",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "booking_id",
                         "name": "id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.RegisterRes"
+                            "$ref": "#/definitions/models.StatusChangeModel"
                         }
                     },
                     "400": {
@@ -3669,6 +4198,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.StandartError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3677,331 +4224,8196 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.AttractionModel": {
-            "type": "object",
-            "properties": {
-                "attraction_id": {
-                    "type": "string"
-                },
-                "attraction_name": {
-                    "type": "string"
-                },
-                "contact_number": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "images": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ImageModel"
+        },
+        "/v1/booking/restaurants/{id}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
                     }
-                },
-                "licence_url": {
+                ],
+                "description": "Api for the owner of the restaurant to confirm a pending booking",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_RESTAURANT"
+                ],
+                "summary": "CONFIRM RESTAURANT BOOKING",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/booking/restaurants/{id}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of a restaurant taking bookings on request to decline a booking that waits for approval, the booking is cancelled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_RESTAURANT"
+                ],
+                "summary": "DECLINE RESTAURANT BOOKING",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/booking/restaurants/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting the current status of a restaurant booking and every change that led to it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_RESTAURANT"
+                ],
+                "summary": "RESTAURANT BOOKING STATUS HISTORY",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusHistoryModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/booking/users/attraction/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Get All Users By Attraction Id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_ATTRACTION"
+                ],
+                "summary": "Get All Users By Attraction Id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.IdRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/booking/users/restaurant/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Get All Users By Restaurant Id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_RESTAURANT"
+                ],
+                "summary": "Get All Users By Restaurant Id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.IdRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/booking/users/room/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Get All Users By Room Id. Use /v1/owner/bookings instead, it lists the bookings of all rooms of the hotels of the owner with their guests",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_HOTEL"
+                ],
+                "summary": "Get All Users By Room Id",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.IdRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing bookings of the user, of one type or of all of them. Admins may list bookings of any user by user_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "List User Bookings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel, restaurant or attraction",
+                        "name": "booking_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "user_id",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.List"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for changing the reason of a pending or confirmed booking, booking_type tells which one. Dates and party size change through /v1/bookings/{id}/reschedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "Update Booking",
+                "parameters": [
+                    {
+                        "description": "updateModel",
+                        "name": "UpdateBookingReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateBookingReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for booking a hotel room, a restaurant table or attraction tickets, booking_type picks which one (will_arrive as YYYY-MM-DD for hotels and YYYY-MM-DD HH:MM otherwise). With waitlist set a full room or restaurant slot puts the guest on the waitlist and answers 202",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "Create Booking",
+                "parameters": [
+                    {
+                        "description": "createModel",
+                        "name": "CreateBookingReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateBookingReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/models.WaitlistEntryModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings/calendar.ics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for downloading every booking of the user as one iCalendar (.ics) file. Holds are left out until they are confirmed",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "CALENDAR"
+                ],
+                "summary": "USER BOOKINGS CALENDAR",
+                "responses": {
+                    "200": {
+                        "description": "text/calendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings/code/{code}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for establishment owners to find a booking of their establishments by the confirmation code the guest shows, the code may be typed in lower case or with dashes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "Get Booking By Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "confirmation_code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings/deleted": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing deleted bookings of one type or of all of them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "List Deleted Bookings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel, restaurant or attraction",
+                        "name": "booking_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.List"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings/establishment/{id}/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to list users who booked a room, a restaurant or an attraction",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "List Booked Users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "room, restaurant or attraction id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "hotel, restaurant or attraction",
+                        "name": "booking_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.BookedUser"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing bookings of one type or of all of them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "List Bookings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel, restaurant or attraction",
+                        "name": "booking_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.List"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting a booking of any type, users see only their own bookings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "Get Booking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "hotel, restaurant or attraction",
+                        "name": "booking_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for deleting a cancelled, no-show or expired booking. Bookings that still hold their place answer 409 and have to be cancelled first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "Delete Booking",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "hotel, restaurant or attraction",
+                        "name": "booking_type",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings/{id}/calendar.ics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for downloading the booking as an iCalendar (.ics) event. Hotel stays are all-day events ending on the check-out day, restaurant and attraction bookings start at the reserved time",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "CALENDAR"
+                ],
+                "summary": "BOOKING CALENDAR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "text/calendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings/{id}/reschedule": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for moving a pending or confirmed booking to new dates or changing its party size. The booking has to fit into what is left at the new dates, hotel stays are priced again. Users can reschedule only their own bookings and are held to the change rules of the cancellation policy, which set the change fee and reject late changes with 409",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING"
+                ],
+                "summary": "RESCHEDULE BOOKING",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "rescheduleModel",
+                        "name": "RescheduleReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RescheduleReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusChangeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/bookings/{id}/ticket": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the ticket of a booking as a QR code the establishment scans at the door. The code carries a signed payload and lets the guest in once",
+                "produces": [
+                    "image/png",
+                    "image/svg+xml"
+                ],
+                "tags": [
+                    "TICKET"
+                ],
+                "summary": "BOOKING TICKET",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "booking_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "png or svg, png by default",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "width and height of a png in pixels, 320 by default",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "image",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/favourite/add": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for adding establishment to favourites",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAVOURITE"
+                ],
+                "summary": "ADD ESTABLISHMENT TO FAVOURITES",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "establishment_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "user_id",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.FavouriteModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/favourite/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing favourites by favourite_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAVOURITE"
+                ],
+                "summary": "LIST FAVOURITES BY USER_ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "user_id",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListFavouritesModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/favourite/remove": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for removing favourite by favourite_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAVOURITE"
+                ],
+                "summary": "REMOVE FROM FAVOURITES BY FAVOURITE_ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "favourite_id",
+                        "name": "favourite_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RemoveResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/holds": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for holding a hotel room, a restaurant table or attraction tickets during checkout. The hold keeps its place like a booking until hold_expires_at and is released on its own unless it is confirmed before",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOLD"
+                ],
+                "summary": "CREATE HOLD",
+                "parameters": [
+                    {
+                        "description": "holdModel",
+                        "name": "CreateHoldReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateHoldReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/holds/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for giving up a hold before it runs out",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOLD"
+                ],
+                "summary": "RELEASE HOLD",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hold_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/holds/{id}/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for turning a hold into a booking, hotel bookings wait for the payment as usual. Holds that ran out are rejected with 409",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOLD"
+                ],
+                "summary": "CONFIRM HOLD",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hold_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting hotel by hotel_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOTEL"
+                ],
+                "summary": "GET HOTEL BY HOTEL_ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "hotel_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.HotelModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for updating hotel by hotel_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOTEL"
+                ],
+                "summary": "UPDATE HOTEL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "hotel_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdatingHotel",
+                        "name": "UpdatingHotel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateHotel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.HotelModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for creating hotel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOTEL"
+                ],
+                "summary": "CREATE HOTEL",
+                "parameters": [
+                    {
+                        "description": "Hotel",
+                        "name": "Hotel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateHotel"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.HotelModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for deleting hotel by hotel_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOTEL"
+                ],
+                "summary": "DELETE HOTEL BY HOTEL_ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "hotel_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/available": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing hotels having a free room for the stay, with the cheapest free room and its nightly price",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOTEL"
+                ],
+                "summary": "LIST AVAILABLE HOTELS BY CITY, DATES AND PARTY SIZE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "city",
+                        "name": "city",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "will_arrive (YYYY-MM-DD)",
+                        "name": "will_arrive",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "will_leave (YYYY-MM-DD)",
+                        "name": "will_leave",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "party_size",
+                        "name": "party_size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListAvailableHotelsModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing hotels by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOTEL"
+                ],
+                "summary": "FIND HOTELS BY NAME",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListHotelsModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing hotels by page and limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOTEL"
+                ],
+                "summary": "LIST HOTELS BY PAGE AND LIMIT",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListHotelsModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/listlocation": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing hotels by page, limit, country, city and state_province",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "HOTEL"
+                ],
+                "summary": "LIST HOTELS BY PAGE, LIMIT, COUNTRY, CITY AND STATE_PROVINCE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "province",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListHotelsModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/add-ons": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing the add-ons guests can book with the establishment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ADD_ON"
+                ],
+                "summary": "LIST ADD-ONS OF ESTABLISHMENT",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListAddOnsModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to add an extra such as breakfast, parking or a transfer to the add-ons the establishment sells with its bookings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ADD_ON"
+                ],
+                "summary": "CREATE ADD-ON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "AddOn",
+                        "name": "AddOn",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateAddOn"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.AddOnModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/add-ons/{add_on_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to change one of its add-ons, bookings made before keep the price they were made at",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ADD_ON"
+                ],
+                "summary": "UPDATE ADD-ON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "add_on_id",
+                        "name": "add_on_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "AddOn",
+                        "name": "AddOn",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateAddOn"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AddOnModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to stop selling one of its add-ons, bookings made with it keep it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ADD_ON"
+                ],
+                "summary": "DELETE ADD-ON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "add_on_id",
+                        "name": "add_on_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/booking-mode": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting how the establishment takes bookings, establishments that never chose take them instantly",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_MODE"
+                ],
+                "summary": "GET BOOKING MODE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingModeModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to choose how it takes bookings. In instant mode bookings are taken as soon as they fit, in request mode they stay pending until the owner accepts or declines them and are declined when nobody answered in time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_MODE"
+                ],
+                "summary": "SET BOOKING MODE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "instant or request",
+                        "name": "BookingMode",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetBookingMode"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingModeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/calendar-feed": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting the secret link of the establishment booking calendar. Calendar apps subscribed to the link see every booking of the establishment without signing in, so the link should only be shared with its staff",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CALENDAR"
+                ],
+                "summary": "ESTABLISHMENT CALENDAR FEED",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CalendarFeedRes"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/calendar.ics": {
+            "get": {
+                "description": "Api for the iCalendar (.ics) feed of the establishment bookings from the last 30 days on, calendar apps subscribe to it with the link from calendar-feed",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "CALENDAR"
+                ],
+                "summary": "ESTABLISHMENT CALENDAR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "token of the calendar-feed link",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "text/calendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/cancellation-policy": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting the cancellation policy of the establishment, establishments without a policy allow free cancellation until arrival",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CANCELLATION_POLICY"
+                ],
+                "summary": "GET CANCELLATION POLICY",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CancellationPolicyModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for replacing the cancellation policy of the establishment. Guests cancelling at least hours_before hours before arrival get refund_percent of the price back, the rule with the latest deadline that was not missed applies and cancellations after every deadline are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CANCELLATION_POLICY"
+                ],
+                "summary": "SET CANCELLATION POLICY",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "CancellationPolicy",
+                        "name": "CancellationPolicy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetCancellationPolicy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CancellationPolicyModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for deleting the cancellation policy of the establishment, bookings fall back to free cancellation until arrival",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CANCELLATION_POLICY"
+                ],
+                "summary": "DELETE CANCELLATION POLICY",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/closures": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing the closure calendar of the establishment, the days it takes no bookings on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CLOSURE"
+                ],
+                "summary": "LIST CLOSURES OF ESTABLISHMENT",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListClosuresModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to close it for bookings. Without weekdays it is closed every day from start_date to end_date, with weekdays such as \"monday\" only on those days of the week, between start_date and end_date when they are given. Dates are in YYYY-MM-DD format and both ends are included. Bookings on closed days are rejected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CLOSURE"
+                ],
+                "summary": "CREATE CLOSURE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Closure",
+                        "name": "Closure",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateClosure"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ClosureModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/closures/{closure_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to change the dates, weekdays or reason of one of its closures, bookings made before are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CLOSURE"
+                ],
+                "summary": "UPDATE CLOSURE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "closure_id",
+                        "name": "closure_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Closure",
+                        "name": "Closure",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateClosure"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ClosureModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to remove one of its closures, its days can be booked again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CLOSURE"
+                ],
+                "summary": "DELETE CLOSURE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "closure_id",
+                        "name": "closure_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/rooms": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing rooms of the hotel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ROOM"
+                ],
+                "summary": "LIST ROOMS OF HOTEL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListRoomsModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the hotel to create a room type of it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ROOM"
+                ],
+                "summary": "CREATE ROOM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Room",
+                        "name": "Room",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateRoom"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.RoomModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/rooms/{room_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting a room of the hotel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ROOM"
+                ],
+                "summary": "GET ROOM BY ROOM_ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "room_id",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RoomModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the hotel to update a room of it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ROOM"
+                ],
+                "summary": "UPDATE ROOM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "room_id",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Room",
+                        "name": "Room",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateRoom"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RoomModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the hotel to delete a room of it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ROOM"
+                ],
+                "summary": "DELETE ROOM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "room_id",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/rooms/{room_id}/blocks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the hotel to list the nights the room is taken on other platforms, each block takes one room from starts_on up to ends_on (the check-out day)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EXTERNAL_CALENDAR"
+                ],
+                "summary": "LIST EXTERNAL BLOCKS",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "room_id",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ExternalBlockModel"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/rooms/{room_id}/calendars": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the hotel to list the calendars of other platforms the room is synced with, when they last synced and how many blocks they hold",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EXTERNAL_CALENDAR"
+                ],
+                "summary": "LIST EXTERNAL CALENDARS",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "room_id",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CalendarSourceModel"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the hotel to sync the room with the iCalendar link of another platform it is listed on. Every event of the calendar takes one room for its nights so the room is not booked twice. The calendar is fetched right away and then periodically, a failed fetch shows up as last_error and keeps the blocks of the previous sync",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EXTERNAL_CALENDAR"
+                ],
+                "summary": "ADD EXTERNAL CALENDAR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "room_id",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "calendar",
+                        "name": "AddCalendarReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AddCalendarReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CalendarSourceModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/rooms/{room_id}/calendars/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the hotel to import an iCalendar (.ics) file exported from another platform the room is listed on. The file is imported once, uploading it again to the sync api replaces its blocks",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EXTERNAL_CALENDAR"
+                ],
+                "summary": "UPLOAD EXTERNAL CALENDAR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "room_id",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "name of the other platform",
+                        "name": "name",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "iCalendar file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CalendarSourceModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/rooms/{room_id}/calendars/{calendar_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the hotel to stop syncing the room with a calendar of another platform, its blocks are removed with it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EXTERNAL_CALENDAR"
+                ],
+                "summary": "REMOVE EXTERNAL CALENDAR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "room_id",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "calendar_id",
+                        "name": "calendar_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/hotel/{id}/rooms/{room_id}/calendars/{calendar_id}/sync": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the hotel to sync a calendar of another platform now instead of waiting for the periodic sync. An uploaded calendar is replaced by the file sent along",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "EXTERNAL_CALENDAR"
+                ],
+                "summary": "SYNC EXTERNAL CALENDAR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hotel_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "room_id",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "calendar_id",
+                        "name": "calendar_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "iCalendar file, only for uploaded calendars",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CalendarSourceModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/itineraries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing the itineraries of the user with their bookings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ITINERARY"
+                ],
+                "summary": "LIST ITINERARIES",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ItineraryModel"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for booking a whole trip at once: hotel stays, restaurant tables and attraction tickets are booked together or not at all. When one booking fails the ones already made are cancelled again and the error of the failed one is returned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ITINERARY"
+                ],
+                "summary": "CREATE ITINERARY",
+                "parameters": [
+                    {
+                        "description": "createModel",
+                        "name": "CreateItineraryReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateItineraryReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ItineraryModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/itineraries/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting an itinerary with its bookings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ITINERARY"
+                ],
+                "summary": "GET ITINERARY",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "itinerary_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ItineraryModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/itineraries/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for cancelling every booking of a trip that can still be cancelled. Each booking is held to the cancellation policy of its establishment, a booking that can no longer be cancelled stops the cancellation with 409",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ITINERARY"
+                ],
+                "summary": "CANCEL ITINERARY",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "itinerary_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "reason",
+                        "name": "StatusReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.StatusReq"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ItineraryModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/media/establishment/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Through this api frontent can upload establishment photo and get the link to the media.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MEDIA"
+                ],
+                "summary": "Upload Establishment photo",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Image",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Establishment_ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.EstablishmentImageRespons"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/media/user-photo": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Through this api frontent can upload user photo and get the link to the media.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "MEDIA"
+                ],
+                "summary": "Upload User photo",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Image",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/owner/bookings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for owners to list the bookings of all of their establishments, or of one of them, with the guests who made them. A booking is listed when any of its days falls between from and to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "OWNER"
+                ],
+                "summary": "List Owner Bookings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "only bookings of this establishment",
+                        "name": "establishment_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "first day, as 2024-12-30",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last day, as 2025-01-02",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated statuses, all but held and expired by default",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.OwnerBookingList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payments/webhook": {
+            "post": {
+                "description": "Api for events of the payment provider, the body must be signed in the X-Payment-Signature header",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PAYMENT"
+                ],
+                "summary": "PAYMENT PROVIDER WEBHOOK",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "hex encoded HMAC-SHA256 of the body",
+                        "name": "X-Payment-Signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "PaymentWebhookReq",
+                        "name": "PaymentWebhookReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.PaymentWebhookReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PaymentModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting restaurant by restaurant_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RESTAURANT"
+                ],
+                "summary": "GET RESTAURANT BY RESTAURANT_ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "restaurant_id",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RestaurantModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for updating restaurant by restaurant_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RESTAURANT"
+                ],
+                "summary": "UPDATE RESTAURANT",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "restaurant_id",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdatingRestaurant",
+                        "name": "UpdatingRestaurant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateRestaurant"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RestaurantModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for creating restaurant",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RESTAURANT"
+                ],
+                "summary": "CREATE RESTAURANT",
+                "parameters": [
+                    {
+                        "description": "Restaurant",
+                        "name": "Restaurant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateRestaurant"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RestaurantModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for deleting restaurant by restaurant_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RESTAURANT"
+                ],
+                "summary": "DELETE RESTAURANT BY RESTAURANT_ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "restaurant_id",
+                        "name": "restaurant_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/find": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing restaurants by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RESTAURANT"
+                ],
+                "summary": "FIND RESTAURANTS BY NAME",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListRestaurantsModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing restaurants by page and limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RESTAURANT"
+                ],
+                "summary": "LIST RESTAURANTS BY PAGE AND LIMIT",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListRestaurantsModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/listlocation": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing restaurants by page, limit, country, city and state_province",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RESTAURANT"
+                ],
+                "summary": "LIST RESTAURANTS BY PAGE, LIMIT, COUNTRY, CITY AND STATE_PROVINCE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "province",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListRestaurantsModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/{id}/add-ons": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing the add-ons guests can book with the establishment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ADD_ON"
+                ],
+                "summary": "LIST ADD-ONS OF ESTABLISHMENT",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListAddOnsModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to add an extra such as breakfast, parking or a transfer to the add-ons the establishment sells with its bookings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ADD_ON"
+                ],
+                "summary": "CREATE ADD-ON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "AddOn",
+                        "name": "AddOn",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateAddOn"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.AddOnModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/{id}/add-ons/{add_on_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to change one of its add-ons, bookings made before keep the price they were made at",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ADD_ON"
+                ],
+                "summary": "UPDATE ADD-ON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "add_on_id",
+                        "name": "add_on_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "AddOn",
+                        "name": "AddOn",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateAddOn"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AddOnModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to stop selling one of its add-ons, bookings made with it keep it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ADD_ON"
+                ],
+                "summary": "DELETE ADD-ON",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "add_on_id",
+                        "name": "add_on_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/{id}/booking-mode": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting how the establishment takes bookings, establishments that never chose take them instantly",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_MODE"
+                ],
+                "summary": "GET BOOKING MODE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingModeModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to choose how it takes bookings. In instant mode bookings are taken as soon as they fit, in request mode they stay pending until the owner accepts or declines them and are declined when nobody answered in time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BOOKING_MODE"
+                ],
+                "summary": "SET BOOKING MODE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "instant or request",
+                        "name": "BookingMode",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetBookingMode"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingModeModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/{id}/calendar-feed": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting the secret link of the establishment booking calendar. Calendar apps subscribed to the link see every booking of the establishment without signing in, so the link should only be shared with its staff",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CALENDAR"
+                ],
+                "summary": "ESTABLISHMENT CALENDAR FEED",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CalendarFeedRes"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/{id}/calendar.ics": {
+            "get": {
+                "description": "Api for the iCalendar (.ics) feed of the establishment bookings from the last 30 days on, calendar apps subscribe to it with the link from calendar-feed",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "CALENDAR"
+                ],
+                "summary": "ESTABLISHMENT CALENDAR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "token of the calendar-feed link",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "text/calendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/{id}/cancellation-policy": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for getting the cancellation policy of the establishment, establishments without a policy allow free cancellation until arrival",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CANCELLATION_POLICY"
+                ],
+                "summary": "GET CANCELLATION POLICY",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CancellationPolicyModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for replacing the cancellation policy of the establishment. Guests cancelling at least hours_before hours before arrival get refund_percent of the price back, the rule with the latest deadline that was not missed applies and cancellations after every deadline are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CANCELLATION_POLICY"
+                ],
+                "summary": "SET CANCELLATION POLICY",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "CancellationPolicy",
+                        "name": "CancellationPolicy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SetCancellationPolicy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CancellationPolicyModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for deleting the cancellation policy of the establishment, bookings fall back to free cancellation until arrival",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CANCELLATION_POLICY"
+                ],
+                "summary": "DELETE CANCELLATION POLICY",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/{id}/closures": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing the closure calendar of the establishment, the days it takes no bookings on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CLOSURE"
+                ],
+                "summary": "LIST CLOSURES OF ESTABLISHMENT",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListClosuresModel"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to close it for bookings. Without weekdays it is closed every day from start_date to end_date, with weekdays such as \"monday\" only on those days of the week, between start_date and end_date when they are given. Dates are in YYYY-MM-DD format and both ends are included. Bookings on closed days are rejected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CLOSURE"
+                ],
+                "summary": "CREATE CLOSURE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Closure",
+                        "name": "Closure",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateClosure"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.ClosureModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/{id}/closures/{closure_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to change the dates, weekdays or reason of one of its closures, bookings made before are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CLOSURE"
+                ],
+                "summary": "UPDATE CLOSURE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "closure_id",
+                        "name": "closure_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Closure",
+                        "name": "Closure",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateClosure"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ClosureModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for the owner of the establishment to remove one of its closures, its days can be booked again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CLOSURE"
+                ],
+                "summary": "DELETE CLOSURE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "closure_id",
+                        "name": "closure_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/restaurant/{id}/slots": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing the reservation slots of a restaurant within its opening hours with the seats still free in each",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RESTAURANT"
+                ],
+                "summary": "LIST FREE RESERVATION SLOTS OF A RESTAURANT FOR A DATE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "restaurant_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListSlotsModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/review/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for creating review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "REVIEW"
+                ],
+                "summary": "CREATE REVIEW",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "establishment_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "user_id",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Review",
+                        "name": "Review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateReview"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/review/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for deleting review by review_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "REVIEW"
+                ],
+                "summary": "DELETE REVIEW BY REVIEW_ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "review_id",
+                        "name": "review_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DeleteResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/review/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing reviews by establishment_id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "REVIEW"
+                ],
+                "summary": "LIST REVIEWS BY ESTABLISHMENT_ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "establishment_id",
+                        "name": "establishment_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListReviews"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tickets/check-in": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for establishment owners to check the guest in with the payload read from the ticket QR code. Only tickets of bookings at their own establishments are accepted and a ticket that was used already is rejected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TICKET"
+                ],
+                "summary": "SCAN TICKET",
+                "parameters": [
+                    {
+                        "description": "payload of the QR code",
+                        "name": "TicketScanReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TicketScanReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TicketScanRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/token/{refresh}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for updated acces token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "TOKEN"
+                ],
+                "summary": "UPDATE TOKEN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refresh Token",
+                        "name": "refresh",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TokenResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Update",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "UPDATE",
+                "parameters": [
+                    {
+                        "description": "createModel",
+                        "name": "User",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UserReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Create",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "CREATE",
+                "parameters": [
+                    {
+                        "description": "createModel",
+                        "name": "User",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UserReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/code": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for verify new password code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SET-PASSWORD"
+                ],
+                "summary": "FORGET PASSWORD CODE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "email",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for ListUsers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "LIST USERS",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "column",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListUsersRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/list/deleted": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for ListDeletedUsers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "LIST DELETED USERS",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "column",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "value",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ListUsersRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/login": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for login user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LOGIN"
+                ],
+                "summary": "LOGIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "password",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserResCreate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for update new password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SET-PASSWORD"
+                ],
+                "summary": "SET NEW PASSWORD",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "password",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserResCreate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/register": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for register a new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SIGNUP"
+                ],
+                "summary": "REGISTER USER",
+                "parameters": [
+                    {
+                        "description": "RegisterUser",
+                        "name": "User",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/set/{email}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for set new password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SET-PASSWORD"
+                ],
+                "summary": "FORGET PASSWORD",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "EMAIL",
+                        "name": "email",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/token": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Get user by token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "GET BY TOKEN",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/verify": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for verify a new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SIGNUP"
+                ],
+                "summary": "VERIFICATION",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "email",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserResCreate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Get",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "GET",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for Delete",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "USER"
+                ],
+                "summary": "DELETE",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegisterRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/waitlist": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for listing the waitlist entries and open offers of the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WAITLIST"
+                ],
+                "summary": "LIST WAITLIST",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WaitlistEntryModel"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for queueing for a hotel room or a restaurant slot that is full. When a booking for these dates is cancelled the guest who waited longest and whose party fits gets an offer. The place is held for the guest until offer_expires_at",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WAITLIST"
+                ],
+                "summary": "JOIN WAITLIST",
+                "parameters": [
+                    {
+                        "description": "joinModel",
+                        "name": "JoinWaitlistReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.JoinWaitlistReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.WaitlistEntryModel"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/waitlist/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for leaving the waitlist, an open offer passes on to the next guest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WAITLIST"
+                ],
+                "summary": "LEAVE WAITLIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "waitlist_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WaitlistEntryModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/waitlist/{id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for turning an open waitlist offer into a booking, the booking held for the offer is confirmed. Offers that ran out are rejected with 409",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WAITLIST"
+                ],
+                "summary": "ACCEPT WAITLIST OFFER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "waitlist_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "repeats of a request with the same key return its first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.BookingRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/waitlist/{id}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Api for turning down a waitlist offer, it passes on to the next guest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WAITLIST"
+                ],
+                "summary": "DECLINE WAITLIST OFFER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "waitlist_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WaitlistEntryModel"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.StandartError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.AddCalendarReq": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "default": "Other platform"
+                },
+                "url": {
+                    "type": "string",
+                    "default": "https://example.com/listing.ics"
+                }
+            }
+        },
+        "models.AddOnModel": {
+            "type": "object",
+            "properties": {
+                "add_on_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "establishment_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AttractionModel": {
+            "type": "object",
+            "properties": {
+                "attraction_id": {
+                    "type": "string"
+                },
+                "attraction_name": {
+                    "type": "string"
+                },
+                "contact_number": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "daily_quota": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "entry_window_minutes": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImageModel"
+                    }
+                },
+                "licence_url": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/models.LocationModel"
+                },
+                "opening_hours": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "ticket_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TicketTypeModel"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "website_url": {
+                    "type": "string"
+                },
+                "window_quota": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AttractionQuotaModel": {
+            "type": "object",
+            "properties": {
+                "daily_quota": {
+                    "type": "integer"
+                },
+                "daily_remaining": {
+                    "type": "integer"
+                },
+                "window_quota": {
+                    "type": "integer"
+                },
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EntryWindowModel"
+                    }
+                }
+            }
+        },
+        "models.AvailableHotelModel": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "free_rooms": {
+                    "type": "integer"
+                },
+                "hotel_id": {
+                    "type": "string"
+                },
+                "hotel_name": {
+                    "type": "string"
+                },
+                "nightly_price": {
+                    "type": "number"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "room_capacity": {
+                    "type": "integer"
+                },
+                "room_description": {
+                    "type": "string"
+                },
+                "room_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BookedUser": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BookingAddOn": {
+            "type": "object",
+            "properties": {
+                "add_on_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "unit_price": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.BookingAddOnReq": {
+            "type": "object",
+            "properties": {
+                "add_on_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "default": 1
+                }
+            }
+        },
+        "models.BookingGuest": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "type": "integer"
+                },
+                "document_number": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BookingModeModel": {
+            "type": "object",
+            "properties": {
+                "establishment_id": {
+                    "type": "string"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BookingRes": {
+            "type": "object",
+            "properties": {
+                "add_ons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingAddOn"
+                    }
+                },
+                "adult_tickets": {
+                    "type": "integer"
+                },
+                "approval_due_at": {
+                    "type": "string"
+                },
+                "booking_type": {
+                    "type": "string"
+                },
+                "child_tickets": {
+                    "type": "integer"
+                },
+                "confirmation_code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "guests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingGuest"
+                    }
+                },
+                "hold_expires_at": {
+                    "type": "string"
+                },
+                "hra_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_canceled": {
+                    "type": "boolean"
+                },
+                "itinerary_id": {
+                    "type": "string"
+                },
+                "number_of_people": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "special_request_note": {
+                    "type": "string"
+                },
+                "special_requests": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_price": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "will_arrive": {
+                    "type": "string"
+                },
+                "will_leave": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CalendarFeedRes": {
+            "type": "object",
+            "properties": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CalendarSourceModel": {
+            "type": "object",
+            "properties": {
+                "blocks": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_synced_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CancellationPolicyModel": {
+            "type": "object",
+            "properties": {
+                "establishment_id": {
+                    "type": "string"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CancellationRuleModel"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CancellationRuleModel": {
+            "type": "object",
+            "properties": {
+                "change_fee_percent": {
+                    "type": "number",
+                    "default": 0
+                },
+                "hours_before": {
+                    "type": "integer",
+                    "default": 48
+                },
+                "refund_percent": {
+                    "type": "number",
+                    "default": 100
+                }
+            }
+        },
+        "models.ClosureModel": {
+            "type": "object",
+            "properties": {
+                "closure_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "establishment_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.CreateAddOn": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "default": "buffet breakfast from 7 to 10"
+                },
+                "name": {
+                    "type": "string",
+                    "default": "Breakfast"
+                },
+                "price": {
+                    "type": "number",
+                    "default": 12
+                }
+            }
+        },
+        "models.CreateAttraction": {
+            "type": "object",
+            "properties": {
+                "attraction_name": {
+                    "type": "string",
+                    "default": "Anhor Park"
+                },
+                "contact_number": {
+                    "type": "string",
+                    "default": "+(99891)-234-56-78"
+                },
+                "daily_quota": {
+                    "type": "integer",
+                    "default": 500
+                },
+                "description": {
+                    "type": "string",
+                    "default": "available for all ages"
+                },
+                "entry_window_minutes": {
+                    "type": "integer",
+                    "default": 60
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CreateImage"
+                    }
+                },
+                "licence_url": {
+                    "type": "string",
+                    "default": "https://creativecommons.org/licenses/by/4.0/"
+                },
+                "location": {
+                    "$ref": "#/definitions/models.CreateLocation"
+                },
+                "opening_hours": {
+                    "type": "string",
+                    "default": "09:00-18:00"
+                },
+                "rating": {
+                    "type": "number",
+                    "default": 4.3
+                },
+                "website_url": {
+                    "type": "string",
+                    "default": "https://creativecommons.org/licenses/by/4.1/"
+                },
+                "window_quota": {
+                    "type": "integer",
+                    "default": 80
+                }
+            }
+        },
+        "models.CreateBookingReq": {
+            "type": "object",
+            "properties": {
+                "add_ons": {
+                    "description": "AddOns are priced from the catalogue of the establishment into the total",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingAddOnReq"
+                    }
+                },
+                "adult_tickets": {
+                    "type": "integer"
+                },
+                "booking_type": {
+                    "type": "string"
+                },
+                "child_tickets": {
+                    "type": "integer"
+                },
+                "guests": {
+                    "description": "Guests names the people of the party, hotels register them on arrival",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingGuest"
+                    }
+                },
+                "hra_id": {
+                    "type": "string"
+                },
+                "number_of_people": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "special_request_note": {
+                    "type": "string"
+                },
+                "special_requests": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "late_arrival",
+                        "crib"
+                    ]
+                },
+                "waitlist": {
+                    "description": "Waitlist joins the waitlist when a room or restaurant slot is full",
+                    "type": "boolean"
+                },
+                "will_arrive": {
+                    "type": "string"
+                },
+                "will_leave": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CreateClosure": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "default": "2030-02-14"
+                },
+                "reason": {
+                    "type": "string",
+                    "default": "renovation"
+                },
+                "start_date": {
+                    "type": "string",
+                    "default": "2030-02-01"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.CreateHoldReq": {
+            "type": "object",
+            "properties": {
+                "adult_tickets": {
+                    "type": "integer"
+                },
+                "booking_type": {
+                    "type": "string",
+                    "default": "hotel"
+                },
+                "child_tickets": {
+                    "type": "integer"
+                },
+                "hra_id": {
+                    "type": "string"
+                },
+                "number_of_people": {
+                    "type": "integer",
+                    "default": 2
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "will_arrive": {
+                    "type": "string",
+                    "default": "2024-12-30"
+                },
+                "will_leave": {
+                    "type": "string",
+                    "default": "2025-01-02"
+                }
+            }
+        },
+        "models.CreateHotel": {
+            "type": "object",
+            "properties": {
+                "contact_number": {
+                    "type": "string",
+                    "default": "+(99891)-234-56-78"
+                },
+                "description": {
+                    "type": "string",
+                    "default": "in affordable prices"
+                },
+                "hotel_name": {
+                    "type": "string",
+                    "default": "Silk Road"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CreateImage"
+                    }
+                },
+                "licence_url": {
+                    "type": "string",
+                    "default": "https://creativecommons.org/licenses/by/1.2/"
+                },
+                "location": {
+                    "$ref": "#/definitions/models.CreateLocation"
+                },
+                "rating": {
+                    "type": "number",
+                    "default": 4.6
+                },
+                "website_url": {
+                    "type": "string",
+                    "default": "https://creativecommons.org/licenses/by/1.3/"
+                }
+            }
+        },
+        "models.CreateImage": {
+            "type": "object",
+            "properties": {
+                "image_url": {
+                    "type": "string",
+                    "default": "www.photo/images/141"
+                }
+            }
+        },
+        "models.CreateItineraryReq": {
+            "type": "object",
+            "properties": {
+                "bookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ItineraryBookingReq"
+                    }
+                }
+            }
+        },
+        "models.CreateLocation": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "default": "87G9+V57, Shaykhontohur Street 28, Tashkent, Toshkent Shahri"
+                },
+                "city": {
+                    "type": "string",
+                    "default": "Tashkent"
+                },
+                "country": {
+                    "type": "string",
+                    "default": "Uzbekistan"
+                },
+                "latitude": {
+                    "type": "number",
+                    "default": 40.7128
+                },
+                "longitude": {
+                    "type": "number",
+                    "default": 74.006
+                },
+                "state_province": {
+                    "type": "string",
+                    "default": "Shaykhontohur"
+                }
+            }
+        },
+        "models.CreateRestaurant": {
+            "type": "object",
+            "properties": {
+                "contact_number": {
+                    "type": "string",
+                    "default": "+(99891)-234-56-78"
+                },
+                "description": {
+                    "type": "string",
+                    "default": "uzbek national cousine"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CreateImage"
+                    }
+                },
+                "licence_url": {
+                    "type": "string",
+                    "default": "https://creativecommons.org/licenses/by/3.2/"
+                },
+                "location": {
+                    "$ref": "#/definitions/models.CreateLocation"
+                },
+                "opening_hours": {
+                    "type": "string",
+                    "default": "06:00-22:00"
+                },
+                "rating": {
+                    "type": "number",
+                    "default": 4.1
+                },
+                "restaurant_name": {
+                    "type": "string",
+                    "default": "Kamolon Osh Markazi"
+                },
+                "seat_capacity": {
+                    "type": "integer",
+                    "default": 50
+                },
+                "slot_minutes": {
+                    "type": "integer",
+                    "default": 120
+                },
+                "website_url": {
+                    "type": "string",
+                    "default": "https://creativecommons.org/licenses/by/3.3/"
+                }
+            }
+        },
+        "models.CreateReview": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "default": "very good!"
+                },
+                "rating": {
+                    "type": "number",
+                    "default": 4.7
+                }
+            }
+        },
+        "models.CreateRoom": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "default": 2
+                },
+                "description": {
+                    "type": "string",
+                    "default": "double room with a city view"
+                },
+                "discount": {
+                    "type": "number",
+                    "default": 10
+                },
+                "holidays": {
+                    "description": "charged with the holiday surcharge",
+                    "type": "string",
+                    "default": "2024-12-31"
+                },
+                "number_of_rooms": {
+                    "type": "integer",
+                    "default": 5
+                },
+                "price": {
+                    "type": "number",
+                    "default": 120
+                }
+            }
+        },
+        "models.CreateTicketType": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "default": "adult"
+                },
+                "price": {
+                    "type": "number",
+                    "default": 15
+                }
+            }
+        },
+        "models.DeleteResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.EntryWindowModel": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.Error": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EstablishmentImageRespons": {
+            "type": "object",
+            "properties": {
+                "image_url": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ExternalBlockModel": {
+            "type": "object",
+            "properties": {
+                "ends_on": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "source_id": {
+                    "type": "string"
+                },
+                "starts_on": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.FavouriteModel": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "establishment_id": {
+                    "type": "string"
+                },
+                "favourite_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.GuestModel": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "phone_number": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.HotelModel": {
+            "type": "object",
+            "properties": {
+                "contact_number": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hotel_id": {
+                    "type": "string"
+                },
+                "hotel_name": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImageModel"
+                    }
+                },
+                "licence_url": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/models.LocationModel"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RoomModel"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "website_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.IdReq": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.IdRes": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IdReq"
+                    }
+                }
+            }
+        },
+        "models.ImageModel": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "establishment_id": {
+                    "type": "string"
+                },
+                "image_id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ItineraryBookingReq": {
+            "type": "object",
+            "properties": {
+                "adult_tickets": {
+                    "type": "integer"
+                },
+                "booking_type": {
+                    "type": "string",
+                    "default": "hotel"
+                },
+                "child_tickets": {
+                    "type": "integer"
+                },
+                "hra_id": {
+                    "type": "string"
+                },
+                "number_of_people": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "will_arrive": {
+                    "type": "string"
+                },
+                "will_leave": {
                     "type": "string"
+                }
+            }
+        },
+        "models.ItineraryModel": {
+            "type": "object",
+            "properties": {
+                "bookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingRes"
+                    }
                 },
-                "location": {
-                    "$ref": "#/definitions/models.LocationModel"
+                "created_at": {
+                    "type": "string"
                 },
-                "owner_id": {
+                "id": {
                     "type": "string"
                 },
-                "rating": {
-                    "type": "number"
+                "status": {
+                    "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
                 },
-                "website_url": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "models.BookingRes": {
+        "models.JoinWaitlistReq": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "deleted_at": {
-                    "type": "string"
+                "booking_type": {
+                    "type": "string",
+                    "default": "hotel"
                 },
                 "hra_id": {
                     "type": "string"
                 },
-                "id": {
+                "number_of_people": {
+                    "type": "integer",
+                    "default": 2
+                },
+                "reason": {
                     "type": "string"
                 },
-                "is_canceled": {
-                    "type": "boolean"
+                "will_arrive": {
+                    "type": "string",
+                    "default": "2024-12-30"
                 },
-                "number_of_people": {
+                "will_leave": {
+                    "type": "string",
+                    "default": "2025-01-02"
+                }
+            }
+        },
+        "models.List": {
+            "type": "object",
+            "properties": {
+                "bookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingRes"
+                    }
+                },
+                "count": {
                     "type": "integer"
+                }
+            }
+        },
+        "models.ListAddOnsModel": {
+            "type": "object",
+            "properties": {
+                "add_ons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AddOnModel"
+                    }
                 },
-                "reason": {
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ListAttractionModel": {
+            "type": "object",
+            "properties": {
+                "attractions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AttractionModel"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ListAvailableHotelsModel": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "hotels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AvailableHotelModel"
+                    }
+                }
+            }
+        },
+        "models.ListClosuresModel": {
+            "type": "object",
+            "properties": {
+                "closures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ClosureModel"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ListFavouritesModel": {
+            "type": "object",
+            "properties": {
+                "favourites": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FavouriteModel"
+                    }
+                }
+            }
+        },
+        "models.ListHotelsModel": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "hotels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.HotelModel"
+                    }
+                }
+            }
+        },
+        "models.ListRestaurantsModel": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "restaurants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RestaurantModel"
+                    }
+                }
+            }
+        },
+        "models.ListReviews": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ReviewModel"
+                    }
+                }
+            }
+        },
+        "models.ListRoomsModel": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RoomModel"
+                    }
+                }
+            }
+        },
+        "models.ListSlotsModel": {
+            "type": "object",
+            "properties": {
+                "seat_capacity": {
+                    "type": "integer"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SlotModel"
+                    }
+                }
+            }
+        },
+        "models.ListTicketTypesModel": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "ticket_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TicketTypeModel"
+                    }
+                }
+            }
+        },
+        "models.ListUsersRes": {
+            "type": "object"
+        },
+        "models.LocationModel": {
+            "type": "object",
+            "properties": {
+                "address": {
                     "type": "string"
                 },
-                "updated_at": {
+                "city": {
                     "type": "string"
                 },
-                "user_id": {
+                "country": {
                     "type": "string"
                 },
-                "will_arrive": {
+                "created_at": {
                     "type": "string"
                 },
-                "will_leave": {
+                "establishment_id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "location_id": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "state_province": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "models.CreateAttraction": {
+        "models.OwnerBookingList": {
             "type": "object",
             "properties": {
-                "attraction_name": {
-                    "type": "string",
-                    "default": "Anhor Park"
-                },
-                "contact_number": {
-                    "type": "string",
-                    "default": "+(99891)-234-56-78"
-                },
-                "description": {
-                    "type": "string",
-                    "default": "available for all ages"
-                },
-                "images": {
+                "bookings": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CreateImage"
+                        "$ref": "#/definitions/models.OwnerBookingModel"
                     }
                 },
-                "licence_url": {
-                    "type": "string",
-                    "default": "https://creativecommons.org/licenses/by/4.0/"
-                },
-                "location": {
-                    "$ref": "#/definitions/models.CreateLocation"
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.OwnerBookingModel": {
+            "type": "object",
+            "properties": {
+                "booking": {
+                    "$ref": "#/definitions/models.BookingRes"
                 },
-                "rating": {
-                    "type": "number",
-                    "default": 4.3
+                "establishment_id": {
+                    "type": "string"
                 },
-                "website_url": {
+                "guest": {
+                    "$ref": "#/definitions/models.GuestModel"
+                }
+            }
+        },
+        "models.PayReq": {
+            "type": "object",
+            "properties": {
+                "card_token": {
                     "type": "string",
-                    "default": "https://creativecommons.org/licenses/by/4.1/"
+                    "default": "tok_visa"
                 }
             }
         },
-        "models.CreateBookingReq": {
+        "models.PaymentAttemptModel": {
             "type": "object",
             "properties": {
-                "hra_id": {
+                "action": {
                     "type": "string"
                 },
-                "is_canceled": {
-                    "type": "boolean"
-                },
-                "number_of_people": {
-                    "type": "integer"
+                "attempt_id": {
+                    "type": "string"
                 },
-                "reason": {
+                "created_at": {
                     "type": "string"
                 },
-                "will_arrive": {
+                "error": {
                     "type": "string"
                 },
-                "will_leave": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "models.CreateHotel": {
+        "models.PaymentModel": {
             "type": "object",
             "properties": {
-                "contact_number": {
-                    "type": "string",
-                    "default": "+(99891)-234-56-78"
-                },
-                "description": {
-                    "type": "string",
-                    "default": "in affordable prices"
-                },
-                "hotel_name": {
-                    "type": "string",
-                    "default": "Silk Road"
+                "amount": {
+                    "type": "number"
                 },
-                "images": {
+                "attempts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CreateImage"
+                        "$ref": "#/definitions/models.PaymentAttemptModel"
                     }
                 },
-                "licence_url": {
-                    "type": "string",
-                    "default": "https://creativecommons.org/licenses/by/1.2/"
+                "booking_id": {
+                    "type": "string"
                 },
-                "location": {
-                    "$ref": "#/definitions/models.CreateLocation"
+                "created_at": {
+                    "type": "string"
                 },
-                "rating": {
-                    "type": "number",
-                    "default": 4.6
+                "currency": {
+                    "type": "string"
                 },
-                "website_url": {
-                    "type": "string",
-                    "default": "https://creativecommons.org/licenses/by/1.3/"
+                "payment_id": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "provider_ref": {
+                    "type": "string"
+                },
+                "refunded_amount": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "models.CreateImage": {
+        "models.PaymentWebhookReq": {
             "type": "object",
             "properties": {
-                "image_url": {
+                "event": {
                     "type": "string",
-                    "default": "www.photo/images/141"
+                    "default": "payment.succeeded"
+                },
+                "provider_ref": {
+                    "type": "string"
                 }
             }
         },
-        "models.CreateLocation": {
+        "models.PriceQuoteModel": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string",
-                    "default": "87G9+V57, Shaykhontohur Street 28, Tashkent, Toshkent Shahri"
+                "currency": {
+                    "type": "string"
                 },
-                "city": {
-                    "type": "string",
-                    "default": "Tashkent"
+                "discount": {
+                    "type": "number"
                 },
-                "country": {
-                    "type": "string",
-                    "default": "Uzbekistan"
+                "holiday_nights": {
+                    "type": "integer"
                 },
-                "latitude": {
-                    "type": "number",
-                    "default": 40.7128
+                "nightly_price": {
+                    "type": "number"
                 },
-                "longitude": {
-                    "type": "number",
-                    "default": 74.006
+                "nights": {
+                    "type": "integer"
                 },
-                "state_province": {
-                    "type": "string",
-                    "default": "Shaykhontohur"
+                "subtotal": {
+                    "type": "number"
+                },
+                "surcharge": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "number"
                 }
             }
         },
-        "models.CreateRestaurant": {
+        "models.QuoteReq": {
             "type": "object",
             "properties": {
-                "contact_number": {
-                    "type": "string",
-                    "default": "+(99891)-234-56-78"
-                },
-                "description": {
-                    "type": "string",
-                    "default": "uzbek national cousine"
-                },
-                "images": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.CreateImage"
-                    }
-                },
-                "licence_url": {
-                    "type": "string",
-                    "default": "https://creativecommons.org/licenses/by/3.2/"
-                },
-                "location": {
-                    "$ref": "#/definitions/models.CreateLocation"
-                },
-                "opening_hours": {
-                    "type": "string",
-                    "default": "06:00-22:00"
+                "hra_id": {
+                    "type": "string"
                 },
-                "rating": {
-                    "type": "number",
-                    "default": 4.1
+                "number_of_people": {
+                    "type": "integer",
+                    "default": 2
                 },
-                "restaurant_name": {
+                "will_arrive": {
                     "type": "string",
-                    "default": "Kamolon Osh Markazi"
+                    "default": "2024-12-30"
                 },
-                "website_url": {
+                "will_leave": {
                     "type": "string",
-                    "default": "https://creativecommons.org/licenses/by/3.3/"
+                    "default": "2025-01-02"
                 }
             }
         },
-        "models.CreateReview": {
+        "models.RegisterReq": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string",
-                    "default": "very good!"
+                "email": {
+                    "type": "string"
                 },
-                "rating": {
-                    "type": "number",
-                    "default": 4.7
-                }
-            }
-        },
-        "models.DeleteResponse": {
-            "type": "object",
-            "properties": {
-                "success": {
-                    "type": "boolean"
+                "full_name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
                 }
             }
         },
-        "models.Error": {
+        "models.RegisterRes": {
             "type": "object",
             "properties": {
-                "message": {
+                "content": {
                     "type": "string"
                 }
             }
         },
-        "models.EstablishmentImageRespons": {
+        "models.RemoveResponse": {
             "type": "object",
             "properties": {
-                "image_url": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.FavouriteModel": {
+        "models.RescheduleReq": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "adult_tickets": {
+                    "type": "integer"
                 },
-                "establishment_id": {
+                "booking_type": {
                     "type": "string"
                 },
-                "favourite_id": {
+                "child_tickets": {
+                    "type": "integer"
+                },
+                "number_of_people": {
+                    "type": "integer"
+                },
+                "reason": {
                     "type": "string"
                 },
-                "updated_at": {
+                "will_arrive": {
                     "type": "string"
                 },
-                "user_id": {
+                "will_leave": {
                     "type": "string"
                 }
             }
         },
-        "models.HotelModel": {
+        "models.RestaurantModel": {
             "type": "object",
             "properties": {
                 "contact_number": {
@@ -4013,12 +12425,6 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
-                "hotel_id": {
-                    "type": "string"
-                },
-                "hotel_name": {
-                    "type": "string"
-                },
                 "images": {
                     "type": "array",
                     "items": {
@@ -4031,12 +12437,27 @@ const docTemplate = `{
                 "location": {
                     "$ref": "#/definitions/models.LocationModel"
                 },
+                "opening_hours": {
+                    "type": "string"
+                },
                 "owner_id": {
                     "type": "string"
                 },
                 "rating": {
                     "type": "number"
                 },
+                "restaurant_id": {
+                    "type": "string"
+                },
+                "restaurant_name": {
+                    "type": "string"
+                },
+                "seat_capacity": {
+                    "type": "integer"
+                },
+                "slot_minutes": {
+                    "type": "integer"
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -4045,269 +12466,232 @@ const docTemplate = `{
                 }
             }
         },
-        "models.IdReq": {
+        "models.ReviewModel": {
             "type": "object",
             "properties": {
-                "id": {
+                "comment": {
                     "type": "string"
-                }
-            }
-        },
-        "models.IdRes": {
-            "type": "object",
-            "properties": {
-                "ids": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.IdReq"
-                    }
-                }
-            }
-        },
-        "models.ImageModel": {
-            "type": "object",
-            "properties": {
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "establishment_id": {
                     "type": "string"
                 },
-                "image_id": {
-                    "type": "string"
+                "rating": {
+                    "type": "number"
                 },
-                "image_url": {
+                "review_id": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "models.List": {
-            "type": "object",
-            "properties": {
-                "bookings": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.BookingRes"
-                    }
-                }
-            }
-        },
-        "models.ListAttractionModel": {
+        "models.RoomModel": {
             "type": "object",
             "properties": {
-                "attractions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.AttractionModel"
-                    }
+                "capacity": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
                 },
-                "count": {
+                "description": {
+                    "type": "string"
+                },
+                "discount": {
+                    "type": "number"
+                },
+                "holidays": {
+                    "type": "string"
+                },
+                "hotel_id": {
+                    "type": "string"
+                },
+                "number_of_rooms": {
                     "type": "integer"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "models.ListFavouritesModel": {
+        "models.SetBookingMode": {
             "type": "object",
+            "required": [
+                "mode"
+            ],
             "properties": {
-                "favourites": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.FavouriteModel"
-                    }
+                "mode": {
+                    "type": "string",
+                    "default": "request"
                 }
             }
         },
-        "models.ListHotelsModel": {
+        "models.SetCancellationPolicy": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "hotels": {
+                "rules": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.HotelModel"
+                        "$ref": "#/definitions/models.CancellationRuleModel"
                     }
                 }
             }
         },
-        "models.ListRestaurantsModel": {
+        "models.SlotModel": {
             "type": "object",
             "properties": {
-                "count": {
+                "end": {
+                    "type": "string"
+                },
+                "free_seats": {
                     "type": "integer"
                 },
-                "restaurants": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.RestaurantModel"
-                    }
+                "start": {
+                    "type": "string"
                 }
             }
         },
-        "models.ListReviews": {
+        "models.StandartError": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "reviews": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ReviewModel"
-                    }
+                "error": {
+                    "$ref": "#/definitions/models.Error"
                 }
             }
         },
-        "models.ListUsersRes": {
-            "type": "object"
-        },
-        "models.LocationModel": {
+        "models.StatusChangeModel": {
             "type": "object",
             "properties": {
-                "address": {
+                "booking_id": {
                     "type": "string"
                 },
-                "city": {
+                "booking_type": {
                     "type": "string"
                 },
-                "country": {
+                "change_fee": {
+                    "type": "number"
+                },
+                "changed_by": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "establishment_id": {
+                "currency": {
                     "type": "string"
                 },
-                "latitude": {
+                "from_status": {
+                    "type": "string"
+                },
+                "from_will_arrive": {
+                    "type": "string"
+                },
+                "from_will_leave": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "price_difference": {
                     "type": "number"
                 },
-                "location_id": {
+                "reason": {
                     "type": "string"
                 },
-                "longitude": {
+                "refund_amount": {
                     "type": "number"
                 },
-                "state_province": {
+                "refund_percent": {
+                    "type": "number"
+                },
+                "to_status": {
                     "type": "string"
                 },
-                "updated_at": {
+                "to_will_arrive": {
+                    "type": "string"
+                },
+                "to_will_leave": {
                     "type": "string"
                 }
             }
         },
-        "models.RegisterReq": {
+        "models.StatusHistoryModel": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "full_name": {
-                    "type": "string"
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatusChangeModel"
+                    }
                 },
-                "password": {
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "models.RegisterRes": {
+        "models.StatusReq": {
             "type": "object",
             "properties": {
-                "content": {
+                "reason": {
                     "type": "string"
                 }
             }
         },
-        "models.RemoveResponse": {
+        "models.TicketScanReq": {
             "type": "object",
+            "required": [
+                "payload"
+            ],
             "properties": {
-                "success": {
-                    "type": "boolean"
+                "payload": {
+                    "type": "string"
                 }
             }
         },
-        "models.RestaurantModel": {
+        "models.TicketScanRes": {
             "type": "object",
             "properties": {
-                "contact_number": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "images": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.ImageModel"
-                    }
-                },
-                "licence_url": {
-                    "type": "string"
-                },
-                "location": {
-                    "$ref": "#/definitions/models.LocationModel"
-                },
-                "opening_hours": {
-                    "type": "string"
-                },
-                "owner_id": {
-                    "type": "string"
+                "booking": {
+                    "$ref": "#/definitions/models.BookingRes"
                 },
-                "rating": {
-                    "type": "number"
-                },
-                "restaurant_id": {
-                    "type": "string"
-                },
-                "restaurant_name": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                },
-                "website_url": {
-                    "type": "string"
+                "change": {
+                    "$ref": "#/definitions/models.StatusChangeModel"
                 }
             }
         },
-        "models.ReviewModel": {
+        "models.TicketTypeModel": {
             "type": "object",
             "properties": {
-                "comment": {
+                "attraction_id": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "establishment_id": {
+                "name": {
                     "type": "string"
                 },
-                "rating": {
+                "price": {
                     "type": "number"
                 },
-                "review_id": {
+                "ticket_type_id": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
-                },
-                "user_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "models.StandartError": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "$ref": "#/definitions/models.Error"
                 }
             }
         },
@@ -4328,6 +12712,23 @@ const docTemplate = `{
                 }
             }
         },
+        "models.UpdateAddOn": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "default": "buffet breakfast from 7 to 11"
+                },
+                "name": {
+                    "type": "string",
+                    "default": "Breakfast"
+                },
+                "price": {
+                    "type": "number",
+                    "default": 14
+                }
+            }
+        },
         "models.UpdateAttraction": {
             "type": "object",
             "properties": {
@@ -4339,10 +12740,18 @@ const docTemplate = `{
                     "type": "string",
                     "default": "updated contact number"
                 },
+                "daily_quota": {
+                    "type": "integer",
+                    "default": 400
+                },
                 "description": {
                     "type": "string",
                     "default": "updated description"
                 },
+                "entry_window_minutes": {
+                    "type": "integer",
+                    "default": 30
+                },
                 "licence_url": {
                     "type": "string",
                     "default": "updated licence url"
@@ -4350,6 +12759,10 @@ const docTemplate = `{
                 "location": {
                     "$ref": "#/definitions/models.UpdateLocation"
                 },
+                "opening_hours": {
+                    "type": "string",
+                    "default": "10:00-20:00"
+                },
                 "rating": {
                     "type": "number",
                     "default": 5
@@ -4357,32 +12770,73 @@ const docTemplate = `{
                 "website_url": {
                     "type": "string",
                     "default": "updated website url"
+                },
+                "window_quota": {
+                    "type": "integer",
+                    "default": 40
                 }
             }
         },
         "models.UpdateBookingReq": {
             "type": "object",
             "properties": {
-                "hra_id": {
-                    "type": "string"
+                "add_ons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingAddOnReq"
+                    }
                 },
-                "id": {
+                "booking_type": {
                     "type": "string"
                 },
-                "is_canceled": {
-                    "type": "boolean"
+                "guests": {
+                    "description": "the guests, special requests and add-ons sent replace the stored ones,\nwhatever is left out or null is kept",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.BookingGuest"
+                    }
                 },
-                "number_of_people": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
                 "reason": {
                     "type": "string"
                 },
-                "will_arrive": {
+                "special_request_note": {
                     "type": "string"
                 },
-                "will_leave": {
-                    "type": "string"
+                "special_requests": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "late_arrival",
+                        "crib"
+                    ]
+                }
+            }
+        },
+        "models.UpdateClosure": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "default": "2030-02-20"
+                },
+                "reason": {
+                    "type": "string",
+                    "default": "renovation of the lobby"
+                },
+                "start_date": {
+                    "type": "string",
+                    "default": "2030-02-01"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -4477,12 +12931,29 @@ const docTemplate = `{
                     "type": "string",
                     "default": "updated restaurant name"
                 },
+                "seat_capacity": {
+                    "type": "integer",
+                    "default": 60
+                },
+                "slot_minutes": {
+                    "type": "integer",
+                    "default": 90
+                },
                 "website_url": {
                     "type": "string",
                     "default": "updated website url"
                 }
             }
         },
+        "models.UpdateTicketType": {
+            "type": "object",
+            "properties": {
+                "price": {
+                    "type": "number",
+                    "default": 12.5
+                }
+            }
+        },
         "models.UserReq": {
             "type": "object",
             "properties": {
@@ -4590,6 +13061,47 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "models.WaitlistEntryModel": {
+            "type": "object",
+            "properties": {
+                "booking_id": {
+                    "type": "string"
+                },
+                "booking_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "hra_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "number_of_people": {
+                    "type": "integer"
+                },
+                "offer_expires_at": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "will_arrive": {
+                    "type": "string"
+                },
+                "will_leave": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -0,0 +1,284 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CREATE ADD-ON
+// @Summary CREATE ADD-ON
+// @Security BearerAuth
+// @Description Api for the owner of the establishment to add an extra such as breakfast, parking or a transfer to the add-ons the establishment sells with its bookings
+// @Tags ADD_ON
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Param AddOn body models.CreateAddOn true "AddOn"
+// @Success 201 {object} models.AddOnModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/add-ons [POST]
+// @Router /v1/restaurant/{id}/add-ons [POST]
+// @Router /v1/attraction/{id}/add-ons [POST]
+func (h HandlerV1) CreateAddOn(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "CreateAddOn")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.CreateAddOn
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	establishment_id := c.Param("id")
+
+	if err := h.establishmentExists(ctx, c.FullPath(), establishment_id); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "establishment not found",
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, establishment_id) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().CreateAddOn(ctx, &pbe.AddOn{
+		AddOnId:         uuid.New().String(),
+		EstablishmentId: establishment_id,
+		Name:            body.Name,
+		Description:     body.Description,
+		Price:           body.Price,
+	})
+	if err != nil {
+		h.addOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, addOnModel(response))
+}
+
+// LIST ADD-ONS OF ESTABLISHMENT
+// @Summary LIST ADD-ONS OF ESTABLISHMENT
+// @Security BearerAuth
+// @Description Api for listing the add-ons guests can book with the establishment
+// @Tags ADD_ON
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Success 200 {object} models.ListAddOnsModel
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/add-ons [GET]
+// @Router /v1/restaurant/{id}/add-ons [GET]
+// @Router /v1/attraction/{id}/add-ons [GET]
+func (h HandlerV1) ListAddOns(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListAddOns")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	response, err := h.Service.EstablishmentService().ListAddOns(ctx, &pbe.ListAddOnsRequest{
+		EstablishmentId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	addOns := []*models.AddOnModel{}
+	for _, addOn := range response.AddOns {
+		addOns = append(addOns, addOnModel(addOn))
+	}
+
+	c.JSON(http.StatusOK, models.ListAddOnsModel{
+		AddOns: addOns,
+		Count:  response.Count,
+	})
+}
+
+// UPDATE ADD-ON
+// @Summary UPDATE ADD-ON
+// @Security BearerAuth
+// @Description Api for the owner of the establishment to change one of its add-ons, bookings made before keep the price they were made at
+// @Tags ADD_ON
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Param add_on_id path string true "add_on_id"
+// @Param AddOn body models.UpdateAddOn true "AddOn"
+// @Success 200 {object} models.AddOnModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/add-ons/{add_on_id} [PUT]
+// @Router /v1/restaurant/{id}/add-ons/{add_on_id} [PUT]
+// @Router /v1/attraction/{id}/add-ons/{add_on_id} [PUT]
+func (h HandlerV1) UpdateAddOn(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "UpdateAddOn")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.UpdateAddOn
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !h.addOnBelongsToEstablishment(c, c.Param("add_on_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().UpdateAddOn(ctx, &pbe.UpdateAddOnRequest{
+		AddOn: &pbe.AddOn{
+			AddOnId:         c.Param("add_on_id"),
+			EstablishmentId: c.Param("id"),
+			Name:            body.Name,
+			Description:     body.Description,
+			Price:           body.Price,
+		},
+	})
+	if err != nil {
+		h.addOnError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, addOnModel(response.AddOn))
+}
+
+// DELETE ADD-ON
+// @Summary DELETE ADD-ON
+// @Security BearerAuth
+// @Description Api for the owner of the establishment to stop selling one of its add-ons, bookings made with it keep it
+// @Tags ADD_ON
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Param add_on_id path string true "add_on_id"
+// @Success 200 {object} models.DeleteResponse
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/add-ons/{add_on_id} [DELETE]
+// @Router /v1/restaurant/{id}/add-ons/{add_on_id} [DELETE]
+// @Router /v1/attraction/{id}/add-ons/{add_on_id} [DELETE]
+func (h HandlerV1) DeleteAddOn(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "DeleteAddOn")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	if !h.addOnBelongsToEstablishment(c, c.Param("add_on_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().DeleteAddOn(ctx, &pbe.DeleteAddOnRequest{
+		AddOnId: c.Param("add_on_id"),
+	})
+	if err != nil {
+		h.addOnError(c, err)
+		return
+	}
+
+	if !response.Success {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "not deleted",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "successfuly deleted",
+	})
+}
+
+// addOnBelongsToEstablishment writes a 404 response and returns false when
+// the establishment does not sell the add-on
+func (h HandlerV1) addOnBelongsToEstablishment(c *gin.Context, add_on_id, establishment_id string) bool {
+	response, err := h.Service.EstablishmentService().ListAddOns(c.Request.Context(), &pbe.ListAddOnsRequest{
+		EstablishmentId: establishment_id,
+	})
+	if err == nil {
+		for _, addOn := range response.AddOns {
+			if addOn.AddOnId == add_on_id {
+				return true
+			}
+		}
+	}
+
+	c.JSON(http.StatusNotFound, gin.H{
+		"error": "add-on not found",
+	})
+	return false
+}
+
+func (h HandlerV1) addOnError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "name must not be empty and price must not be negative",
+		})
+	case codes.AlreadyExists:
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "establishment already sells an add-on with this name",
+		})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "add-on not found",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+	}
+}
+
+func addOnModel(addOn *pbe.AddOn) *models.AddOnModel {
+	return &models.AddOnModel{
+		AddOnId:         addOn.AddOnId,
+		EstablishmentId: addOn.EstablishmentId,
+		Name:            addOn.Name,
+		Description:     addOn.Description,
+		Price:           addOn.Price,
+		CreatedAt:       addOn.CreatedAt,
+		UpdatedAt:       addOn.UpdatedAt,
+	}
+}
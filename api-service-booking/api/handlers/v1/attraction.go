@@ -2,6 +2,7 @@ package v1
 
 import (
 	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
 	pbe "Booking/api-service-booking/genproto/establishment-proto"
 	"Booking/api-service-booking/internal/pkg/otlp"
 	"net/http"
@@ -10,6 +11,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -33,7 +36,6 @@ func (h HandlerV1) CreateAttraction(c *gin.Context) {
 
 	jspbMarshal.UseProtoNames = true
 
-
 	ctx, span := otlp.Start(c, "api", "CreateAttraction")
 	span.SetAttributes(
 		attribute.Key("method").String(c.Request.Method),
@@ -50,10 +52,10 @@ func (h HandlerV1) CreateAttraction(c *gin.Context) {
 	owner_id, statusCode := GetIdFromToken(c.Request, h.Config)
 	if statusCode != http.StatusOK {
 		c.JSON(statusCode, gin.H{
-            "error": "Can't get",
-        })
-        return
-    }
+			"error": "Can't get",
+		})
+		return
+	}
 
 	attraction_id := uuid.New().String()
 	location_id := uuid.New().String()
@@ -75,15 +77,19 @@ func (h HandlerV1) CreateAttraction(c *gin.Context) {
 
 	// Format("2006-01-02T15:04:05Z")
 	response, err := h.Service.EstablishmentService().CreateAttraction(ctx, &pbe.Attraction{
-		AttractionId:   attraction_id,
-		OwnerId:        owner_id,
-		AttractionName: body.AttractionName,
-		Description:    body.Description,
-		Rating:         float32(body.Rating),
-		ContactNumber:  body.ContactNumber,
-		LicenceUrl:     body.LicenceUrl,
-		WebsiteUrl:     body.WebsiteUrl,
-		Images:         images,
+		AttractionId:       attraction_id,
+		OwnerId:            owner_id,
+		AttractionName:     body.AttractionName,
+		Description:        body.Description,
+		Rating:             float32(body.Rating),
+		OpeningHours:       body.OpeningHours,
+		EntryWindowMinutes: body.EntryWindowMinutes,
+		DailyQuota:         body.DailyQuota,
+		WindowQuota:        body.WindowQuota,
+		ContactNumber:      body.ContactNumber,
+		LicenceUrl:         body.LicenceUrl,
+		WebsiteUrl:         body.WebsiteUrl,
+		Images:             images,
 		Location: &pbe.Location{
 			LocationId:      location_id,
 			EstablishmentId: attraction_id,
@@ -117,15 +123,19 @@ func (h HandlerV1) CreateAttraction(c *gin.Context) {
 	}
 
 	respModel := models.AttractionModel{
-		AttractionId:   response.AttractionId,
-		OwnerId:        response.OwnerId,
-		AttractionName: response.AttractionName,
-		Description:    response.Description,
-		Rating:         response.Rating,
-		ContactNumber:  response.ContactNumber,
-		LicenceUrl:     response.LicenceUrl,
-		WebsiteUrl:     response.WebsiteUrl,
-		Images:         respImages,
+		AttractionId:       response.AttractionId,
+		OwnerId:            response.OwnerId,
+		AttractionName:     response.AttractionName,
+		Description:        response.Description,
+		Rating:             response.Rating,
+		OpeningHours:       response.OpeningHours,
+		EntryWindowMinutes: response.EntryWindowMinutes,
+		DailyQuota:         response.DailyQuota,
+		WindowQuota:        response.WindowQuota,
+		ContactNumber:      response.ContactNumber,
+		LicenceUrl:         response.LicenceUrl,
+		WebsiteUrl:         response.WebsiteUrl,
+		Images:             respImages,
 		Location: models.LocationModel{
 			LocationId:      response.Location.LocationId,
 			EstablishmentId: response.Location.EstablishmentId,
@@ -142,6 +152,8 @@ func (h HandlerV1) CreateAttraction(c *gin.Context) {
 		UpdatedAt: response.UpdatedAt,
 	}
 
+	h.linkOwner(ctx, owner_id, response.AttractionId)
+
 	c.JSON(http.StatusCreated, respModel)
 }
 
@@ -196,16 +208,26 @@ func (h HandlerV1) GetAttraction(c *gin.Context) {
 		respImages = append(respImages, &image)
 	}
 
+	var respTicketTypes []*models.TicketTypeModel
+
+	for _, respTicketType := range response.Attraction.TicketTypes {
+		respTicketTypes = append(respTicketTypes, ticketTypeModel(respTicketType))
+	}
+
 	respModel := models.AttractionModel{
-		AttractionId:   response.Attraction.AttractionId,
-		OwnerId:        response.Attraction.OwnerId,
-		AttractionName: response.Attraction.AttractionName,
-		Description:    response.Attraction.Description,
-		Rating:         response.Attraction.Rating,
-		ContactNumber:  response.Attraction.ContactNumber,
-		LicenceUrl:     response.Attraction.LicenceUrl,
-		WebsiteUrl:     response.Attraction.WebsiteUrl,
-		Images:         respImages,
+		AttractionId:       response.Attraction.AttractionId,
+		OwnerId:            response.Attraction.OwnerId,
+		AttractionName:     response.Attraction.AttractionName,
+		Description:        response.Attraction.Description,
+		Rating:             response.Attraction.Rating,
+		OpeningHours:       response.Attraction.OpeningHours,
+		EntryWindowMinutes: response.Attraction.EntryWindowMinutes,
+		DailyQuota:         response.Attraction.DailyQuota,
+		WindowQuota:        response.Attraction.WindowQuota,
+		ContactNumber:      response.Attraction.ContactNumber,
+		LicenceUrl:         response.Attraction.LicenceUrl,
+		WebsiteUrl:         response.Attraction.WebsiteUrl,
+		Images:             respImages,
 		Location: models.LocationModel{
 			LocationId:      response.Attraction.Location.LocationId,
 			EstablishmentId: response.Attraction.Location.EstablishmentId,
@@ -218,8 +240,9 @@ func (h HandlerV1) GetAttraction(c *gin.Context) {
 			CreatedAt:       response.Attraction.Location.CreatedAt,
 			UpdatedAt:       response.Attraction.Location.UpdatedAt,
 		},
-		CreatedAt: response.Attraction.CreatedAt,
-		UpdatedAt: response.Attraction.UpdatedAt,
+		TicketTypes: respTicketTypes,
+		CreatedAt:   response.Attraction.CreatedAt,
+		UpdatedAt:   response.Attraction.UpdatedAt,
 	}
 
 	c.JSON(200, respModel)
@@ -303,15 +326,19 @@ func (h HandlerV1) ListAttractions(c *gin.Context) {
 		}
 
 		attraction := models.AttractionModel{
-			AttractionId:   respAttraction.AttractionId,
-			OwnerId:        respAttraction.OwnerId,
-			AttractionName: respAttraction.AttractionName,
-			Description:    respAttraction.Description,
-			Rating:         respAttraction.Rating,
-			ContactNumber:  respAttraction.ContactNumber,
-			LicenceUrl:     respAttraction.LicenceUrl,
-			WebsiteUrl:     respAttraction.WebsiteUrl,
-			Images:         respImages,
+			AttractionId:       respAttraction.AttractionId,
+			OwnerId:            respAttraction.OwnerId,
+			AttractionName:     respAttraction.AttractionName,
+			Description:        respAttraction.Description,
+			Rating:             respAttraction.Rating,
+			OpeningHours:       respAttraction.OpeningHours,
+			EntryWindowMinutes: respAttraction.EntryWindowMinutes,
+			DailyQuota:         respAttraction.DailyQuota,
+			WindowQuota:        respAttraction.WindowQuota,
+			ContactNumber:      respAttraction.ContactNumber,
+			LicenceUrl:         respAttraction.LicenceUrl,
+			WebsiteUrl:         respAttraction.WebsiteUrl,
+			Images:             respImages,
 			Location: models.LocationModel{
 				LocationId:      respAttraction.Location.LocationId,
 				EstablishmentId: respAttraction.Location.EstablishmentId,
@@ -377,13 +404,17 @@ func (h HandlerV1) UpdateAttraction(c *gin.Context) {
 
 	response, err := h.Service.EstablishmentService().UpdateAttraction(ctx, &pbe.UpdateAttractionRequest{
 		Attraction: &pbe.Attraction{
-			AttractionId:   attraction_id,
-			AttractionName: body.AttractionName,
-			Description:    body.Description,
-			Rating:         float32(body.Rating),
-			ContactNumber:  body.ContactNumber,
-			LicenceUrl:     body.LicenceUrl,
-			WebsiteUrl:     body.WebsiteUrl,
+			AttractionId:       attraction_id,
+			AttractionName:     body.AttractionName,
+			Description:        body.Description,
+			Rating:             float32(body.Rating),
+			OpeningHours:       body.OpeningHours,
+			EntryWindowMinutes: body.EntryWindowMinutes,
+			DailyQuota:         body.DailyQuota,
+			WindowQuota:        body.WindowQuota,
+			ContactNumber:      body.ContactNumber,
+			LicenceUrl:         body.LicenceUrl,
+			WebsiteUrl:         body.WebsiteUrl,
 			Location: &pbe.Location{
 				Address:       body.Location.Address,
 				Latitude:      float32(body.Location.Latitude),
@@ -416,15 +447,19 @@ func (h HandlerV1) UpdateAttraction(c *gin.Context) {
 	}
 
 	respModel := models.AttractionModel{
-		AttractionId:   response.Attraction.AttractionId,
-		OwnerId:        response.Attraction.OwnerId,
-		AttractionName: response.Attraction.AttractionName,
-		Description:    response.Attraction.Description,
-		Rating:         response.Attraction.Rating,
-		ContactNumber:  response.Attraction.ContactNumber,
-		LicenceUrl:     response.Attraction.LicenceUrl,
-		WebsiteUrl:     response.Attraction.WebsiteUrl,
-		Images:         respImages,
+		AttractionId:       response.Attraction.AttractionId,
+		OwnerId:            response.Attraction.OwnerId,
+		AttractionName:     response.Attraction.AttractionName,
+		Description:        response.Attraction.Description,
+		Rating:             response.Attraction.Rating,
+		OpeningHours:       response.Attraction.OpeningHours,
+		EntryWindowMinutes: response.Attraction.EntryWindowMinutes,
+		DailyQuota:         response.Attraction.DailyQuota,
+		WindowQuota:        response.Attraction.WindowQuota,
+		ContactNumber:      response.Attraction.ContactNumber,
+		LicenceUrl:         response.Attraction.LicenceUrl,
+		WebsiteUrl:         response.Attraction.WebsiteUrl,
+		Images:             respImages,
 		Location: models.LocationModel{
 			LocationId:      response.Attraction.Location.LocationId,
 			EstablishmentId: response.Attraction.Location.EstablishmentId,
@@ -488,6 +523,7 @@ func (h HandlerV1) DeleteAttraction(c *gin.Context) {
 		h.Logger.Error("not deleted")
 		return
 	}
+	h.unlinkOwner(ctx, attraction_id)
 
 	c.JSON(200, gin.H{
 		"message": "successfuly deleted",
@@ -579,15 +615,19 @@ func (h HandlerV1) ListAttractionsByLocation(c *gin.Context) {
 		}
 
 		attraction := models.AttractionModel{
-			AttractionId:   respAttraction.AttractionId,
-			OwnerId:        respAttraction.OwnerId,
-			AttractionName: respAttraction.AttractionName,
-			Description:    respAttraction.Description,
-			Rating:         respAttraction.Rating,
-			ContactNumber:  respAttraction.ContactNumber,
-			LicenceUrl:     respAttraction.LicenceUrl,
-			WebsiteUrl:     respAttraction.WebsiteUrl,
-			Images:         respImages,
+			AttractionId:       respAttraction.AttractionId,
+			OwnerId:            respAttraction.OwnerId,
+			AttractionName:     respAttraction.AttractionName,
+			Description:        respAttraction.Description,
+			Rating:             respAttraction.Rating,
+			OpeningHours:       respAttraction.OpeningHours,
+			EntryWindowMinutes: respAttraction.EntryWindowMinutes,
+			DailyQuota:         respAttraction.DailyQuota,
+			WindowQuota:        respAttraction.WindowQuota,
+			ContactNumber:      respAttraction.ContactNumber,
+			LicenceUrl:         respAttraction.LicenceUrl,
+			WebsiteUrl:         respAttraction.WebsiteUrl,
+			Images:             respImages,
 			Location: models.LocationModel{
 				LocationId:      respAttraction.Location.LocationId,
 				EstablishmentId: respAttraction.Location.EstablishmentId,
@@ -609,7 +649,7 @@ func (h HandlerV1) ListAttractionsByLocation(c *gin.Context) {
 
 	respModel := models.ListAttractionModel{
 		Attractions: respAttractions,
-		Count:     uint64(response.Count),
+		Count:       uint64(response.Count),
 	}
 
 	c.JSON(200, respModel)
@@ -671,15 +711,19 @@ func (h HandlerV1) FindAttractionsByName(c *gin.Context) {
 		}
 
 		attraction := models.AttractionModel{
-			AttractionId:   respAttraction.AttractionId,
-			OwnerId:        respAttraction.OwnerId,
-			AttractionName: respAttraction.AttractionName,
-			Description:    respAttraction.Description,
-			Rating:         respAttraction.Rating,
-			ContactNumber:  respAttraction.ContactNumber,
-			LicenceUrl:     respAttraction.LicenceUrl,
-			WebsiteUrl:     respAttraction.WebsiteUrl,
-			Images:         respImages,
+			AttractionId:       respAttraction.AttractionId,
+			OwnerId:            respAttraction.OwnerId,
+			AttractionName:     respAttraction.AttractionName,
+			Description:        respAttraction.Description,
+			Rating:             respAttraction.Rating,
+			OpeningHours:       respAttraction.OpeningHours,
+			EntryWindowMinutes: respAttraction.EntryWindowMinutes,
+			DailyQuota:         respAttraction.DailyQuota,
+			WindowQuota:        respAttraction.WindowQuota,
+			ContactNumber:      respAttraction.ContactNumber,
+			LicenceUrl:         respAttraction.LicenceUrl,
+			WebsiteUrl:         respAttraction.WebsiteUrl,
+			Images:             respImages,
 			Location: models.LocationModel{
 				LocationId:      respAttraction.Location.LocationId,
 				EstablishmentId: respAttraction.Location.EstablishmentId,
@@ -705,4 +749,65 @@ func (h HandlerV1) FindAttractionsByName(c *gin.Context) {
 	}
 
 	c.JSON(200, listModel)
-}
\ No newline at end of file
+}
+
+// GET REMAINING TICKET QUOTA OF AN ATTRACTION FOR A DATE
+// @Summary GET REMAINING TICKET QUOTA OF AN ATTRACTION FOR A DATE
+// @Security BearerAuth
+// @Description Api for getting the tickets still available for a day and for each entry window of an attraction
+// @Tags ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id path string true "attraction_id"
+// @Param date query string true "date (YYYY-MM-DD)"
+// @Success 200 {object} models.AttractionQuotaModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/attraction/{id}/quota [GET]
+func (h HandlerV1) AttractionQuota(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "AttractionQuota")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	response, err := h.Service.BookingService().UABRemainingQuota(ctx, &pbb.QuotaReq{
+		AttractionId: c.Param("id"),
+		Date:         c.Query("date"),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "date must be in YYYY-MM-DD format",
+			})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Attraction not found",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			h.Logger.Error(err.Error())
+		}
+		return
+	}
+
+	windows := []*models.EntryWindowModel{}
+	for _, window := range response.Windows {
+		windows = append(windows, &models.EntryWindowModel{
+			Start:     window.Start,
+			End:       window.End,
+			Remaining: window.Remaining,
+		})
+	}
+
+	c.JSON(http.StatusOK, models.AttractionQuotaModel{
+		DailyQuota:     response.DailyQuota,
+		DailyRemaining: response.DailyRemaining,
+		WindowQuota:    response.WindowQuota,
+		Windows:        windows,
+	})
+}
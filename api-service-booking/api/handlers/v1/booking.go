@@ -3,10 +3,10 @@ package v1
 import (
 	models "Booking/api-service-booking/api/models"
 	pbb "Booking/api-service-booking/genproto/booking-proto"
-	pbe "Booking/api-service-booking/genproto/establishment-proto"
 	pbu "Booking/api-service-booking/genproto/user-proto"
 	l "Booking/api-service-booking/internal/pkg/logger"
 	"Booking/api-service-booking/internal/pkg/otlp"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -15,192 +15,403 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
-	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// Create Hotel Booking
-// @Summary Create Hotel Booking
+type listRPC func(ctx context.Context, in *pbb.ListReq, opts ...grpc.CallOption) (*pbb.ListBookingRes, error)
+
+// bookingMessages are the errors shown for bookings of each type
+var bookingMessages = map[string]struct {
+	overbooked string
+	invalid    string
+	notFound   string
+}{
+	bookingHotel: {
+		overbooked: "Room is fully booked for these dates",
+		invalid:    "Invalid stay dates",
+		notFound:   "Room not found",
+	},
+	bookingRestaurant: {
+		overbooked: "Not enough free seats at this time",
+		invalid:    "Reservation is outside opening hours or party is too large",
+		notFound:   "Restaurant not found",
+	},
+	bookingAttraction: {
+		overbooked: "Not enough tickets left for this entry window",
+		invalid:    "Entry time or tickets are not valid for this attraction",
+		notFound:   "Attraction not found",
+	},
+}
+
+// Create Booking
+// @Summary Create Booking
 // @Security BearerAuth
-// @Description Api for Create Hotel Booking
-// @Tags BOOKING_HOTEL
+// @Description Api for booking a hotel room, a restaurant table or attraction tickets, booking_type picks which one (will_arrive as YYYY-MM-DD for hotels and YYYY-MM-DD HH:MM otherwise). With waitlist set a full room or restaurant slot puts the guest on the waitlist and answers 202
+// @Tags BOOKING
 // @Accept json
 // @Produce json
 // @Param CreateBookingReq body models.CreateBookingReq true "createModel"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 201 {object} models.BookingRes
+// @Success 202 {object} models.WaitlistEntryModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings [post]
+func (h *HandlerV1) CreateBooking(c *gin.Context) {
+	h.createBooking(c, "CreateBooking", "")
+}
+
+// Get Booking
+// @Summary Get Booking
+// @Security BearerAuth
+// @Description Api for getting a booking of any type, users see only their own bookings
+// @Tags BOOKING
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param booking_type query string false "hotel, restaurant or attraction"
 // @Success 200 {object} models.BookingRes
 // @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
 // @Failure 500 {object} models.StandartError
-// @Router /v1/booking/hotels [post]
-func (h *HandlerV1) UHBCreate(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UHBCreate")
+// @Router /v1/bookings/{id} [get]
+func (h *HandlerV1) GetBooking(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "GetBooking")
 	span.SetAttributes(
 		attribute.Key("method").String(c.Request.Method),
 	)
 	defer span.End()
 
-	var (
-		body        models.CreateBookingReq
-		jsonMarshal protojson.MarshalOptions
+	booking, ok := h.ownBooking(ctx, c, c.Query("booking_type"), c.Param("id"))
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, bookingModel(booking))
+}
+
+// Get Booking By Code
+// @Summary Get Booking By Code
+// @Security BearerAuth
+// @Description Api for establishment owners to find a booking of their establishments by the confirmation code the guest shows, the code may be typed in lower case or with dashes
+// @Tags BOOKING
+// @Accept json
+// @Produce json
+// @Param code path string true "confirmation_code"
+// @Success 200 {object} models.BookingRes
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings/code/{code} [get]
+func (h *HandlerV1) GetBookingByCode(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "GetBookingByCode")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
 	)
-	jsonMarshal.UseProtoNames = true
+	defer span.End()
 
-	err := c.ShouldBindJSON(&body)
+	booking, err := h.Service.BookingService().BookingGetByCode(ctx, &pbb.ConfirmationCodeReq{
+		Code: c.Param("code"),
+	})
 	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Booking not found",
+			})
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": status.Convert(err).Message(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Try Again Later...",
+			})
+		}
+		h.Logger.Error("failed to get booking by confirmation code", l.Error(err))
+		return
+	}
+
+	// a booking of an establishment the caller does not own is not found,
+	// so codes can not be probed
+	ownerID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+	if !h.bookingOfOwner(ctx, c, ownerID, booking) {
+		return
+	}
+
+	c.JSON(http.StatusOK, bookingModel(booking))
+}
+
+// List User Bookings
+// @Summary List User Bookings
+// @Security BearerAuth
+// @Description Api for listing bookings of the user, of one type or of all of them. Admins may list bookings of any user by user_id
+// @Tags BOOKING
+// @Accept json
+// @Produce json
+// @Param booking_type query string false "hotel, restaurant or attraction"
+// @Param user_id query string false "user_id"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.List
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings [get]
+func (h *HandlerV1) ListUserBookings(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListUserBookings")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.Pagination
+	if err := c.ShouldBindQuery(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form in booking hotel",
+			"error": "Not true form of request",
 		})
-		l.Error(err)
+		h.Logger.Error("failed to bind query", l.Error(err))
 		return
 	}
 
-	userID, statusCode := GetIdFromToken(c.Request, h.Config)
-	if statusCode == 401 {
-		c.JSON(http.StatusUnauthorized, models.Error{
-			Message: "Log In Again",
+	userID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
 		})
 		return
 	}
+	if userID == "" {
+		// admins look at bookings of any user, their own by default
+		userID = c.Query("user_id")
+		if userID == "" {
+			userID, _ = GetIdFromToken(c.Request, h.Config)
+		}
+	}
 
-	response, err := h.Service.BookingService().UHBCreate(ctx, &pbb.GeneralBook{
-		Id:             uuid.NewString(),
-		UserId:         userID,
-		HraId:          body.HraId,
-		WillArrive:     body.WillArrive,
-		WillLeave:      body.WillLeave,
-		NumberOfPeople: body.NumberOfPeople,
-		IsCanceled:     body.IsCanceled,
-		Reason:         body.Reason,
-		CreatedAt:      time.Now().Format("2006-01-02T15:04:05"),
+	response, err := h.Service.BookingService().BookingGetAllByUId(ctx, &pbb.ListReqById{
+		Limit:       uint64(body.Limit),
+		Offset:      uint64((body.Page - 1) * body.Limit),
+		Id:          &pbb.Id{Id: userID},
+		BookingType: c.Query("booking_type"),
 	})
-
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		l.Error(err)
+		h.bookingError(c, "", err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, &models.BookingRes{
-		Id:             uuid.MustParse(response.Id),
-		UserId:         response.UserId,
-		HraId:          response.HraId,
-		WillArrive:     response.WillArrive,
-		WillLeave:      response.WillLeave,
-		NumberOfPeople: response.NumberOfPeople,
-		IsCanceled:     response.IsCanceled,
-		Reason:         response.Reason,
-		CreatedAt:      response.CreatedAt,
-	})
+	c.JSON(http.StatusOK, bookingListModel(response))
 }
 
-// Create Restaurant Booking
-// @Summary Create Restaurant Booking
+// List Bookings
+// @Summary List Bookings
 // @Security BearerAuth
-// @Description Api for Create Restaurant Booking
-// @Tags BOOKING_RESTAURANT
+// @Description Api for listing bookings of one type or of all of them
+// @Tags BOOKING
 // @Accept json
 // @Produce json
-// @Param CreateBookingReq body models.CreateBookingReq true "createModel"
+// @Param booking_type query string false "hotel, restaurant or attraction"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.List
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings/list [get]
+func (h *HandlerV1) ListBookings(c *gin.Context) {
+	if !h.adminOnly(c) {
+		return
+	}
+
+	response, ok := h.listBookings(c, "ListBookings", c.Query("booking_type"), h.Service.BookingService().BookingList)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, bookingListModel(response))
+}
+
+// List Deleted Bookings
+// @Summary List Deleted Bookings
+// @Security BearerAuth
+// @Description Api for listing deleted bookings of one type or of all of them
+// @Tags BOOKING
+// @Accept json
+// @Produce json
+// @Param booking_type query string false "hotel, restaurant or attraction"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.List
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings/deleted [get]
+func (h *HandlerV1) ListDeletedBookings(c *gin.Context) {
+	if !h.adminOnly(c) {
+		return
+	}
+
+	response, ok := h.listBookings(c, "ListDeletedBookings", c.Query("booking_type"), h.Service.BookingService().BookingListDeleted)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, bookingListModel(response))
+}
+
+// List Booked Users
+// @Summary List Booked Users
+// @Security BearerAuth
+// @Description Api for the owner of the establishment to list users who booked a room, a restaurant or an attraction
+// @Tags BOOKING
+// @Accept json
+// @Produce json
+// @Param id path string true "room, restaurant or attraction id"
+// @Param booking_type query string false "hotel, restaurant or attraction"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} []models.BookedUser
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings/establishment/{id}/users [get]
+func (h *HandlerV1) ListBookedUsers(c *gin.Context) {
+	h.bookedUsers(c, "ListBookedUsers", c.Query("booking_type"))
+}
+
+// Update Booking
+// @Summary Update Booking
+// @Security BearerAuth
+// @Description Api for changing the reason of a pending or confirmed booking, booking_type tells which one. Dates and party size change through /v1/bookings/{id}/reschedule
+// @Tags BOOKING
+// @Accept json
+// @Produce json
+// @Param UpdateBookingReq body models.UpdateBookingReq true "updateModel"
 // @Success 200 {object} models.BookingRes
 // @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings [put]
+func (h *HandlerV1) UpdateBooking(c *gin.Context) {
+	h.updateBooking(c, "UpdateBooking", "")
+}
+
+// Delete Booking
+// @Summary Delete Booking
+// @Security BearerAuth
+// @Description Api for deleting a cancelled, no-show or expired booking. Bookings that still hold their place answer 409 and have to be cancelled first
+// @Tags BOOKING
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param booking_type query string true "hotel, restaurant or attraction"
+// @Success 200 {object} models.StandartError
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
 // @Failure 500 {object} models.StandartError
-// @Router /v1/booking/restaurants [post]
-func (h *HandlerV1) URBCreate(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "URBCreate")
+// @Router /v1/bookings/{id} [delete]
+func (h *HandlerV1) DeleteBooking(c *gin.Context) {
+	h.deleteBooking(c, "DeleteBooking", c.Query("booking_type"), c.Param("id"))
+}
+
+// Quote Hotel Booking
+// @Summary Quote Hotel Booking
+// @Security BearerAuth
+// @Description Api for pricing a hotel stay without booking it: nights at the room price, holiday surcharges and the room discount
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param QuoteReq body models.QuoteReq true "quoteModel"
+// @Success 200 {object} models.PriceQuoteModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/quote [post]
+func (h *HandlerV1) UHBQuote(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "UHBQuote")
 	span.SetAttributes(
 		attribute.Key("method").String(c.Request.Method),
 	)
 	defer span.End()
 
-	var (
-		body        models.CreateBookingReq
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
+	var body models.QuoteReq
 	err := c.ShouldBindJSON(&body)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form in booking restaurant",
+			"error": "Not true form of request",
 		})
 		l.Error(err)
 		return
 	}
 
-	userID, statusCode := GetIdFromToken(c.Request, h.Config)
-	if statusCode == 401 {
-		c.JSON(http.StatusUnauthorized, models.Error{
-			Message: "Log In Again",
-		})
-		return
-	}
-
-	response, err := h.Service.BookingService().URBCreate(ctx, &pbb.GeneralBook{
-		Id:             uuid.NewString(),
-		UserId:         userID,
+	response, err := h.Service.BookingService().UHBQuote(ctx, &pbb.GeneralBook{
 		HraId:          body.HraId,
 		WillArrive:     body.WillArrive,
 		WillLeave:      body.WillLeave,
 		NumberOfPeople: body.NumberOfPeople,
-		IsCanceled:     body.IsCanceled,
-		Reason:         body.Reason,
-		CreatedAt:      time.Now().Format("2006-01-02T15:04:05"),
 	})
-
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid stay dates",
+			})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Room not found",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Try Again Later...",
+			})
+		}
 		l.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, &models.BookingRes{
-		Id:             uuid.MustParse(response.Id),
-		UserId:         response.UserId,
-		HraId:          response.HraId,
-		WillArrive:     response.WillArrive,
-		WillLeave:      response.WillLeave,
-		NumberOfPeople: response.NumberOfPeople,
-		IsCanceled:     response.IsCanceled,
-		Reason:         response.Reason,
-		CreatedAt:      response.CreatedAt,
+	c.JSON(http.StatusOK, &models.PriceQuoteModel{
+		Nights:        response.Nights,
+		HolidayNights: response.HolidayNights,
+		NightlyPrice:  response.NightlyPrice,
+		Subtotal:      response.Subtotal,
+		Surcharge:     response.Surcharge,
+		Discount:      response.Discount,
+		Total:         response.Total,
+		Currency:      response.Currency,
 	})
 }
 
-// Create Attraction Booking
-// @Summary Create Attraction Booking
-// @Security BearerAuth
-// @Description Api for Create Attraction Booking
-// @Tags BOOKING_ATTRACTION
-// @Accept json
-// @Produce json
-// @Param CreateBookingReq body models.CreateBookingReq true "createModel"
-// @Success 200 {object} models.BookingRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/attractions [post]
-func (h *HandlerV1) UABCreate(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UABCreate")
+// createBooking books an establishment of bookingType, an empty type is taken from the body
+func (h *HandlerV1) createBooking(c *gin.Context, spanName, bookingType string) {
+	ctx, span := otlp.Start(c, "api", spanName)
 	span.SetAttributes(
 		attribute.Key("method").String(c.Request.Method),
 	)
 	defer span.End()
 
-	var (
-		body        models.CreateBookingReq
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
+	var body models.CreateBookingReq
 	err := c.ShouldBindJSON(&body)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form in booking attraction",
+			"error": "Not true form of request",
 		})
 		l.Error(err)
 		return
 	}
+	if bookingType == "" {
+		bookingType = body.BookingType
+	}
+	if !validBookingType(c, bookingType) {
+		return
+	}
 
 	userID, statusCode := GetIdFromToken(c.Request, h.Config)
 	if statusCode == 401 {
@@ -210,408 +421,228 @@ func (h *HandlerV1) UABCreate(c *gin.Context) {
 		return
 	}
 
-	response, err := h.Service.BookingService().UABCreate(ctx, &pbb.GeneralBook{
+	response, err := h.Service.BookingService().BookingCreate(ctx, &pbb.GeneralBook{
 		Id:             uuid.NewString(),
+		BookingType:    bookingType,
 		UserId:         userID,
 		HraId:          body.HraId,
 		WillArrive:     body.WillArrive,
 		WillLeave:      body.WillLeave,
 		NumberOfPeople: body.NumberOfPeople,
-		IsCanceled:     body.IsCanceled,
 		Reason:         body.Reason,
+		AdultTickets:   body.AdultTickets,
+		ChildTickets:   body.ChildTickets,
+		Guests:         bookingGuestsPb(body.Guests),
+		AddOns:         bookingAddOnsPb(body.AddOns),
 		CreatedAt:      time.Now().Format("2006-01-02T15:04:05"),
+
+		SpecialRequests:    body.SpecialRequests,
+		SpecialRequestNote: body.SpecialRequestNote,
 	})
+	if status.Code(err) == codes.FailedPrecondition && body.Waitlist && bookingType != bookingAttraction {
+		entry, err := h.joinWaitlist(ctx, userID, &models.JoinWaitlistReq{
+			BookingType:    bookingType,
+			HraId:          body.HraId,
+			WillArrive:     body.WillArrive,
+			WillLeave:      body.WillLeave,
+			NumberOfPeople: body.NumberOfPeople,
+			Reason:         body.Reason,
+		})
+		if err != nil {
+			h.waitlistError(c, err)
+			return
+		}
 
+		c.JSON(http.StatusAccepted, waitlistEntryModel(entry))
+		return
+	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		l.Error(err)
+		h.bookingError(c, bookingType, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, &models.BookingRes{
-		Id:             uuid.MustParse(response.Id),
-		UserId:         response.UserId,
-		HraId:          response.HraId,
-		WillArrive:     response.WillArrive,
-		WillLeave:      response.WillLeave,
-		NumberOfPeople: response.NumberOfPeople,
-		IsCanceled:     response.IsCanceled,
-		Reason:         response.Reason,
-		CreatedAt:      response.CreatedAt,
-	})
+	c.JSON(http.StatusCreated, bookingModel(response))
 }
 
-// Get All Hotels By User Id
-// @Summary Get All Hotels By User Id
-// @Security BearerAuth
-// @Description Api for Get All Hotels By User Id
-// @Tags BOOKING_HOTEL
-// @Accept json
-// @Produce json
-// @Param id query models.IdReq true "id"
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.IdRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/hotels/{id} [get]
-func (h *HandlerV1) UHBGetAllByUId(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UHBGetAllByUId")
+// updateBooking changes a booking of bookingType, an empty type is taken from
+// the body. The booking keeps the user who made it.
+func (h *HandlerV1) updateBooking(c *gin.Context, spanName, bookingType string) {
+	ctx, span := otlp.Start(c, "api", spanName)
 	span.SetAttributes(
 		attribute.Key("method").String(c.Request.Method),
 	)
 	defer span.End()
 
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindQuery(&body)
+	var body models.UpdateBookingReq
+	err := c.ShouldBindJSON(&body)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": "Not true form of request",
 		})
-		l.Error(err)
+		h.Logger.Error("failed to bind json", l.Error(err))
 		return
 	}
-
-	id := c.Query("id")
-	if id == "" {
-		userID, statusCode := GetIdFromToken(c.Request, h.Config)
-		if statusCode != http.StatusOK {
-			c.JSON(statusCode, gin.H{
-				"error": "Can't get user",
-			})
-			return
-		}
-		id = userID
+	if bookingType == "" {
+		bookingType = body.BookingType
+	}
+	if !validBookingType(c, bookingType) {
+		return
 	}
 
-	response, err := h.Service.BookingService().UHBGetAllByUId(
-		ctx, &pbb.ListReqById{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-			Id: &pbb.Id{
-				Id: id,
-			},
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		l.Error(err)
+	booking, ok := h.ownBooking(ctx, c, bookingType, body.Id.String())
+	if !ok {
 		return
 	}
 
-	var hotelsDetails []*pbe.Hotel
-	for _, booking := range response.UserHotel {
-		hotelResponse, err := h.Service.EstablishmentService().GetHotel(ctx, &pbe.GetHotelRequest{
-			HotelId: booking.HraId,
-		})
-		if err != nil {
-			if errors.Is(err, fmt.Errorf("no rows in result set")) {
-				continue
-			}
-			c.JSON(http.StatusExpectationFailed, gin.H{
-				"error": "Failed to retrieve hotel details",
-			})
-			l.Error(err)
-			return
-		}
-		hotelsDetails = append(hotelsDetails, hotelResponse.Hotel)
+	// the special requests and their note are sent together, the one left out
+	// keeps its stored value
+	specialRequests := body.SpecialRequests
+	if specialRequests == nil {
+		specialRequests = booking.SpecialRequests
+	}
+	specialRequestNote := booking.SpecialRequestNote
+	if body.SpecialRequestNote != nil {
+		specialRequestNote = *body.SpecialRequestNote
+	}
+
+	response, err := h.Service.BookingService().BookingUpdate(ctx, &pbb.GeneralBook{
+		Id:          body.Id.String(),
+		BookingType: bookingType,
+		UserId:      booking.UserId,
+		Reason:      body.Reason,
+		Guests:      bookingGuestsPb(body.Guests),
+		GuestsSet:   body.Guests != nil,
+		AddOns:      bookingAddOnsPb(body.AddOns),
+		AddOnsSet:   body.AddOns != nil,
+		UpdatedAt:   time.Now().Format("2006-01-02T15:04:05"),
+
+		SpecialRequests:    specialRequests,
+		SpecialRequestNote: specialRequestNote,
+		SpecialRequestsSet: body.SpecialRequests != nil || body.SpecialRequestNote != nil,
+	})
+	if err != nil {
+		h.bookingError(c, bookingType, err)
+		return
 	}
 
-	c.JSON(http.StatusOK, hotelsDetails)
+	c.JSON(http.StatusOK, bookingModel(response))
 }
 
-// Get All Restaurants By User Id
-// @Summary Get All Restaurants By User Id
-// @Security BearerAuth
-// @Description Api for Get All Restaurants By User Id
-// @Tags BOOKING_RESTAURANT
-// @Accept json
-// @Produce json
-// @Param id query models.IdReq true "id"
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.IdRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/restaurants/{id} [get]
-func (h *HandlerV1) URBGetAllByUId(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "URBGetAllByUId")
+// deleteBooking soft-deletes a booking of bookingType
+func (h *HandlerV1) deleteBooking(c *gin.Context, spanName, bookingType, id string) {
+	ctx, span := otlp.Start(c, "api", spanName)
 	span.SetAttributes(
 		attribute.Key("method").String(c.Request.Method),
 	)
 	defer span.End()
 
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindQuery(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
-		})
-		l.Error(err)
+	if !validBookingType(c, bookingType) {
 		return
 	}
-
-	id := c.Param("id")
-	if id == "" {
-		userID, statusCode := GetIdFromToken(c.Request, h.Config)
-		if statusCode != http.StatusOK {
-			c.JSON(statusCode, gin.H{
-				"error": "Can't get user",
-			})
-			return
-		}
-		id = userID
+	if _, ok := h.ownBooking(ctx, c, bookingType, id); !ok {
+		return
 	}
 
-	response, err := h.Service.BookingService().URBGetAllByUId(
-		ctx, &pbb.ListReqById{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-			Id: &pbb.Id{
-				Id: id,
-			},
+	_, err := h.Service.BookingService().BookingDelete(ctx, &pbb.BookingId{
+		Id:          id,
+		BookingType: bookingType,
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "Only cancelled, no-show or expired bookings can be deleted, cancel the booking first",
 		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Try Again Later...",
 		})
-		l.Error(err)
+		h.Logger.Error("failed to delete booking", l.Error(err))
 		return
 	}
 
-	var restaurantsDetails []*pbe.Restaurant
-	for _, booking := range response.UserRestaurant {
-		restaurantResponse, err := h.Service.EstablishmentService().GetRestaurant(ctx, &pbe.GetRestaurantRequest{
-			RestaurantId: booking.HraId,
-		})
-		if err != nil {
-			if errors.Is(err, fmt.Errorf("no rows in result set")) {
-				continue
-			}
-			c.JSON(http.StatusExpectationFailed, gin.H{
-				"error": "Failed to retrieve restaurant details",
-			})
-			l.Error(err)
-			return
-		}
-		restaurantsDetails = append(restaurantsDetails, restaurantResponse.Restaurant)
-	}
-
-	c.JSON(http.StatusOK, restaurantsDetails)
+	c.JSON(http.StatusOK, "successfully canceled...")
 }
 
-// Get All Attractions By User Id
-// @Summary Get All Attractions By User Id
-// @Security BearerAuth
-// @Description Api for Get All Attractions By User Id
-// @Tags BOOKING_ATTRACTION
-// @Accept json
-// @Produce json
-// @Param id query models.IdReq true "id"
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.IdRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/attractions/{id} [get]
-func (h *HandlerV1) UABGetAllByUId(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UABGetAllByUId")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindQuery(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
+// ownBooking looks a booking up and answers 404 unless the caller made it or is an admin
+func (h *HandlerV1) ownBooking(ctx context.Context, c *gin.Context, bookingType, id string) (*pbb.GeneralBook, bool) {
+	userID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
 		})
-		l.Error(err)
-		return
+		return nil, false
 	}
 
-	id := c.Param("id")
-	if id == "" {
-		userID, statusCode := GetIdFromToken(c.Request, h.Config)
-		if statusCode != http.StatusOK {
-			c.JSON(statusCode, gin.H{
-				"error": "Can't get user",
-			})
-			return
-		}
-		id = userID
+	booking, err := h.Service.BookingService().BookingGet(ctx, &pbb.BookingId{
+		Id:          id,
+		BookingType: bookingType,
+	})
+	if err == nil && userID != "" && booking.UserId != userID {
+		err = status.Error(codes.NotFound, "booking not found")
 	}
-
-	response, err := h.Service.BookingService().UABGetAllByUId(
-		ctx, &pbb.ListReqById{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-			Id: &pbb.Id{
-				Id: id,
-			},
-		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		l.Error(err)
-		return
-	}
-
-	var attractionsDetails []*pbe.Attraction
-	for _, booking := range response.UserAttraction {
-		attractionResponse, err := h.Service.EstablishmentService().GetAttraction(ctx, &pbe.GetAttractionRequest{
-			AttractionId: booking.HraId,
-		})
-		if err != nil {
-			if errors.Is(err, fmt.Errorf("no rows in result set")) {
-				continue
-			}
-			c.JSON(http.StatusExpectationFailed, gin.H{
-				"error": "Failed to retrieve restaurant details",
+		switch status.Code(err) {
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Booking not found",
+			})
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": status.Convert(err).Message(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Try Again Later...",
 			})
-			l.Error(err)
-			return
 		}
-		attractionsDetails = append(attractionsDetails, attractionResponse.Attraction)
+		h.Logger.Error("failed to get booking", l.Error(err))
+		return nil, false
 	}
 
-	c.JSON(http.StatusOK, attractionsDetails)
+	return booking, true
 }
 
-// Get All Users By Room Id
-// @Summary Get All Users By Room Id
-// @Security BearerAuth
-// @Description Api for Get All Users By Room Id
-// @Tags BOOKING_HOTEL
-// @Accept json
-// @Produce json
-// @Param id path string true "ID"
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.IdRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/users/room/{id} [get]
-func (h *HandlerV1) UHBGetAllByHId(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UHBGetAllByHId")
+// listBookings pages through bookings of bookingType with rpc, an empty type lists all of them
+func (h *HandlerV1) listBookings(c *gin.Context, spanName, bookingType string, rpc listRPC) (*pbb.ListBookingRes, bool) {
+	ctx, span := otlp.Start(c, "api", spanName)
 	span.SetAttributes(
 		attribute.Key("method").String(c.Request.Method),
 	)
 	defer span.End()
 
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
+	var body models.Pagination
 	err := c.ShouldBindQuery(&body)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": "Not true form of request",
 		})
 		l.Error(err)
-		return
+		return nil, false
 	}
 
-	id := c.Param("id")
-
-	response, err := h.Service.BookingService().UHBGetAllByHId(
-		ctx, &pbb.ListReqById{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-			Id: &pbb.Id{
-				Id: id,
-			},
-		})
+	response, err := rpc(ctx, &pbb.ListReq{
+		Limit:       uint64(body.Limit),
+		Offset:      uint64((body.Page - 1) * body.Limit),
+		BookingType: bookingType,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		l.Error(err)
-		return
-	}
-
-	var usersDetails []*models.BookedUser
-	for _, booking := range response.UserId {
-		userResponse, err := h.Service.UserService().Get(ctx, &pbu.Filter{
-			Filter: map[string]string{
-				"id": booking.Id,
-			},
-		})
-		if err != nil {
-			if errors.Is(err, fmt.Errorf("no rows in result set")) {
-				continue
-			}
-			c.JSON(http.StatusExpectationFailed, gin.H{
-				"error": "Failed to retrieve user details",
-			})
-			l.Error(err)
-			return
-		}
-		createdAt, err := time.Parse(time.RFC3339, userResponse.User.CreatedAt)
-		if err != nil {
-			c.JSON(http.StatusExpectationFailed, gin.H{
-				"error": "Failed to parse created_at timestamp",
-			})
-			l.Error(err)
-			return
-		}
-		createdAt = createdAt.Add(time.Minute)
-		incrementedCreatedAt := createdAt.Format(time.RFC3339)
-		usersDetails = append(usersDetails, &models.BookedUser{
-			FullName:    userResponse.User.FullName,
-			Email:       userResponse.User.Email,
-			PhoneNumber: userResponse.User.PhoneNumber,
-			BookedTime:  incrementedCreatedAt,
-		})
+		h.bookingError(c, bookingType, err)
+		return nil, false
 	}
 
-	c.JSON(http.StatusOK, usersDetails)
+	return response, true
 }
 
-// Get All Users By Restaurant Id
-// @Summary Get All Users By Restaurant Id
-// @Security BearerAuth
-// @Description Api for Get All Users By Restaurant Id
-// @Tags BOOKING_RESTAURANT
-// @Accept json
-// @Produce json
-// @Param id path string true "ID"
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.IdRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/users/restaurant/{id} [get]
-func (h *HandlerV1) URBGetAllByRId(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "URBGetAllByRId")
+// bookedUsers lists users who booked the room, restaurant or attraction in the id path parameter
+func (h *HandlerV1) bookedUsers(c *gin.Context, spanName, bookingType string) {
+	ctx, span := otlp.Start(c, "api", spanName)
 	span.SetAttributes(
 		attribute.Key("method").String(c.Request.Method),
 	)
 	defer span.End()
 
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
+	var body models.Pagination
 	err := c.ShouldBindQuery(&body)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -621,112 +652,27 @@ func (h *HandlerV1) URBGetAllByRId(c *gin.Context) {
 		return
 	}
 
-	id := c.Param("id")
-
-	response, err := h.Service.BookingService().URBGetAllByRId(
-		ctx, &pbb.ListReqById{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-			Id: &pbb.Id{
-				Id: id,
-			},
-		})
+	// only the owner of the establishment may see who booked it
+	establishmentID, err := h.hraEstablishment(ctx, bookingType, c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Establishment not found",
 		})
-		l.Error(err)
+		h.Logger.Error("failed to get establishment", l.Error(err))
 		return
 	}
-
-	var usersDetails []*models.BookedUser
-	for _, booking := range response.UserId {
-		userResponse, err := h.Service.UserService().Get(ctx, &pbu.Filter{
-			Filter: map[string]string{
-				"id": booking.Id,
-			},
-		})
-		if err != nil {
-			if errors.Is(err, fmt.Errorf("no rows in result set")) {
-				continue
-			}
-			c.JSON(http.StatusExpectationFailed, gin.H{
-				"error": "Failed to retrieve user details",
-			})
-			l.Error(err)
-			return
-		}
-		createdAt, err := time.Parse(time.RFC3339, userResponse.User.CreatedAt)
-		if err != nil {
-			c.JSON(http.StatusExpectationFailed, gin.H{
-				"error": "Failed to parse created_at timestamp",
-			})
-			l.Error(err)
-			return
-		}
-		createdAt = createdAt.Add(time.Minute)
-		incrementedCreatedAt := createdAt.Format(time.RFC3339)
-		usersDetails = append(usersDetails, &models.BookedUser{
-			FullName:    userResponse.User.FullName,
-			Email:       userResponse.User.Email,
-			PhoneNumber: userResponse.User.PhoneNumber,
-			BookedTime:  incrementedCreatedAt,
-		})
-	}
-
-	c.JSON(http.StatusOK, usersDetails)
-}
-
-// Get All Users By Attraction Id
-// @Summary Get All Users By Attraction Id
-// @Security BearerAuth
-// @Description Api for Get All Users By Attraction Id
-// @Tags BOOKING_ATTRACTION
-// @Accept json
-// @Produce json
-// @Param id path string true "ID"
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.IdRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/users/attraction/{id} [get]
-func (h *HandlerV1) UABGetAllByAId(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UABGetAllByAId")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		bodyPL      models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindQuery(&bodyPL)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
-		})
-		l.Error(err)
+	if !h.callerOwnsEstablishment(ctx, c, establishmentID) {
 		return
 	}
 
-	id := c.Param("id")
-
-	response, err := h.Service.BookingService().UABGetAllByAId(
-		ctx, &pbb.ListReqById{
-			Limit:  uint64(bodyPL.Limit),
-			Offset: uint64((bodyPL.Page - 1) * bodyPL.Limit),
-			Id: &pbb.Id{
-				Id: id,
-			},
-		})
+	response, err := h.Service.BookingService().BookingGetAllByHraId(ctx, &pbb.ListReqById{
+		Limit:       uint64(body.Limit),
+		Offset:      uint64((body.Page - 1) * body.Limit),
+		Id:          &pbb.Id{Id: c.Param("id")},
+		BookingType: bookingType,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		l.Error(err)
+		h.bookingError(c, bookingType, err)
 		return
 	}
 
@@ -768,635 +714,146 @@ func (h *HandlerV1) UABGetAllByAId(c *gin.Context) {
 	c.JSON(http.StatusOK, usersDetails)
 }
 
-// List Hotels
-// @Summary List Hotels
-// @Security BearerAuth
-// @Description Api for List Hotels
-// @Tags BOOKING_HOTEL
-// @Accept json
-// @Produce json
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.List
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/hotels [get]
-func (h *HandlerV1) UHBList(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UHBList")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindQuery(&body)
-	if err != nil {
+func (h *HandlerV1) bookingError(c *gin.Context, bookingType string, err error) {
+	messages, ok := bookingMessages[bookingType]
+	switch {
+	case status.Code(err) == codes.FailedPrecondition && ok:
+		c.JSON(http.StatusConflict, gin.H{
+			"error": messages.overbooked,
+		})
+	case status.Code(err) == codes.InvalidArgument && ok:
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
+			"error": messages.invalid,
 		})
-		l.Error(err)
-		return
-	}
-
-	response, err := h.Service.BookingService().UHBList(
-		ctx, &pbb.ListReq{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
+	case status.Code(err) == codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
 		})
-	if err != nil {
+	case status.Code(err) == codes.NotFound && ok:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": messages.notFound,
+		})
+	default:
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Try Again Later...",
 		})
-		l.Error(err)
-		return
 	}
-	c.JSON(http.StatusOK, response)
+	h.Logger.Error("booking request failed", l.Error(err))
 }
 
-// List Restaurants
-// @Summary List Restaurants
-// @Security BearerAuth
-// @Description Api for List Restaurants
-// @Tags BOOKING_RESTAURANT
-// @Accept json
-// @Produce json
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.List
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/restaurants [get]
-func (h *HandlerV1) URBList(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "URBList")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
+// adminOnly keeps the user policy for /v1/bookings/{id} from opening the
+// admin listings that share its path shape
+func (h *HandlerV1) adminOnly(c *gin.Context) bool {
+	userID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return false
+	}
+	if userID != "" {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Permission denied",
+		})
+		return false
+	}
+	return true
+}
 
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
+// validBookingType answers 400 unless bookingType is one the booking service knows
+func validBookingType(c *gin.Context, bookingType string) bool {
+	if _, ok := bookingMessages[bookingType]; ok {
+		return true
+	}
 
-	err := c.ShouldBindQuery(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error": "booking_type must be one of hotel, restaurant, attraction",
+	})
+	return false
+}
+
+func bookingGuestsPb(guests []models.BookingGuest) []*pbb.BookingGuest {
+	res := make([]*pbb.BookingGuest, 0, len(guests))
+	for _, guest := range guests {
+		res = append(res, &pbb.BookingGuest{
+			FullName:       guest.FullName,
+			Age:            guest.Age,
+			DocumentNumber: guest.DocumentNumber,
 		})
-		l.Error(err)
-		return
 	}
+	return res
+}
 
-	response, err := h.Service.BookingService().URBList(
-		ctx, &pbb.ListReq{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
+func bookingAddOnsPb(addOns []models.BookingAddOnReq) []*pbb.BookingAddOn {
+	res := make([]*pbb.BookingAddOn, 0, len(addOns))
+	for _, addOn := range addOns {
+		res = append(res, &pbb.BookingAddOn{
+			AddOnId:  addOn.AddOnId,
+			Quantity: addOn.Quantity,
 		})
-		l.Error(err)
-		return
 	}
-	c.JSON(http.StatusOK, response)
+	return res
 }
 
-// List Attractions
-// @Summary List Attractions
-// @Security BearerAuth
-// @Description Api for List Attractions
-// @Tags BOOKING_ATTRACTION
-// @Accept json
-// @Produce json
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.List
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/attractions [get]
-func (h *HandlerV1) UABList(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UABList")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindQuery(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
-		})
-		l.Error(err)
-		return
-	}
-
-	response, err := h.Service.BookingService().UABList(
-		ctx, &pbb.ListReq{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		l.Error(err)
-		return
-	}
-	c.JSON(http.StatusOK, response)
-}
-
-// List Deleted Hotels
-// @Summary List Deleted Hotels
-// @Security BearerAuth
-// @Description Api for List Deleted Hotels
-// @Tags BOOKING_HOTEL
-// @Accept json
-// @Produce json
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.List
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/hotels/deleted [get]
-func (h *HandlerV1) UHBListDeleted(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UHBListDeleted")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindQuery(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
-		})
-		l.Error(err)
-		return
-	}
-
-	response, err := h.Service.BookingService().UHBListDeleted(
-		ctx, &pbb.ListReq{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		l.Error(err)
-		return
-	}
-	c.JSON(http.StatusOK, response)
-}
-
-// List Deleted Restaurants
-// @Summary List Deleted Restaurants
-// @Security BearerAuth
-// @Description Api for List Deleted Restaurants
-// @Tags BOOKING_RESTAURANT
-// @Accept json
-// @Produce json
-// @Param irequest query models.Pagination true "request"
-// @Success 200 {object} models.List
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/restaurants/deleted [get]
-func (h *HandlerV1) URBListDeleted(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "URBListDeleted")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindQuery(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
-		})
-		l.Error(err)
-		return
-	}
-
-	response, err := h.Service.BookingService().URBListDeleted(
-		ctx, &pbb.ListReq{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		l.Error(err)
-		return
-	}
-	c.JSON(http.StatusOK, response)
+func bookingModel(booking *pbb.GeneralBook) *models.BookingRes {
+	id, _ := uuid.Parse(booking.Id)
+	res := &models.BookingRes{
+		Id:               id,
+		BookingType:      booking.BookingType,
+		UserId:           booking.UserId,
+		HraId:            booking.HraId,
+		WillArrive:       booking.WillArrive,
+		WillLeave:        booking.WillLeave,
+		NumberOfPeople:   booking.NumberOfPeople,
+		IsCanceled:       booking.IsCanceled,
+		Status:           booking.Status,
+		Reason:           booking.Reason,
+		AdultTickets:     booking.AdultTickets,
+		ChildTickets:     booking.ChildTickets,
+		TotalPrice:       booking.TotalPrice,
+		Currency:         booking.Currency,
+		ItineraryId:      booking.ItineraryId,
+		HoldExpiresAt:    booking.HoldExpiresAt,
+		ApprovalDueAt:    booking.ApprovalDueAt,
+		ConfirmationCode: booking.ConfirmationCode,
+		CreatedAt:        booking.CreatedAt,
+		UpdatedAt:        booking.UpdatedAt,
+		DeletedAt:        booking.DeletedAt,
+
+		Guests:             []models.BookingGuest{},
+		AddOns:             []models.BookingAddOn{},
+		SpecialRequests:    booking.SpecialRequests,
+		SpecialRequestNote: booking.SpecialRequestNote,
+	}
+	if res.SpecialRequests == nil {
+		res.SpecialRequests = []string{}
+	}
+	for _, guest := range booking.Guests {
+		res.Guests = append(res.Guests, models.BookingGuest{
+			FullName:       guest.FullName,
+			Age:            guest.Age,
+			DocumentNumber: guest.DocumentNumber,
+		})
+	}
+	for _, addOn := range booking.AddOns {
+		res.AddOns = append(res.AddOns, models.BookingAddOn{
+			AddOnId:   addOn.AddOnId,
+			Name:      addOn.Name,
+			Quantity:  addOn.Quantity,
+			UnitPrice: addOn.UnitPrice,
+			Total:     addOn.Total,
+		})
+	}
+	return res
 }
 
-// List Deleted Attractions
-// @Summary List Deleted Attractions
-// @Security BearerAuth
-// @Description Api for List Deleted Attractions
-// @Tags BOOKING_ATTRACTION
-// @Accept json
-// @Produce json
-// @Param request query models.Pagination true "request"
-// @Success 200 {object} models.List
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/attractions/deleted [get]
-func (h *HandlerV1) UABListDeleted(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UABListDeleted")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		body        models.Pagination
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindQuery(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
-		})
-		l.Error(err)
-		return
+func bookingListModel(response *pbb.ListBookingRes) *models.List {
+	res := &models.List{
+		Bookings: []*models.BookingRes{},
+		Count:    response.Count,
 	}
-
-	response, err := h.Service.BookingService().UABListDeleted(
-		ctx, &pbb.ListReq{
-			Limit:  uint64(body.Limit),
-			Offset: uint64((body.Page - 1) * body.Limit),
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		l.Error(err)
-		return
-	}
-	c.JSON(http.StatusOK, response)
-}
-
-// Update Booked Hotel
-// @Summary Update Booked Hotel
-// @Security BearerAuth
-// @Description Api for Update Booked Hotel
-// @Tags BOOKING_HOTEL
-// @Accept json
-// @Produce json
-// @Param models.UpdateBookingReq body models.UpdateBookingReq true "createModel"
-// @Success 200 {object} models.BookingRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/hotels [put]
-func (h *HandlerV1) UHBUpdate(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UHBUpdate")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		body        models.UpdateBookingReq
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindJSON(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
-		})
-		h.Logger.Error("failed to bind json", l.Error(err))
-		return
-	}
-	userId, statusCode := GetIdFromToken(c.Request, h.Config)
-	if statusCode != http.StatusOK {
-		c.JSON(statusCode, gin.H{
-			"error": "Can't get user",
-		})
-		return
-	}
-
-	response, err := h.Service.BookingService().UHBUpdate(ctx, &pbb.GeneralBook{
-		Id:             body.Id.String(),
-		UserId:         userId,
-		HraId:          body.HraId,
-		WillArrive:     body.WillArrive,
-		WillLeave:      body.WillLeave,
-		NumberOfPeople: body.NumberOfPeople,
-		IsCanceled:     body.IsCanceled,
-		Reason:         body.Reason,
-		CreatedAt:      time.Now().Format("2006-01-02T15:04:05"),
-		UpdatedAt:      time.Now().Format("2006-01-02T15:04:05"),
-	})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		h.Logger.Error("failed to update booked hotel", l.Error(err))
-		return
+	for _, booking := range response.Bookings {
+		res.Bookings = append(res.Bookings, bookingModel(booking))
 	}
-
-	c.JSON(http.StatusOK, &models.BookingRes{
-		Id:             uuid.MustParse(response.Id),
-		UserId:         response.UserId,
-		HraId:          response.HraId,
-		WillArrive:     response.WillArrive,
-		WillLeave:      response.WillLeave,
-		NumberOfPeople: response.NumberOfPeople,
-		IsCanceled:     response.IsCanceled,
-		Reason:         response.Reason,
-		CreatedAt:      response.CreatedAt,
-	})
-}
-
-// Update Booked Restaurant
-// @Summary Update Booked Restaurant
-// @Security BearerAuth
-// @Description Api for Update Booked Restaurant
-// @Tags BOOKING_RESTAURANT
-// @Accept json
-// @Produce json
-// @Param models.UpdateBookingReq body models.UpdateBookingReq true "createModel"
-// @Success 200 {object} models.BookingRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/restaurants [put]
-func (h *HandlerV1) URBUpdate(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "URBUpdate")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		body        models.UpdateBookingReq
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindJSON(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
-		})
-		h.Logger.Error("failed to bind json", l.Error(err))
-		return
-	}
-	userId, statusCode := GetIdFromToken(c.Request, h.Config)
-	if statusCode != http.StatusOK {
-		c.JSON(statusCode, gin.H{
-			"error": "Can't get user",
-		})
-		return
-	}
-
-	response, err := h.Service.BookingService().URBUpdate(ctx, &pbb.GeneralBook{
-		Id:             body.Id.String(),
-		UserId:         userId,
-		HraId:          body.HraId,
-		WillArrive:     body.WillArrive,
-		WillLeave:      body.WillLeave,
-		NumberOfPeople: body.NumberOfPeople,
-		IsCanceled:     body.IsCanceled,
-		Reason:         body.Reason,
-		CreatedAt:      time.Now().Format("2006-01-02T15:04:05"),
-		UpdatedAt:      time.Now().Format("2006-01-02T15:04:05"),
-	})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		h.Logger.Error("failed to update booked restaurant", l.Error(err))
-		return
-	}
-
-	c.JSON(http.StatusOK, &models.BookingRes{
-		Id:             uuid.MustParse(response.Id),
-		UserId:         response.UserId,
-		HraId:          response.HraId,
-		WillArrive:     response.WillArrive,
-		WillLeave:      response.WillLeave,
-		NumberOfPeople: response.NumberOfPeople,
-		IsCanceled:     response.IsCanceled,
-		Reason:         response.Reason,
-		CreatedAt:      response.CreatedAt,
-	})
-}
-
-// Update Booked Attraction
-// @Summary Update Booked Attraction
-// @Security BearerAuth
-// @Description Api for Update Booked Attraction
-// @Tags BOOKING_ATTRACTION
-// @Accept json
-// @Produce json
-// @Param models.UpdateBookingReq body models.UpdateBookingReq true "createModel"
-// @Success 200 {object} models.BookingRes
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/attractions [put]
-func (h *HandlerV1) UABUpdate(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UABUpdate")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var (
-		body        models.UpdateBookingReq
-		jsonMarshal protojson.MarshalOptions
-	)
-	jsonMarshal.UseProtoNames = true
-
-	err := c.ShouldBindJSON(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Not true form of request",
-		})
-		h.Logger.Error("failed to bind json", l.Error(err))
-		return
-	}
-	userId, statusCode := GetIdFromToken(c.Request, h.Config)
-	if statusCode != http.StatusOK {
-		c.JSON(statusCode, gin.H{
-			"error": "Can't get user",
-		})
-		return
-	}
-
-	response, err := h.Service.BookingService().UABUpdate(ctx, &pbb.GeneralBook{
-		Id:             body.Id.String(),
-		UserId:         userId,
-		HraId:          body.HraId,
-		WillArrive:     body.WillArrive,
-		WillLeave:      body.WillLeave,
-		NumberOfPeople: body.NumberOfPeople,
-		IsCanceled:     body.IsCanceled,
-		Reason:         body.Reason,
-		CreatedAt:      time.Now().Format("2006-01-02T15:04:05"),
-		UpdatedAt:      time.Now().Format("2006-01-02T15:04:05"),
-	})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		h.Logger.Error("failed to update booked attraction", l.Error(err))
-		return
-	}
-
-	c.JSON(http.StatusOK, &models.BookingRes{
-		Id:             uuid.MustParse(response.Id),
-		UserId:         response.UserId,
-		HraId:          response.HraId,
-		WillArrive:     response.WillArrive,
-		WillLeave:      response.WillLeave,
-		NumberOfPeople: response.NumberOfPeople,
-		IsCanceled:     response.IsCanceled,
-		Reason:         response.Reason,
-		CreatedAt:      response.CreatedAt,
-	})
-}
-
-// Delete Hotel
-// @Summary Delete Hotel
-// @Security BearerAuth
-// @Description Api for Delete Hotel
-// @Tags BOOKING_HOTEL
-// @Accept json
-// @Produce json
-// @Param id query string true "ID"
-// @Success 200 {object} models.StandartError
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/hotels/{id} [delete]
-func (h *HandlerV1) UHBDelete(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UHBDelete")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var jsonMarshal protojson.MarshalOptions
-	jsonMarshal.UseProtoNames = true
-
-	id := c.Query("id")
-
-	_, err := h.Service.BookingService().UHBDelete(
-		ctx, &pbb.Id{
-			Id: id,
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		h.Logger.Error("failed to delete booked hotel", l.Error(err))
-		return
-	}
-
-	c.JSON(http.StatusOK, "successfully canceled...")
-}
-
-// Delete Restaurant
-// @Summary Delete Restaurant
-// @Security BearerAuth
-// @Description Api for Delete Restaurant
-// @Tags BOOKING_RESTAURANT
-// @Accept json
-// @Produce json
-// @Param id query string true "ID"
-// @Success 200 {object} models.StandartError
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/restaurants/{id} [delete]
-func (h *HandlerV1) URBDelete(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "URBDelete")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var jsonMarshal protojson.MarshalOptions
-	jsonMarshal.UseProtoNames = true
-
-	id := c.Query("id")
-
-	_, err := h.Service.BookingService().URBDelete(
-		ctx, &pbb.Id{
-			Id: id,
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		h.Logger.Error("failed to delete booked restaurant", l.Error(err))
-		return
-	}
-
-	c.JSON(http.StatusOK, "successfully canceled...")
-}
-
-// Delete Attraction
-// @Summary Delete Attraction
-// @Security BearerAuth
-// @Description Api for Delete Attraction
-// @Tags BOOKING_ATTRACTION
-// @Accept json
-// @Produce json
-// @Param id query string true "ID"
-// @Success 200 {object} models.StandartError
-// @Failure 400 {object} models.StandartError
-// @Failure 500 {object} models.StandartError
-// @Router /v1/booking/attractions/{id} [delete]
-func (h *HandlerV1) UABDelete(c *gin.Context) {
-	ctx, span := otlp.Start(c, "api", "UABDelete")
-	span.SetAttributes(
-		attribute.Key("method").String(c.Request.Method),
-	)
-	defer span.End()
-
-	var jsonMarshal protojson.MarshalOptions
-	jsonMarshal.UseProtoNames = true
-
-	id := c.Query("id")
-
-	_, err := h.Service.BookingService().UABDelete(
-		ctx, &pbb.Id{
-			Id: id,
-		})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Try Again Later...",
-		})
-		h.Logger.Error("failed to delete booked attraction", l.Error(err))
-		return
-	}
-
-	c.JSON(http.StatusOK, "successfully canceled...")
+	return res
 }
@@ -0,0 +1,526 @@
+package v1
+
+import (
+	models "Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Routes below keep the per-type booking API working on top of the booking
+// methods that take the booking type.
+
+// Create Hotel Booking
+// @Summary Create Hotel Booking
+// @Security BearerAuth
+// @Description Api for Create Hotel Booking
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param CreateBookingReq body models.CreateBookingReq true "createModel"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.BookingRes
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels [post]
+func (h *HandlerV1) UHBCreate(c *gin.Context) {
+	h.createBooking(c, "UHBCreate", bookingHotel)
+}
+
+// Create Restaurant Booking
+// @Summary Create Restaurant Booking
+// @Security BearerAuth
+// @Description Api for Create Restaurant Booking
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param CreateBookingReq body models.CreateBookingReq true "createModel (will_arrive as YYYY-MM-DD HH:MM)"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.BookingRes
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants [post]
+func (h *HandlerV1) URBCreate(c *gin.Context) {
+	h.createBooking(c, "URBCreate", bookingRestaurant)
+}
+
+// Create Attraction Booking
+// @Summary Create Attraction Booking
+// @Security BearerAuth
+// @Description Api for Create Attraction Booking
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param CreateBookingReq body models.CreateBookingReq true "createModel"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.BookingRes
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions [post]
+func (h *HandlerV1) UABCreate(c *gin.Context) {
+	h.createBooking(c, "UABCreate", bookingAttraction)
+}
+
+// Get All Hotels By User Id
+// @Summary Get All Hotels By User Id
+// @Security BearerAuth
+// @Description Api for Get All Hotels By User Id
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param id query models.IdReq true "id"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.IdRes
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id} [get]
+func (h *HandlerV1) UHBGetAllByUId(c *gin.Context) {
+	h.bookedEstablishments(c, "UHBGetAllByUId", bookingHotel)
+}
+
+// Get All Restaurants By User Id
+// @Summary Get All Restaurants By User Id
+// @Security BearerAuth
+// @Description Api for Get All Restaurants By User Id
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id query models.IdReq true "id"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.IdRes
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants/{id} [get]
+func (h *HandlerV1) URBGetAllByUId(c *gin.Context) {
+	h.bookedEstablishments(c, "URBGetAllByUId", bookingRestaurant)
+}
+
+// Get All Attractions By User Id
+// @Summary Get All Attractions By User Id
+// @Security BearerAuth
+// @Description Api for Get All Attractions By User Id
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id query models.IdReq true "id"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.IdRes
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions/{id} [get]
+func (h *HandlerV1) UABGetAllByUId(c *gin.Context) {
+	h.bookedEstablishments(c, "UABGetAllByUId", bookingAttraction)
+}
+
+// Get All Users By Room Id
+// @Summary Get All Users By Room Id
+// @Security BearerAuth
+// @Description Api for Get All Users By Room Id. Use /v1/owner/bookings instead, it lists the bookings of all rooms of the hotels of the owner with their guests
+// @Tags BOOKING_HOTEL
+// @Deprecated
+// @Accept json
+// @Produce json
+// @Param id path string true "ID"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.IdRes
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/users/room/{id} [get]
+func (h *HandlerV1) UHBGetAllByHId(c *gin.Context) {
+	h.bookedUsers(c, "UHBGetAllByHId", bookingHotel)
+}
+
+// Get All Users By Restaurant Id
+// @Summary Get All Users By Restaurant Id
+// @Security BearerAuth
+// @Description Api for Get All Users By Restaurant Id
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id path string true "ID"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.IdRes
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/users/restaurant/{id} [get]
+func (h *HandlerV1) URBGetAllByRId(c *gin.Context) {
+	h.bookedUsers(c, "URBGetAllByRId", bookingRestaurant)
+}
+
+// Get All Users By Attraction Id
+// @Summary Get All Users By Attraction Id
+// @Security BearerAuth
+// @Description Api for Get All Users By Attraction Id
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id path string true "ID"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.IdRes
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/users/attraction/{id} [get]
+func (h *HandlerV1) UABGetAllByAId(c *gin.Context) {
+	h.bookedUsers(c, "UABGetAllByAId", bookingAttraction)
+}
+
+// List Hotels
+// @Summary List Hotels
+// @Security BearerAuth
+// @Description Api for List Hotels
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.List
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels [get]
+func (h *HandlerV1) UHBList(c *gin.Context) {
+	response, ok := h.listBookings(c, "UHBList", bookingHotel, h.Service.BookingService().BookingList)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_hotel": response.Bookings,
+		"count":      response.Count,
+	})
+}
+
+// List Restaurants
+// @Summary List Restaurants
+// @Security BearerAuth
+// @Description Api for List Restaurants
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.List
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants [get]
+func (h *HandlerV1) URBList(c *gin.Context) {
+	response, ok := h.listBookings(c, "URBList", bookingRestaurant, h.Service.BookingService().BookingList)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_restaurant": response.Bookings,
+		"count":           response.Count,
+	})
+}
+
+// List Attractions
+// @Summary List Attractions
+// @Security BearerAuth
+// @Description Api for List Attractions
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.List
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions [get]
+func (h *HandlerV1) UABList(c *gin.Context) {
+	response, ok := h.listBookings(c, "UABList", bookingAttraction, h.Service.BookingService().BookingList)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_attraction": response.Bookings,
+		"count":           response.Count,
+	})
+}
+
+// List Deleted Hotels
+// @Summary List Deleted Hotels
+// @Security BearerAuth
+// @Description Api for List Deleted Hotels
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.List
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/deleted [get]
+func (h *HandlerV1) UHBListDeleted(c *gin.Context) {
+	response, ok := h.listBookings(c, "UHBListDeleted", bookingHotel, h.Service.BookingService().BookingListDeleted)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_hotel": response.Bookings,
+		"count":      response.Count,
+	})
+}
+
+// List Deleted Restaurants
+// @Summary List Deleted Restaurants
+// @Security BearerAuth
+// @Description Api for List Deleted Restaurants
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param irequest query models.Pagination true "request"
+// @Success 200 {object} models.List
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants/deleted [get]
+func (h *HandlerV1) URBListDeleted(c *gin.Context) {
+	response, ok := h.listBookings(c, "URBListDeleted", bookingRestaurant, h.Service.BookingService().BookingListDeleted)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_restaurant": response.Bookings,
+		"count":           response.Count,
+	})
+}
+
+// List Deleted Attractions
+// @Summary List Deleted Attractions
+// @Security BearerAuth
+// @Description Api for List Deleted Attractions
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.List
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions/deleted [get]
+func (h *HandlerV1) UABListDeleted(c *gin.Context) {
+	response, ok := h.listBookings(c, "UABListDeleted", bookingAttraction, h.Service.BookingService().BookingListDeleted)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_attraction": response.Bookings,
+		"count":           response.Count,
+	})
+}
+
+// Update Booked Hotel
+// @Summary Update Booked Hotel
+// @Security BearerAuth
+// @Description Api for Update Booked Hotel
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param models.UpdateBookingReq body models.UpdateBookingReq true "createModel"
+// @Success 200 {object} models.BookingRes
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels [put]
+func (h *HandlerV1) UHBUpdate(c *gin.Context) {
+	h.updateBooking(c, "UHBUpdate", bookingHotel)
+}
+
+// Update Booked Restaurant
+// @Summary Update Booked Restaurant
+// @Security BearerAuth
+// @Description Api for Update Booked Restaurant
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param models.UpdateBookingReq body models.UpdateBookingReq true "createModel"
+// @Success 200 {object} models.BookingRes
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants [put]
+func (h *HandlerV1) URBUpdate(c *gin.Context) {
+	h.updateBooking(c, "URBUpdate", bookingRestaurant)
+}
+
+// Update Booked Attraction
+// @Summary Update Booked Attraction
+// @Security BearerAuth
+// @Description Api for Update Booked Attraction
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param models.UpdateBookingReq body models.UpdateBookingReq true "createModel"
+// @Success 200 {object} models.BookingRes
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions [put]
+func (h *HandlerV1) UABUpdate(c *gin.Context) {
+	h.updateBooking(c, "UABUpdate", bookingAttraction)
+}
+
+// Delete Hotel
+// @Summary Delete Hotel
+// @Security BearerAuth
+// @Description Api for Delete Hotel
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param id query string true "ID"
+// @Success 200 {object} models.StandartError
+// @Failure 400 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id} [delete]
+func (h *HandlerV1) UHBDelete(c *gin.Context) {
+	h.deleteBooking(c, "UHBDelete", bookingHotel, c.Query("id"))
+}
+
+// Delete Restaurant
+// @Summary Delete Restaurant
+// @Security BearerAuth
+// @Description Api for Delete Restaurant
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id query string true "ID"
+// @Success 200 {object} models.StandartError
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants/{id} [delete]
+func (h *HandlerV1) URBDelete(c *gin.Context) {
+	h.deleteBooking(c, "URBDelete", bookingRestaurant, c.Query("id"))
+}
+
+// Delete Attraction
+// @Summary Delete Attraction
+// @Security BearerAuth
+// @Description Api for Delete Attraction
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id query string true "ID"
+// @Success 200 {object} models.StandartError
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions/{id} [delete]
+func (h *HandlerV1) UABDelete(c *gin.Context) {
+	h.deleteBooking(c, "UABDelete", bookingAttraction, c.Query("id"))
+}
+
+// bookedEstablishments lists hotels, restaurants or attractions booked by the
+// user in the id query parameter, the caller by default
+func (h *HandlerV1) bookedEstablishments(c *gin.Context, spanName, bookingType string) {
+	ctx, span := otlp.Start(c, "api", spanName)
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.Pagination
+	if err := c.ShouldBindQuery(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		l.Error(err)
+		return
+	}
+
+	id := c.Query("id")
+	if id == "" {
+		userID, statusCode := GetIdFromToken(c.Request, h.Config)
+		if statusCode != http.StatusOK {
+			c.JSON(statusCode, gin.H{
+				"error": "Can't get user",
+			})
+			return
+		}
+		id = userID
+	}
+
+	response, err := h.Service.BookingService().BookingGetAllByUId(ctx, &pbb.ListReqById{
+		Limit:       uint64(body.Limit),
+		Offset:      uint64((body.Page - 1) * body.Limit),
+		Id:          &pbb.Id{Id: id},
+		BookingType: bookingType,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+		l.Error(err)
+		return
+	}
+
+	details := make([]interface{}, 0, len(response.Bookings))
+	for _, booking := range response.Bookings {
+		var (
+			detail interface{}
+			err    error
+		)
+		switch bookingType {
+		case bookingHotel:
+			var room *pbe.GetRoomResponse
+			room, err = h.Service.EstablishmentService().GetRoom(ctx, &pbe.GetRoomRequest{
+				RoomId: booking.HraId,
+			})
+			if err == nil {
+				var hotel *pbe.GetHotelResponse
+				hotel, err = h.Service.EstablishmentService().GetHotel(ctx, &pbe.GetHotelRequest{
+					HotelId: room.Room.HotelId,
+				})
+				if err == nil {
+					detail = hotel.Hotel
+				}
+			}
+		case bookingRestaurant:
+			var restaurant *pbe.GetRestaurantResponse
+			restaurant, err = h.Service.EstablishmentService().GetRestaurant(ctx, &pbe.GetRestaurantRequest{
+				RestaurantId: booking.HraId,
+			})
+			if err == nil {
+				detail = restaurant.Restaurant
+			}
+		case bookingAttraction:
+			var attraction *pbe.GetAttractionResponse
+			attraction, err = h.Service.EstablishmentService().GetAttraction(ctx, &pbe.GetAttractionRequest{
+				AttractionId: booking.HraId,
+			})
+			if err == nil {
+				detail = attraction.Attraction
+			}
+		}
+		if err != nil {
+			if errors.Is(err, fmt.Errorf("no rows in result set")) {
+				continue
+			}
+			c.JSON(http.StatusExpectationFailed, gin.H{
+				"error": fmt.Sprintf("Failed to retrieve %s details", bookingType),
+			})
+			l.Error(err)
+			return
+		}
+		details = append(details, detail)
+	}
+
+	c.JSON(http.StatusOK, details)
+}
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SET BOOKING MODE
+// @Summary SET BOOKING MODE
+// @Security BearerAuth
+// @Description Api for the owner of the establishment to choose how it takes bookings. In instant mode bookings are taken as soon as they fit, in request mode they stay pending until the owner accepts or declines them and are declined when nobody answered in time
+// @Tags BOOKING_MODE
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Param BookingMode body models.SetBookingMode true "instant or request"
+// @Success 200 {object} models.BookingModeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/booking-mode [PUT]
+// @Router /v1/restaurant/{id}/booking-mode [PUT]
+// @Router /v1/attraction/{id}/booking-mode [PUT]
+func (h HandlerV1) SetBookingMode(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "SetBookingMode")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.SetBookingMode
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	establishment_id := c.Param("id")
+
+	if err := h.establishmentExists(ctx, c.FullPath(), establishment_id); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "establishment not found",
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, establishment_id) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().SetBookingMode(ctx, &pbe.BookingMode{
+		EstablishmentId: establishment_id,
+		Mode:            body.Mode,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "mode must be instant or request",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, bookingModeModel(response))
+}
+
+// GET BOOKING MODE
+// @Summary GET BOOKING MODE
+// @Security BearerAuth
+// @Description Api for getting how the establishment takes bookings, establishments that never chose take them instantly
+// @Tags BOOKING_MODE
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Success 200 {object} models.BookingModeModel
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/booking-mode [GET]
+// @Router /v1/restaurant/{id}/booking-mode [GET]
+// @Router /v1/attraction/{id}/booking-mode [GET]
+func (h HandlerV1) GetBookingMode(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "GetBookingMode")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	response, err := h.Service.EstablishmentService().GetBookingMode(ctx, &pbe.GetBookingModeRequest{
+		EstablishmentId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, bookingModeModel(response))
+}
+
+func bookingModeModel(mode *pbe.BookingMode) *models.BookingModeModel {
+	return &models.BookingModeModel{
+		EstablishmentId: mode.EstablishmentId,
+		Mode:            mode.Mode,
+		UpdatedAt:       mode.UpdatedAt,
+	}
+}
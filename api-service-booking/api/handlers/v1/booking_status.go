@@ -0,0 +1,603 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// booking types as the booking service knows them
+const (
+	bookingHotel      = "hotel"
+	bookingRestaurant = "restaurant"
+	bookingAttraction = "attraction"
+)
+
+type statusRPC func(ctx context.Context, in *pbb.StatusReq, opts ...grpc.CallOption) (*pbb.StatusChange, error)
+
+// CONFIRM HOTEL BOOKING
+// @Summary CONFIRM HOTEL BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to confirm a pending booking
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id}/confirm [POST]
+func (h *HandlerV1) UHBConfirm(c *gin.Context) {
+	h.changeBookingStatus(c, "UHBConfirm", bookingHotel, h.Service.BookingService().Confirm, false)
+}
+
+// CANCEL HOTEL BOOKING
+// @Summary CANCEL HOTEL BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to cancel a pending or confirmed booking. Users can also cancel their own bookings and are then held to the cancellation policy, which sets the refund and rejects late cancellations with 409
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id}/cancel [POST]
+func (h *HandlerV1) UHBCancel(c *gin.Context) {
+	h.changeBookingStatus(c, "UHBCancel", bookingHotel, h.Service.BookingService().Cancel, true)
+}
+
+// CHECK IN HOTEL BOOKING
+// @Summary CHECK IN HOTEL BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to check in the guest of a confirmed booking
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id}/check-in [POST]
+func (h *HandlerV1) UHBCheckIn(c *gin.Context) {
+	h.changeBookingStatus(c, "UHBCheckIn", bookingHotel, h.Service.BookingService().CheckIn, false)
+}
+
+// ACCEPT HOTEL BOOKING
+// @Summary ACCEPT HOTEL BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of a hotel taking bookings on request to accept a booking that waits for approval. The booking then waits for the payment
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id}/accept [POST]
+func (h *HandlerV1) UHBAccept(c *gin.Context) {
+	h.changeBookingStatus(c, "UHBAccept", bookingHotel, h.Service.BookingService().Accept, false)
+}
+
+// DECLINE HOTEL BOOKING
+// @Summary DECLINE HOTEL BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of a hotel taking bookings on request to decline a booking that waits for approval, the booking is cancelled
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id}/decline [POST]
+func (h *HandlerV1) UHBDecline(c *gin.Context) {
+	h.changeBookingStatus(c, "UHBDecline", bookingHotel, h.Service.BookingService().Decline, false)
+}
+
+// HOTEL BOOKING STATUS HISTORY
+// @Summary HOTEL BOOKING STATUS HISTORY
+// @Security BearerAuth
+// @Description Api for getting the current status of a hotel booking and every change that led to it
+// @Tags BOOKING_HOTEL
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Success 200 {object} models.StatusHistoryModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id}/history [GET]
+func (h *HandlerV1) UHBHistory(c *gin.Context) {
+	h.bookingStatusHistory(c, "UHBHistory", bookingHotel)
+}
+
+// CONFIRM RESTAURANT BOOKING
+// @Summary CONFIRM RESTAURANT BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the restaurant to confirm a pending booking
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants/{id}/confirm [POST]
+func (h *HandlerV1) URBConfirm(c *gin.Context) {
+	h.changeBookingStatus(c, "URBConfirm", bookingRestaurant, h.Service.BookingService().Confirm, false)
+}
+
+// CANCEL RESTAURANT BOOKING
+// @Summary CANCEL RESTAURANT BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the restaurant to cancel a pending or confirmed booking. Users can also cancel their own bookings and are then held to the cancellation policy, which sets the refund and rejects late cancellations with 409
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants/{id}/cancel [POST]
+func (h *HandlerV1) URBCancel(c *gin.Context) {
+	h.changeBookingStatus(c, "URBCancel", bookingRestaurant, h.Service.BookingService().Cancel, true)
+}
+
+// CHECK IN RESTAURANT BOOKING
+// @Summary CHECK IN RESTAURANT BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the restaurant to check in the guest of a confirmed booking
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants/{id}/check-in [POST]
+func (h *HandlerV1) URBCheckIn(c *gin.Context) {
+	h.changeBookingStatus(c, "URBCheckIn", bookingRestaurant, h.Service.BookingService().CheckIn, false)
+}
+
+// ACCEPT RESTAURANT BOOKING
+// @Summary ACCEPT RESTAURANT BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of a restaurant taking bookings on request to accept a booking that waits for approval. The booking is confirmed
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants/{id}/accept [POST]
+func (h *HandlerV1) URBAccept(c *gin.Context) {
+	h.changeBookingStatus(c, "URBAccept", bookingRestaurant, h.Service.BookingService().Accept, false)
+}
+
+// DECLINE RESTAURANT BOOKING
+// @Summary DECLINE RESTAURANT BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of a restaurant taking bookings on request to decline a booking that waits for approval, the booking is cancelled
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants/{id}/decline [POST]
+func (h *HandlerV1) URBDecline(c *gin.Context) {
+	h.changeBookingStatus(c, "URBDecline", bookingRestaurant, h.Service.BookingService().Decline, false)
+}
+
+// RESTAURANT BOOKING STATUS HISTORY
+// @Summary RESTAURANT BOOKING STATUS HISTORY
+// @Security BearerAuth
+// @Description Api for getting the current status of a restaurant booking and every change that led to it
+// @Tags BOOKING_RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Success 200 {object} models.StatusHistoryModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/restaurants/{id}/history [GET]
+func (h *HandlerV1) URBHistory(c *gin.Context) {
+	h.bookingStatusHistory(c, "URBHistory", bookingRestaurant)
+}
+
+// CONFIRM ATTRACTION BOOKING
+// @Summary CONFIRM ATTRACTION BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the attraction to confirm a pending booking
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions/{id}/confirm [POST]
+func (h *HandlerV1) UABConfirm(c *gin.Context) {
+	h.changeBookingStatus(c, "UABConfirm", bookingAttraction, h.Service.BookingService().Confirm, false)
+}
+
+// CANCEL ATTRACTION BOOKING
+// @Summary CANCEL ATTRACTION BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the attraction to cancel a pending or confirmed booking. Users can also cancel their own bookings and are then held to the cancellation policy, which sets the refund and rejects late cancellations with 409
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions/{id}/cancel [POST]
+func (h *HandlerV1) UABCancel(c *gin.Context) {
+	h.changeBookingStatus(c, "UABCancel", bookingAttraction, h.Service.BookingService().Cancel, true)
+}
+
+// CHECK IN ATTRACTION BOOKING
+// @Summary CHECK IN ATTRACTION BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the attraction to check in the guest of a confirmed booking
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions/{id}/check-in [POST]
+func (h *HandlerV1) UABCheckIn(c *gin.Context) {
+	h.changeBookingStatus(c, "UABCheckIn", bookingAttraction, h.Service.BookingService().CheckIn, false)
+}
+
+// ACCEPT ATTRACTION BOOKING
+// @Summary ACCEPT ATTRACTION BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of a attraction taking bookings on request to accept a booking that waits for approval. The booking is confirmed
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions/{id}/accept [POST]
+func (h *HandlerV1) UABAccept(c *gin.Context) {
+	h.changeBookingStatus(c, "UABAccept", bookingAttraction, h.Service.BookingService().Accept, false)
+}
+
+// DECLINE ATTRACTION BOOKING
+// @Summary DECLINE ATTRACTION BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of a attraction taking bookings on request to decline a booking that waits for approval, the booking is cancelled
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions/{id}/decline [POST]
+func (h *HandlerV1) UABDecline(c *gin.Context) {
+	h.changeBookingStatus(c, "UABDecline", bookingAttraction, h.Service.BookingService().Decline, false)
+}
+
+// ATTRACTION BOOKING STATUS HISTORY
+// @Summary ATTRACTION BOOKING STATUS HISTORY
+// @Security BearerAuth
+// @Description Api for getting the current status of a attraction booking and every change that led to it
+// @Tags BOOKING_ATTRACTION
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Success 200 {object} models.StatusHistoryModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/attractions/{id}/history [GET]
+func (h *HandlerV1) UABHistory(c *gin.Context) {
+	h.bookingStatusHistory(c, "UABHistory", bookingAttraction)
+}
+
+// RESCHEDULE BOOKING
+// @Summary RESCHEDULE BOOKING
+// @Security BearerAuth
+// @Description Api for moving a pending or confirmed booking to new dates or changing its party size. The booking has to fit into what is left at the new dates, hotel stays are priced again. Users can reschedule only their own bookings and are held to the change rules of the cancellation policy, which set the change fee and reject late changes with 409
+// @Tags BOOKING
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param RescheduleReq body models.RescheduleReq true "rescheduleModel"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.StatusChangeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings/{id}/reschedule [POST]
+func (h *HandlerV1) RescheduleBooking(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "RescheduleBooking")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.RescheduleReq
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to bind json", l.Error(err))
+		return
+	}
+	if !validBookingType(c, body.BookingType) {
+		return
+	}
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+	ownerID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().Reschedule(ctx, &pbb.RescheduleReq{
+		BookingId:      c.Param("id"),
+		BookingType:    body.BookingType,
+		WillArrive:     body.WillArrive,
+		WillLeave:      body.WillLeave,
+		NumberOfPeople: body.NumberOfPeople,
+		AdultTickets:   body.AdultTickets,
+		ChildTickets:   body.ChildTickets,
+		Reason:         body.Reason,
+		ChangedBy:      userID,
+		UserId:         ownerID,
+	})
+	if err != nil {
+		h.bookingStatusError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, statusChangeModel(response))
+}
+
+// changeBookingStatus moves the booking in the path with rpc, only the owner
+// of the establishment it was made at may move it. With ownOnly set users may
+// also move their own bookings, and are then held to what rpc allows guests.
+func (h *HandlerV1) changeBookingStatus(c *gin.Context, spanName, bookingType string, rpc statusRPC, ownOnly bool) {
+	ctx, span := otlp.Start(c, "api", spanName)
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.StatusReq
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Not true form of request",
+			})
+			h.Logger.Error("failed to bind json", l.Error(err))
+			return
+		}
+	}
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	req := &pbb.StatusReq{
+		BookingId:   c.Param("id"),
+		BookingType: bookingType,
+		Reason:      body.Reason,
+		ChangedBy:   userID,
+	}
+	var ownerFilter string
+	if ownOnly {
+		ownerFilter, statusCode = GetOwnerFilterFromToken(c.Request, h.Config)
+		if statusCode != http.StatusOK {
+			c.JSON(statusCode, gin.H{
+				"error": "Can't get user",
+			})
+			return
+		}
+	}
+	if ownerFilter != "" {
+		req.UserId = ownerFilter
+	} else {
+		booking, err := h.Service.BookingService().BookingGet(ctx, &pbb.BookingId{
+			Id:          req.BookingId,
+			BookingType: bookingType,
+		})
+		if err != nil {
+			h.bookingStatusError(c, err)
+			return
+		}
+		switch {
+		case ownOnly && booking.UserId == userID:
+			req.UserId = userID
+		case !h.callerOwnsBooking(ctx, c, booking):
+			return
+		}
+	}
+
+	response, err := rpc(ctx, req)
+	if err != nil {
+		h.bookingStatusError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, statusChangeModel(response))
+}
+
+func (h *HandlerV1) bookingStatusHistory(c *gin.Context, spanName, bookingType string) {
+	ctx, span := otlp.Start(c, "api", spanName)
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	userID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().StatusHistory(ctx, &pbb.StatusHistoryReq{
+		BookingId:   c.Param("id"),
+		BookingType: bookingType,
+		UserId:      userID,
+	})
+	if err != nil {
+		h.bookingStatusError(c, err)
+		return
+	}
+
+	res := &models.StatusHistoryModel{
+		Status:  response.Status,
+		Changes: []*models.StatusChangeModel{},
+	}
+	for _, change := range response.Changes {
+		res.Changes = append(res.Changes, statusChangeModel(change))
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *HandlerV1) bookingStatusError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Booking not found",
+		})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+	}
+	h.Logger.Error("booking status request failed", l.Error(err))
+}
+
+func statusChangeModel(change *pbb.StatusChange) *models.StatusChangeModel {
+	return &models.StatusChangeModel{
+		Id:            change.Id,
+		BookingId:     change.BookingId,
+		BookingType:   change.BookingType,
+		FromStatus:    change.FromStatus,
+		ToStatus:      change.ToStatus,
+		Reason:        change.Reason,
+		ChangedBy:     change.ChangedBy,
+		CreatedAt:     change.CreatedAt,
+		RefundPercent: change.RefundPercent,
+		RefundAmount:  change.RefundAmount,
+		Currency:      change.Currency,
+
+		FromWillArrive:  change.FromWillArrive,
+		FromWillLeave:   change.FromWillLeave,
+		ToWillArrive:    change.ToWillArrive,
+		ToWillLeave:     change.ToWillLeave,
+		PriceDifference: change.PriceDifference,
+		ChangeFee:       change.ChangeFee,
+	}
+}
@@ -0,0 +1,411 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	"Booking/api-service-booking/internal/pkg/ical"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	calendarProdId = "-//Booking//Bookings//EN"
+
+	// establishment feeds leave out stays that ended longer ago than this
+	calendarFeedHistory = 30 * 24 * time.Hour
+)
+
+// calendarPlace is what a calendar event tells about the establishment
+type calendarPlace struct {
+	name    string
+	address string
+}
+
+// BOOKING CALENDAR
+// @Summary BOOKING CALENDAR
+// @Security BearerAuth
+// @Description Api for downloading the booking as an iCalendar (.ics) event. Hotel stays are all-day events ending on the check-out day, restaurant and attraction bookings start at the reserved time
+// @Tags CALENDAR
+// @Produce text/calendar
+// @Param id path string true "booking_id"
+// @Success 200 {string} string "text/calendar"
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings/{id}/calendar.ics [GET]
+func (h *HandlerV1) BookingCalendar(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "BookingCalendar")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	booking, ok := h.ownBooking(ctx, c, "", c.Param("id"))
+	if !ok {
+		return
+	}
+
+	calendar := &ical.Calendar{
+		ProdId: calendarProdId,
+	}
+	places := map[string]*calendarPlace{}
+	if event := h.bookingEvent(booking, h.bookingPlace(ctx, booking, places)); event != nil {
+		calendar.Events = append(calendar.Events, event)
+	}
+
+	writeCalendar(c, "booking-"+booking.Id, calendar)
+}
+
+// USER BOOKINGS CALENDAR
+// @Summary USER BOOKINGS CALENDAR
+// @Security BearerAuth
+// @Description Api for downloading every booking of the user as one iCalendar (.ics) file. Holds are left out until they are confirmed
+// @Tags CALENDAR
+// @Produce text/calendar
+// @Success 200 {string} string "text/calendar"
+// @Failure 401 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings/calendar.ics [GET]
+func (h *HandlerV1) UserBookingsCalendar(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "UserBookingsCalendar")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	// a zero limit lists all of them
+	response, err := h.Service.BookingService().BookingGetAllByUId(ctx, &pbb.ListReqById{
+		Id: &pbb.Id{Id: userID},
+	})
+	if err != nil {
+		h.bookingError(c, "", err)
+		return
+	}
+
+	calendar := &ical.Calendar{
+		ProdId: calendarProdId,
+		Name:   "My bookings",
+	}
+	places := map[string]*calendarPlace{}
+	for _, booking := range response.Bookings {
+		if booking.Status == "held" || booking.Status == "expired" {
+			continue
+		}
+		if event := h.bookingEvent(booking, h.bookingPlace(ctx, booking, places)); event != nil {
+			calendar.Events = append(calendar.Events, event)
+		}
+	}
+
+	writeCalendar(c, "bookings", calendar)
+}
+
+// ESTABLISHMENT CALENDAR FEED
+// @Summary ESTABLISHMENT CALENDAR FEED
+// @Security BearerAuth
+// @Description Api for getting the secret link of the establishment booking calendar. Calendar apps subscribed to the link see every booking of the establishment without signing in, so the link should only be shared with its staff
+// @Tags CALENDAR
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Success 200 {object} models.CalendarFeedRes
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/calendar-feed [GET]
+// @Router /v1/restaurant/{id}/calendar-feed [GET]
+// @Router /v1/attraction/{id}/calendar-feed [GET]
+func (h *HandlerV1) EstablishmentCalendarFeed(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "EstablishmentCalendarFeed")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	kind := establishmentKind(c.FullPath())
+	establishment_id := c.Param("id")
+
+	if err := h.establishmentExists(ctx, c.FullPath(), establishment_id); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "establishment not found",
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	baseURL := h.Config.Calendar.BaseURL
+	if baseURL == "" {
+		scheme := "http"
+		if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+			scheme = "https"
+		}
+		baseURL = scheme + "://" + c.Request.Host
+	}
+
+	c.JSON(http.StatusOK, models.CalendarFeedRes{
+		Url: fmt.Sprintf("%s/v1/%s/%s/calendar.ics?token=%s",
+			strings.TrimSuffix(baseURL, "/"), kind, establishment_id, h.calendarFeedToken(kind, establishment_id)),
+	})
+}
+
+// ESTABLISHMENT CALENDAR
+// @Summary ESTABLISHMENT CALENDAR
+// @Description Api for the iCalendar (.ics) feed of the establishment bookings from the last 30 days on, calendar apps subscribe to it with the link from calendar-feed
+// @Tags CALENDAR
+// @Produce text/calendar
+// @Param id path string true "establishment_id"
+// @Param token query string true "token of the calendar-feed link"
+// @Success 200 {string} string "text/calendar"
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/calendar.ics [GET]
+// @Router /v1/restaurant/{id}/calendar.ics [GET]
+// @Router /v1/attraction/{id}/calendar.ics [GET]
+func (h *HandlerV1) EstablishmentCalendar(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "EstablishmentCalendar")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	kind := establishmentKind(c.FullPath())
+	establishment_id := c.Param("id")
+
+	token := h.calendarFeedToken(kind, establishment_id)
+	if !hmac.Equal([]byte(c.Query("token")), []byte(token)) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Permission denied",
+		})
+		return
+	}
+
+	place, err := h.establishmentPlace(ctx, kind, establishment_id)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "establishment not found",
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Try Again Later...",
+			})
+		}
+		h.Logger.Error("failed to get establishment", l.Error(err))
+		return
+	}
+
+	response, err := h.Service.BookingService().BookingListByEstablishment(ctx, &pbb.EstablishmentBookingsReq{
+		BookingType:     kind,
+		EstablishmentId: establishment_id,
+		Since:           time.Now().Add(-calendarFeedHistory).Format("2006-01-02"),
+	})
+	if err != nil {
+		h.bookingError(c, kind, err)
+		return
+	}
+
+	calendar := &ical.Calendar{
+		ProdId: calendarProdId,
+		Name:   place.name,
+	}
+	for _, booking := range response.Bookings {
+		if event := h.bookingEvent(booking, place); event != nil {
+			calendar.Events = append(calendar.Events, event)
+		}
+	}
+
+	writeCalendar(c, kind+"-"+establishment_id, calendar)
+}
+
+// calendarFeedToken signs the establishment so feed links can not be guessed
+func (h *HandlerV1) calendarFeedToken(kind, establishment_id string) string {
+	mac := hmac.New(sha256.New, []byte(h.Config.Calendar.FeedSecret))
+	mac.Write([]byte(kind + ":" + establishment_id))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// bookingPlace looks the establishment of the booking up once per hra id, an
+// establishment that is gone leaves the event without a name
+func (h *HandlerV1) bookingPlace(ctx context.Context, booking *pbb.GeneralBook, places map[string]*calendarPlace) *calendarPlace {
+	if place, ok := places[booking.HraId]; ok {
+		return place
+	}
+
+	establishment_id := booking.HraId
+	if booking.BookingType == bookingHotel {
+		room, err := h.Service.EstablishmentService().GetRoom(ctx, &pbe.GetRoomRequest{
+			RoomId: booking.HraId,
+		})
+		if err == nil {
+			establishment_id = room.Room.HotelId
+		} else {
+			h.Logger.Error("failed to get room", l.Error(err))
+		}
+	}
+
+	place, err := h.establishmentPlace(ctx, booking.BookingType, establishment_id)
+	if err != nil {
+		h.Logger.Error("failed to get establishment", l.Error(err))
+		place = &calendarPlace{}
+	}
+	places[booking.HraId] = place
+
+	return place
+}
+
+// establishmentPlace looks up the name and address of the hotel, restaurant or attraction
+func (h *HandlerV1) establishmentPlace(ctx context.Context, kind, establishment_id string) (*calendarPlace, error) {
+	switch kind {
+	case bookingHotel:
+		response, err := h.Service.EstablishmentService().GetHotel(ctx, &pbe.GetHotelRequest{
+			HotelId: establishment_id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &calendarPlace{
+			name:    response.Hotel.GetHotelName(),
+			address: placeAddress(response.Hotel.GetLocation()),
+		}, nil
+	case bookingRestaurant:
+		response, err := h.Service.EstablishmentService().GetRestaurant(ctx, &pbe.GetRestaurantRequest{
+			RestaurantId: establishment_id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &calendarPlace{
+			name:    response.Restaurant.GetRestaurantName(),
+			address: placeAddress(response.Restaurant.GetLocation()),
+		}, nil
+	default:
+		response, err := h.Service.EstablishmentService().GetAttraction(ctx, &pbe.GetAttractionRequest{
+			AttractionId: establishment_id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &calendarPlace{
+			name:    response.Attraction.GetAttractionName(),
+			address: placeAddress(response.Attraction.GetLocation()),
+		}, nil
+	}
+}
+
+// bookingEvent turns the booking into a calendar event, a booking whose
+// dates can not be read is left out
+func (h *HandlerV1) bookingEvent(booking *pbb.GeneralBook, place *calendarPlace) *ical.Event {
+	event := &ical.Event{
+		UID:      booking.Id + "@booking",
+		Location: place.address,
+		Status:   eventStatus(booking.Status),
+		Stamp:    time.Now(),
+	}
+
+	layout := "2006-01-02 15:04"
+	if booking.BookingType == bookingHotel {
+		layout = "2006-01-02"
+		event.AllDay = true
+	}
+	var err error
+	event.Start, err = time.Parse(layout, booking.WillArrive)
+	if err == nil && booking.WillLeave != "" {
+		event.End, err = time.Parse(layout, booking.WillLeave)
+	}
+	if err != nil {
+		h.Logger.Error("failed to read booking dates", l.Error(err))
+		return nil
+	}
+
+	switch booking.BookingType {
+	case bookingHotel:
+		event.Summary = "Stay"
+		if place.name != "" {
+			event.Summary = "Stay at " + place.name
+		}
+	case bookingRestaurant:
+		event.Summary = "Table reservation"
+		if place.name != "" {
+			event.Summary = "Table at " + place.name
+		}
+	default:
+		event.Summary = "Attraction visit"
+		if place.name != "" {
+			event.Summary = "Visit to " + place.name
+		}
+	}
+
+	description := []string{
+		"Booking " + booking.Id,
+		"Status: " + booking.Status,
+	}
+	if booking.BookingType == bookingAttraction {
+		description = append(description, fmt.Sprintf("Tickets: %d adult, %d child", booking.AdultTickets, booking.ChildTickets))
+	} else {
+		description = append(description, fmt.Sprintf("Guests: %d", booking.NumberOfPeople))
+	}
+	if booking.Reason != "" {
+		description = append(description, booking.Reason)
+	}
+	event.Description = strings.Join(description, "\n")
+
+	return event
+}
+
+// eventStatus maps a booking status to the status of its calendar event
+func eventStatus(bookingStatus string) string {
+	switch bookingStatus {
+	case "pending":
+		return ical.StatusTentative
+	case "cancelled", "no_show":
+		return ical.StatusCancelled
+	default:
+		return ical.StatusConfirmed
+	}
+}
+
+// establishmentKind is the booking type of the establishment named in the route
+func establishmentKind(route string) string {
+	switch {
+	case strings.HasPrefix(route, "/v1/hotel/"):
+		return bookingHotel
+	case strings.HasPrefix(route, "/v1/restaurant/"):
+		return bookingRestaurant
+	default:
+		return bookingAttraction
+	}
+}
+
+func placeAddress(location *pbe.Location) string {
+	var parts []string
+	for _, part := range []string{location.GetAddress(), location.GetCity(), location.GetCountry()} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func writeCalendar(c *gin.Context, filename string, calendar *ical.Calendar) {
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".ics"))
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", calendar.Bytes())
+}
@@ -0,0 +1,408 @@
+package v1
+
+import (
+	apierrors "Booking/api-service-booking/api/errors"
+	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploaded calendars larger than this are rejected
+const maxCalendarUpload = 5 << 20
+
+// ADD EXTERNAL CALENDAR
+// @Summary ADD EXTERNAL CALENDAR
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to sync the room with the iCalendar link of another platform it is listed on. Every event of the calendar takes one room for its nights so the room is not booked twice. The calendar is fetched right away and then periodically, a failed fetch shows up as last_error and keeps the blocks of the previous sync
+// @Tags EXTERNAL_CALENDAR
+// @Accept json
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param room_id path string true "room_id"
+// @Param AddCalendarReq body models.AddCalendarReq true "calendar"
+// @Success 201 {object} models.CalendarSourceModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms/{room_id}/calendars [POST]
+func (h *HandlerV1) AddExternalCalendar(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "AddExternalCalendar")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.AddCalendarReq
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to bind json", l.Error(err))
+		return
+	}
+
+	if !h.roomBelongsToHotel(c, c.Param("room_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.BookingService().CalendarSourceCreate(ctx, &pbb.CalendarSource{
+		RoomId: c.Param("room_id"),
+		Name:   body.Name,
+		Url:    body.Url,
+	})
+	if err != nil {
+		h.calendarSyncError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, calendarSourceModel(response))
+}
+
+// UPLOAD EXTERNAL CALENDAR
+// @Summary UPLOAD EXTERNAL CALENDAR
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to import an iCalendar (.ics) file exported from another platform the room is listed on. The file is imported once, uploading it again to the sync api replaces its blocks
+// @Tags EXTERNAL_CALENDAR
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param room_id path string true "room_id"
+// @Param name formData string false "name of the other platform"
+// @Param file formData file true "iCalendar file"
+// @Success 201 {object} models.CalendarSourceModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms/{room_id}/calendars/upload [POST]
+func (h *HandlerV1) UploadExternalCalendar(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "UploadExternalCalendar")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	content, ok := h.calendarFile(c, true)
+	if !ok {
+		return
+	}
+
+	if !h.roomBelongsToHotel(c, c.Param("room_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.BookingService().CalendarSourceCreate(ctx, &pbb.CalendarSource{
+		RoomId:  c.Param("room_id"),
+		Name:    c.PostForm("name"),
+		Content: content,
+	})
+	if err != nil {
+		h.calendarSyncError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, calendarSourceModel(response))
+}
+
+// LIST EXTERNAL CALENDARS
+// @Summary LIST EXTERNAL CALENDARS
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to list the calendars of other platforms the room is synced with, when they last synced and how many blocks they hold
+// @Tags EXTERNAL_CALENDAR
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param room_id path string true "room_id"
+// @Success 200 {object} []models.CalendarSourceModel
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms/{room_id}/calendars [GET]
+func (h *HandlerV1) ListExternalCalendars(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListExternalCalendars")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	if !h.roomBelongsToHotel(c, c.Param("room_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.BookingService().CalendarSourceList(ctx, &pbb.CalendarSourceListReq{
+		RoomId: c.Param("room_id"),
+	})
+	if err != nil {
+		h.calendarSyncError(c, err)
+		return
+	}
+
+	sources := []*models.CalendarSourceModel{}
+	for _, source := range response.Sources {
+		sources = append(sources, calendarSourceModel(source))
+	}
+	c.JSON(http.StatusOK, sources)
+}
+
+// SYNC EXTERNAL CALENDAR
+// @Summary SYNC EXTERNAL CALENDAR
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to sync a calendar of another platform now instead of waiting for the periodic sync. An uploaded calendar is replaced by the file sent along
+// @Tags EXTERNAL_CALENDAR
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param room_id path string true "room_id"
+// @Param calendar_id path string true "calendar_id"
+// @Param file formData file false "iCalendar file, only for uploaded calendars"
+// @Success 200 {object} models.CalendarSourceModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms/{room_id}/calendars/{calendar_id}/sync [POST]
+func (h *HandlerV1) SyncExternalCalendar(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "SyncExternalCalendar")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	content, ok := h.calendarFile(c, false)
+	if !ok {
+		return
+	}
+
+	if !h.roomCalendar(c) {
+		return
+	}
+
+	response, err := h.Service.BookingService().CalendarSourceSync(ctx, &pbb.CalendarSourceReq{
+		Id:      c.Param("calendar_id"),
+		Content: content,
+	})
+	if err != nil {
+		h.calendarSyncError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, calendarSourceModel(response))
+}
+
+// REMOVE EXTERNAL CALENDAR
+// @Summary REMOVE EXTERNAL CALENDAR
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to stop syncing the room with a calendar of another platform, its blocks are removed with it
+// @Tags EXTERNAL_CALENDAR
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param room_id path string true "room_id"
+// @Param calendar_id path string true "calendar_id"
+// @Success 200 {object} models.DeleteResponse
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms/{room_id}/calendars/{calendar_id} [DELETE]
+func (h *HandlerV1) RemoveExternalCalendar(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "RemoveExternalCalendar")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	if !h.roomCalendar(c) {
+		return
+	}
+
+	_, err := h.Service.BookingService().CalendarSourceDelete(ctx, &pbb.CalendarSourceReq{
+		Id: c.Param("calendar_id"),
+	})
+	if err != nil {
+		h.calendarSyncError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.DeleteResponse{
+		Success: true,
+	})
+}
+
+// LIST EXTERNAL BLOCKS
+// @Summary LIST EXTERNAL BLOCKS
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to list the nights the room is taken on other platforms, each block takes one room from starts_on up to ends_on (the check-out day)
+// @Tags EXTERNAL_CALENDAR
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param room_id path string true "room_id"
+// @Param from query string false "YYYY-MM-DD"
+// @Param to query string false "YYYY-MM-DD"
+// @Success 200 {object} []models.ExternalBlockModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms/{room_id}/blocks [GET]
+func (h *HandlerV1) ListExternalBlocks(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListExternalBlocks")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	if !h.roomBelongsToHotel(c, c.Param("room_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.BookingService().ExternalBlockList(ctx, &pbb.ExternalBlockListReq{
+		RoomId: c.Param("room_id"),
+		From:   c.Query("from"),
+		To:     c.Query("to"),
+	})
+	if err != nil {
+		h.calendarSyncError(c, err)
+		return
+	}
+
+	blocks := []*models.ExternalBlockModel{}
+	for _, block := range response.Blocks {
+		blocks = append(blocks, &models.ExternalBlockModel{
+			Id:       block.Id,
+			SourceId: block.SourceId,
+			Uid:      block.Uid,
+			Summary:  block.Summary,
+			StartsOn: block.StartsOn,
+			EndsOn:   block.EndsOn,
+		})
+	}
+	c.JSON(http.StatusOK, blocks)
+}
+
+// calendarFile reads the uploaded iCalendar file, a missing optional file is
+// empty content
+func (h *HandlerV1) calendarFile(c *gin.Context, required bool) (string, bool) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		if !required && err == http.ErrMissingFile {
+			return "", true
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "an iCalendar file is required",
+		})
+		return "", false
+	}
+	if header.Size > maxCalendarUpload {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "File size cannot be larger than 5 MB",
+		})
+		return "", false
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to open uploaded calendar", l.Error(err))
+		return "", false
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxCalendarUpload))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to read uploaded calendar", l.Error(err))
+		return "", false
+	}
+
+	return string(content), true
+}
+
+// roomCalendar writes the error response and returns false unless the caller
+// owns the hotel in the path and the calendar in the path is synced with its room
+func (h *HandlerV1) roomCalendar(c *gin.Context) bool {
+	if !h.roomBelongsToHotel(c, c.Param("room_id"), c.Param("id")) {
+		return false
+	}
+
+	if !h.callerOwnsEstablishment(c.Request.Context(), c, c.Param("id")) {
+		return false
+	}
+
+	response, err := h.Service.BookingService().CalendarSourceList(c.Request.Context(), &pbb.CalendarSourceListReq{
+		RoomId: c.Param("room_id"),
+	})
+	if err != nil {
+		h.calendarSyncError(c, err)
+		return false
+	}
+	for _, source := range response.Sources {
+		if source.Id == c.Param("calendar_id") {
+			return true
+		}
+	}
+
+	c.JSON(http.StatusNotFound, gin.H{
+		"error": "calendar not found",
+	})
+	return false
+}
+
+func (h *HandlerV1) calendarSyncError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":  "Invalid calendar",
+			"errors": apierrors.ErrorDetails(status.Convert(err)),
+		})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "calendar not found",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+	}
+	h.Logger.Error("external calendar request failed", l.Error(err))
+}
+
+func calendarSourceModel(source *pbb.CalendarSource) *models.CalendarSourceModel {
+	return &models.CalendarSourceModel{
+		Id:           source.Id,
+		RoomId:       source.RoomId,
+		Name:         source.Name,
+		Url:          source.Url,
+		LastSyncedAt: source.LastSyncedAt,
+		LastError:    source.LastError,
+		Blocks:       source.Blocks,
+		CreatedAt:    source.CreatedAt,
+		UpdatedAt:    source.UpdatedAt,
+	}
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	pbu "Booking/api-service-booking/genproto/user-proto"
+	"Booking/api-service-booking/internal/pkg/config"
+)
+
+// notFoundServices answers every establishment lookup with NotFound, so a
+// request that gets past the token check ends in 404
+type notFoundServices struct{}
+
+func (notFoundServices) EstablishmentService() pbe.EstablishmentServiceClient {
+	return notFoundEstablishments{}
+}
+func (notFoundServices) UserService() pbu.UserServiceClient       { return nil }
+func (notFoundServices) BookingService() pbb.BookingServiceClient { return nil }
+func (notFoundServices) Close()                                   {}
+
+type notFoundEstablishments struct {
+	pbe.EstablishmentServiceClient
+}
+
+func (notFoundEstablishments) GetHotel(ctx context.Context, in *pbe.GetHotelRequest, opts ...grpc.CallOption) (*pbe.GetHotelResponse, error) {
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (notFoundEstablishments) GetRestaurant(ctx context.Context, in *pbe.GetRestaurantRequest, opts ...grpc.CallOption) (*pbe.GetRestaurantResponse, error) {
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (notFoundEstablishments) GetAttraction(ctx context.Context, in *pbe.GetAttractionRequest, opts ...grpc.CallOption) (*pbe.GetAttractionResponse, error) {
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func TestEstablishmentCalendarToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cfg := &config.Config{}
+	cfg.Calendar.FeedSecret = "feed_secret"
+	h := &HandlerV1{
+		Config:  cfg,
+		Logger:  zap.NewNop(),
+		Service: notFoundServices{},
+	}
+
+	router := gin.New()
+	router.GET("/v1/hotel/:id/calendar.ics", h.EstablishmentCalendar)
+	router.GET("/v1/restaurant/:id/calendar.ics", h.EstablishmentCalendar)
+	router.GET("/v1/attraction/:id/calendar.ics", h.EstablishmentCalendar)
+
+	other := &HandlerV1{Config: &config.Config{}}
+	other.Config.Calendar.FeedSecret = "other_secret"
+
+	tests := []struct {
+		name  string
+		path  string
+		token string
+		want  int
+	}{
+		{
+			name:  "hotel token",
+			path:  "/v1/hotel/est-1/calendar.ics",
+			token: h.calendarFeedToken(bookingHotel, "est-1"),
+			want:  http.StatusNotFound,
+		},
+		{
+			name:  "restaurant token",
+			path:  "/v1/restaurant/est-1/calendar.ics",
+			token: h.calendarFeedToken(bookingRestaurant, "est-1"),
+			want:  http.StatusNotFound,
+		},
+		{
+			name:  "attraction token",
+			path:  "/v1/attraction/est-1/calendar.ics",
+			token: h.calendarFeedToken(bookingAttraction, "est-1"),
+			want:  http.StatusNotFound,
+		},
+		{
+			name: "no token",
+			path: "/v1/hotel/est-1/calendar.ics",
+			want: http.StatusForbidden,
+		},
+		{
+			name:  "token of another establishment",
+			path:  "/v1/hotel/est-1/calendar.ics",
+			token: h.calendarFeedToken(bookingHotel, "est-2"),
+			want:  http.StatusForbidden,
+		},
+		{
+			name:  "token of another kind",
+			path:  "/v1/restaurant/est-1/calendar.ics",
+			token: h.calendarFeedToken(bookingHotel, "est-1"),
+			want:  http.StatusForbidden,
+		},
+		{
+			name:  "token of another secret",
+			path:  "/v1/hotel/est-1/calendar.ics",
+			token: other.calendarFeedToken(bookingHotel, "est-1"),
+			want:  http.StatusForbidden,
+		},
+		{
+			name:  "truncated token",
+			path:  "/v1/hotel/est-1/calendar.ics",
+			token: h.calendarFeedToken(bookingHotel, "est-1")[:32],
+			want:  http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := tt.path
+			if tt.token != "" {
+				target += "?token=" + url.QueryEscape(tt.token)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SET CANCELLATION POLICY
+// @Summary SET CANCELLATION POLICY
+// @Security BearerAuth
+// @Description Api for replacing the cancellation policy of the establishment. Guests cancelling at least hours_before hours before arrival get refund_percent of the price back, the rule with the latest deadline that was not missed applies and cancellations after every deadline are rejected.
+// @Tags CANCELLATION_POLICY
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Param CancellationPolicy body models.SetCancellationPolicy true "CancellationPolicy"
+// @Success 200 {object} models.CancellationPolicyModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/cancellation-policy [PUT]
+// @Router /v1/restaurant/{id}/cancellation-policy [PUT]
+// @Router /v1/attraction/{id}/cancellation-policy [PUT]
+func (h HandlerV1) SetCancellationPolicy(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "SetCancellationPolicy")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.SetCancellationPolicy
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	establishment_id := c.Param("id")
+
+	// the establishment must exist before a policy can be attached to it
+	if err := h.establishmentExists(ctx, c.FullPath(), establishment_id); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "establishment not found",
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, establishment_id) {
+		return
+	}
+
+	request := &pbe.CancellationPolicy{
+		EstablishmentId: establishment_id,
+	}
+	for _, rule := range body.Rules {
+		request.Rules = append(request.Rules, &pbe.CancellationRule{
+			HoursBefore:      rule.HoursBefore,
+			RefundPercent:    rule.RefundPercent,
+			ChangeFeePercent: rule.ChangeFeePercent,
+		})
+	}
+
+	response, err := h.Service.EstablishmentService().SetCancellationPolicy(ctx, request)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "rules need unique non-negative hours_before, refund_percent and change_fee_percent between 0 and 100",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, cancellationPolicyModel(response))
+}
+
+// GET CANCELLATION POLICY
+// @Summary GET CANCELLATION POLICY
+// @Security BearerAuth
+// @Description Api for getting the cancellation policy of the establishment, establishments without a policy allow free cancellation until arrival
+// @Tags CANCELLATION_POLICY
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Success 200 {object} models.CancellationPolicyModel
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/cancellation-policy [GET]
+// @Router /v1/restaurant/{id}/cancellation-policy [GET]
+// @Router /v1/attraction/{id}/cancellation-policy [GET]
+func (h HandlerV1) GetCancellationPolicy(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "GetCancellationPolicy")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	response, err := h.Service.EstablishmentService().GetCancellationPolicy(ctx, &pbe.GetCancellationPolicyRequest{
+		EstablishmentId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, cancellationPolicyModel(response))
+}
+
+// DELETE CANCELLATION POLICY
+// @Summary DELETE CANCELLATION POLICY
+// @Security BearerAuth
+// @Description Api for deleting the cancellation policy of the establishment, bookings fall back to free cancellation until arrival
+// @Tags CANCELLATION_POLICY
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Success 200 {object} models.DeleteResponse
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/cancellation-policy [DELETE]
+// @Router /v1/restaurant/{id}/cancellation-policy [DELETE]
+// @Router /v1/attraction/{id}/cancellation-policy [DELETE]
+func (h HandlerV1) DeleteCancellationPolicy(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "DeleteCancellationPolicy")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().DeleteCancellationPolicy(ctx, &pbe.DeleteCancellationPolicyRequest{
+		EstablishmentId: c.Param("id"),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "cancellation policy not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, models.DeleteResponse{
+		Success: response.Success,
+	})
+}
+
+// establishmentExists looks the establishment up by the kind named in the route
+func (h HandlerV1) establishmentExists(ctx context.Context, route, establishment_id string) error {
+	var err error
+	switch {
+	case strings.HasPrefix(route, "/v1/hotel/"):
+		_, err = h.Service.EstablishmentService().GetHotel(ctx, &pbe.GetHotelRequest{
+			HotelId: establishment_id,
+		})
+	case strings.HasPrefix(route, "/v1/restaurant/"):
+		_, err = h.Service.EstablishmentService().GetRestaurant(ctx, &pbe.GetRestaurantRequest{
+			RestaurantId: establishment_id,
+		})
+	default:
+		_, err = h.Service.EstablishmentService().GetAttraction(ctx, &pbe.GetAttractionRequest{
+			AttractionId: establishment_id,
+		})
+	}
+	return err
+}
+
+func cancellationPolicyModel(policy *pbe.CancellationPolicy) *models.CancellationPolicyModel {
+	response := &models.CancellationPolicyModel{
+		EstablishmentId: policy.EstablishmentId,
+		Rules:           []*models.CancellationRuleModel{},
+		UpdatedAt:       policy.UpdatedAt,
+	}
+	for _, rule := range policy.Rules {
+		response.Rules = append(response.Rules, &models.CancellationRuleModel{
+			HoursBefore:      rule.HoursBefore,
+			RefundPercent:    rule.RefundPercent,
+			ChangeFeePercent: rule.ChangeFeePercent,
+		})
+	}
+
+	return response
+}
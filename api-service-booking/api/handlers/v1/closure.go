@@ -0,0 +1,284 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CREATE CLOSURE
+// @Summary CREATE CLOSURE
+// @Security BearerAuth
+// @Description Api for the owner of the establishment to close it for bookings. Without weekdays it is closed every day from start_date to end_date, with weekdays such as "monday" only on those days of the week, between start_date and end_date when they are given. Dates are in YYYY-MM-DD format and both ends are included. Bookings on closed days are rejected
+// @Tags CLOSURE
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Param Closure body models.CreateClosure true "Closure"
+// @Success 201 {object} models.ClosureModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/closures [POST]
+// @Router /v1/restaurant/{id}/closures [POST]
+// @Router /v1/attraction/{id}/closures [POST]
+func (h HandlerV1) CreateClosure(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "CreateClosure")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.CreateClosure
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	establishment_id := c.Param("id")
+
+	if err := h.establishmentExists(ctx, c.FullPath(), establishment_id); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "establishment not found",
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, establishment_id) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().CreateClosure(ctx, &pbe.Closure{
+		ClosureId:       uuid.New().String(),
+		EstablishmentId: establishment_id,
+		StartDate:       body.StartDate,
+		EndDate:         body.EndDate,
+		Weekdays:        body.Weekdays,
+		Reason:          body.Reason,
+	})
+	if err != nil {
+		h.closureError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, closureModel(response))
+}
+
+// LIST CLOSURES OF ESTABLISHMENT
+// @Summary LIST CLOSURES OF ESTABLISHMENT
+// @Security BearerAuth
+// @Description Api for listing the closure calendar of the establishment, the days it takes no bookings on
+// @Tags CLOSURE
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Success 200 {object} models.ListClosuresModel
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/closures [GET]
+// @Router /v1/restaurant/{id}/closures [GET]
+// @Router /v1/attraction/{id}/closures [GET]
+func (h HandlerV1) ListClosures(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListClosures")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	response, err := h.Service.EstablishmentService().ListClosures(ctx, &pbe.ListClosuresRequest{
+		EstablishmentId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	closures := []*models.ClosureModel{}
+	for _, closure := range response.Closures {
+		closures = append(closures, closureModel(closure))
+	}
+
+	c.JSON(http.StatusOK, models.ListClosuresModel{
+		Closures: closures,
+		Count:    response.Count,
+	})
+}
+
+// UPDATE CLOSURE
+// @Summary UPDATE CLOSURE
+// @Security BearerAuth
+// @Description Api for the owner of the establishment to change the dates, weekdays or reason of one of its closures, bookings made before are kept
+// @Tags CLOSURE
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Param closure_id path string true "closure_id"
+// @Param Closure body models.UpdateClosure true "Closure"
+// @Success 200 {object} models.ClosureModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/closures/{closure_id} [PUT]
+// @Router /v1/restaurant/{id}/closures/{closure_id} [PUT]
+// @Router /v1/attraction/{id}/closures/{closure_id} [PUT]
+func (h HandlerV1) UpdateClosure(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "UpdateClosure")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.UpdateClosure
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !h.closureBelongsToEstablishment(c, c.Param("closure_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().UpdateClosure(ctx, &pbe.UpdateClosureRequest{
+		Closure: &pbe.Closure{
+			ClosureId:       c.Param("closure_id"),
+			EstablishmentId: c.Param("id"),
+			StartDate:       body.StartDate,
+			EndDate:         body.EndDate,
+			Weekdays:        body.Weekdays,
+			Reason:          body.Reason,
+		},
+	})
+	if err != nil {
+		h.closureError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, closureModel(response.Closure))
+}
+
+// DELETE CLOSURE
+// @Summary DELETE CLOSURE
+// @Security BearerAuth
+// @Description Api for the owner of the establishment to remove one of its closures, its days can be booked again
+// @Tags CLOSURE
+// @Accept json
+// @Produce json
+// @Param id path string true "establishment_id"
+// @Param closure_id path string true "closure_id"
+// @Success 200 {object} models.DeleteResponse
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/closures/{closure_id} [DELETE]
+// @Router /v1/restaurant/{id}/closures/{closure_id} [DELETE]
+// @Router /v1/attraction/{id}/closures/{closure_id} [DELETE]
+func (h HandlerV1) DeleteClosure(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "DeleteClosure")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	if !h.closureBelongsToEstablishment(c, c.Param("closure_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().DeleteClosure(ctx, &pbe.DeleteClosureRequest{
+		ClosureId: c.Param("closure_id"),
+	})
+	if err != nil {
+		h.closureError(c, err)
+		return
+	}
+
+	if !response.Success {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "not deleted",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "successfuly deleted",
+	})
+}
+
+// closureBelongsToEstablishment writes a 404 response and returns false when
+// the closure is not in the calendar of the establishment
+func (h HandlerV1) closureBelongsToEstablishment(c *gin.Context, closure_id, establishment_id string) bool {
+	response, err := h.Service.EstablishmentService().ListClosures(c.Request.Context(), &pbe.ListClosuresRequest{
+		EstablishmentId: establishment_id,
+	})
+	if err == nil {
+		for _, closure := range response.Closures {
+			if closure.ClosureId == closure_id {
+				return true
+			}
+		}
+	}
+
+	c.JSON(http.StatusNotFound, gin.H{
+		"error": "closure not found",
+	})
+	return false
+}
+
+func (h HandlerV1) closureError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "closure not found",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+	}
+}
+
+func closureModel(closure *pbe.Closure) *models.ClosureModel {
+	weekdays := []string{}
+	weekdays = append(weekdays, closure.Weekdays...)
+
+	return &models.ClosureModel{
+		ClosureId:       closure.ClosureId,
+		EstablishmentId: closure.EstablishmentId,
+		StartDate:       closure.StartDate,
+		EndDate:         closure.EndDate,
+		Weekdays:        weekdays,
+		Reason:          closure.Reason,
+		CreatedAt:       closure.CreatedAt,
+		UpdatedAt:       closure.UpdatedAt,
+	}
+}
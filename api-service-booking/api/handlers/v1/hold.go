@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+)
+
+type holdRPC func(ctx context.Context, in *pbb.HoldReq, opts ...grpc.CallOption) (*pbb.GeneralBook, error)
+
+// CREATE HOLD
+// @Summary CREATE HOLD
+// @Security BearerAuth
+// @Description Api for holding a hotel room, a restaurant table or attraction tickets during checkout. The hold keeps its place like a booking until hold_expires_at and is released on its own unless it is confirmed before
+// @Tags HOLD
+// @Accept json
+// @Produce json
+// @Param CreateHoldReq body models.CreateHoldReq true "holdModel"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 201 {object} models.BookingRes
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/holds [POST]
+func (h *HandlerV1) CreateHold(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "CreateHold")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.CreateHoldReq
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to bind json", l.Error(err))
+		return
+	}
+	if !validBookingType(c, body.BookingType) {
+		return
+	}
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().HoldCreate(ctx, &pbb.GeneralBook{
+		Id:             uuid.NewString(),
+		BookingType:    body.BookingType,
+		UserId:         userID,
+		HraId:          body.HraId,
+		WillArrive:     body.WillArrive,
+		WillLeave:      body.WillLeave,
+		NumberOfPeople: body.NumberOfPeople,
+		Reason:         body.Reason,
+		AdultTickets:   body.AdultTickets,
+		ChildTickets:   body.ChildTickets,
+	})
+	if err != nil {
+		h.bookingError(c, body.BookingType, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, bookingModel(response))
+}
+
+// CONFIRM HOLD
+// @Summary CONFIRM HOLD
+// @Security BearerAuth
+// @Description Api for turning a hold into a booking, hotel bookings wait for the payment as usual. Holds that ran out are rejected with 409
+// @Tags HOLD
+// @Accept json
+// @Produce json
+// @Param id path string true "hold_id"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.BookingRes
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/holds/{id}/confirm [POST]
+func (h *HandlerV1) ConfirmHold(c *gin.Context) {
+	h.changeHold(c, "ConfirmHold", h.Service.BookingService().HoldConfirm)
+}
+
+// RELEASE HOLD
+// @Summary RELEASE HOLD
+// @Security BearerAuth
+// @Description Api for giving up a hold before it runs out
+// @Tags HOLD
+// @Accept json
+// @Produce json
+// @Param id path string true "hold_id"
+// @Success 200 {object} models.BookingRes
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/holds/{id} [DELETE]
+func (h *HandlerV1) ReleaseHold(c *gin.Context) {
+	h.changeHold(c, "ReleaseHold", h.Service.BookingService().HoldRelease)
+}
+
+// changeHold moves the hold in the path with rpc, users other than admins
+// may change only their own holds
+func (h *HandlerV1) changeHold(c *gin.Context, spanName string, rpc holdRPC) {
+	ctx, span := otlp.Start(c, "api", spanName)
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	userID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := rpc(ctx, &pbb.HoldReq{
+		Id:     c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		h.bookingStatusError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, bookingModel(response))
+}
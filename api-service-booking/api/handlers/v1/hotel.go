@@ -2,6 +2,7 @@ package v1
 
 import (
 	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
 	pbe "Booking/api-service-booking/genproto/establishment-proto"
 	"Booking/api-service-booking/internal/pkg/otlp"
 	"net/http"
@@ -10,6 +11,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -143,6 +146,8 @@ func (h HandlerV1) CreateHotel(c *gin.Context) {
 		UpdatedAt: response.UpdatedAt,
 	}
 
+	h.linkOwner(ctx, owner_id, response.HotelId)
+
 	c.JSON(http.StatusCreated, respModel)
 }
 
@@ -197,6 +202,23 @@ func (h HandlerV1) GetHotel(c *gin.Context) {
 		respImages = append(respImages, &image)
 	}
 
+	var respRooms []*models.RoomModel
+
+	for _, respRoom := range response.Hotel.Rooms {
+		respRooms = append(respRooms, &models.RoomModel{
+			RoomId:        respRoom.RoomId,
+			HotelId:       respRoom.HotelId,
+			Price:         respRoom.Price,
+			Description:   respRoom.Description,
+			NumberOfRooms: respRoom.NumberOfRooms,
+			Capacity:      respRoom.Capacity,
+			Holidays:      respRoom.Holidays,
+			Discount:      respRoom.Discount,
+			CreatedAt:     respRoom.CreatedAt,
+			UpdatedAt:     respRoom.UpdatedAt,
+		})
+	}
+
 	respModel := models.HotelModel{
 		HotelId:       response.Hotel.HotelId,
 		OwnerId:       response.Hotel.OwnerId,
@@ -219,6 +241,7 @@ func (h HandlerV1) GetHotel(c *gin.Context) {
 			CreatedAt:       response.Hotel.Location.CreatedAt,
 			UpdatedAt:       response.Hotel.Location.UpdatedAt,
 		},
+		Rooms:     respRooms,
 		CreatedAt: response.Hotel.CreatedAt,
 		UpdatedAt: response.Hotel.UpdatedAt,
 	}
@@ -491,6 +514,7 @@ func (h HandlerV1) DeleteHotel(c *gin.Context) {
 		h.Logger.Error("not deleted")
 		return
 	}
+	h.unlinkOwner(ctx, hotel_id)
 
 	c.JSON(200, gin.H{
 		"message": "successfuly deleted",
@@ -710,4 +734,76 @@ func (h HandlerV1) FindHotelsByName(c *gin.Context) {
 	}
 
 	c.JSON(200, listModel)
-}
\ No newline at end of file
+}
+
+// LIST AVAILABLE HOTELS BY CITY, DATES AND PARTY SIZE
+// @Summary LIST AVAILABLE HOTELS BY CITY, DATES AND PARTY SIZE
+// @Security BearerAuth
+// @Description Api for listing hotels having a free room for the stay, with the cheapest free room and its nightly price
+// @Tags HOTEL
+// @Accept json
+// @Produce json
+// @Param city query string true "city"
+// @Param will_arrive query string true "will_arrive (YYYY-MM-DD)"
+// @Param will_leave query string true "will_leave (YYYY-MM-DD)"
+// @Param party_size query int true "party_size"
+// @Success 200 {object} models.ListAvailableHotelsModel
+// @Failure 400 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/available [GET]
+func (h HandlerV1) ListAvailableHotels(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListAvailableHotels")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	partySize, err := strconv.ParseInt(c.Query("party_size"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "party_size must be a number",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().SearchAvailableHotels(ctx, &pbb.AvailabilityReq{
+		City:       c.Query("city"),
+		WillArrive: c.Query("will_arrive"),
+		WillLeave:  c.Query("will_leave"),
+		PartySize:  partySize,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid stay dates or party size",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	var hotels []*models.AvailableHotelModel
+	for _, hotel := range response.Hotels {
+		hotels = append(hotels, &models.AvailableHotelModel{
+			HotelId:         hotel.HotelId,
+			HotelName:       hotel.HotelName,
+			Rating:          hotel.Rating,
+			Address:         hotel.Address,
+			City:            hotel.City,
+			RoomId:          hotel.RoomId,
+			RoomDescription: hotel.RoomDescription,
+			RoomCapacity:    hotel.RoomCapacity,
+			NightlyPrice:    hotel.NightlyPrice,
+			FreeRooms:       hotel.FreeRooms,
+		})
+	}
+
+	c.JSON(http.StatusOK, models.ListAvailableHotelsModel{
+		Hotels: hotels,
+		Count:  response.Count,
+	})
+}
@@ -0,0 +1,261 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CREATE ITINERARY
+// @Summary CREATE ITINERARY
+// @Security BearerAuth
+// @Description Api for booking a whole trip at once: hotel stays, restaurant tables and attraction tickets are booked together or not at all. When one booking fails the ones already made are cancelled again and the error of the failed one is returned
+// @Tags ITINERARY
+// @Accept json
+// @Produce json
+// @Param CreateItineraryReq body models.CreateItineraryReq true "createModel"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 201 {object} models.ItineraryModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/itineraries [POST]
+func (h *HandlerV1) CreateItinerary(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "CreateItinerary")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.CreateItineraryReq
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to bind json", l.Error(err))
+		return
+	}
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	req := &pbb.Itinerary{
+		UserId: userID,
+	}
+	for _, booking := range body.Bookings {
+		if !validBookingType(c, booking.BookingType) {
+			return
+		}
+		req.Bookings = append(req.Bookings, &pbb.GeneralBook{
+			BookingType:    booking.BookingType,
+			HraId:          booking.HraId,
+			WillArrive:     booking.WillArrive,
+			WillLeave:      booking.WillLeave,
+			NumberOfPeople: booking.NumberOfPeople,
+			Reason:         booking.Reason,
+			AdultTickets:   booking.AdultTickets,
+			ChildTickets:   booking.ChildTickets,
+		})
+	}
+
+	response, err := h.Service.BookingService().ItineraryCreate(ctx, req)
+	if err != nil {
+		h.itineraryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, itineraryModel(response))
+}
+
+// LIST ITINERARIES
+// @Summary LIST ITINERARIES
+// @Security BearerAuth
+// @Description Api for listing the itineraries of the user with their bookings
+// @Tags ITINERARY
+// @Accept json
+// @Produce json
+// @Success 200 {object} []models.ItineraryModel
+// @Failure 500 {object} models.StandartError
+// @Router /v1/itineraries [GET]
+func (h *HandlerV1) ListItineraries(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListItineraries")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().ItineraryList(ctx, &pbb.ItineraryListReq{
+		UserId: userID,
+	})
+	if err != nil {
+		h.itineraryError(c, err)
+		return
+	}
+
+	itineraries := []*models.ItineraryModel{}
+	for _, itinerary := range response.Itineraries {
+		itineraries = append(itineraries, itineraryModel(itinerary))
+	}
+
+	c.JSON(http.StatusOK, itineraries)
+}
+
+// GET ITINERARY
+// @Summary GET ITINERARY
+// @Security BearerAuth
+// @Description Api for getting an itinerary with its bookings
+// @Tags ITINERARY
+// @Accept json
+// @Produce json
+// @Param id path string true "itinerary_id"
+// @Success 200 {object} models.ItineraryModel
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/itineraries/{id} [GET]
+func (h *HandlerV1) GetItinerary(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "GetItinerary")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	userID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().ItineraryGet(ctx, &pbb.ItineraryReq{
+		Id:     c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		h.itineraryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, itineraryModel(response))
+}
+
+// CANCEL ITINERARY
+// @Summary CANCEL ITINERARY
+// @Security BearerAuth
+// @Description Api for cancelling every booking of a trip that can still be cancelled. Each booking is held to the cancellation policy of its establishment, a booking that can no longer be cancelled stops the cancellation with 409
+// @Tags ITINERARY
+// @Accept json
+// @Produce json
+// @Param id path string true "itinerary_id"
+// @Param StatusReq body models.StatusReq false "reason"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.ItineraryModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/itineraries/{id}/cancel [POST]
+func (h *HandlerV1) CancelItinerary(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "CancelItinerary")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.StatusReq
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Not true form of request",
+			})
+			h.Logger.Error("failed to bind json", l.Error(err))
+			return
+		}
+	}
+
+	changedBy, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+	userID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().ItineraryCancel(ctx, &pbb.ItineraryReq{
+		Id:        c.Param("id"),
+		UserId:    userID,
+		Reason:    body.Reason,
+		ChangedBy: changedBy,
+	})
+	if err != nil {
+		h.itineraryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, itineraryModel(response))
+}
+
+func (h *HandlerV1) itineraryError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+	}
+	h.Logger.Error("itinerary request failed", l.Error(err))
+}
+
+func itineraryModel(itinerary *pbb.Itinerary) *models.ItineraryModel {
+	res := &models.ItineraryModel{
+		Id:        itinerary.Id,
+		UserId:    itinerary.UserId,
+		Status:    itinerary.Status,
+		Bookings:  []*models.BookingRes{},
+		CreatedAt: itinerary.CreatedAt,
+		UpdatedAt: itinerary.UpdatedAt,
+	}
+	for _, booking := range itinerary.Bookings {
+		res.Bookings = append(res.Bookings, bookingModel(booking))
+	}
+	return res
+}
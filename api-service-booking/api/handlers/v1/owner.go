@@ -0,0 +1,296 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	pbu "Booking/api-service-booking/genproto/user-proto"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// List Owner Bookings
+// @Summary List Owner Bookings
+// @Security BearerAuth
+// @Description Api for owners to list the bookings of all of their establishments, or of one of them, with the guests who made them. A booking is listed when any of its days falls between from and to
+// @Tags OWNER
+// @Accept json
+// @Produce json
+// @Param establishment_id query string false "only bookings of this establishment"
+// @Param from query string false "first day, as 2024-12-30"
+// @Param to query string false "last day, as 2025-01-02"
+// @Param status query string false "comma separated statuses, all but held and expired by default"
+// @Param request query models.Pagination true "request"
+// @Success 200 {object} models.OwnerBookingList
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/owner/bookings [get]
+func (h *HandlerV1) ListOwnerBookings(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListOwnerBookings")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.Pagination
+	if err := c.ShouldBindQuery(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to bind query", l.Error(err))
+		return
+	}
+	if body.Page < 1 {
+		body.Page = 1
+	}
+
+	ownerID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	var establishmentIds []string
+	if establishmentID := c.Query("establishment_id"); establishmentID != "" {
+		if !h.ownsEstablishment(ctx, c, ownerID, establishmentID) {
+			return
+		}
+		establishmentIds = []string{establishmentID}
+	} else {
+		owned, err := h.Service.UserService().UserEstablishmentList(ctx, &pbu.Id{Id: ownerID})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Try Again Later...",
+			})
+			h.Logger.Error("failed to list owned establishments", l.Error(err))
+			return
+		}
+		if len(owned.EstablishmentIds) == 0 {
+			c.JSON(http.StatusOK, models.OwnerBookingList{Bookings: []*models.OwnerBookingModel{}})
+			return
+		}
+		establishmentIds = owned.EstablishmentIds
+	}
+
+	var statuses []string
+	for _, value := range strings.Split(c.Query("status"), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			statuses = append(statuses, value)
+		}
+	}
+
+	response, err := h.Service.BookingService().BookingListForOwner(ctx, &pbb.OwnerBookingsReq{
+		EstablishmentIds: establishmentIds,
+		From:             c.Query("from"),
+		To:               c.Query("to"),
+		Statuses:         statuses,
+		Limit:            uint64(body.Limit),
+		Offset:           uint64((body.Page - 1) * body.Limit),
+	})
+	if err != nil {
+		h.bookingError(c, "", err)
+		return
+	}
+
+	// a guest often has several bookings, each of them is looked up once
+	guests := make(map[string]*models.GuestModel)
+	res := models.OwnerBookingList{
+		Bookings: make([]*models.OwnerBookingModel, 0, len(response.Bookings)),
+		Count:    response.Count,
+	}
+	for _, booking := range response.Bookings {
+		guest, ok := guests[booking.Booking.UserId]
+		if !ok {
+			if guest, ok = h.bookingGuest(ctx, c, booking.Booking.UserId); !ok {
+				return
+			}
+			guests[booking.Booking.UserId] = guest
+		}
+		res.Bookings = append(res.Bookings, &models.OwnerBookingModel{
+			EstablishmentId: booking.EstablishmentId,
+			Booking:         bookingModel(booking.Booking),
+			Guest:           guest,
+		})
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// bookingGuest looks the guest of a booking up, a guest who deleted the
+// account is returned empty
+func (h *HandlerV1) bookingGuest(ctx context.Context, c *gin.Context, userID string) (*models.GuestModel, bool) {
+	response, err := h.Service.UserService().Get(ctx, &pbu.Filter{
+		Filter: map[string]string{
+			"id": userID,
+		},
+	})
+	if status.Code(err) == codes.NotFound || (err != nil && strings.Contains(err.Error(), "no rows in result set")) {
+		return &models.GuestModel{}, true
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+		h.Logger.Error("failed to get booking guest", l.Error(err))
+		return nil, false
+	}
+
+	return &models.GuestModel{
+		FullName:    response.User.FullName,
+		Email:       response.User.Email,
+		PhoneNumber: response.User.PhoneNumber,
+	}, true
+}
+
+// ownsEstablishment writes the error response and returns false unless the
+// establishment is owned by ownerID
+func (h *HandlerV1) ownsEstablishment(ctx context.Context, c *gin.Context, ownerID, establishmentID string) bool {
+	owner, err := h.Service.UserService().UserEstablishmentGet(ctx, &pbu.Filter{
+		Filter: map[string]string{
+			"establishment_id": establishmentID,
+		},
+	})
+	if status.Code(err) == codes.NotFound || (err != nil && strings.Contains(err.Error(), "no rows in result set")) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Establishment not found",
+		})
+		return false
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+		h.Logger.Error("failed to get establishment owner", l.Error(err))
+		return false
+	}
+	if owner.User.GetId() != ownerID {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "The establishment is not yours",
+		})
+		return false
+	}
+
+	return true
+}
+
+// callerOwnsEstablishment writes the error response and returns false unless
+// the caller of the request owns the establishment
+func (h *HandlerV1) callerOwnsEstablishment(ctx context.Context, c *gin.Context, establishmentID string) bool {
+	ownerID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return false
+	}
+
+	return h.ownsEstablishment(ctx, c, ownerID, establishmentID)
+}
+
+// callerOwnsBooking writes the error response and returns false unless the
+// caller owns the establishment the booking was made at
+func (h *HandlerV1) callerOwnsBooking(ctx context.Context, c *gin.Context, booking *pbb.GeneralBook) bool {
+	establishmentID, err := h.bookingEstablishment(ctx, booking)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Booking not found",
+		})
+		h.Logger.Error("failed to get booking establishment", l.Error(err))
+		return false
+	}
+
+	return h.callerOwnsEstablishment(ctx, c, establishmentID)
+}
+
+// bookingOfOwner writes a 404 response and returns false unless ownerID owns
+// the establishment the booking was made at, so other owners can not tell
+// that the booking exists
+func (h *HandlerV1) bookingOfOwner(ctx context.Context, c *gin.Context, ownerID string, booking *pbb.GeneralBook) bool {
+	owned, err := h.Service.UserService().UserEstablishmentList(ctx, &pbu.Id{Id: ownerID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+		h.Logger.Error("failed to list owned establishments", l.Error(err))
+		return false
+	}
+	establishmentID, err := h.bookingEstablishment(ctx, booking)
+	if err != nil || !slices.Contains(owned.EstablishmentIds, establishmentID) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Booking not found",
+		})
+		return false
+	}
+
+	return true
+}
+
+// bookingEstablishment returns the establishment the booking was made at
+func (h *HandlerV1) bookingEstablishment(ctx context.Context, booking *pbb.GeneralBook) (string, error) {
+	return h.hraEstablishment(ctx, booking.BookingType, booking.HraId)
+}
+
+// hraEstablishment returns the establishment of the room, restaurant or
+// attraction hraID that bookings of bookingType are made for, a room belongs
+// to its hotel
+func (h *HandlerV1) hraEstablishment(ctx context.Context, bookingType, hraID string) (string, error) {
+	if bookingType != bookingHotel {
+		return hraID, nil
+	}
+
+	room, err := h.Service.EstablishmentService().GetRoom(ctx, &pbe.GetRoomRequest{
+		RoomId: hraID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return room.Room.HotelId, nil
+}
+
+// linkOwner records who owns a new establishment, the establishment exists
+// already so a failure is only logged
+func (h *HandlerV1) linkOwner(ctx context.Context, ownerID, establishmentID string) {
+	_, err := h.Service.UserService().UserEstablishmentCreate(ctx, &pbu.UE{
+		UserId:          ownerID,
+		EstablishmentId: establishmentID,
+	})
+	if err != nil {
+		h.Logger.Error("failed to link establishment owner", l.Error(err))
+	}
+}
+
+// unlinkOwner forgets the owner of a deleted establishment
+func (h *HandlerV1) unlinkOwner(ctx context.Context, establishmentID string) {
+	owner, err := h.Service.UserService().UserEstablishmentGet(ctx, &pbu.Filter{
+		Filter: map[string]string{
+			"establishment_id": establishmentID,
+		},
+	})
+	if err != nil {
+		// establishments made before owners were linked have none
+		return
+	}
+	_, err = h.Service.UserService().UserEstablishmentDelete(ctx, &pbu.Filter{
+		Filter: map[string]string{
+			"user_id":          owner.User.GetId(),
+			"establishment_id": establishmentID,
+		},
+	})
+	if err != nil {
+		h.Logger.Error("failed to unlink establishment owner", l.Error(err))
+	}
+}
@@ -0,0 +1,295 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PaymentSignatureHeader carries the hex encoded HMAC-SHA256 of the webhook
+// body signed with the payment webhook secret
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+// PAY FOR A HOTEL BOOKING
+// @Summary PAY FOR A HOTEL BOOKING
+// @Security BearerAuth
+// @Description Api for charging the total price of a hotel booking, a declined card leaves the payment pending so it can be retried
+// @Tags PAYMENT
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param PayReq body models.PayReq true "PayReq"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.PaymentModel
+// @Failure 400 {object} models.StandartError
+// @Failure 402 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id}/pay [POST]
+func (h *HandlerV1) PayHotelBooking(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "PayHotelBooking")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.PayReq
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to bind json", l.Error(err))
+		return
+	}
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().PaymentCreate(ctx, &pbb.PayReq{
+		BookingId: c.Param("id"),
+		UserId:    userID,
+		CardToken: body.CardToken,
+	})
+	if err != nil {
+		h.paymentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, paymentModel(response))
+}
+
+// GET PAYMENT OF A HOTEL BOOKING
+// @Summary GET PAYMENT OF A HOTEL BOOKING
+// @Security BearerAuth
+// @Description Api for getting the payment of a hotel booking with all its attempts, guests get the payments of their own bookings and owners those of bookings at their hotels
+// @Tags PAYMENT
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Success 200 {object} models.PaymentModel
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id}/payment [GET]
+func (h *HandlerV1) GetHotelBookingPayment(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "GetHotelBookingPayment")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	// guests see the payments of their own bookings, owners those of the
+	// bookings at their establishments
+	booking, ok := h.ownBooking(ctx, c, bookingHotel, c.Param("id"))
+	if !ok {
+		return
+	}
+	if booking.UserId != userID && !h.bookingOfOwner(ctx, c, userID, booking) {
+		return
+	}
+
+	response, err := h.Service.BookingService().PaymentGet(ctx, &pbb.Id{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		h.paymentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, paymentModel(response))
+}
+
+// REFUND A HOTEL BOOKING
+// @Summary REFUND A HOTEL BOOKING
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to refund the payment of a cancelled booking, the refund is what the cancellation policy granted when the booking was cancelled
+// @Tags PAYMENT
+// @Accept json
+// @Produce json
+// @Param id path string true "booking_id"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 200 {object} models.PaymentModel
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/booking/hotels/{id}/refund [POST]
+func (h *HandlerV1) RefundHotelBooking(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "RefundHotelBooking")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	booking, err := h.Service.BookingService().BookingGet(ctx, &pbb.BookingId{
+		Id:          c.Param("id"),
+		BookingType: bookingHotel,
+	})
+	if err != nil {
+		h.paymentError(c, err)
+		return
+	}
+	if !h.callerOwnsBooking(ctx, c, booking) {
+		return
+	}
+
+	response, err := h.Service.BookingService().PaymentRefund(ctx, &pbb.Id{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		h.paymentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, paymentModel(response))
+}
+
+// PAYMENT PROVIDER WEBHOOK
+// @Summary PAYMENT PROVIDER WEBHOOK
+// @Description Api for events of the payment provider, the body must be signed in the X-Payment-Signature header
+// @Tags PAYMENT
+// @Accept json
+// @Produce json
+// @Param X-Payment-Signature header string true "hex encoded HMAC-SHA256 of the body"
+// @Param PaymentWebhookReq body models.PaymentWebhookReq true "PaymentWebhookReq"
+// @Success 200 {object} models.PaymentModel
+// @Failure 400 {object} models.StandartError
+// @Failure 401 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/payments/webhook [POST]
+func (h *HandlerV1) PaymentWebhook(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "PaymentWebhook")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	payload, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to read body", l.Error(err))
+		return
+	}
+
+	if !validPaymentSignature([]byte(h.Config.Payment.WebhookSecret), payload, c.GetHeader(PaymentSignatureHeader)) {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "Invalid signature",
+		})
+		return
+	}
+
+	var body models.PaymentWebhookReq
+	if err := json.Unmarshal(payload, &body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to bind json", l.Error(err))
+		return
+	}
+
+	response, err := h.Service.BookingService().PaymentWebhook(ctx, &pbb.PaymentEvent{
+		Event:       body.Event,
+		ProviderRef: body.ProviderRef,
+	})
+	if err != nil {
+		h.paymentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, paymentModel(response))
+}
+
+// validPaymentSignature compares the signature with the HMAC-SHA256 of payload in constant time
+func validPaymentSignature(secret, payload []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func (h *HandlerV1) paymentError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Booking can not be paid",
+		})
+	case codes.Aborted:
+		c.JSON(http.StatusPaymentRequired, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Payment not found",
+		})
+	case codes.FailedPrecondition, codes.AlreadyExists:
+		c.JSON(http.StatusConflict, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+	}
+	h.Logger.Error("payment request failed", l.Error(err))
+}
+
+func paymentModel(payment *pbb.Payment) *models.PaymentModel {
+	res := &models.PaymentModel{
+		PaymentId:      payment.PaymentId,
+		BookingId:      payment.BookingId,
+		UserId:         payment.UserId,
+		Amount:         payment.Amount,
+		Currency:       payment.Currency,
+		RefundedAmount: payment.RefundedAmount,
+		Status:         payment.Status,
+		Provider:       payment.Provider,
+		ProviderRef:    payment.ProviderRef,
+		Attempts:       []*models.PaymentAttemptModel{},
+		CreatedAt:      payment.CreatedAt,
+		UpdatedAt:      payment.UpdatedAt,
+	}
+	for _, attempt := range payment.Attempts {
+		res.Attempts = append(res.Attempts, &models.PaymentAttemptModel{
+			AttemptId: attempt.AttemptId,
+			Action:    attempt.Action,
+			Status:    attempt.Status,
+			Error:     attempt.Error,
+			CreatedAt: attempt.CreatedAt,
+		})
+	}
+
+	return res
+}
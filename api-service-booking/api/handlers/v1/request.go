@@ -30,3 +30,23 @@ func GetIdFromToken(r *http.Request, cfg *config.Config) (string, int) {
 
 	return resp, 200
 }
+
+// GetOwnerFilterFromToken returns the id of the user whose bookings the caller
+// may act on, an empty id lets admins act on bookings of every user
+func GetOwnerFilterFromToken(r *http.Request, cfg *config.Config) (string, int) {
+	userID, statusCode := GetIdFromToken(r, cfg)
+	if statusCode != http.StatusOK {
+		return "", statusCode
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	claims, err := tokens.ExtractClaim(token, []byte(cfg.Token.SignInKey))
+	if err != nil {
+		return "", http.StatusUnauthorized
+	}
+	if cast.ToString(claims["role"]) == "admin" {
+		return "", http.StatusOK
+	}
+
+	return userID, http.StatusOK
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
 	pbe "Booking/api-service-booking/genproto/establishment-proto"
 	"Booking/api-service-booking/internal/pkg/otlp"
 	"context"
@@ -12,6 +13,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -82,6 +85,8 @@ func (h HandlerV1) CreateRestaurant(c *gin.Context) {
 		Description:    body.Description,
 		Rating:         float32(body.Rating),
 		OpeningHours:   body.OpeningHours,
+		SeatCapacity:   body.SeatCapacity,
+		SlotMinutes:    body.SlotMinutes,
 		ContactNumber:  body.ContactNumber,
 		LicenceUrl:     body.LicenceUrl,
 		WebsiteUrl:     body.WebsiteUrl,
@@ -125,6 +130,8 @@ func (h HandlerV1) CreateRestaurant(c *gin.Context) {
 		Description:    response.Description,
 		Rating:         response.Rating,
 		OpeningHours:   response.OpeningHours,
+		SeatCapacity:   response.SeatCapacity,
+		SlotMinutes:    response.SlotMinutes,
 		ContactNumber:  response.ContactNumber,
 		LicenceUrl:     response.LicenceUrl,
 		WebsiteUrl:     response.WebsiteUrl,
@@ -145,6 +152,8 @@ func (h HandlerV1) CreateRestaurant(c *gin.Context) {
 		UpdatedAt: response.UpdatedAt,
 	}
 
+	h.linkOwner(ctx, owner_id, response.RestaurantId)
+
 	c.JSON(http.StatusCreated, respModel)
 }
 
@@ -209,6 +218,8 @@ func (h HandlerV1) GetRestaurant(c *gin.Context) {
 		Description:    response.Restaurant.Description,
 		Rating:         response.Restaurant.Rating,
 		OpeningHours:   response.Restaurant.OpeningHours,
+		SeatCapacity:   response.Restaurant.SeatCapacity,
+		SlotMinutes:    response.Restaurant.SlotMinutes,
 		ContactNumber:  response.Restaurant.ContactNumber,
 		LicenceUrl:     response.Restaurant.LicenceUrl,
 		WebsiteUrl:     response.Restaurant.WebsiteUrl,
@@ -319,6 +330,8 @@ func (h HandlerV1) ListRestaurants(c *gin.Context) {
 			Description:    respRestaurant.Description,
 			Rating:         respRestaurant.Rating,
 			OpeningHours:   respRestaurant.OpeningHours,
+			SeatCapacity:   respRestaurant.SeatCapacity,
+			SlotMinutes:    respRestaurant.SlotMinutes,
 			ContactNumber:  respRestaurant.ContactNumber,
 			LicenceUrl:     respRestaurant.LicenceUrl,
 			WebsiteUrl:     respRestaurant.WebsiteUrl,
@@ -396,6 +409,8 @@ func (h HandlerV1) UpdateRestaurant(c *gin.Context) {
 			Description:    body.Description,
 			Rating:         float32(body.Rating),
 			OpeningHours:   body.OpeningHours,
+			SeatCapacity:   body.SeatCapacity,
+			SlotMinutes:    body.SlotMinutes,
 			ContactNumber:  body.ContactNumber,
 			LicenceUrl:     body.LicenceUrl,
 			WebsiteUrl:     body.WebsiteUrl,
@@ -437,6 +452,8 @@ func (h HandlerV1) UpdateRestaurant(c *gin.Context) {
 		Description:    response.Restaurant.Description,
 		Rating:         response.Restaurant.Rating,
 		OpeningHours:   response.Restaurant.OpeningHours,
+		SeatCapacity:   response.Restaurant.SeatCapacity,
+		SlotMinutes:    response.Restaurant.SlotMinutes,
 		ContactNumber:  response.Restaurant.ContactNumber,
 		LicenceUrl:     response.Restaurant.LicenceUrl,
 		WebsiteUrl:     response.Restaurant.WebsiteUrl,
@@ -505,6 +522,7 @@ func (h HandlerV1) DeleteRestaurant(c *gin.Context) {
 		h.Logger.Error("not deleted")
 		return
 	}
+	h.unlinkOwner(ctx, restaurant_id)
 
 	c.JSON(200, gin.H{
 		"message": "successfuly deleted",
@@ -603,6 +621,8 @@ func (h HandlerV1) ListRestaurantsByLocation(c *gin.Context) {
 			Description:    respRestaurant.Description,
 			Rating:         respRestaurant.Rating,
 			OpeningHours:   respRestaurant.OpeningHours,
+			SeatCapacity:   respRestaurant.SeatCapacity,
+			SlotMinutes:    respRestaurant.SlotMinutes,
 			ContactNumber:  respRestaurant.ContactNumber,
 			LicenceUrl:     respRestaurant.LicenceUrl,
 			WebsiteUrl:     respRestaurant.WebsiteUrl,
@@ -699,6 +719,8 @@ func (h HandlerV1) FindRestaurantsByName(c *gin.Context) {
 			Description:    respRestaurant.Description,
 			Rating:         respRestaurant.Rating,
 			OpeningHours:   respRestaurant.OpeningHours,
+			SeatCapacity:   respRestaurant.SeatCapacity,
+			SlotMinutes:    respRestaurant.SlotMinutes,
 			ContactNumber:  respRestaurant.ContactNumber,
 			LicenceUrl:     respRestaurant.LicenceUrl,
 			WebsiteUrl:     respRestaurant.WebsiteUrl,
@@ -729,3 +751,62 @@ func (h HandlerV1) FindRestaurantsByName(c *gin.Context) {
 
 	c.JSON(200, listModel)
 }
+
+// LIST FREE RESERVATION SLOTS OF A RESTAURANT FOR A DATE
+// @Summary LIST FREE RESERVATION SLOTS OF A RESTAURANT FOR A DATE
+// @Security BearerAuth
+// @Description Api for listing the reservation slots of a restaurant within its opening hours with the seats still free in each
+// @Tags RESTAURANT
+// @Accept json
+// @Produce json
+// @Param id path string true "restaurant_id"
+// @Param date query string true "date (YYYY-MM-DD)"
+// @Success 200 {object} models.ListSlotsModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/restaurant/{id}/slots [GET]
+func (h HandlerV1) ListRestaurantSlots(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListRestaurantSlots")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	response, err := h.Service.BookingService().URBFreeSlots(ctx, &pbb.FreeSlotsReq{
+		RestaurantId: c.Param("id"),
+		Date:         c.Query("date"),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "date must be in YYYY-MM-DD format",
+			})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Restaurant not found",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			h.Logger.Error(err.Error())
+		}
+		return
+	}
+
+	slots := []*models.SlotModel{}
+	for _, slot := range response.Slots {
+		slots = append(slots, &models.SlotModel{
+			Start:     slot.Start,
+			End:       slot.End,
+			FreeSeats: slot.FreeSeats,
+		})
+	}
+
+	c.JSON(http.StatusOK, models.ListSlotsModel{
+		Slots:        slots,
+		SeatCapacity: response.SeatCapacity,
+	})
+}
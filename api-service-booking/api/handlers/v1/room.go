@@ -0,0 +1,309 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// CREATE ROOM
+// @Summary CREATE ROOM
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to create a room type of it
+// @Tags ROOM
+// @Accept json
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param Room body models.CreateRoom true "Room"
+// @Success 201 {object} models.RoomModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms [POST]
+func (h HandlerV1) CreateRoom(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "CreateRoom")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.CreateRoom
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	hotel_id := c.Param("id")
+
+	// the hotel must exist before rooms can be attached to it
+	if _, err := h.Service.EstablishmentService().GetHotel(ctx, &pbe.GetHotelRequest{
+		HotelId: hotel_id,
+	}); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "hotel not found",
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, hotel_id) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().CreateRoom(ctx, &pbe.Room{
+		RoomId:        uuid.New().String(),
+		HotelId:       hotel_id,
+		Price:         body.Price,
+		Description:   body.Description,
+		NumberOfRooms: body.NumberOfRooms,
+		Capacity:      body.Capacity,
+		Holidays:      body.Holidays,
+		Discount:      body.Discount,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, roomModel(response))
+}
+
+// GET ROOM BY ROOM_ID
+// @Summary GET ROOM BY ROOM_ID
+// @Security BearerAuth
+// @Description Api for getting a room of the hotel
+// @Tags ROOM
+// @Accept json
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param room_id path string true "room_id"
+// @Success 200 {object} models.RoomModel
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms/{room_id} [GET]
+func (h HandlerV1) GetRoom(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "GetRoom")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	response, err := h.Service.EstablishmentService().GetRoom(ctx, &pbe.GetRoomRequest{
+		RoomId: c.Param("room_id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	if response.Room.HotelId != c.Param("id") {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "room not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, roomModel(response.Room))
+}
+
+// LIST ROOMS OF HOTEL
+// @Summary LIST ROOMS OF HOTEL
+// @Security BearerAuth
+// @Description Api for listing rooms of the hotel
+// @Tags ROOM
+// @Accept json
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Success 200 {object} models.ListRoomsModel
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms [GET]
+func (h HandlerV1) ListRoomsByHotel(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListRoomsByHotel")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	response, err := h.Service.EstablishmentService().ListRoomsByHotel(ctx, &pbe.ListRoomsByHotelRequest{
+		HotelId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	var rooms []*models.RoomModel
+	for _, room := range response.Rooms {
+		rooms = append(rooms, roomModel(room))
+	}
+
+	c.JSON(http.StatusOK, models.ListRoomsModel{
+		Rooms: rooms,
+		Count: response.Count,
+	})
+}
+
+// UPDATE ROOM
+// @Summary UPDATE ROOM
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to update a room of it
+// @Tags ROOM
+// @Accept json
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param room_id path string true "room_id"
+// @Param Room body models.CreateRoom true "Room"
+// @Success 200 {object} models.RoomModel
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms/{room_id} [PUT]
+func (h HandlerV1) UpdateRoom(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "UpdateRoom")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.CreateRoom
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !h.roomBelongsToHotel(c, c.Param("room_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().UpdateRoom(ctx, &pbe.UpdateRoomRequest{
+		Room: &pbe.Room{
+			RoomId:        c.Param("room_id"),
+			HotelId:       c.Param("id"),
+			Price:         body.Price,
+			Description:   body.Description,
+			NumberOfRooms: body.NumberOfRooms,
+			Capacity:      body.Capacity,
+			Holidays:      body.Holidays,
+			Discount:      body.Discount,
+		},
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, roomModel(response.Room))
+}
+
+// DELETE ROOM
+// @Summary DELETE ROOM
+// @Security BearerAuth
+// @Description Api for the owner of the hotel to delete a room of it
+// @Tags ROOM
+// @Accept json
+// @Produce json
+// @Param id path string true "hotel_id"
+// @Param room_id path string true "room_id"
+// @Success 200 {object} models.DeleteResponse
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/hotel/{id}/rooms/{room_id} [DELETE]
+func (h HandlerV1) DeleteRoom(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "DeleteRoom")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	if !h.roomBelongsToHotel(c, c.Param("room_id"), c.Param("id")) {
+		return
+	}
+
+	if !h.callerOwnsEstablishment(ctx, c, c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().DeleteRoom(ctx, &pbe.DeleteRoomRequest{
+		RoomId: c.Param("room_id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	if !response.Success {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "not deleted",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "successfuly deleted",
+	})
+}
+
+// roomBelongsToHotel writes a 404 response and returns false when the room
+// does not exist or belongs to another hotel
+func (h HandlerV1) roomBelongsToHotel(c *gin.Context, room_id, hotel_id string) bool {
+	response, err := h.Service.EstablishmentService().GetRoom(c.Request.Context(), &pbe.GetRoomRequest{
+		RoomId: room_id,
+	})
+	if err != nil || response.Room.HotelId != hotel_id {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "room not found",
+		})
+		return false
+	}
+
+	return true
+}
+
+func roomModel(room *pbe.Room) *models.RoomModel {
+	return &models.RoomModel{
+		RoomId:        room.RoomId,
+		HotelId:       room.HotelId,
+		Price:         room.Price,
+		Description:   room.Description,
+		NumberOfRooms: room.NumberOfRooms,
+		Capacity:      room.Capacity,
+		Holidays:      room.Holidays,
+		Discount:      room.Discount,
+		CreatedAt:     room.CreatedAt,
+		UpdatedAt:     room.UpdatedAt,
+	}
+}
@@ -0,0 +1,183 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"Booking/api-service-booking/internal/pkg/ticket"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	ticketDefaultSize = 320
+	ticketMaxSize     = 1024
+)
+
+// BOOKING TICKET
+// @Summary BOOKING TICKET
+// @Security BearerAuth
+// @Description Api for the ticket of a booking as a QR code the establishment scans at the door. The code carries a signed payload and lets the guest in once
+// @Tags TICKET
+// @Produce image/png
+// @Produce image/svg+xml
+// @Param id path string true "booking_id"
+// @Param format query string false "png or svg, png by default"
+// @Param size query int false "width and height of a png in pixels, 320 by default"
+// @Success 200 {string} string "image"
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/bookings/{id}/ticket [GET]
+func (h *HandlerV1) BookingTicket(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "BookingTicket")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	format := c.DefaultQuery("format", "png")
+	if format != "png" && format != "svg" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "format must be png or svg",
+		})
+		return
+	}
+	size := ticketDefaultSize
+	if value := c.Query("size"); value != "" {
+		var err error
+		if size, err = strconv.Atoi(value); err != nil || size < 64 || size > ticketMaxSize {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "size must be between 64 and 1024",
+			})
+			return
+		}
+	}
+
+	booking, ok := h.ownBooking(ctx, c, "", c.Param("id"))
+	if !ok {
+		return
+	}
+	switch booking.Status {
+	case "held", "cancelled", "no_show", "expired":
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "The booking has no valid ticket",
+		})
+		return
+	}
+
+	payload := ticket.Sign(h.Config.Ticket.Secret, booking.BookingType, booking.Id)
+	var (
+		image       []byte
+		contentType string
+		err         error
+	)
+	if format == "svg" {
+		image, err = ticket.SVG(payload)
+		contentType = "image/svg+xml"
+	} else {
+		image, err = ticket.PNG(payload, size)
+		contentType = "image/png"
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+		h.Logger.Error("failed to draw ticket", l.Error(err))
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.Data(http.StatusOK, contentType, image)
+}
+
+// SCAN TICKET
+// @Summary SCAN TICKET
+// @Security BearerAuth
+// @Description Api for establishment owners to check the guest in with the payload read from the ticket QR code. Only tickets of bookings at their own establishments are accepted and a ticket that was used already is rejected
+// @Tags TICKET
+// @Accept json
+// @Produce json
+// @Param TicketScanReq body models.TicketScanReq true "payload of the QR code"
+// @Success 200 {object} models.TicketScanRes
+// @Failure 400 {object} models.StandartError
+// @Failure 403 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/tickets/check-in [POST]
+func (h *HandlerV1) ScanTicket(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ScanTicket")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.TicketScanReq
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to bind json", l.Error(err))
+		return
+	}
+
+	scanned, err := ticket.Verify(h.Config.Ticket.Secret, body.Payload)
+	if err != nil {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "The ticket is not valid",
+		})
+		return
+	}
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	booking, err := h.Service.BookingService().BookingGet(ctx, &pbb.BookingId{
+		Id:          scanned.BookingId,
+		BookingType: scanned.BookingType,
+	})
+	if err != nil {
+		h.bookingStatusError(c, err)
+		return
+	}
+	if !h.callerOwnsBooking(ctx, c, booking) {
+		return
+	}
+	switch booking.Status {
+	case "checked_in", "completed":
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "The ticket was used already",
+		})
+		return
+	}
+
+	// two scans of the same ticket at once may both get here, the booking
+	// service checks the booking in only once and the other scan gets a conflict
+	change, err := h.Service.BookingService().CheckIn(ctx, &pbb.StatusReq{
+		BookingId:   booking.Id,
+		BookingType: booking.BookingType,
+		Reason:      "ticket scanned",
+		ChangedBy:   userID,
+	})
+	if err != nil {
+		h.bookingStatusError(c, err)
+		return
+	}
+
+	booking.Status = change.ToStatus
+	c.JSON(http.StatusOK, models.TicketScanRes{
+		Booking: bookingModel(booking),
+		Change:  statusChangeModel(change),
+	})
+}
@@ -0,0 +1,263 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbe "Booking/api-service-booking/genproto/establishment-proto"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CREATE TICKET TYPE
+// @Summary CREATE TICKET TYPE
+// @Security BearerAuth
+// @Description Api for creating an adult or child ticket type of the attraction
+// @Tags TICKET_TYPE
+// @Accept json
+// @Produce json
+// @Param id path string true "attraction_id"
+// @Param TicketType body models.CreateTicketType true "TicketType"
+// @Success 201 {object} models.TicketTypeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/attraction/{id}/tickets [POST]
+func (h HandlerV1) CreateTicketType(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "CreateTicketType")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.CreateTicketType
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	attraction_id := c.Param("id")
+
+	// the attraction must exist before ticket types can be attached to it
+	if _, err := h.Service.EstablishmentService().GetAttraction(ctx, &pbe.GetAttractionRequest{
+		AttractionId: attraction_id,
+	}); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "attraction not found",
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().CreateTicketType(ctx, &pbe.TicketType{
+		TicketTypeId: uuid.New().String(),
+		AttractionId: attraction_id,
+		Name:         body.Name,
+		Price:        body.Price,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "name must be adult or child and price must not be negative",
+			})
+		case codes.AlreadyExists:
+			c.JSON(http.StatusConflict, gin.H{
+				"error": "attraction already sells this ticket type",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+			h.Logger.Error(err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, ticketTypeModel(response))
+}
+
+// LIST TICKET TYPES OF ATTRACTION
+// @Summary LIST TICKET TYPES OF ATTRACTION
+// @Security BearerAuth
+// @Description Api for listing ticket types of the attraction
+// @Tags TICKET_TYPE
+// @Accept json
+// @Produce json
+// @Param id path string true "attraction_id"
+// @Success 200 {object} models.ListTicketTypesModel
+// @Failure 500 {object} models.StandartError
+// @Router /v1/attraction/{id}/tickets [GET]
+func (h HandlerV1) ListTicketTypesByAttraction(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListTicketTypesByAttraction")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	response, err := h.Service.EstablishmentService().ListTicketTypesByAttraction(ctx, &pbe.ListTicketTypesByAttractionRequest{
+		AttractionId: c.Param("id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	var ticketTypes []*models.TicketTypeModel
+	for _, ticketType := range response.TicketTypes {
+		ticketTypes = append(ticketTypes, ticketTypeModel(ticketType))
+	}
+
+	c.JSON(http.StatusOK, models.ListTicketTypesModel{
+		TicketTypes: ticketTypes,
+		Count:       response.Count,
+	})
+}
+
+// UPDATE TICKET TYPE
+// @Summary UPDATE TICKET TYPE
+// @Security BearerAuth
+// @Description Api for updating the price of a ticket type of the attraction
+// @Tags TICKET_TYPE
+// @Accept json
+// @Produce json
+// @Param id path string true "attraction_id"
+// @Param ticket_type_id path string true "ticket_type_id"
+// @Param TicketType body models.UpdateTicketType true "TicketType"
+// @Success 200 {object} models.TicketTypeModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/attraction/{id}/tickets/{ticket_type_id} [PUT]
+func (h HandlerV1) UpdateTicketType(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "UpdateTicketType")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.UpdateTicketType
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	if !h.ticketTypeBelongsToAttraction(c, c.Param("ticket_type_id"), c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().UpdateTicketType(ctx, &pbe.UpdateTicketTypeRequest{
+		TicketType: &pbe.TicketType{
+			TicketTypeId: c.Param("ticket_type_id"),
+			AttractionId: c.Param("id"),
+			Price:        body.Price,
+		},
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "price must not be negative",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, ticketTypeModel(response.TicketType))
+}
+
+// DELETE TICKET TYPE
+// @Summary DELETE TICKET TYPE
+// @Security BearerAuth
+// @Description Api for deleting a ticket type of the attraction
+// @Tags TICKET_TYPE
+// @Accept json
+// @Produce json
+// @Param id path string true "attraction_id"
+// @Param ticket_type_id path string true "ticket_type_id"
+// @Success 200 {object} models.DeleteResponse
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/attraction/{id}/tickets/{ticket_type_id} [DELETE]
+func (h HandlerV1) DeleteTicketType(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "DeleteTicketType")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	if !h.ticketTypeBelongsToAttraction(c, c.Param("ticket_type_id"), c.Param("id")) {
+		return
+	}
+
+	response, err := h.Service.EstablishmentService().DeleteTicketType(ctx, &pbe.DeleteTicketTypeRequest{
+		TicketTypeId: c.Param("ticket_type_id"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.Logger.Error(err.Error())
+		return
+	}
+
+	if !response.Success {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "not deleted",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "successfuly deleted",
+	})
+}
+
+// ticketTypeBelongsToAttraction writes a 404 response and returns false when
+// the attraction does not sell the ticket type
+func (h HandlerV1) ticketTypeBelongsToAttraction(c *gin.Context, ticket_type_id, attraction_id string) bool {
+	response, err := h.Service.EstablishmentService().ListTicketTypesByAttraction(c.Request.Context(), &pbe.ListTicketTypesByAttractionRequest{
+		AttractionId: attraction_id,
+	})
+	if err == nil {
+		for _, ticketType := range response.TicketTypes {
+			if ticketType.TicketTypeId == ticket_type_id {
+				return true
+			}
+		}
+	}
+
+	c.JSON(http.StatusNotFound, gin.H{
+		"error": "ticket type not found",
+	})
+	return false
+}
+
+func ticketTypeModel(ticketType *pbe.TicketType) *models.TicketTypeModel {
+	return &models.TicketTypeModel{
+		TicketTypeId: ticketType.TicketTypeId,
+		AttractionId: ticketType.AttractionId,
+		Name:         ticketType.Name,
+		Price:        ticketType.Price,
+		CreatedAt:    ticketType.CreatedAt,
+		UpdatedAt:    ticketType.UpdatedAt,
+	}
+}
@@ -0,0 +1,262 @@
+package v1
+
+import (
+	"Booking/api-service-booking/api/models"
+	pbb "Booking/api-service-booking/genproto/booking-proto"
+	l "Booking/api-service-booking/internal/pkg/logger"
+	"Booking/api-service-booking/internal/pkg/otlp"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type waitlistRPC func(ctx context.Context, in *pbb.WaitlistReq, opts ...grpc.CallOption) (*pbb.WaitlistEntry, error)
+
+// JOIN WAITLIST
+// @Summary JOIN WAITLIST
+// @Security BearerAuth
+// @Description Api for queueing for a hotel room or a restaurant slot that is full. When a booking for these dates is cancelled the guest who waited longest and whose party fits gets an offer. The place is held for the guest until offer_expires_at
+// @Tags WAITLIST
+// @Accept json
+// @Produce json
+// @Param JoinWaitlistReq body models.JoinWaitlistReq true "joinModel"
+// @Success 201 {object} models.WaitlistEntryModel
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/waitlist [POST]
+func (h *HandlerV1) JoinWaitlist(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "JoinWaitlist")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	var body models.JoinWaitlistReq
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Not true form of request",
+		})
+		h.Logger.Error("failed to bind json", l.Error(err))
+		return
+	}
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.joinWaitlist(ctx, userID, &body)
+	if err != nil {
+		h.waitlistError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, waitlistEntryModel(response))
+}
+
+// LIST WAITLIST
+// @Summary LIST WAITLIST
+// @Security BearerAuth
+// @Description Api for listing the waitlist entries and open offers of the user
+// @Tags WAITLIST
+// @Accept json
+// @Produce json
+// @Success 200 {object} []models.WaitlistEntryModel
+// @Failure 500 {object} models.StandartError
+// @Router /v1/waitlist [GET]
+func (h *HandlerV1) ListWaitlist(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "ListWaitlist")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	userID, statusCode := GetIdFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().WaitlistList(ctx, &pbb.WaitlistListReq{
+		UserId: userID,
+	})
+	if err != nil {
+		h.waitlistError(c, err)
+		return
+	}
+
+	entries := []*models.WaitlistEntryModel{}
+	for _, entry := range response.Entries {
+		entries = append(entries, waitlistEntryModel(entry))
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// LEAVE WAITLIST
+// @Summary LEAVE WAITLIST
+// @Security BearerAuth
+// @Description Api for leaving the waitlist, an open offer passes on to the next guest
+// @Tags WAITLIST
+// @Accept json
+// @Produce json
+// @Param id path string true "waitlist_id"
+// @Success 200 {object} models.WaitlistEntryModel
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/waitlist/{id} [DELETE]
+func (h *HandlerV1) LeaveWaitlist(c *gin.Context) {
+	h.changeWaitlistEntry(c, "LeaveWaitlist", h.Service.BookingService().WaitlistLeave)
+}
+
+// DECLINE WAITLIST OFFER
+// @Summary DECLINE WAITLIST OFFER
+// @Security BearerAuth
+// @Description Api for turning down a waitlist offer, it passes on to the next guest
+// @Tags WAITLIST
+// @Accept json
+// @Produce json
+// @Param id path string true "waitlist_id"
+// @Success 200 {object} models.WaitlistEntryModel
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/waitlist/{id}/decline [POST]
+func (h *HandlerV1) DeclineWaitlistOffer(c *gin.Context) {
+	h.changeWaitlistEntry(c, "DeclineWaitlistOffer", h.Service.BookingService().WaitlistDecline)
+}
+
+// ACCEPT WAITLIST OFFER
+// @Summary ACCEPT WAITLIST OFFER
+// @Security BearerAuth
+// @Description Api for turning an open waitlist offer into a booking, the booking held for the offer is confirmed. Offers that ran out are rejected with 409
+// @Tags WAITLIST
+// @Accept json
+// @Produce json
+// @Param id path string true "waitlist_id"
+// @Param Idempotency-Key header string false "repeats of a request with the same key return its first response"
+// @Success 201 {object} models.BookingRes
+// @Failure 400 {object} models.StandartError
+// @Failure 404 {object} models.StandartError
+// @Failure 409 {object} models.StandartError
+// @Failure 500 {object} models.StandartError
+// @Router /v1/waitlist/{id}/accept [POST]
+func (h *HandlerV1) AcceptWaitlistOffer(c *gin.Context) {
+	ctx, span := otlp.Start(c, "api", "AcceptWaitlistOffer")
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	userID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := h.Service.BookingService().WaitlistAccept(ctx, &pbb.WaitlistReq{
+		Id:     c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		h.waitlistError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, bookingModel(response))
+}
+
+// joinWaitlist queues the user for what body asks for
+func (h *HandlerV1) joinWaitlist(ctx context.Context, userID string, body *models.JoinWaitlistReq) (*pbb.WaitlistEntry, error) {
+	return h.Service.BookingService().WaitlistJoin(ctx, &pbb.WaitlistEntry{
+		BookingType:    body.BookingType,
+		UserId:         userID,
+		HraId:          body.HraId,
+		WillArrive:     body.WillArrive,
+		WillLeave:      body.WillLeave,
+		NumberOfPeople: body.NumberOfPeople,
+		Reason:         body.Reason,
+	})
+}
+
+// changeWaitlistEntry moves the waitlist entry in the path with rpc, users
+// other than admins may change only their own entries
+func (h *HandlerV1) changeWaitlistEntry(c *gin.Context, spanName string, rpc waitlistRPC) {
+	ctx, span := otlp.Start(c, "api", spanName)
+	span.SetAttributes(
+		attribute.Key("method").String(c.Request.Method),
+	)
+	defer span.End()
+
+	userID, statusCode := GetOwnerFilterFromToken(c.Request, h.Config)
+	if statusCode != http.StatusOK {
+		c.JSON(statusCode, gin.H{
+			"error": "Can't get user",
+		})
+		return
+	}
+
+	response, err := rpc(ctx, &pbb.WaitlistReq{
+		Id:     c.Param("id"),
+		UserId: userID,
+	})
+	if err != nil {
+		h.waitlistError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, waitlistEntryModel(response))
+}
+
+func (h *HandlerV1) waitlistError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Waitlist entry not found",
+		})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Try Again Later...",
+		})
+	}
+	h.Logger.Error("waitlist request failed", l.Error(err))
+}
+
+func waitlistEntryModel(entry *pbb.WaitlistEntry) *models.WaitlistEntryModel {
+	return &models.WaitlistEntryModel{
+		Id:             entry.Id,
+		BookingType:    entry.BookingType,
+		UserId:         entry.UserId,
+		HraId:          entry.HraId,
+		WillArrive:     entry.WillArrive,
+		WillLeave:      entry.WillLeave,
+		NumberOfPeople: entry.NumberOfPeople,
+		Reason:         entry.Reason,
+		Status:         entry.Status,
+		OfferExpiresAt: entry.OfferExpiresAt,
+		BookingId:      entry.BookingId,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
@@ -0,0 +1,162 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+	"go.uber.org/zap"
+
+	redisrepo "Booking/api-service-booking/internal/infrastructure/repository/redis"
+	"Booking/api-service-booking/internal/pkg/config"
+	tokens "Booking/api-service-booking/internal/pkg/token"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyKeyMaxLength   = 255
+	idempotencyCacheKeyPrefix = "idempotency:"
+)
+
+// idempotentResponse is what is kept for an Idempotency-Key, Done is false
+// while the first request with the key is still running
+type idempotentResponse struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// responseRecorder keeps a copy of the response body while it is written
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *responseRecorder) WriteString(s string) (int, error) {
+	r.body.WriteString(s)
+	return r.ResponseWriter.WriteString(s)
+}
+
+// Idempotency replays the response of a successful request when a client
+// repeats it with the same Idempotency-Key header, so retries on flaky
+// networks do not book twice. Keys are scoped to the user of the token and
+// bound to the method, path and body they were first used with. Failed
+// requests release their key, so they can be retried with it. Requests
+// without the header and requests made while the cache is down run as usual.
+func Idempotency(cache redisrepo.Cache, cfg config.Config, logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > idempotencyKeyMaxLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "Idempotency-Key is too long",
+			})
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"error": "Not true form of request",
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		fingerprint := sha256.New()
+		fingerprint.Write([]byte(c.Request.Method + " " + c.Request.URL.Path + "\n"))
+		fingerprint.Write(body)
+		record := idempotentResponse{
+			Fingerprint: hex.EncodeToString(fingerprint.Sum(nil)),
+		}
+		cacheKey := idempotencyCacheKeyPrefix + idempotencySubject(c, cfg) + ":" + key
+
+		claimed, err := cache.SetNX(c.Request.Context(), cacheKey, record, cfg.Idempotency.LockTTL)
+		if err != nil {
+			logger.Error("failed to claim idempotency key", zap.Error(err))
+			c.Next()
+			return
+		}
+		if !claimed {
+			replayIdempotent(c, cache, cacheKey, record.Fingerprint)
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		// the response is kept even when the client went away meanwhile
+		ctx := context.Background()
+		status := recorder.Status()
+		if status < http.StatusOK || status >= http.StatusMultipleChoices {
+			if err := cache.Del(ctx, cacheKey); err != nil {
+				logger.Error("failed to release idempotency key", zap.Error(err))
+			}
+			return
+		}
+
+		record.Done = true
+		record.Status = status
+		record.ContentType = recorder.Header().Get("Content-Type")
+		record.Body = recorder.body.Bytes()
+		if err := cache.Set(ctx, cacheKey, record, cfg.Idempotency.TTL); err != nil {
+			logger.Error("failed to store idempotent response", zap.Error(err))
+		}
+	}
+}
+
+// replayIdempotent answers a repeated request with the response kept for its key
+func replayIdempotent(c *gin.Context, cache redisrepo.Cache, cacheKey, fingerprint string) {
+	var record idempotentResponse
+	data, err := cache.Get(c.Request.Context(), cacheKey)
+	if err == nil {
+		err = json.Unmarshal(data, &record)
+	}
+	switch {
+	case err != nil:
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+			"error": "Request with this Idempotency-Key could not be checked, try again",
+		})
+	case record.Fingerprint != fingerprint:
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"error": "Idempotency-Key was already used for another request",
+		})
+	case !record.Done:
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+			"error": "Request with this Idempotency-Key is still in progress",
+		})
+	default:
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Data(record.Status, record.ContentType, record.Body)
+		c.Abort()
+	}
+}
+
+// idempotencySubject is the user of the token, keys of different users never collide
+func idempotencySubject(c *gin.Context, cfg config.Config) string {
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	claims, err := tokens.ExtractClaim(token, []byte(cfg.Token.SignInKey))
+	if err != nil {
+		return "anonymous"
+	}
+	return cast.ToString(claims["sub"])
+}
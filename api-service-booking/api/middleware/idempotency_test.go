@@ -0,0 +1,180 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"Booking/api-service-booking/internal/pkg/config"
+)
+
+// fakeCache keeps values in memory the way the redis cache marshals them
+type fakeCache struct {
+	mu     sync.Mutex
+	values map[string][]byte
+	err    error
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{values: make(map[string][]byte)}
+}
+
+func (c *fakeCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = data
+	return nil
+}
+
+func (c *fakeCache) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.values[key]; ok {
+		return false, nil
+	}
+	c.values[key] = data
+	return true, nil
+}
+
+func (c *fakeCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.values[key]
+	if !ok {
+		return nil, errors.New("redis: nil")
+	}
+	return data, nil
+}
+
+func (c *fakeCache) Del(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+	return nil
+}
+
+func idempotentRouter(cache *fakeCache, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	var cfg config.Config
+	cfg.Idempotency.TTL = time.Hour
+	cfg.Idempotency.LockTTL = time.Minute
+
+	router := gin.New()
+	router.Use(Idempotency(cache, cfg, zap.NewNop()))
+	router.POST("/bookings", handler)
+
+	return router
+}
+
+func idempotentRequest(router *gin.Engine, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/bookings", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	res := httptest.NewRecorder()
+	router.ServeHTTP(res, req)
+	return res
+}
+
+func TestIdempotency(t *testing.T) {
+	calls := 0
+	status := http.StatusCreated
+	router := idempotentRouter(newFakeCache(), func(c *gin.Context) {
+		calls++
+		c.JSON(status, gin.H{"call": calls})
+	})
+
+	first := idempotentRequest(router, "key-1", `{"room":"a"}`)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+
+	// the repeat gets the first response without running the handler again
+	repeat := idempotentRequest(router, "key-1", `{"room":"a"}`)
+	assert.Equal(t, http.StatusCreated, repeat.Code)
+	assert.Equal(t, "true", repeat.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, first.Body.String(), repeat.Body.String())
+	assert.Equal(t, first.Header().Get("Content-Type"), repeat.Header().Get("Content-Type"))
+	assert.Equal(t, 1, calls)
+
+	// the key was used for another request
+	other := idempotentRequest(router, "key-1", `{"room":"b"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, other.Code)
+	assert.Equal(t, 1, calls)
+
+	// a failed request releases its key, so the retry runs
+	status = http.StatusInternalServerError
+	failed := idempotentRequest(router, "key-2", `{"room":"a"}`)
+	assert.Equal(t, http.StatusInternalServerError, failed.Code)
+	status = http.StatusCreated
+	retry := idempotentRequest(router, "key-2", `{"room":"a"}`)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Empty(t, retry.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, 3, calls)
+
+	// requests without a key always run
+	idempotentRequest(router, "", `{"room":"a"}`)
+	idempotentRequest(router, "", `{"room":"a"}`)
+	assert.Equal(t, 5, calls)
+}
+
+func TestIdempotencyInProgress(t *testing.T) {
+	var (
+		router *gin.Engine
+		nested *httptest.ResponseRecorder
+	)
+	calls := 0
+	router = idempotentRouter(newFakeCache(), func(c *gin.Context) {
+		calls++
+		// the client repeats the request while the first one still runs
+		if calls == 1 {
+			nested = idempotentRequest(router, "key-1", `{"room":"a"}`)
+		}
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+
+	first := idempotentRequest(router, "key-1", `{"room":"a"}`)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	if assert.NotNil(t, nested) {
+		assert.Equal(t, http.StatusConflict, nested.Code)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotencyCacheDown(t *testing.T) {
+	cache := newFakeCache()
+	cache.err = errors.New("connection refused")
+
+	calls := 0
+	router := idempotentRouter(cache, func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+
+	// without the cache requests run as usual
+	idempotentRequest(router, "key-1", `{"room":"a"}`)
+	res := idempotentRequest(router, "key-1", `{"room":"a"}`)
+	assert.Equal(t, http.StatusCreated, res.Code)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,28 @@
+package models
+
+type CreateAddOn struct {
+	Name        string  `json:"name" default:"Breakfast"`
+	Description string  `json:"description" default:"buffet breakfast from 7 to 10"`
+	Price       float64 `json:"price" default:"12"`
+}
+
+type UpdateAddOn struct {
+	Name        string  `json:"name" default:"Breakfast"`
+	Description string  `json:"description" default:"buffet breakfast from 7 to 11"`
+	Price       float64 `json:"price" default:"14"`
+}
+
+type AddOnModel struct {
+	AddOnId         string  `json:"add_on_id"`
+	EstablishmentId string  `json:"establishment_id"`
+	Name            string  `json:"name"`
+	Description     string  `json:"description"`
+	Price           float64 `json:"price"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+type ListAddOnsModel struct {
+	AddOns []*AddOnModel `json:"add_ons"`
+	Count  uint64        `json:"count"`
+}
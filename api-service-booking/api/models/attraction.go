@@ -3,19 +3,23 @@ package models
 import "time"
 
 type Attraction struct {
-	AttractionId   string    `json:"attraction_id"`
-	OwnerId        string    `json:"owner_id"`
-	AttractionName string    `json:"attraction_name"`
-	Description    string    `json:"description"`
-	Rating         float64   `json:"rating"`
-	ContactNumber  string    `json:"contact_number"`
-	LicenceUrl     string    `json:"licence_url"`
-	WebsiteUrl     string    `json:"website_url"`
-	Images         []*Image  `json:"images"`
-	Location       Location  `json:"location"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
-	DeletedAt      time.Time `json:"deleted_at"`
+	AttractionId       string    `json:"attraction_id"`
+	OwnerId            string    `json:"owner_id"`
+	AttractionName     string    `json:"attraction_name"`
+	Description        string    `json:"description"`
+	Rating             float64   `json:"rating"`
+	OpeningHours       string    `json:"opening_hours"`
+	EntryWindowMinutes int64     `json:"entry_window_minutes"`
+	DailyQuota         int64     `json:"daily_quota"`
+	WindowQuota        int64     `json:"window_quota"`
+	ContactNumber      string    `json:"contact_number"`
+	LicenceUrl         string    `json:"licence_url"`
+	WebsiteUrl         string    `json:"website_url"`
+	Images             []*Image  `json:"images"`
+	Location           Location  `json:"location"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+	DeletedAt          time.Time `json:"deleted_at"`
 }
 
 type Image struct {
@@ -42,14 +46,18 @@ type Location struct {
 }
 
 type CreateAttraction struct {
-	AttractionName string         `json:"attraction_name" default:"Anhor Park"`
-	Description    string         `json:"description" default:"available for all ages"`
-	Rating         float64        `json:"rating" default:"4.3"`
-	ContactNumber  string         `json:"contact_number" default:"+(99891)-234-56-78"`
-	LicenceUrl     string         `json:"licence_url" default:"https://creativecommons.org/licenses/by/4.0/"`
-	WebsiteUrl     string         `json:"website_url" default:"https://creativecommons.org/licenses/by/4.1/"`
-	Images         []*CreateImage `json:"images"`
-	Location       CreateLocation `json:"location"`
+	AttractionName     string         `json:"attraction_name" default:"Anhor Park"`
+	Description        string         `json:"description" default:"available for all ages"`
+	Rating             float64        `json:"rating" default:"4.3"`
+	OpeningHours       string         `json:"opening_hours" default:"09:00-18:00"`
+	EntryWindowMinutes int64          `json:"entry_window_minutes" default:"60"`
+	DailyQuota         int64          `json:"daily_quota" default:"500"`
+	WindowQuota        int64          `json:"window_quota" default:"80"`
+	ContactNumber      string         `json:"contact_number" default:"+(99891)-234-56-78"`
+	LicenceUrl         string         `json:"licence_url" default:"https://creativecommons.org/licenses/by/4.0/"`
+	WebsiteUrl         string         `json:"website_url" default:"https://creativecommons.org/licenses/by/4.1/"`
+	Images             []*CreateImage `json:"images"`
+	Location           CreateLocation `json:"location"`
 }
 
 type CreateImage struct {
@@ -66,18 +74,23 @@ type CreateLocation struct {
 }
 
 type AttractionModel struct {
-	AttractionId   string        `json:"attraction_id"`
-	OwnerId        string        `json:"owner_id"`
-	AttractionName string        `json:"attraction_name"`
-	Description    string        `json:"description"`
-	Rating         float32       `json:"rating"`
-	ContactNumber  string        `json:"contact_number"`
-	LicenceUrl     string        `json:"licence_url"`
-	WebsiteUrl     string        `json:"website_url"`
-	Images         []*ImageModel `json:"images"`
-	Location       LocationModel `json:"location"`
-	CreatedAt      string        `json:"created_at"`
-	UpdatedAt      string        `json:"updated_at"`
+	AttractionId       string             `json:"attraction_id"`
+	OwnerId            string             `json:"owner_id"`
+	AttractionName     string             `json:"attraction_name"`
+	Description        string             `json:"description"`
+	Rating             float32            `json:"rating"`
+	OpeningHours       string             `json:"opening_hours"`
+	EntryWindowMinutes int64              `json:"entry_window_minutes"`
+	DailyQuota         int64              `json:"daily_quota"`
+	WindowQuota        int64              `json:"window_quota"`
+	ContactNumber      string             `json:"contact_number"`
+	LicenceUrl         string             `json:"licence_url"`
+	WebsiteUrl         string             `json:"website_url"`
+	Images             []*ImageModel      `json:"images"`
+	Location           LocationModel      `json:"location"`
+	TicketTypes        []*TicketTypeModel `json:"ticket_types,omitempty"`
+	CreatedAt          string             `json:"created_at"`
+	UpdatedAt          string             `json:"updated_at"`
 }
 
 type ImageModel struct {
@@ -107,13 +120,17 @@ type ListAttractionModel struct {
 }
 
 type UpdateAttraction struct {
-	AttractionName string         `json:"attraction_name" default:"updated attraction name"`
-	Description    string         `json:"description" default:"updated description"`
-	Rating         float64        `json:"rating" default:"5.0"`
-	ContactNumber  string         `json:"contact_number" default:"updated contact number"`
-	LicenceUrl     string         `json:"licence_url" default:"updated licence url"`
-	WebsiteUrl     string         `json:"website_url" default:"updated website url"`
-	Location       UpdateLocation `json:"location"`
+	AttractionName     string         `json:"attraction_name" default:"updated attraction name"`
+	Description        string         `json:"description" default:"updated description"`
+	Rating             float64        `json:"rating" default:"5.0"`
+	OpeningHours       string         `json:"opening_hours" default:"10:00-20:00"`
+	EntryWindowMinutes int64          `json:"entry_window_minutes" default:"30"`
+	DailyQuota         int64          `json:"daily_quota" default:"400"`
+	WindowQuota        int64          `json:"window_quota" default:"40"`
+	ContactNumber      string         `json:"contact_number" default:"updated contact number"`
+	LicenceUrl         string         `json:"licence_url" default:"updated licence url"`
+	WebsiteUrl         string         `json:"website_url" default:"updated website url"`
+	Location           UpdateLocation `json:"location"`
 }
 
 type UpdateLocation struct {
@@ -130,11 +147,11 @@ type DeleteResponse struct {
 }
 
 type FieldValuesByLocation struct {
-	Country string `json:"country"`
-	City string `json:"city"`
+	Country  string `json:"country"`
+	City     string `json:"city"`
 	Province string `json:"province"`
 }
 
 type FindByName struct {
 	Name string `json:"name"`
-}
\ No newline at end of file
+}
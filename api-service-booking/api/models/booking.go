@@ -3,36 +3,109 @@ package models
 import "github.com/google/uuid"
 
 type CreateBookingReq struct {
+	BookingType    string `json:"booking_type,omitempty"`
 	HraId          string `json:"hra_id"`
 	WillArrive     string `json:"will_arrive"`
 	WillLeave      string `json:"will_leave"`
 	NumberOfPeople int64  `json:"number_of_people"`
-	IsCanceled     bool   `json:"is_canceled"`
 	Reason         string `json:"reason"`
+	AdultTickets   int64  `json:"adult_tickets"`
+	ChildTickets   int64  `json:"child_tickets"`
+	// Guests names the people of the party, hotels register them on arrival
+	Guests             []BookingGuest `json:"guests"`
+	SpecialRequests    []string       `json:"special_requests" example:"late_arrival,crib"`
+	SpecialRequestNote string         `json:"special_request_note"`
+	// AddOns are priced from the catalogue of the establishment into the total
+	AddOns []BookingAddOnReq `json:"add_ons"`
+	// Waitlist joins the waitlist when a room or restaurant slot is full
+	Waitlist bool `json:"waitlist"`
 }
 
 type UpdateBookingReq struct {
-	Id             uuid.UUID `json:"id"`
-	HraId          string    `json:"hra_id"`
-	WillArrive     string    `json:"will_arrive"`
-	WillLeave      string    `json:"will_leave"`
-	NumberOfPeople int64     `json:"number_of_people"`
-	IsCanceled     bool      `json:"is_canceled"`
-	Reason         string    `json:"reason"`
+	Id          uuid.UUID `json:"id"`
+	BookingType string    `json:"booking_type,omitempty"`
+	Reason      string    `json:"reason"`
+	// the guests, special requests and add-ons sent replace the stored ones,
+	// whatever is left out or null is kept
+	Guests             []BookingGuest    `json:"guests"`
+	SpecialRequests    []string          `json:"special_requests" example:"late_arrival,crib"`
+	SpecialRequestNote *string           `json:"special_request_note"`
+	AddOns             []BookingAddOnReq `json:"add_ons"`
+}
+
+type BookingAddOnReq struct {
+	AddOnId  string `json:"add_on_id"`
+	Quantity int64  `json:"quantity" default:"1"`
+}
+
+type BookingAddOn struct {
+	AddOnId   string  `json:"add_on_id"`
+	Name      string  `json:"name"`
+	Quantity  int64   `json:"quantity"`
+	UnitPrice float64 `json:"unit_price"`
+	Total     float64 `json:"total"`
+}
+
+type BookingGuest struct {
+	FullName       string `json:"full_name"`
+	Age            int64  `json:"age"`
+	DocumentNumber string `json:"document_number,omitempty"`
+}
+
+type RescheduleReq struct {
+	BookingType    string `json:"booking_type"`
+	WillArrive     string `json:"will_arrive"`
+	WillLeave      string `json:"will_leave"`
+	NumberOfPeople int64  `json:"number_of_people"`
+	AdultTickets   int64  `json:"adult_tickets"`
+	ChildTickets   int64  `json:"child_tickets"`
+	Reason         string `json:"reason"`
 }
 
 type BookingRes struct {
-	Id             uuid.UUID `json:"id"`
-	UserId         string    `json:"user_id"`
-	HraId          string    `json:"hra_id"`
-	WillArrive     string    `json:"will_arrive"`
-	WillLeave      string    `json:"will_leave"`
-	NumberOfPeople int64     `json:"number_of_people"`
-	IsCanceled     bool      `json:"is_canceled"`
-	Reason         string    `json:"reason"`
-	CreatedAt      string    `json:"created_at"`
-	UpdatedAt      string    `json:"updated_at"`
-	DeletedAt      string    `json:"deleted_at"`
+	Id                 uuid.UUID      `json:"id"`
+	BookingType        string         `json:"booking_type,omitempty"`
+	UserId             string         `json:"user_id"`
+	HraId              string         `json:"hra_id"`
+	WillArrive         string         `json:"will_arrive"`
+	WillLeave          string         `json:"will_leave"`
+	NumberOfPeople     int64          `json:"number_of_people"`
+	IsCanceled         bool           `json:"is_canceled"`
+	Status             string         `json:"status"`
+	Reason             string         `json:"reason"`
+	AdultTickets       int64          `json:"adult_tickets,omitempty"`
+	ChildTickets       int64          `json:"child_tickets,omitempty"`
+	TotalPrice         float64        `json:"total_price,omitempty"`
+	Currency           string         `json:"currency,omitempty"`
+	ItineraryId        string         `json:"itinerary_id,omitempty"`
+	HoldExpiresAt      string         `json:"hold_expires_at,omitempty"`
+	ApprovalDueAt      string         `json:"approval_due_at,omitempty"`
+	Guests             []BookingGuest `json:"guests"`
+	SpecialRequests    []string       `json:"special_requests"`
+	SpecialRequestNote string         `json:"special_request_note,omitempty"`
+	AddOns             []BookingAddOn `json:"add_ons"`
+	ConfirmationCode   string         `json:"confirmation_code"`
+	CreatedAt          string         `json:"created_at"`
+	UpdatedAt          string         `json:"updated_at"`
+	DeletedAt          string         `json:"deleted_at"`
+}
+
+type QuoteReq struct {
+	HraId          string `json:"hra_id"`
+	WillArrive     string `json:"will_arrive" default:"2024-12-30"`
+	WillLeave      string `json:"will_leave" default:"2025-01-02"`
+	NumberOfPeople int64  `json:"number_of_people" default:"2"`
+}
+
+type PriceQuoteModel struct {
+	Nights        int64   `json:"nights"`
+	HolidayNights int64   `json:"holiday_nights"`
+	NightlyPrice  float64 `json:"nightly_price"`
+	Subtotal      float64 `json:"subtotal"`
+	Surcharge     float64 `json:"surcharge"`
+	Discount      float64 `json:"discount"`
+	Total         float64 `json:"total"`
+	Currency      string  `json:"currency"`
 }
 
 type IdReq struct {
@@ -45,6 +118,7 @@ type IdRes struct {
 
 type List struct {
 	Bookings []*BookingRes `json:"bookings"`
+	Count    int64         `json:"count"`
 }
 
 type BookedUser struct {
@@ -53,3 +127,34 @@ type BookedUser struct {
 	PhoneNumber string `json:"phone_number"`
 	BookedTime  string `json:"created_at"`
 }
+
+type StatusReq struct {
+	Reason string `json:"reason"`
+}
+
+type StatusChangeModel struct {
+	Id          string `json:"id"`
+	BookingId   string `json:"booking_id"`
+	BookingType string `json:"booking_type"`
+	FromStatus  string `json:"from_status"`
+	ToStatus    string `json:"to_status"`
+	Reason      string `json:"reason"`
+	ChangedBy   string `json:"changed_by"`
+	CreatedAt   string `json:"created_at"`
+
+	RefundPercent float64 `json:"refund_percent,omitempty"`
+	RefundAmount  float64 `json:"refund_amount,omitempty"`
+	Currency      string  `json:"currency,omitempty"`
+
+	FromWillArrive  string  `json:"from_will_arrive,omitempty"`
+	FromWillLeave   string  `json:"from_will_leave,omitempty"`
+	ToWillArrive    string  `json:"to_will_arrive,omitempty"`
+	ToWillLeave     string  `json:"to_will_leave,omitempty"`
+	PriceDifference float64 `json:"price_difference,omitempty"`
+	ChangeFee       float64 `json:"change_fee,omitempty"`
+}
+
+type StatusHistoryModel struct {
+	Status  string               `json:"status"`
+	Changes []*StatusChangeModel `json:"changes"`
+}
@@ -0,0 +1,11 @@
+package models
+
+type SetBookingMode struct {
+	Mode string `json:"mode" binding:"required" default:"request"`
+}
+
+type BookingModeModel struct {
+	EstablishmentId string `json:"establishment_id"`
+	Mode            string `json:"mode"`
+	UpdatedAt       string `json:"updated_at"`
+}
@@ -0,0 +1,31 @@
+package models
+
+type CalendarFeedRes struct {
+	Url string `json:"url"`
+}
+
+type AddCalendarReq struct {
+	Name string `json:"name" default:"Other platform"`
+	Url  string `json:"url" default:"https://example.com/listing.ics"`
+}
+
+type CalendarSourceModel struct {
+	Id           string `json:"id"`
+	RoomId       string `json:"room_id"`
+	Name         string `json:"name"`
+	Url          string `json:"url"`
+	LastSyncedAt string `json:"last_synced_at"`
+	LastError    string `json:"last_error"`
+	Blocks       int64  `json:"blocks"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type ExternalBlockModel struct {
+	Id       string `json:"id"`
+	SourceId string `json:"source_id"`
+	Uid      string `json:"uid"`
+	Summary  string `json:"summary"`
+	StartsOn string `json:"starts_on"`
+	EndsOn   string `json:"ends_on"`
+}
@@ -0,0 +1,17 @@
+package models
+
+type CancellationRuleModel struct {
+	HoursBefore      int64   `json:"hours_before" default:"48"`
+	RefundPercent    float64 `json:"refund_percent" default:"100"`
+	ChangeFeePercent float64 `json:"change_fee_percent" default:"0"`
+}
+
+type SetCancellationPolicy struct {
+	Rules []*CancellationRuleModel `json:"rules"`
+}
+
+type CancellationPolicyModel struct {
+	EstablishmentId string                   `json:"establishment_id"`
+	Rules           []*CancellationRuleModel `json:"rules"`
+	UpdatedAt       string                   `json:"updated_at"`
+}
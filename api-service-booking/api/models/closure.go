@@ -0,0 +1,31 @@
+package models
+
+type CreateClosure struct {
+	StartDate string   `json:"start_date" default:"2030-02-01"`
+	EndDate   string   `json:"end_date" default:"2030-02-14"`
+	Weekdays  []string `json:"weekdays"`
+	Reason    string   `json:"reason" default:"renovation"`
+}
+
+type UpdateClosure struct {
+	StartDate string   `json:"start_date" default:"2030-02-01"`
+	EndDate   string   `json:"end_date" default:"2030-02-20"`
+	Weekdays  []string `json:"weekdays"`
+	Reason    string   `json:"reason" default:"renovation of the lobby"`
+}
+
+type ClosureModel struct {
+	ClosureId       string   `json:"closure_id"`
+	EstablishmentId string   `json:"establishment_id"`
+	StartDate       string   `json:"start_date"`
+	EndDate         string   `json:"end_date"`
+	Weekdays        []string `json:"weekdays"`
+	Reason          string   `json:"reason"`
+	CreatedAt       string   `json:"created_at"`
+	UpdatedAt       string   `json:"updated_at"`
+}
+
+type ListClosuresModel struct {
+	Closures []*ClosureModel `json:"closures"`
+	Count    uint64          `json:"count"`
+}
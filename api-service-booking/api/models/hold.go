@@ -0,0 +1,12 @@
+package models
+
+type CreateHoldReq struct {
+	BookingType    string `json:"booking_type" default:"hotel"`
+	HraId          string `json:"hra_id"`
+	WillArrive     string `json:"will_arrive" default:"2024-12-30"`
+	WillLeave      string `json:"will_leave" default:"2025-01-02"`
+	NumberOfPeople int64  `json:"number_of_people" default:"2"`
+	Reason         string `json:"reason"`
+	AdultTickets   int64  `json:"adult_tickets"`
+	ChildTickets   int64  `json:"child_tickets"`
+}
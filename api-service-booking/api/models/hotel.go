@@ -22,6 +22,7 @@ type HotelModel struct {
 	WebsiteUrl    string        `json:"website_url"`
 	Images        []*ImageModel `json:"images"`
 	Location      LocationModel `json:"location"`
+	Rooms         []*RoomModel  `json:"rooms,omitempty"`
 	CreatedAt     string        `json:"created_at"`
 	UpdatedAt     string        `json:"updated_at"`
 }
@@ -39,4 +40,21 @@ type UpdateHotel struct {
 	LicenceUrl    string         `json:"licence_url" default:"updated licence url"`
 	WebsiteUrl    string         `json:"website_url" default:"updated website url"`
 	Location      UpdateLocation `json:"location"`
-}
\ No newline at end of file
+}
+type AvailableHotelModel struct {
+	HotelId         string  `json:"hotel_id"`
+	HotelName       string  `json:"hotel_name"`
+	Rating          float32 `json:"rating"`
+	Address         string  `json:"address"`
+	City            string  `json:"city"`
+	RoomId          string  `json:"room_id"`
+	RoomDescription string  `json:"room_description"`
+	RoomCapacity    int64   `json:"room_capacity"`
+	NightlyPrice    float64 `json:"nightly_price"`
+	FreeRooms       int64   `json:"free_rooms"`
+}
+
+type ListAvailableHotelsModel struct {
+	Hotels []*AvailableHotelModel `json:"hotels"`
+	Count  int64                  `json:"count"`
+}
@@ -0,0 +1,25 @@
+package models
+
+type ItineraryBookingReq struct {
+	BookingType    string `json:"booking_type" default:"hotel"`
+	HraId          string `json:"hra_id"`
+	WillArrive     string `json:"will_arrive"`
+	WillLeave      string `json:"will_leave"`
+	NumberOfPeople int64  `json:"number_of_people"`
+	Reason         string `json:"reason"`
+	AdultTickets   int64  `json:"adult_tickets"`
+	ChildTickets   int64  `json:"child_tickets"`
+}
+
+type CreateItineraryReq struct {
+	Bookings []*ItineraryBookingReq `json:"bookings"`
+}
+
+type ItineraryModel struct {
+	Id        string        `json:"id"`
+	UserId    string        `json:"user_id"`
+	Status    string        `json:"status"`
+	Bookings  []*BookingRes `json:"bookings"`
+	CreatedAt string        `json:"created_at"`
+	UpdatedAt string        `json:"updated_at"`
+}
@@ -0,0 +1,18 @@
+package models
+
+type OwnerBookingModel struct {
+	EstablishmentId string      `json:"establishment_id"`
+	Booking         *BookingRes `json:"booking"`
+	Guest           *GuestModel `json:"guest"`
+}
+
+type GuestModel struct {
+	FullName    string `json:"full_name"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phone_number"`
+}
+
+type OwnerBookingList struct {
+	Bookings []*OwnerBookingModel `json:"bookings"`
+	Count    int64                `json:"count"`
+}
@@ -0,0 +1,33 @@
+package models
+
+type PayReq struct {
+	CardToken string `json:"card_token" default:"tok_visa"`
+}
+
+type PaymentWebhookReq struct {
+	Event       string `json:"event" default:"payment.succeeded"`
+	ProviderRef string `json:"provider_ref"`
+}
+
+type PaymentAttemptModel struct {
+	AttemptId string `json:"attempt_id"`
+	Action    string `json:"action"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type PaymentModel struct {
+	PaymentId      string                 `json:"payment_id"`
+	BookingId      string                 `json:"booking_id"`
+	UserId         string                 `json:"user_id"`
+	Amount         float64                `json:"amount"`
+	Currency       string                 `json:"currency"`
+	RefundedAmount float64                `json:"refunded_amount"`
+	Status         string                 `json:"status"`
+	Provider       string                 `json:"provider"`
+	ProviderRef    string                 `json:"provider_ref"`
+	Attempts       []*PaymentAttemptModel `json:"attempts"`
+	CreatedAt      string                 `json:"created_at"`
+	UpdatedAt      string                 `json:"updated_at"`
+}
@@ -5,6 +5,8 @@ type CreateRestaurant struct {
 	Description    string         `json:"description" default:"uzbek national cousine"`
 	Rating         float64        `json:"rating" default:"4.1"`
 	OpeningHours   string         `json:"opening_hours"  default:"06:00-22:00"`
+	SeatCapacity   int64          `json:"seat_capacity" default:"50"`
+	SlotMinutes    int64          `json:"slot_minutes" default:"120"`
 	ContactNumber  string         `json:"contact_number" default:"+(99891)-234-56-78"`
 	LicenceUrl     string         `json:"licence_url" default:"https://creativecommons.org/licenses/by/3.2/"`
 	WebsiteUrl     string         `json:"website_url" default:"https://creativecommons.org/licenses/by/3.3/"`
@@ -19,6 +21,8 @@ type RestaurantModel struct {
 	Description    string        `json:"description"`
 	Rating         float32       `json:"rating"`
 	OpeningHours   string        `json:"opening_hours"`
+	SeatCapacity   int64         `json:"seat_capacity"`
+	SlotMinutes    int64         `json:"slot_minutes"`
 	ContactNumber  string        `json:"contact_number"`
 	LicenceUrl     string        `json:"licence_url"`
 	WebsiteUrl     string        `json:"website_url"`
@@ -38,8 +42,21 @@ type UpdateRestaurant struct {
 	Description    string         `json:"description" default:"updated description"`
 	Rating         float64        `json:"rating" default:"4.9"`
 	OpeningHours   string         `json:"opening_hours" default:"09:00-00:00"`
+	SeatCapacity   int64          `json:"seat_capacity" default:"60"`
+	SlotMinutes    int64          `json:"slot_minutes" default:"90"`
 	ContactNumber  string         `json:"contact_number" default:"updated contact number"`
 	LicenceUrl     string         `json:"licence_url" default:"updated licence url"`
 	WebsiteUrl     string         `json:"website_url" default:"updated website url"`
 	Location       UpdateLocation `json:"location"`
-}
\ No newline at end of file
+}
+
+type SlotModel struct {
+	Start     string `json:"start"`
+	End       string `json:"end"`
+	FreeSeats int64  `json:"free_seats"`
+}
+
+type ListSlotsModel struct {
+	Slots        []*SlotModel `json:"slots"`
+	SeatCapacity int64        `json:"seat_capacity"`
+}
@@ -0,0 +1,28 @@
+package models
+
+type CreateRoom struct {
+	Price         float64 `json:"price" default:"120"`
+	Description   string  `json:"description" default:"double room with a city view"`
+	NumberOfRooms int64   `json:"number_of_rooms" default:"5"`
+	Capacity      int64   `json:"capacity" default:"2"`
+	Holidays      string  `json:"holidays" default:"2024-12-31"` // charged with the holiday surcharge
+	Discount      float64 `json:"discount" default:"10"`
+}
+
+type RoomModel struct {
+	RoomId        string  `json:"room_id"`
+	HotelId       string  `json:"hotel_id"`
+	Price         float64 `json:"price"`
+	Description   string  `json:"description"`
+	NumberOfRooms int64   `json:"number_of_rooms"`
+	Capacity      int64   `json:"capacity"`
+	Holidays      string  `json:"holidays"`
+	Discount      float64 `json:"discount"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+type ListRoomsModel struct {
+	Rooms []*RoomModel `json:"rooms"`
+	Count uint64       `json:"count"`
+}
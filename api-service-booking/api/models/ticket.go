@@ -0,0 +1,10 @@
+package models
+
+type TicketScanReq struct {
+	Payload string `json:"payload" binding:"required"`
+}
+
+type TicketScanRes struct {
+	Booking *BookingRes        `json:"booking"`
+	Change  *StatusChangeModel `json:"change"`
+}
@@ -0,0 +1,37 @@
+package models
+
+type CreateTicketType struct {
+	Name  string  `json:"name" default:"adult"`
+	Price float64 `json:"price" default:"15"`
+}
+
+type UpdateTicketType struct {
+	Price float64 `json:"price" default:"12.5"`
+}
+
+type TicketTypeModel struct {
+	TicketTypeId string  `json:"ticket_type_id"`
+	AttractionId string  `json:"attraction_id"`
+	Name         string  `json:"name"`
+	Price        float64 `json:"price"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+type ListTicketTypesModel struct {
+	TicketTypes []*TicketTypeModel `json:"ticket_types"`
+	Count       uint64             `json:"count"`
+}
+
+type EntryWindowModel struct {
+	Start     string `json:"start"`
+	End       string `json:"end"`
+	Remaining int64  `json:"remaining"`
+}
+
+type AttractionQuotaModel struct {
+	DailyQuota     int64               `json:"daily_quota"`
+	DailyRemaining int64               `json:"daily_remaining"`
+	WindowQuota    int64               `json:"window_quota"`
+	Windows        []*EntryWindowModel `json:"windows"`
+}
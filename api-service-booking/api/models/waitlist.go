@@ -0,0 +1,25 @@
+package models
+
+type JoinWaitlistReq struct {
+	BookingType    string `json:"booking_type" default:"hotel"`
+	HraId          string `json:"hra_id"`
+	WillArrive     string `json:"will_arrive" default:"2024-12-30"`
+	WillLeave      string `json:"will_leave" default:"2025-01-02"`
+	NumberOfPeople int64  `json:"number_of_people" default:"2"`
+	Reason         string `json:"reason"`
+}
+
+type WaitlistEntryModel struct {
+	Id             string `json:"id"`
+	BookingType    string `json:"booking_type"`
+	UserId         string `json:"user_id"`
+	HraId          string `json:"hra_id"`
+	WillArrive     string `json:"will_arrive"`
+	WillLeave      string `json:"will_leave"`
+	NumberOfPeople int64  `json:"number_of_people"`
+	Reason         string `json:"reason"`
+	Status         string `json:"status"`
+	OfferExpiresAt string `json:"offer_expires_at,omitempty"`
+	BookingId      string `json:"booking_id,omitempty"`
+	CreatedAt      string `json:"created_at"`
+}
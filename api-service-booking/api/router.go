@@ -18,6 +18,7 @@ import (
 	"go.uber.org/zap"
 
 	grpcClients "Booking/api-service-booking/internal/infrastructure/grpc_service_client"
+	redisrepo "Booking/api-service-booking/internal/infrastructure/repository/redis"
 	"Booking/api-service-booking/internal/pkg/config"
 	tokens "Booking/api-service-booking/internal/pkg/token"
 	"Booking/api-service-booking/internal/usecase/app_version"
@@ -34,6 +35,7 @@ type RouteOption struct {
 	BrokerProducer event.BrokerProducer
 	AppVersion     app_version.AppVersion
 	Enforcer       *casbin.Enforcer
+	Cache          redisrepo.Cache
 }
 
 // NewRouter
@@ -74,6 +76,9 @@ func NewRoute(option RouteOption) *gin.Engine {
 	router.Static("/media", "./media")
 	api := router.Group("/v1")
 
+	// requests that book or move money can be retried with an Idempotency-Key
+	idempotent := middleware.Idempotency(option.Cache, *option.Config, option.Logger)
+
 	// USER METHODS
 
 	api.POST("/users", HandlerV1.Create)
@@ -92,6 +97,29 @@ func NewRoute(option RouteOption) *gin.Engine {
 	api.DELETE("/attraction", HandlerV1.DeleteAttraction)
 	api.GET("/attraction/listlocation", HandlerV1.ListAttractionsByLocation)
 	api.GET("/attraction/find", HandlerV1.FindAttractionsByName)
+	api.GET("/attraction/:id/quota", HandlerV1.AttractionQuota)
+
+	api.POST("/attraction/:id/tickets", HandlerV1.CreateTicketType)
+	api.GET("/attraction/:id/tickets", HandlerV1.ListTicketTypesByAttraction)
+	api.PUT("/attraction/:id/tickets/:ticket_type_id", HandlerV1.UpdateTicketType)
+	api.DELETE("/attraction/:id/tickets/:ticket_type_id", HandlerV1.DeleteTicketType)
+
+	api.GET("/attraction/:id/calendar-feed", HandlerV1.EstablishmentCalendarFeed)
+	api.GET("/attraction/:id/calendar.ics", HandlerV1.EstablishmentCalendar)
+
+	api.PUT("/attraction/:id/cancellation-policy", HandlerV1.SetCancellationPolicy)
+	api.GET("/attraction/:id/cancellation-policy", HandlerV1.GetCancellationPolicy)
+	api.DELETE("/attraction/:id/cancellation-policy", HandlerV1.DeleteCancellationPolicy)
+	api.PUT("/attraction/:id/booking-mode", HandlerV1.SetBookingMode)
+	api.GET("/attraction/:id/booking-mode", HandlerV1.GetBookingMode)
+	api.POST("/attraction/:id/add-ons", HandlerV1.CreateAddOn)
+	api.GET("/attraction/:id/add-ons", HandlerV1.ListAddOns)
+	api.PUT("/attraction/:id/add-ons/:add_on_id", HandlerV1.UpdateAddOn)
+	api.DELETE("/attraction/:id/add-ons/:add_on_id", HandlerV1.DeleteAddOn)
+	api.POST("/attraction/:id/closures", HandlerV1.CreateClosure)
+	api.GET("/attraction/:id/closures", HandlerV1.ListClosures)
+	api.PUT("/attraction/:id/closures/:closure_id", HandlerV1.UpdateClosure)
+	api.DELETE("/attraction/:id/closures/:closure_id", HandlerV1.DeleteClosure)
 
 	// HOTEL METHODS
 	api.POST("/hotel", HandlerV1.CreateHotel)
@@ -101,6 +129,39 @@ func NewRoute(option RouteOption) *gin.Engine {
 	api.DELETE("/hotel", HandlerV1.DeleteHotel)
 	api.GET("/hotel/listlocation", HandlerV1.ListHotelsByLocation)
 	api.GET("/hotel/find", HandlerV1.FindHotelsByName)
+	api.GET("/hotel/available", HandlerV1.ListAvailableHotels)
+
+	api.GET("/hotel/:id/calendar-feed", HandlerV1.EstablishmentCalendarFeed)
+	api.GET("/hotel/:id/calendar.ics", HandlerV1.EstablishmentCalendar)
+
+	api.PUT("/hotel/:id/cancellation-policy", HandlerV1.SetCancellationPolicy)
+	api.GET("/hotel/:id/cancellation-policy", HandlerV1.GetCancellationPolicy)
+	api.DELETE("/hotel/:id/cancellation-policy", HandlerV1.DeleteCancellationPolicy)
+	api.PUT("/hotel/:id/booking-mode", HandlerV1.SetBookingMode)
+	api.GET("/hotel/:id/booking-mode", HandlerV1.GetBookingMode)
+	api.POST("/hotel/:id/add-ons", HandlerV1.CreateAddOn)
+	api.GET("/hotel/:id/add-ons", HandlerV1.ListAddOns)
+	api.PUT("/hotel/:id/add-ons/:add_on_id", HandlerV1.UpdateAddOn)
+	api.DELETE("/hotel/:id/add-ons/:add_on_id", HandlerV1.DeleteAddOn)
+	api.POST("/hotel/:id/closures", HandlerV1.CreateClosure)
+	api.GET("/hotel/:id/closures", HandlerV1.ListClosures)
+	api.PUT("/hotel/:id/closures/:closure_id", HandlerV1.UpdateClosure)
+	api.DELETE("/hotel/:id/closures/:closure_id", HandlerV1.DeleteClosure)
+
+	// ROOM METHODS
+	api.POST("/hotel/:id/rooms", HandlerV1.CreateRoom)
+	api.GET("/hotel/:id/rooms", HandlerV1.ListRoomsByHotel)
+	api.GET("/hotel/:id/rooms/:room_id", HandlerV1.GetRoom)
+	api.PUT("/hotel/:id/rooms/:room_id", HandlerV1.UpdateRoom)
+	api.DELETE("/hotel/:id/rooms/:room_id", HandlerV1.DeleteRoom)
+
+	// EXTERNAL CALENDAR METHODS
+	api.POST("/hotel/:id/rooms/:room_id/calendars", HandlerV1.AddExternalCalendar)
+	api.POST("/hotel/:id/rooms/:room_id/calendars/upload", HandlerV1.UploadExternalCalendar)
+	api.GET("/hotel/:id/rooms/:room_id/calendars", HandlerV1.ListExternalCalendars)
+	api.POST("/hotel/:id/rooms/:room_id/calendars/:calendar_id/sync", HandlerV1.SyncExternalCalendar)
+	api.DELETE("/hotel/:id/rooms/:room_id/calendars/:calendar_id", HandlerV1.RemoveExternalCalendar)
+	api.GET("/hotel/:id/rooms/:room_id/blocks", HandlerV1.ListExternalBlocks)
 
 	// RESTAURANT METHODS
 	api.POST("/restaurant", HandlerV1.CreateRestaurant)
@@ -110,6 +171,24 @@ func NewRoute(option RouteOption) *gin.Engine {
 	api.DELETE("/restaurant", HandlerV1.DeleteRestaurant)
 	api.GET("/restaurant/listlocation", HandlerV1.ListRestaurantsByLocation)
 	api.GET("/restaurant/find", HandlerV1.FindRestaurantsByName)
+	api.GET("/restaurant/:id/slots", HandlerV1.ListRestaurantSlots)
+
+	api.GET("/restaurant/:id/calendar-feed", HandlerV1.EstablishmentCalendarFeed)
+	api.GET("/restaurant/:id/calendar.ics", HandlerV1.EstablishmentCalendar)
+
+	api.PUT("/restaurant/:id/cancellation-policy", HandlerV1.SetCancellationPolicy)
+	api.GET("/restaurant/:id/cancellation-policy", HandlerV1.GetCancellationPolicy)
+	api.DELETE("/restaurant/:id/cancellation-policy", HandlerV1.DeleteCancellationPolicy)
+	api.PUT("/restaurant/:id/booking-mode", HandlerV1.SetBookingMode)
+	api.GET("/restaurant/:id/booking-mode", HandlerV1.GetBookingMode)
+	api.POST("/restaurant/:id/add-ons", HandlerV1.CreateAddOn)
+	api.GET("/restaurant/:id/add-ons", HandlerV1.ListAddOns)
+	api.PUT("/restaurant/:id/add-ons/:add_on_id", HandlerV1.UpdateAddOn)
+	api.DELETE("/restaurant/:id/add-ons/:add_on_id", HandlerV1.DeleteAddOn)
+	api.POST("/restaurant/:id/closures", HandlerV1.CreateClosure)
+	api.GET("/restaurant/:id/closures", HandlerV1.ListClosures)
+	api.PUT("/restaurant/:id/closures/:closure_id", HandlerV1.UpdateClosure)
+	api.DELETE("/restaurant/:id/closures/:closure_id", HandlerV1.DeleteClosure)
 
 	// FAVOURITE METHODS
 	api.POST("/favourite/add", HandlerV1.AddToFavourites)
@@ -143,8 +222,58 @@ func NewRoute(option RouteOption) *gin.Engine {
 	api.POST("/media/user-photo", HandlerV1.UploadMedia)
 	api.POST("/media/establishment/:id", HandlerV1.CreateEstablishmentMedia)
 
+	// BOOKING
+	api.POST("/bookings", idempotent, HandlerV1.CreateBooking)
+	api.GET("/bookings", HandlerV1.ListUserBookings)
+	api.GET("/bookings/list", HandlerV1.ListBookings)
+	api.GET("/bookings/deleted", HandlerV1.ListDeletedBookings)
+	api.GET("/bookings/calendar.ics", HandlerV1.UserBookingsCalendar)
+	api.GET("/bookings/establishment/:id/users", HandlerV1.ListBookedUsers)
+	api.GET("/bookings/code/:code", HandlerV1.GetBookingByCode)
+	api.GET("/bookings/:id", HandlerV1.GetBooking)
+	api.GET("/bookings/:id/calendar.ics", HandlerV1.BookingCalendar)
+	api.GET("/bookings/:id/ticket", HandlerV1.BookingTicket)
+	api.PUT("/bookings", HandlerV1.UpdateBooking)
+	api.DELETE("/bookings/:id", HandlerV1.DeleteBooking)
+	api.POST("/bookings/:id/reschedule", idempotent, HandlerV1.RescheduleBooking)
+
+	// WAITLIST
+	api.POST("/waitlist", HandlerV1.JoinWaitlist)
+	api.GET("/waitlist", HandlerV1.ListWaitlist)
+	api.DELETE("/waitlist/:id", HandlerV1.LeaveWaitlist)
+	api.POST("/waitlist/:id/accept", idempotent, HandlerV1.AcceptWaitlistOffer)
+	api.POST("/waitlist/:id/decline", HandlerV1.DeclineWaitlistOffer)
+
+	// ITINERARY
+	api.POST("/itineraries", idempotent, HandlerV1.CreateItinerary)
+	api.GET("/itineraries", HandlerV1.ListItineraries)
+	api.GET("/itineraries/:id", HandlerV1.GetItinerary)
+	api.POST("/itineraries/:id/cancel", idempotent, HandlerV1.CancelItinerary)
+
+	// HOLD
+	api.POST("/holds", idempotent, HandlerV1.CreateHold)
+	api.POST("/holds/:id/confirm", idempotent, HandlerV1.ConfirmHold)
+	api.DELETE("/holds/:id", HandlerV1.ReleaseHold)
+
+	// TICKET
+	api.POST("/tickets/check-in", HandlerV1.ScanTicket)
+
+	// OWNER
+	api.GET("/owner/bookings", HandlerV1.ListOwnerBookings)
+
 	// BOOKING HOTEL
-	api.POST("/booking/hotels", HandlerV1.UHBCreate)
+	api.POST("/booking/hotels", idempotent, HandlerV1.UHBCreate)
+	api.POST("/booking/hotels/quote", HandlerV1.UHBQuote)
+	api.POST("/booking/hotels/:id/pay", idempotent, HandlerV1.PayHotelBooking)
+	api.GET("/booking/hotels/:id/payment", HandlerV1.GetHotelBookingPayment)
+	api.POST("/booking/hotels/:id/refund", idempotent, HandlerV1.RefundHotelBooking)
+	api.POST("/booking/hotels/:id/confirm", HandlerV1.UHBConfirm)
+	api.POST("/booking/hotels/:id/cancel", idempotent, HandlerV1.UHBCancel)
+	api.POST("/booking/hotels/:id/check-in", HandlerV1.UHBCheckIn)
+	api.POST("/booking/hotels/:id/accept", HandlerV1.UHBAccept)
+	api.POST("/booking/hotels/:id/decline", HandlerV1.UHBDecline)
+	api.GET("/booking/hotels/:id/history", HandlerV1.UHBHistory)
+	api.POST("/payments/webhook", HandlerV1.PaymentWebhook)
 	api.GET("/booking/hotels/:id", HandlerV1.UHBGetAllByUId)
 	api.GET("/booking/users/room/:id", HandlerV1.UHBGetAllByHId)
 	api.GET("/booking/hotels", HandlerV1.UHBList)
@@ -153,7 +282,13 @@ func NewRoute(option RouteOption) *gin.Engine {
 	api.DELETE("/booking/hotels/:id", HandlerV1.UHBDelete)
 	
 	// BOOKING RESTAURANT
-	api.POST("/booking/restaurants", HandlerV1.URBCreate)
+	api.POST("/booking/restaurants", idempotent, HandlerV1.URBCreate)
+	api.POST("/booking/restaurants/:id/confirm", HandlerV1.URBConfirm)
+	api.POST("/booking/restaurants/:id/cancel", idempotent, HandlerV1.URBCancel)
+	api.POST("/booking/restaurants/:id/check-in", HandlerV1.URBCheckIn)
+	api.POST("/booking/restaurants/:id/accept", HandlerV1.URBAccept)
+	api.POST("/booking/restaurants/:id/decline", HandlerV1.URBDecline)
+	api.GET("/booking/restaurants/:id/history", HandlerV1.URBHistory)
 	api.GET("/booking/restaurants/:id", HandlerV1.URBGetAllByUId)
 	api.GET("/booking/users/restaurant/:id", HandlerV1.URBGetAllByRId)
 	api.GET("/booking/restaurants", HandlerV1.URBList)
@@ -162,7 +297,13 @@ func NewRoute(option RouteOption) *gin.Engine {
 	api.DELETE("/booking/restaurants/:id", HandlerV1.URBDelete)
 
 	// BOOKING ATTRACTION
-	api.POST("/booking/attractions", HandlerV1.UABCreate)
+	api.POST("/booking/attractions", idempotent, HandlerV1.UABCreate)
+	api.POST("/booking/attractions/:id/confirm", HandlerV1.UABConfirm)
+	api.POST("/booking/attractions/:id/cancel", idempotent, HandlerV1.UABCancel)
+	api.POST("/booking/attractions/:id/check-in", HandlerV1.UABCheckIn)
+	api.POST("/booking/attractions/:id/accept", HandlerV1.UABAccept)
+	api.POST("/booking/attractions/:id/decline", HandlerV1.UABDecline)
+	api.GET("/booking/attractions/:id/history", HandlerV1.UABHistory)
 	api.GET("/booking/attractions/:id", HandlerV1.UABGetAllByUId)
 	api.GET("/booking/users/attraction/:id", HandlerV1.UABGetAllByAId)
 	api.GET("/booking/attractions", HandlerV1.UABList)
@@ -17,6 +17,7 @@ import (
 
 	// "Booking/api-service-booking/internal/infrastructure/kafka"
 	"Booking/api-service-booking/internal/infrastructure/repository/postgresql"
+	redisrepo "Booking/api-service-booking/internal/infrastructure/repository/redis"
 	"Booking/api-service-booking/internal/pkg/config"
 	"Booking/api-service-booking/internal/pkg/logger"
 	"Booking/api-service-booking/internal/pkg/otlp"
@@ -119,7 +120,7 @@ func (a *App) Run() error {
 	a.Clients = clients
 
 	// initialize cache
-	// cache := redisrepo.NewCache(a.RedisDB)
+	cache := redisrepo.NewCache(a.RedisDB)
 
 	// tokenRepo := postgresql.NewRefreshTokenRepo(a.DB)
 
@@ -131,7 +132,7 @@ func (a *App) Run() error {
 		Config:         a.Config,
 		Logger:         a.Logger,
 		ContextTimeout: contextTimeout,
-		// Cache:          cache,
+		Cache:          cache,
 		Enforcer:       a.Enforcer,
 		Service:        clients,
 		BrokerProducer: a.BrokerProducer,
@@ -13,6 +13,7 @@ import (
 
 type Cache interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
 	Get(ctx context.Context, key string) ([]byte, error)
 	Del(ctx context.Context, key string) error
 }
@@ -42,6 +43,16 @@ func (c *cache) Set(ctx context.Context, key string, value interface{}, expirati
 	return nil
 }
 
+// SetNX sets key only when it does not exist yet and reports whether it did
+func (c *cache) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	byteData, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+
+	return c.rdb.Client.SetNX(ctx, key, string(byteData), expiration).Result()
+}
+
 func (c *cache) Get(ctx context.Context, key string) ([]byte, error) {
 	// tracing
 	// ctx, span := otlp_pkg.Start(ctx, "cecheService", "CasheRepoGet")
@@ -62,6 +62,20 @@ type Config struct {
 			UserCreateTopic string
 		}
 	}
+	Payment struct {
+		WebhookSecret string
+	}
+	Idempotency struct {
+		TTL     time.Duration
+		LockTTL time.Duration
+	}
+	Calendar struct {
+		FeedSecret string
+		BaseURL    string
+	}
+	Ticket struct {
+		Secret string
+	}
 	EstablishmentService webAddress
 	UserService          webAddress
 	BookingService       webAddress
@@ -127,6 +141,29 @@ func NewConfig() (*Config, error) {
 	config.Token.RefreshTTL = refreshTTL
 	config.Token.SignInKey = getEnv("TOKEN_SIGNIN_KEY", "debug_booking")
 
+	// payment configuration
+	config.Payment.WebhookSecret = getEnv("PAYMENT_WEBHOOK_SECRET", "payment_webhook_secret")
+
+	// idempotency configuration
+	idempotencyTTL, err := time.ParseDuration(getEnv("IDEMPOTENCY_TTL", "24h"))
+	if err != nil {
+		return nil, err
+	}
+	// a request that never finished stops blocking its key after the lock ttl
+	idempotencyLockTTL, err := time.ParseDuration(getEnv("IDEMPOTENCY_LOCK_TTL", "1m"))
+	if err != nil {
+		return nil, err
+	}
+	config.Idempotency.TTL = idempotencyTTL
+	config.Idempotency.LockTTL = idempotencyLockTTL
+
+	// calendar configuration, feed links are built from the request host when no base url is set
+	config.Calendar.FeedSecret = getEnv("CALENDAR_FEED_SECRET", "calendar_feed_secret")
+	config.Calendar.BaseURL = getEnv("CALENDAR_FEED_BASE_URL", "")
+
+	// ticket configuration, the QR codes of booking tickets are signed with the secret
+	config.Ticket.Secret = getEnv("TICKET_SECRET", "ticket_secret")
+
 	// otlp collector configuration
 	config.OTLPCollector.Host = getEnv("OTLP_COLLECTOR_HOST", "otel-collector")
 	config.OTLPCollector.Port = getEnv("OTLP_COLLECTOR_PORT", ":4317")
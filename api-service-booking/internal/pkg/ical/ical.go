@@ -0,0 +1,121 @@
+// Package ical writes iCalendar (RFC 5545) calendars of bookings
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// event statuses
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405"
+
+	// content lines longer than this many octets are folded
+	lineLimit = 75
+)
+
+type Calendar struct {
+	ProdId string
+	Name   string
+	Events []*Event
+}
+
+// Event is a VEVENT. Start and End are local times of the establishment and
+// are written as floating times, AllDay events are written as dates with an
+// exclusive End.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Status      string
+	Stamp       time.Time
+}
+
+// Bytes renders the calendar with CRLF line breaks and folded lines
+func (c *Calendar) Bytes() []byte {
+	var buf bytes.Buffer
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+c.ProdId)
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "METHOD:PUBLISH")
+	if c.Name != "" {
+		writeLine(&buf, "X-WR-CALNAME:"+EscapeText(c.Name))
+	}
+	for _, event := range c.Events {
+		event.write(&buf)
+	}
+	writeLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+func (e *Event) write(buf *bytes.Buffer) {
+	writeLine(buf, "BEGIN:VEVENT")
+	writeLine(buf, "UID:"+e.UID)
+	writeLine(buf, "DTSTAMP:"+e.Stamp.UTC().Format(dateTimeFormat)+"Z")
+	if e.AllDay {
+		writeLine(buf, "DTSTART;VALUE=DATE:"+e.Start.Format(dateFormat))
+		if !e.End.IsZero() {
+			writeLine(buf, "DTEND;VALUE=DATE:"+e.End.Format(dateFormat))
+		}
+	} else {
+		writeLine(buf, "DTSTART:"+e.Start.Format(dateTimeFormat))
+		if !e.End.IsZero() {
+			writeLine(buf, "DTEND:"+e.End.Format(dateTimeFormat))
+		}
+	}
+	writeLine(buf, "SUMMARY:"+EscapeText(e.Summary))
+	if e.Description != "" {
+		writeLine(buf, "DESCRIPTION:"+EscapeText(e.Description))
+	}
+	if e.Location != "" {
+		writeLine(buf, "LOCATION:"+EscapeText(e.Location))
+	}
+	if e.Status != "" {
+		writeLine(buf, "STATUS:"+e.Status)
+	}
+	writeLine(buf, "END:VEVENT")
+}
+
+// EscapeText escapes a TEXT value: backslashes, semicolons, commas and line breaks
+func EscapeText(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(text)
+}
+
+// writeLine writes a content line folded after lineLimit octets, a fold never
+// splits a multi-byte character
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := lineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// the leading space of a continuation line counts against the limit
+		limit = lineLimit - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,97 @@
+// Package ticket signs the payload of booking tickets and draws it as a QR code
+package ticket
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+const (
+	// payloadPrefix marks the payload version, payloads of another version
+	// are not read
+	payloadPrefix = "BKT1"
+
+	// the signature is cut to this many bytes to keep the QR code small
+	signatureLength = 16
+)
+
+var ErrInvalid = errors.New("ticket is not valid")
+
+// Ticket is what a verified payload names
+type Ticket struct {
+	BookingType string
+	BookingId   string
+}
+
+// Sign returns the payload of the ticket of a booking
+func Sign(secret, bookingType, booking_id string) string {
+	message := strings.Join([]string{payloadPrefix, bookingType, booking_id}, ".")
+	return message + "." + signature(secret, message)
+}
+
+// Verify reads a payload made by Sign with the same secret
+func Verify(secret, payload string) (*Ticket, error) {
+	parts := strings.Split(strings.TrimSpace(payload), ".")
+	if len(parts) != 4 || parts[0] != payloadPrefix || parts[1] == "" || parts[2] == "" {
+		return nil, ErrInvalid
+	}
+
+	message := strings.Join(parts[:3], ".")
+	if !hmac.Equal([]byte(parts[3]), []byte(signature(secret, message))) {
+		return nil, ErrInvalid
+	}
+
+	return &Ticket{
+		BookingType: parts[1],
+		BookingId:   parts[2],
+	}, nil
+}
+
+func signature(secret, message string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:signatureLength])
+}
+
+// PNG draws the payload as a QR code of size by size pixels
+func PNG(payload string, size int) ([]byte, error) {
+	return qrcode.Encode(payload, qrcode.Medium, size)
+}
+
+// SVG draws the payload as a QR code that scales to any size, one unit of
+// the view box is one module of the code
+func SVG(payload string) ([]byte, error) {
+	code, err := qrcode.New(payload, qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+	bitmap := code.Bitmap()
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, len(bitmap), len(bitmap))
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/>`, len(bitmap), len(bitmap))
+	b.WriteString(`<path fill="#000" d="`)
+	for y, row := range bitmap {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+			// a run of dark modules is drawn as one rectangle
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+			fmt.Fprintf(&b, "M%d %dh%dv1h-%dz", start, y, x-start, x-start)
+		}
+	}
+	b.WriteString(`"/></svg>`)
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,109 @@
+package ticket
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignVerify(t *testing.T) {
+	const secret = "ticket_secret"
+	payload := Sign(secret, "hotel", "booking-1")
+	parts := strings.Split(payload, ".")
+
+	tests := []struct {
+		name    string
+		secret  string
+		payload string
+		want    *Ticket
+	}{
+		{
+			name:    "signed payload",
+			secret:  secret,
+			payload: payload,
+			want:    &Ticket{BookingType: "hotel", BookingId: "booking-1"},
+		},
+		{
+			name:    "surrounding spaces",
+			secret:  secret,
+			payload: " " + payload + "\n",
+			want:    &Ticket{BookingType: "hotel", BookingId: "booking-1"},
+		},
+		{
+			name:    "other secret",
+			secret:  "other_secret",
+			payload: payload,
+		},
+		{
+			name:    "tampered booking id",
+			secret:  secret,
+			payload: strings.Join([]string{parts[0], parts[1], "booking-2", parts[3]}, "."),
+		},
+		{
+			name:    "tampered booking type",
+			secret:  secret,
+			payload: strings.Join([]string{parts[0], "restaurant", parts[2], parts[3]}, "."),
+		},
+		{
+			name:    "tampered signature",
+			secret:  secret,
+			payload: payload[:len(payload)-1] + flip(payload[len(payload)-1]),
+		},
+		{
+			name:    "truncated signature",
+			secret:  secret,
+			payload: payload[:len(payload)-4],
+		},
+		{
+			name:    "signature left out",
+			secret:  secret,
+			payload: strings.Join(parts[:3], "."),
+		},
+		{
+			name:    "empty signature",
+			secret:  secret,
+			payload: strings.Join(parts[:3], ".") + ".",
+		},
+		{
+			name:    "other version",
+			secret:  secret,
+			payload: strings.Join([]string{"BKT2", parts[1], parts[2], parts[3]}, "."),
+		},
+		{
+			name:    "empty booking id",
+			secret:  secret,
+			payload: Sign(secret, "hotel", ""),
+		},
+		{
+			name:    "extra part",
+			secret:  secret,
+			payload: payload + ".extra",
+		},
+		{
+			name:    "empty payload",
+			secret:  secret,
+			payload: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(tt.secret, tt.payload)
+			if tt.want == nil {
+				assert.ErrorIs(t, err, ErrInvalid)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func flip(c byte) string {
+	if c == 'A' {
+		return "B"
+	}
+	return "A"
+}
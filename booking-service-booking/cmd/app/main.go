@@ -5,6 +5,8 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	// the runtime image has no zoneinfo, RESERVATION_TIME_ZONE is read from here
+	_ "time/tzdata"
 	"Booking/booking-service-booking/internal/app"
 	"Booking/booking-service-booking/internal/pkg/config"
 
@@ -5,6 +5,7 @@ package booking
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -167,10 +168,66 @@ func (m *Filter) GetFilter() map[string]string {
 	return nil
 }
 
+type BookingId struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	BookingType          string   `protobuf:"bytes,2,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BookingId) Reset()         { *m = BookingId{} }
+func (m *BookingId) String() string { return proto.CompactTextString(m) }
+func (*BookingId) ProtoMessage()    {}
+func (*BookingId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{3}
+}
+func (m *BookingId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BookingId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BookingId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BookingId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BookingId.Merge(m, src)
+}
+func (m *BookingId) XXX_Size() int {
+	return m.Size()
+}
+func (m *BookingId) XXX_DiscardUnknown() {
+	xxx_messageInfo_BookingId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BookingId proto.InternalMessageInfo
+
+func (m *BookingId) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BookingId) GetBookingType() string {
+	if m != nil {
+		return m.BookingType
+	}
+	return ""
+}
+
 type ListReqById struct {
 	Limit                uint64   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
 	Offset               uint64   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset"`
 	Id                   *Id      `protobuf:"bytes,3,opt,name=id,proto3" json:"id"`
+	BookingType          string   `protobuf:"bytes,4,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -180,7 +237,7 @@ func (m *ListReqById) Reset()         { *m = ListReqById{} }
 func (m *ListReqById) String() string { return proto.CompactTextString(m) }
 func (*ListReqById) ProtoMessage()    {}
 func (*ListReqById) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6f4ab27959496508, []int{3}
+	return fileDescriptor_6f4ab27959496508, []int{4}
 }
 func (m *ListReqById) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,9 +287,17 @@ func (m *ListReqById) GetId() *Id {
 	return nil
 }
 
+func (m *ListReqById) GetBookingType() string {
+	if m != nil {
+		return m.BookingType
+	}
+	return ""
+}
+
 type ListReq struct {
 	Limit                uint64   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
 	Offset               uint64   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset"`
+	BookingType          string   `protobuf:"bytes,3,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -242,7 +307,7 @@ func (m *ListReq) Reset()         { *m = ListReq{} }
 func (m *ListReq) String() string { return proto.CompactTextString(m) }
 func (*ListReq) ProtoMessage()    {}
 func (*ListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6f4ab27959496508, []int{4}
+	return fileDescriptor_6f4ab27959496508, []int{5}
 }
 func (m *ListReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,136 +350,33 @@ func (m *ListReq) GetOffset() uint64 {
 	return 0
 }
 
-type ListUserHotelRes struct {
-	UserHotel            []*GeneralBook `protobuf:"bytes,1,rep,name=user_hotel,json=userHotel,proto3" json:"user_hotel"`
-	Count                int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
-}
-
-func (m *ListUserHotelRes) Reset()         { *m = ListUserHotelRes{} }
-func (m *ListUserHotelRes) String() string { return proto.CompactTextString(m) }
-func (*ListUserHotelRes) ProtoMessage()    {}
-func (*ListUserHotelRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6f4ab27959496508, []int{5}
-}
-func (m *ListUserHotelRes) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ListUserHotelRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ListUserHotelRes.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *ListUserHotelRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListUserHotelRes.Merge(m, src)
-}
-func (m *ListUserHotelRes) XXX_Size() int {
-	return m.Size()
-}
-func (m *ListUserHotelRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListUserHotelRes.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ListUserHotelRes proto.InternalMessageInfo
-
-func (m *ListUserHotelRes) GetUserHotel() []*GeneralBook {
-	if m != nil {
-		return m.UserHotel
-	}
-	return nil
-}
-
-func (m *ListUserHotelRes) GetCount() int64 {
+func (m *ListReq) GetBookingType() string {
 	if m != nil {
-		return m.Count
+		return m.BookingType
 	}
-	return 0
+	return ""
 }
 
-type ListUserRestaurantRes struct {
-	UserRestaurant       []*GeneralBook `protobuf:"bytes,1,rep,name=user_restaurant,json=userRestaurant,proto3" json:"user_restaurant"`
+type ListBookingRes struct {
+	Bookings             []*GeneralBook `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings"`
 	Count                int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ListUserRestaurantRes) Reset()         { *m = ListUserRestaurantRes{} }
-func (m *ListUserRestaurantRes) String() string { return proto.CompactTextString(m) }
-func (*ListUserRestaurantRes) ProtoMessage()    {}
-func (*ListUserRestaurantRes) Descriptor() ([]byte, []int) {
+func (m *ListBookingRes) Reset()         { *m = ListBookingRes{} }
+func (m *ListBookingRes) String() string { return proto.CompactTextString(m) }
+func (*ListBookingRes) ProtoMessage()    {}
+func (*ListBookingRes) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6f4ab27959496508, []int{6}
 }
-func (m *ListUserRestaurantRes) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ListUserRestaurantRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ListUserRestaurantRes.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *ListUserRestaurantRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListUserRestaurantRes.Merge(m, src)
-}
-func (m *ListUserRestaurantRes) XXX_Size() int {
-	return m.Size()
-}
-func (m *ListUserRestaurantRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListUserRestaurantRes.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ListUserRestaurantRes proto.InternalMessageInfo
-
-func (m *ListUserRestaurantRes) GetUserRestaurant() []*GeneralBook {
-	if m != nil {
-		return m.UserRestaurant
-	}
-	return nil
-}
-
-func (m *ListUserRestaurantRes) GetCount() int64 {
-	if m != nil {
-		return m.Count
-	}
-	return 0
-}
-
-type ListUserAttractionRes struct {
-	UserAttraction       []*GeneralBook `protobuf:"bytes,1,rep,name=user_attraction,json=userAttraction,proto3" json:"user_attraction"`
-	Count                int64          `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
-}
-
-func (m *ListUserAttractionRes) Reset()         { *m = ListUserAttractionRes{} }
-func (m *ListUserAttractionRes) String() string { return proto.CompactTextString(m) }
-func (*ListUserAttractionRes) ProtoMessage()    {}
-func (*ListUserAttractionRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6f4ab27959496508, []int{7}
-}
-func (m *ListUserAttractionRes) XXX_Unmarshal(b []byte) error {
+func (m *ListBookingRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListUserAttractionRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListBookingRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListUserAttractionRes.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListBookingRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -424,26 +386,26 @@ func (m *ListUserAttractionRes) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ListUserAttractionRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListUserAttractionRes.Merge(m, src)
+func (m *ListBookingRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBookingRes.Merge(m, src)
 }
-func (m *ListUserAttractionRes) XXX_Size() int {
+func (m *ListBookingRes) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListUserAttractionRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListUserAttractionRes.DiscardUnknown(m)
+func (m *ListBookingRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBookingRes.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListUserAttractionRes proto.InternalMessageInfo
+var xxx_messageInfo_ListBookingRes proto.InternalMessageInfo
 
-func (m *ListUserAttractionRes) GetUserAttraction() []*GeneralBook {
+func (m *ListBookingRes) GetBookings() []*GeneralBook {
 	if m != nil {
-		return m.UserAttraction
+		return m.Bookings
 	}
 	return nil
 }
 
-func (m *ListUserAttractionRes) GetCount() int64 {
+func (m *ListBookingRes) GetCount() int64 {
 	if m != nil {
 		return m.Count
 	}
@@ -451,17 +413,38 @@ func (m *ListUserAttractionRes) GetCount() int64 {
 }
 
 type GeneralBook struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	HraId                string   `protobuf:"bytes,3,opt,name=hra_id,json=hraId,proto3" json:"hra_id"`
-	WillArrive           string   `protobuf:"bytes,4,opt,name=will_arrive,json=willArrive,proto3" json:"will_arrive"`
-	WillLeave            string   `protobuf:"bytes,5,opt,name=will_leave,json=willLeave,proto3" json:"will_leave"`
-	NumberOfPeople       int64    `protobuf:"varint,6,opt,name=number_of_people,json=numberOfPeople,proto3" json:"number_of_people"`
-	IsCanceled           bool     `protobuf:"varint,7,opt,name=is_canceled,json=isCanceled,proto3" json:"is_canceled"`
-	Reason               string   `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason"`
-	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Id                 string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	UserId             string          `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	HraId              string          `protobuf:"bytes,3,opt,name=hra_id,json=hraId,proto3" json:"hra_id"`
+	WillArrive         string          `protobuf:"bytes,4,opt,name=will_arrive,json=willArrive,proto3" json:"will_arrive"`
+	WillLeave          string          `protobuf:"bytes,5,opt,name=will_leave,json=willLeave,proto3" json:"will_leave"`
+	NumberOfPeople     int64           `protobuf:"varint,6,opt,name=number_of_people,json=numberOfPeople,proto3" json:"number_of_people"`
+	IsCanceled         bool            `protobuf:"varint,7,opt,name=is_canceled,json=isCanceled,proto3" json:"is_canceled"`
+	Reason             string          `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason"`
+	CreatedAt          string          `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt          string          `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt          string          `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	AdultTickets       int64           `protobuf:"varint,12,opt,name=adult_tickets,json=adultTickets,proto3" json:"adult_tickets"`
+	ChildTickets       int64           `protobuf:"varint,13,opt,name=child_tickets,json=childTickets,proto3" json:"child_tickets"`
+	TotalPrice         float64         `protobuf:"fixed64,14,opt,name=total_price,json=totalPrice,proto3" json:"total_price"`
+	Currency           string          `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency"`
+	Status             string          `protobuf:"bytes,16,opt,name=status,proto3" json:"status"`
+	BookingType        string          `protobuf:"bytes,17,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
+	ItineraryId        string          `protobuf:"bytes,18,opt,name=itinerary_id,json=itineraryId,proto3" json:"itinerary_id"`
+	HoldExpiresAt      string          `protobuf:"bytes,19,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at"`
+	ConfirmationCode   string          `protobuf:"bytes,20,opt,name=confirmation_code,json=confirmationCode,proto3" json:"confirmation_code"`
+	ApprovalDueAt      string          `protobuf:"bytes,21,opt,name=approval_due_at,json=approvalDueAt,proto3" json:"approval_due_at"`
+	Guests             []*BookingGuest `protobuf:"bytes,22,rep,name=guests,proto3" json:"guests"`
+	SpecialRequests    []string        `protobuf:"bytes,23,rep,name=special_requests,json=specialRequests,proto3" json:"special_requests"`
+	SpecialRequestNote string          `protobuf:"bytes,24,opt,name=special_request_note,json=specialRequestNote,proto3" json:"special_request_note"`
+	AddOns             []*BookingAddOn `protobuf:"bytes,25,rep,name=add_ons,json=addOns,proto3" json:"add_ons"`
+	// an update leaves the guests as they are unless guests_set
+	GuestsSet bool `protobuf:"varint,26,opt,name=guests_set,json=guestsSet,proto3" json:"guests_set"`
+	// an update leaves the add-ons as they are unless add_ons_set
+	AddOnsSet bool `protobuf:"varint,27,opt,name=add_ons_set,json=addOnsSet,proto3" json:"add_ons_set"`
+	// an update leaves the special requests and their note as they are unless
+	// special_requests_set
+	SpecialRequestsSet   bool     `protobuf:"varint,28,opt,name=special_requests_set,json=specialRequestsSet,proto3" json:"special_requests_set"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -471,7 +454,7 @@ func (m *GeneralBook) Reset()         { *m = GeneralBook{} }
 func (m *GeneralBook) String() string { return proto.CompactTextString(m) }
 func (*GeneralBook) ProtoMessage()    {}
 func (*GeneralBook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6f4ab27959496508, []int{8}
+	return fileDescriptor_6f4ab27959496508, []int{7}
 }
 func (m *GeneralBook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,1665 +560,17267 @@ func (m *GeneralBook) GetDeletedAt() string {
 	return ""
 }
 
-type UserId struct {
-	UserId               []*Id    `protobuf:"bytes,1,rep,name=user_id,json=userId,proto3" json:"user_id"`
-	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *UserId) Reset()         { *m = UserId{} }
-func (m *UserId) String() string { return proto.CompactTextString(m) }
-func (*UserId) ProtoMessage()    {}
-func (*UserId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6f4ab27959496508, []int{9}
-}
-func (m *UserId) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *UserId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UserId.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *GeneralBook) GetAdultTickets() int64 {
+	if m != nil {
+		return m.AdultTickets
 	}
-}
-func (m *UserId) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserId.Merge(m, src)
-}
-func (m *UserId) XXX_Size() int {
-	return m.Size()
-}
-func (m *UserId) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserId.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_UserId proto.InternalMessageInfo
-
-func (m *UserId) GetUserId() []*Id {
+func (m *GeneralBook) GetChildTickets() int64 {
 	if m != nil {
-		return m.UserId
+		return m.ChildTickets
 	}
-	return nil
+	return 0
 }
 
-func (m *UserId) GetCount() int64 {
+func (m *GeneralBook) GetTotalPrice() float64 {
 	if m != nil {
-		return m.Count
+		return m.TotalPrice
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*DelRes)(nil), "booking.DelRes")
-	proto.RegisterType((*Id)(nil), "booking.Id")
-	proto.RegisterType((*Filter)(nil), "booking.Filter")
-	proto.RegisterMapType((map[string]string)(nil), "booking.Filter.FilterEntry")
-	proto.RegisterType((*ListReqById)(nil), "booking.ListReqById")
-	proto.RegisterType((*ListReq)(nil), "booking.ListReq")
-	proto.RegisterType((*ListUserHotelRes)(nil), "booking.ListUserHotelRes")
-	proto.RegisterType((*ListUserRestaurantRes)(nil), "booking.ListUserRestaurantRes")
-	proto.RegisterType((*ListUserAttractionRes)(nil), "booking.ListUserAttractionRes")
-	proto.RegisterType((*GeneralBook)(nil), "booking.GeneralBook")
-	proto.RegisterType((*UserId)(nil), "booking.UserId")
+func (m *GeneralBook) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("booking-proto/booking.proto", fileDescriptor_6f4ab27959496508) }
-
-var fileDescriptor_6f4ab27959496508 = []byte{
-	// 749 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdb, 0x6a, 0xdb, 0x4a,
-	0x14, 0x3d, 0xb2, 0x13, 0x39, 0xde, 0x02, 0xc7, 0x0c, 0xc9, 0x39, 0x3a, 0x09, 0x75, 0x8d, 0xe8,
-	0x83, 0xf3, 0xd0, 0x14, 0x12, 0xa8, 0x7b, 0xa1, 0x0f, 0x52, 0xd2, 0xc4, 0x86, 0x40, 0xcb, 0x14,
-	0x41, 0x5f, 0x8a, 0x50, 0xac, 0x71, 0x23, 0x32, 0x91, 0xdc, 0xd1, 0xc8, 0xad, 0xff, 0xa4, 0x9f,
-	0xd4, 0x97, 0x42, 0xbf, 0xa0, 0x94, 0xf4, 0x47, 0xca, 0x5c, 0xec, 0x58, 0x6e, 0x12, 0x5f, 0x9e,
-	0xec, 0xbd, 0xf6, 0x5e, 0x6b, 0x5f, 0x66, 0xf6, 0x20, 0xd8, 0x3d, 0x4f, 0xd3, 0xcb, 0x38, 0xf9,
-	0xf8, 0x78, 0xc0, 0x52, 0x9e, 0x3e, 0xd1, 0xd6, 0xbe, 0xb4, 0x50, 0x45, 0x9b, 0x4e, 0x13, 0xcc,
-	0x63, 0x42, 0x31, 0xc9, 0xd0, 0xbf, 0x60, 0x32, 0x92, 0xe5, 0x94, 0xdb, 0x46, 0xd3, 0x68, 0x55,
-	0xb1, 0xb6, 0x9c, 0x2d, 0x28, 0x75, 0x23, 0x54, 0x83, 0x52, 0x1c, 0x69, 0x4f, 0x29, 0x8e, 0x9c,
-	0x2f, 0x60, 0x9e, 0xc4, 0x94, 0x13, 0x86, 0x0e, 0xc1, 0xec, 0xcb, 0x7f, 0xb6, 0xd1, 0x2c, 0xb7,
-	0xac, 0x83, 0xdd, 0xfd, 0x71, 0x2a, 0x15, 0xa0, 0x7f, 0x5e, 0x27, 0x9c, 0x8d, 0xb0, 0x0e, 0xdd,
-	0x79, 0x0e, 0xd6, 0x14, 0x8c, 0xea, 0x50, 0xbe, 0x24, 0x23, 0x2d, 0x2f, 0xfe, 0xa2, 0x2d, 0x58,
-	0x1f, 0x86, 0x34, 0x27, 0x76, 0x49, 0x62, 0xca, 0x78, 0x51, 0x7a, 0x66, 0x38, 0xef, 0xc1, 0x3a,
-	0x8b, 0x33, 0x8e, 0xc9, 0x27, 0x6f, 0xd4, 0x8d, 0x44, 0x20, 0x8d, 0xaf, 0x62, 0x55, 0xf5, 0x1a,
-	0x56, 0x86, 0x68, 0x26, 0xed, 0xf7, 0x33, 0xc2, 0x25, 0x7f, 0x0d, 0x6b, 0x0b, 0xed, 0xca, 0x36,
-	0xca, 0x4d, 0xa3, 0x65, 0x1d, 0x58, 0x93, 0x42, 0xbb, 0x91, 0xec, 0xa9, 0x0d, 0x15, 0xad, 0xbc,
-	0x9c, 0xaa, 0xf3, 0x01, 0xea, 0x82, 0xe8, 0x67, 0x84, 0x75, 0x52, 0xae, 0xc6, 0x79, 0x08, 0x90,
-	0x67, 0x84, 0x05, 0x17, 0x02, 0xd0, 0xa3, 0xd9, 0x9a, 0x64, 0x3c, 0x25, 0x09, 0x61, 0x21, 0xf5,
-	0xd2, 0xf4, 0x12, 0x57, 0xf3, 0x31, 0x4f, 0xa4, 0xed, 0xa5, 0x79, 0xa2, 0xf4, 0xcb, 0x58, 0x19,
-	0x0e, 0x85, 0xed, 0xb1, 0x3c, 0x26, 0x19, 0x0f, 0x73, 0x16, 0x26, 0x5c, 0xe4, 0x78, 0x05, 0x9b,
-	0x32, 0x07, 0x9b, 0xa0, 0xf7, 0x26, 0xaa, 0xe5, 0x05, 0x85, 0xf9, 0xd9, 0x5c, 0xce, 0x59, 0xd8,
-	0xe3, 0x71, 0x9a, 0x4c, 0x67, 0x0b, 0x27, 0xe8, 0xfc, 0x6c, 0x37, 0x0a, 0x77, 0x64, 0xfb, 0x5e,
-	0x02, 0x6b, 0x8a, 0x35, 0x7b, 0xcf, 0xd0, 0x7f, 0x50, 0x91, 0x49, 0xe3, 0x48, 0xdf, 0x04, 0x53,
-	0x98, 0xdd, 0x08, 0x6d, 0x83, 0x79, 0xc1, 0xc2, 0x40, 0x9f, 0x66, 0x15, 0xaf, 0x5f, 0xb0, 0xb0,
-	0x1b, 0xa1, 0x87, 0x60, 0x7d, 0x8e, 0x29, 0x0d, 0x42, 0xc6, 0xe2, 0x21, 0xb1, 0xd7, 0xa4, 0x0f,
-	0x04, 0xe4, 0x4a, 0x04, 0x3d, 0x00, 0x69, 0x05, 0x94, 0x84, 0x43, 0x62, 0xaf, 0x4b, 0x7f, 0x55,
-	0x20, 0x67, 0x02, 0x40, 0x2d, 0xa8, 0x27, 0xf9, 0xd5, 0x39, 0x61, 0x41, 0xda, 0x0f, 0x06, 0x24,
-	0x1d, 0x50, 0x62, 0x9b, 0xb2, 0xe0, 0x9a, 0xc2, 0xdf, 0xf4, 0xdf, 0x4a, 0x54, 0x64, 0x8a, 0xb3,
-	0xa0, 0x17, 0x26, 0x3d, 0x42, 0x49, 0x64, 0x57, 0x9a, 0x46, 0x6b, 0x03, 0x43, 0x9c, 0x1d, 0x69,
-	0x44, 0x2d, 0x54, 0x98, 0xa5, 0x89, 0xbd, 0x31, 0x5e, 0x28, 0x61, 0x89, 0x0a, 0x7a, 0x8c, 0x84,
-	0x9c, 0x44, 0x41, 0xc8, 0xed, 0xaa, 0xaa, 0x40, 0x23, 0x2e, 0x17, 0xee, 0x7c, 0x10, 0x8d, 0xdd,
-	0xa0, 0xdc, 0x1a, 0x51, 0xee, 0x88, 0x50, 0xa2, 0xdd, 0x96, 0x72, 0x6b, 0xc4, 0xe5, 0xce, 0x31,
-	0x98, 0xbe, 0x1a, 0xd0, 0xa3, 0x9b, 0xc9, 0xa9, 0x63, 0x2a, 0xdc, 0xf7, 0xf1, 0x18, 0x6f, 0x3d,
-	0x95, 0x83, 0x9f, 0x55, 0xa8, 0x79, 0x2a, 0xf8, 0x1d, 0x61, 0xc3, 0xb8, 0x47, 0x50, 0x1b, 0xaa,
-	0x7e, 0xc7, 0x3b, 0x92, 0x65, 0xa2, 0x5b, 0x4f, 0x7c, 0xe7, 0x56, 0x54, 0x12, 0xf1, 0xaa, 0x44,
-	0x77, 0x15, 0xa2, 0x0b, 0x35, 0xbf, 0xe3, 0x9d, 0x12, 0xee, 0x52, 0xea, 0x8d, 0x7c, 0xd1, 0xe5,
-	0x24, 0x6e, 0xea, 0xe9, 0xd8, 0xf9, 0xbf, 0x80, 0x16, 0xb6, 0xf7, 0x04, 0x6a, 0x3e, 0x5e, 0x40,
-	0xa2, 0xf1, 0x97, 0x44, 0x71, 0x43, 0x85, 0x8e, 0xbb, 0x92, 0x4e, 0x71, 0xf7, 0xda, 0x85, 0x96,
-	0x3a, 0x77, 0xea, 0x6c, 0x4e, 0x50, 0x7d, 0x0b, 0xda, 0x85, 0x46, 0xf0, 0x72, 0xc4, 0x9b, 0xca,
-	0xdd, 0xc5, 0x89, 0x4f, 0xa1, 0xe2, 0x77, 0x3c, 0x11, 0x82, 0xea, 0xb3, 0x8c, 0xfb, 0x46, 0xfe,
-	0x12, 0x2a, 0x3e, 0xbe, 0x8b, 0x37, 0x6f, 0xce, 0x82, 0xec, 0x2e, 0x4e, 0x9e, 0x7d, 0xd8, 0x6a,
-	0xba, 0xe2, 0x63, 0xb5, 0x47, 0xcb, 0x15, 0xee, 0xc9, 0x11, 0xdf, 0x4f, 0x9f, 0x57, 0xbf, 0x27,
-	0xa7, 0xbd, 0xac, 0xc6, 0xec, 0x1d, 0x11, 0x1b, 0xea, 0xcb, 0x97, 0x62, 0x85, 0x0d, 0x5d, 0x91,
-	0xe8, 0xae, 0x42, 0xdc, 0x93, 0xa5, 0xaa, 0x56, 0xd1, 0xf4, 0xbb, 0x34, 0x75, 0x9d, 0xf4, 0x67,
-	0xc9, 0x9e, 0x2c, 0x6e, 0xe1, 0x50, 0x77, 0xa1, 0x50, 0xaf, 0xfe, 0xed, 0xba, 0x61, 0xfc, 0xb8,
-	0x6e, 0x18, 0xbf, 0xae, 0x1b, 0xc6, 0xd7, 0xdf, 0x8d, 0x7f, 0xce, 0x4d, 0xf9, 0x61, 0x74, 0xf8,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x57, 0xa9, 0xff, 0x00, 0x37, 0x09, 0x00, 0x00,
+func (m *GeneralBook) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// BookingServiceClient is the client API for BookingService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BookingServiceClient interface {
-	UHBCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error)
-	URBCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error)
-	UABCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error)
-	UHBGetAllByUId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*ListUserHotelRes, error)
-	URBGetAllByUId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*ListUserRestaurantRes, error)
-	UABGetAllByUId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*ListUserAttractionRes, error)
-	UHBGetAllByHId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*UserId, error)
-	URBGetAllByRId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*UserId, error)
-	UABGetAllByAId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*UserId, error)
-	UHBList(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserHotelRes, error)
-	URBList(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserRestaurantRes, error)
-	UABList(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserAttractionRes, error)
-	UHBListDeleted(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserHotelRes, error)
-	URBListDeleted(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserRestaurantRes, error)
-	UABListDeleted(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserAttractionRes, error)
-	UHBUpdate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error)
-	URBUpdate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error)
-	UABUpdate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error)
-	UHBDelete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*DelRes, error)
-	URBDelete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*DelRes, error)
-	UABDelete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*DelRes, error)
+func (m *GeneralBook) GetBookingType() string {
+	if m != nil {
+		return m.BookingType
+	}
+	return ""
 }
 
-type bookingServiceClient struct {
-	cc *grpc.ClientConn
+func (m *GeneralBook) GetItineraryId() string {
+	if m != nil {
+		return m.ItineraryId
+	}
+	return ""
 }
 
-func NewBookingServiceClient(cc *grpc.ClientConn) BookingServiceClient {
-	return &bookingServiceClient{cc}
+func (m *GeneralBook) GetHoldExpiresAt() string {
+	if m != nil {
+		return m.HoldExpiresAt
+	}
+	return ""
 }
 
-func (c *bookingServiceClient) UHBCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error) {
-	out := new(GeneralBook)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UHBCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GeneralBook) GetConfirmationCode() string {
+	if m != nil {
+		return m.ConfirmationCode
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bookingServiceClient) URBCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error) {
-	out := new(GeneralBook)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/URBCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GeneralBook) GetApprovalDueAt() string {
+	if m != nil {
+		return m.ApprovalDueAt
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bookingServiceClient) UABCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error) {
-	out := new(GeneralBook)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UABCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GeneralBook) GetGuests() []*BookingGuest {
+	if m != nil {
+		return m.Guests
 	}
-	return out, nil
+	return nil
 }
 
-func (c *bookingServiceClient) UHBGetAllByUId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*ListUserHotelRes, error) {
-	out := new(ListUserHotelRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UHBGetAllByUId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GeneralBook) GetSpecialRequests() []string {
+	if m != nil {
+		return m.SpecialRequests
 	}
-	return out, nil
+	return nil
 }
 
-func (c *bookingServiceClient) URBGetAllByUId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*ListUserRestaurantRes, error) {
-	out := new(ListUserRestaurantRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/URBGetAllByUId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GeneralBook) GetSpecialRequestNote() string {
+	if m != nil {
+		return m.SpecialRequestNote
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bookingServiceClient) UABGetAllByUId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*ListUserAttractionRes, error) {
-	out := new(ListUserAttractionRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UABGetAllByUId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GeneralBook) GetAddOns() []*BookingAddOn {
+	if m != nil {
+		return m.AddOns
 	}
-	return out, nil
+	return nil
 }
 
-func (c *bookingServiceClient) UHBGetAllByHId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*UserId, error) {
-	out := new(UserId)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UHBGetAllByHId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GeneralBook) GetGuestsSet() bool {
+	if m != nil {
+		return m.GuestsSet
 	}
-	return out, nil
+	return false
 }
 
-func (c *bookingServiceClient) URBGetAllByRId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*UserId, error) {
-	out := new(UserId)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/URBGetAllByRId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GeneralBook) GetAddOnsSet() bool {
+	if m != nil {
+		return m.AddOnsSet
 	}
-	return out, nil
+	return false
 }
 
-func (c *bookingServiceClient) UABGetAllByAId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*UserId, error) {
-	out := new(UserId)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UABGetAllByAId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GeneralBook) GetSpecialRequestsSet() bool {
+	if m != nil {
+		return m.SpecialRequestsSet
 	}
-	return out, nil
+	return false
 }
 
-func (c *bookingServiceClient) UHBList(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserHotelRes, error) {
-	out := new(ListUserHotelRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UHBList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BookingGuest struct {
+	FullName             string   `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name"`
+	Age                  int64    `protobuf:"varint,2,opt,name=age,proto3" json:"age"`
+	DocumentNumber       string   `protobuf:"bytes,3,opt,name=document_number,json=documentNumber,proto3" json:"document_number"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *bookingServiceClient) URBList(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserRestaurantRes, error) {
-	out := new(ListUserRestaurantRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/URBList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BookingGuest) Reset()         { *m = BookingGuest{} }
+func (m *BookingGuest) String() string { return proto.CompactTextString(m) }
+func (*BookingGuest) ProtoMessage()    {}
+func (*BookingGuest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{8}
+}
+func (m *BookingGuest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BookingGuest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BookingGuest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *BookingGuest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BookingGuest.Merge(m, src)
+}
+func (m *BookingGuest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BookingGuest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BookingGuest.DiscardUnknown(m)
 }
 
-func (c *bookingServiceClient) UABList(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserAttractionRes, error) {
-	out := new(ListUserAttractionRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UABList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BookingGuest proto.InternalMessageInfo
+
+func (m *BookingGuest) GetFullName() string {
+	if m != nil {
+		return m.FullName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bookingServiceClient) UHBListDeleted(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserHotelRes, error) {
-	out := new(ListUserHotelRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UHBListDeleted", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BookingGuest) GetAge() int64 {
+	if m != nil {
+		return m.Age
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bookingServiceClient) URBListDeleted(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserRestaurantRes, error) {
-	out := new(ListUserRestaurantRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/URBListDeleted", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BookingGuest) GetDocumentNumber() string {
+	if m != nil {
+		return m.DocumentNumber
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bookingServiceClient) UABListDeleted(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListUserAttractionRes, error) {
-	out := new(ListUserAttractionRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UABListDeleted", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BookingAddOn struct {
+	AddOnId              string   `protobuf:"bytes,1,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Quantity             int64    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity"`
+	UnitPrice            float64  `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price"`
+	Total                float64  `protobuf:"fixed64,5,opt,name=total,proto3" json:"total"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *bookingServiceClient) UHBUpdate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error) {
-	out := new(GeneralBook)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UHBUpdate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BookingAddOn) Reset()         { *m = BookingAddOn{} }
+func (m *BookingAddOn) String() string { return proto.CompactTextString(m) }
+func (*BookingAddOn) ProtoMessage()    {}
+func (*BookingAddOn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{9}
+}
+func (m *BookingAddOn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BookingAddOn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BookingAddOn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *BookingAddOn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BookingAddOn.Merge(m, src)
+}
+func (m *BookingAddOn) XXX_Size() int {
+	return m.Size()
+}
+func (m *BookingAddOn) XXX_DiscardUnknown() {
+	xxx_messageInfo_BookingAddOn.DiscardUnknown(m)
 }
 
-func (c *bookingServiceClient) URBUpdate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error) {
-	out := new(GeneralBook)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/URBUpdate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BookingAddOn proto.InternalMessageInfo
+
+func (m *BookingAddOn) GetAddOnId() string {
+	if m != nil {
+		return m.AddOnId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bookingServiceClient) UABUpdate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error) {
-	out := new(GeneralBook)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UABUpdate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BookingAddOn) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *bookingServiceClient) UHBDelete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*DelRes, error) {
-	out := new(DelRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UHBDelete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BookingAddOn) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bookingServiceClient) URBDelete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*DelRes, error) {
-	out := new(DelRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/URBDelete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BookingAddOn) GetUnitPrice() float64 {
+	if m != nil {
+		return m.UnitPrice
 	}
-	return out, nil
+	return 0
 }
 
-func (c *bookingServiceClient) UABDelete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*DelRes, error) {
-	out := new(DelRes)
-	err := c.cc.Invoke(ctx, "/booking.BookingService/UABDelete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BookingAddOn) GetTotal() float64 {
+	if m != nil {
+		return m.Total
 	}
-	return out, nil
+	return 0
 }
 
-// BookingServiceServer is the server API for BookingService service.
-type BookingServiceServer interface {
-	UHBCreate(context.Context, *GeneralBook) (*GeneralBook, error)
-	URBCreate(context.Context, *GeneralBook) (*GeneralBook, error)
-	UABCreate(context.Context, *GeneralBook) (*GeneralBook, error)
-	UHBGetAllByUId(context.Context, *ListReqById) (*ListUserHotelRes, error)
-	URBGetAllByUId(context.Context, *ListReqById) (*ListUserRestaurantRes, error)
-	UABGetAllByUId(context.Context, *ListReqById) (*ListUserAttractionRes, error)
-	UHBGetAllByHId(context.Context, *ListReqById) (*UserId, error)
-	URBGetAllByRId(context.Context, *ListReqById) (*UserId, error)
-	UABGetAllByAId(context.Context, *ListReqById) (*UserId, error)
-	UHBList(context.Context, *ListReq) (*ListUserHotelRes, error)
-	URBList(context.Context, *ListReq) (*ListUserRestaurantRes, error)
-	UABList(context.Context, *ListReq) (*ListUserAttractionRes, error)
-	UHBListDeleted(context.Context, *ListReq) (*ListUserHotelRes, error)
-	URBListDeleted(context.Context, *ListReq) (*ListUserRestaurantRes, error)
-	UABListDeleted(context.Context, *ListReq) (*ListUserAttractionRes, error)
-	UHBUpdate(context.Context, *GeneralBook) (*GeneralBook, error)
-	URBUpdate(context.Context, *GeneralBook) (*GeneralBook, error)
-	UABUpdate(context.Context, *GeneralBook) (*GeneralBook, error)
-	UHBDelete(context.Context, *Id) (*DelRes, error)
-	URBDelete(context.Context, *Id) (*DelRes, error)
-	UABDelete(context.Context, *Id) (*DelRes, error)
+type UserId struct {
+	UserId               []*Id    `protobuf:"bytes,1,rep,name=user_id,json=userId,proto3" json:"user_id"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedBookingServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedBookingServiceServer struct {
+func (m *UserId) Reset()         { *m = UserId{} }
+func (m *UserId) String() string { return proto.CompactTextString(m) }
+func (*UserId) ProtoMessage()    {}
+func (*UserId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{10}
 }
-
-func (*UnimplementedBookingServiceServer) UHBCreate(ctx context.Context, req *GeneralBook) (*GeneralBook, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UHBCreate not implemented")
+func (m *UserId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserId.Merge(m, src)
+}
+func (m *UserId) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserId) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserId.DiscardUnknown(m)
 }
-func (*UnimplementedBookingServiceServer) URBCreate(ctx context.Context, req *GeneralBook) (*GeneralBook, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method URBCreate not implemented")
+
+var xxx_messageInfo_UserId proto.InternalMessageInfo
+
+func (m *UserId) GetUserId() []*Id {
+	if m != nil {
+		return m.UserId
+	}
+	return nil
 }
-func (*UnimplementedBookingServiceServer) UABCreate(ctx context.Context, req *GeneralBook) (*GeneralBook, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UABCreate not implemented")
+
+func (m *UserId) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
-func (*UnimplementedBookingServiceServer) UHBGetAllByUId(ctx context.Context, req *ListReqById) (*ListUserHotelRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UHBGetAllByUId not implemented")
+
+type AvailabilityReq struct {
+	City                 string   `protobuf:"bytes,1,opt,name=city,proto3" json:"city"`
+	WillArrive           string   `protobuf:"bytes,2,opt,name=will_arrive,json=willArrive,proto3" json:"will_arrive"`
+	WillLeave            string   `protobuf:"bytes,3,opt,name=will_leave,json=willLeave,proto3" json:"will_leave"`
+	PartySize            int64    `protobuf:"varint,4,opt,name=party_size,json=partySize,proto3" json:"party_size"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedBookingServiceServer) URBGetAllByUId(ctx context.Context, req *ListReqById) (*ListUserRestaurantRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method URBGetAllByUId not implemented")
+
+func (m *AvailabilityReq) Reset()         { *m = AvailabilityReq{} }
+func (m *AvailabilityReq) String() string { return proto.CompactTextString(m) }
+func (*AvailabilityReq) ProtoMessage()    {}
+func (*AvailabilityReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{11}
 }
-func (*UnimplementedBookingServiceServer) UABGetAllByUId(ctx context.Context, req *ListReqById) (*ListUserAttractionRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UABGetAllByUId not implemented")
+func (m *AvailabilityReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedBookingServiceServer) UHBGetAllByHId(ctx context.Context, req *ListReqById) (*UserId, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UHBGetAllByHId not implemented")
+func (m *AvailabilityReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AvailabilityReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedBookingServiceServer) URBGetAllByRId(ctx context.Context, req *ListReqById) (*UserId, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method URBGetAllByRId not implemented")
+func (m *AvailabilityReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AvailabilityReq.Merge(m, src)
 }
-func (*UnimplementedBookingServiceServer) UABGetAllByAId(ctx context.Context, req *ListReqById) (*UserId, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UABGetAllByAId not implemented")
+func (m *AvailabilityReq) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedBookingServiceServer) UHBList(ctx context.Context, req *ListReq) (*ListUserHotelRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UHBList not implemented")
+func (m *AvailabilityReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AvailabilityReq.DiscardUnknown(m)
 }
-func (*UnimplementedBookingServiceServer) URBList(ctx context.Context, req *ListReq) (*ListUserRestaurantRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method URBList not implemented")
+
+var xxx_messageInfo_AvailabilityReq proto.InternalMessageInfo
+
+func (m *AvailabilityReq) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
 }
-func (*UnimplementedBookingServiceServer) UABList(ctx context.Context, req *ListReq) (*ListUserAttractionRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UABList not implemented")
+
+func (m *AvailabilityReq) GetWillArrive() string {
+	if m != nil {
+		return m.WillArrive
+	}
+	return ""
 }
-func (*UnimplementedBookingServiceServer) UHBListDeleted(ctx context.Context, req *ListReq) (*ListUserHotelRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UHBListDeleted not implemented")
+
+func (m *AvailabilityReq) GetWillLeave() string {
+	if m != nil {
+		return m.WillLeave
+	}
+	return ""
 }
-func (*UnimplementedBookingServiceServer) URBListDeleted(ctx context.Context, req *ListReq) (*ListUserRestaurantRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method URBListDeleted not implemented")
+
+func (m *AvailabilityReq) GetPartySize() int64 {
+	if m != nil {
+		return m.PartySize
+	}
+	return 0
 }
-func (*UnimplementedBookingServiceServer) UABListDeleted(ctx context.Context, req *ListReq) (*ListUserAttractionRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UABListDeleted not implemented")
+
+type AvailableHotel struct {
+	HotelId              string   `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id"`
+	HotelName            string   `protobuf:"bytes,2,opt,name=hotel_name,json=hotelName,proto3" json:"hotel_name"`
+	Rating               float32  `protobuf:"fixed32,3,opt,name=rating,proto3" json:"rating"`
+	Address              string   `protobuf:"bytes,4,opt,name=address,proto3" json:"address"`
+	City                 string   `protobuf:"bytes,5,opt,name=city,proto3" json:"city"`
+	RoomId               string   `protobuf:"bytes,6,opt,name=room_id,json=roomId,proto3" json:"room_id"`
+	RoomDescription      string   `protobuf:"bytes,7,opt,name=room_description,json=roomDescription,proto3" json:"room_description"`
+	RoomCapacity         int64    `protobuf:"varint,8,opt,name=room_capacity,json=roomCapacity,proto3" json:"room_capacity"`
+	NightlyPrice         float64  `protobuf:"fixed64,9,opt,name=nightly_price,json=nightlyPrice,proto3" json:"nightly_price"`
+	FreeRooms            int64    `protobuf:"varint,10,opt,name=free_rooms,json=freeRooms,proto3" json:"free_rooms"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedBookingServiceServer) UHBUpdate(ctx context.Context, req *GeneralBook) (*GeneralBook, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UHBUpdate not implemented")
+
+func (m *AvailableHotel) Reset()         { *m = AvailableHotel{} }
+func (m *AvailableHotel) String() string { return proto.CompactTextString(m) }
+func (*AvailableHotel) ProtoMessage()    {}
+func (*AvailableHotel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{12}
 }
-func (*UnimplementedBookingServiceServer) URBUpdate(ctx context.Context, req *GeneralBook) (*GeneralBook, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method URBUpdate not implemented")
+func (m *AvailableHotel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedBookingServiceServer) UABUpdate(ctx context.Context, req *GeneralBook) (*GeneralBook, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UABUpdate not implemented")
+func (m *AvailableHotel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AvailableHotel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedBookingServiceServer) UHBDelete(ctx context.Context, req *Id) (*DelRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UHBDelete not implemented")
+func (m *AvailableHotel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AvailableHotel.Merge(m, src)
 }
-func (*UnimplementedBookingServiceServer) URBDelete(ctx context.Context, req *Id) (*DelRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method URBDelete not implemented")
+func (m *AvailableHotel) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedBookingServiceServer) UABDelete(ctx context.Context, req *Id) (*DelRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UABDelete not implemented")
+func (m *AvailableHotel) XXX_DiscardUnknown() {
+	xxx_messageInfo_AvailableHotel.DiscardUnknown(m)
 }
 
-func RegisterBookingServiceServer(s *grpc.Server, srv BookingServiceServer) {
-	s.RegisterService(&_BookingService_serviceDesc, srv)
-}
+var xxx_messageInfo_AvailableHotel proto.InternalMessageInfo
 
-func _BookingService_UHBCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeneralBook)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AvailableHotel) GetHotelId() string {
+	if m != nil {
+		return m.HotelId
 	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UHBCreate(ctx, in)
+	return ""
+}
+
+func (m *AvailableHotel) GetHotelName() string {
+	if m != nil {
+		return m.HotelName
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UHBCreate",
+	return ""
+}
+
+func (m *AvailableHotel) GetRating() float32 {
+	if m != nil {
+		return m.Rating
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UHBCreate(ctx, req.(*GeneralBook))
+	return 0
+}
+
+func (m *AvailableHotel) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _BookingService_URBCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeneralBook)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AvailableHotel) GetCity() string {
+	if m != nil {
+		return m.City
 	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).URBCreate(ctx, in)
+	return ""
+}
+
+func (m *AvailableHotel) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/URBCreate",
+	return ""
+}
+
+func (m *AvailableHotel) GetRoomDescription() string {
+	if m != nil {
+		return m.RoomDescription
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).URBCreate(ctx, req.(*GeneralBook))
+	return ""
+}
+
+func (m *AvailableHotel) GetRoomCapacity() int64 {
+	if m != nil {
+		return m.RoomCapacity
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BookingService_UABCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeneralBook)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UABCreate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UABCreate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UABCreate(ctx, req.(*GeneralBook))
+func (m *AvailableHotel) GetNightlyPrice() float64 {
+	if m != nil {
+		return m.NightlyPrice
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BookingService_UHBGetAllByUId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReqById)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UHBGetAllByUId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UHBGetAllByUId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UHBGetAllByUId(ctx, req.(*ListReqById))
+func (m *AvailableHotel) GetFreeRooms() int64 {
+	if m != nil {
+		return m.FreeRooms
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BookingService_URBGetAllByUId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReqById)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).URBGetAllByUId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/URBGetAllByUId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).URBGetAllByUId(ctx, req.(*ListReqById))
-	}
-	return interceptor(ctx, in, info, handler)
+type AvailabilityRes struct {
+	Hotels               []*AvailableHotel `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels"`
+	Count                int64             `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func _BookingService_UABGetAllByUId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReqById)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UABGetAllByUId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UABGetAllByUId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UABGetAllByUId(ctx, req.(*ListReqById))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *AvailabilityRes) Reset()         { *m = AvailabilityRes{} }
+func (m *AvailabilityRes) String() string { return proto.CompactTextString(m) }
+func (*AvailabilityRes) ProtoMessage()    {}
+func (*AvailabilityRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{13}
 }
-
-func _BookingService_UHBGetAllByHId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReqById)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UHBGetAllByHId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UHBGetAllByHId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UHBGetAllByHId(ctx, req.(*ListReqById))
+func (m *AvailabilityRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AvailabilityRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AvailabilityRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AvailabilityRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AvailabilityRes.Merge(m, src)
+}
+func (m *AvailabilityRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *AvailabilityRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_AvailabilityRes.DiscardUnknown(m)
 }
 
-func _BookingService_URBGetAllByRId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReqById)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).URBGetAllByRId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/URBGetAllByRId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).URBGetAllByRId(ctx, req.(*ListReqById))
+var xxx_messageInfo_AvailabilityRes proto.InternalMessageInfo
+
+func (m *AvailabilityRes) GetHotels() []*AvailableHotel {
+	if m != nil {
+		return m.Hotels
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _BookingService_UABGetAllByAId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReqById)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UABGetAllByAId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UABGetAllByAId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UABGetAllByAId(ctx, req.(*ListReqById))
+func (m *AvailabilityRes) GetCount() int64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BookingService_UHBList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UHBList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UHBList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UHBList(ctx, req.(*ListReq))
-	}
-	return interceptor(ctx, in, info, handler)
+type FreeSlotsReq struct {
+	RestaurantId         string   `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id"`
+	Date                 string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _BookingService_URBList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).URBList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/URBList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).URBList(ctx, req.(*ListReq))
+func (m *FreeSlotsReq) Reset()         { *m = FreeSlotsReq{} }
+func (m *FreeSlotsReq) String() string { return proto.CompactTextString(m) }
+func (*FreeSlotsReq) ProtoMessage()    {}
+func (*FreeSlotsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{14}
+}
+func (m *FreeSlotsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreeSlotsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreeSlotsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *FreeSlotsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreeSlotsReq.Merge(m, src)
+}
+func (m *FreeSlotsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreeSlotsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreeSlotsReq.DiscardUnknown(m)
 }
 
-func _BookingService_UABList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UABList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UABList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UABList(ctx, req.(*ListReq))
+var xxx_messageInfo_FreeSlotsReq proto.InternalMessageInfo
+
+func (m *FreeSlotsReq) GetRestaurantId() string {
+	if m != nil {
+		return m.RestaurantId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _BookingService_UHBListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UHBListDeleted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UHBListDeleted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UHBListDeleted(ctx, req.(*ListReq))
+func (m *FreeSlotsReq) GetDate() string {
+	if m != nil {
+		return m.Date
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _BookingService_URBListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).URBListDeleted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/URBListDeleted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).URBListDeleted(ctx, req.(*ListReq))
-	}
-	return interceptor(ctx, in, info, handler)
+type Slot struct {
+	Start                string   `protobuf:"bytes,1,opt,name=start,proto3" json:"start"`
+	End                  string   `protobuf:"bytes,2,opt,name=end,proto3" json:"end"`
+	FreeSeats            int64    `protobuf:"varint,3,opt,name=free_seats,json=freeSeats,proto3" json:"free_seats"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _BookingService_UABListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UABListDeleted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UABListDeleted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UABListDeleted(ctx, req.(*ListReq))
+func (m *Slot) Reset()         { *m = Slot{} }
+func (m *Slot) String() string { return proto.CompactTextString(m) }
+func (*Slot) ProtoMessage()    {}
+func (*Slot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{15}
+}
+func (m *Slot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Slot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Slot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Slot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slot.Merge(m, src)
+}
+func (m *Slot) XXX_Size() int {
+	return m.Size()
+}
+func (m *Slot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slot.DiscardUnknown(m)
 }
 
-func _BookingService_UHBUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeneralBook)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UHBUpdate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UHBUpdate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UHBUpdate(ctx, req.(*GeneralBook))
+var xxx_messageInfo_Slot proto.InternalMessageInfo
+
+func (m *Slot) GetStart() string {
+	if m != nil {
+		return m.Start
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _BookingService_URBUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeneralBook)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).URBUpdate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/URBUpdate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).URBUpdate(ctx, req.(*GeneralBook))
+func (m *Slot) GetEnd() string {
+	if m != nil {
+		return m.End
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _BookingService_UABUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GeneralBook)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UABUpdate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UABUpdate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UABUpdate(ctx, req.(*GeneralBook))
+func (m *Slot) GetFreeSeats() int64 {
+	if m != nil {
+		return m.FreeSeats
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BookingService_UHBDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Id)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UHBDelete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UHBDelete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UHBDelete(ctx, req.(*Id))
-	}
-	return interceptor(ctx, in, info, handler)
+type FreeSlotsRes struct {
+	Slots                []*Slot  `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots"`
+	SeatCapacity         int64    `protobuf:"varint,2,opt,name=seat_capacity,json=seatCapacity,proto3" json:"seat_capacity"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _BookingService_URBDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Id)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).URBDelete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/URBDelete",
+func (m *FreeSlotsRes) Reset()         { *m = FreeSlotsRes{} }
+func (m *FreeSlotsRes) String() string { return proto.CompactTextString(m) }
+func (*FreeSlotsRes) ProtoMessage()    {}
+func (*FreeSlotsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{16}
+}
+func (m *FreeSlotsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreeSlotsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreeSlotsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).URBDelete(ctx, req.(*Id))
+}
+func (m *FreeSlotsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreeSlotsRes.Merge(m, src)
+}
+func (m *FreeSlotsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreeSlotsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreeSlotsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreeSlotsRes proto.InternalMessageInfo
+
+func (m *FreeSlotsRes) GetSlots() []*Slot {
+	if m != nil {
+		return m.Slots
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _BookingService_UABDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Id)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *FreeSlotsRes) GetSeatCapacity() int64 {
+	if m != nil {
+		return m.SeatCapacity
 	}
-	if interceptor == nil {
-		return srv.(BookingServiceServer).UABDelete(ctx, in)
+	return 0
+}
+
+type QuotaReq struct {
+	AttractionId         string   `protobuf:"bytes,1,opt,name=attraction_id,json=attractionId,proto3" json:"attraction_id"`
+	Date                 string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaReq) Reset()         { *m = QuotaReq{} }
+func (m *QuotaReq) String() string { return proto.CompactTextString(m) }
+func (*QuotaReq) ProtoMessage()    {}
+func (*QuotaReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{17}
+}
+func (m *QuotaReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/booking.BookingService/UABDelete",
+}
+func (m *QuotaReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaReq.Merge(m, src)
+}
+func (m *QuotaReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaReq proto.InternalMessageInfo
+
+func (m *QuotaReq) GetAttractionId() string {
+	if m != nil {
+		return m.AttractionId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UABDelete(ctx, req.(*Id))
+	return ""
+}
+
+func (m *QuotaReq) GetDate() string {
+	if m != nil {
+		return m.Date
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _BookingService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "booking.BookingService",
-	HandlerType: (*BookingServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UHBCreate",
-			Handler:    _BookingService_UHBCreate_Handler,
-		},
-		{
-			MethodName: "URBCreate",
-			Handler:    _BookingService_URBCreate_Handler,
-		},
-		{
-			MethodName: "UABCreate",
-			Handler:    _BookingService_UABCreate_Handler,
-		},
-		{
-			MethodName: "UHBGetAllByUId",
-			Handler:    _BookingService_UHBGetAllByUId_Handler,
-		},
-		{
-			MethodName: "URBGetAllByUId",
-			Handler:    _BookingService_URBGetAllByUId_Handler,
-		},
-		{
-			MethodName: "UABGetAllByUId",
-			Handler:    _BookingService_UABGetAllByUId_Handler,
-		},
-		{
-			MethodName: "UHBGetAllByHId",
-			Handler:    _BookingService_UHBGetAllByHId_Handler,
-		},
-		{
-			MethodName: "URBGetAllByRId",
-			Handler:    _BookingService_URBGetAllByRId_Handler,
-		},
-		{
-			MethodName: "UABGetAllByAId",
-			Handler:    _BookingService_UABGetAllByAId_Handler,
-		},
-		{
-			MethodName: "UHBList",
-			Handler:    _BookingService_UHBList_Handler,
-		},
-		{
-			MethodName: "URBList",
-			Handler:    _BookingService_URBList_Handler,
-		},
-		{
-			MethodName: "UABList",
-			Handler:    _BookingService_UABList_Handler,
-		},
-		{
-			MethodName: "UHBListDeleted",
-			Handler:    _BookingService_UHBListDeleted_Handler,
-		},
-		{
-			MethodName: "URBListDeleted",
-			Handler:    _BookingService_URBListDeleted_Handler,
-		},
-		{
-			MethodName: "UABListDeleted",
-			Handler:    _BookingService_UABListDeleted_Handler,
-		},
-		{
-			MethodName: "UHBUpdate",
-			Handler:    _BookingService_UHBUpdate_Handler,
-		},
-		{
-			MethodName: "URBUpdate",
-			Handler:    _BookingService_URBUpdate_Handler,
-		},
-		{
-			MethodName: "UABUpdate",
-			Handler:    _BookingService_UABUpdate_Handler,
-		},
-		{
-			MethodName: "UHBDelete",
-			Handler:    _BookingService_UHBDelete_Handler,
-		},
-		{
-			MethodName: "URBDelete",
-			Handler:    _BookingService_URBDelete_Handler,
-		},
-		{
-			MethodName: "UABDelete",
-			Handler:    _BookingService_UABDelete_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "booking-proto/booking.proto",
+type EntryWindow struct {
+	Start                string   `protobuf:"bytes,1,opt,name=start,proto3" json:"start"`
+	End                  string   `protobuf:"bytes,2,opt,name=end,proto3" json:"end"`
+	Remaining            int64    `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DelRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EntryWindow) Reset()         { *m = EntryWindow{} }
+func (m *EntryWindow) String() string { return proto.CompactTextString(m) }
+func (*EntryWindow) ProtoMessage()    {}
+func (*EntryWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{18}
+}
+func (m *EntryWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntryWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntryWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DelRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EntryWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntryWindow.Merge(m, src)
+}
+func (m *EntryWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntryWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntryWindow.DiscardUnknown(m)
 }
 
-func (m *DelRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_EntryWindow proto.InternalMessageInfo
+
+func (m *EntryWindow) GetStart() string {
+	if m != nil {
+		return m.Start
 	}
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EntryWindow) GetEnd() string {
+	if m != nil {
+		return m.End
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Id) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EntryWindow) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Id) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuotaRes struct {
+	DailyQuota           int64          `protobuf:"varint,1,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota"`
+	DailyRemaining       int64          `protobuf:"varint,2,opt,name=daily_remaining,json=dailyRemaining,proto3" json:"daily_remaining"`
+	WindowQuota          int64          `protobuf:"varint,3,opt,name=window_quota,json=windowQuota,proto3" json:"window_quota"`
+	Windows              []*EntryWindow `protobuf:"bytes,4,rep,name=windows,proto3" json:"windows"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *Id) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuotaRes) Reset()         { *m = QuotaRes{} }
+func (m *QuotaRes) String() string { return proto.CompactTextString(m) }
+func (*QuotaRes) ProtoMessage()    {}
+func (*QuotaRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{19}
+}
+func (m *QuotaRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuotaRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaRes.Merge(m, src)
+}
+func (m *QuotaRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaRes.DiscardUnknown(m)
 }
 
-func (m *Filter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuotaRes proto.InternalMessageInfo
+
+func (m *QuotaRes) GetDailyQuota() int64 {
+	if m != nil {
+		return m.DailyQuota
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Filter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuotaRes) GetDailyRemaining() int64 {
+	if m != nil {
+		return m.DailyRemaining
+	}
+	return 0
 }
 
-func (m *Filter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *QuotaRes) GetWindowQuota() int64 {
+	if m != nil {
+		return m.WindowQuota
 	}
-	if len(m.Filter) > 0 {
-		for k := range m.Filter {
-			v := m.Filter[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintBooking(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintBooking(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintBooking(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ListReqById) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuotaRes) GetWindows() []*EntryWindow {
+	if m != nil {
+		return m.Windows
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListReqById) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PriceQuote struct {
+	Nights               int64    `protobuf:"varint,1,opt,name=nights,proto3" json:"nights"`
+	HolidayNights        int64    `protobuf:"varint,2,opt,name=holiday_nights,json=holidayNights,proto3" json:"holiday_nights"`
+	NightlyPrice         float64  `protobuf:"fixed64,3,opt,name=nightly_price,json=nightlyPrice,proto3" json:"nightly_price"`
+	Subtotal             float64  `protobuf:"fixed64,4,opt,name=subtotal,proto3" json:"subtotal"`
+	Surcharge            float64  `protobuf:"fixed64,5,opt,name=surcharge,proto3" json:"surcharge"`
+	Discount             float64  `protobuf:"fixed64,6,opt,name=discount,proto3" json:"discount"`
+	Total                float64  `protobuf:"fixed64,7,opt,name=total,proto3" json:"total"`
+	Currency             string   `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListReqById) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBooking(dAtA, i, uint64(size))
+func (m *PriceQuote) Reset()         { *m = PriceQuote{} }
+func (m *PriceQuote) String() string { return proto.CompactTextString(m) }
+func (*PriceQuote) ProtoMessage()    {}
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{20}
+}
+func (m *PriceQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Offset != 0 {
-		i = encodeVarintBooking(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Limit != 0 {
-		i = encodeVarintBooking(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PriceQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceQuote.Merge(m, src)
+}
+func (m *PriceQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceQuote.DiscardUnknown(m)
 }
 
-func (m *ListReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PriceQuote proto.InternalMessageInfo
+
+func (m *PriceQuote) GetNights() int64 {
+	if m != nil {
+		return m.Nights
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ListReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceQuote) GetHolidayNights() int64 {
+	if m != nil {
+		return m.HolidayNights
+	}
+	return 0
 }
 
-func (m *ListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Offset != 0 {
-		i = encodeVarintBooking(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Limit != 0 {
-		i = encodeVarintBooking(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
+func (m *PriceQuote) GetNightlyPrice() float64 {
+	if m != nil {
+		return m.NightlyPrice
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ListUserHotelRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceQuote) GetSubtotal() float64 {
+	if m != nil {
+		return m.Subtotal
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ListUserHotelRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceQuote) GetSurcharge() float64 {
+	if m != nil {
+		return m.Surcharge
+	}
+	return 0
 }
 
-func (m *ListUserHotelRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintBooking(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
+func (m *PriceQuote) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
 	}
-	if len(m.UserHotel) > 0 {
-		for iNdEx := len(m.UserHotel) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserHotel[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBooking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *PriceQuote) GetTotal() float64 {
+	if m != nil {
+		return m.Total
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ListUserRestaurantRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceQuote) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListUserRestaurantRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PaymentAttempt struct {
+	AttemptId            string   `protobuf:"bytes,1,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error"`
+	CreatedAt            string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListUserRestaurantRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintBooking(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.UserRestaurant) > 0 {
-		for iNdEx := len(m.UserRestaurant) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserRestaurant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBooking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PaymentAttempt) Reset()         { *m = PaymentAttempt{} }
+func (m *PaymentAttempt) String() string { return proto.CompactTextString(m) }
+func (*PaymentAttempt) ProtoMessage()    {}
+func (*PaymentAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{21}
+}
+func (m *PaymentAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PaymentAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentAttempt.Merge(m, src)
+}
+func (m *PaymentAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentAttempt.DiscardUnknown(m)
 }
 
-func (m *ListUserAttractionRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PaymentAttempt proto.InternalMessageInfo
+
+func (m *PaymentAttempt) GetAttemptId() string {
+	if m != nil {
+		return m.AttemptId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListUserAttractionRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PaymentAttempt) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
 }
 
-func (m *ListUserAttractionRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PaymentAttempt) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if m.Count != 0 {
-		i = encodeVarintBooking(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *PaymentAttempt) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.UserAttraction) > 0 {
-		for iNdEx := len(m.UserAttraction) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserAttraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBooking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *PaymentAttempt) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GeneralBook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Payment struct {
+	PaymentId            string            `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id"`
+	BookingId            string            `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id"`
+	UserId               string            `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Amount               float64           `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount"`
+	Currency             string            `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency"`
+	Status               string            `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`
+	Provider             string            `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider"`
+	ProviderRef          string            `protobuf:"bytes,8,opt,name=provider_ref,json=providerRef,proto3" json:"provider_ref"`
+	Attempts             []*PaymentAttempt `protobuf:"bytes,9,rep,name=attempts,proto3" json:"attempts"`
+	CreatedAt            string            `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string            `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	RefundedAmount       float64           `protobuf:"fixed64,12,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GeneralBook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Payment) Reset()         { *m = Payment{} }
+func (m *Payment) String() string { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()    {}
+func (*Payment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{22}
 }
-
-func (m *GeneralBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Payment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x5a
+}
+func (m *Payment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payment.Merge(m, src)
+}
+func (m *Payment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Payment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Payment proto.InternalMessageInfo
+
+func (m *Payment) GetPaymentId() string {
+	if m != nil {
+		return m.PaymentId
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x52
+	return ""
+}
+
+func (m *Payment) GetBookingId() string {
+	if m != nil {
+		return m.BookingId
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *Payment) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *Payment) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
 	}
-	if m.IsCanceled {
-		i--
-		if m.IsCanceled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *Payment) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
-	if m.NumberOfPeople != 0 {
-		i = encodeVarintBooking(dAtA, i, uint64(m.NumberOfPeople))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *Payment) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.WillLeave) > 0 {
-		i -= len(m.WillLeave)
-		copy(dAtA[i:], m.WillLeave)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillLeave)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *Payment) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.WillArrive) > 0 {
-		i -= len(m.WillArrive)
-		copy(dAtA[i:], m.WillArrive)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillArrive)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *Payment) GetProviderRef() string {
+	if m != nil {
+		return m.ProviderRef
 	}
-	if len(m.HraId) > 0 {
-		i -= len(m.HraId)
-		copy(dAtA[i:], m.HraId)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.HraId)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *Payment) GetAttempts() []*PaymentAttempt {
+	if m != nil {
+		return m.Attempts
 	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Payment) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Payment) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UserId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Payment) GetRefundedAmount() float64 {
+	if m != nil {
+		return m.RefundedAmount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UserId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PayReq struct {
+	BookingId            string   `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	CardToken            string   `protobuf:"bytes,3,opt,name=card_token,json=cardToken,proto3" json:"card_token"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UserId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintBooking(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.UserId) > 0 {
-		for iNdEx := len(m.UserId) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserId[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBooking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PayReq) Reset()         { *m = PayReq{} }
+func (m *PayReq) String() string { return proto.CompactTextString(m) }
+func (*PayReq) ProtoMessage()    {}
+func (*PayReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{23}
+}
+func (m *PayReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PayReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayReq.Merge(m, src)
+}
+func (m *PayReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayReq.DiscardUnknown(m)
 }
 
-func encodeVarintBooking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBooking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_PayReq proto.InternalMessageInfo
+
+func (m *PayReq) GetBookingId() string {
+	if m != nil {
+		return m.BookingId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *DelRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *PayReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return n
+	return ""
 }
 
-func (m *Id) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PayReq) GetCardToken() string {
+	if m != nil {
+		return m.CardToken
 	}
-	return n
+	return ""
 }
 
-func (m *Filter) Size() (n int) {
-	if m == nil {
-		return 0
+type PaymentEvent struct {
+	Event                string   `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
+	ProviderRef          string   `protobuf:"bytes,2,opt,name=provider_ref,json=providerRef,proto3" json:"provider_ref"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaymentEvent) Reset()         { *m = PaymentEvent{} }
+func (m *PaymentEvent) String() string { return proto.CompactTextString(m) }
+func (*PaymentEvent) ProtoMessage()    {}
+func (*PaymentEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{24}
+}
+func (m *PaymentEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymentEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymentEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Filter) > 0 {
-		for k, v := range m.Filter {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovBooking(uint64(len(k))) + 1 + len(v) + sovBooking(uint64(len(v)))
-			n += mapEntrySize + 1 + sovBooking(uint64(mapEntrySize))
+}
+func (m *PaymentEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentEvent.Merge(m, src)
+}
+func (m *PaymentEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymentEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentEvent proto.InternalMessageInfo
+
+func (m *PaymentEvent) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *PaymentEvent) GetProviderRef() string {
+	if m != nil {
+		return m.ProviderRef
+	}
+	return ""
+}
+
+type StatusReq struct {
+	BookingId            string   `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id"`
+	BookingType          string   `protobuf:"bytes,2,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+	ChangedBy            string   `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by"`
+	UserId               string   `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusReq) Reset()         { *m = StatusReq{} }
+func (m *StatusReq) String() string { return proto.CompactTextString(m) }
+func (*StatusReq) ProtoMessage()    {}
+func (*StatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{25}
+}
+func (m *StatusReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *StatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusReq.Merge(m, src)
+}
+func (m *StatusReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusReq proto.InternalMessageInfo
+
+func (m *StatusReq) GetBookingId() string {
+	if m != nil {
+		return m.BookingId
 	}
-	return n
+	return ""
 }
 
-func (m *ListReqById) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StatusReq) GetBookingType() string {
+	if m != nil {
+		return m.BookingType
 	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovBooking(uint64(m.Limit))
+	return ""
+}
+
+func (m *StatusReq) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.Offset != 0 {
-		n += 1 + sovBooking(uint64(m.Offset))
+	return ""
+}
+
+func (m *StatusReq) GetChangedBy() string {
+	if m != nil {
+		return m.ChangedBy
 	}
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovBooking(uint64(l))
+	return ""
+}
+
+func (m *StatusReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+type StatusChange struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	BookingId            string   `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id"`
+	BookingType          string   `protobuf:"bytes,3,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
+	FromStatus           string   `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3" json:"from_status"`
+	ToStatus             string   `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3" json:"to_status"`
+	Reason               string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason"`
+	ChangedBy            string   `protobuf:"bytes,7,opt,name=changed_by,json=changedBy,proto3" json:"changed_by"`
+	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	RefundPercent        float64  `protobuf:"fixed64,9,opt,name=refund_percent,json=refundPercent,proto3" json:"refund_percent"`
+	RefundAmount         float64  `protobuf:"fixed64,10,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount"`
+	Currency             string   `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency"`
+	FromWillArrive       string   `protobuf:"bytes,12,opt,name=from_will_arrive,json=fromWillArrive,proto3" json:"from_will_arrive"`
+	FromWillLeave        string   `protobuf:"bytes,13,opt,name=from_will_leave,json=fromWillLeave,proto3" json:"from_will_leave"`
+	ToWillArrive         string   `protobuf:"bytes,14,opt,name=to_will_arrive,json=toWillArrive,proto3" json:"to_will_arrive"`
+	ToWillLeave          string   `protobuf:"bytes,15,opt,name=to_will_leave,json=toWillLeave,proto3" json:"to_will_leave"`
+	PriceDifference      float64  `protobuf:"fixed64,16,opt,name=price_difference,json=priceDifference,proto3" json:"price_difference"`
+	ChangeFee            float64  `protobuf:"fixed64,17,opt,name=change_fee,json=changeFee,proto3" json:"change_fee"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusChange) Reset()         { *m = StatusChange{} }
+func (m *StatusChange) String() string { return proto.CompactTextString(m) }
+func (*StatusChange) ProtoMessage()    {}
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{26}
+}
+func (m *StatusChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *StatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusChange.Merge(m, src)
+}
+func (m *StatusChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusChange.DiscardUnknown(m)
 }
 
-func (m *ListReq) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_StatusChange proto.InternalMessageInfo
+
+func (m *StatusChange) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovBooking(uint64(m.Limit))
+	return ""
+}
+
+func (m *StatusChange) GetBookingId() string {
+	if m != nil {
+		return m.BookingId
 	}
-	if m.Offset != 0 {
-		n += 1 + sovBooking(uint64(m.Offset))
+	return ""
+}
+
+func (m *StatusChange) GetBookingType() string {
+	if m != nil {
+		return m.BookingType
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *StatusChange) GetFromStatus() string {
+	if m != nil {
+		return m.FromStatus
 	}
-	return n
+	return ""
 }
 
-func (m *ListUserHotelRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StatusChange) GetToStatus() string {
+	if m != nil {
+		return m.ToStatus
 	}
-	var l int
-	_ = l
-	if len(m.UserHotel) > 0 {
-		for _, e := range m.UserHotel {
-			l = e.Size()
-			n += 1 + l + sovBooking(uint64(l))
-		}
+	return ""
+}
+
+func (m *StatusChange) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.Count != 0 {
-		n += 1 + sovBooking(uint64(m.Count))
+	return ""
+}
+
+func (m *StatusChange) GetChangedBy() string {
+	if m != nil {
+		return m.ChangedBy
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *StatusChange) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return n
+	return ""
 }
 
-func (m *ListUserRestaurantRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StatusChange) GetRefundPercent() float64 {
+	if m != nil {
+		return m.RefundPercent
 	}
-	var l int
-	_ = l
-	if len(m.UserRestaurant) > 0 {
-		for _, e := range m.UserRestaurant {
-			l = e.Size()
-			n += 1 + l + sovBooking(uint64(l))
-		}
+	return 0
+}
+
+func (m *StatusChange) GetRefundAmount() float64 {
+	if m != nil {
+		return m.RefundAmount
 	}
-	if m.Count != 0 {
-		n += 1 + sovBooking(uint64(m.Count))
+	return 0
+}
+
+func (m *StatusChange) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *StatusChange) GetFromWillArrive() string {
+	if m != nil {
+		return m.FromWillArrive
 	}
-	return n
+	return ""
 }
 
-func (m *ListUserAttractionRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StatusChange) GetFromWillLeave() string {
+	if m != nil {
+		return m.FromWillLeave
 	}
-	var l int
-	_ = l
-	if len(m.UserAttraction) > 0 {
-		for _, e := range m.UserAttraction {
-			l = e.Size()
-			n += 1 + l + sovBooking(uint64(l))
+	return ""
+}
+
+func (m *StatusChange) GetToWillArrive() string {
+	if m != nil {
+		return m.ToWillArrive
+	}
+	return ""
+}
+
+func (m *StatusChange) GetToWillLeave() string {
+	if m != nil {
+		return m.ToWillLeave
+	}
+	return ""
+}
+
+func (m *StatusChange) GetPriceDifference() float64 {
+	if m != nil {
+		return m.PriceDifference
+	}
+	return 0
+}
+
+func (m *StatusChange) GetChangeFee() float64 {
+	if m != nil {
+		return m.ChangeFee
+	}
+	return 0
+}
+
+type RescheduleReq struct {
+	BookingId            string   `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id"`
+	BookingType          string   `protobuf:"bytes,2,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
+	WillArrive           string   `protobuf:"bytes,3,opt,name=will_arrive,json=willArrive,proto3" json:"will_arrive"`
+	WillLeave            string   `protobuf:"bytes,4,opt,name=will_leave,json=willLeave,proto3" json:"will_leave"`
+	NumberOfPeople       int64    `protobuf:"varint,5,opt,name=number_of_people,json=numberOfPeople,proto3" json:"number_of_people"`
+	AdultTickets         int64    `protobuf:"varint,6,opt,name=adult_tickets,json=adultTickets,proto3" json:"adult_tickets"`
+	ChildTickets         int64    `protobuf:"varint,7,opt,name=child_tickets,json=childTickets,proto3" json:"child_tickets"`
+	Reason               string   `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason"`
+	ChangedBy            string   `protobuf:"bytes,9,opt,name=changed_by,json=changedBy,proto3" json:"changed_by"`
+	UserId               string   `protobuf:"bytes,10,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RescheduleReq) Reset()         { *m = RescheduleReq{} }
+func (m *RescheduleReq) String() string { return proto.CompactTextString(m) }
+func (*RescheduleReq) ProtoMessage()    {}
+func (*RescheduleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{27}
+}
+func (m *RescheduleReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RescheduleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RescheduleReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RescheduleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescheduleReq.Merge(m, src)
+}
+func (m *RescheduleReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RescheduleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescheduleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescheduleReq proto.InternalMessageInfo
+
+func (m *RescheduleReq) GetBookingId() string {
+	if m != nil {
+		return m.BookingId
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetBookingType() string {
+	if m != nil {
+		return m.BookingType
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetWillArrive() string {
+	if m != nil {
+		return m.WillArrive
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetWillLeave() string {
+	if m != nil {
+		return m.WillLeave
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetNumberOfPeople() int64 {
+	if m != nil {
+		return m.NumberOfPeople
+	}
+	return 0
+}
+
+func (m *RescheduleReq) GetAdultTickets() int64 {
+	if m != nil {
+		return m.AdultTickets
+	}
+	return 0
+}
+
+func (m *RescheduleReq) GetChildTickets() int64 {
+	if m != nil {
+		return m.ChildTickets
+	}
+	return 0
+}
+
+func (m *RescheduleReq) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetChangedBy() string {
+	if m != nil {
+		return m.ChangedBy
+	}
+	return ""
+}
+
+func (m *RescheduleReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type StatusHistoryReq struct {
+	BookingId            string   `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id"`
+	BookingType          string   `protobuf:"bytes,2,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusHistoryReq) Reset()         { *m = StatusHistoryReq{} }
+func (m *StatusHistoryReq) String() string { return proto.CompactTextString(m) }
+func (*StatusHistoryReq) ProtoMessage()    {}
+func (*StatusHistoryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{28}
+}
+func (m *StatusHistoryReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusHistoryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusHistoryReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusHistoryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusHistoryReq.Merge(m, src)
+}
+func (m *StatusHistoryReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusHistoryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusHistoryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusHistoryReq proto.InternalMessageInfo
+
+func (m *StatusHistoryReq) GetBookingId() string {
+	if m != nil {
+		return m.BookingId
+	}
+	return ""
+}
+
+func (m *StatusHistoryReq) GetBookingType() string {
+	if m != nil {
+		return m.BookingType
+	}
+	return ""
+}
+
+func (m *StatusHistoryReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type StatusHistoryRes struct {
+	Status               string          `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
+	Changes              []*StatusChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StatusHistoryRes) Reset()         { *m = StatusHistoryRes{} }
+func (m *StatusHistoryRes) String() string { return proto.CompactTextString(m) }
+func (*StatusHistoryRes) ProtoMessage()    {}
+func (*StatusHistoryRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{29}
+}
+func (m *StatusHistoryRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusHistoryRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusHistoryRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusHistoryRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusHistoryRes.Merge(m, src)
+}
+func (m *StatusHistoryRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusHistoryRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusHistoryRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusHistoryRes proto.InternalMessageInfo
+
+func (m *StatusHistoryRes) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *StatusHistoryRes) GetChanges() []*StatusChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type WaitlistEntry struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	BookingType          string   `protobuf:"bytes,2,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	HraId                string   `protobuf:"bytes,4,opt,name=hra_id,json=hraId,proto3" json:"hra_id"`
+	WillArrive           string   `protobuf:"bytes,5,opt,name=will_arrive,json=willArrive,proto3" json:"will_arrive"`
+	WillLeave            string   `protobuf:"bytes,6,opt,name=will_leave,json=willLeave,proto3" json:"will_leave"`
+	NumberOfPeople       int64    `protobuf:"varint,7,opt,name=number_of_people,json=numberOfPeople,proto3" json:"number_of_people"`
+	Reason               string   `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason"`
+	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status"`
+	OfferExpiresAt       string   `protobuf:"bytes,10,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at"`
+	BookingId            string   `protobuf:"bytes,11,opt,name=booking_id,json=bookingId,proto3" json:"booking_id"`
+	CreatedAt            string   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitlistEntry) Reset()         { *m = WaitlistEntry{} }
+func (m *WaitlistEntry) String() string { return proto.CompactTextString(m) }
+func (*WaitlistEntry) ProtoMessage()    {}
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{30}
+}
+func (m *WaitlistEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WaitlistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WaitlistEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WaitlistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitlistEntry.Merge(m, src)
+}
+func (m *WaitlistEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *WaitlistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitlistEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitlistEntry proto.InternalMessageInfo
+
+func (m *WaitlistEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetBookingType() string {
+	if m != nil {
+		return m.BookingType
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetHraId() string {
+	if m != nil {
+		return m.HraId
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetWillArrive() string {
+	if m != nil {
+		return m.WillArrive
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetWillLeave() string {
+	if m != nil {
+		return m.WillLeave
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetNumberOfPeople() int64 {
+	if m != nil {
+		return m.NumberOfPeople
+	}
+	return 0
+}
+
+func (m *WaitlistEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetOfferExpiresAt() string {
+	if m != nil {
+		return m.OfferExpiresAt
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetBookingId() string {
+	if m != nil {
+		return m.BookingId
+	}
+	return ""
+}
+
+func (m *WaitlistEntry) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+type WaitlistReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitlistReq) Reset()         { *m = WaitlistReq{} }
+func (m *WaitlistReq) String() string { return proto.CompactTextString(m) }
+func (*WaitlistReq) ProtoMessage()    {}
+func (*WaitlistReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{31}
+}
+func (m *WaitlistReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WaitlistReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WaitlistReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WaitlistReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitlistReq.Merge(m, src)
+}
+func (m *WaitlistReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *WaitlistReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitlistReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitlistReq proto.InternalMessageInfo
+
+func (m *WaitlistReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WaitlistReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type WaitlistListReq struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WaitlistListReq) Reset()         { *m = WaitlistListReq{} }
+func (m *WaitlistListReq) String() string { return proto.CompactTextString(m) }
+func (*WaitlistListReq) ProtoMessage()    {}
+func (*WaitlistListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{32}
+}
+func (m *WaitlistListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WaitlistListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WaitlistListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WaitlistListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitlistListReq.Merge(m, src)
+}
+func (m *WaitlistListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *WaitlistListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitlistListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitlistListReq proto.InternalMessageInfo
+
+func (m *WaitlistListReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type WaitlistListRes struct {
+	Entries              []*WaitlistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WaitlistListRes) Reset()         { *m = WaitlistListRes{} }
+func (m *WaitlistListRes) String() string { return proto.CompactTextString(m) }
+func (*WaitlistListRes) ProtoMessage()    {}
+func (*WaitlistListRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{33}
+}
+func (m *WaitlistListRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WaitlistListRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WaitlistListRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WaitlistListRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitlistListRes.Merge(m, src)
+}
+func (m *WaitlistListRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *WaitlistListRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitlistListRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitlistListRes proto.InternalMessageInfo
+
+func (m *WaitlistListRes) GetEntries() []*WaitlistEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type Itinerary struct {
+	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	UserId               string         `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Status               string         `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+	Bookings             []*GeneralBook `protobuf:"bytes,4,rep,name=bookings,proto3" json:"bookings"`
+	CreatedAt            string         `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string         `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Itinerary) Reset()         { *m = Itinerary{} }
+func (m *Itinerary) String() string { return proto.CompactTextString(m) }
+func (*Itinerary) ProtoMessage()    {}
+func (*Itinerary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{34}
+}
+func (m *Itinerary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Itinerary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Itinerary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Itinerary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Itinerary.Merge(m, src)
+}
+func (m *Itinerary) XXX_Size() int {
+	return m.Size()
+}
+func (m *Itinerary) XXX_DiscardUnknown() {
+	xxx_messageInfo_Itinerary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Itinerary proto.InternalMessageInfo
+
+func (m *Itinerary) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Itinerary) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Itinerary) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Itinerary) GetBookings() []*GeneralBook {
+	if m != nil {
+		return m.Bookings
+	}
+	return nil
+}
+
+func (m *Itinerary) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Itinerary) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ItineraryReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+	ChangedBy            string   `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ItineraryReq) Reset()         { *m = ItineraryReq{} }
+func (m *ItineraryReq) String() string { return proto.CompactTextString(m) }
+func (*ItineraryReq) ProtoMessage()    {}
+func (*ItineraryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{35}
+}
+func (m *ItineraryReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItineraryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItineraryReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItineraryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItineraryReq.Merge(m, src)
+}
+func (m *ItineraryReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItineraryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItineraryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItineraryReq proto.InternalMessageInfo
+
+func (m *ItineraryReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ItineraryReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ItineraryReq) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ItineraryReq) GetChangedBy() string {
+	if m != nil {
+		return m.ChangedBy
+	}
+	return ""
+}
+
+type ItineraryListReq struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ItineraryListReq) Reset()         { *m = ItineraryListReq{} }
+func (m *ItineraryListReq) String() string { return proto.CompactTextString(m) }
+func (*ItineraryListReq) ProtoMessage()    {}
+func (*ItineraryListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{36}
+}
+func (m *ItineraryListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItineraryListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItineraryListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItineraryListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItineraryListReq.Merge(m, src)
+}
+func (m *ItineraryListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItineraryListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItineraryListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItineraryListReq proto.InternalMessageInfo
+
+func (m *ItineraryListReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type ItineraryListRes struct {
+	Itineraries          []*Itinerary `protobuf:"bytes,1,rep,name=itineraries,proto3" json:"itineraries"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ItineraryListRes) Reset()         { *m = ItineraryListRes{} }
+func (m *ItineraryListRes) String() string { return proto.CompactTextString(m) }
+func (*ItineraryListRes) ProtoMessage()    {}
+func (*ItineraryListRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{37}
+}
+func (m *ItineraryListRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItineraryListRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItineraryListRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItineraryListRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItineraryListRes.Merge(m, src)
+}
+func (m *ItineraryListRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItineraryListRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItineraryListRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItineraryListRes proto.InternalMessageInfo
+
+func (m *ItineraryListRes) GetItineraries() []*Itinerary {
+	if m != nil {
+		return m.Itineraries
+	}
+	return nil
+}
+
+type HoldReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HoldReq) Reset()         { *m = HoldReq{} }
+func (m *HoldReq) String() string { return proto.CompactTextString(m) }
+func (*HoldReq) ProtoMessage()    {}
+func (*HoldReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{38}
+}
+func (m *HoldReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HoldReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HoldReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HoldReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HoldReq.Merge(m, src)
+}
+func (m *HoldReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *HoldReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_HoldReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HoldReq proto.InternalMessageInfo
+
+func (m *HoldReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *HoldReq) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type EstablishmentBookingsReq struct {
+	BookingType          string   `protobuf:"bytes,1,opt,name=booking_type,json=bookingType,proto3" json:"booking_type"`
+	EstablishmentId      string   `protobuf:"bytes,2,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	Since                string   `protobuf:"bytes,3,opt,name=since,proto3" json:"since"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstablishmentBookingsReq) Reset()         { *m = EstablishmentBookingsReq{} }
+func (m *EstablishmentBookingsReq) String() string { return proto.CompactTextString(m) }
+func (*EstablishmentBookingsReq) ProtoMessage()    {}
+func (*EstablishmentBookingsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{39}
+}
+func (m *EstablishmentBookingsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstablishmentBookingsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstablishmentBookingsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstablishmentBookingsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstablishmentBookingsReq.Merge(m, src)
+}
+func (m *EstablishmentBookingsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstablishmentBookingsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstablishmentBookingsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstablishmentBookingsReq proto.InternalMessageInfo
+
+func (m *EstablishmentBookingsReq) GetBookingType() string {
+	if m != nil {
+		return m.BookingType
+	}
+	return ""
+}
+
+func (m *EstablishmentBookingsReq) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
+	}
+	return ""
+}
+
+func (m *EstablishmentBookingsReq) GetSince() string {
+	if m != nil {
+		return m.Since
+	}
+	return ""
+}
+
+type CalendarSource struct {
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	RoomId       string `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Url          string `protobuf:"bytes,4,opt,name=url,proto3" json:"url"`
+	LastSyncedAt string `protobuf:"bytes,5,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at"`
+	LastError    string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error"`
+	Blocks       int64  `protobuf:"varint,7,opt,name=blocks,proto3" json:"blocks"`
+	CreatedAt    string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt    string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	// iCalendar file of an uploaded calendar, it is never sent back
+	Content              string   `protobuf:"bytes,10,opt,name=content,proto3" json:"content"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CalendarSource) Reset()         { *m = CalendarSource{} }
+func (m *CalendarSource) String() string { return proto.CompactTextString(m) }
+func (*CalendarSource) ProtoMessage()    {}
+func (*CalendarSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{40}
+}
+func (m *CalendarSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalendarSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CalendarSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CalendarSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarSource.Merge(m, src)
+}
+func (m *CalendarSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalendarSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarSource proto.InternalMessageInfo
+
+func (m *CalendarSource) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CalendarSource) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
+func (m *CalendarSource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CalendarSource) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CalendarSource) GetLastSyncedAt() string {
+	if m != nil {
+		return m.LastSyncedAt
+	}
+	return ""
+}
+
+func (m *CalendarSource) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *CalendarSource) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *CalendarSource) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *CalendarSource) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *CalendarSource) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+type CalendarSourceReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Content              string   `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CalendarSourceReq) Reset()         { *m = CalendarSourceReq{} }
+func (m *CalendarSourceReq) String() string { return proto.CompactTextString(m) }
+func (*CalendarSourceReq) ProtoMessage()    {}
+func (*CalendarSourceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{41}
+}
+func (m *CalendarSourceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalendarSourceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CalendarSourceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CalendarSourceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarSourceReq.Merge(m, src)
+}
+func (m *CalendarSourceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalendarSourceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarSourceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarSourceReq proto.InternalMessageInfo
+
+func (m *CalendarSourceReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CalendarSourceReq) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+type CalendarSourceListReq struct {
+	RoomId               string   `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CalendarSourceListReq) Reset()         { *m = CalendarSourceListReq{} }
+func (m *CalendarSourceListReq) String() string { return proto.CompactTextString(m) }
+func (*CalendarSourceListReq) ProtoMessage()    {}
+func (*CalendarSourceListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{42}
+}
+func (m *CalendarSourceListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalendarSourceListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CalendarSourceListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CalendarSourceListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarSourceListReq.Merge(m, src)
+}
+func (m *CalendarSourceListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalendarSourceListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarSourceListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarSourceListReq proto.InternalMessageInfo
+
+func (m *CalendarSourceListReq) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
+type CalendarSourceListRes struct {
+	Sources              []*CalendarSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CalendarSourceListRes) Reset()         { *m = CalendarSourceListRes{} }
+func (m *CalendarSourceListRes) String() string { return proto.CompactTextString(m) }
+func (*CalendarSourceListRes) ProtoMessage()    {}
+func (*CalendarSourceListRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{43}
+}
+func (m *CalendarSourceListRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalendarSourceListRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CalendarSourceListRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CalendarSourceListRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalendarSourceListRes.Merge(m, src)
+}
+func (m *CalendarSourceListRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalendarSourceListRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalendarSourceListRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalendarSourceListRes proto.InternalMessageInfo
+
+func (m *CalendarSourceListRes) GetSources() []*CalendarSource {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+type ExternalBlock struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	SourceId             string   `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id"`
+	RoomId               string   `protobuf:"bytes,3,opt,name=room_id,json=roomId,proto3" json:"room_id"`
+	Uid                  string   `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid"`
+	Summary              string   `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary"`
+	StartsOn             string   `protobuf:"bytes,6,opt,name=starts_on,json=startsOn,proto3" json:"starts_on"`
+	EndsOn               string   `protobuf:"bytes,7,opt,name=ends_on,json=endsOn,proto3" json:"ends_on"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalBlock) Reset()         { *m = ExternalBlock{} }
+func (m *ExternalBlock) String() string { return proto.CompactTextString(m) }
+func (*ExternalBlock) ProtoMessage()    {}
+func (*ExternalBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{44}
+}
+func (m *ExternalBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalBlock.Merge(m, src)
+}
+func (m *ExternalBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalBlock proto.InternalMessageInfo
+
+func (m *ExternalBlock) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExternalBlock) GetSourceId() string {
+	if m != nil {
+		return m.SourceId
+	}
+	return ""
+}
+
+func (m *ExternalBlock) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
+func (m *ExternalBlock) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *ExternalBlock) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *ExternalBlock) GetStartsOn() string {
+	if m != nil {
+		return m.StartsOn
+	}
+	return ""
+}
+
+func (m *ExternalBlock) GetEndsOn() string {
+	if m != nil {
+		return m.EndsOn
+	}
+	return ""
+}
+
+type ExternalBlockListReq struct {
+	RoomId               string   `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalBlockListReq) Reset()         { *m = ExternalBlockListReq{} }
+func (m *ExternalBlockListReq) String() string { return proto.CompactTextString(m) }
+func (*ExternalBlockListReq) ProtoMessage()    {}
+func (*ExternalBlockListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{45}
+}
+func (m *ExternalBlockListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalBlockListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalBlockListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalBlockListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalBlockListReq.Merge(m, src)
+}
+func (m *ExternalBlockListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalBlockListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalBlockListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalBlockListReq proto.InternalMessageInfo
+
+func (m *ExternalBlockListReq) GetRoomId() string {
+	if m != nil {
+		return m.RoomId
+	}
+	return ""
+}
+
+func (m *ExternalBlockListReq) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ExternalBlockListReq) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+type ExternalBlockListRes struct {
+	Blocks               []*ExternalBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExternalBlockListRes) Reset()         { *m = ExternalBlockListRes{} }
+func (m *ExternalBlockListRes) String() string { return proto.CompactTextString(m) }
+func (*ExternalBlockListRes) ProtoMessage()    {}
+func (*ExternalBlockListRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{46}
+}
+func (m *ExternalBlockListRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalBlockListRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalBlockListRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalBlockListRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalBlockListRes.Merge(m, src)
+}
+func (m *ExternalBlockListRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalBlockListRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalBlockListRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalBlockListRes proto.InternalMessageInfo
+
+func (m *ExternalBlockListRes) GetBlocks() []*ExternalBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type ConfirmationCodeReq struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmationCodeReq) Reset()         { *m = ConfirmationCodeReq{} }
+func (m *ConfirmationCodeReq) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationCodeReq) ProtoMessage()    {}
+func (*ConfirmationCodeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{47}
+}
+func (m *ConfirmationCodeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmationCodeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmationCodeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmationCodeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationCodeReq.Merge(m, src)
+}
+func (m *ConfirmationCodeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmationCodeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationCodeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationCodeReq proto.InternalMessageInfo
+
+func (m *ConfirmationCodeReq) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type OwnerBookingsReq struct {
+	EstablishmentIds     []string `protobuf:"bytes,1,rep,name=establishment_ids,json=establishmentIds,proto3" json:"establishment_ids"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
+	Statuses             []string `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses"`
+	Limit                uint64   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	Offset               uint64   `protobuf:"varint,6,opt,name=offset,proto3" json:"offset"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OwnerBookingsReq) Reset()         { *m = OwnerBookingsReq{} }
+func (m *OwnerBookingsReq) String() string { return proto.CompactTextString(m) }
+func (*OwnerBookingsReq) ProtoMessage()    {}
+func (*OwnerBookingsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{48}
+}
+func (m *OwnerBookingsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerBookingsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerBookingsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerBookingsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerBookingsReq.Merge(m, src)
+}
+func (m *OwnerBookingsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerBookingsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerBookingsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerBookingsReq proto.InternalMessageInfo
+
+func (m *OwnerBookingsReq) GetEstablishmentIds() []string {
+	if m != nil {
+		return m.EstablishmentIds
+	}
+	return nil
+}
+
+func (m *OwnerBookingsReq) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *OwnerBookingsReq) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *OwnerBookingsReq) GetStatuses() []string {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *OwnerBookingsReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *OwnerBookingsReq) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type OwnerBooking struct {
+	Booking              *GeneralBook `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking"`
+	EstablishmentId      string       `protobuf:"bytes,2,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *OwnerBooking) Reset()         { *m = OwnerBooking{} }
+func (m *OwnerBooking) String() string { return proto.CompactTextString(m) }
+func (*OwnerBooking) ProtoMessage()    {}
+func (*OwnerBooking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{49}
+}
+func (m *OwnerBooking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerBooking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerBooking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerBooking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerBooking.Merge(m, src)
+}
+func (m *OwnerBooking) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerBooking) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerBooking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerBooking proto.InternalMessageInfo
+
+func (m *OwnerBooking) GetBooking() *GeneralBook {
+	if m != nil {
+		return m.Booking
+	}
+	return nil
+}
+
+func (m *OwnerBooking) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
+	}
+	return ""
+}
+
+type OwnerBookingsRes struct {
+	Bookings             []*OwnerBooking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings"`
+	Count                int64           `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *OwnerBookingsRes) Reset()         { *m = OwnerBookingsRes{} }
+func (m *OwnerBookingsRes) String() string { return proto.CompactTextString(m) }
+func (*OwnerBookingsRes) ProtoMessage()    {}
+func (*OwnerBookingsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4ab27959496508, []int{50}
+}
+func (m *OwnerBookingsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerBookingsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerBookingsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerBookingsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerBookingsRes.Merge(m, src)
+}
+func (m *OwnerBookingsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerBookingsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerBookingsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerBookingsRes proto.InternalMessageInfo
+
+func (m *OwnerBookingsRes) GetBookings() []*OwnerBooking {
+	if m != nil {
+		return m.Bookings
+	}
+	return nil
+}
+
+func (m *OwnerBookingsRes) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DelRes)(nil), "booking.DelRes")
+	proto.RegisterType((*Id)(nil), "booking.Id")
+	proto.RegisterType((*Filter)(nil), "booking.Filter")
+	proto.RegisterMapType((map[string]string)(nil), "booking.Filter.FilterEntry")
+	proto.RegisterType((*BookingId)(nil), "booking.BookingId")
+	proto.RegisterType((*ListReqById)(nil), "booking.ListReqById")
+	proto.RegisterType((*ListReq)(nil), "booking.ListReq")
+	proto.RegisterType((*ListBookingRes)(nil), "booking.ListBookingRes")
+	proto.RegisterType((*GeneralBook)(nil), "booking.GeneralBook")
+	proto.RegisterType((*BookingGuest)(nil), "booking.BookingGuest")
+	proto.RegisterType((*BookingAddOn)(nil), "booking.BookingAddOn")
+	proto.RegisterType((*UserId)(nil), "booking.UserId")
+	proto.RegisterType((*AvailabilityReq)(nil), "booking.AvailabilityReq")
+	proto.RegisterType((*AvailableHotel)(nil), "booking.AvailableHotel")
+	proto.RegisterType((*AvailabilityRes)(nil), "booking.AvailabilityRes")
+	proto.RegisterType((*FreeSlotsReq)(nil), "booking.FreeSlotsReq")
+	proto.RegisterType((*Slot)(nil), "booking.Slot")
+	proto.RegisterType((*FreeSlotsRes)(nil), "booking.FreeSlotsRes")
+	proto.RegisterType((*QuotaReq)(nil), "booking.QuotaReq")
+	proto.RegisterType((*EntryWindow)(nil), "booking.EntryWindow")
+	proto.RegisterType((*QuotaRes)(nil), "booking.QuotaRes")
+	proto.RegisterType((*PriceQuote)(nil), "booking.PriceQuote")
+	proto.RegisterType((*PaymentAttempt)(nil), "booking.PaymentAttempt")
+	proto.RegisterType((*Payment)(nil), "booking.Payment")
+	proto.RegisterType((*PayReq)(nil), "booking.PayReq")
+	proto.RegisterType((*PaymentEvent)(nil), "booking.PaymentEvent")
+	proto.RegisterType((*StatusReq)(nil), "booking.StatusReq")
+	proto.RegisterType((*StatusChange)(nil), "booking.StatusChange")
+	proto.RegisterType((*RescheduleReq)(nil), "booking.RescheduleReq")
+	proto.RegisterType((*StatusHistoryReq)(nil), "booking.StatusHistoryReq")
+	proto.RegisterType((*StatusHistoryRes)(nil), "booking.StatusHistoryRes")
+	proto.RegisterType((*WaitlistEntry)(nil), "booking.WaitlistEntry")
+	proto.RegisterType((*WaitlistReq)(nil), "booking.WaitlistReq")
+	proto.RegisterType((*WaitlistListReq)(nil), "booking.WaitlistListReq")
+	proto.RegisterType((*WaitlistListRes)(nil), "booking.WaitlistListRes")
+	proto.RegisterType((*Itinerary)(nil), "booking.Itinerary")
+	proto.RegisterType((*ItineraryReq)(nil), "booking.ItineraryReq")
+	proto.RegisterType((*ItineraryListReq)(nil), "booking.ItineraryListReq")
+	proto.RegisterType((*ItineraryListRes)(nil), "booking.ItineraryListRes")
+	proto.RegisterType((*HoldReq)(nil), "booking.HoldReq")
+	proto.RegisterType((*EstablishmentBookingsReq)(nil), "booking.EstablishmentBookingsReq")
+	proto.RegisterType((*CalendarSource)(nil), "booking.CalendarSource")
+	proto.RegisterType((*CalendarSourceReq)(nil), "booking.CalendarSourceReq")
+	proto.RegisterType((*CalendarSourceListReq)(nil), "booking.CalendarSourceListReq")
+	proto.RegisterType((*CalendarSourceListRes)(nil), "booking.CalendarSourceListRes")
+	proto.RegisterType((*ExternalBlock)(nil), "booking.ExternalBlock")
+	proto.RegisterType((*ExternalBlockListReq)(nil), "booking.ExternalBlockListReq")
+	proto.RegisterType((*ExternalBlockListRes)(nil), "booking.ExternalBlockListRes")
+	proto.RegisterType((*ConfirmationCodeReq)(nil), "booking.ConfirmationCodeReq")
+	proto.RegisterType((*OwnerBookingsReq)(nil), "booking.OwnerBookingsReq")
+	proto.RegisterType((*OwnerBooking)(nil), "booking.OwnerBooking")
+	proto.RegisterType((*OwnerBookingsRes)(nil), "booking.OwnerBookingsRes")
+}
+
+func init() { proto.RegisterFile("booking-proto/booking.proto", fileDescriptor_6f4ab27959496508) }
+
+var fileDescriptor_6f4ab27959496508 = []byte{
+	// 3192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xbb, 0x93, 0x1b, 0xc7,
+	0xd1, 0xff, 0x00, 0xdc, 0x01, 0xd8, 0xc6, 0xe3, 0xc0, 0xe1, 0x9d, 0x08, 0x81, 0x22, 0x45, 0xed,
+	0xa7, 0xc7, 0xf1, 0x63, 0x89, 0xd2, 0x47, 0x4a, 0x2a, 0xf1, 0xd3, 0xab, 0xee, 0x8e, 0x47, 0x11,
+	0x92, 0x3e, 0x92, 0xda, 0x23, 0x8b, 0x2c, 0x3b, 0xd8, 0x9a, 0xdb, 0x1d, 0x10, 0x5b, 0x5c, 0xec,
+	0x82, 0x3b, 0x83, 0xa3, 0x20, 0x57, 0x39, 0x72, 0x95, 0x43, 0x27, 0x0a, 0x9c, 0x38, 0x56, 0xe4,
+	0xd8, 0x89, 0x9d, 0x38, 0x72, 0xe8, 0xc4, 0x99, 0x03, 0x97, 0xfc, 0x2f, 0x38, 0x70, 0xe8, 0xea,
+	0x79, 0xec, 0x0b, 0xc0, 0x3d, 0x54, 0x8a, 0x6e, 0xfb, 0x37, 0x3d, 0x33, 0xdd, 0x3d, 0x3d, 0x3d,
+	0xdd, 0x8d, 0x83, 0x8b, 0x87, 0x71, 0xfc, 0x2c, 0x88, 0x9e, 0xbe, 0x3d, 0x4d, 0x62, 0x11, 0xbf,
+	0xa3, 0xa9, 0xeb, 0x92, 0x22, 0x0d, 0x4d, 0xda, 0x57, 0xa0, 0x7e, 0x9b, 0x85, 0x0e, 0xe3, 0xe4,
+	0x25, 0xa8, 0x27, 0x8c, 0xcf, 0x42, 0xd1, 0xaf, 0x5c, 0xa9, 0x6c, 0x5b, 0x8e, 0xa6, 0xec, 0x4d,
+	0xa8, 0x0e, 0x7d, 0xd2, 0x85, 0x6a, 0xe0, 0xeb, 0x91, 0x6a, 0xe0, 0xdb, 0xdf, 0x40, 0xfd, 0x4e,
+	0x10, 0x0a, 0x96, 0x90, 0x9b, 0x50, 0x1f, 0xc9, 0xaf, 0x7e, 0xe5, 0x4a, 0x6d, 0xbb, 0x75, 0xe3,
+	0xe2, 0x75, 0xb3, 0x95, 0x62, 0xd0, 0x7f, 0xf6, 0x23, 0x91, 0xcc, 0x1d, 0xcd, 0x3a, 0xb8, 0x05,
+	0xad, 0x1c, 0x4c, 0x7a, 0x50, 0x7b, 0xc6, 0xe6, 0x7a, 0x79, 0xfc, 0x24, 0x9b, 0xb0, 0x7e, 0x44,
+	0xc3, 0x19, 0xeb, 0x57, 0x25, 0xa6, 0x88, 0xff, 0xab, 0x7e, 0x58, 0xb1, 0x3f, 0x05, 0x6b, 0x57,
+	0x6d, 0xb0, 0x28, 0x16, 0x79, 0x0d, 0xda, 0x7a, 0x77, 0x57, 0xcc, 0xa7, 0x66, 0x76, 0x4b, 0x63,
+	0x0f, 0xe7, 0x53, 0x66, 0xff, 0x02, 0x5a, 0x5f, 0x05, 0x5c, 0x38, 0xec, 0xf9, 0xee, 0x7c, 0xe8,
+	0xe3, 0x46, 0x61, 0x30, 0x09, 0x94, 0xd6, 0x6b, 0x8e, 0x22, 0xd0, 0x18, 0xf1, 0x68, 0xc4, 0x99,
+	0x90, 0x2b, 0xac, 0x39, 0x9a, 0x22, 0x17, 0xe5, 0x7e, 0xb5, 0x2b, 0x95, 0xed, 0xd6, 0x8d, 0x56,
+	0xaa, 0xe8, 0xd0, 0x5f, 0xba, 0xf9, 0xda, 0xe2, 0xe6, 0x3f, 0x83, 0x86, 0xde, 0xfc, 0x8c, 0x1b,
+	0x97, 0xd7, 0xae, 0x2d, 0xae, 0xfd, 0x04, 0xba, 0xb8, 0xb6, 0x36, 0x0e, 0x1e, 0xe9, 0xbb, 0xd0,
+	0xd4, 0x0c, 0x5c, 0x1f, 0xce, 0x66, 0x2a, 0xf3, 0xe7, 0x2c, 0x62, 0x09, 0x0d, 0x91, 0xdb, 0x49,
+	0xb9, 0x50, 0x28, 0x2f, 0x9e, 0x45, 0x6a, 0xf7, 0x9a, 0xa3, 0x08, 0xfb, 0xef, 0x0d, 0x68, 0xe5,
+	0xf8, 0x17, 0xac, 0x7e, 0x01, 0x1a, 0x33, 0xce, 0x12, 0x37, 0xf0, 0xb5, 0xc1, 0xeb, 0x48, 0x0e,
+	0x7d, 0xb2, 0x05, 0xf5, 0x71, 0x42, 0x5d, 0x6d, 0x32, 0xcb, 0x59, 0x1f, 0x27, 0x74, 0xe8, 0x93,
+	0x57, 0xa1, 0xf5, 0x22, 0x08, 0x43, 0x97, 0x26, 0x49, 0x70, 0x64, 0xec, 0x04, 0x08, 0xed, 0x48,
+	0x84, 0x5c, 0x02, 0x49, 0xb9, 0x21, 0xa3, 0x47, 0xac, 0xbf, 0x2e, 0xc7, 0x2d, 0x44, 0xbe, 0x42,
+	0x80, 0x6c, 0x43, 0x2f, 0x9a, 0x4d, 0x0e, 0x59, 0xe2, 0xc6, 0x23, 0x77, 0xca, 0xe2, 0x69, 0xc8,
+	0xfa, 0x75, 0x29, 0x70, 0x57, 0xe1, 0xf7, 0x47, 0x0f, 0x24, 0x8a, 0x3b, 0x05, 0xdc, 0xf5, 0x68,
+	0xe4, 0xb1, 0x90, 0xf9, 0xfd, 0xc6, 0x95, 0xca, 0x76, 0xd3, 0x81, 0x80, 0xef, 0x69, 0x44, 0x79,
+	0x3d, 0xe5, 0x71, 0xd4, 0x6f, 0x1a, 0xaf, 0x47, 0x0a, 0x25, 0xf0, 0x12, 0x46, 0x05, 0xf3, 0x5d,
+	0x2a, 0xfa, 0x96, 0x92, 0x40, 0x23, 0x3b, 0x02, 0x87, 0x67, 0x53, 0xdf, 0x0c, 0x83, 0x1a, 0xd6,
+	0x88, 0x1a, 0xf6, 0x59, 0xc8, 0xf4, 0x70, 0x4b, 0x0d, 0x6b, 0x64, 0x47, 0x90, 0xff, 0x86, 0x0e,
+	0xf5, 0x67, 0xa1, 0x70, 0x45, 0xe0, 0x3d, 0x63, 0x82, 0xf7, 0xdb, 0x52, 0xf8, 0xb6, 0x04, 0x1f,
+	0x2a, 0x0c, 0x99, 0xbc, 0x71, 0x10, 0xfa, 0x29, 0x53, 0x47, 0x31, 0x49, 0xd0, 0x30, 0xbd, 0x0a,
+	0x2d, 0x11, 0x0b, 0x1a, 0xba, 0xd3, 0x24, 0xf0, 0x58, 0xbf, 0x7b, 0xa5, 0xb2, 0x5d, 0x71, 0x40,
+	0x42, 0x0f, 0x10, 0x21, 0x03, 0x68, 0x7a, 0xb3, 0x24, 0x61, 0x91, 0x37, 0xef, 0x6f, 0x48, 0x39,
+	0x52, 0x1a, 0x75, 0xe7, 0x82, 0x8a, 0x19, 0xef, 0xf7, 0x94, 0xee, 0x8a, 0x5a, 0xf0, 0xb5, 0x73,
+	0x0b, 0xbe, 0x86, 0x2c, 0x81, 0x08, 0xd0, 0x23, 0x92, 0x39, 0x1e, 0x2f, 0x51, 0x2c, 0x29, 0x36,
+	0xf4, 0xc9, 0x9b, 0xb0, 0x31, 0x8e, 0x43, 0xdf, 0x65, 0xdf, 0x4c, 0x83, 0x84, 0x71, 0x34, 0xc4,
+	0x79, 0xc9, 0xd5, 0x41, 0x78, 0x5f, 0xa1, 0x3b, 0x82, 0x5c, 0x83, 0x73, 0x5e, 0x1c, 0x8d, 0x82,
+	0x64, 0x42, 0x45, 0x10, 0x47, 0xae, 0x17, 0xfb, 0xac, 0xbf, 0x29, 0x39, 0x7b, 0xf9, 0x81, 0xbd,
+	0xd8, 0x67, 0xb8, 0x28, 0x9d, 0x4e, 0x93, 0xf8, 0x88, 0x86, 0xae, 0x3f, 0x63, 0xb8, 0xe8, 0x96,
+	0x5a, 0xd4, 0xc0, 0xb7, 0x67, 0x6c, 0x47, 0x90, 0xb7, 0xa1, 0xfe, 0x74, 0xc6, 0xb8, 0xe0, 0xfd,
+	0x97, 0xa4, 0xdf, 0x6f, 0xa5, 0x7e, 0xaf, 0xaf, 0xc7, 0xe7, 0x38, 0xea, 0x68, 0x26, 0x72, 0x15,
+	0x7a, 0x7c, 0xca, 0xbc, 0x80, 0x86, 0x6e, 0xc2, 0x9e, 0xab, 0x89, 0x17, 0xae, 0xd4, 0xb6, 0x2d,
+	0x67, 0x43, 0xe3, 0x8e, 0x86, 0xc9, 0xbb, 0xb0, 0x59, 0x62, 0x75, 0xa3, 0x58, 0xb0, 0x7e, 0x5f,
+	0x8a, 0x41, 0x8a, 0xec, 0xf7, 0x62, 0xc1, 0xc8, 0x75, 0x68, 0x50, 0xdf, 0x77, 0xe3, 0x88, 0xf7,
+	0x5f, 0x5e, 0x2e, 0xcc, 0x8e, 0xef, 0xdf, 0x8f, 0x9c, 0x3a, 0xc5, 0x3f, 0x1c, 0x9d, 0x47, 0x89,
+	0xe5, 0x62, 0x18, 0x18, 0x48, 0x97, 0xb5, 0x14, 0x72, 0xc0, 0x04, 0xb9, 0x0c, 0x2d, 0xbd, 0x9c,
+	0x1c, 0xbf, 0xa8, 0xc6, 0xd5, 0x5c, 0x1c, 0x5f, 0x14, 0x50, 0x31, 0xbe, 0x22, 0x19, 0x4b, 0x02,
+	0xe2, 0x0c, 0x7b, 0x04, 0xed, 0xbc, 0x55, 0xc8, 0x45, 0xb0, 0x46, 0xb3, 0x30, 0x74, 0x23, 0x3a,
+	0x61, 0xfa, 0x96, 0x37, 0x11, 0xb8, 0x47, 0x27, 0x0c, 0x43, 0x35, 0x7d, 0xca, 0x74, 0x7c, 0xc0,
+	0x4f, 0xf2, 0x16, 0x6c, 0xf8, 0xb1, 0x37, 0x9b, 0xb0, 0x48, 0xb8, 0xea, 0xfa, 0xe9, 0xdb, 0xde,
+	0x35, 0xf0, 0x3d, 0x89, 0xda, 0xbf, 0xa9, 0x40, 0x3b, 0xaf, 0x31, 0x19, 0x80, 0xa5, 0x54, 0x71,
+	0xd3, 0x70, 0xd2, 0x90, 0x8a, 0x0c, 0x7d, 0x42, 0x60, 0x4d, 0xee, 0xaf, 0x02, 0x8a, 0xfc, 0x46,
+	0x67, 0x7e, 0x3e, 0xa3, 0x91, 0x08, 0xc4, 0x5c, 0x6e, 0x51, 0x73, 0x52, 0x5a, 0xde, 0xc8, 0x28,
+	0x10, 0xfa, 0x22, 0xac, 0xc9, 0x8b, 0x60, 0x21, 0xa2, 0xee, 0xc1, 0x26, 0xac, 0xcb, 0x5b, 0x21,
+	0x83, 0x49, 0xc5, 0x51, 0x84, 0x7d, 0x1b, 0xea, 0x8f, 0x54, 0xa4, 0x7a, 0x3d, 0x0b, 0x61, 0x2a,
+	0x52, 0x16, 0xa2, 0xbb, 0x89, 0x67, 0xcb, 0xc3, 0xe3, 0xaf, 0x2a, 0xb0, 0xb1, 0x73, 0x44, 0x83,
+	0x90, 0x1e, 0x06, 0x61, 0x20, 0xe6, 0x18, 0xdd, 0x09, 0xac, 0x79, 0x28, 0xa6, 0xd2, 0x4a, 0x7e,
+	0x97, 0xc3, 0x5e, 0xf5, 0x84, 0xb0, 0x57, 0x2b, 0x87, 0xbd, 0x4b, 0x00, 0x53, 0x9a, 0x88, 0xb9,
+	0xcb, 0x83, 0x6f, 0x95, 0x8a, 0x35, 0xc7, 0x92, 0xc8, 0x41, 0xf0, 0x2d, 0xb3, 0xff, 0x54, 0x85,
+	0xae, 0x16, 0x23, 0x64, 0x77, 0x63, 0xc1, 0x42, 0xf2, 0x32, 0x34, 0xc7, 0xf8, 0x91, 0xb3, 0xaf,
+	0xa4, 0x87, 0x3e, 0x2e, 0xa6, 0x86, 0x72, 0x56, 0xb6, 0x24, 0x22, 0x8f, 0x19, 0xe3, 0x22, 0x15,
+	0x41, 0xf4, 0x54, 0x8a, 0x51, 0x75, 0x34, 0x45, 0xfa, 0xd2, 0x99, 0x13, 0xc6, 0xb9, 0x0e, 0xdb,
+	0x86, 0x4c, 0x35, 0x5e, 0xcf, 0x69, 0x7c, 0x01, 0x1a, 0x49, 0x1c, 0x4f, 0x70, 0xfb, 0xba, 0x0e,
+	0xaf, 0x71, 0x3c, 0x19, 0xfa, 0x78, 0xe1, 0xe4, 0x80, 0xcf, 0xb8, 0x97, 0x04, 0x53, 0xbc, 0xdf,
+	0x32, 0x38, 0x5b, 0xce, 0x06, 0xe2, 0xb7, 0x33, 0x18, 0xe3, 0xa0, 0x64, 0xf5, 0xe8, 0x94, 0xca,
+	0x0d, 0x9a, 0x2a, 0x0e, 0x22, 0xb8, 0xa7, 0x31, 0x64, 0x8a, 0x82, 0xa7, 0x63, 0x11, 0xce, 0xb5,
+	0x03, 0x58, 0xf2, 0x98, 0xdb, 0x1a, 0x54, 0x3e, 0x70, 0x09, 0x60, 0x94, 0x30, 0xe6, 0xe2, 0x4c,
+	0x2e, 0x83, 0x76, 0xcd, 0xb1, 0x10, 0x71, 0x10, 0xb0, 0x9f, 0x94, 0x4f, 0x91, 0x93, 0x77, 0xa0,
+	0x2e, 0x4d, 0x62, 0x9e, 0xcf, 0x0b, 0xa9, 0x53, 0x14, 0x0d, 0xed, 0x68, 0xb6, 0x15, 0x0e, 0xf2,
+	0x39, 0xb4, 0xef, 0x24, 0x8c, 0x1d, 0x84, 0xb1, 0xe0, 0xe8, 0x1c, 0xa8, 0x12, 0xe3, 0x82, 0xce,
+	0x12, 0x1a, 0x89, 0xec, 0x6c, 0xda, 0x19, 0xa8, 0x2e, 0x00, 0x3e, 0x27, 0xe6, 0x02, 0xe0, 0xb7,
+	0xfd, 0xff, 0xb0, 0x86, 0x8b, 0xe0, 0x36, 0x5c, 0xd0, 0xc4, 0xa4, 0x6a, 0x8a, 0xc0, 0xab, 0xc9,
+	0x22, 0xf3, 0x04, 0xe3, 0x67, 0xaa, 0x31, 0x67, 0x54, 0xf0, 0x7e, 0x2d, 0xd3, 0xf8, 0x00, 0x01,
+	0xfb, 0x49, 0x41, 0x2e, 0x7c, 0x72, 0xd6, 0x39, 0x7e, 0x6b, 0x6d, 0x3b, 0xa9, 0xb6, 0xc8, 0xe1,
+	0xa8, 0x31, 0x14, 0x1e, 0x97, 0xcb, 0xce, 0x43, 0xa9, 0xda, 0x46, 0xd0, 0x9c, 0x87, 0xbd, 0x07,
+	0xcd, 0xaf, 0x67, 0xb1, 0xa0, 0x5a, 0x5b, 0x2a, 0x44, 0x42, 0x3d, 0x19, 0xde, 0x33, 0x6d, 0x33,
+	0x70, 0x85, 0xb6, 0x07, 0xd0, 0x92, 0xe9, 0xe1, 0xe3, 0x20, 0xf2, 0xe3, 0x17, 0xa7, 0x56, 0xfa,
+	0x15, 0xb0, 0x12, 0x36, 0xa1, 0x41, 0x64, 0xbc, 0xb7, 0xe6, 0x64, 0x80, 0xfd, 0x7d, 0x25, 0x15,
+	0x4d, 0x3e, 0x9f, 0x3e, 0x0d, 0xc2, 0xb9, 0xfb, 0x1c, 0x11, 0xb9, 0x70, 0xcd, 0x01, 0x09, 0x49,
+	0x1e, 0x19, 0xdb, 0x24, 0x43, 0xb6, 0xa2, 0x52, 0xb7, 0x2b, 0x61, 0xc7, 0xa0, 0xf8, 0x20, 0xbe,
+	0x90, 0x62, 0xea, 0xa5, 0xd4, 0xbe, 0x2d, 0x85, 0xa9, 0xb5, 0xae, 0x43, 0x43, 0x91, 0x78, 0x75,
+	0x8a, 0xc9, 0x58, 0x4e, 0x4d, 0xc7, 0x30, 0xd9, 0xff, 0xae, 0x00, 0x48, 0xc7, 0xc5, 0xe9, 0xf2,
+	0x46, 0x4a, 0x6f, 0xe6, 0x5a, 0x4c, 0x4d, 0x91, 0x37, 0xa0, 0x3b, 0x8e, 0xc3, 0xc0, 0xa7, 0x73,
+	0x57, 0x8f, 0x2b, 0x09, 0x3b, 0x1a, 0xbd, 0xa7, 0xd8, 0x16, 0x6e, 0x48, 0x6d, 0xc9, 0x0d, 0x19,
+	0x40, 0x93, 0xcf, 0x0e, 0x55, 0xa0, 0x54, 0x21, 0x34, 0xa5, 0xd1, 0xac, 0x7c, 0x96, 0x78, 0x63,
+	0x9a, 0x3c, 0x65, 0x3a, 0x8a, 0x66, 0x00, 0xce, 0xf4, 0x03, 0xae, 0x7c, 0xbf, 0xae, 0x66, 0x1a,
+	0x3a, 0x8b, 0xbd, 0x8d, 0x5c, 0xec, 0x2d, 0x64, 0x26, 0xcd, 0x62, 0x66, 0x62, 0x7f, 0x57, 0x81,
+	0xee, 0x03, 0x3a, 0xc7, 0xb7, 0x63, 0x47, 0x08, 0x36, 0x99, 0xca, 0x94, 0x8a, 0xaa, 0xcf, 0xcc,
+	0x85, 0x2c, 0x8d, 0x0c, 0x65, 0x1e, 0xa7, 0x7c, 0xc9, 0x64, 0xa0, 0x8a, 0xca, 0xe5, 0x38, 0xb5,
+	0x42, 0x8e, 0xb3, 0x09, 0xeb, 0x2c, 0x49, 0xe2, 0x44, 0x47, 0x31, 0x45, 0x94, 0xb2, 0xbe, 0xf5,
+	0x52, 0xd6, 0x67, 0xff, 0xba, 0x06, 0x0d, 0x2d, 0x96, 0x0a, 0xc6, 0xf2, 0x33, 0x27, 0x8f, 0x46,
+	0x54, 0x78, 0x35, 0x39, 0x54, 0x9a, 0x15, 0x5b, 0x87, 0x69, 0xdd, 0x92, 0xcb, 0x98, 0x6b, 0x85,
+	0x8c, 0x19, 0xf5, 0x98, 0x48, 0x2b, 0x2a, 0xfb, 0x6b, 0xaa, 0x60, 0xad, 0xf5, 0x95, 0x79, 0x5c,
+	0xbd, 0xa0, 0xe3, 0x00, 0x9a, 0x98, 0x13, 0x05, 0x3e, 0x4b, 0x74, 0x70, 0x4d, 0x69, 0xf4, 0x57,
+	0xf3, 0xed, 0x26, 0x6c, 0xa4, 0x4f, 0xa0, 0x65, 0x30, 0x87, 0x8d, 0xc8, 0x4d, 0x68, 0x6a, 0xfb,
+	0xf2, 0xbe, 0x55, 0x0a, 0x7f, 0xc5, 0xc3, 0x71, 0x52, 0xc6, 0x92, 0x05, 0xe1, 0xf8, 0xbc, 0xb9,
+	0x55, 0xce, 0x9b, 0xdf, 0x82, 0x8d, 0x84, 0x8d, 0x66, 0x91, 0x8f, 0xe3, 0xca, 0x0c, 0x6d, 0x69,
+	0x86, 0xae, 0x81, 0x77, 0x24, 0x6a, 0xbb, 0x50, 0x7f, 0x40, 0xe5, 0x43, 0x5b, 0x34, 0x74, 0xe5,
+	0x18, 0x43, 0x17, 0x4b, 0x13, 0x14, 0x94, 0x26, 0xbe, 0x2b, 0xe2, 0x67, 0x2c, 0x32, 0x6f, 0x2d,
+	0x22, 0x0f, 0x11, 0xc0, 0x90, 0xad, 0x75, 0xdc, 0x3f, 0x62, 0xca, 0x87, 0x19, 0x7e, 0x98, 0xe0,
+	0x23, 0x89, 0x05, 0x2b, 0x56, 0x17, 0xac, 0x68, 0xff, 0xae, 0x02, 0xd6, 0x81, 0x3c, 0x8f, 0x53,
+	0x48, 0x7b, 0x72, 0xf9, 0x9a, 0x2b, 0x58, 0x6a, 0x0b, 0x05, 0xcb, 0x98, 0x46, 0x4f, 0x99, 0xef,
+	0x1e, 0xce, 0xb5, 0x57, 0x5b, 0x1a, 0xd9, 0x9d, 0xe7, 0xed, 0xb0, 0x9e, 0xb7, 0x83, 0xfd, 0xe7,
+	0x35, 0x68, 0x2b, 0xf9, 0xf6, 0x24, 0xf3, 0x42, 0x71, 0x77, 0x82, 0x27, 0x9f, 0x5c, 0x98, 0x62,
+	0x94, 0x1d, 0x25, 0xf1, 0xc4, 0xd5, 0x4e, 0xaa, 0xcb, 0x3d, 0x84, 0xd4, 0xc6, 0x98, 0x70, 0x8a,
+	0xd8, 0x0c, 0x6b, 0xef, 0x16, 0xb1, 0x1e, 0xcc, 0x14, 0xae, 0x1f, 0xa3, 0x70, 0xa3, 0xac, 0x70,
+	0xd1, 0x11, 0x9b, 0x65, 0x47, 0x7c, 0x03, 0xb4, 0x4b, 0xb9, 0x53, 0x96, 0x78, 0x78, 0xb0, 0x2a,
+	0x63, 0xe8, 0x28, 0xf4, 0x81, 0x02, 0xd5, 0x4b, 0x2d, 0xd9, 0xb4, 0x3b, 0x82, 0x8a, 0x9a, 0x0a,
+	0xdc, 0x59, 0xbc, 0x9b, 0xad, 0xd2, 0xdd, 0xdc, 0x86, 0x9e, 0xd4, 0x3d, 0x9f, 0xf8, 0xb5, 0x55,
+	0x76, 0x8c, 0xf8, 0xe3, 0x2c, 0xf9, 0x7b, 0x13, 0x36, 0x32, 0x4e, 0x95, 0x01, 0x76, 0x54, 0x69,
+	0x63, 0x18, 0x55, 0x16, 0xf8, 0x3a, 0x74, 0x45, 0x5c, 0x58, 0xaf, 0xab, 0xde, 0x53, 0x11, 0xe7,
+	0x56, 0xb3, 0xa1, 0x23, 0xe2, 0xfc, 0x5a, 0xaa, 0xf8, 0x6b, 0x89, 0x38, 0x5b, 0xe9, 0x2a, 0xf4,
+	0xe4, 0x53, 0xe0, 0xfa, 0xc1, 0x68, 0xc4, 0x50, 0x5e, 0x26, 0x2b, 0xc1, 0x8a, 0xb3, 0x21, 0xf1,
+	0xdb, 0x29, 0x9c, 0x19, 0xdb, 0x1d, 0x31, 0x55, 0x10, 0x56, 0x8c, 0xb1, 0xef, 0x30, 0x66, 0xff,
+	0xad, 0x0a, 0x1d, 0x87, 0x71, 0x6f, 0xcc, 0xfc, 0x59, 0xc8, 0x7e, 0x1a, 0x47, 0x2f, 0x65, 0xcb,
+	0xb5, 0x13, 0xb2, 0xe5, 0xb5, 0xd3, 0x34, 0x09, 0xd6, 0x97, 0x36, 0x09, 0x16, 0xca, 0xf1, 0xfa,
+	0x69, 0xca, 0xf1, 0xc6, 0x92, 0x72, 0xfc, 0xb8, 0x6e, 0x42, 0xe6, 0xab, 0xd6, 0x31, 0x97, 0x13,
+	0x0a, 0x97, 0x73, 0x02, 0x3d, 0x75, 0x0b, 0xee, 0x06, 0x5c, 0xc4, 0xc9, 0xfc, 0xa7, 0xb1, 0xec,
+	0xaa, 0xc7, 0xc7, 0xfe, 0xf9, 0xc2, 0x76, 0x3c, 0xf7, 0xb8, 0x54, 0x0a, 0x8f, 0xcb, 0x3b, 0xd0,
+	0x50, 0x0a, 0x60, 0xbe, 0x51, 0xac, 0x6a, 0xf3, 0xe1, 0xc4, 0x31, 0x5c, 0xf6, 0xbf, 0xaa, 0xd0,
+	0x79, 0x4c, 0x03, 0x11, 0x06, 0x5c, 0xa8, 0xae, 0xdf, 0xd9, 0x9b, 0x77, 0xab, 0xdf, 0xcd, 0xac,
+	0xd3, 0xb4, 0x76, 0x4c, 0xa7, 0x69, 0xfd, 0x04, 0x27, 0xaa, 0x9f, 0xc6, 0x89, 0x1a, 0x4b, 0x9d,
+	0x68, 0xd5, 0xd1, 0x67, 0xf6, 0xb3, 0x0a, 0xf6, 0xdb, 0x86, 0x5e, 0x8c, 0xd7, 0x2b, 0xdf, 0x1f,
+	0x51, 0x87, 0xdf, 0x95, 0x78, 0xd6, 0x20, 0x29, 0x1e, 0x78, 0xab, 0x7c, 0xe0, 0xc5, 0x40, 0xd7,
+	0x2e, 0xe7, 0x2c, 0x1f, 0x40, 0xcb, 0x58, 0x1d, 0xbd, 0xe7, 0xb4, 0xad, 0x3b, 0xfb, 0x7f, 0x60,
+	0xc3, 0xcc, 0x33, 0x1d, 0xcb, 0x0b, 0xf9, 0x1a, 0x39, 0xcf, 0xbb, 0x57, 0xe6, 0xc5, 0x36, 0x49,
+	0x83, 0x45, 0x22, 0x09, 0x98, 0x29, 0x26, 0x5e, 0x4a, 0xdd, 0xa3, 0xe0, 0x04, 0x8e, 0x61, 0xb3,
+	0xff, 0x58, 0x01, 0x6b, 0x68, 0xfa, 0x47, 0xa7, 0x96, 0x73, 0x65, 0x82, 0x97, 0xef, 0x7d, 0xae,
+	0x9d, 0xaa, 0xf7, 0x79, 0x7c, 0xf2, 0x57, 0x4a, 0x5d, 0xea, 0xa5, 0xd4, 0xc5, 0x8e, 0xa0, 0x9d,
+	0x4a, 0x7f, 0x16, 0x43, 0xff, 0xc8, 0x07, 0xdd, 0xbe, 0x06, 0xbd, 0x74, 0xbf, 0x13, 0x0f, 0xe8,
+	0xee, 0x02, 0x33, 0x27, 0xef, 0x41, 0xda, 0xae, 0xcb, 0x4e, 0x89, 0x64, 0x5d, 0x8f, 0x54, 0x99,
+	0x3c, 0x9b, 0x7d, 0x03, 0x1a, 0x77, 0xe3, 0xd0, 0x3f, 0x93, 0x2b, 0xfd, 0x12, 0xfa, 0xfb, 0x5c,
+	0xd0, 0xc3, 0x30, 0xe0, 0x63, 0xcc, 0xa8, 0x74, 0x0f, 0x48, 0x26, 0x44, 0xe5, 0x3b, 0x5f, 0x59,
+	0xbc, 0xf3, 0x57, 0xa1, 0xc7, 0xf2, 0xd3, 0xb3, 0x0d, 0x36, 0x0a, 0xb8, 0xea, 0xcf, 0xf0, 0x20,
+	0xf2, 0xcc, 0x6b, 0xa1, 0x08, 0xfb, 0xbb, 0x2a, 0x74, 0xf7, 0x68, 0xc8, 0x22, 0x9f, 0x26, 0x07,
+	0xf1, 0x2c, 0xf1, 0xd8, 0x32, 0xd9, 0x4d, 0xa3, 0xa2, 0x5a, 0x68, 0x54, 0x98, 0x36, 0x54, 0x2d,
+	0xd7, 0x86, 0xea, 0x41, 0x6d, 0x96, 0x84, 0xfa, 0x48, 0xf0, 0x13, 0xdf, 0xe4, 0x90, 0x72, 0xe1,
+	0xf2, 0x79, 0xe4, 0xe5, 0xdd, 0xa7, 0x8d, 0xe8, 0x81, 0x04, 0x95, 0x07, 0x49, 0x2e, 0x55, 0x78,
+	0x68, 0x0f, 0x42, 0x64, 0x1f, 0x01, 0x74, 0x84, 0xc3, 0x30, 0xf6, 0x9e, 0x99, 0xa7, 0x45, 0x53,
+	0x27, 0x65, 0x32, 0x45, 0xbf, 0xb4, 0xca, 0x29, 0x75, 0x1f, 0x1a, 0x5e, 0x1c, 0x09, 0x16, 0x99,
+	0xf0, 0x62, 0x48, 0xfb, 0x13, 0x38, 0x57, 0xb4, 0xca, 0xb2, 0x43, 0xcd, 0x4d, 0xaf, 0x16, 0xa7,
+	0xbf, 0x0b, 0x5b, 0xc5, 0xe9, 0x39, 0x2f, 0x34, 0xb6, 0xac, 0xe4, 0x6d, 0x69, 0x7f, 0xb1, 0x7c,
+	0x06, 0x27, 0xff, 0x0b, 0x0d, 0x2e, 0x81, 0xc5, 0x3e, 0x4b, 0x49, 0x42, 0xc3, 0x67, 0xff, 0xa1,
+	0x02, 0x9d, 0xfd, 0x6f, 0x04, 0x4b, 0x22, 0x1a, 0xee, 0xa2, 0x9d, 0x16, 0x24, 0xbf, 0x08, 0x96,
+	0x62, 0xce, 0x0e, 0xb5, 0xa9, 0x80, 0x61, 0xe1, 0xbc, 0x6b, 0x85, 0xf3, 0xc6, 0xb3, 0x4d, 0x1f,
+	0x91, 0xda, 0x4c, 0x59, 0x80, 0xcf, 0x26, 0x13, 0x9a, 0x98, 0xc2, 0xcb, 0x90, 0x72, 0x07, 0x41,
+	0x13, 0xc1, 0xdd, 0x34, 0x39, 0x6d, 0x2a, 0xe0, 0x7e, 0x84, 0x3b, 0xb0, 0xc8, 0x97, 0x43, 0x2a,
+	0x37, 0xad, 0x23, 0x79, 0x3f, 0xb2, 0x0f, 0x60, 0xb3, 0x20, 0xf8, 0x49, 0x66, 0x43, 0x17, 0xc4,
+	0x0c, 0xd0, 0xb4, 0x46, 0xf0, 0x1b, 0x95, 0x15, 0xb1, 0x16, 0xbd, 0x2a, 0x62, 0xfb, 0xce, 0xd2,
+	0x45, 0x39, 0xb9, 0x9e, 0xfa, 0x54, 0x39, 0x0a, 0x17, 0xd8, 0x8d, 0xaf, 0xd9, 0x57, 0xe1, 0xfc,
+	0x5e, 0xa9, 0xe7, 0x6e, 0xba, 0x99, 0xb1, 0xcf, 0xd2, 0x6e, 0x66, 0xec, 0x33, 0xfb, 0xf7, 0x15,
+	0xe8, 0xdd, 0x7f, 0x11, 0xb1, 0x24, 0x7f, 0x9d, 0xaf, 0xc1, 0xb9, 0xf2, 0x5d, 0x55, 0x5b, 0x5b,
+	0x4e, 0xaf, 0x74, 0x59, 0xf9, 0x69, 0x14, 0x93, 0x1d, 0x09, 0x19, 0xd0, 0x99, 0x0a, 0xe3, 0x96,
+	0x93, 0xd2, 0xd9, 0x2f, 0x68, 0xeb, 0xcb, 0x7f, 0x41, 0xab, 0xe7, 0x7f, 0x41, 0xb3, 0x03, 0x68,
+	0xe7, 0xc5, 0xc5, 0x76, 0x8c, 0xb6, 0x85, 0x54, 0x6b, 0xd5, 0xfb, 0x60, 0x98, 0xce, 0x10, 0x86,
+	0x30, 0x8f, 0x2a, 0x59, 0x06, 0x7d, 0xbc, 0xfc, 0x5b, 0x5c, 0x96, 0x30, 0xe5, 0x99, 0x4f, 0xfa,
+	0x31, 0xee, 0xc6, 0xf7, 0x5b, 0xd0, 0xd5, 0xbc, 0x07, 0x2c, 0x39, 0xc2, 0xb6, 0xcd, 0x47, 0xd0,
+	0xd1, 0xc8, 0x9e, 0x0c, 0x0b, 0x64, 0xa9, 0x2a, 0x83, 0xa5, 0x28, 0xf9, 0x00, 0xc0, 0x74, 0xff,
+	0x99, 0x20, 0xa4, 0xfc, 0xdb, 0xc4, 0xd0, 0x5f, 0x31, 0x6f, 0x0f, 0x48, 0x36, 0x6f, 0x27, 0x0c,
+	0x77, 0xe7, 0x8f, 0x30, 0x02, 0xa7, 0xbc, 0xb9, 0x1f, 0x59, 0x07, 0x17, 0x0a, 0x68, 0xee, 0x17,
+	0xca, 0x4f, 0x60, 0xb3, 0xb4, 0xc8, 0xdd, 0x84, 0xae, 0x5c, 0x66, 0x23, 0x45, 0x75, 0xd7, 0xfe,
+	0x43, 0x68, 0xe9, 0xe9, 0xc8, 0x46, 0x7a, 0xe5, 0x59, 0xab, 0x37, 0xfe, 0x2c, 0x95, 0x1e, 0x07,
+	0x6e, 0xab, 0x9f, 0xe6, 0xce, 0xb2, 0x40, 0x66, 0xf3, 0x47, 0x32, 0xd6, 0x9e, 0xc9, 0xe6, 0xef,
+	0xa5, 0x93, 0xd5, 0xce, 0x4b, 0xcd, 0x9e, 0x69, 0xab, 0x7f, 0xa1, 0xff, 0x12, 0xb6, 0x0e, 0x18,
+	0x4d, 0xbc, 0x71, 0xb1, 0xf9, 0xcc, 0x49, 0xbf, 0xdc, 0x96, 0x36, 0x3f, 0x43, 0x0c, 0x56, 0x8d,
+	0x70, 0xf2, 0x31, 0xb4, 0x1f, 0x39, 0xbb, 0x69, 0xfb, 0x97, 0x64, 0xde, 0x98, 0x6f, 0x55, 0x0f,
+	0x96, 0xc2, 0x9c, 0xdc, 0x82, 0x73, 0x8f, 0x76, 0x76, 0xd3, 0xf6, 0xa7, 0x6a, 0x70, 0x9e, 0x4b,
+	0x79, 0x4d, 0xef, 0x77, 0xb0, 0x00, 0x71, 0xf2, 0x3e, 0x34, 0x1f, 0xdd, 0xdd, 0xfd, 0x5a, 0xf6,
+	0x34, 0x97, 0xdb, 0xec, 0x7c, 0xd6, 0x66, 0xca, 0xda, 0x9f, 0x37, 0xa0, 0xa3, 0x1b, 0x32, 0xda,
+	0xc7, 0x37, 0xf2, 0xcd, 0x28, 0xdc, 0xab, 0x57, 0xee, 0x4e, 0x91, 0x6b, 0x00, 0xfa, 0x13, 0x5d,
+	0x3b, 0xff, 0x8b, 0xce, 0x12, 0xe6, 0xeb, 0xe9, 0x06, 0x8e, 0x2c, 0xee, 0x4f, 0xe2, 0xbf, 0x95,
+	0xb6, 0x28, 0x1f, 0xb3, 0xc3, 0x31, 0x9e, 0xea, 0x56, 0x99, 0x47, 0xb6, 0x8e, 0x96, 0x4c, 0x7d,
+	0x0f, 0x1a, 0x3a, 0xca, 0x12, 0x52, 0xaa, 0x9a, 0x8a, 0x36, 0x2f, 0x34, 0x66, 0x6e, 0x42, 0x5d,
+	0xfd, 0x6c, 0x7d, 0x96, 0x49, 0xb8, 0xd5, 0x98, 0x79, 0xcf, 0x86, 0xd1, 0x19, 0xb7, 0xda, 0xf1,
+	0x3c, 0x36, 0x15, 0x67, 0xdc, 0xea, 0x36, 0xf3, 0xc2, 0x20, 0x62, 0x67, 0x99, 0xf5, 0x11, 0x40,
+	0xd6, 0x39, 0x20, 0xd9, 0xfb, 0x54, 0x68, 0x27, 0xac, 0x9a, 0xbc, 0x0f, 0x9d, 0x42, 0xc1, 0x4a,
+	0x5e, 0x2e, 0xf1, 0x65, 0x75, 0xf3, 0x60, 0xe5, 0x10, 0x27, 0x9f, 0x42, 0xdb, 0x14, 0x25, 0x5f,
+	0xc4, 0x41, 0x44, 0x56, 0xd4, 0x2a, 0x83, 0x15, 0x38, 0xd9, 0xcd, 0xe6, 0xcb, 0x38, 0xd4, 0x5f,
+	0xe0, 0x33, 0xe1, 0x64, 0xd5, 0x08, 0x46, 0xc2, 0xb4, 0x3a, 0x56, 0xa5, 0xe7, 0xe6, 0x02, 0x2b,
+	0x2e, 0xb0, 0x4a, 0x84, 0x8f, 0xa1, 0x6b, 0x00, 0x7d, 0x72, 0xcb, 0xe7, 0x2f, 0x8f, 0x47, 0x9f,
+	0x65, 0x05, 0x9c, 0x39, 0xc2, 0xb3, 0x6d, 0x7f, 0x0b, 0x36, 0xd2, 0x82, 0x41, 0xdf, 0xcf, 0x25,
+	0xa5, 0xc4, 0x60, 0x09, 0x46, 0x6e, 0xe5, 0x0a, 0x27, 0xbc, 0xa6, 0x5b, 0x8b, 0x3c, 0xb8, 0xf3,
+	0xb2, 0xa9, 0xfb, 0xd0, 0x29, 0x94, 0x35, 0xb9, 0xe3, 0x2f, 0xd7, 0x46, 0x83, 0x95, 0x43, 0x18,
+	0x0a, 0x73, 0xc2, 0xab, 0x1b, 0x76, 0x06, 0x21, 0x3e, 0x04, 0xc0, 0x8a, 0xe8, 0x47, 0xbc, 0xbc,
+	0xef, 0x43, 0x4b, 0xce, 0xd4, 0xa1, 0x20, 0x8b, 0x13, 0xba, 0xc2, 0x3a, 0x7e, 0x9a, 0xc3, 0x42,
+	0x46, 0x39, 0x3b, 0xf5, 0xb4, 0x27, 0x30, 0xc8, 0xbd, 0x78, 0xbb, 0xf3, 0x42, 0x49, 0x46, 0x5e,
+	0xcb, 0x12, 0xc3, 0x15, 0xa5, 0xda, 0xea, 0xa7, 0xf0, 0x2e, 0x6c, 0x16, 0xd3, 0x74, 0x6d, 0x8b,
+	0x55, 0x59, 0xfc, 0x60, 0xd5, 0x00, 0x79, 0x08, 0x64, 0xb1, 0x42, 0x20, 0x97, 0x57, 0xb0, 0x9b,
+	0xa3, 0x3d, 0x7e, 0x9c, 0x93, 0x61, 0x79, 0x55, 0xac, 0xc8, 0xc8, 0x60, 0xc5, 0xac, 0xa2, 0xaa,
+	0x25, 0x01, 0xf7, 0xca, 0xaa, 0xea, 0xf7, 0xfb, 0xb8, 0xc5, 0x16, 0xde, 0xf1, 0xaf, 0xe1, 0xdc,
+	0x42, 0xb2, 0x4e, 0x2e, 0x2d, 0xcf, 0xcc, 0x8d, 0x8e, 0xc7, 0x0e, 0x73, 0x72, 0x07, 0x7a, 0x59,
+	0x1e, 0xb5, 0x3b, 0x97, 0xff, 0x2b, 0xf3, 0x4a, 0x26, 0xd3, 0x62, 0x4a, 0xbf, 0xc2, 0x49, 0xbe,
+	0x84, 0xf3, 0x39, 0x27, 0xb9, 0x13, 0x27, 0x32, 0x35, 0xcd, 0xdd, 0xab, 0x72, 0xc6, 0x3f, 0x58,
+	0x39, 0xc4, 0x77, 0x7b, 0x7f, 0xf9, 0xe1, 0x72, 0xe5, 0xaf, 0x3f, 0x5c, 0xae, 0xfc, 0xe3, 0x87,
+	0xcb, 0x95, 0xdf, 0xfe, 0xf3, 0xf2, 0x7f, 0x1d, 0xd6, 0xe5, 0x7f, 0x1f, 0xde, 0xfc, 0xcf, 0x00,
+	0x89, 0x82, 0x43, 0x91, 0x9c, 0x28, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BookingServiceClient is the client API for BookingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BookingServiceClient interface {
+	BookingCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error)
+	BookingGet(ctx context.Context, in *BookingId, opts ...grpc.CallOption) (*GeneralBook, error)
+	BookingGetAllByUId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*ListBookingRes, error)
+	BookingGetAllByHraId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*UserId, error)
+	BookingList(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListBookingRes, error)
+	BookingListDeleted(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListBookingRes, error)
+	BookingUpdate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error)
+	BookingDelete(ctx context.Context, in *BookingId, opts ...grpc.CallOption) (*DelRes, error)
+	SearchAvailableHotels(ctx context.Context, in *AvailabilityReq, opts ...grpc.CallOption) (*AvailabilityRes, error)
+	URBFreeSlots(ctx context.Context, in *FreeSlotsReq, opts ...grpc.CallOption) (*FreeSlotsRes, error)
+	UABRemainingQuota(ctx context.Context, in *QuotaReq, opts ...grpc.CallOption) (*QuotaRes, error)
+	UHBQuote(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*PriceQuote, error)
+	PaymentCreate(ctx context.Context, in *PayReq, opts ...grpc.CallOption) (*Payment, error)
+	PaymentGet(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Payment, error)
+	PaymentRefund(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Payment, error)
+	PaymentWebhook(ctx context.Context, in *PaymentEvent, opts ...grpc.CallOption) (*Payment, error)
+	Confirm(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error)
+	Cancel(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error)
+	CheckIn(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error)
+	Accept(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error)
+	Decline(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error)
+	Reschedule(ctx context.Context, in *RescheduleReq, opts ...grpc.CallOption) (*StatusChange, error)
+	StatusHistory(ctx context.Context, in *StatusHistoryReq, opts ...grpc.CallOption) (*StatusHistoryRes, error)
+	WaitlistJoin(ctx context.Context, in *WaitlistEntry, opts ...grpc.CallOption) (*WaitlistEntry, error)
+	WaitlistList(ctx context.Context, in *WaitlistListReq, opts ...grpc.CallOption) (*WaitlistListRes, error)
+	WaitlistLeave(ctx context.Context, in *WaitlistReq, opts ...grpc.CallOption) (*WaitlistEntry, error)
+	WaitlistAccept(ctx context.Context, in *WaitlistReq, opts ...grpc.CallOption) (*GeneralBook, error)
+	WaitlistDecline(ctx context.Context, in *WaitlistReq, opts ...grpc.CallOption) (*WaitlistEntry, error)
+	ItineraryCreate(ctx context.Context, in *Itinerary, opts ...grpc.CallOption) (*Itinerary, error)
+	ItineraryGet(ctx context.Context, in *ItineraryReq, opts ...grpc.CallOption) (*Itinerary, error)
+	ItineraryList(ctx context.Context, in *ItineraryListReq, opts ...grpc.CallOption) (*ItineraryListRes, error)
+	ItineraryCancel(ctx context.Context, in *ItineraryReq, opts ...grpc.CallOption) (*Itinerary, error)
+	HoldCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error)
+	HoldConfirm(ctx context.Context, in *HoldReq, opts ...grpc.CallOption) (*GeneralBook, error)
+	HoldRelease(ctx context.Context, in *HoldReq, opts ...grpc.CallOption) (*GeneralBook, error)
+	BookingListByEstablishment(ctx context.Context, in *EstablishmentBookingsReq, opts ...grpc.CallOption) (*ListBookingRes, error)
+	CalendarSourceCreate(ctx context.Context, in *CalendarSource, opts ...grpc.CallOption) (*CalendarSource, error)
+	CalendarSourceList(ctx context.Context, in *CalendarSourceListReq, opts ...grpc.CallOption) (*CalendarSourceListRes, error)
+	CalendarSourceSync(ctx context.Context, in *CalendarSourceReq, opts ...grpc.CallOption) (*CalendarSource, error)
+	CalendarSourceDelete(ctx context.Context, in *CalendarSourceReq, opts ...grpc.CallOption) (*DelRes, error)
+	ExternalBlockList(ctx context.Context, in *ExternalBlockListReq, opts ...grpc.CallOption) (*ExternalBlockListRes, error)
+	BookingGetByCode(ctx context.Context, in *ConfirmationCodeReq, opts ...grpc.CallOption) (*GeneralBook, error)
+	BookingListForOwner(ctx context.Context, in *OwnerBookingsReq, opts ...grpc.CallOption) (*OwnerBookingsRes, error)
+}
+
+type bookingServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBookingServiceClient(cc *grpc.ClientConn) BookingServiceClient {
+	return &bookingServiceClient{cc}
+}
+
+func (c *bookingServiceClient) BookingCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error) {
+	out := new(GeneralBook)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingGet(ctx context.Context, in *BookingId, opts ...grpc.CallOption) (*GeneralBook, error) {
+	out := new(GeneralBook)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingGetAllByUId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*ListBookingRes, error) {
+	out := new(ListBookingRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingGetAllByUId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingGetAllByHraId(ctx context.Context, in *ListReqById, opts ...grpc.CallOption) (*UserId, error) {
+	out := new(UserId)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingGetAllByHraId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingList(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListBookingRes, error) {
+	out := new(ListBookingRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingListDeleted(ctx context.Context, in *ListReq, opts ...grpc.CallOption) (*ListBookingRes, error) {
+	out := new(ListBookingRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingUpdate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error) {
+	out := new(GeneralBook)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingDelete(ctx context.Context, in *BookingId, opts ...grpc.CallOption) (*DelRes, error) {
+	out := new(DelRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SearchAvailableHotels(ctx context.Context, in *AvailabilityReq, opts ...grpc.CallOption) (*AvailabilityRes, error) {
+	out := new(AvailabilityRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/SearchAvailableHotels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) URBFreeSlots(ctx context.Context, in *FreeSlotsReq, opts ...grpc.CallOption) (*FreeSlotsRes, error) {
+	out := new(FreeSlotsRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/URBFreeSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UABRemainingQuota(ctx context.Context, in *QuotaReq, opts ...grpc.CallOption) (*QuotaRes, error) {
+	out := new(QuotaRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/UABRemainingQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UHBQuote(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*PriceQuote, error) {
+	out := new(PriceQuote)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/UHBQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) PaymentCreate(ctx context.Context, in *PayReq, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/PaymentCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) PaymentGet(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/PaymentGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) PaymentRefund(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/PaymentRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) PaymentWebhook(ctx context.Context, in *PaymentEvent, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/PaymentWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) Confirm(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error) {
+	out := new(StatusChange)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/Confirm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) Cancel(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error) {
+	out := new(StatusChange)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/Cancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CheckIn(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error) {
+	out := new(StatusChange)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) Accept(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error) {
+	out := new(StatusChange)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/Accept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) Decline(ctx context.Context, in *StatusReq, opts ...grpc.CallOption) (*StatusChange, error) {
+	out := new(StatusChange)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/Decline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) Reschedule(ctx context.Context, in *RescheduleReq, opts ...grpc.CallOption) (*StatusChange, error) {
+	out := new(StatusChange)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/Reschedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) StatusHistory(ctx context.Context, in *StatusHistoryReq, opts ...grpc.CallOption) (*StatusHistoryRes, error) {
+	out := new(StatusHistoryRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/StatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) WaitlistJoin(ctx context.Context, in *WaitlistEntry, opts ...grpc.CallOption) (*WaitlistEntry, error) {
+	out := new(WaitlistEntry)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/WaitlistJoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) WaitlistList(ctx context.Context, in *WaitlistListReq, opts ...grpc.CallOption) (*WaitlistListRes, error) {
+	out := new(WaitlistListRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/WaitlistList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) WaitlistLeave(ctx context.Context, in *WaitlistReq, opts ...grpc.CallOption) (*WaitlistEntry, error) {
+	out := new(WaitlistEntry)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/WaitlistLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) WaitlistAccept(ctx context.Context, in *WaitlistReq, opts ...grpc.CallOption) (*GeneralBook, error) {
+	out := new(GeneralBook)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/WaitlistAccept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) WaitlistDecline(ctx context.Context, in *WaitlistReq, opts ...grpc.CallOption) (*WaitlistEntry, error) {
+	out := new(WaitlistEntry)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/WaitlistDecline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ItineraryCreate(ctx context.Context, in *Itinerary, opts ...grpc.CallOption) (*Itinerary, error) {
+	out := new(Itinerary)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/ItineraryCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ItineraryGet(ctx context.Context, in *ItineraryReq, opts ...grpc.CallOption) (*Itinerary, error) {
+	out := new(Itinerary)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/ItineraryGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ItineraryList(ctx context.Context, in *ItineraryListReq, opts ...grpc.CallOption) (*ItineraryListRes, error) {
+	out := new(ItineraryListRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/ItineraryList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ItineraryCancel(ctx context.Context, in *ItineraryReq, opts ...grpc.CallOption) (*Itinerary, error) {
+	out := new(Itinerary)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/ItineraryCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) HoldCreate(ctx context.Context, in *GeneralBook, opts ...grpc.CallOption) (*GeneralBook, error) {
+	out := new(GeneralBook)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/HoldCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) HoldConfirm(ctx context.Context, in *HoldReq, opts ...grpc.CallOption) (*GeneralBook, error) {
+	out := new(GeneralBook)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/HoldConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) HoldRelease(ctx context.Context, in *HoldReq, opts ...grpc.CallOption) (*GeneralBook, error) {
+	out := new(GeneralBook)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/HoldRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingListByEstablishment(ctx context.Context, in *EstablishmentBookingsReq, opts ...grpc.CallOption) (*ListBookingRes, error) {
+	out := new(ListBookingRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingListByEstablishment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CalendarSourceCreate(ctx context.Context, in *CalendarSource, opts ...grpc.CallOption) (*CalendarSource, error) {
+	out := new(CalendarSource)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/CalendarSourceCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CalendarSourceList(ctx context.Context, in *CalendarSourceListReq, opts ...grpc.CallOption) (*CalendarSourceListRes, error) {
+	out := new(CalendarSourceListRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/CalendarSourceList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CalendarSourceSync(ctx context.Context, in *CalendarSourceReq, opts ...grpc.CallOption) (*CalendarSource, error) {
+	out := new(CalendarSource)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/CalendarSourceSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CalendarSourceDelete(ctx context.Context, in *CalendarSourceReq, opts ...grpc.CallOption) (*DelRes, error) {
+	out := new(DelRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/CalendarSourceDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ExternalBlockList(ctx context.Context, in *ExternalBlockListReq, opts ...grpc.CallOption) (*ExternalBlockListRes, error) {
+	out := new(ExternalBlockListRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/ExternalBlockList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingGetByCode(ctx context.Context, in *ConfirmationCodeReq, opts ...grpc.CallOption) (*GeneralBook, error) {
+	out := new(GeneralBook)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingGetByCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) BookingListForOwner(ctx context.Context, in *OwnerBookingsReq, opts ...grpc.CallOption) (*OwnerBookingsRes, error) {
+	out := new(OwnerBookingsRes)
+	err := c.cc.Invoke(ctx, "/booking.BookingService/BookingListForOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingServiceServer is the server API for BookingService service.
+type BookingServiceServer interface {
+	BookingCreate(context.Context, *GeneralBook) (*GeneralBook, error)
+	BookingGet(context.Context, *BookingId) (*GeneralBook, error)
+	BookingGetAllByUId(context.Context, *ListReqById) (*ListBookingRes, error)
+	BookingGetAllByHraId(context.Context, *ListReqById) (*UserId, error)
+	BookingList(context.Context, *ListReq) (*ListBookingRes, error)
+	BookingListDeleted(context.Context, *ListReq) (*ListBookingRes, error)
+	BookingUpdate(context.Context, *GeneralBook) (*GeneralBook, error)
+	BookingDelete(context.Context, *BookingId) (*DelRes, error)
+	SearchAvailableHotels(context.Context, *AvailabilityReq) (*AvailabilityRes, error)
+	URBFreeSlots(context.Context, *FreeSlotsReq) (*FreeSlotsRes, error)
+	UABRemainingQuota(context.Context, *QuotaReq) (*QuotaRes, error)
+	UHBQuote(context.Context, *GeneralBook) (*PriceQuote, error)
+	PaymentCreate(context.Context, *PayReq) (*Payment, error)
+	PaymentGet(context.Context, *Id) (*Payment, error)
+	PaymentRefund(context.Context, *Id) (*Payment, error)
+	PaymentWebhook(context.Context, *PaymentEvent) (*Payment, error)
+	Confirm(context.Context, *StatusReq) (*StatusChange, error)
+	Cancel(context.Context, *StatusReq) (*StatusChange, error)
+	CheckIn(context.Context, *StatusReq) (*StatusChange, error)
+	Accept(context.Context, *StatusReq) (*StatusChange, error)
+	Decline(context.Context, *StatusReq) (*StatusChange, error)
+	Reschedule(context.Context, *RescheduleReq) (*StatusChange, error)
+	StatusHistory(context.Context, *StatusHistoryReq) (*StatusHistoryRes, error)
+	WaitlistJoin(context.Context, *WaitlistEntry) (*WaitlistEntry, error)
+	WaitlistList(context.Context, *WaitlistListReq) (*WaitlistListRes, error)
+	WaitlistLeave(context.Context, *WaitlistReq) (*WaitlistEntry, error)
+	WaitlistAccept(context.Context, *WaitlistReq) (*GeneralBook, error)
+	WaitlistDecline(context.Context, *WaitlistReq) (*WaitlistEntry, error)
+	ItineraryCreate(context.Context, *Itinerary) (*Itinerary, error)
+	ItineraryGet(context.Context, *ItineraryReq) (*Itinerary, error)
+	ItineraryList(context.Context, *ItineraryListReq) (*ItineraryListRes, error)
+	ItineraryCancel(context.Context, *ItineraryReq) (*Itinerary, error)
+	HoldCreate(context.Context, *GeneralBook) (*GeneralBook, error)
+	HoldConfirm(context.Context, *HoldReq) (*GeneralBook, error)
+	HoldRelease(context.Context, *HoldReq) (*GeneralBook, error)
+	BookingListByEstablishment(context.Context, *EstablishmentBookingsReq) (*ListBookingRes, error)
+	CalendarSourceCreate(context.Context, *CalendarSource) (*CalendarSource, error)
+	CalendarSourceList(context.Context, *CalendarSourceListReq) (*CalendarSourceListRes, error)
+	CalendarSourceSync(context.Context, *CalendarSourceReq) (*CalendarSource, error)
+	CalendarSourceDelete(context.Context, *CalendarSourceReq) (*DelRes, error)
+	ExternalBlockList(context.Context, *ExternalBlockListReq) (*ExternalBlockListRes, error)
+	BookingGetByCode(context.Context, *ConfirmationCodeReq) (*GeneralBook, error)
+	BookingListForOwner(context.Context, *OwnerBookingsReq) (*OwnerBookingsRes, error)
+}
+
+// UnimplementedBookingServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBookingServiceServer struct {
+}
+
+func (*UnimplementedBookingServiceServer) BookingCreate(ctx context.Context, req *GeneralBook) (*GeneralBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingCreate not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingGet(ctx context.Context, req *BookingId) (*GeneralBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingGet not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingGetAllByUId(ctx context.Context, req *ListReqById) (*ListBookingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingGetAllByUId not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingGetAllByHraId(ctx context.Context, req *ListReqById) (*UserId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingGetAllByHraId not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingList(ctx context.Context, req *ListReq) (*ListBookingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingList not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingListDeleted(ctx context.Context, req *ListReq) (*ListBookingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingListDeleted not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingUpdate(ctx context.Context, req *GeneralBook) (*GeneralBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingUpdate not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingDelete(ctx context.Context, req *BookingId) (*DelRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingDelete not implemented")
+}
+func (*UnimplementedBookingServiceServer) SearchAvailableHotels(ctx context.Context, req *AvailabilityReq) (*AvailabilityRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAvailableHotels not implemented")
+}
+func (*UnimplementedBookingServiceServer) URBFreeSlots(ctx context.Context, req *FreeSlotsReq) (*FreeSlotsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method URBFreeSlots not implemented")
+}
+func (*UnimplementedBookingServiceServer) UABRemainingQuota(ctx context.Context, req *QuotaReq) (*QuotaRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UABRemainingQuota not implemented")
+}
+func (*UnimplementedBookingServiceServer) UHBQuote(ctx context.Context, req *GeneralBook) (*PriceQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UHBQuote not implemented")
+}
+func (*UnimplementedBookingServiceServer) PaymentCreate(ctx context.Context, req *PayReq) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentCreate not implemented")
+}
+func (*UnimplementedBookingServiceServer) PaymentGet(ctx context.Context, req *Id) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentGet not implemented")
+}
+func (*UnimplementedBookingServiceServer) PaymentRefund(ctx context.Context, req *Id) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentRefund not implemented")
+}
+func (*UnimplementedBookingServiceServer) PaymentWebhook(ctx context.Context, req *PaymentEvent) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentWebhook not implemented")
+}
+func (*UnimplementedBookingServiceServer) Confirm(ctx context.Context, req *StatusReq) (*StatusChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Confirm not implemented")
+}
+func (*UnimplementedBookingServiceServer) Cancel(ctx context.Context, req *StatusReq) (*StatusChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (*UnimplementedBookingServiceServer) CheckIn(ctx context.Context, req *StatusReq) (*StatusChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (*UnimplementedBookingServiceServer) Accept(ctx context.Context, req *StatusReq) (*StatusChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accept not implemented")
+}
+func (*UnimplementedBookingServiceServer) Decline(ctx context.Context, req *StatusReq) (*StatusChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decline not implemented")
+}
+func (*UnimplementedBookingServiceServer) Reschedule(ctx context.Context, req *RescheduleReq) (*StatusChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reschedule not implemented")
+}
+func (*UnimplementedBookingServiceServer) StatusHistory(ctx context.Context, req *StatusHistoryReq) (*StatusHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatusHistory not implemented")
+}
+func (*UnimplementedBookingServiceServer) WaitlistJoin(ctx context.Context, req *WaitlistEntry) (*WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitlistJoin not implemented")
+}
+func (*UnimplementedBookingServiceServer) WaitlistList(ctx context.Context, req *WaitlistListReq) (*WaitlistListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitlistList not implemented")
+}
+func (*UnimplementedBookingServiceServer) WaitlistLeave(ctx context.Context, req *WaitlistReq) (*WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitlistLeave not implemented")
+}
+func (*UnimplementedBookingServiceServer) WaitlistAccept(ctx context.Context, req *WaitlistReq) (*GeneralBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitlistAccept not implemented")
+}
+func (*UnimplementedBookingServiceServer) WaitlistDecline(ctx context.Context, req *WaitlistReq) (*WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitlistDecline not implemented")
+}
+func (*UnimplementedBookingServiceServer) ItineraryCreate(ctx context.Context, req *Itinerary) (*Itinerary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItineraryCreate not implemented")
+}
+func (*UnimplementedBookingServiceServer) ItineraryGet(ctx context.Context, req *ItineraryReq) (*Itinerary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItineraryGet not implemented")
+}
+func (*UnimplementedBookingServiceServer) ItineraryList(ctx context.Context, req *ItineraryListReq) (*ItineraryListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItineraryList not implemented")
+}
+func (*UnimplementedBookingServiceServer) ItineraryCancel(ctx context.Context, req *ItineraryReq) (*Itinerary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItineraryCancel not implemented")
+}
+func (*UnimplementedBookingServiceServer) HoldCreate(ctx context.Context, req *GeneralBook) (*GeneralBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldCreate not implemented")
+}
+func (*UnimplementedBookingServiceServer) HoldConfirm(ctx context.Context, req *HoldReq) (*GeneralBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldConfirm not implemented")
+}
+func (*UnimplementedBookingServiceServer) HoldRelease(ctx context.Context, req *HoldReq) (*GeneralBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldRelease not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingListByEstablishment(ctx context.Context, req *EstablishmentBookingsReq) (*ListBookingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingListByEstablishment not implemented")
+}
+func (*UnimplementedBookingServiceServer) CalendarSourceCreate(ctx context.Context, req *CalendarSource) (*CalendarSource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarSourceCreate not implemented")
+}
+func (*UnimplementedBookingServiceServer) CalendarSourceList(ctx context.Context, req *CalendarSourceListReq) (*CalendarSourceListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarSourceList not implemented")
+}
+func (*UnimplementedBookingServiceServer) CalendarSourceSync(ctx context.Context, req *CalendarSourceReq) (*CalendarSource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarSourceSync not implemented")
+}
+func (*UnimplementedBookingServiceServer) CalendarSourceDelete(ctx context.Context, req *CalendarSourceReq) (*DelRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarSourceDelete not implemented")
+}
+func (*UnimplementedBookingServiceServer) ExternalBlockList(ctx context.Context, req *ExternalBlockListReq) (*ExternalBlockListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalBlockList not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingGetByCode(ctx context.Context, req *ConfirmationCodeReq) (*GeneralBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingGetByCode not implemented")
+}
+func (*UnimplementedBookingServiceServer) BookingListForOwner(ctx context.Context, req *OwnerBookingsReq) (*OwnerBookingsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookingListForOwner not implemented")
+}
+
+func RegisterBookingServiceServer(s *grpc.Server, srv BookingServiceServer) {
+	s.RegisterService(&_BookingService_serviceDesc, srv)
+}
+
+func _BookingService_BookingCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingCreate(ctx, req.(*GeneralBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingGet(ctx, req.(*BookingId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingGetAllByUId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReqById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingGetAllByUId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingGetAllByUId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingGetAllByUId(ctx, req.(*ListReqById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingGetAllByHraId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReqById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingGetAllByHraId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingGetAllByHraId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingGetAllByHraId(ctx, req.(*ListReqById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingList(ctx, req.(*ListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingListDeleted(ctx, req.(*ListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingUpdate(ctx, req.(*GeneralBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingDelete(ctx, req.(*BookingId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SearchAvailableHotels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvailabilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SearchAvailableHotels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/SearchAvailableHotels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SearchAvailableHotels(ctx, req.(*AvailabilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_URBFreeSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreeSlotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).URBFreeSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/URBFreeSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).URBFreeSlots(ctx, req.(*FreeSlotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UABRemainingQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UABRemainingQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/UABRemainingQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UABRemainingQuota(ctx, req.(*QuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UHBQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UHBQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/UHBQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UHBQuote(ctx, req.(*GeneralBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_PaymentCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).PaymentCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/PaymentCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).PaymentCreate(ctx, req.(*PayReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_PaymentGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).PaymentGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/PaymentGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).PaymentGet(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_PaymentRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).PaymentRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/PaymentRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).PaymentRefund(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_PaymentWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).PaymentWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/PaymentWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).PaymentWebhook(ctx, req.(*PaymentEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_Confirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).Confirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/Confirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).Confirm(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).Cancel(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/CheckIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CheckIn(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_Accept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).Accept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/Accept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).Accept(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_Decline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).Decline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/Decline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).Decline(ctx, req.(*StatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_Reschedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).Reschedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/Reschedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).Reschedule(ctx, req.(*RescheduleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_StatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).StatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/StatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).StatusHistory(ctx, req.(*StatusHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_WaitlistJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).WaitlistJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/WaitlistJoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).WaitlistJoin(ctx, req.(*WaitlistEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_WaitlistList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).WaitlistList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/WaitlistList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).WaitlistList(ctx, req.(*WaitlistListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_WaitlistLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).WaitlistLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/WaitlistLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).WaitlistLeave(ctx, req.(*WaitlistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_WaitlistAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).WaitlistAccept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/WaitlistAccept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).WaitlistAccept(ctx, req.(*WaitlistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_WaitlistDecline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).WaitlistDecline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/WaitlistDecline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).WaitlistDecline(ctx, req.(*WaitlistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ItineraryCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Itinerary)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ItineraryCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/ItineraryCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ItineraryCreate(ctx, req.(*Itinerary))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ItineraryGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItineraryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ItineraryGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/ItineraryGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ItineraryGet(ctx, req.(*ItineraryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ItineraryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItineraryListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ItineraryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/ItineraryList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ItineraryList(ctx, req.(*ItineraryListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ItineraryCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItineraryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ItineraryCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/ItineraryCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ItineraryCancel(ctx, req.(*ItineraryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_HoldCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneralBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).HoldCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/HoldCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).HoldCreate(ctx, req.(*GeneralBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_HoldConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).HoldConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/HoldConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).HoldConfirm(ctx, req.(*HoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_HoldRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).HoldRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/HoldRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).HoldRelease(ctx, req.(*HoldReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingListByEstablishment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstablishmentBookingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingListByEstablishment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingListByEstablishment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingListByEstablishment(ctx, req.(*EstablishmentBookingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CalendarSourceCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CalendarSourceCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/CalendarSourceCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CalendarSourceCreate(ctx, req.(*CalendarSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CalendarSourceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarSourceListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CalendarSourceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/CalendarSourceList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CalendarSourceList(ctx, req.(*CalendarSourceListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CalendarSourceSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CalendarSourceSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/CalendarSourceSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CalendarSourceSync(ctx, req.(*CalendarSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CalendarSourceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CalendarSourceDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/CalendarSourceDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CalendarSourceDelete(ctx, req.(*CalendarSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ExternalBlockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalBlockListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExternalBlockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/ExternalBlockList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExternalBlockList(ctx, req.(*ExternalBlockListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingGetByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmationCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingGetByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingGetByCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingGetByCode(ctx, req.(*ConfirmationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_BookingListForOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnerBookingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookingListForOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/booking.BookingService/BookingListForOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookingListForOwner(ctx, req.(*OwnerBookingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BookingService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "booking.BookingService",
+	HandlerType: (*BookingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BookingCreate",
+			Handler:    _BookingService_BookingCreate_Handler,
+		},
+		{
+			MethodName: "BookingGet",
+			Handler:    _BookingService_BookingGet_Handler,
+		},
+		{
+			MethodName: "BookingGetAllByUId",
+			Handler:    _BookingService_BookingGetAllByUId_Handler,
+		},
+		{
+			MethodName: "BookingGetAllByHraId",
+			Handler:    _BookingService_BookingGetAllByHraId_Handler,
+		},
+		{
+			MethodName: "BookingList",
+			Handler:    _BookingService_BookingList_Handler,
+		},
+		{
+			MethodName: "BookingListDeleted",
+			Handler:    _BookingService_BookingListDeleted_Handler,
+		},
+		{
+			MethodName: "BookingUpdate",
+			Handler:    _BookingService_BookingUpdate_Handler,
+		},
+		{
+			MethodName: "BookingDelete",
+			Handler:    _BookingService_BookingDelete_Handler,
+		},
+		{
+			MethodName: "SearchAvailableHotels",
+			Handler:    _BookingService_SearchAvailableHotels_Handler,
+		},
+		{
+			MethodName: "URBFreeSlots",
+			Handler:    _BookingService_URBFreeSlots_Handler,
+		},
+		{
+			MethodName: "UABRemainingQuota",
+			Handler:    _BookingService_UABRemainingQuota_Handler,
+		},
+		{
+			MethodName: "UHBQuote",
+			Handler:    _BookingService_UHBQuote_Handler,
+		},
+		{
+			MethodName: "PaymentCreate",
+			Handler:    _BookingService_PaymentCreate_Handler,
+		},
+		{
+			MethodName: "PaymentGet",
+			Handler:    _BookingService_PaymentGet_Handler,
+		},
+		{
+			MethodName: "PaymentRefund",
+			Handler:    _BookingService_PaymentRefund_Handler,
+		},
+		{
+			MethodName: "PaymentWebhook",
+			Handler:    _BookingService_PaymentWebhook_Handler,
+		},
+		{
+			MethodName: "Confirm",
+			Handler:    _BookingService_Confirm_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _BookingService_Cancel_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _BookingService_CheckIn_Handler,
+		},
+		{
+			MethodName: "Accept",
+			Handler:    _BookingService_Accept_Handler,
+		},
+		{
+			MethodName: "Decline",
+			Handler:    _BookingService_Decline_Handler,
+		},
+		{
+			MethodName: "Reschedule",
+			Handler:    _BookingService_Reschedule_Handler,
+		},
+		{
+			MethodName: "StatusHistory",
+			Handler:    _BookingService_StatusHistory_Handler,
+		},
+		{
+			MethodName: "WaitlistJoin",
+			Handler:    _BookingService_WaitlistJoin_Handler,
+		},
+		{
+			MethodName: "WaitlistList",
+			Handler:    _BookingService_WaitlistList_Handler,
+		},
+		{
+			MethodName: "WaitlistLeave",
+			Handler:    _BookingService_WaitlistLeave_Handler,
+		},
+		{
+			MethodName: "WaitlistAccept",
+			Handler:    _BookingService_WaitlistAccept_Handler,
+		},
+		{
+			MethodName: "WaitlistDecline",
+			Handler:    _BookingService_WaitlistDecline_Handler,
+		},
+		{
+			MethodName: "ItineraryCreate",
+			Handler:    _BookingService_ItineraryCreate_Handler,
+		},
+		{
+			MethodName: "ItineraryGet",
+			Handler:    _BookingService_ItineraryGet_Handler,
+		},
+		{
+			MethodName: "ItineraryList",
+			Handler:    _BookingService_ItineraryList_Handler,
+		},
+		{
+			MethodName: "ItineraryCancel",
+			Handler:    _BookingService_ItineraryCancel_Handler,
+		},
+		{
+			MethodName: "HoldCreate",
+			Handler:    _BookingService_HoldCreate_Handler,
+		},
+		{
+			MethodName: "HoldConfirm",
+			Handler:    _BookingService_HoldConfirm_Handler,
+		},
+		{
+			MethodName: "HoldRelease",
+			Handler:    _BookingService_HoldRelease_Handler,
+		},
+		{
+			MethodName: "BookingListByEstablishment",
+			Handler:    _BookingService_BookingListByEstablishment_Handler,
+		},
+		{
+			MethodName: "CalendarSourceCreate",
+			Handler:    _BookingService_CalendarSourceCreate_Handler,
+		},
+		{
+			MethodName: "CalendarSourceList",
+			Handler:    _BookingService_CalendarSourceList_Handler,
+		},
+		{
+			MethodName: "CalendarSourceSync",
+			Handler:    _BookingService_CalendarSourceSync_Handler,
+		},
+		{
+			MethodName: "CalendarSourceDelete",
+			Handler:    _BookingService_CalendarSourceDelete_Handler,
+		},
+		{
+			MethodName: "ExternalBlockList",
+			Handler:    _BookingService_ExternalBlockList_Handler,
+		},
+		{
+			MethodName: "BookingGetByCode",
+			Handler:    _BookingService_BookingGetByCode_Handler,
+		},
+		{
+			MethodName: "BookingListForOwner",
+			Handler:    _BookingService_BookingListForOwner_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking-proto/booking.proto",
+}
+
+func (m *DelRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Id) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Id) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Id) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Filter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Filter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Filter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Filter) > 0 {
+		for k := range m.Filter {
+			v := m.Filter[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintBooking(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintBooking(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintBooking(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BookingId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BookingId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BookingId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListReqById) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReqById) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListReqById) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBooking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Offset != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Offset != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListBookingRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBookingRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBookingRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bookings) > 0 {
+		for iNdEx := len(m.Bookings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bookings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GeneralBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneralBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneralBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SpecialRequestsSet {
+		i--
+		if m.SpecialRequestsSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.AddOnsSet {
+		i--
+		if m.AddOnsSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.GuestsSet {
+		i--
+		if m.GuestsSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.AddOns) > 0 {
+		for iNdEx := len(m.AddOns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddOns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.SpecialRequestNote) > 0 {
+		i -= len(m.SpecialRequestNote)
+		copy(dAtA[i:], m.SpecialRequestNote)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.SpecialRequestNote)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.SpecialRequests) > 0 {
+		for iNdEx := len(m.SpecialRequests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpecialRequests[iNdEx])
+			copy(dAtA[i:], m.SpecialRequests[iNdEx])
+			i = encodeVarintBooking(dAtA, i, uint64(len(m.SpecialRequests[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.Guests) > 0 {
+		for iNdEx := len(m.Guests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Guests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.ApprovalDueAt) > 0 {
+		i -= len(m.ApprovalDueAt)
+		copy(dAtA[i:], m.ApprovalDueAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ApprovalDueAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.ConfirmationCode) > 0 {
+		i -= len(m.ConfirmationCode)
+		copy(dAtA[i:], m.ConfirmationCode)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ConfirmationCode)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.HoldExpiresAt) > 0 {
+		i -= len(m.HoldExpiresAt)
+		copy(dAtA[i:], m.HoldExpiresAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.HoldExpiresAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ItineraryId) > 0 {
+		i -= len(m.ItineraryId)
+		copy(dAtA[i:], m.ItineraryId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ItineraryId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.TotalPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TotalPrice))))
+		i--
+		dAtA[i] = 0x71
+	}
+	if m.ChildTickets != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.ChildTickets))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.AdultTickets != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.AdultTickets))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.IsCanceled {
+		i--
+		if m.IsCanceled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumberOfPeople != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.NumberOfPeople))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.WillLeave) > 0 {
+		i -= len(m.WillLeave)
+		copy(dAtA[i:], m.WillLeave)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillLeave)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WillArrive) > 0 {
+		i -= len(m.WillArrive)
+		copy(dAtA[i:], m.WillArrive)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillArrive)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HraId) > 0 {
+		i -= len(m.HraId)
+		copy(dAtA[i:], m.HraId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.HraId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BookingGuest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BookingGuest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BookingGuest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentNumber) > 0 {
+		i -= len(m.DocumentNumber)
+		copy(dAtA[i:], m.DocumentNumber)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.DocumentNumber)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Age != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Age))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FullName) > 0 {
+		i -= len(m.FullName)
+		copy(dAtA[i:], m.FullName)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.FullName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BookingAddOn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BookingAddOn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BookingAddOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Total != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Total))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.UnitPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UnitPrice))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AddOnId) > 0 {
+		i -= len(m.AddOnId)
+		copy(dAtA[i:], m.AddOnId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.AddOnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UserId) > 0 {
+		for iNdEx := len(m.UserId) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserId[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AvailabilityReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvailabilityReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvailabilityReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PartySize != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.PartySize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.WillLeave) > 0 {
+		i -= len(m.WillLeave)
+		copy(dAtA[i:], m.WillLeave)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillLeave)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WillArrive) > 0 {
+		i -= len(m.WillArrive)
+		copy(dAtA[i:], m.WillArrive)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillArrive)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AvailableHotel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvailableHotel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvailableHotel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FreeRooms != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.FreeRooms))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NightlyPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NightlyPrice))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.RoomCapacity != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.RoomCapacity))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RoomDescription) > 0 {
+		i -= len(m.RoomDescription)
+		copy(dAtA[i:], m.RoomDescription)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.RoomDescription)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if len(m.HotelName) > 0 {
+		i -= len(m.HotelName)
+		copy(dAtA[i:], m.HotelName)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.HotelName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HotelId) > 0 {
+		i -= len(m.HotelId)
+		copy(dAtA[i:], m.HotelId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.HotelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AvailabilityRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AvailabilityRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AvailabilityRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hotels) > 0 {
+		for iNdEx := len(m.Hotels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hotels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FreeSlotsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreeSlotsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreeSlotsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RestaurantId) > 0 {
+		i -= len(m.RestaurantId)
+		copy(dAtA[i:], m.RestaurantId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.RestaurantId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Slot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FreeSeats != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.FreeSeats))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FreeSlotsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreeSlotsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreeSlotsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SeatCapacity != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.SeatCapacity))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Slots) > 0 {
+		for iNdEx := len(m.Slots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttractionId) > 0 {
+		i -= len(m.AttractionId)
+		copy(dAtA[i:], m.AttractionId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.AttractionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntryWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntryWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntryWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.End) > 0 {
+		i -= len(m.End)
+		copy(dAtA[i:], m.End)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.End)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.WindowQuota != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.WindowQuota))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DailyRemaining != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.DailyRemaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DailyQuota != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.DailyQuota))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Total != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Total))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.Discount != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Discount))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.Surcharge != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Surcharge))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Subtotal != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Subtotal))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.NightlyPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NightlyPrice))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.HolidayNights != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.HolidayNights))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nights != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Nights))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttemptId) > 0 {
+		i -= len(m.AttemptId)
+		copy(dAtA[i:], m.AttemptId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.AttemptId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RefundedAmount != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RefundedAmount))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Attempts) > 0 {
+		for iNdEx := len(m.Attempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProviderRef) > 0 {
+		i -= len(m.ProviderRef)
+		copy(dAtA[i:], m.ProviderRef)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ProviderRef)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Amount != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Amount))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BookingId) > 0 {
+		i -= len(m.BookingId)
+		copy(dAtA[i:], m.BookingId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PaymentId) > 0 {
+		i -= len(m.PaymentId)
+		copy(dAtA[i:], m.PaymentId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.PaymentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CardToken) > 0 {
+		i -= len(m.CardToken)
+		copy(dAtA[i:], m.CardToken)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.CardToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BookingId) > 0 {
+		i -= len(m.BookingId)
+		copy(dAtA[i:], m.BookingId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ProviderRef) > 0 {
+		i -= len(m.ProviderRef)
+		copy(dAtA[i:], m.ProviderRef)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ProviderRef)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChangedBy) > 0 {
+		i -= len(m.ChangedBy)
+		copy(dAtA[i:], m.ChangedBy)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ChangedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BookingId) > 0 {
+		i -= len(m.BookingId)
+		copy(dAtA[i:], m.BookingId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangeFee != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ChangeFee))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x89
+	}
+	if m.PriceDifference != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriceDifference))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x81
+	}
+	if len(m.ToWillLeave) > 0 {
+		i -= len(m.ToWillLeave)
+		copy(dAtA[i:], m.ToWillLeave)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ToWillLeave)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ToWillArrive) > 0 {
+		i -= len(m.ToWillArrive)
+		copy(dAtA[i:], m.ToWillArrive)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ToWillArrive)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.FromWillLeave) > 0 {
+		i -= len(m.FromWillLeave)
+		copy(dAtA[i:], m.FromWillLeave)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.FromWillLeave)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.FromWillArrive) > 0 {
+		i -= len(m.FromWillArrive)
+		copy(dAtA[i:], m.FromWillArrive)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.FromWillArrive)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RefundAmount != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RefundAmount))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.RefundPercent != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RefundPercent))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ChangedBy) > 0 {
+		i -= len(m.ChangedBy)
+		copy(dAtA[i:], m.ChangedBy)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ChangedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToStatus) > 0 {
+		i -= len(m.ToStatus)
+		copy(dAtA[i:], m.ToStatus)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ToStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FromStatus) > 0 {
+		i -= len(m.FromStatus)
+		copy(dAtA[i:], m.FromStatus)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.FromStatus)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BookingId) > 0 {
+		i -= len(m.BookingId)
+		copy(dAtA[i:], m.BookingId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RescheduleReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RescheduleReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RescheduleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ChangedBy) > 0 {
+		i -= len(m.ChangedBy)
+		copy(dAtA[i:], m.ChangedBy)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ChangedBy)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ChildTickets != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.ChildTickets))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AdultTickets != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.AdultTickets))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumberOfPeople != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.NumberOfPeople))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WillLeave) > 0 {
+		i -= len(m.WillLeave)
+		copy(dAtA[i:], m.WillLeave)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillLeave)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WillArrive) > 0 {
+		i -= len(m.WillArrive)
+		copy(dAtA[i:], m.WillArrive)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillArrive)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BookingId) > 0 {
+		i -= len(m.BookingId)
+		copy(dAtA[i:], m.BookingId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusHistoryReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusHistoryReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusHistoryReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BookingId) > 0 {
+		i -= len(m.BookingId)
+		copy(dAtA[i:], m.BookingId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusHistoryRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusHistoryRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusHistoryRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WaitlistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitlistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitlistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.BookingId) > 0 {
+		i -= len(m.BookingId)
+		copy(dAtA[i:], m.BookingId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.OfferExpiresAt) > 0 {
+		i -= len(m.OfferExpiresAt)
+		copy(dAtA[i:], m.OfferExpiresAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.OfferExpiresAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.NumberOfPeople != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.NumberOfPeople))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.WillLeave) > 0 {
+		i -= len(m.WillLeave)
+		copy(dAtA[i:], m.WillLeave)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillLeave)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WillArrive) > 0 {
+		i -= len(m.WillArrive)
+		copy(dAtA[i:], m.WillArrive)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.WillArrive)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HraId) > 0 {
+		i -= len(m.HraId)
+		copy(dAtA[i:], m.HraId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.HraId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WaitlistReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitlistReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitlistReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WaitlistListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitlistListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitlistListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WaitlistListRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitlistListRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitlistListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Itinerary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Itinerary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Itinerary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Bookings) > 0 {
+		for iNdEx := len(m.Bookings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bookings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ItineraryReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItineraryReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItineraryReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ChangedBy) > 0 {
+		i -= len(m.ChangedBy)
+		copy(dAtA[i:], m.ChangedBy)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.ChangedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ItineraryListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItineraryListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItineraryListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ItineraryListRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItineraryListRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItineraryListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Itineraries) > 0 {
+		for iNdEx := len(m.Itineraries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Itineraries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HoldReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HoldReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HoldReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstablishmentBookingsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstablishmentBookingsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstablishmentBookingsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Since) > 0 {
+		i -= len(m.Since)
+		copy(dAtA[i:], m.Since)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Since)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BookingType) > 0 {
+		i -= len(m.BookingType)
+		copy(dAtA[i:], m.BookingType)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.BookingType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CalendarSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalendarSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalendarSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastSyncedAt) > 0 {
+		i -= len(m.LastSyncedAt)
+		copy(dAtA[i:], m.LastSyncedAt)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.LastSyncedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CalendarSourceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalendarSourceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalendarSourceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CalendarSourceListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalendarSourceListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalendarSourceListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CalendarSourceListRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalendarSourceListRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalendarSourceListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EndsOn) > 0 {
+		i -= len(m.EndsOn)
+		copy(dAtA[i:], m.EndsOn)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.EndsOn)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StartsOn) > 0 {
+		i -= len(m.StartsOn)
+		copy(dAtA[i:], m.StartsOn)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.StartsOn)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceId) > 0 {
+		i -= len(m.SourceId)
+		copy(dAtA[i:], m.SourceId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.SourceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalBlockListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalBlockListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalBlockListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalBlockListRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalBlockListRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalBlockListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmationCodeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmationCodeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmationCodeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerBookingsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerBookingsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerBookingsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Limit != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Statuses[iNdEx])
+			copy(dAtA[i:], m.Statuses[iNdEx])
+			i = encodeVarintBooking(dAtA, i, uint64(len(m.Statuses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EstablishmentIds) > 0 {
+		for iNdEx := len(m.EstablishmentIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EstablishmentIds[iNdEx])
+			copy(dAtA[i:], m.EstablishmentIds[iNdEx])
+			i = encodeVarintBooking(dAtA, i, uint64(len(m.EstablishmentIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerBooking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerBooking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerBooking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintBooking(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Booking != nil {
+		{
+			size, err := m.Booking.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBooking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerBookingsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerBookingsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerBookingsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintBooking(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bookings) > 0 {
+		for iNdEx := len(m.Bookings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bookings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBooking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBooking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBooking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Id) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Filter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBooking(uint64(len(k))) + 1 + len(v) + sovBooking(uint64(len(v)))
+			n += mapEntrySize + 1 + sovBooking(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BookingId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListReqById) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovBooking(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovBooking(uint64(m.Offset))
+	}
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovBooking(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovBooking(uint64(m.Offset))
+	}
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBookingRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bookings) > 0 {
+		for _, e := range m.Bookings {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovBooking(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GeneralBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.HraId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.WillArrive)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.WillLeave)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.NumberOfPeople != 0 {
+		n += 1 + sovBooking(uint64(m.NumberOfPeople))
+	}
+	if m.IsCanceled {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.AdultTickets != 0 {
+		n += 1 + sovBooking(uint64(m.AdultTickets))
+	}
+	if m.ChildTickets != 0 {
+		n += 1 + sovBooking(uint64(m.ChildTickets))
+	}
+	if m.TotalPrice != 0 {
+		n += 9
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 2 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ItineraryId)
+	if l > 0 {
+		n += 2 + l + sovBooking(uint64(l))
+	}
+	l = len(m.HoldExpiresAt)
+	if l > 0 {
+		n += 2 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ConfirmationCode)
+	if l > 0 {
+		n += 2 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ApprovalDueAt)
+	if l > 0 {
+		n += 2 + l + sovBooking(uint64(l))
+	}
+	if len(m.Guests) > 0 {
+		for _, e := range m.Guests {
+			l = e.Size()
+			n += 2 + l + sovBooking(uint64(l))
+		}
+	}
+	if len(m.SpecialRequests) > 0 {
+		for _, s := range m.SpecialRequests {
+			l = len(s)
+			n += 2 + l + sovBooking(uint64(l))
+		}
+	}
+	l = len(m.SpecialRequestNote)
+	if l > 0 {
+		n += 2 + l + sovBooking(uint64(l))
+	}
+	if len(m.AddOns) > 0 {
+		for _, e := range m.AddOns {
+			l = e.Size()
+			n += 2 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.GuestsSet {
+		n += 3
+	}
+	if m.AddOnsSet {
+		n += 3
+	}
+	if m.SpecialRequestsSet {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BookingGuest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FullName)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.Age != 0 {
+		n += 1 + sovBooking(uint64(m.Age))
+	}
+	l = len(m.DocumentNumber)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BookingAddOn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddOnId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovBooking(uint64(m.Quantity))
+	}
+	if m.UnitPrice != 0 {
+		n += 9
+	}
+	if m.Total != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		for _, e := range m.UserId {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovBooking(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AvailabilityReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.WillArrive)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.WillLeave)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.PartySize != 0 {
+		n += 1 + sovBooking(uint64(m.PartySize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AvailableHotel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HotelId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.HotelName)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.RoomDescription)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.RoomCapacity != 0 {
+		n += 1 + sovBooking(uint64(m.RoomCapacity))
+	}
+	if m.NightlyPrice != 0 {
+		n += 9
+	}
+	if m.FreeRooms != 0 {
+		n += 1 + sovBooking(uint64(m.FreeRooms))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AvailabilityRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hotels) > 0 {
+		for _, e := range m.Hotels {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovBooking(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FreeSlotsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RestaurantId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.FreeSeats != 0 {
+		n += 1 + sovBooking(uint64(m.FreeSeats))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FreeSlotsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slots) > 0 {
+		for _, e := range m.Slots {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.SeatCapacity != 0 {
+		n += 1 + sovBooking(uint64(m.SeatCapacity))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QuotaReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttractionId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EntryWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovBooking(uint64(m.Remaining))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QuotaRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DailyQuota != 0 {
+		n += 1 + sovBooking(uint64(m.DailyQuota))
+	}
+	if m.DailyRemaining != 0 {
+		n += 1 + sovBooking(uint64(m.DailyRemaining))
+	}
+	if m.WindowQuota != 0 {
+		n += 1 + sovBooking(uint64(m.WindowQuota))
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PriceQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nights != 0 {
+		n += 1 + sovBooking(uint64(m.Nights))
+	}
+	if m.HolidayNights != 0 {
+		n += 1 + sovBooking(uint64(m.HolidayNights))
+	}
+	if m.NightlyPrice != 0 {
+		n += 9
+	}
+	if m.Subtotal != 0 {
+		n += 9
+	}
+	if m.Surcharge != 0 {
+		n += 9
+	}
+	if m.Discount != 0 {
+		n += 9
+	}
+	if m.Total != 0 {
+		n += 9
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PaymentAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttemptId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Payment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PaymentId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 9
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ProviderRef)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if len(m.Attempts) > 0 {
+		for _, e := range m.Attempts {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.RefundedAmount != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PayReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BookingId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.CardToken)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PaymentEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ProviderRef)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BookingId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ChangedBy)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.FromStatus)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ToStatus)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ChangedBy)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.RefundPercent != 0 {
+		n += 9
+	}
+	if m.RefundAmount != 0 {
+		n += 9
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.FromWillArrive)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.FromWillLeave)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ToWillArrive)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ToWillLeave)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.PriceDifference != 0 {
+		n += 10
+	}
+	if m.ChangeFee != 0 {
+		n += 10
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RescheduleReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BookingId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.WillArrive)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.WillLeave)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.NumberOfPeople != 0 {
+		n += 1 + sovBooking(uint64(m.NumberOfPeople))
+	}
+	if m.AdultTickets != 0 {
+		n += 1 + sovBooking(uint64(m.AdultTickets))
+	}
+	if m.ChildTickets != 0 {
+		n += 1 + sovBooking(uint64(m.ChildTickets))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ChangedBy)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusHistoryReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BookingId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusHistoryRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WaitlistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.HraId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.WillArrive)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.WillLeave)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.NumberOfPeople != 0 {
+		n += 1 + sovBooking(uint64(m.NumberOfPeople))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.OfferExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.BookingId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WaitlistReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WaitlistListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WaitlistListRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Itinerary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if len(m.Bookings) > 0 {
+		for _, e := range m.Bookings {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ItineraryReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.ChangedBy)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ItineraryListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ItineraryListRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Itineraries) > 0 {
+		for _, e := range m.Itineraries {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HoldReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EstablishmentBookingsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BookingType)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Since)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CalendarSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.LastSyncedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovBooking(uint64(m.Blocks))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CalendarSourceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CalendarSourceListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CalendarSourceListRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExternalBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.SourceId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.StartsOn)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.EndsOn)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExternalBlockListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExternalBlockListRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConfirmationCodeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OwnerBookingsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EstablishmentIds) > 0 {
+		for _, s := range m.EstablishmentIds {
+			l = len(s)
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if len(m.Statuses) > 0 {
+		for _, s := range m.Statuses {
+			l = len(s)
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.Limit != 0 {
+		n += 1 + sovBooking(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovBooking(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OwnerBooking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Booking != nil {
+		l = m.Booking.Size()
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovBooking(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OwnerBookingsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bookings) > 0 {
+		for _, e := range m.Bookings {
+			l = e.Size()
+			n += 1 + l + sovBooking(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovBooking(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBooking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBooking(x uint64) (n int) {
+	return sovBooking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Id) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Id: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Id: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Filter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBooking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBooking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBooking
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthBooking
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBooking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthBooking
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthBooking
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBooking(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthBooking
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BookingId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BookingId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BookingId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListReqById) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListReqById: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListReqById: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &Id{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBookingRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBookingRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBookingRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bookings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bookings = append(m.Bookings, &GeneralBook{})
+			if err := m.Bookings[len(m.Bookings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeneralBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneralBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneralBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HraId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HraId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillArrive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillArrive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillLeave", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillLeave = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfPeople", wireType)
+			}
+			m.NumberOfPeople = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfPeople |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCanceled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCanceled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdultTickets", wireType)
+			}
+			m.AdultTickets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdultTickets |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildTickets", wireType)
+			}
+			m.ChildTickets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChildTickets |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TotalPrice = float64(math.Float64frombits(v))
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItineraryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItineraryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HoldExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmationCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalDueAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalDueAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guests = append(m.Guests, &BookingGuest{})
+			if err := m.Guests[len(m.Guests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialRequests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialRequests = append(m.SpecialRequests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialRequestNote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialRequestNote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddOns = append(m.AddOns, &BookingAddOn{})
+			if err := m.AddOns[len(m.AddOns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuestsSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GuestsSet = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOnsSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AddOnsSet = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialRequestsSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpecialRequestsSet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BookingGuest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BookingGuest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BookingGuest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FullName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BookingAddOn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BookingAddOn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BookingAddOn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddOnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.UnitPrice = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Total = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = append(m.UserId, &Id{})
+			if err := m.UserId[len(m.UserId)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AvailabilityReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AvailabilityReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AvailabilityReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillArrive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillArrive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillLeave", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillLeave = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartySize", wireType)
+			}
+			m.PartySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AvailableHotel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AvailableHotel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AvailableHotel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotelName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoomDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomCapacity", wireType)
+			}
+			m.RoomCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoomCapacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NightlyPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NightlyPrice = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeRooms", wireType)
+			}
+			m.FreeRooms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeRooms |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AvailabilityRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AvailabilityRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AvailabilityRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hotels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hotels = append(m.Hotels, &AvailableHotel{})
+			if err := m.Hotels[len(m.Hotels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreeSlotsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreeSlotsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreeSlotsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestaurantId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestaurantId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Slot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeSeats", wireType)
+			}
+			m.FreeSeats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeSeats |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreeSlotsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreeSlotsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreeSlotsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slots = append(m.Slots, &Slot{})
+			if err := m.Slots[len(m.Slots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeatCapacity", wireType)
+			}
+			m.SeatCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeatCapacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttractionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttractionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntryWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntryWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntryWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyQuota", wireType)
+			}
+			m.DailyQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailyQuota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyRemaining", wireType)
+			}
+			m.DailyRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailyRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowQuota", wireType)
+			}
+			m.WindowQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowQuota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, &EntryWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceQuote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceQuote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceQuote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nights", wireType)
+			}
+			m.Nights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nights |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolidayNights", wireType)
+			}
+			m.HolidayNights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HolidayNights |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NightlyPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NightlyPrice = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subtotal", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Subtotal = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surcharge", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Surcharge = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Discount = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Total = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentAttempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentAttempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttemptId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Payment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Amount = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attempts = append(m.Attempts, &PaymentAttempt{})
+			if err := m.Attempts[len(m.Attempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmount", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RefundedAmount = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RefundPercent = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RefundAmount = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromWillArrive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromWillArrive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromWillLeave", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromWillLeave = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToWillArrive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToWillArrive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToWillLeave", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToWillLeave = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDifference", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PriceDifference = float64(math.Float64frombits(v))
+		case 17:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeFee", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ChangeFee = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RescheduleReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RescheduleReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RescheduleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillArrive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillArrive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillLeave", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillLeave = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfPeople", wireType)
+			}
+			m.NumberOfPeople = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfPeople |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdultTickets", wireType)
+			}
+			m.AdultTickets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdultTickets |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildTickets", wireType)
+			}
+			m.ChildTickets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChildTickets |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusHistoryReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusHistoryReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusHistoryReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusHistoryRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusHistoryRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusHistoryRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &StatusChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitlistEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitlistEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitlistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HraId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HraId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillArrive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillArrive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillLeave", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WillLeave = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfPeople", wireType)
+			}
+			m.NumberOfPeople = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfPeople |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OfferExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitlistReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitlistReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitlistReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitlistListReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitlistListReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitlistListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitlistListRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitlistListRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitlistListRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &WaitlistEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Itinerary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Itinerary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Itinerary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bookings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bookings = append(m.Bookings, &GeneralBook{})
+			if err := m.Bookings[len(m.Bookings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ItineraryReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItineraryReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItineraryReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ItineraryListReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItineraryListReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItineraryListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ItineraryListRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItineraryListRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItineraryListRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Itineraries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Itineraries = append(m.Itineraries, &Itinerary{})
+			if err := m.Itineraries[len(m.Itineraries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HoldReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HoldReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HoldReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstablishmentBookingsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstablishmentBookingsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstablishmentBookingsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Since = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CalendarSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CalendarSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CalendarSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastSyncedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.Count != 0 {
-		n += 1 + sovBooking(uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *GeneralBook) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	l = len(m.HraId)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	l = len(m.WillArrive)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	l = len(m.WillLeave)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	if m.NumberOfPeople != 0 {
-		n += 1 + sovBooking(uint64(m.NumberOfPeople))
-	}
-	if m.IsCanceled {
-		n += 2
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovBooking(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *UserId) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UserId) > 0 {
-		for _, e := range m.UserId {
-			l = e.Size()
-			n += 1 + l + sovBooking(uint64(l))
-		}
-	}
-	if m.Count != 0 {
-		n += 1 + sovBooking(uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBooking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBooking(x uint64) (n int) {
-	return sovBooking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DelRes) Unmarshal(dAtA []byte) error {
+func (m *CalendarSourceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2258,15 +17843,15 @@ func (m *DelRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: CalendarSourceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CalendarSourceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2294,7 +17879,39 @@ func (m *DelRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2318,7 +17935,7 @@ func (m *DelRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Id) Unmarshal(dAtA []byte) error {
+func (m *CalendarSourceListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2341,15 +17958,15 @@ func (m *Id) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Id: wiretype end group for non-group")
+			return fmt.Errorf("proto: CalendarSourceListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Id: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CalendarSourceListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2377,7 +17994,7 @@ func (m *Id) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.RoomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2401,7 +18018,7 @@ func (m *Id) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Filter) Unmarshal(dAtA []byte) error {
+func (m *CalendarSourceListRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2424,138 +18041,45 @@ func (m *Filter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
+			return fmt.Errorf("proto: CalendarSourceListRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CalendarSourceListRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBooking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthBooking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBooking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Filter == nil {
-				m.Filter = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBooking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBooking
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthBooking
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthBooking
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBooking
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthBooking
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthBooking
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipBooking(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthBooking
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Filter[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, &CalendarSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2579,7 +18103,7 @@ func (m *Filter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListReqById) Unmarshal(dAtA []byte) error {
+func (m *ExternalBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2602,17 +18126,17 @@ func (m *ListReqById) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListReqById: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExternalBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListReqById: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -2622,16 +18146,29 @@ func (m *ListReqById) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
 			}
-			m.Offset = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -2641,16 +18178,29 @@ func (m *ListReqById) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -2660,84 +18210,93 @@ func (m *ListReqById) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBooking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBooking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &Id{}
+			m.RoomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBooking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthBooking
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBooking
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
 			}
-			m.Limit = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsOn", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -2747,16 +18306,29 @@ func (m *ListReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
 			}
-			m.Offset = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartsOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsOn", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -2766,11 +18338,24 @@ func (m *ListReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndsOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBooking(dAtA[iNdEx:])
@@ -2793,7 +18378,7 @@ func (m *ListReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUserHotelRes) Unmarshal(dAtA []byte) error {
+func (m *ExternalBlockListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2816,17 +18401,17 @@ func (m *ListUserHotelRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUserHotelRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExternalBlockListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUserHotelRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalBlockListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserHotel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -2836,31 +18421,61 @@ func (m *ListUserHotelRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBooking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBooking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBooking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserHotel = append(m.UserHotel, &GeneralBook{})
-			if err := m.UserHotel[len(m.UserHotel)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -2870,11 +18485,24 @@ func (m *ListUserHotelRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBooking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBooking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBooking(dAtA[iNdEx:])
@@ -2897,7 +18525,7 @@ func (m *ListUserHotelRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUserRestaurantRes) Unmarshal(dAtA []byte) error {
+func (m *ExternalBlockListRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2920,15 +18548,15 @@ func (m *ListUserRestaurantRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUserRestaurantRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExternalBlockListRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUserRestaurantRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalBlockListRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserRestaurant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2955,30 +18583,11 @@ func (m *ListUserRestaurantRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserRestaurant = append(m.UserRestaurant, &GeneralBook{})
-			if err := m.UserRestaurant[len(m.UserRestaurant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Blocks = append(m.Blocks, &ExternalBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBooking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBooking(dAtA[iNdEx:])
@@ -3001,7 +18610,7 @@ func (m *ListUserRestaurantRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUserAttractionRes) Unmarshal(dAtA []byte) error {
+func (m *ConfirmationCodeReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3024,17 +18633,17 @@ func (m *ListUserAttractionRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUserAttractionRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfirmationCodeReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUserAttractionRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfirmationCodeReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAttraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -3044,45 +18653,24 @@ func (m *ListUserAttractionRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBooking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBooking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserAttraction = append(m.UserAttraction, &GeneralBook{})
-			if err := m.UserAttraction[len(m.UserAttraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBooking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBooking(dAtA[iNdEx:])
@@ -3105,7 +18693,7 @@ func (m *ListUserAttractionRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeneralBook) Unmarshal(dAtA []byte) error {
+func (m *OwnerBookingsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3128,15 +18716,15 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeneralBook: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnerBookingsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeneralBook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnerBookingsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3164,11 +18752,11 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.EstablishmentIds = append(m.EstablishmentIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3196,11 +18784,11 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HraId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3228,11 +18816,11 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HraId = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WillArrive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3260,45 +18848,13 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WillArrive = string(dAtA[iNdEx:postIndex])
+			m.Statuses = append(m.Statuses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WillLeave", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBooking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBooking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBooking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WillLeave = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfPeople", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.NumberOfPeople = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -3308,36 +18864,16 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumberOfPeople |= int64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCanceled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBooking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsCanceled = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var stringLen uint64
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -3347,61 +18883,67 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Offset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBooking
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBooking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBooking
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBooking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBooking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBooking
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerBooking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBooking
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerBooking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerBooking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Booking", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBooking
@@ -3411,27 +18953,31 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBooking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBooking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			if m.Booking == nil {
+				m.Booking = &GeneralBook{}
+			}
+			if err := m.Booking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3459,7 +19005,7 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3483,7 +19029,7 @@ func (m *GeneralBook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserId) Unmarshal(dAtA []byte) error {
+func (m *OwnerBookingsRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3506,15 +19052,15 @@ func (m *UserId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserId: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnerBookingsRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnerBookingsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bookings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3541,8 +19087,8 @@ func (m *UserId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = append(m.UserId, &Id{})
-			if err := m.UserId[len(m.UserId)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bookings = append(m.Bookings, &OwnerBooking{})
+			if err := m.Bookings[len(m.Bookings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -324,6 +324,53 @@ func (m *UEwU) GetEstablishmentId() string {
 	return ""
 }
 
+type UEList struct {
+	EstablishmentIds     []string `protobuf:"bytes,1,rep,name=establishment_ids,json=establishmentIds,proto3" json:"establishment_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UEList) Reset()         { *m = UEList{} }
+func (m *UEList) String() string { return proto.CompactTextString(m) }
+func (*UEList) ProtoMessage()    {}
+func (*UEList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3685497d3bcbbc58, []int{6}
+}
+func (m *UEList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UEList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UEList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UEList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UEList.Merge(m, src)
+}
+func (m *UEList) XXX_Size() int {
+	return m.Size()
+}
+func (m *UEList) XXX_DiscardUnknown() {
+	xxx_messageInfo_UEList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UEList proto.InternalMessageInfo
+
+func (m *UEList) GetEstablishmentIds() []string {
+	if m != nil {
+		return m.EstablishmentIds
+	}
+	return nil
+}
+
 type Filter struct {
 	Filter               map[string]string `protobuf:"bytes,1,rep,name=filter,proto3" json:"filter" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -335,7 +382,7 @@ func (m *Filter) Reset()         { *m = Filter{} }
 func (m *Filter) String() string { return proto.CompactTextString(m) }
 func (*Filter) ProtoMessage()    {}
 func (*Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3685497d3bcbbc58, []int{6}
+	return fileDescriptor_3685497d3bcbbc58, []int{7}
 }
 func (m *Filter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +431,7 @@ func (m *ListUsersReq) Reset()         { *m = ListUsersReq{} }
 func (m *ListUsersReq) String() string { return proto.CompactTextString(m) }
 func (*ListUsersReq) ProtoMessage()    {}
 func (*ListUsersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3685497d3bcbbc58, []int{7}
+	return fileDescriptor_3685497d3bcbbc58, []int{8}
 }
 func (m *ListUsersReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +493,7 @@ func (m *ListUsersRes) Reset()         { *m = ListUsersRes{} }
 func (m *ListUsersRes) String() string { return proto.CompactTextString(m) }
 func (*ListUsersRes) ProtoMessage()    {}
 func (*ListUsersRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3685497d3bcbbc58, []int{8}
+	return fileDescriptor_3685497d3bcbbc58, []int{9}
 }
 func (m *ListUsersRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,7 +559,7 @@ func (m *UserList) Reset()         { *m = UserList{} }
 func (m *UserList) String() string { return proto.CompactTextString(m) }
 func (*UserList) ProtoMessage()    {}
 func (*UserList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3685497d3bcbbc58, []int{9}
+	return fileDescriptor_3685497d3bcbbc58, []int{10}
 }
 func (m *UserList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +703,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3685497d3bcbbc58, []int{10}
+	return fileDescriptor_3685497d3bcbbc58, []int{11}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -794,7 +841,7 @@ func (m *GetUser) Reset()         { *m = GetUser{} }
 func (m *GetUser) String() string { return proto.CompactTextString(m) }
 func (*GetUser) ProtoMessage()    {}
 func (*GetUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3685497d3bcbbc58, []int{11}
+	return fileDescriptor_3685497d3bcbbc58, []int{12}
 }
 func (m *GetUser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,6 +884,7 @@ func init() {
 	proto.RegisterType((*Status)(nil), "user.Status")
 	proto.RegisterType((*UE)(nil), "user.UE")
 	proto.RegisterType((*UEwU)(nil), "user.UEwU")
+	proto.RegisterType((*UEList)(nil), "user.UEList")
 	proto.RegisterType((*Filter)(nil), "user.Filter")
 	proto.RegisterMapType((map[string]string)(nil), "user.Filter.FilterEntry")
 	proto.RegisterType((*ListUsersReq)(nil), "user.ListUsersReq")
@@ -849,55 +897,57 @@ func init() {
 func init() { proto.RegisterFile("user-proto/user.proto", fileDescriptor_3685497d3bcbbc58) }
 
 var fileDescriptor_3685497d3bcbbc58 = []byte{
-	// 766 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x4b, 0x6f, 0xdb, 0x46,
-	0x10, 0xae, 0x28, 0x89, 0x96, 0x46, 0x92, 0x2b, 0x2c, 0xdc, 0x9a, 0x50, 0x5b, 0x55, 0x65, 0x0d,
-	0xd4, 0x3e, 0xd8, 0x35, 0x6c, 0x14, 0x68, 0x7d, 0xf3, 0x43, 0x76, 0x15, 0x04, 0x0e, 0x42, 0x47,
-	0xbe, 0x12, 0x94, 0x38, 0xb4, 0x08, 0xf3, 0x21, 0xef, 0x2e, 0xe5, 0xf8, 0x57, 0xe4, 0x9a, 0x9f,
-	0x94, 0x63, 0x7e, 0x42, 0xe0, 0x9c, 0x73, 0xce, 0x35, 0xd8, 0x07, 0x15, 0x2a, 0x76, 0x10, 0x07,
-	0xc8, 0x31, 0x27, 0xcd, 0x7c, 0xdf, 0xb7, 0xf3, 0x12, 0x77, 0x16, 0x7e, 0xca, 0x18, 0xd2, 0xcd,
-	0x29, 0x4d, 0x79, 0xfa, 0xb7, 0x30, 0xb7, 0xa4, 0x49, 0x2a, 0xc2, 0xb6, 0x6b, 0x60, 0x1e, 0x61,
-	0xe4, 0x20, 0xb3, 0x57, 0xc0, 0x18, 0xf8, 0x64, 0x19, 0x8c, 0xd0, 0xb7, 0x4a, 0xbd, 0xd2, 0x7a,
-	0xdd, 0x31, 0x42, 0xdf, 0xde, 0x06, 0xe3, 0xf8, 0x9c, 0xac, 0x40, 0x35, 0x08, 0x31, 0xca, 0x09,
-	0xe5, 0x08, 0x74, 0xe6, 0x45, 0x19, 0x5a, 0x86, 0x42, 0xa5, 0x63, 0xff, 0x0a, 0xe6, 0x19, 0xf7,
-	0x78, 0xc6, 0x08, 0x81, 0xca, 0x38, 0xf5, 0x51, 0x1e, 0xaa, 0x3a, 0xd2, 0xb6, 0xff, 0x07, 0x63,
-	0xd8, 0x27, 0xab, 0xb0, 0x24, 0xb2, 0xbb, 0xf3, 0x54, 0xa6, 0x70, 0x07, 0x3e, 0xd9, 0x80, 0x36,
-	0x32, 0xee, 0x8d, 0xa2, 0x90, 0x4d, 0x62, 0x4c, 0xb8, 0x50, 0xa8, 0xe8, 0x3f, 0x2e, 0xe0, 0x03,
-	0xdf, 0x7e, 0x0a, 0x95, 0x61, 0xff, 0x7a, 0x48, 0xba, 0x20, 0x3b, 0x91, 0x81, 0x1a, 0x3b, 0xb0,
-	0x25, 0x5b, 0x1c, 0x32, 0xa4, 0x8e, 0xc4, 0xbf, 0x26, 0x64, 0x06, 0xe6, 0x71, 0x18, 0x71, 0xa4,
-	0x64, 0x1b, 0xcc, 0x40, 0x5a, 0x56, 0xa9, 0x57, 0x5e, 0x6f, 0xec, 0x58, 0x2a, 0xac, 0x62, 0xf5,
-	0x4f, 0x3f, 0xe1, 0xf4, 0xc6, 0xd1, 0xba, 0xce, 0x7f, 0xd0, 0x28, 0xc0, 0xa4, 0x0d, 0xe5, 0x4b,
-	0xbc, 0xd1, 0xdd, 0x09, 0xf3, 0xfe, 0x69, 0xed, 0x19, 0xff, 0x96, 0xec, 0x73, 0x68, 0x3e, 0x0e,
-	0x19, 0x17, 0x35, 0x33, 0x07, 0xaf, 0x84, 0x32, 0x0a, 0xe3, 0x90, 0xcb, 0xd3, 0x15, 0x47, 0x39,
-	0xe4, 0x67, 0x30, 0xd3, 0x20, 0x60, 0xc8, 0x65, 0x80, 0x8a, 0xa3, 0x3d, 0x62, 0x81, 0x11, 0xcc,
-	0xac, 0xb2, 0xec, 0xbe, 0xa6, 0xcb, 0x3c, 0x77, 0x8c, 0x60, 0x66, 0x3f, 0x5a, 0x88, 0xcb, 0xc8,
-	0x1a, 0x54, 0x05, 0xcd, 0x74, 0x4f, 0xcb, 0x1f, 0x47, 0x25, 0x64, 0x8e, 0x22, 0x45, 0xf6, 0x71,
-	0x9a, 0x25, 0x2a, 0x4d, 0xd9, 0x51, 0x8e, 0xfd, 0xde, 0x80, 0x5a, 0xae, 0xfc, 0xf4, 0x23, 0x21,
-	0xbf, 0x40, 0x3d, 0xc8, 0xa2, 0xc8, 0x4d, 0xbc, 0x38, 0x6f, 0xaf, 0x26, 0x80, 0x53, 0x2f, 0x46,
-	0x11, 0x0f, 0x63, 0x2f, 0x8c, 0x64, 0x89, 0x75, 0x47, 0x39, 0xc4, 0x86, 0x96, 0xef, 0x71, 0x74,
-	0xd3, 0xc0, 0x1d, 0x85, 0x94, 0x4f, 0xac, 0xaa, 0x64, 0x1b, 0x02, 0x7c, 0x12, 0x1c, 0x08, 0x88,
-	0xfc, 0x0e, 0x8d, 0x29, 0x4d, 0x83, 0x30, 0x42, 0x37, 0x8c, 0x2f, 0x2c, 0x53, 0x2a, 0x40, 0x43,
-	0x83, 0xf8, 0x42, 0x7e, 0x60, 0x1e, 0xf5, 0xad, 0x25, 0xc9, 0x48, 0x5b, 0x8c, 0xe9, 0x02, 0x13,
-	0x1f, 0xa9, 0x55, 0x53, 0x5f, 0x96, 0xf2, 0xc8, 0x1f, 0xd0, 0x9c, 0x4e, 0xd2, 0x04, 0xdd, 0x24,
-	0x8b, 0x47, 0x48, 0xad, 0xba, 0xca, 0x27, 0xb1, 0x53, 0x09, 0x89, 0x70, 0x34, 0x8d, 0xd0, 0x02,
-	0x15, 0x4e, 0xd8, 0xe4, 0x4f, 0x68, 0x51, 0x0c, 0x28, 0xb2, 0x89, 0xcb, 0xd3, 0x4b, 0x4c, 0xac,
-	0x86, 0x24, 0x9b, 0x1a, 0x7c, 0x26, 0x30, 0xf2, 0x1b, 0xc0, 0x98, 0xa2, 0xc7, 0xd1, 0x77, 0x3d,
-	0x6e, 0x35, 0xa5, 0xa2, 0xae, 0x91, 0x7d, 0x2e, 0xe8, 0x6c, 0xea, 0xe7, 0x74, 0x4b, 0xd1, 0x1a,
-	0x51, 0xb4, 0x8f, 0x11, 0x6a, 0x7a, 0x59, 0xd1, 0x1a, 0xd9, 0xe7, 0xf6, 0x8b, 0x32, 0x54, 0xc4,
-	0xe4, 0xbf, 0xc5, 0xd4, 0x3b, 0x50, 0x9b, 0x7a, 0x8c, 0x5d, 0xa7, 0xd4, 0xb7, 0x2a, 0xea, 0x44,
-	0xee, 0x7f, 0xff, 0x47, 0x1e, 0xfc, 0x8f, 0x6c, 0xc0, 0xd2, 0x09, 0xca, 0x6b, 0xf5, 0xa5, 0xe5,
-	0xb3, 0xf3, 0xae, 0x0c, 0x0d, 0xe1, 0x9e, 0x21, 0x9d, 0x85, 0x63, 0x24, 0x3d, 0x30, 0x0f, 0x65,
-	0x15, 0xa4, 0xa0, 0xed, 0x14, 0x6c, 0x62, 0x43, 0xf9, 0x04, 0x39, 0x69, 0x16, 0x17, 0x4e, 0xa7,
-	0xa5, 0xbc, 0x3c, 0xeb, 0x1e, 0xb4, 0xc5, 0x3d, 0x3c, 0x52, 0x15, 0xc9, 0xfb, 0x4d, 0x88, 0x92,
-	0x14, 0x17, 0x49, 0xe7, 0x2e, 0xc6, 0xc8, 0x2e, 0xd4, 0xe7, 0xfe, 0x83, 0x0f, 0xf5, 0xc0, 0x1c,
-	0xca, 0xe9, 0x7c, 0xb6, 0xec, 0x35, 0x80, 0xb3, 0x34, 0xd0, 0x25, 0x11, 0xbd, 0x87, 0x06, 0x7e,
-	0x47, 0xf7, 0xa1, 0xde, 0x18, 0xb2, 0x09, 0xab, 0x42, 0xdd, 0x2f, 0xee, 0x5d, 0x3d, 0x0f, 0x7d,
-	0x64, 0xd8, 0xef, 0xcc, 0x2d, 0xb2, 0x0d, 0x2b, 0x77, 0xe4, 0x77, 0x87, 0x93, 0x97, 0x21, 0x1e,
-	0x83, 0x7f, 0xee, 0x49, 0xa0, 0x6b, 0x5a, 0x3c, 0xb4, 0x58, 0xd7, 0x5f, 0xd0, 0x3a, 0x9c, 0xe0,
-	0xf8, 0x72, 0x98, 0x84, 0x57, 0x19, 0x32, 0x46, 0xe6, 0x8b, 0x34, 0x17, 0xea, 0x27, 0xad, 0x0b,
-	0x66, 0xff, 0x79, 0xc8, 0x78, 0x51, 0x51, 0x18, 0xc3, 0x41, 0xfb, 0xd5, 0x6d, 0xb7, 0xf4, 0xfa,
-	0xb6, 0x5b, 0x7a, 0x73, 0xdb, 0x2d, 0xbd, 0x7c, 0xdb, 0xfd, 0x61, 0x64, 0xca, 0xd7, 0x76, 0xf7,
-	0x43, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x26, 0x9e, 0x27, 0x86, 0x07, 0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x6e, 0xeb, 0x54,
+	0x10, 0x26, 0x8e, 0xe3, 0x26, 0x93, 0xa4, 0x84, 0xa3, 0x5e, 0xae, 0x15, 0x20, 0x04, 0x73, 0x25,
+	0xee, 0x15, 0xba, 0x6d, 0xd5, 0xaa, 0x12, 0x74, 0xd7, 0x9f, 0xb4, 0x04, 0xa1, 0x22, 0x5c, 0xdc,
+	0xad, 0xe5, 0xc4, 0xe3, 0xc6, 0xaa, 0x7f, 0xd2, 0x73, 0x8e, 0x53, 0xfa, 0x14, 0x6c, 0x59, 0xf1,
+	0x3c, 0x2c, 0x79, 0x04, 0x54, 0x1e, 0x82, 0x2d, 0x3a, 0x3f, 0x0e, 0x4e, 0x53, 0x41, 0x91, 0x58,
+	0xb2, 0xca, 0xcc, 0xf7, 0x7d, 0x33, 0x67, 0x66, 0xe2, 0x33, 0x07, 0x5e, 0x14, 0x0c, 0xe9, 0xdb,
+	0x39, 0xcd, 0x79, 0xbe, 0x23, 0xcc, 0x6d, 0x69, 0x12, 0x53, 0xd8, 0x4e, 0x13, 0xac, 0x53, 0x4c,
+	0x5c, 0x64, 0xce, 0x16, 0x18, 0xe3, 0x90, 0x6c, 0x82, 0x11, 0x87, 0x76, 0x6d, 0x58, 0x7b, 0xdd,
+	0x72, 0x8d, 0x38, 0x74, 0x76, 0xc1, 0x38, 0xbb, 0x22, 0x5b, 0xd0, 0x88, 0x62, 0x4c, 0x4a, 0x42,
+	0x39, 0x02, 0x5d, 0x04, 0x49, 0x81, 0xb6, 0xa1, 0x50, 0xe9, 0x38, 0x1f, 0x82, 0x75, 0xc9, 0x03,
+	0x5e, 0x30, 0x42, 0xc0, 0x9c, 0xe6, 0x21, 0xca, 0xa0, 0x86, 0x2b, 0x6d, 0xe7, 0x2b, 0x30, 0xbc,
+	0x11, 0x79, 0x09, 0x1b, 0xe2, 0x74, 0x7f, 0x79, 0x94, 0x25, 0xdc, 0x71, 0x48, 0xde, 0x40, 0x0f,
+	0x19, 0x0f, 0x26, 0x49, 0xcc, 0x66, 0x29, 0x66, 0x5c, 0x28, 0x54, 0xf6, 0x77, 0x57, 0xf0, 0x71,
+	0xe8, 0x7c, 0x07, 0xa6, 0x37, 0xba, 0xf3, 0xc8, 0x00, 0x64, 0x27, 0x32, 0x51, 0x7b, 0x0f, 0xb6,
+	0x65, 0x8b, 0x1e, 0x43, 0xea, 0x4a, 0xfc, 0xdf, 0xa4, 0x3c, 0x00, 0xcb, 0x1b, 0x7d, 0x13, 0x33,
+	0x4e, 0x3e, 0x87, 0xf7, 0x1e, 0x07, 0x31, 0xbb, 0x36, 0xac, 0xbf, 0x6e, 0xb9, 0xbd, 0x47, 0x51,
+	0xcc, 0x29, 0xc0, 0x3a, 0x8b, 0x13, 0x8e, 0x94, 0xec, 0x82, 0x15, 0x49, 0x4b, 0x6a, 0xdb, 0x7b,
+	0xb6, 0xaa, 0x46, 0xb1, 0xfa, 0x67, 0x94, 0x71, 0x7a, 0xef, 0x6a, 0x5d, 0xff, 0x4b, 0x68, 0x57,
+	0x60, 0xd2, 0x83, 0xfa, 0x0d, 0xde, 0xeb, 0xa1, 0x08, 0xf3, 0xe9, 0x21, 0x1f, 0x1a, 0x5f, 0xd4,
+	0x9c, 0x2b, 0xe8, 0x88, 0x5a, 0x45, 0xab, 0xcc, 0xc5, 0x5b, 0xa1, 0x4c, 0xe2, 0x34, 0xe6, 0x32,
+	0xda, 0x74, 0x95, 0x43, 0xde, 0x07, 0x2b, 0x8f, 0x22, 0x86, 0x5c, 0x26, 0x30, 0x5d, 0xed, 0x11,
+	0x1b, 0x8c, 0x68, 0x61, 0xd7, 0xe5, 0xd0, 0x9a, 0xba, 0xcc, 0x2b, 0xd7, 0x88, 0x16, 0xce, 0xd7,
+	0x2b, 0x79, 0x19, 0x79, 0x05, 0x0d, 0x41, 0x33, 0xdd, 0xd3, 0xe6, 0x5f, 0x13, 0x16, 0x32, 0x57,
+	0x91, 0xe2, 0xf4, 0x69, 0x5e, 0x64, 0xea, 0x98, 0xba, 0xab, 0x1c, 0xe7, 0x0f, 0x03, 0x9a, 0xa5,
+	0xf2, 0xf1, 0xb7, 0x45, 0x3e, 0x80, 0x56, 0x54, 0x24, 0x89, 0x9f, 0x05, 0x69, 0xd9, 0x5e, 0x53,
+	0x00, 0x17, 0x41, 0x8a, 0x22, 0x1f, 0xa6, 0x41, 0x9c, 0xc8, 0x12, 0x5b, 0xae, 0x72, 0x88, 0x03,
+	0xdd, 0x30, 0xe0, 0xe8, 0xe7, 0x91, 0x3f, 0x89, 0x29, 0x9f, 0xd9, 0x0d, 0xc9, 0xb6, 0x05, 0xf8,
+	0x6d, 0x74, 0x2c, 0x20, 0xf2, 0x31, 0xb4, 0xe7, 0x34, 0x8f, 0xe2, 0x04, 0xfd, 0x38, 0xbd, 0xb6,
+	0x2d, 0xa9, 0x00, 0x0d, 0x8d, 0xd3, 0x6b, 0xf9, 0x5d, 0x06, 0x34, 0xb4, 0x37, 0x24, 0x23, 0x6d,
+	0x31, 0xa6, 0x6b, 0xcc, 0x42, 0xa4, 0x76, 0x53, 0x7d, 0x90, 0xca, 0x23, 0x9f, 0x40, 0x67, 0x3e,
+	0xcb, 0x33, 0xf4, 0xb3, 0x22, 0x9d, 0x20, 0xb5, 0x5b, 0xea, 0x3c, 0x89, 0x5d, 0x48, 0x48, 0xa4,
+	0xa3, 0x79, 0x82, 0x36, 0xa8, 0x74, 0xc2, 0x26, 0x9f, 0x42, 0x97, 0x62, 0x44, 0x91, 0xcd, 0x7c,
+	0x9e, 0xdf, 0x60, 0x66, 0xb7, 0x25, 0xd9, 0xd1, 0xe0, 0xf7, 0x02, 0x23, 0x1f, 0x01, 0x4c, 0x29,
+	0x06, 0x1c, 0x43, 0x3f, 0xe0, 0x76, 0x47, 0x2a, 0x5a, 0x1a, 0x39, 0xe2, 0x82, 0x2e, 0xe6, 0x61,
+	0x49, 0x77, 0x15, 0xad, 0x11, 0x45, 0x87, 0x98, 0xa0, 0xa6, 0x37, 0x15, 0xad, 0x91, 0x23, 0xee,
+	0xfc, 0x58, 0x07, 0x53, 0x4c, 0xfe, 0xbf, 0x98, 0x7a, 0x1f, 0x9a, 0xf3, 0x80, 0xb1, 0xbb, 0x9c,
+	0x86, 0xb6, 0xa9, 0x22, 0x4a, 0xff, 0xff, 0x7f, 0xe4, 0xd9, 0xff, 0xc8, 0x1b, 0xd8, 0x38, 0x47,
+	0x79, 0xad, 0xfe, 0x69, 0x67, 0xed, 0xfd, 0x6c, 0x42, 0x5b, 0xb8, 0x97, 0x48, 0x17, 0xf1, 0x14,
+	0xc9, 0x10, 0xac, 0x13, 0x59, 0x05, 0xa9, 0x68, 0xfb, 0x15, 0x9b, 0x38, 0x50, 0x3f, 0x47, 0x4e,
+	0x3a, 0xd5, 0x85, 0xd3, 0xef, 0x2a, 0xaf, 0x3c, 0xf5, 0x10, 0x7a, 0xe2, 0x1e, 0x9e, 0xaa, 0x8a,
+	0x3c, 0x79, 0x6d, 0x89, 0x92, 0x54, 0x17, 0x49, 0x7f, 0x1d, 0x63, 0x64, 0x1f, 0x5a, 0x4b, 0xff,
+	0xd9, 0x41, 0x43, 0xb0, 0xbc, 0x79, 0xf8, 0x77, 0x65, 0xbf, 0x02, 0xb8, 0xcc, 0x23, 0x5d, 0x12,
+	0xd1, 0x7b, 0x68, 0x1c, 0xf6, 0x75, 0x1f, 0xea, 0x69, 0x22, 0x6f, 0xe1, 0xa5, 0x50, 0x8f, 0xaa,
+	0x8b, 0x57, 0xcf, 0x43, 0x87, 0x78, 0xa3, 0xfe, 0xd2, 0x22, 0xbb, 0xb0, 0xb5, 0x26, 0x5f, 0x1f,
+	0x4e, 0x59, 0x86, 0x78, 0x43, 0x0e, 0x9e, 0x38, 0x40, 0xd7, 0xb4, 0x1a, 0xb4, 0x5a, 0xd7, 0x0e,
+	0xbc, 0x58, 0x0b, 0x93, 0x9b, 0x6e, 0xad, 0x11, 0xfd, 0xac, 0x7c, 0x06, 0xdd, 0x93, 0x19, 0x4e,
+	0x6f, 0xbc, 0x2c, 0xbe, 0x2d, 0x90, 0x31, 0xb2, 0xdc, 0xbc, 0xa5, 0x50, 0x3f, 0x9d, 0x03, 0xb0,
+	0x46, 0x3f, 0xc4, 0x8c, 0x57, 0x15, 0x95, 0xb9, 0x1d, 0xf7, 0x7e, 0x79, 0x18, 0xd4, 0x7e, 0x7d,
+	0x18, 0xd4, 0x7e, 0x7b, 0x18, 0xd4, 0x7e, 0xfa, 0x7d, 0xf0, 0xce, 0xc4, 0x92, 0xaf, 0xfa, 0xfe,
+	0x9f, 0x03, 0x00, 0x80, 0x39, 0xe0, 0x4f, 0xee, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -921,6 +971,7 @@ type UserServiceClient interface {
 	UserEstablishmentCreate(ctx context.Context, in *UE, opts ...grpc.CallOption) (*UE, error)
 	UserEstablishmentGet(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*UEwU, error)
 	UserEstablishmentDelete(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*DelRes, error)
+	UserEstablishmentList(ctx context.Context, in *Id, opts ...grpc.CallOption) (*UEList, error)
 	CheckUniquess(ctx context.Context, in *FV, opts ...grpc.CallOption) (*Status, error)
 	Exists(ctx context.Context, in *FV, opts ...grpc.CallOption) (*User, error)
 }
@@ -1014,6 +1065,15 @@ func (c *userServiceClient) UserEstablishmentDelete(ctx context.Context, in *Fil
 	return out, nil
 }
 
+func (c *userServiceClient) UserEstablishmentList(ctx context.Context, in *Id, opts ...grpc.CallOption) (*UEList, error) {
+	out := new(UEList)
+	err := c.cc.Invoke(ctx, "/user.UserService/UserEstablishmentList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CheckUniquess(ctx context.Context, in *FV, opts ...grpc.CallOption) (*Status, error) {
 	out := new(Status)
 	err := c.cc.Invoke(ctx, "/user.UserService/CheckUniquess", in, out, opts...)
@@ -1043,6 +1103,7 @@ type UserServiceServer interface {
 	UserEstablishmentCreate(context.Context, *UE) (*UE, error)
 	UserEstablishmentGet(context.Context, *Filter) (*UEwU, error)
 	UserEstablishmentDelete(context.Context, *Filter) (*DelRes, error)
+	UserEstablishmentList(context.Context, *Id) (*UEList, error)
 	CheckUniquess(context.Context, *FV) (*Status, error)
 	Exists(context.Context, *FV) (*User, error)
 }
@@ -1078,6 +1139,9 @@ func (*UnimplementedUserServiceServer) UserEstablishmentGet(ctx context.Context,
 func (*UnimplementedUserServiceServer) UserEstablishmentDelete(ctx context.Context, req *Filter) (*DelRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserEstablishmentDelete not implemented")
 }
+func (*UnimplementedUserServiceServer) UserEstablishmentList(ctx context.Context, req *Id) (*UEList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserEstablishmentList not implemented")
+}
 func (*UnimplementedUserServiceServer) CheckUniquess(ctx context.Context, req *FV) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckUniquess not implemented")
 }
@@ -1251,6 +1315,24 @@ func _UserService_UserEstablishmentDelete_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UserEstablishmentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UserEstablishmentList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/UserEstablishmentList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UserEstablishmentList(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CheckUniquess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FV)
 	if err := dec(in); err != nil {
@@ -1327,6 +1409,10 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserEstablishmentDelete",
 			Handler:    _UserService_UserEstablishmentDelete_Handler,
 		},
+		{
+			MethodName: "UserEstablishmentList",
+			Handler:    _UserService_UserEstablishmentList_Handler,
+		},
 		{
 			MethodName: "CheckUniquess",
 			Handler:    _UserService_CheckUniquess_Handler,
@@ -1561,6 +1647,42 @@ func (m *UEwU) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UEList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UEList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UEList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EstablishmentIds) > 0 {
+		for iNdEx := len(m.EstablishmentIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EstablishmentIds[iNdEx])
+			copy(dAtA[i:], m.EstablishmentIds[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.EstablishmentIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Filter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2098,6 +2220,24 @@ func (m *UEwU) Size() (n int) {
 	return n
 }
 
+func (m *UEList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EstablishmentIds) > 0 {
+		for _, s := range m.EstablishmentIds {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Filter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2868,6 +3008,89 @@ func (m *UEwU) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UEList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UEList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UEList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstablishmentIds = append(m.EstablishmentIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Filter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ go 1.21.6
 require (
 	github.com/golang/protobuf v1.5.4
 	github.com/stretchr/testify v1.8.3
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.42.0
 	go.opentelemetry.io/otel v1.16.0
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.16.0
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.16.0
@@ -1,19 +1,26 @@
 package app
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strconv"
 	"time"
 	pb "Booking/booking-service-booking/genproto/booking-proto"
 	grpc_server "Booking/booking-service-booking/internal/delivery/grpc/server"
 	invest_grpc "Booking/booking-service-booking/internal/delivery/grpc/services"
+	"Booking/booking-service-booking/internal/entity"
 	"Booking/booking-service-booking/internal/infrastructure/grpc_service_clients"
 	"Booking/booking-service-booking/internal/infrastructure/kafka"
+	"Booking/booking-service-booking/internal/infrastructure/payment"
 	repo "Booking/booking-service-booking/internal/infrastructure/repository/postgresql"
 	"Booking/booking-service-booking/internal/pkg/config"
 	"Booking/booking-service-booking/internal/pkg/logger"
 	"Booking/booking-service-booking/internal/pkg/postgres"
+	"Booking/booking-service-booking/internal/pkg/scheduler"
 	"Booking/booking-service-booking/internal/usecase"
 	"Booking/booking-service-booking/internal/usecase/event"
+	payment_usecase "Booking/booking-service-booking/internal/usecase/payment"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
@@ -23,6 +30,10 @@ import (
 	"google.golang.org/grpc"
 )
 
+// schedulerLockKey is the advisory lock the replicas of the service compete
+// for ("booking" in ASCII), the one holding it runs the background jobs
+const schedulerLockKey int64 = 0x626f6f6b696e67
+
 type App struct {
 	Config			*config.Config
 	Logger			*zap.Logger
@@ -31,6 +42,8 @@ type App struct {
 	BookingUsecase	usecase.Booking
 	ServiceClients	grpc_service_clients.ServiceClients
 	BrokerProducer	event.BrokerProducer
+	stopJobs		context.CancelFunc
+	jobsDone		chan struct{}
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -84,6 +97,67 @@ func (a *App) Run() error {
 	if err != nil {
 		return fmt.Errorf("error during parse duration for context timeout : %w", err)
 	}
+	// holiday surcharge percent initialization
+	holidaySurcharge, err := strconv.ParseFloat(a.Config.Pricing.HolidaySurcharge, 64)
+	if err != nil {
+		return fmt.Errorf("error during parse holiday surcharge : %w", err)
+	}
+	// waitlist offer lifetime and sweep interval initialization
+	offerTTL, err := time.ParseDuration(a.Config.Waitlist.OfferTTL)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for waitlist offer ttl : %w", err)
+	}
+	sweepInterval, err := time.ParseDuration(a.Config.Waitlist.SweepInterval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for waitlist sweep interval : %w", err)
+	}
+	// hold lifetime and sweep interval initialization
+	holdTTL, err := time.ParseDuration(a.Config.Hold.TTL)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for hold ttl : %w", err)
+	}
+	holdSweepInterval, err := time.ParseDuration(a.Config.Hold.SweepInterval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for hold sweep interval : %w", err)
+	}
+	// time owners have to answer bookings made on request
+	approvalTTL, err := time.ParseDuration(a.Config.Approval.Timeout)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for booking approval timeout : %w", err)
+	}
+	// external calendar sync interval and fetch timeout initialization
+	calendarSyncInterval, err := time.ParseDuration(a.Config.CalendarSync.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for calendar sync interval : %w", err)
+	}
+	calendarFetchTimeout, err := time.ParseDuration(a.Config.CalendarSync.FetchTimeout)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for calendar fetch timeout : %w", err)
+	}
+	// scheduler interval, no-show grace and reminder lead time initialization
+	schedulerInterval, err := time.ParseDuration(a.Config.Scheduler.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for scheduler interval : %w", err)
+	}
+	noShowAfter := make(map[string]time.Duration)
+	for bookingType, value := range map[string]string{
+		entity.BookingHotel:      a.Config.Scheduler.HotelNoShowAfter,
+		entity.BookingRestaurant: a.Config.Scheduler.RestaurantNoShowAfter,
+		entity.BookingAttraction: a.Config.Scheduler.AttractionNoShowAfter,
+	} {
+		if noShowAfter[bookingType], err = time.ParseDuration(value); err != nil {
+			return fmt.Errorf("error during parse duration for %s no-show grace : %w", bookingType, err)
+		}
+	}
+	reminderBefore, err := time.ParseDuration(a.Config.Scheduler.ReminderBefore)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for reminder lead time : %w", err)
+	}
+	// time zone of reservation times initialization
+	reservationZone, err := time.LoadLocation(a.Config.Reservation.TimeZone)
+	if err != nil {
+		return fmt.Errorf("error during load reservation time zone : %w", err)
+	}
 	// Initialize Service Clients
 	serviceClients, err := grpc_service_clients.New(a.Config)
 	if err != nil {
@@ -95,9 +169,88 @@ func (a *App) Run() error {
 	userRepo := repo.NewBookingRepo(a.DB)
 
 	// usecase initialization
-	userUsecase := usecase.NewBookingService(contextTimeout, userRepo)
+	userUsecase := usecase.NewBookingService(contextTimeout, userRepo, repo.NewWaitlistRepo(a.DB), repo.NewItineraryRepo(a.DB), a.ServiceClients, entity.Pricing{
+		Currency:         a.Config.Pricing.Currency,
+		HolidaySurcharge: holidaySurcharge,
+	}, offerTTL, holdTTL, approvalTTL, reservationZone)
+	a.BookingUsecase = userUsecase
 
-	pb.RegisterBookingServiceServer(a.GrpcServer, invest_grpc.NewRPC(a.Logger, userUsecase, a.BrokerProducer))
+	calendarSyncUsecase := usecase.NewCalendarSyncService(contextTimeout, repo.NewCalendarSyncRepo(a.DB), a.ServiceClients, &http.Client{
+		Timeout: calendarFetchTimeout,
+	})
+	lifecycleUsecase := usecase.NewLifecycleService(contextTimeout, userRepo, a.BrokerProducer, noShowAfter, reminderBefore, reservationZone)
+
+	// background jobs initialization, only the replica holding the scheduler
+	// lock runs them
+	jobs := scheduler.New(a.Logger, a.DB.LeaderLock(schedulerLockKey))
+	jobs.Every("waitlist offers sweep", sweepInterval, func(ctx context.Context) error {
+		expired, err := userUsecase.WaitlistExpireOffers(ctx)
+		if expired > 0 {
+			a.Logger.Info("waitlist offers expired", zap.Int("count", expired))
+		}
+		return err
+	})
+	jobs.Every("booking holds sweep", holdSweepInterval, func(ctx context.Context) error {
+		expired, err := userUsecase.ExpireHolds(ctx)
+		if expired > 0 {
+			a.Logger.Info("booking holds expired", zap.Int("count", expired))
+		}
+		return err
+	})
+	jobs.Every("unanswered booking requests", schedulerInterval, func(ctx context.Context) error {
+		declined, err := userUsecase.DeclineUnanswered(ctx)
+		if declined > 0 {
+			a.Logger.Info("unanswered booking requests declined", zap.Int("count", declined))
+		}
+		return err
+	})
+	jobs.Every("external calendars sync", calendarSyncInterval, func(ctx context.Context) error {
+		synced, err := calendarSyncUsecase.CalendarSyncAll(ctx)
+		if synced > 0 {
+			a.Logger.Info("external calendars synced", zap.Int("count", synced))
+		}
+		return err
+	})
+	jobs.Every("booking no-shows", schedulerInterval, func(ctx context.Context) error {
+		marked, err := lifecycleUsecase.MarkNoShows(ctx)
+		if marked > 0 {
+			a.Logger.Info("bookings marked no-show", zap.Int("count", marked))
+		}
+		return err
+	})
+	jobs.Every("booking completion", schedulerInterval, func(ctx context.Context) error {
+		completed, err := lifecycleUsecase.CompleteBookings(ctx)
+		if completed > 0 {
+			a.Logger.Info("bookings completed", zap.Int("count", completed))
+		}
+		return err
+	})
+	jobs.Every("booking reminders", schedulerInterval, func(ctx context.Context) error {
+		sent, err := lifecycleUsecase.SendReminders(ctx)
+		if sent > 0 {
+			a.Logger.Info("booking reminders sent", zap.Int("count", sent))
+		}
+		return err
+	})
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	a.stopJobs = stopJobs
+	a.jobsDone = make(chan struct{})
+	go func() {
+		defer close(a.jobsDone)
+		jobs.Run(jobsCtx)
+	}()
+
+	var paymentProvider payment_usecase.Provider
+	switch a.Config.Payment.Provider {
+	case "fake":
+		paymentProvider = payment.NewFakeProvider()
+	default:
+		return fmt.Errorf("unknown payment provider %q", a.Config.Payment.Provider)
+	}
+	paymentUsecase := usecase.NewPaymentService(contextTimeout, repo.NewPaymentRepo(a.DB), userRepo, paymentProvider)
+
+	pb.RegisterBookingServiceServer(a.GrpcServer, invest_grpc.NewRPC(a.Logger, userUsecase, paymentUsecase, calendarSyncUsecase, a.BrokerProducer))
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
@@ -106,6 +259,13 @@ func (a *App) Run() error {
 }
 
 func (a *App) Stop() {
+	// stop background jobs, the scheduler gives its lock up before the
+	// database connection closes
+	if a.stopJobs != nil {
+		a.stopJobs()
+		<-a.jobsDone
+	}
+
 	// close broker producer
 	a.BrokerProducer.Close()
 
@@ -11,9 +11,14 @@ import (
 )
 
 var (
-	errNotFound   *entity.ErrNotFound
-	errConflict   *entity.ErrConflict
-	errValidation *entity.ErrValidation
+	errNotFound    *entity.ErrNotFound
+	errConflict    *entity.ErrConflict
+	errOverbooking *entity.ErrOverbooking
+	errTransition  *entity.ErrInvalidTransition
+	errDeclined    *entity.ErrPaymentDeclined
+	errClosed      *entity.ErrCancellationClosed
+	errChange      *entity.ErrChangeClosed
+	errValidation  *entity.ErrValidation
 )
 
 func ErrorStatus(ctx context.Context, err error) *status.Status {
@@ -24,9 +29,27 @@ func ErrorStatus(ctx context.Context, err error) *status.Status {
 	// error not found
 	case errors.As(err, &errNotFound):
 		st = status.New(codes.NotFound, err.Error())
+	// error not found returned by another service
+	case status.Code(err) == codes.NotFound:
+		st = status.New(codes.NotFound, err.Error())
 	// error conflict
 	case errors.As(err, &errConflict):
 		st = status.New(codes.AlreadyExists, err.Error())
+	// error overbooking
+	case errors.As(err, &errOverbooking):
+		st = status.New(codes.FailedPrecondition, err.Error())
+	// error invalid transition
+	case errors.As(err, &errTransition):
+		st = status.New(codes.FailedPrecondition, err.Error())
+	// error cancellation closed
+	case errors.As(err, &errClosed):
+		st = status.New(codes.FailedPrecondition, err.Error())
+	// error change closed
+	case errors.As(err, &errChange):
+		st = status.New(codes.FailedPrecondition, err.Error())
+	// error payment declined
+	case errors.As(err, &errDeclined):
+		st = status.New(codes.Aborted, err.Error())
 	// error validation errors
 	case errors.As(err, &errValidation):
 		st = status.New(codes.InvalidArgument, codes.InvalidArgument.String())
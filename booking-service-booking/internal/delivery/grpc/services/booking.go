@@ -2,6 +2,7 @@ package services
 
 import (
 	pb "Booking/booking-service-booking/genproto/booking-proto"
+	"Booking/booking-service-booking/internal/delivery/grpc"
 	"Booking/booking-service-booking/internal/entity"
 	"Booking/booking-service-booking/internal/pkg/otlp"
 	"Booking/booking-service-booking/internal/usecase"
@@ -17,622 +18,462 @@ import (
 type bookingRPC struct {
 	logger         *zap.Logger
 	bookingUsecase usecase.Booking
+	paymentUsecase usecase.Payment
+	calendarSync   usecase.CalendarSync
 	brokerProducer event.BrokerProducer
 }
 
-func NewRPC(logger *zap.Logger, bookingUsecase usecase.Booking, brokerProducer event.BrokerProducer) pb.BookingServiceServer {
+func NewRPC(logger *zap.Logger, bookingUsecase usecase.Booking, paymentUsecase usecase.Payment, calendarSync usecase.CalendarSync, brokerProducer event.BrokerProducer) pb.BookingServiceServer {
 	return &bookingRPC{
 		logger:         logger,
 		bookingUsecase: bookingUsecase,
+		paymentUsecase: paymentUsecase,
+		calendarSync:   calendarSync,
 		brokerProducer: brokerProducer,
 	}
 }
 
 // CREATE FOR CLIENTS
-func (r *bookingRPC) UHBCreate(ctx context.Context, req *pb.GeneralBook) (*pb.GeneralBook, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UHBCreate")
+func (r *bookingRPC) BookingCreate(ctx context.Context, req *pb.GeneralBook) (*pb.GeneralBook, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingCreate")
 	span.SetAttributes(
 		attribute.Key("createdId").String(req.Id),
+		attribute.Key("BookingType").String(req.BookingType),
 	)
 	defer span.End()
 
-	UHBC, err := r.bookingUsecase.UHBCreate(ctx, &entity.GeneralBooking{
-		Id:             uuid.Must(uuid.Parse(req.Id)),
-		UserId:         req.UserId,
-		HraId:          req.HraId,
-		WillArrive:     req.WillArrive,
-		WillLeave:      req.WillLeave,
-		NumberOfPeople: req.NumberOfPeople,
-		IsCanceled:     req.IsCanceled,
-		Reason:         req.Reason,
-	})
+	booking, err := r.bookingUsecase.Create(ctx, bookingFromPb(req))
 	if err != nil {
-		return nil, err
-	}
-	return &pb.GeneralBook{
-		Id:             UHBC.Id.String(),
-		UserId:         UHBC.UserId,
-		HraId:          UHBC.HraId,
-		WillArrive:     UHBC.WillArrive,
-		WillLeave:      UHBC.WillLeave,
-		NumberOfPeople: UHBC.NumberOfPeople,
-		IsCanceled:     UHBC.IsCanceled,
-		Reason:         UHBC.Reason,
-		CreatedAt:      UHBC.CreatedAt.Format("2006-01-02"),
-	}, nil
+		return nil, grpc.Error(ctx, err)
+	}
+	return bookingToPb(booking), nil
 }
 
-func (r *bookingRPC) URBCreate(ctx context.Context, req *pb.GeneralBook) (*pb.GeneralBook, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "URBCreate")
+// GET
+func (r *bookingRPC) BookingGet(ctx context.Context, req *pb.BookingId) (*pb.GeneralBook, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingGet")
 	span.SetAttributes(
-		attribute.Key("createdId").String(req.Id),
+		attribute.Key("Id").String(req.Id),
+		attribute.Key("BookingType").String(req.BookingType),
 	)
 	defer span.End()
 
-	URBC, err := r.bookingUsecase.URBCreate(ctx, &entity.GeneralBooking{
-		Id:             uuid.Must(uuid.Parse(req.Id)),
-		UserId:         req.UserId,
-		HraId:          req.HraId,
-		WillArrive:     req.WillArrive,
-		NumberOfPeople: req.NumberOfPeople,
-		IsCanceled:     req.IsCanceled,
-		Reason:         req.Reason,
-	})
+	booking, err := r.bookingUsecase.Get(ctx, req.BookingType, req.Id)
 	if err != nil {
-		return nil, err
-	}
-	return &pb.GeneralBook{
-		Id:             URBC.Id.String(),
-		UserId:         URBC.UserId,
-		HraId:          URBC.HraId,
-		WillArrive:     URBC.WillArrive,
-		NumberOfPeople: URBC.NumberOfPeople,
-		IsCanceled:     URBC.IsCanceled,
-		Reason:         URBC.Reason,
-		CreatedAt:      URBC.CreatedAt.Format("2006-01-02"),
-	}, nil
+		return nil, grpc.Error(ctx, err)
+	}
+	return bookingToPb(booking), nil
 }
 
-func (r *bookingRPC) UABCreate(ctx context.Context, req *pb.GeneralBook) (*pb.GeneralBook, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UABCreate")
-	span.SetAttributes(
-		attribute.Key("createdId").String(req.Id),
-	)
+func (r *bookingRPC) BookingGetByCode(ctx context.Context, req *pb.ConfirmationCodeReq) (*pb.GeneralBook, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingGetByCode")
 	defer span.End()
 
-	UABC, err := r.bookingUsecase.UABCreate(ctx, &entity.GeneralBooking{
-		Id:             uuid.Must(uuid.Parse(req.Id)),
-		UserId:         req.UserId,
-		HraId:          req.HraId,
-		WillArrive:     req.WillArrive,
-		NumberOfPeople: req.NumberOfPeople,
-		IsCanceled:     req.IsCanceled,
-		Reason:         req.Reason,
-	})
+	booking, err := r.bookingUsecase.GetByConfirmationCode(ctx, req.Code)
 	if err != nil {
-		return nil, err
-	}
-	return &pb.GeneralBook{
-		Id:             UABC.Id.String(),
-		UserId:         UABC.UserId,
-		HraId:          UABC.HraId,
-		WillArrive:     UABC.WillArrive,
-		NumberOfPeople: UABC.NumberOfPeople,
-		IsCanceled:     UABC.IsCanceled,
-		Reason:         UABC.Reason,
-		CreatedAt:      UABC.CreatedAt.Format("2006-01-02"),
-	}, nil
+		return nil, grpc.Error(ctx, err)
+	}
+	return bookingToPb(booking), nil
 }
 
-// GET ALL BY USER ID FOR CLIENTS
-func (r *bookingRPC) UHBGetAllByUId(ctx context.Context, req *pb.ListReqById) (*pb.ListUserHotelRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UHBGetAllByUId")
+// LIST FOR OWNERS OF ESTABLISHMENTS
+func (r *bookingRPC) BookingListForOwner(ctx context.Context, req *pb.OwnerBookingsReq) (*pb.OwnerBookingsRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingListForOwner")
 	span.SetAttributes(
-		attribute.Key("HId").String(req.Id.Id),
+		attribute.Key("From").String(req.From),
+		attribute.Key("To").String(req.To),
 		attribute.Key("Limit").Int64(int64(req.Limit)),
 		attribute.Key("Offset").Int64(int64(req.Offset)),
 	)
 	defer span.End()
 
-	UHBGA, count, err := r.bookingUsecase.UHBGetAllByUId(ctx, req.Limit, req.Offset, req.Id.Id)
+	bookings, count, err := r.bookingUsecase.ListForOwner(ctx, &entity.OwnerBookingFilter{
+		EstablishmentIds: req.EstablishmentIds,
+		Statuses:         req.Statuses,
+		Limit:            req.Limit,
+		Offset:           req.Offset,
+	}, req.From, req.To)
 	if err != nil {
-		return nil, err
-	}
-	var uhbs []*pb.GeneralBook
-	for _, uhb := range UHBGA {
-		uhbs = append(uhbs, &pb.GeneralBook{
-			Id:             uhb.Id.String(),
-			UserId:         uhb.UserId,
-			HraId:          uhb.HraId,
-			WillArrive:     uhb.WillArrive,
-			WillLeave:      uhb.WillLeave,
-			NumberOfPeople: uhb.NumberOfPeople,
-			IsCanceled:     uhb.IsCanceled,
-			Reason:         uhb.Reason,
-			CreatedAt:      uhb.CreatedAt.Format("2006-01-02"),
-			UpdatedAt:      uhb.UpdatedAt.Format("2006-01-02"),
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.OwnerBookingsRes{Count: count}
+	for _, booking := range bookings {
+		res.Bookings = append(res.Bookings, &pb.OwnerBooking{
+			Booking:         bookingToPb(booking.Booking),
+			EstablishmentId: booking.EstablishmentId,
 		})
 	}
-	return &pb.ListUserHotelRes{
-		UserHotel: uhbs,
-		Count:     count,
-	}, nil
+	return res, nil
 }
 
-func (r *bookingRPC) URBGetAllByUId(ctx context.Context, req *pb.ListReqById) (*pb.ListUserRestaurantRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "URBGetAllByUId")
+// GET ALL BY USER ID FOR CLIENTS
+func (r *bookingRPC) BookingGetAllByUId(ctx context.Context, req *pb.ListReqById) (*pb.ListBookingRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingGetAllByUId")
 	span.SetAttributes(
-		attribute.Key("RId").String(req.Id.Id),
+		attribute.Key("UId").String(req.Id.GetId()),
+		attribute.Key("BookingType").String(req.BookingType),
 		attribute.Key("Limit").Int64(int64(req.Limit)),
 		attribute.Key("Offset").Int64(int64(req.Offset)),
 	)
 	defer span.End()
 
-	URBGA, count, err := r.bookingUsecase.URBGetAllByUId(ctx, req.Limit, req.Offset, req.Id.Id)
+	bookings, count, err := r.bookingUsecase.GetAllByUId(ctx, req.BookingType, req.Limit, req.Offset, req.Id.GetId())
 	if err != nil {
-		return nil, err
-	}
-	var urbs []*pb.GeneralBook
-	for _, urb := range URBGA {
-		urbs = append(urbs, &pb.GeneralBook{
-			Id:             urb.Id.String(),
-			UserId:         urb.UserId,
-			HraId:          urb.HraId,
-			WillArrive:     urb.WillArrive,
-			NumberOfPeople: urb.NumberOfPeople,
-			IsCanceled:     urb.IsCanceled,
-			Reason:         urb.Reason,
-			CreatedAt:      urb.CreatedAt.Format("2006-01-02"),
-			UpdatedAt:      urb.UpdatedAt.Format("2006-01-02"),
-		})
+		return nil, grpc.Error(ctx, err)
 	}
-	return &pb.ListUserRestaurantRes{
-		UserRestaurant: urbs,
-		Count:          count,
-	}, nil
+	return bookingsToPb(bookings, count), nil
 }
 
-func (r *bookingRPC) UABGetAllByUId(ctx context.Context, req *pb.ListReqById) (*pb.ListUserAttractionRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UABGetAllByUId")
+// LIST BY ESTABLISHMENT
+func (r *bookingRPC) BookingListByEstablishment(ctx context.Context, req *pb.EstablishmentBookingsReq) (*pb.ListBookingRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingListByEstablishment")
 	span.SetAttributes(
-		attribute.Key("AId").String(req.Id.Id),
-		attribute.Key("Limit").Int64(int64(req.Limit)),
-		attribute.Key("Offset").Int64(int64(req.Offset)),
+		attribute.Key("EstablishmentId").String(req.EstablishmentId),
+		attribute.Key("BookingType").String(req.BookingType),
 	)
 	defer span.End()
 
-	UABGA, count, err := r.bookingUsecase.UABGetAllByUId(ctx, req.Limit, req.Offset, req.Id.Id)
+	bookings, err := r.bookingUsecase.ListByEstablishment(ctx, req.BookingType, req.EstablishmentId, req.Since)
 	if err != nil {
-		return nil, err
-	}
-	var uabs []*pb.GeneralBook
-	for _, uab := range UABGA {
-		uabs = append(uabs, &pb.GeneralBook{
-			Id:             uab.Id.String(),
-			UserId:         uab.UserId,
-			HraId:          uab.HraId,
-			WillArrive:     uab.WillArrive,
-			NumberOfPeople: uab.NumberOfPeople,
-			IsCanceled:     uab.IsCanceled,
-			Reason:         uab.Reason,
-			CreatedAt:      uab.CreatedAt.Format("2006-01-02"),
-			UpdatedAt:      uab.UpdatedAt.Format("2006-01-02"),
-		})
+		return nil, grpc.Error(ctx, err)
 	}
-	return &pb.ListUserAttractionRes{
-		UserAttraction: uabs,
-		Count:          count,
-	}, nil
+	return bookingsToPb(bookings, int64(len(bookings))), nil
 }
 
-// GET ALL BY HRA ID FOR OWNERS
-func (r *bookingRPC) UHBGetAllByHId(ctx context.Context, req *pb.ListReqById) (*pb.UserId, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UHBGetAllByHId")
+// GET ALL USERS BY HRA ID
+func (r *bookingRPC) BookingGetAllByHraId(ctx context.Context, req *pb.ListReqById) (*pb.UserId, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingGetAllByHraId")
 	span.SetAttributes(
-		attribute.Key("HId").String(req.Id.Id),
+		attribute.Key("HraId").String(req.Id.GetId()),
+		attribute.Key("BookingType").String(req.BookingType),
 		attribute.Key("Limit").Int64(int64(req.Limit)),
 		attribute.Key("Offset").Int64(int64(req.Offset)),
 	)
 	defer span.End()
 
-	UHBGA, count, err := r.bookingUsecase.UHBGetAllByHId(ctx, req.Limit, req.Offset, req.Id.Id)
+	userIds, count, err := r.bookingUsecase.GetAllByHraId(ctx, req.BookingType, req.Limit, req.Offset, req.Id.GetId())
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
-	var uhbs []*pb.Id
-	for _, uhb := range UHBGA {
-		uhbs = append(uhbs, &pb.Id{
-			Id: uhb.UserId,
+	var ids []*pb.Id
+	for _, userId := range userIds {
+		ids = append(ids, &pb.Id{
+			Id: userId.UserId,
 		})
 	}
 	return &pb.UserId{
-		UserId: uhbs,
+		UserId: ids,
 		Count:  count,
 	}, nil
 }
 
-func (r *bookingRPC) URBGetAllByRId(ctx context.Context, req *pb.ListReqById) (*pb.UserId, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "URBGetAllByRId")
+// LIST FOR ADMIN
+func (r *bookingRPC) BookingList(ctx context.Context, req *pb.ListReq) (*pb.ListBookingRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingList")
 	span.SetAttributes(
-		attribute.Key("RId").String(req.Id.Id),
+		attribute.Key("BookingType").String(req.BookingType),
 		attribute.Key("Limit").Int64(int64(req.Limit)),
 		attribute.Key("Offset").Int64(int64(req.Offset)),
 	)
 	defer span.End()
 
-	URBGA, count, err := r.bookingUsecase.URBGetAllByRId(ctx, req.Limit, req.Offset, req.Id.Id)
+	bookings, count, err := r.bookingUsecase.List(ctx, req.BookingType, req.Limit, req.Offset)
 	if err != nil {
-		return nil, err
-	}
-	var urbs []*pb.Id
-	for _, urb := range URBGA {
-		urbs = append(urbs, &pb.Id{
-			Id: urb.UserId,
-		})
+		return nil, grpc.Error(ctx, err)
 	}
-	return &pb.UserId{
-		UserId: urbs,
-		Count:  count,
-	}, nil
+	return bookingsToPb(bookings, count), nil
 }
 
-func (r *bookingRPC) UABGetAllByAId(ctx context.Context, req *pb.ListReqById) (*pb.UserId, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UABGetAllByAId")
+// LIST DELETED FOR ADMIN
+func (r *bookingRPC) BookingListDeleted(ctx context.Context, req *pb.ListReq) (*pb.ListBookingRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingListDeleted")
 	span.SetAttributes(
-		attribute.Key("AId").String(req.Id.Id),
+		attribute.Key("BookingType").String(req.BookingType),
 		attribute.Key("Limit").Int64(int64(req.Limit)),
 		attribute.Key("Offset").Int64(int64(req.Offset)),
 	)
 	defer span.End()
 
-	UABGA, count, err := r.bookingUsecase.UABGetAllByAId(ctx, req.Limit, req.Offset, req.Id.Id)
+	bookings, count, err := r.bookingUsecase.ListDeleted(ctx, req.BookingType, req.Limit, req.Offset)
 	if err != nil {
-		return nil, err
-	}
-	var uabs []*pb.Id
-	for _, uab := range UABGA {
-		uabs = append(uabs, &pb.Id{
-			Id: uab.UserId,
-		})
+		return nil, grpc.Error(ctx, err)
 	}
-	return &pb.UserId{
-		UserId: uabs,
-		Count:  count,
-	}, nil
+	return bookingsToPb(bookings, count), nil
 }
 
-// LIST BOOKINGS FOR ADMIN
-func (r *bookingRPC) UHBList(ctx context.Context, req *pb.ListReq) (*pb.ListUserHotelRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UHBList")
+// UPDATE
+func (r *bookingRPC) BookingUpdate(ctx context.Context, req *pb.GeneralBook) (*pb.GeneralBook, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingUpdate")
 	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(req.Limit)),
-		attribute.Key("Offset").String(fmt.Sprint(req.Offset)),
+		attribute.Key("Id").String(fmt.Sprint(req.Id)),
+		attribute.Key("BookingType").String(req.BookingType),
 	)
 	defer span.End()
 
-	println("something=======================================")
-	UHBGA, count, err := r.bookingUsecase.UHBList(ctx, req.Limit, req.Offset)
+	update := bookingFromPb(req)
+	if !req.GuestsSet {
+		update.Guests = nil
+	}
+	if !req.AddOnsSet {
+		update.AddOns = nil
+	}
+	if !req.SpecialRequestsSet {
+		update.SpecialRequests = nil
+	} else if update.SpecialRequests == nil {
+		update.SpecialRequests = []string{}
+	}
+
+	booking, err := r.bookingUsecase.Update(ctx, update)
 	if err != nil {
-		return nil, err
-	}
-	var uhbs []*pb.GeneralBook
-	for _, uhb := range UHBGA {
-		uhbs = append(uhbs, &pb.GeneralBook{
-			Id:             uhb.Id.String(),
-			UserId:         uhb.UserId,
-			HraId:          uhb.HraId,
-			WillArrive:     uhb.WillArrive,
-			WillLeave:      uhb.WillLeave,
-			NumberOfPeople: uhb.NumberOfPeople,
-			IsCanceled:     uhb.IsCanceled,
-			Reason:         uhb.Reason,
-			CreatedAt:      uhb.CreatedAt.Format("2006-01-02"),
-			UpdatedAt:      uhb.UpdatedAt.Format("2006-01-02"),
-		})
+		return nil, grpc.Error(ctx, err)
 	}
-	return &pb.ListUserHotelRes{
-		UserHotel: uhbs,
-		Count:     count,
-	}, nil
+	return bookingToPb(booking), nil
 }
 
-func (r *bookingRPC) URBList(ctx context.Context, req *pb.ListReq) (*pb.ListUserRestaurantRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "URBList")
+// DELETE
+func (r *bookingRPC) BookingDelete(ctx context.Context, req *pb.BookingId) (*pb.DelRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "BookingDelete")
 	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(req.Limit)),
-		attribute.Key("Offset").String(fmt.Sprint(req.Offset)),
+		attribute.Key("Id").String(req.Id),
+		attribute.Key("BookingType").String(req.BookingType),
 	)
 	defer span.End()
 
-	URBGA, count, err := r.bookingUsecase.URBList(ctx, req.Limit, req.Offset)
+	err := r.bookingUsecase.Delete(ctx, req.BookingType, req.Id)
 	if err != nil {
-		return nil, err
-	}
-	var urbs []*pb.GeneralBook
-	for _, urb := range URBGA {
-		urbs = append(urbs, &pb.GeneralBook{
-			Id:             urb.Id.String(),
-			UserId:         urb.UserId,
-			HraId:          urb.HraId,
-			WillArrive:     urb.WillArrive,
-			NumberOfPeople: urb.NumberOfPeople,
-			IsCanceled:     urb.IsCanceled,
-			Reason:         urb.Reason,
-			CreatedAt:      urb.CreatedAt.Format("2006-01-02"),
-			UpdatedAt:      urb.UpdatedAt.Format("2006-01-02"),
-		})
+		return nil, grpc.Error(ctx, err)
 	}
-	return &pb.ListUserRestaurantRes{
-		UserRestaurant: urbs,
-		Count:          count,
-	}, nil
+	return &pb.DelRes{Result: "Successfully deleted"}, nil
 }
 
-func (r *bookingRPC) UABList(ctx context.Context, req *pb.ListReq) (*pb.ListUserAttractionRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UABList")
-	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(req.Limit)),
-		attribute.Key("Offset").String(fmt.Sprint(req.Offset)),
-	)
-	defer span.End()
+func bookingFromPb(req *pb.GeneralBook) *entity.GeneralBooking {
+	return &entity.GeneralBooking{
+		Id:             uuid.Must(uuid.Parse(req.Id)),
+		BookingType:    req.BookingType,
+		UserId:         req.UserId,
+		HraId:          req.HraId,
+		WillArrive:     req.WillArrive,
+		WillLeave:      req.WillLeave,
+		NumberOfPeople: req.NumberOfPeople,
+		Reason:         req.Reason,
+		AdultTickets:   req.AdultTickets,
+		ChildTickets:   req.ChildTickets,
+		Guests:         guestsFromPb(req.Guests),
+		AddOns:         addOnsFromPb(req.AddOns),
 
-	UABGA, count, err := r.bookingUsecase.UABList(ctx, req.Limit, req.Offset)
-	if err != nil {
-		return nil, err
-	}
-	var uabs []*pb.GeneralBook
-	for _, uab := range UABGA {
-		uabs = append(uabs, &pb.GeneralBook{
-			Id:             uab.Id.String(),
-			UserId:         uab.UserId,
-			HraId:          uab.HraId,
-			WillArrive:     uab.WillArrive,
-			NumberOfPeople: uab.NumberOfPeople,
-			IsCanceled:     uab.IsCanceled,
-			Reason:         uab.Reason,
-			CreatedAt:      uab.CreatedAt.Format("2006-01-02"),
-			UpdatedAt:      uab.UpdatedAt.Format("2006-01-02"),
-		})
+		SpecialRequests:    req.SpecialRequests,
+		SpecialRequestNote: req.SpecialRequestNote,
 	}
-	return &pb.ListUserAttractionRes{
-		UserAttraction: uabs,
-		Count:          count,
-	}, nil
 }
 
-// LIST DELETED BOOKINGS FOR ADMIN
-func (r *bookingRPC) UHBListDeleted(ctx context.Context, req *pb.ListReq) (*pb.ListUserHotelRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UHBListDeleted")
-	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(req.Limit)),
-		attribute.Key("Offset").String(fmt.Sprint(req.Offset)),
-	)
-	defer span.End()
-
-	UHBGA, count, err := r.bookingUsecase.UHBListDeleted(ctx, req.Limit, req.Offset)
-	if err != nil {
-		return nil, err
-	}
-	var uhbs []*pb.GeneralBook
-	for _, uhb := range UHBGA {
-		uhbs = append(uhbs, &pb.GeneralBook{
-			Id:             uhb.Id.String(),
-			UserId:         uhb.UserId,
-			HraId:          uhb.HraId,
-			WillArrive:     uhb.WillArrive,
-			WillLeave:      uhb.WillLeave,
-			NumberOfPeople: uhb.NumberOfPeople,
-			IsCanceled:     uhb.IsCanceled,
-			Reason:         uhb.Reason,
-			CreatedAt:      uhb.CreatedAt.Format("2006-01-02"),
-			UpdatedAt:      uhb.UpdatedAt.Format("2006-01-02"),
+// addOnsFromPb takes the add-ons selected, they are priced from the catalogue
+// of the establishment
+func addOnsFromPb(addOns []*pb.BookingAddOn) []entity.BookingAddOn {
+	res := make([]entity.BookingAddOn, 0, len(addOns))
+	for _, addOn := range addOns {
+		res = append(res, entity.BookingAddOn{
+			AddOnId:  addOn.AddOnId,
+			Quantity: addOn.Quantity,
 		})
 	}
-	return &pb.ListUserHotelRes{
-		UserHotel: uhbs,
-		Count:     count,
-	}, nil
+	return res
 }
 
-func (r *bookingRPC) URBListDeleted(ctx context.Context, req *pb.ListReq) (*pb.ListUserRestaurantRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "URBListDeleted")
-	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(req.Limit)),
-		attribute.Key("Offset").String(fmt.Sprint(req.Offset)),
-	)
-	defer span.End()
-
-	URBGA, count, err := r.bookingUsecase.URBListDeleted(ctx, req.Limit, req.Offset)
-	if err != nil {
-		return nil, err
-	}
-	var urbs []*pb.GeneralBook
-	for _, urb := range URBGA {
-		urbs = append(urbs, &pb.GeneralBook{
-			Id:             urb.Id.String(),
-			UserId:         urb.UserId,
-			HraId:          urb.HraId,
-			WillArrive:     urb.WillArrive,
-			NumberOfPeople: urb.NumberOfPeople,
-			IsCanceled:     urb.IsCanceled,
-			Reason:         urb.Reason,
-			CreatedAt:      urb.CreatedAt.Format("2006-01-02"),
-			UpdatedAt:      urb.UpdatedAt.Format("2006-01-02"),
+func guestsFromPb(guests []*pb.BookingGuest) []entity.BookingGuest {
+	res := make([]entity.BookingGuest, 0, len(guests))
+	for _, guest := range guests {
+		res = append(res, entity.BookingGuest{
+			FullName:       guest.FullName,
+			Age:            guest.Age,
+			DocumentNumber: guest.DocumentNumber,
 		})
 	}
-	return &pb.ListUserRestaurantRes{
-		UserRestaurant: urbs,
-		Count:          count,
-	}, nil
+	return res
 }
 
-func (r *bookingRPC) UABListDeleted(ctx context.Context, req *pb.ListReq) (*pb.ListUserAttractionRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UABListDeleted")
-	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(req.Limit)),
-		attribute.Key("Offset").String(fmt.Sprint(req.Offset)),
-	)
-	defer span.End()
-
-	UABGA, count, err := r.bookingUsecase.UABListDeleted(ctx, req.Limit, req.Offset)
-	if err != nil {
-		return nil, err
-	}
-	var uabs []*pb.GeneralBook
-	for _, uab := range UABGA {
-		uabs = append(uabs, &pb.GeneralBook{
-			Id:             uab.Id.String(),
-			UserId:         uab.UserId,
-			HraId:          uab.HraId,
-			WillArrive:     uab.WillArrive,
-			NumberOfPeople: uab.NumberOfPeople,
-			IsCanceled:     uab.IsCanceled,
-			Reason:         uab.Reason,
-			CreatedAt:      uab.CreatedAt.Format("2006-01-02"),
-			UpdatedAt:      uab.UpdatedAt.Format("2006-01-02"),
+func bookingToPb(booking *entity.GeneralBooking) *pb.GeneralBook {
+	res := &pb.GeneralBook{
+		Id:               booking.Id.String(),
+		BookingType:      booking.BookingType,
+		UserId:           booking.UserId,
+		HraId:            booking.HraId,
+		WillArrive:       booking.WillArrive,
+		WillLeave:        booking.WillLeave,
+		NumberOfPeople:   booking.NumberOfPeople,
+		IsCanceled:       booking.Status == entity.BookingCancelled,
+		Status:           booking.Status,
+		Reason:           booking.Reason,
+		AdultTickets:     booking.AdultTickets,
+		ChildTickets:     booking.ChildTickets,
+		TotalPrice:       booking.TotalPrice,
+		Currency:         booking.Currency,
+		ItineraryId:      booking.ItineraryId,
+		CreatedAt:        booking.CreatedAt.Format("2006-01-02"),
+		ConfirmationCode: booking.ConfirmationCode,
+
+		SpecialRequests:    booking.SpecialRequests,
+		SpecialRequestNote: booking.SpecialRequestNote,
+	}
+	for _, guest := range booking.Guests {
+		res.Guests = append(res.Guests, &pb.BookingGuest{
+			FullName:       guest.FullName,
+			Age:            guest.Age,
+			DocumentNumber: guest.DocumentNumber,
 		})
 	}
-	return &pb.ListUserAttractionRes{
-		UserAttraction: uabs,
-		Count:          count,
-	}, nil
+	for _, addOn := range booking.AddOns {
+		res.AddOns = append(res.AddOns, &pb.BookingAddOn{
+			AddOnId:   addOn.AddOnId,
+			Name:      addOn.Name,
+			Quantity:  addOn.Quantity,
+			UnitPrice: addOn.UnitPrice,
+			Total:     addOn.Total,
+		})
+	}
+	if !booking.UpdatedAt.IsZero() {
+		res.UpdatedAt = booking.UpdatedAt.Format("2006-01-02")
+	}
+	if !booking.HoldExpiresAt.IsZero() {
+		res.HoldExpiresAt = booking.HoldExpiresAt.Format("2006-01-02T15:04:05")
+	}
+	if !booking.ApprovalDueAt.IsZero() {
+		res.ApprovalDueAt = booking.ApprovalDueAt.Format("2006-01-02T15:04:05")
+	}
+	return res
 }
 
-// UPDATE FOR CLIENTS
-func (r *bookingRPC) UHBUpdate(ctx context.Context, req *pb.GeneralBook) (*pb.GeneralBook, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UHBUpdate")
-	span.SetAttributes(
-		attribute.Key("Id").String(fmt.Sprint(req.Id)),
-	)
-	defer span.End()
-
-	UHBU, err := r.bookingUsecase.UHBUpdate(ctx, &entity.GeneralBooking{
-		Id:             uuid.Must(uuid.Parse(req.Id)),
-		UserId:         req.UserId,
-		HraId:          req.HraId,
-		WillArrive:     req.WillArrive,
-		WillLeave:      req.WillLeave,
-		NumberOfPeople: req.NumberOfPeople,
-		IsCanceled:     req.IsCanceled,
-		Reason:         req.Reason,
-	})
-	if err != nil {
-		return nil, err
-	}
-	return &pb.GeneralBook{
-		Id:             UHBU.Id.String(),
-		UserId:         UHBU.UserId,
-		HraId:          UHBU.HraId,
-		WillArrive:     UHBU.WillArrive,
-		WillLeave:      UHBU.WillLeave,
-		NumberOfPeople: UHBU.NumberOfPeople,
-		IsCanceled:     UHBU.IsCanceled,
-		Reason:         UHBU.Reason,
-		CreatedAt:      UHBU.CreatedAt.Format("2006-01-02"),
-	}, nil
+func bookingsToPb(bookings []*entity.GeneralBooking, count int64) *pb.ListBookingRes {
+	res := &pb.ListBookingRes{
+		Count: count,
+	}
+	for _, booking := range bookings {
+		res.Bookings = append(res.Bookings, bookingToPb(booking))
+	}
+	return res
 }
 
-func (r *bookingRPC) URBUpdate(ctx context.Context, req *pb.GeneralBook) (*pb.GeneralBook, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "URBUpdate")
+// AVAILABILITY
+func (r *bookingRPC) SearchAvailableHotels(ctx context.Context, req *pb.AvailabilityReq) (*pb.AvailabilityRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "SearchAvailableHotels")
 	span.SetAttributes(
-		attribute.Key("Id").String(fmt.Sprint(req.Id)),
+		attribute.Key("city").String(req.City),
 	)
 	defer span.End()
 
-	URBU, err := r.bookingUsecase.URBUpdate(ctx, &entity.GeneralBooking{
-		Id:             uuid.Must(uuid.Parse(req.Id)),
-		UserId:         req.UserId,
-		HraId:          req.HraId,
-		WillArrive:     req.WillArrive,
-		NumberOfPeople: req.NumberOfPeople,
-		IsCanceled:     req.IsCanceled,
-		Reason:         req.Reason,
+	hotels, err := r.bookingUsecase.SearchAvailableHotels(ctx, &entity.AvailabilityFilter{
+		City:       req.City,
+		WillArrive: req.WillArrive,
+		WillLeave:  req.WillLeave,
+		PartySize:  req.PartySize,
 	})
 	if err != nil {
-		return nil, err
-	}
-	return &pb.GeneralBook{
-		Id:             URBU.Id.String(),
-		UserId:         URBU.UserId,
-		HraId:          URBU.HraId,
-		WillArrive:     URBU.WillArrive,
-		NumberOfPeople: URBU.NumberOfPeople,
-		IsCanceled:     URBU.IsCanceled,
-		Reason:         URBU.Reason,
-		CreatedAt:      URBU.CreatedAt.Format("2006-01-02"),
-	}, nil
-}
-
-func (r *bookingRPC) UABUpdate(ctx context.Context, req *pb.GeneralBook) (*pb.GeneralBook, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UABUpdate")
-	span.SetAttributes(
-		attribute.Key("Id").String(fmt.Sprint(req.Id)),
-	)
-	defer span.End()
+		return nil, grpc.Error(ctx, err)
+	}
+
+	var res pb.AvailabilityRes
+	for _, hotel := range hotels {
+		res.Hotels = append(res.Hotels, &pb.AvailableHotel{
+			HotelId:         hotel.HotelId,
+			HotelName:       hotel.HotelName,
+			Rating:          hotel.Rating,
+			Address:         hotel.Address,
+			City:            hotel.City,
+			RoomId:          hotel.RoomId,
+			RoomDescription: hotel.RoomDescription,
+			RoomCapacity:    hotel.RoomCapacity,
+			NightlyPrice:    hotel.NightlyPrice,
+			FreeRooms:       hotel.FreeRooms,
+		})
+	}
+	res.Count = int64(len(res.Hotels))
 
-	UABU, err := r.bookingUsecase.UABUpdate(ctx, &entity.GeneralBooking{
-		Id:             uuid.Must(uuid.Parse(req.Id)),
-		UserId:         req.UserId,
-		HraId:          req.HraId,
-		WillArrive:     req.WillArrive,
-		NumberOfPeople: req.NumberOfPeople,
-		IsCanceled:     req.IsCanceled,
-		Reason:         req.Reason,
-	})
-	if err != nil {
-		return nil, err
-	}
-	return &pb.GeneralBook{
-		Id:             UABU.Id.String(),
-		UserId:         UABU.UserId,
-		HraId:          UABU.HraId,
-		WillArrive:     UABU.WillArrive,
-		NumberOfPeople: UABU.NumberOfPeople,
-		IsCanceled:     UABU.IsCanceled,
-		Reason:         UABU.Reason,
-		CreatedAt:      UABU.CreatedAt.Format("2006-01-02"),
-	}, nil
+	return &res, nil
 }
 
-// SOFT DELETE FOR CLIENTS
-func (r *bookingRPC) UHBDelete(ctx context.Context, req *pb.Id) (*pb.DelRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UHBDelete")
+func (r *bookingRPC) URBFreeSlots(ctx context.Context, req *pb.FreeSlotsReq) (*pb.FreeSlotsRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "URBFreeSlots")
 	span.SetAttributes(
-		attribute.Key("Id").String(fmt.Sprint(req.Id)),
+		attribute.Key("restaurant_id").String(req.RestaurantId),
+		attribute.Key("date").String(req.Date),
 	)
 	defer span.End()
 
-	err := r.bookingUsecase.UHBDelete(ctx, req.Id)
+	slots, seatCapacity, err := r.bookingUsecase.URBFreeSlots(ctx, req.RestaurantId, req.Date)
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
-	return &pb.DelRes{Result: "Successfully deleted"}, nil
+
+	res := pb.FreeSlotsRes{
+		SeatCapacity: seatCapacity,
+	}
+	for _, slot := range slots {
+		res.Slots = append(res.Slots, &pb.Slot{
+			Start:     slot.Start,
+			End:       slot.End,
+			FreeSeats: slot.FreeSeats,
+		})
+	}
+
+	return &res, nil
 }
 
-func (r *bookingRPC) URBDelete(ctx context.Context, req *pb.Id) (*pb.DelRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "URBDelete")
+func (r *bookingRPC) UABRemainingQuota(ctx context.Context, req *pb.QuotaReq) (*pb.QuotaRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "UABRemainingQuota")
 	span.SetAttributes(
-		attribute.Key("Id").String(fmt.Sprint(req.Id)),
+		attribute.Key("attraction_id").String(req.AttractionId),
+		attribute.Key("date").String(req.Date),
 	)
 	defer span.End()
 
-	err := r.bookingUsecase.URBDelete(ctx, req.Id)
+	quota, err := r.bookingUsecase.UABRemainingQuota(ctx, req.AttractionId, req.Date)
 	if err != nil {
-		return nil, err
+		return nil, grpc.Error(ctx, err)
 	}
-	return &pb.DelRes{Result: "Successfully deleted"}, nil
+
+	res := pb.QuotaRes{
+		DailyQuota:     quota.DailyQuota,
+		DailyRemaining: quota.DailyRemaining,
+		WindowQuota:    quota.WindowQuota,
+	}
+	for _, window := range quota.Windows {
+		res.Windows = append(res.Windows, &pb.EntryWindow{
+			Start:     window.Start,
+			End:       window.End,
+			Remaining: window.Remaining,
+		})
+	}
+
+	return &res, nil
 }
 
-func (r *bookingRPC) UABDelete(ctx context.Context, req *pb.Id) (*pb.DelRes, error) {
-	ctx, span := otlp.Start(ctx, "Delivery", "UABDelete")
+func (r *bookingRPC) UHBQuote(ctx context.Context, req *pb.GeneralBook) (*pb.PriceQuote, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "UHBQuote")
 	span.SetAttributes(
-		attribute.Key("Id").String(fmt.Sprint(req.Id)),
+		attribute.Key("room_id").String(req.HraId),
+		attribute.Key("will_arrive").String(req.WillArrive),
+		attribute.Key("will_leave").String(req.WillLeave),
 	)
 	defer span.End()
 
-	err := r.bookingUsecase.UABDelete(ctx, req.Id)
+	quote, err := r.bookingUsecase.UHBQuote(ctx, &entity.GeneralBooking{
+		HraId:          req.HraId,
+		WillArrive:     req.WillArrive,
+		WillLeave:      req.WillLeave,
+		NumberOfPeople: req.NumberOfPeople,
+	})
 	if err != nil {
-		return nil, err
-	}
-	return &pb.DelRes{Result: "Successfully deleted"}, nil
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.PriceQuote{
+		Nights:        quote.Nights,
+		HolidayNights: quote.HolidayNights,
+		NightlyPrice:  quote.NightlyPrice,
+		Subtotal:      quote.Subtotal,
+		Surcharge:     quote.Surcharge,
+		Discount:      quote.Discount,
+		Total:         quote.Total,
+		Currency:      quote.Currency,
+	}, nil
 }
@@ -0,0 +1,122 @@
+package services
+
+import (
+	pb "Booking/booking-service-booking/genproto/booking-proto"
+	"Booking/booking-service-booking/internal/delivery/grpc"
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (r *bookingRPC) CalendarSourceCreate(ctx context.Context, req *pb.CalendarSource) (*pb.CalendarSource, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "CalendarSourceCreate")
+	span.SetAttributes(
+		attribute.Key("room_id").String(req.RoomId),
+	)
+	defer span.End()
+
+	source, err := r.calendarSync.CalendarSourceCreate(ctx, &entity.CalendarSource{
+		RoomId: req.RoomId,
+		Name:   req.Name,
+		Url:    req.Url,
+	}, req.Content)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return calendarSourceToPb(source), nil
+}
+
+func (r *bookingRPC) CalendarSourceList(ctx context.Context, req *pb.CalendarSourceListReq) (*pb.CalendarSourceListRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "CalendarSourceList")
+	span.SetAttributes(
+		attribute.Key("room_id").String(req.RoomId),
+	)
+	defer span.End()
+
+	sources, err := r.calendarSync.CalendarSourceList(ctx, req.RoomId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.CalendarSourceListRes{}
+	for _, source := range sources {
+		res.Sources = append(res.Sources, calendarSourceToPb(source))
+	}
+	return res, nil
+}
+
+func (r *bookingRPC) CalendarSourceSync(ctx context.Context, req *pb.CalendarSourceReq) (*pb.CalendarSource, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "CalendarSourceSync")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	source, err := r.calendarSync.CalendarSourceSync(ctx, req.Id, req.Content)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return calendarSourceToPb(source), nil
+}
+
+func (r *bookingRPC) CalendarSourceDelete(ctx context.Context, req *pb.CalendarSourceReq) (*pb.DelRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "CalendarSourceDelete")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	if err := r.calendarSync.CalendarSourceDelete(ctx, req.Id); err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.DelRes{Result: "Successfully deleted"}, nil
+}
+
+func (r *bookingRPC) ExternalBlockList(ctx context.Context, req *pb.ExternalBlockListReq) (*pb.ExternalBlockListRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "ExternalBlockList")
+	span.SetAttributes(
+		attribute.Key("room_id").String(req.RoomId),
+	)
+	defer span.End()
+
+	blocks, err := r.calendarSync.ExternalBlockList(ctx, req.RoomId, req.From, req.To)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.ExternalBlockListRes{}
+	for _, block := range blocks {
+		res.Blocks = append(res.Blocks, &pb.ExternalBlock{
+			Id:       block.Id,
+			SourceId: block.SourceId,
+			RoomId:   block.RoomId,
+			Uid:      block.Uid,
+			Summary:  block.Summary,
+			StartsOn: block.StartsOn.Format("2006-01-02"),
+			EndsOn:   block.EndsOn.Format("2006-01-02"),
+		})
+	}
+	return res, nil
+}
+
+func calendarSourceToPb(source *entity.CalendarSource) *pb.CalendarSource {
+	res := &pb.CalendarSource{
+		Id:        source.Id,
+		RoomId:    source.RoomId,
+		Name:      source.Name,
+		Url:       source.Url,
+		LastError: source.LastError,
+		Blocks:    source.Blocks,
+		CreatedAt: source.CreatedAt.Format("2006-01-02T15:04:05"),
+		UpdatedAt: source.UpdatedAt.Format("2006-01-02T15:04:05"),
+	}
+	if !source.LastSyncedAt.IsZero() {
+		res.LastSyncedAt = source.LastSyncedAt.Format("2006-01-02T15:04:05")
+	}
+	return res
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	pb "Booking/booking-service-booking/genproto/booking-proto"
+	"Booking/booking-service-booking/internal/delivery/grpc"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (r *bookingRPC) HoldCreate(ctx context.Context, req *pb.GeneralBook) (*pb.GeneralBook, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "HoldCreate")
+	span.SetAttributes(
+		attribute.Key("createdId").String(req.Id),
+		attribute.Key("BookingType").String(req.BookingType),
+	)
+	defer span.End()
+
+	booking, err := r.bookingUsecase.Hold(ctx, bookingFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return bookingToPb(booking), nil
+}
+
+func (r *bookingRPC) HoldConfirm(ctx context.Context, req *pb.HoldReq) (*pb.GeneralBook, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "HoldConfirm")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	booking, err := r.bookingUsecase.HoldConfirm(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return bookingToPb(booking), nil
+}
+
+func (r *bookingRPC) HoldRelease(ctx context.Context, req *pb.HoldReq) (*pb.GeneralBook, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "HoldRelease")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	booking, err := r.bookingUsecase.HoldRelease(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return bookingToPb(booking), nil
+}
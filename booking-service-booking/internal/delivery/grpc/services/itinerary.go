@@ -0,0 +1,112 @@
+package services
+
+import (
+	pb "Booking/booking-service-booking/genproto/booking-proto"
+	"Booking/booking-service-booking/internal/delivery/grpc"
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (r *bookingRPC) ItineraryCreate(ctx context.Context, req *pb.Itinerary) (*pb.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "ItineraryCreate")
+	span.SetAttributes(
+		attribute.Key("user_id").String(req.UserId),
+	)
+	defer span.End()
+
+	itinerary := &entity.Itinerary{
+		UserId: req.UserId,
+	}
+	// the bookings get their ids once the itinerary is made
+	for _, booking := range req.Bookings {
+		itinerary.Bookings = append(itinerary.Bookings, &entity.GeneralBooking{
+			BookingType:    booking.BookingType,
+			HraId:          booking.HraId,
+			WillArrive:     booking.WillArrive,
+			WillLeave:      booking.WillLeave,
+			NumberOfPeople: booking.NumberOfPeople,
+			Reason:         booking.Reason,
+			AdultTickets:   booking.AdultTickets,
+			ChildTickets:   booking.ChildTickets,
+			Guests:         guestsFromPb(booking.Guests),
+			AddOns:         addOnsFromPb(booking.AddOns),
+
+			SpecialRequests:    booking.SpecialRequests,
+			SpecialRequestNote: booking.SpecialRequestNote,
+		})
+	}
+
+	itinerary, err := r.bookingUsecase.ItineraryCreate(ctx, itinerary)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return itineraryToPb(itinerary), nil
+}
+
+func (r *bookingRPC) ItineraryGet(ctx context.Context, req *pb.ItineraryReq) (*pb.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "ItineraryGet")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	itinerary, err := r.bookingUsecase.ItineraryGet(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return itineraryToPb(itinerary), nil
+}
+
+func (r *bookingRPC) ItineraryList(ctx context.Context, req *pb.ItineraryListReq) (*pb.ItineraryListRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "ItineraryList")
+	span.SetAttributes(
+		attribute.Key("user_id").String(req.UserId),
+	)
+	defer span.End()
+
+	itineraries, err := r.bookingUsecase.ItineraryList(ctx, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.ItineraryListRes{}
+	for _, itinerary := range itineraries {
+		res.Itineraries = append(res.Itineraries, itineraryToPb(itinerary))
+	}
+
+	return res, nil
+}
+
+func (r *bookingRPC) ItineraryCancel(ctx context.Context, req *pb.ItineraryReq) (*pb.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "ItineraryCancel")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	itinerary, err := r.bookingUsecase.ItineraryCancel(ctx, req.Id, req.Reason, req.ChangedBy, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return itineraryToPb(itinerary), nil
+}
+
+func itineraryToPb(itinerary *entity.Itinerary) *pb.Itinerary {
+	res := &pb.Itinerary{
+		Id:        itinerary.Id,
+		UserId:    itinerary.UserId,
+		Status:    itinerary.Status,
+		CreatedAt: itinerary.CreatedAt.Format("2006-01-02T15:04:05"),
+		UpdatedAt: itinerary.UpdatedAt.Format("2006-01-02T15:04:05"),
+	}
+	for _, booking := range itinerary.Bookings {
+		res.Bookings = append(res.Bookings, bookingToPb(booking))
+	}
+	return res
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	pb "Booking/booking-service-booking/genproto/booking-proto"
+	"Booking/booking-service-booking/internal/delivery/grpc"
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (r *bookingRPC) PaymentCreate(ctx context.Context, req *pb.PayReq) (*pb.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "PaymentCreate")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.BookingId),
+	)
+	defer span.End()
+
+	payment, err := r.paymentUsecase.PaymentCreate(ctx, req.BookingId, req.UserId, req.CardToken)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return paymentToPb(payment), nil
+}
+
+func (r *bookingRPC) PaymentGet(ctx context.Context, req *pb.Id) (*pb.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "PaymentGet")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.Id),
+	)
+	defer span.End()
+
+	payment, err := r.paymentUsecase.PaymentGet(ctx, req.Id)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return paymentToPb(payment), nil
+}
+
+func (r *bookingRPC) PaymentRefund(ctx context.Context, req *pb.Id) (*pb.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "PaymentRefund")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.Id),
+	)
+	defer span.End()
+
+	payment, err := r.paymentUsecase.PaymentRefund(ctx, req.Id)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return paymentToPb(payment), nil
+}
+
+func (r *bookingRPC) PaymentWebhook(ctx context.Context, req *pb.PaymentEvent) (*pb.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "PaymentWebhook")
+	span.SetAttributes(
+		attribute.Key("event").String(req.Event),
+		attribute.Key("provider_ref").String(req.ProviderRef),
+	)
+	defer span.End()
+
+	payment, err := r.paymentUsecase.PaymentWebhook(ctx, &entity.PaymentEvent{
+		Event:       req.Event,
+		ProviderRef: req.ProviderRef,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return paymentToPb(payment), nil
+}
+
+func paymentToPb(payment *entity.Payment) *pb.Payment {
+	res := &pb.Payment{
+		PaymentId:      payment.Id,
+		BookingId:      payment.BookingId,
+		UserId:         payment.UserId,
+		Amount:         payment.Amount,
+		Currency:       payment.Currency,
+		Status:         payment.Status,
+		RefundedAmount: payment.RefundedAmount,
+		Provider:       payment.Provider,
+		ProviderRef:    payment.ProviderRef,
+		CreatedAt:      payment.CreatedAt.Format("2006-01-02T15:04:05"),
+		UpdatedAt:      payment.UpdatedAt.Format("2006-01-02T15:04:05"),
+	}
+	for _, attempt := range payment.Attempts {
+		res.Attempts = append(res.Attempts, &pb.PaymentAttempt{
+			AttemptId: attempt.Id,
+			Action:    attempt.Action,
+			Status:    attempt.Status,
+			Error:     attempt.Error,
+			CreatedAt: attempt.CreatedAt.Format("2006-01-02T15:04:05"),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	pb "Booking/booking-service-booking/genproto/booking-proto"
+	"Booking/booking-service-booking/internal/delivery/grpc"
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (r *bookingRPC) Confirm(ctx context.Context, req *pb.StatusReq) (*pb.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "Confirm")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.BookingId),
+	)
+	defer span.End()
+
+	change, err := r.bookingUsecase.Confirm(ctx, statusChangeFromPb(req), req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return statusChangeToPb(change), nil
+}
+
+func (r *bookingRPC) Cancel(ctx context.Context, req *pb.StatusReq) (*pb.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "Cancel")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.BookingId),
+	)
+	defer span.End()
+
+	change, err := r.bookingUsecase.Cancel(ctx, statusChangeFromPb(req), req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return statusChangeToPb(change), nil
+}
+
+func (r *bookingRPC) CheckIn(ctx context.Context, req *pb.StatusReq) (*pb.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "CheckIn")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.BookingId),
+	)
+	defer span.End()
+
+	change, err := r.bookingUsecase.CheckIn(ctx, statusChangeFromPb(req), req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return statusChangeToPb(change), nil
+}
+
+func (r *bookingRPC) Accept(ctx context.Context, req *pb.StatusReq) (*pb.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "Accept")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.BookingId),
+	)
+	defer span.End()
+
+	change, err := r.bookingUsecase.Accept(ctx, statusChangeFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return statusChangeToPb(change), nil
+}
+
+func (r *bookingRPC) Decline(ctx context.Context, req *pb.StatusReq) (*pb.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "Decline")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.BookingId),
+	)
+	defer span.End()
+
+	change, err := r.bookingUsecase.Decline(ctx, statusChangeFromPb(req))
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return statusChangeToPb(change), nil
+}
+
+func (r *bookingRPC) Reschedule(ctx context.Context, req *pb.RescheduleReq) (*pb.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "Reschedule")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.BookingId),
+	)
+	defer span.End()
+
+	change, err := r.bookingUsecase.Reschedule(ctx, &entity.Reschedule{
+		BookingId:      req.BookingId,
+		BookingType:    req.BookingType,
+		WillArrive:     req.WillArrive,
+		WillLeave:      req.WillLeave,
+		NumberOfPeople: req.NumberOfPeople,
+		AdultTickets:   req.AdultTickets,
+		ChildTickets:   req.ChildTickets,
+		Reason:         req.Reason,
+		ChangedBy:      req.ChangedBy,
+	}, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return statusChangeToPb(change), nil
+}
+
+func (r *bookingRPC) StatusHistory(ctx context.Context, req *pb.StatusHistoryReq) (*pb.StatusHistoryRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "StatusHistory")
+	span.SetAttributes(
+		attribute.Key("booking_id").String(req.BookingId),
+	)
+	defer span.End()
+
+	status, changes, err := r.bookingUsecase.StatusHistory(ctx, req.BookingType, req.BookingId, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.StatusHistoryRes{
+		Status: status,
+	}
+	for _, change := range changes {
+		res.Changes = append(res.Changes, statusChangeToPb(change))
+	}
+
+	return res, nil
+}
+
+func statusChangeFromPb(req *pb.StatusReq) *entity.StatusChange {
+	return &entity.StatusChange{
+		BookingId:   req.BookingId,
+		BookingType: req.BookingType,
+		Reason:      req.Reason,
+		ChangedBy:   req.ChangedBy,
+	}
+}
+
+func statusChangeToPb(change *entity.StatusChange) *pb.StatusChange {
+	return &pb.StatusChange{
+		Id:            change.Id,
+		BookingId:     change.BookingId,
+		BookingType:   change.BookingType,
+		FromStatus:    change.From,
+		ToStatus:      change.To,
+		Reason:        change.Reason,
+		ChangedBy:     change.ChangedBy,
+		CreatedAt:     change.CreatedAt.Format("2006-01-02T15:04:05"),
+		RefundPercent: change.RefundPercent,
+		RefundAmount:  change.RefundAmount,
+		Currency:      change.Currency,
+
+		FromWillArrive:  change.FromWillArrive,
+		FromWillLeave:   change.FromWillLeave,
+		ToWillArrive:    change.ToWillArrive,
+		ToWillLeave:     change.ToWillLeave,
+		PriceDifference: change.PriceDifference,
+		ChangeFee:       change.ChangeFee,
+	}
+}
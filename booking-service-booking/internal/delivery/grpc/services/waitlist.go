@@ -0,0 +1,119 @@
+package services
+
+import (
+	pb "Booking/booking-service-booking/genproto/booking-proto"
+	"Booking/booking-service-booking/internal/delivery/grpc"
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (r *bookingRPC) WaitlistJoin(ctx context.Context, req *pb.WaitlistEntry) (*pb.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "WaitlistJoin")
+	span.SetAttributes(
+		attribute.Key("hra_id").String(req.HraId),
+	)
+	defer span.End()
+
+	entry, err := r.bookingUsecase.WaitlistJoin(ctx, &entity.WaitlistEntry{
+		BookingType:    req.BookingType,
+		UserId:         req.UserId,
+		HraId:          req.HraId,
+		WillArrive:     req.WillArrive,
+		WillLeave:      req.WillLeave,
+		NumberOfPeople: req.NumberOfPeople,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return waitlistEntryToPb(entry), nil
+}
+
+func (r *bookingRPC) WaitlistList(ctx context.Context, req *pb.WaitlistListReq) (*pb.WaitlistListRes, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "WaitlistList")
+	span.SetAttributes(
+		attribute.Key("user_id").String(req.UserId),
+	)
+	defer span.End()
+
+	entries, err := r.bookingUsecase.WaitlistList(ctx, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	res := &pb.WaitlistListRes{}
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, waitlistEntryToPb(entry))
+	}
+
+	return res, nil
+}
+
+func (r *bookingRPC) WaitlistLeave(ctx context.Context, req *pb.WaitlistReq) (*pb.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "WaitlistLeave")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	entry, err := r.bookingUsecase.WaitlistLeave(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return waitlistEntryToPb(entry), nil
+}
+
+func (r *bookingRPC) WaitlistAccept(ctx context.Context, req *pb.WaitlistReq) (*pb.GeneralBook, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "WaitlistAccept")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	booking, err := r.bookingUsecase.WaitlistAccept(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return bookingToPb(booking), nil
+}
+
+func (r *bookingRPC) WaitlistDecline(ctx context.Context, req *pb.WaitlistReq) (*pb.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "WaitlistDecline")
+	span.SetAttributes(
+		attribute.Key("id").String(req.Id),
+	)
+	defer span.End()
+
+	entry, err := r.bookingUsecase.WaitlistDecline(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return waitlistEntryToPb(entry), nil
+}
+
+func waitlistEntryToPb(entry *entity.WaitlistEntry) *pb.WaitlistEntry {
+	res := &pb.WaitlistEntry{
+		Id:             entry.Id,
+		BookingType:    entry.BookingType,
+		UserId:         entry.UserId,
+		HraId:          entry.HraId,
+		WillArrive:     entry.WillArrive,
+		WillLeave:      entry.WillLeave,
+		NumberOfPeople: entry.NumberOfPeople,
+		Reason:         entry.Reason,
+		Status:         entry.Status,
+		BookingId:      entry.BookingId,
+		CreatedAt:      entry.CreatedAt.Format("2006-01-02T15:04:05"),
+	}
+	if !entry.OfferExpiresAt.IsZero() {
+		res.OfferExpiresAt = entry.OfferExpiresAt.Format("2006-01-02T15:04:05")
+	}
+	return res
+}
@@ -0,0 +1,54 @@
+package entity
+
+// BookingAddOn is an extra of the establishment booked along with a booking.
+// Its name and price are copied from the catalogue of the establishment when
+// it is booked, so later changes to the catalogue leave the booking alone.
+type BookingAddOn struct {
+	AddOnId   string
+	Name      string
+	Quantity  int64
+	UnitPrice float64
+	Total     float64
+}
+
+// SetUnitPrice prices the add-on at price for each of its quantity
+func (a *BookingAddOn) SetUnitPrice(price float64) {
+	a.UnitPrice = price
+	a.Total = roundPrice(price * float64(a.Quantity))
+}
+
+// AddOnsTotal is what the add-ons of a booking cost together
+func AddOnsTotal(addOns []BookingAddOn) float64 {
+	total := 0.0
+	for _, addOn := range addOns {
+		total += addOn.Total
+	}
+	return roundPrice(total)
+}
+
+// WithAddOns is total with the add-ons priced on top
+func WithAddOns(total float64, addOns []BookingAddOn) float64 {
+	return roundPrice(total + AddOnsTotal(addOns))
+}
+
+// SameAddOns reports whether a and b select the same quantities of the same
+// add-ons, in any order
+func SameAddOns(a, b []BookingAddOn) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	quantities := make(map[string]int64, len(a))
+	for _, addOn := range a {
+		quantities[addOn.AddOnId] += addOn.Quantity
+	}
+	for _, addOn := range b {
+		quantities[addOn.AddOnId] -= addOn.Quantity
+	}
+	for _, quantity := range quantities {
+		if quantity != 0 {
+			return false
+		}
+	}
+	return true
+}
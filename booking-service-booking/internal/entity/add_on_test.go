@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddOnsTotal(t *testing.T) {
+	breakfast := BookingAddOn{AddOnId: "breakfast", Quantity: 3}
+	breakfast.SetUnitPrice(12.35)
+	assert.Equal(t, 12.35, breakfast.UnitPrice)
+	assert.Equal(t, 37.05, breakfast.Total)
+
+	parking := BookingAddOn{AddOnId: "parking", Quantity: 2}
+	parking.SetUnitPrice(0.1)
+	assert.Equal(t, 0.2, parking.Total)
+
+	assert.Equal(t, 37.25, AddOnsTotal([]BookingAddOn{breakfast, parking}))
+	assert.Equal(t, 0.0, AddOnsTotal(nil))
+	assert.Equal(t, 137.25, WithAddOns(100, []BookingAddOn{breakfast, parking}))
+}
+
+func TestSameAddOns(t *testing.T) {
+	breakfast := BookingAddOn{AddOnId: "breakfast", Quantity: 2}
+	parking := BookingAddOn{AddOnId: "parking", Quantity: 1}
+
+	assert.True(t, SameAddOns(nil, []BookingAddOn{}))
+	assert.True(t, SameAddOns([]BookingAddOn{breakfast, parking}, []BookingAddOn{parking, breakfast}))
+
+	assert.False(t, SameAddOns([]BookingAddOn{breakfast}, []BookingAddOn{breakfast, parking}))
+	assert.False(t, SameAddOns([]BookingAddOn{breakfast}, []BookingAddOn{{AddOnId: "breakfast", Quantity: 3}}))
+	assert.False(t, SameAddOns([]BookingAddOn{breakfast}, []BookingAddOn{parking}))
+}
@@ -0,0 +1,53 @@
+package entity
+
+type AvailabilityFilter struct {
+	City       string
+	WillArrive string
+	WillLeave  string
+	PartySize  int64
+}
+
+type AvailableHotel struct {
+	HotelId         string
+	HotelName       string
+	Rating          float32
+	Address         string
+	City            string
+	RoomId          string
+	RoomDescription string
+	RoomCapacity    int64
+	NightlyPrice    float64
+	FreeRooms       int64
+}
+
+type FreeSlot struct {
+	Start     string
+	End       string
+	FreeSeats int64
+}
+
+type TicketQuota struct {
+	Daily  int64
+	Window int64
+}
+
+// Capacity bounds what an establishment takes at the same time, only the
+// field matching the booking type is checked
+type Capacity struct {
+	Rooms   int64
+	Seats   int64
+	Tickets TicketQuota
+}
+
+type EntryWindow struct {
+	Start     string
+	End       string
+	Remaining int64
+}
+
+type AttractionQuota struct {
+	DailyQuota     int64
+	DailyRemaining int64
+	WindowQuota    int64
+	Windows        []*EntryWindow
+}
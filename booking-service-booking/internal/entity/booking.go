@@ -8,13 +8,26 @@ import (
 
 type GeneralBooking struct {
 	Id uuid.UUID
+	BookingType string
 	UserId string
 	HraId string
 	WillArrive string
 	WillLeave string
 	NumberOfPeople int64
-	IsCanceled bool
+	Status string
 	Reason string
+	AdultTickets int64
+	ChildTickets int64
+	Guests []BookingGuest
+	SpecialRequests []string
+	SpecialRequestNote string
+	AddOns []BookingAddOn
+	TotalPrice float64
+	Currency string
+	ItineraryId string
+	HoldExpiresAt time.Time
+	ApprovalDueAt time.Time
+	ConfirmationCode string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt time.Time
@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+// CalendarSource is an iCalendar feed of another platform a hotel room is
+// also listed on. Sources with a url are synced again periodically, uploaded
+// files are imported once and replaced by uploading them again.
+type CalendarSource struct {
+	Id           string
+	RoomId       string
+	Name         string
+	Url          string
+	LastSyncedAt time.Time
+	LastError    string
+	Blocks       int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// ExternalBlock keeps one room of a room type taken for the nights from
+// StartsOn up to, but not including, EndsOn
+type ExternalBlock struct {
+	Id       string
+	SourceId string
+	RoomId   string
+	Uid      string
+	Summary  string
+	StartsOn time.Time
+	EndsOn   time.Time
+}
+
+// BlockNights turns the start and end of a calendar event into the nights it
+// takes a room for. The end works like a check-out, the night of its day stays
+// free, and an event without an end or ending on its first day takes one night.
+func BlockNights(start, end time.Time) (startsOn, endsOn time.Time) {
+	startsOn = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	endsOn = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	if end.IsZero() || !endsOn.After(startsOn) {
+		endsOn = startsOn.AddDate(0, 0, 1)
+	}
+	return startsOn, endsOn
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockNights(t *testing.T) {
+	day := func(value string) time.Time {
+		parsed, err := time.Parse("2006-01-02 15:04", value)
+		assert.NoError(t, err)
+		return parsed
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		startsOn   time.Time
+		endsOn     time.Time
+	}{
+		{
+			name:     "all day stay",
+			start:    day("2030-05-01 00:00"),
+			end:      day("2030-05-04 00:00"),
+			startsOn: day("2030-05-01 00:00"),
+			endsOn:   day("2030-05-04 00:00"),
+		},
+		{
+			name:     "timed stay ends on check-out day",
+			start:    day("2030-05-01 15:00"),
+			end:      day("2030-05-03 11:00"),
+			startsOn: day("2030-05-01 00:00"),
+			endsOn:   day("2030-05-03 00:00"),
+		},
+		{
+			name:     "event within one day takes its night",
+			start:    day("2030-05-01 09:00"),
+			end:      day("2030-05-01 17:00"),
+			startsOn: day("2030-05-01 00:00"),
+			endsOn:   day("2030-05-02 00:00"),
+		},
+		{
+			name:     "event without an end takes one night",
+			start:    day("2030-05-01 00:00"),
+			startsOn: day("2030-05-01 00:00"),
+			endsOn:   day("2030-05-02 00:00"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startsOn, endsOn := BlockNights(tt.start, tt.end)
+			assert.Equal(t, tt.startsOn, startsOn)
+			assert.Equal(t, tt.endsOn, endsOn)
+		})
+	}
+}
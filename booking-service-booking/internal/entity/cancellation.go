@@ -0,0 +1,84 @@
+package entity
+
+import "time"
+
+// CancellationRule refunds RefundPercent of the price to guests who cancel at
+// least HoursBefore hours before their arrival. Guests moving their booking
+// that early pay ChangeFeePercent of the price instead.
+type CancellationRule struct {
+	HoursBefore      int64
+	RefundPercent    float64
+	ChangeFeePercent float64
+}
+
+// CancellationRefund returns the percent of the price refunded when a booking
+// is cancelled at now for an arrival at arrive. The rule with the latest
+// deadline that was not missed applies, no rules at all mean a free
+// cancellation. It fails with *ErrCancellationClosed when every deadline was
+// missed.
+func CancellationRefund(rules []*CancellationRule, arrive, now time.Time) (float64, error) {
+	if len(rules) == 0 {
+		return 100, nil
+	}
+
+	applied, deadline := applicableRule(rules, arrive, now)
+	if applied == nil {
+		return 0, NewErrCancellationClosed(deadline)
+	}
+
+	return applied.RefundPercent, nil
+}
+
+// ChangeFee returns the percent of the price charged for moving a booking at
+// now away from an arrival at arrive. The same rule as for a cancellation
+// applies, no rules at all mean a free change. It fails with *ErrChangeClosed
+// when every deadline was missed.
+func ChangeFee(rules []*CancellationRule, arrive, now time.Time) (float64, error) {
+	if len(rules) == 0 {
+		return 0, nil
+	}
+
+	applied, deadline := applicableRule(rules, arrive, now)
+	if applied == nil {
+		return 0, NewErrChangeClosed(deadline)
+	}
+
+	return applied.ChangeFeePercent, nil
+}
+
+// applicableRule returns the rule with the latest deadline that was not missed
+// at now, or nil together with the earliest deadline when all of them were
+func applicableRule(rules []*CancellationRule, arrive, now time.Time) (*CancellationRule, int64) {
+	hoursLeft := arrive.Sub(now).Hours()
+
+	var (
+		applied  *CancellationRule
+		deadline int64 = -1
+	)
+	for _, rule := range rules {
+		if float64(rule.HoursBefore) <= hoursLeft && (applied == nil || rule.HoursBefore > applied.HoursBefore) {
+			applied = rule
+		}
+		if deadline < 0 || rule.HoursBefore < deadline {
+			deadline = rule.HoursBefore
+		}
+	}
+
+	return applied, deadline
+}
+
+// RefundAmount is the part of price refunded at percent, rounded to cents
+func RefundAmount(price, percent float64) float64 {
+	return roundPrice(price * percent / 100)
+}
+
+// ChangeFeeAmount is the fee charged at percent of price, rounded to cents
+func ChangeFeeAmount(price, percent float64) float64 {
+	return roundPrice(price * percent / 100)
+}
+
+// PriceDifference is what a guest owes when the price of a booking changes
+// from one price to another, negative when the booking became cheaper
+func PriceDifference(from, to float64) float64 {
+	return roundPrice(to - from)
+}
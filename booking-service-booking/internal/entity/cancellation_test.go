@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancellationRefund(t *testing.T) {
+	// free cancellation until 48h before arrival, 50% after
+	rules := []*CancellationRule{
+		{HoursBefore: 0, RefundPercent: 50},
+		{HoursBefore: 48, RefundPercent: 100},
+	}
+	arrive := time.Date(2030, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	percent, err := CancellationRefund(rules, arrive, arrive.Add(-72*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 100.0, percent)
+
+	percent, err = CancellationRefund(rules, arrive, arrive.Add(-48*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 100.0, percent)
+
+	percent, err = CancellationRefund(rules, arrive, arrive.Add(-47*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 50.0, percent)
+
+	// too late once the guest should have arrived
+	_, err = CancellationRefund(rules, arrive, arrive.Add(time.Minute))
+	var errClosed *ErrCancellationClosed
+	assert.ErrorAs(t, err, &errClosed)
+
+	// non-refundable but cancellable until a day before
+	_, err = CancellationRefund([]*CancellationRule{{HoursBefore: 24, RefundPercent: 0}}, arrive, arrive.Add(-12*time.Hour))
+	assert.ErrorAs(t, err, &errClosed)
+
+	percent, err = CancellationRefund(nil, arrive, arrive.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 100.0, percent)
+}
+
+func TestRefundAmount(t *testing.T) {
+	assert.Equal(t, 60.0, RefundAmount(120, 50))
+	assert.Equal(t, 33.33, RefundAmount(100, 33.333))
+	assert.Equal(t, 0.0, RefundAmount(0, 100))
+}
+
+func TestChangeFee(t *testing.T) {
+	// free changes until 48h before arrival, 10% after
+	rules := []*CancellationRule{
+		{HoursBefore: 0, RefundPercent: 50, ChangeFeePercent: 10},
+		{HoursBefore: 48, RefundPercent: 100},
+	}
+	arrive := time.Date(2030, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	percent, err := ChangeFee(rules, arrive, arrive.Add(-72*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, percent)
+
+	percent, err = ChangeFee(rules, arrive, arrive.Add(-47*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, percent)
+
+	// too late once the guest should have arrived
+	_, err = ChangeFee(rules, arrive, arrive.Add(time.Minute))
+	var errClosed *ErrChangeClosed
+	assert.ErrorAs(t, err, &errClosed)
+
+	percent, err = ChangeFee(nil, arrive, arrive.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, percent)
+
+	assert.Equal(t, 12.35, ChangeFeeAmount(123.45, 10))
+	assert.Equal(t, -0.3, PriceDifference(100.5, 100.2))
+}
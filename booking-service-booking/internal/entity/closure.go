@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var closureWeekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// Closure is a period an establishment takes no bookings in. Without weekdays
+// it covers every day from Start to End, with weekdays only those days of the
+// week. A zero Start or End leaves that side of the closure open.
+type Closure struct {
+	Start    time.Time
+	End      time.Time
+	Weekdays map[time.Weekday]bool
+	Reason   string
+}
+
+// ParseClosure parses a closure from the calendar of an establishment,
+// dates are in YYYY-MM-DD format and may be empty
+func ParseClosure(start, end string, weekdays []string, reason string) (Closure, error) {
+	closure := Closure{
+		Weekdays: make(map[time.Weekday]bool, len(weekdays)),
+		Reason:   reason,
+	}
+
+	var err error
+	if start != "" {
+		if closure.Start, err = time.Parse("2006-01-02", start); err != nil {
+			return closure, fmt.Errorf("invalid closure start %q", start)
+		}
+	}
+	if end != "" {
+		if closure.End, err = time.Parse("2006-01-02", end); err != nil {
+			return closure, fmt.Errorf("invalid closure end %q", end)
+		}
+	}
+	for _, name := range weekdays {
+		weekday, ok := closureWeekdays[strings.ToLower(name)]
+		if !ok {
+			return closure, fmt.Errorf("invalid closure weekday %q", name)
+		}
+		closure.Weekdays[weekday] = true
+	}
+
+	return closure, nil
+}
+
+// Covers reports whether the establishment is closed on day
+func (c Closure) Covers(day time.Time) bool {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	if !c.Start.IsZero() && day.Before(c.Start) {
+		return false
+	}
+	if !c.End.IsZero() && day.After(c.End) {
+		return false
+	}
+	if len(c.Weekdays) != 0 {
+		return c.Weekdays[day.Weekday()]
+	}
+	// a closure without weekdays needs both dates
+	return !c.Start.IsZero() && !c.End.IsZero()
+}
+
+// FirstClosedDay returns the first day from the day of from up to, but not
+// including, the day of until that one of closures covers
+func FirstClosedDay(closures []Closure, from, until time.Time) (time.Time, Closure, bool) {
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, time.UTC)
+	for ; day.Before(last); day = day.AddDate(0, 0, 1) {
+		for _, closure := range closures {
+			if closure.Covers(day) {
+				return day, closure, true
+			}
+		}
+	}
+
+	return time.Time{}, Closure{}, false
+}
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosure(t *testing.T) {
+	day := func(value string) time.Time {
+		date, err := time.Parse("2006-01-02", value)
+		assert.NoError(t, err)
+		return date
+	}
+
+	renovation, err := ParseClosure("2030-02-01", "2030-02-14", nil, "renovation")
+	assert.NoError(t, err)
+	assert.False(t, renovation.Covers(day("2030-01-31")))
+	assert.True(t, renovation.Covers(day("2030-02-01")))
+	assert.True(t, renovation.Covers(day("2030-02-14")))
+	assert.False(t, renovation.Covers(day("2030-02-15")))
+
+	// the time of a restaurant reservation does not matter
+	assert.True(t, renovation.Covers(time.Date(2030, 2, 14, 21, 30, 0, 0, time.UTC)))
+
+	// 2030-02-04 is a monday
+	mondays, err := ParseClosure("", "", []string{"Monday"}, "day off")
+	assert.NoError(t, err)
+	assert.True(t, mondays.Covers(day("2030-02-04")))
+	assert.False(t, mondays.Covers(day("2030-02-05")))
+	assert.True(t, mondays.Covers(day("2031-02-03")))
+
+	// recurring closures are bounded by their dates when they have them
+	winterMondays, err := ParseClosure("2030-01-01", "2030-02-28", []string{"monday"}, "")
+	assert.NoError(t, err)
+	assert.True(t, winterMondays.Covers(day("2030-02-04")))
+	assert.False(t, winterMondays.Covers(day("2030-03-04")))
+
+	_, err = ParseClosure("2030-02-31", "", nil, "")
+	assert.Error(t, err)
+	_, err = ParseClosure("", "", []string{"someday"}, "")
+	assert.Error(t, err)
+
+	closures := []Closure{renovation, mondays}
+
+	// the night of the departure day is not spent at the hotel
+	_, _, closed := FirstClosedDay(closures, day("2030-01-29"), day("2030-02-01"))
+	assert.False(t, closed)
+
+	closedDay, closure, closed := FirstClosedDay(closures, day("2030-01-25"), day("2030-02-03"))
+	assert.True(t, closed)
+	assert.Equal(t, day("2030-01-28"), closedDay)
+	assert.Equal(t, "day off", closure.Reason)
+
+	closedDay, closure, closed = FirstClosedDay(closures, day("2030-01-29"), day("2030-02-03"))
+	assert.True(t, closed)
+	assert.Equal(t, day("2030-02-01"), closedDay)
+	assert.Equal(t, "renovation", closure.Reason)
+}
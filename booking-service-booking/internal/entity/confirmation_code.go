@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+const (
+	// confirmation codes leave out 0, O, 1, I and L, which are easily mixed
+	// up when a code is read out over the phone
+	confirmationCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+	ConfirmationCodeLength   = 8
+)
+
+// NewConfirmationCode returns a random code guests can read out to identify
+// their booking
+func NewConfirmationCode() (string, error) {
+	code := make([]byte, ConfirmationCodeLength)
+	max := big.NewInt(int64(len(confirmationCodeAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = confirmationCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// NormalizeConfirmationCode reads a code the way guests tend to write it down,
+// in lower case or split by spaces or dashes. It reports false when the
+// result can not be a confirmation code.
+func NormalizeConfirmationCode(code string) (string, bool) {
+	code = strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code))
+	if len(code) != ConfirmationCodeLength {
+		return "", false
+	}
+	for _, r := range code {
+		if !strings.ContainsRune(confirmationCodeAlphabet, r) {
+			return "", false
+		}
+	}
+	return code, true
+}
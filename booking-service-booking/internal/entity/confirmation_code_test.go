@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConfirmationCode(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		code, err := NewConfirmationCode()
+		assert.NoError(t, err)
+		assert.Len(t, code, ConfirmationCodeLength)
+		assert.NotContains(t, code, "0")
+		assert.NotContains(t, code, "O")
+		assert.NotContains(t, code, "1")
+		assert.NotContains(t, code, "I")
+		assert.NotContains(t, code, "L")
+
+		normalized, ok := NormalizeConfirmationCode(code)
+		assert.True(t, ok)
+		assert.Equal(t, code, normalized)
+
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+}
+
+func TestNormalizeConfirmationCode(t *testing.T) {
+	code, ok := NormalizeConfirmationCode("abcd-2345")
+	assert.True(t, ok)
+	assert.Equal(t, "ABCD2345", code)
+
+	code, ok = NormalizeConfirmationCode(" AB CD 23 45 ")
+	assert.True(t, ok)
+	assert.Equal(t, "ABCD2345", code)
+
+	_, ok = NormalizeConfirmationCode("ABCD234")
+	assert.False(t, ok)
+	_, ok = NormalizeConfirmationCode("ABCD2340")
+	assert.False(t, ok)
+	_, ok = NormalizeConfirmationCode("")
+	assert.False(t, ok)
+}
@@ -36,6 +36,73 @@ func NewErrConflict(text string) *ErrConflict {
 	return &ErrConflict{text}
 }
 
+// error overbooking
+type ErrOverbooking struct {
+	name string
+}
+
+func (e *ErrOverbooking) Error() string {
+	return e.name + " is fully booked for the requested dates"
+}
+
+func NewErrOverbooking(text string) *ErrOverbooking {
+	return &ErrOverbooking{text}
+}
+
+// error invalid transition
+type ErrInvalidTransition struct {
+	name string
+	from string
+	to   string
+}
+
+func (e *ErrInvalidTransition) Error() string {
+	return e.name + " can not move from " + e.from + " to " + e.to
+}
+
+func NewErrInvalidTransition(name, from, to string) *ErrInvalidTransition {
+	return &ErrInvalidTransition{name: name, from: from, to: to}
+}
+
+// error payment declined
+type ErrPaymentDeclined struct {
+	reason string
+}
+
+func (e *ErrPaymentDeclined) Error() string {
+	return "payment declined: " + e.reason
+}
+
+func NewErrPaymentDeclined(reason string) *ErrPaymentDeclined {
+	return &ErrPaymentDeclined{reason}
+}
+
+// error cancellation closed
+type ErrCancellationClosed struct {
+	hoursBefore int64
+}
+
+func (e *ErrCancellationClosed) Error() string {
+	return fmt.Sprintf("booking can be cancelled only until %d hours before arrival", e.hoursBefore)
+}
+
+func NewErrCancellationClosed(hoursBefore int64) *ErrCancellationClosed {
+	return &ErrCancellationClosed{hoursBefore}
+}
+
+// error change closed
+type ErrChangeClosed struct {
+	hoursBefore int64
+}
+
+func (e *ErrChangeClosed) Error() string {
+	return fmt.Sprintf("booking can be changed only until %d hours before arrival", e.hoursBefore)
+}
+
+func NewErrChangeClosed(hoursBefore int64) *ErrChangeClosed {
+	return &ErrChangeClosed{hoursBefore}
+}
+
 // error validation
 type ErrValidation struct {
 	Err    error
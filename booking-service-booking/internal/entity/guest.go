@@ -0,0 +1,37 @@
+package entity
+
+// special requests a guest can make with a booking
+const (
+	RequestLateArrival  = "late_arrival"
+	RequestEarlyCheckIn = "early_check_in"
+	RequestLateCheckOut = "late_check_out"
+	RequestCrib         = "crib"
+	RequestExtraBed     = "extra_bed"
+	RequestAccessible   = "accessible"
+	RequestQuiet        = "quiet"
+	RequestHighChair    = "high_chair"
+)
+
+var specialRequests = map[string]bool{
+	RequestLateArrival:  true,
+	RequestEarlyCheckIn: true,
+	RequestLateCheckOut: true,
+	RequestCrib:         true,
+	RequestExtraBed:     true,
+	RequestAccessible:   true,
+	RequestQuiet:        true,
+	RequestHighChair:    true,
+}
+
+// IsSpecialRequest reports whether code is a special request establishments understand
+func IsSpecialRequest(code string) bool {
+	return specialRequests[code]
+}
+
+// BookingGuest is one person of the party of a booking. Hotels need the names,
+// ages and document numbers of their guests to register them.
+type BookingGuest struct {
+	FullName       string
+	Age            int64
+	DocumentNumber string
+}
@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSpecialRequest(t *testing.T) {
+	assert.True(t, IsSpecialRequest(RequestLateArrival))
+	assert.True(t, IsSpecialRequest(RequestCrib))
+
+	assert.False(t, IsSpecialRequest(""))
+	assert.False(t, IsSpecialRequest("Crib"))
+	assert.False(t, IsSpecialRequest("jacuzzi"))
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// itinerary statuses
+const (
+	ItineraryPending   = "pending"
+	ItineraryConfirmed = "confirmed"
+	ItineraryFailed    = "failed"
+	ItineraryCancelled = "cancelled"
+)
+
+var itineraryTransitions = map[string][]string{
+	ItineraryPending:   {ItineraryConfirmed, ItineraryFailed},
+	ItineraryConfirmed: {ItineraryCancelled},
+}
+
+// CanMoveItinerary reports whether an itinerary in status from may move to status to
+func CanMoveItinerary(from, to string) bool {
+	for _, next := range itineraryTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Itinerary books the hotel stay, restaurant dinners and attraction tickets
+// of one trip together. It is pending while its bookings are made one by one
+// and confirmed once all of them are. When one of them fails the ones already
+// made are cancelled again and the itinerary fails as a whole.
+type Itinerary struct {
+	Id        string
+	UserId    string
+	Status    string
+	Bookings  []*GeneralBooking
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
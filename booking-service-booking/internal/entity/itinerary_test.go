@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanMoveItinerary(t *testing.T) {
+	assert.True(t, CanMoveItinerary(ItineraryPending, ItineraryConfirmed))
+	assert.True(t, CanMoveItinerary(ItineraryPending, ItineraryFailed))
+	assert.True(t, CanMoveItinerary(ItineraryConfirmed, ItineraryCancelled))
+
+	assert.False(t, CanMoveItinerary(ItineraryPending, ItineraryCancelled))
+	assert.False(t, CanMoveItinerary(ItineraryConfirmed, ItineraryFailed))
+	assert.False(t, CanMoveItinerary(ItineraryFailed, ItineraryCancelled))
+	assert.False(t, CanMoveItinerary(ItineraryCancelled, ItineraryConfirmed))
+}
@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReservationLayout is the layout of restaurant reservation times
+const ReservationLayout = "2006-01-02 15:04"
+
+// ReservationClock is the time on the clocks of establishments in zone when
+// it is now. Reservation times are written in that local time and read as if
+// they were UTC, the clock is labelled UTC the same way so the two compare.
+func ReservationClock(now time.Time, zone *time.Location) time.Time {
+	local := now.In(zone)
+	return time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), local.Second(), local.Nanosecond(), time.UTC)
+}
+
+// TimeRange is an opening range as offsets from midnight.
+// Close may exceed 24h when the range ends after midnight.
+type TimeRange struct {
+	Open  time.Duration
+	Close time.Duration
+}
+
+type OpeningHours []TimeRange
+
+// ParseOpeningHours parses opening hours such as "09:00-22:00" or
+// "12:00 - 15:00, 18:00 - 02:00". A range closing at or before its opening
+// time ends on the next day. Empty opening hours mean open around the clock.
+func ParseOpeningHours(value string) (OpeningHours, error) {
+	if strings.TrimSpace(value) == "" {
+		return OpeningHours{{Open: 0, Close: 24 * time.Hour}}, nil
+	}
+
+	var hours OpeningHours
+	for _, part := range strings.Split(value, ",") {
+		bounds := strings.Split(part, "-")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid opening hours range %q", strings.TrimSpace(part))
+		}
+
+		open, err := parseClock(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		closing, err := parseClock(bounds[1])
+		if err != nil {
+			return nil, err
+		}
+		if closing <= open {
+			closing += 24 * time.Hour
+		}
+
+		hours = append(hours, TimeRange{Open: open, Close: closing})
+	}
+
+	return hours, nil
+}
+
+func parseClock(value string) (time.Duration, error) {
+	clock, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid opening hours time %q", strings.TrimSpace(value))
+	}
+
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute, nil
+}
+
+// Fits reports whether [start, start+length) lies within a single opening range
+// of the day the range opens on
+func (h OpeningHours) Fits(start time.Time, length time.Duration) bool {
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	for _, days := range []int{0, -1} {
+		midnight := day.AddDate(0, 0, days)
+		for _, r := range h {
+			if !start.Before(midnight.Add(r.Open)) && !start.Add(length).After(midnight.Add(r.Close)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Slots lists the starts of consecutive slots of the given length opening on date
+func (h OpeningHours) Slots(date time.Time, length time.Duration) []time.Time {
+	var starts []time.Time
+	if length <= 0 {
+		return starts
+	}
+
+	midnight := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	for _, r := range h {
+		for offset := r.Open; offset+length <= r.Close; offset += length {
+			starts = append(starts, midnight.Add(offset))
+		}
+	}
+
+	return starts
+}
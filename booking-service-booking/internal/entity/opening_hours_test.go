@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpeningHours(t *testing.T) {
+	hours, err := ParseOpeningHours("12:00 - 15:00, 18:00-01:00")
+	assert.NoError(t, err)
+	assert.Equal(t, OpeningHours{
+		{Open: 12 * time.Hour, Close: 15 * time.Hour},
+		{Open: 18 * time.Hour, Close: 25 * time.Hour},
+	}, hours)
+
+	at := func(value string) time.Time {
+		start, err := time.Parse(ReservationLayout, value)
+		assert.NoError(t, err)
+		return start
+	}
+
+	assert.True(t, hours.Fits(at("2030-05-01 13:00"), 2*time.Hour))
+	assert.False(t, hours.Fits(at("2030-05-01 14:00"), 2*time.Hour))
+	assert.False(t, hours.Fits(at("2030-05-01 16:00"), time.Hour))
+	assert.True(t, hours.Fits(at("2030-05-01 23:00"), 2*time.Hour))
+	// past midnight belongs to the previous day's evening range
+	assert.True(t, hours.Fits(at("2030-05-02 00:00"), time.Hour))
+	assert.False(t, hours.Fits(at("2030-05-02 00:30"), time.Hour))
+
+	assert.Equal(t, []time.Time{
+		at("2030-05-01 12:00"),
+		at("2030-05-01 18:00"),
+		at("2030-05-01 21:00"),
+	}, hours.Slots(at("2030-05-01 00:00"), 3*time.Hour))
+
+	_, err = ParseOpeningHours("noon till late")
+	assert.Error(t, err)
+
+	always, err := ParseOpeningHours("")
+	assert.NoError(t, err)
+	assert.True(t, always.Fits(at("2030-05-01 03:00"), 2*time.Hour))
+}
+
+func TestReservationClock(t *testing.T) {
+	tashkent := time.FixedZone("UZT", 5*60*60)
+	now := time.Date(2030, 5, 1, 20, 30, 0, 0, time.UTC)
+
+	clock := ReservationClock(now, tashkent)
+	assert.Equal(t, time.Date(2030, 5, 2, 1, 30, 0, 0, time.UTC), clock)
+	assert.Equal(t, now, ReservationClock(now, time.UTC))
+
+	// a reservation at 01:00 local time has started, one at 02:00 has not
+	assert.True(t, clock.After(mustParse(t, "2030-05-02 01:00")))
+	assert.True(t, clock.Before(mustParse(t, "2030-05-02 02:00")))
+}
+
+func mustParse(t *testing.T, value string) time.Time {
+	parsed, err := time.Parse(ReservationLayout, value)
+	assert.NoError(t, err)
+	return parsed
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// OwnerBookingFilter narrows down the bookings of the establishments an owner
+// lists. Bookings taking any time between From and To are listed, a zero time
+// leaves that side open. No Statuses lists every booking that is not a hold.
+type OwnerBookingFilter struct {
+	EstablishmentIds []string
+	From             time.Time
+	To               time.Time
+	Statuses         []string
+	Limit            uint64
+	Offset           uint64
+}
+
+// OwnerBooking is a booking together with the establishment it was made at,
+// for hotel bookings that is the hotel of the booked room
+type OwnerBooking struct {
+	Booking         *GeneralBooking
+	EstablishmentId string
+}
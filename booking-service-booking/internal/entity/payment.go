@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// payment statuses, a payment moves from pending to paid to refunded. A
+// payment is processing while it is being charged, a declined charge moves it
+// back to pending.
+const (
+	PaymentPending    = "pending"
+	PaymentProcessing = "processing"
+	PaymentPaid       = "paid"
+	PaymentRefunded   = "refunded"
+)
+
+// payment attempt actions
+const (
+	PaymentCharge  = "charge"
+	PaymentRefund  = "refund"
+	PaymentWebhook = "webhook"
+)
+
+// payment attempt statuses
+const (
+	AttemptSucceeded = "succeeded"
+	AttemptFailed    = "failed"
+)
+
+// events sent by payment providers to the webhook
+const (
+	EventPaymentSucceeded = "payment.succeeded"
+	EventPaymentFailed    = "payment.failed"
+	EventPaymentRefunded  = "payment.refunded"
+)
+
+var paymentTransitions = map[string][]string{
+	PaymentPending:    {PaymentProcessing, PaymentPaid},
+	PaymentProcessing: {PaymentPending, PaymentPaid},
+	PaymentPaid:       {PaymentRefunded},
+}
+
+// CanMovePayment reports whether a payment in status from may move to status to
+func CanMovePayment(from, to string) bool {
+	return slices.Contains(paymentTransitions[from], to)
+}
+
+type Payment struct {
+	Id             string
+	BookingId      string
+	UserId         string
+	Amount         float64
+	Currency       string
+	RefundedAmount float64
+	Status         string
+	Provider       string
+	ProviderRef    string
+	Attempts       []*PaymentAttempt
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type PaymentAttempt struct {
+	Id        string
+	PaymentId string
+	Action    string
+	Status    string
+	Error     string
+	CreatedAt time.Time
+}
+
+type PaymentEvent struct {
+	Event       string
+	ProviderRef string
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanMovePayment(t *testing.T) {
+	assert.True(t, CanMovePayment(PaymentPending, PaymentPaid))
+	assert.True(t, CanMovePayment(PaymentPaid, PaymentRefunded))
+	assert.True(t, CanMovePayment(PaymentPending, PaymentProcessing))
+	assert.True(t, CanMovePayment(PaymentProcessing, PaymentPaid))
+	assert.True(t, CanMovePayment(PaymentProcessing, PaymentPending))
+
+	assert.False(t, CanMovePayment(PaymentPending, PaymentRefunded))
+	assert.False(t, CanMovePayment(PaymentPaid, PaymentPending))
+	assert.False(t, CanMovePayment(PaymentRefunded, PaymentPaid))
+	assert.False(t, CanMovePayment(PaymentPaid, PaymentPaid))
+	assert.False(t, CanMovePayment(PaymentProcessing, PaymentProcessing))
+	assert.False(t, CanMovePayment(PaymentProcessing, PaymentRefunded))
+}
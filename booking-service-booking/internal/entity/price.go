@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Pricing holds the pricing settings shared by all establishments
+type Pricing struct {
+	Currency string
+	// HolidaySurcharge is the percent added to the nightly price of a holiday
+	HolidaySurcharge float64
+}
+
+type PriceQuote struct {
+	Nights        int64
+	HolidayNights int64
+	NightlyPrice  float64
+	Subtotal      float64
+	Surcharge     float64
+	Discount      float64
+	Total         float64
+	Currency      string
+}
+
+// Holidays are dates on which a surcharge applies. A date without a year
+// recurs every year.
+type Holidays struct {
+	dates  map[string]bool
+	yearly map[string]bool
+}
+
+// ParseHolidays parses holidays such as "2024-12-31, 01-01, 03-21"
+func ParseHolidays(value string) (Holidays, error) {
+	holidays := Holidays{
+		dates:  make(map[string]bool),
+		yearly: make(map[string]bool),
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		if day, err := time.Parse("2006-01-02", part); err == nil {
+			holidays.dates[day.Format("2006-01-02")] = true
+			continue
+		}
+		if day, err := time.Parse("01-02", part); err == nil {
+			holidays.yearly[day.Format("01-02")] = true
+			continue
+		}
+
+		return holidays, fmt.Errorf("invalid holiday %q", part)
+	}
+
+	return holidays, nil
+}
+
+func (h Holidays) Contains(day time.Time) bool {
+	return h.dates[day.Format("2006-01-02")] || h.yearly[day.Format("01-02")]
+}
+
+// QuoteStay prices every night of [arrive, leave) at nightlyPrice, holidays
+// with the surcharge on top, and takes the discount percent off the sum
+func QuoteStay(arrive, leave time.Time, nightlyPrice, discount float64, holidays Holidays, pricing Pricing) *PriceQuote {
+	quote := &PriceQuote{
+		NightlyPrice: nightlyPrice,
+		Currency:     pricing.Currency,
+	}
+
+	for night := arrive; night.Before(leave); night = night.AddDate(0, 0, 1) {
+		quote.Nights++
+		quote.Subtotal += nightlyPrice
+		if holidays.Contains(night) {
+			quote.HolidayNights++
+			quote.Surcharge += nightlyPrice * pricing.HolidaySurcharge / 100
+		}
+	}
+
+	discount = math.Min(math.Max(discount, 0), 100)
+	quote.Subtotal = roundPrice(quote.Subtotal)
+	quote.Surcharge = roundPrice(quote.Surcharge)
+	quote.Discount = roundPrice((quote.Subtotal + quote.Surcharge) * discount / 100)
+	quote.Total = roundPrice(quote.Subtotal + quote.Surcharge - quote.Discount)
+
+	return quote
+}
+
+func roundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuoteStay(t *testing.T) {
+	holidays, err := ParseHolidays("2030-12-31, 01-01")
+	assert.NoError(t, err)
+
+	day := func(value string) time.Time {
+		date, err := time.Parse("2006-01-02", value)
+		assert.NoError(t, err)
+		return date
+	}
+
+	pricing := Pricing{Currency: "USD", HolidaySurcharge: 50}
+
+	// the night of the departure day is not charged
+	quote := QuoteStay(day("2030-12-30"), day("2031-01-02"), 100, 10, holidays, pricing)
+	assert.Equal(t, &PriceQuote{
+		Nights:        3,
+		HolidayNights: 2,
+		NightlyPrice:  100,
+		Subtotal:      300,
+		Surcharge:     100,
+		Discount:      40,
+		Total:         360,
+		Currency:      "USD",
+	}, quote)
+
+	quote = QuoteStay(day("2031-02-10"), day("2031-02-12"), 33.33, 0, holidays, pricing)
+	assert.Equal(t, int64(0), quote.HolidayNights)
+	assert.Equal(t, 66.66, quote.Total)
+
+	_, err = ParseHolidays("new year")
+	assert.Error(t, err)
+
+	empty, err := ParseHolidays("")
+	assert.NoError(t, err)
+	assert.False(t, empty.Contains(day("2031-01-01")))
+}
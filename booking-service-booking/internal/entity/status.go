@@ -0,0 +1,122 @@
+package entity
+
+import "time"
+
+// booking types
+const (
+	BookingHotel      = "hotel"
+	BookingRestaurant = "restaurant"
+	BookingAttraction = "attraction"
+)
+
+// booking statuses
+const (
+	BookingPending   = "pending"
+	BookingConfirmed = "confirmed"
+	BookingCheckedIn = "checked_in"
+	BookingCompleted = "completed"
+	BookingCancelled = "cancelled"
+	BookingNoShow    = "no_show"
+	BookingHeld      = "held"
+	BookingExpired   = "expired"
+)
+
+// ReleasedStatuses are the statuses of bookings that no longer hold a room,
+// seats or tickets
+var ReleasedStatuses = []string{BookingCancelled, BookingNoShow, BookingExpired}
+
+var bookingTransitions = map[string][]string{
+	BookingHeld:      {BookingPending, BookingConfirmed, BookingCancelled, BookingExpired},
+	BookingPending:   {BookingConfirmed, BookingCancelled},
+	BookingConfirmed: {BookingCheckedIn, BookingCancelled, BookingNoShow},
+	BookingCheckedIn: {BookingCompleted},
+}
+
+// IsBookingStatus reports whether status is one of the booking statuses
+func IsBookingStatus(status string) bool {
+	switch status {
+	case BookingPending, BookingConfirmed, BookingCheckedIn, BookingCompleted,
+		BookingCancelled, BookingNoShow, BookingHeld, BookingExpired:
+		return true
+	}
+	return false
+}
+
+// CanMoveBooking reports whether a booking in status from may move to status to
+func CanMoveBooking(from, to string) bool {
+	for _, next := range bookingTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// BookedStatus is the status a new booking of bookingType starts in, hotel
+// bookings wait for the payment before they are confirmed
+func BookedStatus(bookingType string) string {
+	if bookingType == BookingHotel {
+		return BookingPending
+	}
+	return BookingConfirmed
+}
+
+// booking modes of establishments, bookings of establishments in request
+// mode wait pending until the owner accepts or declines them
+const (
+	BookingModeInstant = "instant"
+	BookingModeRequest = "request"
+)
+
+// AwaitingApproval reports whether the booking still waits for the owner of
+// the establishment to accept it
+func (b *GeneralBooking) AwaitingApproval() bool {
+	return b.Status == BookingPending && !b.ApprovalDueAt.IsZero()
+}
+
+// BookingEditable reports whether the details of a booking in status may still change
+func BookingEditable(status string) bool {
+	return status == BookingPending || status == BookingConfirmed
+}
+
+type StatusChange struct {
+	Id          string
+	BookingId   string
+	BookingType string
+	From        string
+	To          string
+	Reason      string
+	ChangedBy   string
+	CreatedAt   time.Time
+
+	// refund owed for a cancellation
+	RefundPercent float64
+	RefundAmount  float64
+	Currency      string
+
+	// dates a reschedule moved the booking from and to, the change of its
+	// price and the fee charged for the change
+	FromWillArrive  string
+	FromWillLeave   string
+	ToWillArrive    string
+	ToWillLeave     string
+	PriceDifference float64
+	ChangeFee       float64
+
+	// deadline for the owner to answer, set when the change makes the
+	// booking wait for the owner
+	ApprovalDueAt time.Time
+}
+
+// Reschedule moves a booking to new dates or changes the size of its party
+type Reschedule struct {
+	BookingId      string
+	BookingType    string
+	WillArrive     string
+	WillLeave      string
+	NumberOfPeople int64
+	AdultTickets   int64
+	ChildTickets   int64
+	Reason         string
+	ChangedBy      string
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanMoveBooking(t *testing.T) {
+	assert.True(t, CanMoveBooking(BookingPending, BookingConfirmed))
+	assert.True(t, CanMoveBooking(BookingPending, BookingCancelled))
+	assert.True(t, CanMoveBooking(BookingConfirmed, BookingCheckedIn))
+	assert.True(t, CanMoveBooking(BookingConfirmed, BookingCancelled))
+	assert.True(t, CanMoveBooking(BookingConfirmed, BookingNoShow))
+	assert.True(t, CanMoveBooking(BookingCheckedIn, BookingCompleted))
+	assert.True(t, CanMoveBooking(BookingHeld, BookingPending))
+	assert.True(t, CanMoveBooking(BookingHeld, BookingExpired))
+
+	assert.False(t, CanMoveBooking(BookingPending, BookingCheckedIn))
+	assert.False(t, CanMoveBooking(BookingCheckedIn, BookingCancelled))
+	assert.False(t, CanMoveBooking(BookingCancelled, BookingConfirmed))
+	assert.False(t, CanMoveBooking(BookingNoShow, BookingCheckedIn))
+	assert.False(t, CanMoveBooking(BookingCompleted, BookingCheckedIn))
+	assert.False(t, CanMoveBooking(BookingConfirmed, BookingConfirmed))
+	assert.False(t, CanMoveBooking(BookingExpired, BookingPending))
+	assert.False(t, CanMoveBooking(BookingPending, BookingHeld))
+}
+
+func TestBookedStatus(t *testing.T) {
+	assert.Equal(t, BookingPending, BookedStatus(BookingHotel))
+	assert.Equal(t, BookingConfirmed, BookedStatus(BookingRestaurant))
+	assert.Equal(t, BookingConfirmed, BookedStatus(BookingAttraction))
+}
+
+func TestBookingEditable(t *testing.T) {
+	assert.True(t, BookingEditable(BookingPending))
+	assert.True(t, BookingEditable(BookingConfirmed))
+
+	assert.False(t, BookingEditable(BookingCheckedIn))
+	assert.False(t, BookingEditable(BookingCancelled))
+	assert.False(t, BookingEditable(BookingNoShow))
+	assert.False(t, BookingEditable(BookingHeld))
+}
+
+func TestIsBookingStatus(t *testing.T) {
+	assert.True(t, IsBookingStatus(BookingCheckedIn))
+	assert.True(t, IsBookingStatus(BookingExpired))
+	assert.False(t, IsBookingStatus("checked-in"))
+	assert.False(t, IsBookingStatus(""))
+}
+
+func TestAwaitingApproval(t *testing.T) {
+	due := time.Now().Add(time.Hour)
+
+	assert.True(t, (&GeneralBooking{Status: BookingPending, ApprovalDueAt: due}).AwaitingApproval())
+
+	// pending hotel bookings of instant establishments only wait for the payment
+	assert.False(t, (&GeneralBooking{Status: BookingPending}).AwaitingApproval())
+	assert.False(t, (&GeneralBooking{Status: BookingConfirmed, ApprovalDueAt: due}).AwaitingApproval())
+}
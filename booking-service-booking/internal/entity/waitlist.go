@@ -0,0 +1,57 @@
+package entity
+
+import "time"
+
+// waitlist statuses
+const (
+	WaitlistWaiting  = "waiting"
+	WaitlistOffered  = "offered"
+	WaitlistAccepted = "accepted"
+	WaitlistDeclined = "declined"
+	WaitlistExpired  = "expired"
+	WaitlistLeft     = "left"
+)
+
+var waitlistTransitions = map[string][]string{
+	WaitlistWaiting: {WaitlistOffered, WaitlistLeft},
+	WaitlistOffered: {WaitlistAccepted, WaitlistDeclined, WaitlistExpired, WaitlistLeft},
+}
+
+// CanMoveWaitlist reports whether a waitlist entry in status from may move to status to
+func CanMoveWaitlist(from, to string) bool {
+	for _, next := range waitlistTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitlistTypes are the booking types guests can queue for, attraction
+// tickets are sold per entry window and are not waitlisted
+var WaitlistTypes = []string{BookingHotel, BookingRestaurant}
+
+// WaitlistEntry queues a guest for a room or a restaurant slot that was full
+// when they asked for it. Once a booking for the same dates is cancelled the
+// oldest waiting entry whose party fits gets an offer. The offer holds the
+// booking BookingId until OfferExpiresAt and confirms it when it is accepted.
+type WaitlistEntry struct {
+	Id             string
+	BookingType    string
+	UserId         string
+	HraId          string
+	WillArrive     string
+	WillLeave      string
+	NumberOfPeople int64
+	Reason         string
+	Status         string
+	OfferExpiresAt time.Time
+	BookingId      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// OfferOpen reports whether the offer made to the entry can still be accepted at now
+func (e *WaitlistEntry) OfferOpen(now time.Time) bool {
+	return e.Status == WaitlistOffered && now.Before(e.OfferExpiresAt)
+}
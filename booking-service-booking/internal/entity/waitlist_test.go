@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitlistOfferOpen(t *testing.T) {
+	now := time.Date(2030, 5, 10, 12, 0, 0, 0, time.UTC)
+	entry := &WaitlistEntry{
+		Status:         WaitlistOffered,
+		OfferExpiresAt: now.Add(time.Hour),
+	}
+	assert.True(t, entry.OfferOpen(now))
+	assert.False(t, entry.OfferOpen(now.Add(time.Hour)))
+
+	entry.Status = WaitlistWaiting
+	assert.False(t, entry.OfferOpen(now))
+}
+
+func TestCanMoveWaitlist(t *testing.T) {
+	assert.True(t, CanMoveWaitlist(WaitlistWaiting, WaitlistOffered))
+	assert.True(t, CanMoveWaitlist(WaitlistOffered, WaitlistAccepted))
+	assert.True(t, CanMoveWaitlist(WaitlistOffered, WaitlistExpired))
+	assert.False(t, CanMoveWaitlist(WaitlistWaiting, WaitlistAccepted))
+	assert.False(t, CanMoveWaitlist(WaitlistExpired, WaitlistAccepted))
+	assert.False(t, CanMoveWaitlist(WaitlistAccepted, WaitlistLeft))
+}
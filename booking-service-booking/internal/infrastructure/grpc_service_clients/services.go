@@ -1,25 +1,50 @@
 package grpc_service_clients
 
 import (
+	"fmt"
+
+	pbe "Booking/booking-service-booking/genproto/establishment-proto"
 	"Booking/booking-service-booking/internal/pkg/config"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ServiceClients interface {
+	EstablishmentService() pbe.EstablishmentServiceClient
 	Close()
 }
 
 type serviceClients struct {
-	services []*grpc.ClientConn
+	establishmentService pbe.EstablishmentServiceClient
+	services             []*grpc.ClientConn
 }
 
 func New(config *config.Config) (ServiceClients, error) {
+	// dial to establishment service
+	connEstablishmentService, err := grpc.Dial(
+		fmt.Sprintf("%s%s", config.EstablishmentService.Host, config.EstablishmentService.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &serviceClients{
-		services: []*grpc.ClientConn{},
+		establishmentService: pbe.NewEstablishmentServiceClient(connEstablishmentService),
+		services: []*grpc.ClientConn{
+			connEstablishmentService,
+		},
 	}, nil
 }
 
+func (s *serviceClients) EstablishmentService() pbe.EstablishmentServiceClient {
+	return s.establishmentService
+}
+
 func (s *serviceClients) Close() {
 	for _, conn := range s.services {
 		conn.Close()
@@ -2,6 +2,8 @@ package kafka
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 	"Booking/booking-service-booking/internal/entity"
 	"Booking/booking-service-booking/internal/pkg/config"
 
@@ -12,6 +14,20 @@ import (
 type producer struct {
 	logger            *zap.Logger
 	investmentCreated *kafka.Writer
+	bookingReminder   *kafka.Writer
+}
+
+// reminderMessage is the value of a booking reminder event
+type reminderMessage struct {
+	BookingId        string    `json:"booking_id"`
+	BookingType      string    `json:"booking_type"`
+	UserId           string    `json:"user_id"`
+	HraId            string    `json:"hra_id"`
+	WillArrive       string    `json:"will_arrive"`
+	WillLeave        string    `json:"will_leave"`
+	NumberOfPeople   int64     `json:"number_of_people"`
+	ConfirmationCode string    `json:"confirmation_code"`
+	SentAt           time.Time `json:"sent_at"`
 }
 
 func NewProducer(config *config.Config, logger *zap.Logger) *producer {
@@ -39,6 +55,13 @@ func NewProducer(config *config.Config, logger *zap.Logger) *producer {
 				}
 			},
 		},
+		bookingReminder: &kafka.Writer{
+			Addr:                   kafka.TCP(config.Kafka.Address...),
+			Topic:                  config.Kafka.Topic.BookingReminder,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
 	}
 }
 
@@ -54,8 +77,35 @@ func (p *producer) ProduceAttractionContent(ctx context.Context, key string, val
 	return nil
 }
 
+// ProduceBookingReminder writes the reminder synchronously, so a booking is
+// marked reminded only once the broker has the event
+func (p *producer) ProduceBookingReminder(ctx context.Context, key string, value *entity.GeneralBooking) error {
+	message, err := json.Marshal(reminderMessage{
+		BookingId:        value.Id.String(),
+		BookingType:      value.BookingType,
+		UserId:           value.UserId,
+		HraId:            value.HraId,
+		WillArrive:       value.WillArrive,
+		WillLeave:        value.WillLeave,
+		NumberOfPeople:   value.NumberOfPeople,
+		ConfirmationCode: value.ConfirmationCode,
+		SentAt:           time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.bookingReminder.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(key),
+		Value: message,
+	})
+}
+
 func (p *producer) Close() {
 	if err := p.investmentCreated.Close(); err != nil {
 		p.logger.Error("error during close writer userCategoryCreated", zap.Error(err))
 	}
+	if err := p.bookingReminder.Close(); err != nil {
+		p.logger.Error("error during close writer bookingReminder", zap.Error(err))
+	}
 }
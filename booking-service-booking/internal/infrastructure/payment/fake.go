@@ -0,0 +1,49 @@
+package payment
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// DeclinedCardToken is the card token the fake provider always declines
+const DeclinedCardToken = "tok_declined"
+
+const fakeRefPrefix = "fake_"
+
+// fakeProvider is a local payment provider for development and tests. It
+// accepts every card except DeclinedCardToken and refunds its own charges.
+type fakeProvider struct{}
+
+func NewFakeProvider() fakeProvider {
+	return fakeProvider{}
+}
+
+func (p fakeProvider) Name() string {
+	return "fake"
+}
+
+func (p fakeProvider) Charge(ctx context.Context, payment *entity.Payment, cardToken string) (string, error) {
+	switch cardToken {
+	case "":
+		return "", entity.NewErrPaymentDeclined("card token is required")
+	case DeclinedCardToken:
+		return "", entity.NewErrPaymentDeclined("card was declined")
+	}
+
+	return fakeRefPrefix + uuid.NewString(), nil
+}
+
+func (p fakeProvider) Refund(ctx context.Context, payment *entity.Payment, amount float64) error {
+	if !strings.HasPrefix(payment.ProviderRef, fakeRefPrefix) {
+		return fmt.Errorf("charge %q was not made by the fake provider", payment.ProviderRef)
+	}
+	if amount <= 0 || amount > payment.Amount {
+		return fmt.Errorf("refund of %.2f does not fit the charge of %.2f", amount, payment.Amount)
+	}
+
+	return nil
+}
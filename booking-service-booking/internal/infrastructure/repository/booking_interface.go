@@ -3,34 +3,35 @@ package repository
 import (
 	"Booking/booking-service-booking/internal/entity"
 	"context"
+	"time"
 )
 
 type Booking interface {
-	UHBCreate(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.GeneralBooking, error)
-	URBCreate(ctx context.Context, bookingRestaurant *entity.GeneralBooking) (*entity.GeneralBooking, error)
-	UABCreate(ctx context.Context, bookingAttraction *entity.GeneralBooking) (*entity.GeneralBooking, error)
-
-	UHBGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error)
-	URBGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error)
-	UABGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error)
-
-	UHBGetAllByHId(ctx context.Context, limit, offset uint64, room_id string) ([]*entity.Id, int64, error)
-	URBGetAllByRId(ctx context.Context, limit, offset uint64, restaurant_id string) ([]*entity.Id, int64, error)
-	UABGetAllByAId(ctx context.Context, limit, offset uint64, attraction_id string) ([]*entity.Id, int64, error)
-
-	UHBList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-	URBList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-	UABList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-
-	UHBListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-	URBListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-	UABListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-
-	UHBUpdate(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.GeneralBooking, error)
-	URBUpdate(ctx context.Context, bookingRestaurant *entity.GeneralBooking) (*entity.GeneralBooking, error)
-	UABUpdate(ctx context.Context, bookingAttraction *entity.GeneralBooking) (*entity.GeneralBooking, error)
-
-	UHBDelete(ctx context.Context, id string) error
-	URBDelete(ctx context.Context, id string) error
-	UABDelete(ctx context.Context, id string) error
+	Create(ctx context.Context, booking *entity.GeneralBooking, capacity entity.Capacity) (*entity.GeneralBooking, error)
+	Get(ctx context.Context, bookingType, id string) (*entity.GeneralBooking, error)
+	GetByConfirmationCode(ctx context.Context, code string) (*entity.GeneralBooking, error)
+	GetAllByUId(ctx context.Context, bookingType string, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error)
+	GetAllByHraId(ctx context.Context, bookingType string, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error)
+	List(ctx context.Context, bookingType string, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
+	ListDeleted(ctx context.Context, bookingType string, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
+	ListByItinerary(ctx context.Context, itinerary_id string) ([]*entity.GeneralBooking, error)
+	ListByHraIds(ctx context.Context, bookingType string, hra_ids []string, since time.Time) ([]*entity.GeneralBooking, error)
+	ListForOwner(ctx context.Context, hra_ids []string, filter *entity.OwnerBookingFilter) ([]*entity.GeneralBooking, int64, error)
+	Update(ctx context.Context, booking *entity.GeneralBooking) (*entity.GeneralBooking, error)
+	Reschedule(ctx context.Context, booking *entity.GeneralBooking, capacity entity.Capacity, change *entity.StatusChange) error
+	Delete(ctx context.Context, bookingType, id string) error
+
+	UHBCountBooked(ctx context.Context, room_ids []string, willArrive, willLeave string) (map[string]int64, error)
+	URBListBetween(ctx context.Context, restaurant_id string, from, to time.Time) ([]*entity.GeneralBooking, error)
+	UABTicketsByEntry(ctx context.Context, attraction_id string, day time.Time) (map[string]int64, error)
+
+	GetStatus(ctx context.Context, bookingType, id string) (status, user_id string, err error)
+	UpdateStatus(ctx context.Context, change *entity.StatusChange) error
+	StatusHistory(ctx context.Context, booking_id string) ([]*entity.StatusChange, error)
+	ExpireHolds(ctx context.Context, now time.Time) ([]*entity.GeneralBooking, error)
+	DeclineUnanswered(ctx context.Context, now time.Time) ([]*entity.GeneralBooking, error)
+	MarkNoShows(ctx context.Context, bookingType string, arrivedBefore, now time.Time) ([]*entity.GeneralBooking, error)
+	CompleteBookings(ctx context.Context, endedBy, now time.Time) ([]*entity.GeneralBooking, error)
+	ListDueReminders(ctx context.Context, now, until time.Time) ([]*entity.GeneralBooking, error)
+	MarkReminded(ctx context.Context, ids []string, at time.Time) error
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"context"
+	"time"
+)
+
+type CalendarSync interface {
+	CreateSource(ctx context.Context, source *entity.CalendarSource) (*entity.CalendarSource, error)
+	GetSource(ctx context.Context, id string) (*entity.CalendarSource, error)
+	ListSources(ctx context.Context, room_id string) ([]*entity.CalendarSource, error)
+	DeleteSource(ctx context.Context, id string) error
+	ReplaceBlocks(ctx context.Context, source *entity.CalendarSource, blocks []*entity.ExternalBlock, syncedAt time.Time) error
+	SetSyncError(ctx context.Context, source *entity.CalendarSource, syncError string, at time.Time) error
+	ListBlocks(ctx context.Context, room_id string, from, to time.Time) ([]*entity.ExternalBlock, error)
+}
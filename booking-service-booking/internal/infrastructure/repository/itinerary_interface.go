@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"context"
+)
+
+type Itinerary interface {
+	Create(ctx context.Context, itinerary *entity.Itinerary) (*entity.Itinerary, error)
+	Get(ctx context.Context, id string) (*entity.Itinerary, error)
+	ListByUser(ctx context.Context, user_id string) ([]*entity.Itinerary, error)
+	UpdateStatus(ctx context.Context, itinerary *entity.Itinerary, from, to string) error
+}
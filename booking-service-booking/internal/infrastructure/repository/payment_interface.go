@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"context"
+)
+
+type Payment interface {
+	Create(ctx context.Context, payment *entity.Payment) (*entity.Payment, error)
+	GetByBookingId(ctx context.Context, booking_id string) (*entity.Payment, error)
+	GetByProviderRef(ctx context.Context, provider_ref string) (*entity.Payment, error)
+	UpdateStatus(ctx context.Context, payment *entity.Payment, from, to string) error
+	AddAttempt(ctx context.Context, attempt *entity.PaymentAttempt) error
+}
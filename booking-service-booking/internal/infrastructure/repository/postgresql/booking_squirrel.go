@@ -10,78 +10,147 @@ import (
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 )
 
 const (
-	bookingHotelTable      = "users_hotels_booking"
-	bookingRestaurantTable = "users_restaurants_booking"
-	bookingAttractionTable = "users_attractions_booking"
+	bookingTable              = "booking_table"
+	bookingStatusHistoryTable = "booking_status_history"
+	bookingGuestTable         = "booking_guest_table"
+	bookingAddOnTable         = "booking_add_on_table"
+
+	// a new confirmation code is drawn this many times at most when it
+	// happens to belong to another booking already
+	confirmationCodeAttempts = 5
 )
 
 type bookingRepo struct {
-	bookingHotelTable      string
-	bookingRestaurantTable string
-	bookingAttractionTable string
-	db                     *postgres.PostgresDB
+	tableName string
+	db        *postgres.PostgresDB
 }
 
 func NewBookingRepo(db *postgres.PostgresDB) *bookingRepo {
 	return &bookingRepo{
-		bookingHotelTable:      bookingHotelTable,
-		bookingRestaurantTable: bookingRestaurantTable,
-		bookingAttractionTable: bookingAttractionTable,
-		db:                     db,
+		tableName: bookingTable,
+		db:        db,
 	}
 }
 
-// UserIdSelecter for each of tables
-func (p *bookingRepo) UserIdSelecter(tableName string) squirrel.SelectBuilder {
+// UserIdSelecter for bookings of any type
+func (p *bookingRepo) UserIdSelecter() squirrel.SelectBuilder {
 	return p.db.Sq.Builder.Select(
 		"user_id",
 		"created_at",
-	).From(tableName)
+	).From(p.tableName)
 }
 
-// Count for each of tables
-func (p *bookingRepo) Count(tableName string) squirrel.SelectBuilder {
+// Count for bookings of any type
+func (p *bookingRepo) Count() squirrel.SelectBuilder {
 	return p.db.Sq.Builder.Select(
 		"COUNT(*) AS count",
-	).From(tableName)
+	).From(p.tableName)
 }
 
-// Selecter for each of tables
-func (p *bookingRepo) Selecter(tableName string) squirrel.SelectBuilder {
+// Selecter for bookings of any type, restaurant reservations and attraction
+// tickets keep the time of the visit while hotel stays keep bare dates
+func (p *bookingRepo) Selecter() squirrel.SelectBuilder {
+	format := fmt.Sprintf("CASE WHEN booking_type = '%s' THEN 'YYYY-MM-DD' ELSE 'YYYY-MM-DD HH24:MI' END", entity.BookingHotel)
+
 	return p.db.Sq.Builder.Select(
 		"id",
+		"booking_type",
 		"user_id",
 		"hra_id",
-		"TO_CHAR(will_arrive, 'YYYY-MM-DD') AS will_arrive",
-		"TO_CHAR(will_leave, 'YYYY-MM-DD') AS will_leave",
+		"TO_CHAR(will_arrive, "+format+") AS will_arrive",
+		"TO_CHAR(will_leave, "+format+") AS will_leave",
 		"number_of_people",
-		"is_canceled",
+		"status",
 		"reason",
 		"created_at",
 		"updated_at",
-	).From(tableName)
+		"adult_tickets",
+		"child_tickets",
+		"total_price",
+		"currency",
+		"COALESCE(itinerary_id::text, '')",
+		"hold_expires_at",
+		"COALESCE(confirmation_code, '')",
+		"approval_due_at",
+		"special_requests",
+		"special_request_note",
+	).From(p.tableName)
+}
+
+// scanBooking reads a row built by Selecter
+func scanBooking(row pgx.Row) (*entity.GeneralBooking, error) {
+	var (
+		booking       entity.GeneralBooking
+		updatedAt     sql.NullTime
+		holdExpiresAt sql.NullTime
+		approvalDueAt sql.NullTime
+	)
+	if err := row.Scan(
+		&booking.Id,
+		&booking.BookingType,
+		&booking.UserId,
+		&booking.HraId,
+		&booking.WillArrive,
+		&booking.WillLeave,
+		&booking.NumberOfPeople,
+		&booking.Status,
+		&booking.Reason,
+		&booking.CreatedAt,
+		&updatedAt,
+		&booking.AdultTickets,
+		&booking.ChildTickets,
+		&booking.TotalPrice,
+		&booking.Currency,
+		&booking.ItineraryId,
+		&holdExpiresAt,
+		&booking.ConfirmationCode,
+		&approvalDueAt,
+		&booking.SpecialRequests,
+		&booking.SpecialRequestNote,
+	); err != nil {
+		return nil, err
+	}
+	booking.UpdatedAt = updatedAt.Time
+	booking.HoldExpiresAt = holdExpiresAt.Time
+	booking.ApprovalDueAt = approvalDueAt.Time
+
+	return &booking, nil
+}
+
+// ofType narrows a query to bookings of the given type, an empty type keeps all of them
+func (p *bookingRepo) ofType(builder squirrel.SelectBuilder, bookingType string) squirrel.SelectBuilder {
+	if bookingType == "" {
+		return builder
+	}
+	return builder.Where(p.db.Sq.Equal("booking_type", bookingType))
 }
 
-// Create for each of tables
-func (p *bookingRepo) UHBCreate(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UHBCreate")
+// Create books an establishment only while it has room for the booking:
+// hotel rooms are counted against capacity.Rooms, restaurant guests against
+// capacity.Seats and attraction tickets against capacity.Tickets. The
+// establishment is locked for the lifetime of the transaction, so concurrent
+// requests for it are serialized.
+func (p *bookingRepo) Create(ctx context.Context, booking *entity.GeneralBooking, capacity entity.Capacity) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "Create")
 	defer span.End()
 
 	var WA time.Time
 	var WL time.Time
 	var err error
-	if bookingHotel.WillArrive != "" {
-		WA, err = time.Parse("2006-01-02", bookingHotel.WillArrive)
+	if booking.WillArrive != "" {
+		WA, err = parseReservationTime(booking.WillArrive)
 
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse will arrive: %v", err)
 		}
 	}
-	if bookingHotel.WillLeave != "" {
-		WL, err = time.Parse("2006-01-02", bookingHotel.WillLeave)
+	if booking.WillLeave != "" {
+		WL, err = parseReservationTime(booking.WillLeave)
 
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse will leave: %v", err)
@@ -89,356 +158,464 @@ func (p *bookingRepo) UHBCreate(ctx context.Context, bookingHotel *entity.Genera
 	}
 
 	data := map[string]interface{}{
-		"id":               bookingHotel.Id,
-		"user_id":          bookingHotel.UserId,
-		"hra_id":           bookingHotel.HraId,
+		"id":               booking.Id,
+		"booking_type":     booking.BookingType,
+		"user_id":          booking.UserId,
+		"hra_id":           booking.HraId,
 		"will_arrive":      WA,
 		"will_leave":       WL,
-		"number_of_people": bookingHotel.NumberOfPeople,
-		"is_canceled":      bookingHotel.IsCanceled,
-		"reason":           bookingHotel.Reason,
-		"created_at":       bookingHotel.CreatedAt,
-		"updated_at":       bookingHotel.UpdatedAt,
+		"number_of_people": booking.NumberOfPeople,
+		"status":           booking.Status,
+		"reason":           booking.Reason,
+		"adult_tickets":    booking.AdultTickets,
+		"child_tickets":    booking.ChildTickets,
+		"total_price":      booking.TotalPrice,
+		"currency":         booking.Currency,
+		"created_at":       booking.CreatedAt,
+		"updated_at":       booking.UpdatedAt,
+
+		"special_requests":     specialRequests(booking),
+		"special_request_note": booking.SpecialRequestNote,
+	}
+	if booking.ItineraryId != "" {
+		data["itinerary_id"] = booking.ItineraryId
+	}
+	if !booking.HoldExpiresAt.IsZero() {
+		data["hold_expires_at"] = booking.HoldExpiresAt
+	}
+	if !booking.ApprovalDueAt.IsZero() {
+		data["approval_due_at"] = booking.ApprovalDueAt
 	}
 
-	query, args, err := p.db.Sq.Builder.Insert(p.bookingHotelTable).SetMap(data).ToSql()
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
-		return bookingHotel, fmt.Errorf("failed to build SQL query for booking hotel: %v", err)
+		return booking, fmt.Errorf("failed to begin transaction for booking %s: %v", booking.BookingType, err)
 	}
-	_, err = p.db.Exec(ctx, query, args...)
-	if err != nil {
-		return bookingHotel, fmt.Errorf("failed to execute SQL query for booking hotel: %v", err)
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", booking.HraId); err != nil {
+		return booking, fmt.Errorf("failed to lock %s for booking: %v", booking.BookingType, err)
 	}
 
-	return bookingHotel, nil
-}
+	if err = p.checkCapacity(ctx, tx, booking, WA, WL, capacity); err != nil {
+		return booking, err
+	}
 
-func (p *bookingRepo) URBCreate(ctx context.Context, bookingRestaurant *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "URBCreate")
-	defer span.End()
+	if err = p.insertWithCode(ctx, tx, booking, data); err != nil {
+		return booking, err
+	}
+	if err = p.insertGuests(ctx, tx, booking); err != nil {
+		return booking, err
+	}
+	if err = p.insertAddOns(ctx, tx, booking); err != nil {
+		return booking, err
+	}
+	if err = p.insertStatusChange(ctx, tx, &entity.StatusChange{
+		BookingId:   booking.Id.String(),
+		BookingType: booking.BookingType,
+		To:          booking.Status,
+		ChangedBy:   booking.UserId,
+		CreatedAt:   booking.CreatedAt,
+	}); err != nil {
+		return booking, err
+	}
 
-	var WA time.Time
-	var WL time.Time
-	var err error
-	if bookingRestaurant.WillArrive != "" {
-		WA, err = time.Parse("2006-01-02", bookingRestaurant.WillArrive)
+	if err = tx.Commit(ctx); err != nil {
+		return booking, fmt.Errorf("failed to commit booking %s: %v", booking.BookingType, err)
+	}
+
+	return booking, nil
+}
 
+// insertWithCode inserts the booking with a fresh confirmation code, a code
+// that is taken already is skipped by the insert and another one is drawn
+func (p *bookingRepo) insertWithCode(ctx context.Context, tx pgx.Tx, booking *entity.GeneralBooking, data map[string]interface{}) error {
+	for attempt := 0; attempt < confirmationCodeAttempts; attempt++ {
+		code, err := entity.NewConfirmationCode()
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse will arrive: %v", err)
+			return fmt.Errorf("failed to generate confirmation code: %v", err)
 		}
-	}
-	if bookingRestaurant.WillLeave != "" {
-		WL, err = time.Parse("2006-01-02", bookingRestaurant.WillLeave)
+		data["confirmation_code"] = code
 
+		query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).
+			Suffix("ON CONFLICT (confirmation_code) DO NOTHING").
+			ToSql()
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse will leave: %v", err)
+			return fmt.Errorf("failed to build SQL query for booking %s: %v", booking.BookingType, err)
+		}
+		commandTag, err := tx.Exec(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to execute SQL query for booking %s: %v", booking.BookingType, err)
+		}
+		if commandTag.RowsAffected() == 1 {
+			booking.ConfirmationCode = code
+			return nil
 		}
 	}
 
-	data := map[string]interface{}{
-		"id":               bookingRestaurant.Id,
-		"user_id":          bookingRestaurant.UserId,
-		"hra_id":           bookingRestaurant.HraId,
-		"will_arrive":      WA,
-		"will_leave":       WL,
-		"number_of_people": bookingRestaurant.NumberOfPeople,
-		"is_canceled":      bookingRestaurant.IsCanceled,
-		"reason":           bookingRestaurant.Reason,
-		"created_at":       bookingRestaurant.CreatedAt,
-		"updated_at":       bookingRestaurant.UpdatedAt,
+	return fmt.Errorf("failed to find a free confirmation code for booking %s", booking.BookingType)
+}
+
+// specialRequests keeps an empty list of special requests from being stored as NULL
+func specialRequests(booking *entity.GeneralBooking) []string {
+	if booking.SpecialRequests == nil {
+		return []string{}
 	}
+	return booking.SpecialRequests
+}
 
-	query, args, err := p.db.Sq.Builder.Insert(p.bookingRestaurantTable).SetMap(data).ToSql()
-	if err != nil {
-		return bookingRestaurant, fmt.Errorf("failed to build SQL query for booking restaurant: %v", err)
+// insertGuests stores the guests of booking in the order they were named
+func (p *bookingRepo) insertGuests(ctx context.Context, tx pgx.Tx, booking *entity.GeneralBooking) error {
+	if len(booking.Guests) == 0 {
+		return nil
 	}
-	_, err = p.db.Exec(ctx, query, args...)
+
+	builder := p.db.Sq.Builder.Insert(bookingGuestTable).
+		Columns("booking_id", "position", "full_name", "age", "document_number")
+	for position, guest := range booking.Guests {
+		builder = builder.Values(booking.Id, position, guest.FullName, guest.Age, guest.DocumentNumber)
+	}
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return bookingRestaurant, fmt.Errorf("failed to execute SQL query for booking restaurant: %v", err)
+		return fmt.Errorf("failed to build SQL query for inserting guests of booking: %v", err)
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to execute SQL query for inserting guests of booking: %v", err)
 	}
 
-	return bookingRestaurant, nil
+	return nil
 }
 
-func (p *bookingRepo) UABCreate(ctx context.Context, bookingAttraction *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UABCreate")
-	defer span.End()
-
-	var WA time.Time
-	var WL time.Time
-	var err error
-	if bookingAttraction.WillArrive != "" {
-		WA, err = time.Parse("2006-01-02", bookingAttraction.WillArrive)
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse will arrive: %v", err)
-		}
-	}
-	if bookingAttraction.WillLeave != "" {
-		WL, err = time.Parse("2006-01-02", bookingAttraction.WillLeave)
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse will leave: %v", err)
-		}
+// insertAddOns stores the add-ons booked with booking
+func (p *bookingRepo) insertAddOns(ctx context.Context, tx pgx.Tx, booking *entity.GeneralBooking) error {
+	if len(booking.AddOns) == 0 {
+		return nil
 	}
 
-	data := map[string]interface{}{
-		"id":               bookingAttraction.Id,
-		"user_id":          bookingAttraction.UserId,
-		"hra_id":           bookingAttraction.HraId,
-		"will_arrive":      WA,
-		"will_leave":       WL,
-		"number_of_people": bookingAttraction.NumberOfPeople,
-		"is_canceled":      bookingAttraction.IsCanceled,
-		"reason":           bookingAttraction.Reason,
-		"created_at":       bookingAttraction.CreatedAt,
-		"updated_at":       bookingAttraction.UpdatedAt,
+	builder := p.db.Sq.Builder.Insert(bookingAddOnTable).
+		Columns("booking_id", "add_on_id", "name", "quantity", "unit_price", "total")
+	for _, addOn := range booking.AddOns {
+		builder = builder.Values(booking.Id, addOn.AddOnId, addOn.Name, addOn.Quantity, addOn.UnitPrice, addOn.Total)
 	}
-
-	query, args, err := p.db.Sq.Builder.Insert(p.bookingAttractionTable).SetMap(data).ToSql()
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return bookingAttraction, fmt.Errorf("failed to build SQL query for booking attraction: %v", err)
+		return fmt.Errorf("failed to build SQL query for inserting add-ons of booking: %v", err)
 	}
-	_, err = p.db.Exec(ctx, query, args...)
-	if err != nil {
-		return bookingAttraction, fmt.Errorf("failed to execute SQL query for booking attraction: %v", err)
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to execute SQL query for inserting add-ons of booking: %v", err)
 	}
 
-	return bookingAttraction, nil
+	return nil
 }
 
-// GetAllByUId for each of tables
-func (p *bookingRepo) UHBGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UHBGetAllByUId")
-	defer span.End()
-
-	var (
-		bookingHotels []*entity.GeneralBooking
-		count         int64
-	)
+// withDetails loads the guests and the add-ons of bookings, with a single
+// query for each
+func (p *bookingRepo) withDetails(ctx context.Context, bookings ...*entity.GeneralBooking) error {
+	if len(bookings) == 0 {
+		return nil
+	}
 
-	selecter := p.Selecter(bookingHotelTable)
+	byId := make(map[uuid.UUID]*entity.GeneralBooking, len(bookings))
+	ids := make([]string, 0, len(bookings))
+	for _, booking := range bookings {
+		booking.Guests = []entity.BookingGuest{}
+		booking.AddOns = []entity.BookingAddOn{}
+		byId[booking.Id] = booking
+		ids = append(ids, booking.Id.String())
+	}
 
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
+	if err := p.loadGuests(ctx, byId, ids); err != nil {
+		return err
 	}
+	return p.loadAddOns(ctx, byId, ids)
+}
 
-	selecter = selecter.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("user_id", user_id))
-	query, args, err := selecter.ToSql()
+func (p *bookingRepo) loadGuests(ctx context.Context, byId map[uuid.UUID]*entity.GeneralBooking, ids []string) error {
+	query, args, err := p.db.Sq.Builder.Select(
+		"booking_id",
+		"full_name",
+		"age",
+		"document_number",
+	).From(bookingGuestTable).
+		Where(p.db.Sq.Equal("booking_id", ids)).
+		OrderBy("booking_id", "position").
+		ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return fmt.Errorf("failed to build SQL query for listing guests of bookings: %v", err)
 	}
 
 	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+		return fmt.Errorf("failed to execute SQL query for listing guests of bookings: %v", err)
 	}
 	defer rows.Close()
-	bookingHotels = make([]*entity.GeneralBooking, 0)
+
 	for rows.Next() {
-		var bookedHotel entity.GeneralBooking
-		if err = rows.Scan(
-			&bookedHotel.Id,
-			&bookedHotel.UserId,
-			&bookedHotel.HraId,
-			&bookedHotel.WillArrive,
-			&bookedHotel.WillLeave,
-			&bookedHotel.NumberOfPeople,
-			&bookedHotel.IsCanceled,
-			&bookedHotel.Reason,
-			&bookedHotel.CreatedAt,
-			&bookedHotel.UpdatedAt,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing bookingHotel: %v", err)
+		var (
+			bookingId uuid.UUID
+			guest     entity.BookingGuest
+		)
+		if err = rows.Scan(&bookingId, &guest.FullName, &guest.Age, &guest.DocumentNumber); err != nil {
+			return fmt.Errorf("failed to scan row while listing guests of bookings: %v", err)
+		}
+		if booking, ok := byId[bookingId]; ok {
+			booking.Guests = append(booking.Guests, guest)
 		}
-		bookingHotels = append(bookingHotels, &bookedHotel)
-	}
-
-	queryCount := p.Count(bookingHotelTable)
-	query, args, err = queryCount.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("user_id", user_id)).ToSql()
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookingHotel: %v", err)
-	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting bookingHotel: %v", err)
 	}
 
-	return bookingHotels, count, nil
+	return rows.Err()
 }
 
-func (p *bookingRepo) URBGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "URBGetAllByUId")
-	defer span.End()
-
-	var (
-		bookingRestaurants []*entity.GeneralBooking
-		count              int64
-	)
-
-	selecter := p.Selecter(bookingRestaurantTable)
-
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
-	}
-
-	selecter = selecter.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("user_id", user_id))
-	query, args, err := selecter.ToSql()
+func (p *bookingRepo) loadAddOns(ctx context.Context, byId map[uuid.UUID]*entity.GeneralBooking, ids []string) error {
+	query, args, err := p.db.Sq.Builder.Select(
+		"booking_id",
+		"add_on_id",
+		"name",
+		"quantity",
+		"unit_price",
+		"total",
+	).From(bookingAddOnTable).
+		Where(p.db.Sq.Equal("booking_id", ids)).
+		OrderBy("booking_id", "name").
+		ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return fmt.Errorf("failed to build SQL query for listing add-ons of bookings: %v", err)
 	}
 
 	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+		return fmt.Errorf("failed to execute SQL query for listing add-ons of bookings: %v", err)
 	}
 	defer rows.Close()
-	bookingRestaurants = make([]*entity.GeneralBooking, 0)
+
 	for rows.Next() {
-		var bookedRestaurant entity.GeneralBooking
-		if err = rows.Scan(
-			&bookedRestaurant.Id,
-			&bookedRestaurant.UserId,
-			&bookedRestaurant.HraId,
-			&bookedRestaurant.WillArrive,
-			&bookedRestaurant.WillLeave,
-			&bookedRestaurant.NumberOfPeople,
-			&bookedRestaurant.IsCanceled,
-			&bookedRestaurant.Reason,
-			&bookedRestaurant.CreatedAt,
-			&bookedRestaurant.UpdatedAt,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing bookingHotel: %v", err)
+		var (
+			bookingId uuid.UUID
+			addOn     entity.BookingAddOn
+		)
+		if err = rows.Scan(&bookingId, &addOn.AddOnId, &addOn.Name, &addOn.Quantity, &addOn.UnitPrice, &addOn.Total); err != nil {
+			return fmt.Errorf("failed to scan row while listing add-ons of bookings: %v", err)
+		}
+		if booking, ok := byId[bookingId]; ok {
+			booking.AddOns = append(booking.AddOns, addOn)
 		}
-		bookingRestaurants = append(bookingRestaurants, &bookedRestaurant)
 	}
 
-	queryCount := p.Count(bookingRestaurantTable)
-	query, args, err = queryCount.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("user_id", user_id)).ToSql()
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookingHotel: %v", err)
-	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting bookingHotel: %v", err)
+	return rows.Err()
+}
+
+// checkCapacity fails unless booking fits into the capacity of what it books
+// between willArrive and willLeave. The booking itself never counts against
+// the capacity, so a booking being moved does not compete with its old dates.
+func (p *bookingRepo) checkCapacity(ctx context.Context, tx pgx.Tx, booking *entity.GeneralBooking, willArrive, willLeave time.Time, capacity entity.Capacity) error {
+	switch booking.BookingType {
+	case entity.BookingHotel:
+		return p.checkRooms(ctx, tx, booking.Id, booking.HraId, willArrive, willLeave, capacity.Rooms)
+	case entity.BookingRestaurant:
+		return p.checkSeats(ctx, tx, booking.Id, booking.HraId, willArrive, willLeave, booking.NumberOfPeople, capacity.Seats)
+	case entity.BookingAttraction:
+		return p.checkTickets(ctx, tx, booking.Id, booking.HraId, willArrive, booking.NumberOfPeople, capacity.Tickets)
 	}
 
-	return bookingRestaurants, count, nil
+	return fmt.Errorf("unknown booking type %q", booking.BookingType)
 }
 
-func (p *bookingRepo) UABGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UABGetAllByUId")
-	defer span.End()
+// checkRooms fails unless a room is left on every night between willArrive and
+// willLeave, i.e. fewer than numberOfRooms other non-cancelled bookings of the
+// room and blocks imported from calendars of other platforms share any night
+func (p *bookingRepo) checkRooms(ctx context.Context, tx pgx.Tx, booking_id uuid.UUID, room_id string, willArrive, willLeave time.Time, numberOfRooms int64) error {
+	var taken int64
+	query, args, err := p.db.Sq.Builder.Select(
+		"COALESCE(MAX(taken), 0) AS taken",
+	).FromSelect(p.roomsTakenPerNight([]string{room_id}, booking_id, willArrive, willLeave), "taken_rooms").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for counting taken rooms: %v", err)
+	}
+	if err = tx.QueryRow(ctx, query, args...).Scan(&taken); err != nil {
+		return fmt.Errorf("failed to count taken rooms: %v", err)
+	}
+	if taken >= numberOfRooms {
+		return entity.NewErrOverbooking("room")
+	}
 
-	var (
-		bookingAttractions []*entity.GeneralBooking
-		count              int64
-	)
+	return nil
+}
 
-	selecter := p.Selecter(bookingAttractionTable)
+// roomsTakenPerNight selects room_id, night and taken for every night between
+// willArrive and willLeave on which rooms of room_ids are taken, by bookings
+// other than booking_id or by blocks imported from calendars of other
+// platforms. Both take a room for the nights from their arrival up to their
+// departure.
+func (p *bookingRepo) roomsTakenPerNight(room_ids []string, booking_id uuid.UUID, willArrive, willLeave interface{}) squirrel.SelectBuilder {
+	blocks := p.db.Sq.Builder.Select(
+		"room_id",
+		"starts_on",
+		"ends_on",
+	).From(externalBlockTable).
+		Where(p.db.Sq.Equal("room_id", room_ids)).
+		Where("starts_on < ? AND ends_on > ?", willLeave, willArrive).
+		PlaceholderFormat(squirrel.Question)
+
+	stays := p.db.Sq.Builder.Select(
+		"hra_id AS room_id",
+		"will_arrive::date AS starts_on",
+		"will_leave::date AS ends_on",
+	).From(p.tableName).
+		Where(p.db.Sq.Equal("booking_type", entity.BookingHotel)).
+		Where(p.db.Sq.Equal("hra_id", room_ids)).
+		Where(p.db.Sq.NotEqual("id", booking_id)).
+		Where(p.db.Sq.NotEqual("status", entity.ReleasedStatuses)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		Where("will_arrive < ? AND will_leave > ?", willLeave, willArrive).
+		SuffixExpr(squirrel.ConcatExpr("UNION ALL ", blocks))
 
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
-	}
+	return p.db.Sq.Builder.Select(
+		"stays.room_id",
+		"nights.night",
+		"COUNT(*) AS taken",
+	).FromSelect(stays, "stays").
+		JoinClause("JOIN generate_series(?::date, ?::date - 1, interval '1 day') AS nights (night) ON stays.starts_on <= nights.night AND stays.ends_on > nights.night", willArrive, willLeave).
+		GroupBy("stays.room_id", "nights.night")
+}
 
-	selecter = selecter.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("user_id", user_id))
-	query, args, err := selecter.ToSql()
+// checkSeats fails unless the guests of other non-cancelled reservations
+// seated at the same time together with the new ones fit into seatCapacity at
+// every moment between willArrive and willLeave. The most guests are seated
+// when the window starts or when one of the reservations arrives.
+func (p *bookingRepo) checkSeats(ctx context.Context, tx pgx.Tx, booking_id uuid.UUID, restaurant_id string, willArrive, willLeave time.Time, guests, seatCapacity int64) error {
+	overlapping := p.db.Sq.Builder.Select(
+		"will_arrive",
+		"will_leave",
+		"number_of_people",
+	).From(p.tableName).
+		Where(p.db.Sq.Equal("booking_type", entity.BookingRestaurant)).
+		Where(p.db.Sq.Equal("hra_id", restaurant_id)).
+		Where(p.db.Sq.NotEqual("id", booking_id)).
+		Where(p.db.Sq.NotEqual("status", entity.ReleasedStatuses)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		Where("will_arrive < ? AND will_leave > ?", willLeave, willArrive)
+
+	seatedAt := p.db.Sq.Builder.Select(
+		"SUM(reservations.number_of_people) AS seated",
+	).FromSelect(overlapping, "reservations").
+		JoinClause(squirrel.ConcatExpr(
+			"JOIN (",
+			overlapping.RemoveColumns().Column("GREATEST(will_arrive, ?) AS seated_at", willArrive).PlaceholderFormat(squirrel.Question),
+			") AS moments ON reservations.will_arrive <= moments.seated_at AND reservations.will_leave > moments.seated_at",
+		)).
+		GroupBy("moments.seated_at")
+
+	var seated int64
+	query, args, err := p.db.Sq.Builder.Select(
+		"COALESCE(MAX(seated), 0) AS seated",
+	).FromSelect(seatedAt, "seating").
+		ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return fmt.Errorf("failed to build SQL query for counting seated guests: %v", err)
 	}
-
-	rows, err := p.db.Query(ctx, query, args...)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+	if err = tx.QueryRow(ctx, query, args...).Scan(&seated); err != nil {
+		return fmt.Errorf("failed to count seated guests: %v", err)
 	}
-	defer rows.Close()
-	bookingAttractions = make([]*entity.GeneralBooking, 0)
-	for rows.Next() {
-		var bookedAttraction entity.GeneralBooking
-		if err = rows.Scan(
-			&bookedAttraction.Id,
-			&bookedAttraction.UserId,
-			&bookedAttraction.HraId,
-			&bookedAttraction.WillArrive,
-			&bookedAttraction.WillLeave,
-			&bookedAttraction.NumberOfPeople,
-			&bookedAttraction.IsCanceled,
-			&bookedAttraction.Reason,
-			&bookedAttraction.CreatedAt,
-			&bookedAttraction.UpdatedAt,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing bookingHotel: %v", err)
-		}
-		bookingAttractions = append(bookingAttractions, &bookedAttraction)
+	if seated+guests > seatCapacity {
+		return entity.NewErrOverbooking("restaurant")
 	}
 
-	queryCount := p.Count(bookingAttractionTable)
-	query, args, err = queryCount.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("user_id", user_id)).ToSql()
+	return nil
+}
+
+// checkTickets fails unless the tickets other bookings hold for the day and
+// for the entry window together with the new ones fit into the quotas, a zero
+// window quota leaves the entry windows unlimited
+func (p *bookingRepo) checkTickets(ctx context.Context, tx pgx.Tx, booking_id uuid.UUID, attraction_id string, entry time.Time, tickets int64, quota entity.TicketQuota) error {
+	day := time.Date(entry.Year(), entry.Month(), entry.Day(), 0, 0, 0, 0, entry.Location())
+	var soldForDay, soldForWindow int64
+	query, args, err := p.db.Sq.Builder.Select(
+		"COALESCE(SUM(number_of_people), 0) AS sold_for_day",
+	).Column("COALESCE(SUM(number_of_people) FILTER (WHERE will_arrive = ?), 0) AS sold_for_window", entry).
+		From(p.tableName).
+		Where(p.db.Sq.Equal("booking_type", entity.BookingAttraction)).
+		Where(p.db.Sq.Equal("hra_id", attraction_id)).
+		Where(p.db.Sq.NotEqual("id", booking_id)).
+		Where(p.db.Sq.NotEqual("status", entity.ReleasedStatuses)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		Where("will_arrive >= ? AND will_arrive < ?", day, day.AddDate(0, 0, 1)).
+		ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookingHotel: %v", err)
+		return fmt.Errorf("failed to build SQL query for counting sold tickets: %v", err)
 	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting bookingHotel: %v", err)
+	if err = tx.QueryRow(ctx, query, args...).Scan(&soldForDay, &soldForWindow); err != nil {
+		return fmt.Errorf("failed to count sold tickets: %v", err)
+	}
+	if soldForDay+tickets > quota.Daily {
+		return entity.NewErrOverbooking("attraction")
+	}
+	if quota.Window > 0 && soldForWindow+tickets > quota.Window {
+		return entity.NewErrOverbooking("entry window")
 	}
 
-	return bookingAttractions, count, nil
+	return nil
 }
 
-// GetAllBy HRA Id for each of tables
-func (p *bookingRepo) UHBGetAllByHId(ctx context.Context, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UHBGetAllByHId")
+// Get returns a booking that was not deleted, an empty type matches bookings of any type
+func (p *bookingRepo) Get(ctx context.Context, bookingType, id string) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "Get")
 	defer span.End()
 
-	var (
-		userIdList []*entity.Id
-		count      int64
-	)
-
-	selecter := p.UserIdSelecter(bookingHotelTable)
-
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
-	}
-
-	selecter = selecter.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("hra_id", hra_id))
-	query, args, err := selecter.ToSql()
+	query, args, err := p.ofType(p.Selecter(), bookingType).
+		Where(p.db.Sq.Equal("id", id)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return nil, fmt.Errorf("failed to build SQL query for getting booking: %v", err)
 	}
 
-	rows, err := p.db.Query(ctx, query, args...)
+	booking, err := scanBooking(p.db.QueryRow(ctx, query, args...))
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+		return nil, p.db.Error(err)
+	}
+	if err = p.withDetails(ctx, booking); err != nil {
+		return nil, err
 	}
-	defer rows.Close()
 
-	userIdList = make([]*entity.Id, 0)
-	for rows.Next() {
-		var userId entity.Id
-		if err = rows.Scan(
-			&userId.UserId,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing userId: %v", err)
-		}
-		userIdList = append(userIdList, &userId)
+	return booking, nil
+}
+
+// GetByConfirmationCode looks a booking of any type up by its confirmation code
+func (p *bookingRepo) GetByConfirmationCode(ctx context.Context, code string) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "GetByConfirmationCode")
+	defer span.End()
+
+	query, args, err := p.Selecter().
+		Where(p.db.Sq.Equal("confirmation_code", code)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting booking by confirmation code: %v", err)
 	}
 
-	queryCount := p.Count(bookingHotelTable)
-	query, args, err = queryCount.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("hra_id", hra_id)).ToSql()
+	booking, err := scanBooking(p.db.QueryRow(ctx, query, args...))
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting userId: %v", err)
+		return nil, p.db.Error(err)
 	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting userId: %v", err)
+	if err = p.withDetails(ctx, booking); err != nil {
+		return nil, err
 	}
 
-	return userIdList, count, nil
+	return booking, nil
+}
+
+// GetAllByUId lists bookings made by a user
+func (p *bookingRepo) GetAllByUId(ctx context.Context, bookingType string, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "GetAllByUId")
+	defer span.End()
+
+	return p.list(ctx, bookingType, limit, offset, p.db.Sq.Equal("deleted_at", nil), p.db.Sq.Equal("user_id", user_id))
 }
 
-func (p *bookingRepo) URBGetAllByRId(ctx context.Context, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "URBGetAllByRId")
+// GetAllByHraId lists users who booked a room, a restaurant or an attraction
+func (p *bookingRepo) GetAllByHraId(ctx context.Context, bookingType string, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "GetAllByHraId")
 	defer span.End()
 
 	var (
@@ -446,7 +623,7 @@ func (p *bookingRepo) URBGetAllByRId(ctx context.Context, limit, offset uint64,
 		count      int64
 	)
 
-	selecter := p.UserIdSelecter(bookingRestaurantTable)
+	selecter := p.ofType(p.UserIdSelecter(), bookingType)
 
 	if limit != 0 {
 		selecter = selecter.Limit(limit).Offset(offset)
@@ -466,16 +643,20 @@ func (p *bookingRepo) URBGetAllByRId(ctx context.Context, limit, offset uint64,
 
 	userIdList = make([]*entity.Id, 0)
 	for rows.Next() {
-		var userId entity.Id
+		var (
+			userId    entity.Id
+			createdAt time.Time
+		)
 		if err = rows.Scan(
 			&userId.UserId,
+			&createdAt,
 		); err != nil {
 			return nil, 0, fmt.Errorf("failed to scan row while listing userId: %v", err)
 		}
 		userIdList = append(userIdList, &userId)
 	}
 
-	queryCount := p.Count(bookingRestaurantTable)
+	queryCount := p.ofType(p.Count(), bookingType)
 	query, args, err = queryCount.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("hra_id", hra_id)).ToSql()
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to build SQL query for counting userId: %v", err)
@@ -488,665 +669,846 @@ func (p *bookingRepo) URBGetAllByRId(ctx context.Context, limit, offset uint64,
 	return userIdList, count, nil
 }
 
-func (p *bookingRepo) UABGetAllByAId(ctx context.Context, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UABGetAllByAId")
+// List lists bookings that were not deleted
+func (p *bookingRepo) List(ctx context.Context, bookingType string, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "List")
 	defer span.End()
 
-	var (
-		userIdList []*entity.Id
-		count      int64
-	)
+	return p.list(ctx, bookingType, limit, offset, p.db.Sq.Equal("deleted_at", nil))
+}
 
-	selecter := p.UserIdSelecter(bookingAttractionTable)
+// ListDeleted lists soft-deleted bookings
+func (p *bookingRepo) ListDeleted(ctx context.Context, bookingType string, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ListDeleted")
+	defer span.End()
 
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
+	return p.list(ctx, bookingType, limit, offset, p.db.Sq.NotEqual("deleted_at", nil))
+}
+
+// ListByItinerary lists the bookings of an itinerary that were not deleted
+func (p *bookingRepo) ListByItinerary(ctx context.Context, itinerary_id string) ([]*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ListByItinerary")
+	defer span.End()
+
+	bookings, _, err := p.list(ctx, "", 0, 0, p.db.Sq.Equal("itinerary_id", itinerary_id), p.db.Sq.Equal("deleted_at", nil))
+	return bookings, err
+}
+
+// ListByHraIds lists the bookings of bookingType made for any of hra_ids that
+// end on or after since, by arrival. Holds are not reservations yet and are
+// left out.
+func (p *bookingRepo) ListByHraIds(ctx context.Context, bookingType string, hra_ids []string, since time.Time) ([]*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ListByHraIds")
+	defer span.End()
+
+	if len(hra_ids) == 0 {
+		return []*entity.GeneralBooking{}, nil
 	}
 
-	selecter = selecter.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("hra_id", hra_id))
-	query, args, err := selecter.ToSql()
+	query, args, err := p.ofType(p.Selecter(), bookingType).
+		Where(p.db.Sq.Equal("hra_id", hra_ids)).
+		Where(p.db.Sq.NotEqual("status", []string{entity.BookingHeld, entity.BookingExpired})).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		Where("COALESCE(will_leave, will_arrive) >= ?", since).
+		OrderBy("will_arrive").
+		ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return nil, fmt.Errorf("failed to build SQL query for listing bookings of establishment: %v", err)
 	}
 
 	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+		return nil, fmt.Errorf("failed to execute SQL query for listing bookings of establishment: %v", err)
 	}
 	defer rows.Close()
 
-	userIdList = make([]*entity.Id, 0)
+	bookings := make([]*entity.GeneralBooking, 0)
 	for rows.Next() {
-		var userId entity.Id
-		if err = rows.Scan(
-			&userId.UserId,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing userId: %v", err)
+		booking, err := scanBooking(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing bookings of establishment: %v", err)
 		}
-		userIdList = append(userIdList, &userId)
+		bookings = append(bookings, booking)
 	}
-
-	queryCount := p.Count(bookingAttractionTable)
-	query, args, err = queryCount.Where(p.db.Sq.Equal("deleted_at", nil)).Where(p.db.Sq.Equal("hra_id", hra_id)).ToSql()
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting userId: %v", err)
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list bookings of establishment: %v", err)
 	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting userId: %v", err)
+
+	if err = p.withDetails(ctx, bookings...); err != nil {
+		return nil, err
 	}
 
-	return userIdList, count, nil
+	return bookings, nil
 }
 
-// List Bookings for Admin
-func (p *bookingRepo) UHBList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UHBList")
+// ListForOwner pages through the bookings made for any of hra_ids that match
+// filter, by arrival
+func (p *bookingRepo) ListForOwner(ctx context.Context, hra_ids []string, filter *entity.OwnerBookingFilter) ([]*entity.GeneralBooking, int64, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ListForOwner")
 	defer span.End()
 
-	var (
-		bookingHotels []*entity.GeneralBooking
-		count         int64
-	)
+	if len(hra_ids) == 0 {
+		return []*entity.GeneralBooking{}, 0, nil
+	}
+
+	conditions := []squirrel.Sqlizer{
+		p.db.Sq.Equal("hra_id", hra_ids),
+		p.db.Sq.Equal("deleted_at", nil),
+	}
+	if len(filter.Statuses) > 0 {
+		conditions = append(conditions, p.db.Sq.Equal("status", filter.Statuses))
+	} else {
+		conditions = append(conditions, p.db.Sq.NotEqual("status", []string{entity.BookingHeld, entity.BookingExpired}))
+	}
+	if !filter.From.IsZero() {
+		conditions = append(conditions, squirrel.Expr("COALESCE(will_leave, will_arrive) >= ?", filter.From))
+	}
+	if !filter.To.IsZero() {
+		conditions = append(conditions, squirrel.Expr("will_arrive < ?", filter.To))
+	}
+
+	return p.listOrdered(ctx, "", "will_arrive", filter.Limit, filter.Offset, conditions...)
+}
+
+// list pages through bookings of the given type matching all of the conditions
+func (p *bookingRepo) list(ctx context.Context, bookingType string, limit, offset uint64, conditions ...squirrel.Sqlizer) ([]*entity.GeneralBooking, int64, error) {
+	return p.listOrdered(ctx, bookingType, "created_at", limit, offset, conditions...)
+}
+
+// listOrdered is list with the bookings sorted by orderBy
+func (p *bookingRepo) listOrdered(ctx context.Context, bookingType, orderBy string, limit, offset uint64, conditions ...squirrel.Sqlizer) ([]*entity.GeneralBooking, int64, error) {
+	var count int64
 
-	selecter := p.Selecter(bookingHotelTable)
+	selecter := p.ofType(p.Selecter(), bookingType)
+	queryCount := p.ofType(p.Count(), bookingType)
+	for _, condition := range conditions {
+		selecter = selecter.Where(condition)
+		queryCount = queryCount.Where(condition)
+	}
 
 	if limit != 0 {
 		selecter = selecter.Limit(limit).Offset(offset)
 	}
 
-	selecter = selecter.Where(p.db.Sq.Equal("deleted_at", nil))
-	query, args, err := selecter.ToSql()
+	query, args, err := selecter.OrderBy(orderBy).ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return nil, 0, fmt.Errorf("failed to build SQL query for listing bookings: %v", err)
 	}
 
 	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+		return nil, 0, fmt.Errorf("failed to execute SQL query for listing bookings: %v", err)
 	}
 	defer rows.Close()
-	bookingHotels = make([]*entity.GeneralBooking, 0)
+
+	bookings := make([]*entity.GeneralBooking, 0)
 	for rows.Next() {
-		var bookedHotel entity.GeneralBooking
-		if err = rows.Scan(
-			&bookedHotel.Id,
-			&bookedHotel.UserId,
-			&bookedHotel.HraId,
-			&bookedHotel.WillArrive,
-			&bookedHotel.WillLeave,
-			&bookedHotel.NumberOfPeople,
-			&bookedHotel.IsCanceled,
-			&bookedHotel.Reason,
-			&bookedHotel.CreatedAt,
-			&bookedHotel.UpdatedAt,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing bookingHotel: %v", err)
+		booking, err := scanBooking(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan row while listing bookings: %v", err)
 		}
-		bookingHotels = append(bookingHotels, &bookedHotel)
+		bookings = append(bookings, booking)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to list bookings: %v", err)
 	}
 
-	queryCount := p.Count(bookingHotelTable)
-	query, args, err = queryCount.Where(p.db.Sq.Equal("deleted_at", nil)).ToSql()
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookingHotel: %v", err)
+	if err = p.withDetails(ctx, bookings...); err != nil {
+		return nil, 0, err
 	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting bookingHotel: %v", err)
+
+	query, args, err = queryCount.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookings: %v", err)
+	}
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return nil, 0, fmt.Errorf("failed to scan row while counting bookings: %v", err)
 	}
 
-	return bookingHotels, count, nil
+	return bookings, count, nil
 }
 
-func (p *bookingRepo) URBList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "URBList")
+// Update changes a booking of booking.BookingType, columns a type does not use
+// keep the values passed in, so ticket counts stay zero for hotels and the
+// price stays zero for restaurants
+func (p *bookingRepo) Update(ctx context.Context, booking *entity.GeneralBooking) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "Update")
 	defer span.End()
 
-	var (
-		bookingRestaurants []*entity.GeneralBooking
-		count              int64
-	)
-
-	selecter := p.Selecter(bookingRestaurantTable)
-
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
+	WA, err := parseReservationTime(booking.WillArrive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse will arrive: %v", err)
+	}
+	WL, err := parseReservationTime(booking.WillLeave)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse will leave: %v", err)
+	}
+	clauses := map[string]interface{}{
+		"user_id":          booking.UserId,
+		"hra_id":           booking.HraId,
+		"will_arrive":      WA,
+		"will_leave":       WL,
+		"number_of_people": booking.NumberOfPeople,
+		"reason":           booking.Reason,
+		"adult_tickets":    booking.AdultTickets,
+		"child_tickets":    booking.ChildTickets,
+		"total_price":      booking.TotalPrice,
+		"currency":         booking.Currency,
+		"updated_at":       booking.UpdatedAt,
+
+		"special_requests":     specialRequests(booking),
+		"special_request_note": booking.SpecialRequestNote,
+	}
+	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", booking.Id)).
+		Where(p.db.Sq.Equal("booking_type", booking.BookingType)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return booking, fmt.Errorf("failed to build SQL query for updating booking: %v", err)
 	}
 
-	selecter = selecter.Where(p.db.Sq.Equal("deleted_at", nil))
-	query, args, err := selecter.ToSql()
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return booking, fmt.Errorf("failed to begin transaction for updating booking: %v", err)
 	}
+	defer tx.Rollback(ctx)
 
-	rows, err := p.db.Query(ctx, query, args...)
+	commandTag, err := tx.Exec(ctx, sqlStr, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+		return booking, fmt.Errorf("failed to execute SQL query for updating booking: %v", err)
 	}
-	defer rows.Close()
-	bookingRestaurants = make([]*entity.GeneralBooking, 0)
-	for rows.Next() {
-		var bookedRestaurant entity.GeneralBooking
-		if err = rows.Scan(
-			&bookedRestaurant.Id,
-			&bookedRestaurant.UserId,
-			&bookedRestaurant.HraId,
-			&bookedRestaurant.WillArrive,
-			&bookedRestaurant.WillLeave,
-			&bookedRestaurant.NumberOfPeople,
-			&bookedRestaurant.IsCanceled,
-			&bookedRestaurant.Reason,
-			&bookedRestaurant.CreatedAt,
-			&bookedRestaurant.UpdatedAt,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing bookingHotel: %v", err)
-		}
-		bookingRestaurants = append(bookingRestaurants, &bookedRestaurant)
+
+	if commandTag.RowsAffected() == 0 {
+		return booking, fmt.Errorf("no rows affected while updating booking")
 	}
 
-	queryCount := p.Count(bookingRestaurantTable)
-	query, args, err = queryCount.Where(p.db.Sq.Equal("deleted_at", nil)).ToSql()
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookingHotel: %v", err)
+	// the guests and add-ons are replaced as a whole
+	if _, err = tx.Exec(ctx, "DELETE FROM "+bookingGuestTable+" WHERE booking_id = $1", booking.Id); err != nil {
+		return booking, fmt.Errorf("failed to delete guests of booking: %v", err)
 	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting bookingHotel: %v", err)
+	if _, err = tx.Exec(ctx, "DELETE FROM "+bookingAddOnTable+" WHERE booking_id = $1", booking.Id); err != nil {
+		return booking, fmt.Errorf("failed to delete add-ons of booking: %v", err)
+	}
+	if err = p.insertGuests(ctx, tx, booking); err != nil {
+		return booking, err
+	}
+	if err = p.insertAddOns(ctx, tx, booking); err != nil {
+		return booking, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return booking, fmt.Errorf("failed to commit updated booking: %v", err)
 	}
 
-	return bookingRestaurants, count, nil
+	return booking, nil
 }
 
-func (p *bookingRepo) UABList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UABList")
+// Reschedule moves booking to its new dates and party size once they fit into
+// capacity and records the change. It fails with *entity.ErrInvalidTransition
+// when the booking is no longer in status change.From.
+func (p *bookingRepo) Reschedule(ctx context.Context, booking *entity.GeneralBooking, capacity entity.Capacity, change *entity.StatusChange) error {
+	ctx, span := otlp.Start(ctx, "Repository", "Reschedule")
 	defer span.End()
 
-	var (
-		bookingAttractions []*entity.GeneralBooking
-		count              int64
-	)
-
-	selecter := p.Selecter(bookingAttractionTable)
-
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
+	WA, err := parseReservationTime(booking.WillArrive)
+	if err != nil {
+		return fmt.Errorf("failed to parse will arrive: %v", err)
+	}
+	WL, err := parseReservationTime(booking.WillLeave)
+	if err != nil {
+		return fmt.Errorf("failed to parse will leave: %v", err)
 	}
 
-	selecter = selecter.Where(p.db.Sq.Equal("deleted_at", nil))
-	query, args, err := selecter.ToSql()
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return fmt.Errorf("failed to begin transaction for rescheduling booking: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", booking.HraId); err != nil {
+		return fmt.Errorf("failed to lock %s for rescheduling: %v", booking.BookingType, err)
+	}
+	if err = p.checkCapacity(ctx, tx, booking, WA, WL, capacity); err != nil {
+		return err
+	}
+
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(map[string]interface{}{
+			"will_arrive":      WA,
+			"will_leave":       WL,
+			"number_of_people": booking.NumberOfPeople,
+			"adult_tickets":    booking.AdultTickets,
+			"child_tickets":    booking.ChildTickets,
+			"total_price":      booking.TotalPrice,
+			"currency":         booking.Currency,
+			"updated_at":       change.CreatedAt,
+		}).
+		Where(p.db.Sq.Equal("id", booking.Id)).
+		Where(p.db.Sq.Equal("booking_type", booking.BookingType)).
+		Where(p.db.Sq.Equal("status", change.From)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for rescheduling booking: %v", err)
 	}
 
-	rows, err := p.db.Query(ctx, query, args...)
+	commandTag, err := tx.Exec(ctx, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+		return fmt.Errorf("failed to execute SQL query for rescheduling booking: %v", err)
 	}
-	defer rows.Close()
-	bookingAttractions = make([]*entity.GeneralBooking, 0)
-	for rows.Next() {
-		var bookedAttraction entity.GeneralBooking
-		if err = rows.Scan(
-			&bookedAttraction.Id,
-			&bookedAttraction.UserId,
-			&bookedAttraction.HraId,
-			&bookedAttraction.WillArrive,
-			&bookedAttraction.WillLeave,
-			&bookedAttraction.NumberOfPeople,
-			&bookedAttraction.IsCanceled,
-			&bookedAttraction.Reason,
-			&bookedAttraction.CreatedAt,
-			&bookedAttraction.UpdatedAt,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing bookingHotel: %v", err)
-		}
-		bookingAttractions = append(bookingAttractions, &bookedAttraction)
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrInvalidTransition("booking", change.From, change.To)
 	}
 
-	queryCount := p.Count(bookingAttractionTable)
-	query, args, err = queryCount.Where(p.db.Sq.Equal("deleted_at", nil)).ToSql()
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookingHotel: %v", err)
+	if err = p.insertStatusChange(ctx, tx, change); err != nil {
+		return err
 	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting bookingHotel: %v", err)
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit rescheduled booking: %v", err)
 	}
 
-	return bookingAttractions, count, nil
+	return nil
 }
 
-// List Deleted Bookings for Admin
-func (p *bookingRepo) UHBListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UHBListDeleted")
+// Delete soft-deletes a booking of the given type
+func (p *bookingRepo) Delete(ctx context.Context, bookingType, id string) error {
+	ctx, span := otlp.Start(ctx, "Repository", "Delete")
 	defer span.End()
 
-	var (
-		bookingHotels []*entity.GeneralBooking
-		count         int64
-	)
-
-	selecter := p.Selecter(bookingHotelTable)
-
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
+	var deletedAt sql.NullTime
+	err := p.db.QueryRow(ctx, "SELECT deleted_at FROM "+p.tableName+" WHERE id = $1 AND booking_type = $2", id, bookingType).Scan(&deletedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return fmt.Errorf("%s: not found", id)
+		}
+		return fmt.Errorf("failed to query booking: %v", err)
+	}
+	if deletedAt.Valid && !deletedAt.Time.IsZero() {
+		return fmt.Errorf("%s: is already soft-deleted", id)
 	}
 
-	selecter = selecter.Where(p.db.Sq.NotEqual("deleted_at", nil))
-	query, args, err := selecter.ToSql()
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+	clauses := map[string]interface{}{
+		"deleted_at": time.Now().Format("2006-01-02T15:04:05"),
 	}
+	sqlBuilder := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", id)).
+		Where(p.db.Sq.Equal("booking_type", bookingType))
 
-	rows, err := p.db.Query(ctx, query, args...)
+	sqlStr, args, err := sqlBuilder.ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
-	}
-	defer rows.Close()
-	bookingHotels = make([]*entity.GeneralBooking, 0)
-	for rows.Next() {
-		var bookedHotel entity.GeneralBooking
-		if err = rows.Scan(
-			&bookedHotel.Id,
-			&bookedHotel.UserId,
-			&bookedHotel.HraId,
-			&bookedHotel.WillArrive,
-			&bookedHotel.WillLeave,
-			&bookedHotel.NumberOfPeople,
-			&bookedHotel.IsCanceled,
-			&bookedHotel.Reason,
-			&bookedHotel.CreatedAt,
-			&bookedHotel.UpdatedAt,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing bookingHotel: %v", err)
-		}
-		bookingHotels = append(bookingHotels, &bookedHotel)
+		return fmt.Errorf("failed to build SQL query for soft deleting booking: %v", err)
 	}
 
-	queryCount := p.Count(bookingHotelTable)
-	query, args, err = queryCount.Where(p.db.Sq.NotEqual("deleted_at", nil)).ToSql()
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookingHotel: %v", err)
+		return fmt.Errorf("failed to execute SQL query for soft deleting booking: %v", err)
 	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting bookingHotel: %v", err)
+
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("no rows affected while soft deleting booking")
 	}
 
-	return bookingHotels, count, nil
+	return nil
 }
 
-func (p *bookingRepo) URBListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "URBListDeleted")
+// UHBCountBooked returns per room how many rooms are taken on the busiest
+// night between willArrive and willLeave, rooms taken on no night are left out
+func (p *bookingRepo) UHBCountBooked(ctx context.Context, room_ids []string, willArrive, willLeave string) (map[string]int64, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "UHBCountBooked")
 	defer span.End()
 
-	var (
-		bookingRestaurants []*entity.GeneralBooking
-		count              int64
-	)
-
-	selecter := p.Selecter(bookingRestaurantTable)
-
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
+	booked := make(map[string]int64, len(room_ids))
+	if len(room_ids) == 0 {
+		return booked, nil
 	}
 
-	selecter = selecter.Where(p.db.Sq.NotEqual("deleted_at", nil))
-	query, args, err := selecter.ToSql()
+	query, args, err := p.db.Sq.Builder.Select(
+		"room_id",
+		"MAX(taken) AS taken",
+	).FromSelect(p.roomsTakenPerNight(room_ids, uuid.Nil, willArrive, willLeave), "taken_rooms").
+		GroupBy("room_id").
+		ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return nil, fmt.Errorf("failed to build SQL query for counting booked rooms: %v", err)
 	}
 
 	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+		return nil, fmt.Errorf("failed to execute SQL query for counting booked rooms: %v", err)
 	}
 	defer rows.Close()
-	bookingRestaurants = make([]*entity.GeneralBooking, 0)
+
 	for rows.Next() {
-		var bookedRestaurant entity.GeneralBooking
-		if err = rows.Scan(
-			&bookedRestaurant.Id,
-			&bookedRestaurant.UserId,
-			&bookedRestaurant.HraId,
-			&bookedRestaurant.WillArrive,
-			&bookedRestaurant.WillLeave,
-			&bookedRestaurant.NumberOfPeople,
-			&bookedRestaurant.IsCanceled,
-			&bookedRestaurant.Reason,
-			&bookedRestaurant.CreatedAt,
-			&bookedRestaurant.UpdatedAt,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing bookingHotel: %v", err)
+		var (
+			roomId string
+			count  int64
+		)
+		if err = rows.Scan(&roomId, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan row while counting booked rooms: %v", err)
 		}
-		bookingRestaurants = append(bookingRestaurants, &bookedRestaurant)
-	}
-
-	queryCount := p.Count(bookingRestaurantTable)
-	query, args, err = queryCount.Where(p.db.Sq.NotEqual("deleted_at", nil)).ToSql()
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookingHotel: %v", err)
-	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting bookingHotel: %v", err)
+		booked[roomId] = count
 	}
 
-	return bookingRestaurants, count, nil
+	return booked, rows.Err()
 }
 
-func (p *bookingRepo) UABListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UABListDeleted")
+// URBListBetween lists non-cancelled reservations of a restaurant overlapping [from, to)
+func (p *bookingRepo) URBListBetween(ctx context.Context, restaurant_id string, from, to time.Time) ([]*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "URBListBetween")
 	defer span.End()
 
-	var (
-		bookingAttractions []*entity.GeneralBooking
-		count              int64
-	)
-
-	selecter := p.Selecter(bookingAttractionTable)
-
-	if limit != 0 {
-		selecter = selecter.Limit(limit).Offset(offset)
-	}
-
-	selecter = selecter.Where(p.db.Sq.NotEqual("deleted_at", nil))
-	query, args, err := selecter.ToSql()
+	query, args, err := p.Selecter().
+		Where(p.db.Sq.Equal("booking_type", entity.BookingRestaurant)).
+		Where(p.db.Sq.Equal("hra_id", restaurant_id)).
+		Where(p.db.Sq.NotEqual("status", entity.ReleasedStatuses)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		Where("will_arrive < ? AND will_leave > ?", to, from).
+		OrderBy("will_arrive").
+		ToSql()
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for listing users: %v", err)
+		return nil, fmt.Errorf("failed to build SQL query for listing reservations: %v", err)
 	}
 
 	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute SQL query for listing users: %v", err)
+		return nil, fmt.Errorf("failed to execute SQL query for listing reservations: %v", err)
 	}
 	defer rows.Close()
-	bookingAttractions = make([]*entity.GeneralBooking, 0)
+
+	bookingRestaurants := make([]*entity.GeneralBooking, 0)
 	for rows.Next() {
-		var bookedAttraction entity.GeneralBooking
-		if err = rows.Scan(
-			&bookedAttraction.Id,
-			&bookedAttraction.UserId,
-			&bookedAttraction.HraId,
-			&bookedAttraction.WillArrive,
-			&bookedAttraction.WillLeave,
-			&bookedAttraction.NumberOfPeople,
-			&bookedAttraction.IsCanceled,
-			&bookedAttraction.Reason,
-			&bookedAttraction.CreatedAt,
-			&bookedAttraction.UpdatedAt,
-		); err != nil {
-			return nil, 0, fmt.Errorf("failed to scan row while listing bookingHotel: %v", err)
+		bookedRestaurant, err := scanBooking(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing reservations: %v", err)
 		}
-		bookingAttractions = append(bookingAttractions, &bookedAttraction)
+		bookingRestaurants = append(bookingRestaurants, bookedRestaurant)
 	}
 
-	queryCount := p.Count(bookingAttractionTable)
-	query, args, err = queryCount.Where(p.db.Sq.NotEqual("deleted_at", nil)).ToSql()
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to build SQL query for counting bookingHotel: %v", err)
-	}
-	row := p.db.QueryRow(ctx, query, args...)
-	if err = row.Scan(&count); err != nil {
-		return nil, 0, fmt.Errorf("failed to scan row while counting bookingHotel: %v", err)
-	}
-
-	return bookingAttractions, count, nil
+	return bookingRestaurants, rows.Err()
 }
 
-// Update
-func (p *bookingRepo) UHBUpdate(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UHBUpdate")
+// UABTicketsByEntry sums tickets sold for each entry window of an attraction on the given day
+func (p *bookingRepo) UABTicketsByEntry(ctx context.Context, attraction_id string, day time.Time) (map[string]int64, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "UABTicketsByEntry")
 	defer span.End()
 
-	WA, err := time.Parse("2006-01-02", bookingHotel.WillArrive)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse will arrive: %v", err)
-	}
-	WL, err := time.Parse("2006-01-02", bookingHotel.WillLeave)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse will leave: %v", err)
-	}
-	clauses := map[string]interface{}{
-		"id":               bookingHotel.Id,
-		"user_id":          bookingHotel.UserId,
-		"hra_id":           bookingHotel.HraId,
-		"will_arrive":      WA,
-		"will_leave":       WL,
-		"number_of_people": bookingHotel.NumberOfPeople,
-		"is_canceled":      bookingHotel.IsCanceled,
-		"reason":           bookingHotel.Reason,
-		"created_at":       bookingHotel.CreatedAt,
-		"updated_at":       bookingHotel.UpdatedAt,
-	}
-	sqlStr, args, err := p.db.Sq.Builder.Update(p.bookingHotelTable).
-		SetMap(clauses).
-		Where(p.db.Sq.Equal("id", bookingHotel.Id)).
+	query, args, err := p.db.Sq.Builder.Select(
+		"TO_CHAR(will_arrive, 'YYYY-MM-DD HH24:MI') AS entry",
+		"SUM(number_of_people) AS tickets",
+	).From(p.tableName).
+		Where(p.db.Sq.Equal("booking_type", entity.BookingAttraction)).
+		Where(p.db.Sq.Equal("hra_id", attraction_id)).
+		Where(p.db.Sq.NotEqual("status", entity.ReleasedStatuses)).
 		Where(p.db.Sq.Equal("deleted_at", nil)).
+		Where("will_arrive >= ? AND will_arrive < ?", day, day.AddDate(0, 0, 1)).
+		GroupBy("will_arrive").
 		ToSql()
 	if err != nil {
-		return bookingHotel, fmt.Errorf("failed to build SQL query for updating booked hotel: %v", err)
+		return nil, fmt.Errorf("failed to build SQL query for counting sold tickets: %v", err)
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
-		return bookingHotel, fmt.Errorf("failed to execute SQL query for updating booked hotel: %v", err)
+		return nil, fmt.Errorf("failed to execute SQL query for counting sold tickets: %v", err)
 	}
+	defer rows.Close()
 
-	if commandTag.RowsAffected() == 0 {
-		return bookingHotel, fmt.Errorf("no rows affected while updating booked hotel")
+	sold := make(map[string]int64)
+	for rows.Next() {
+		var (
+			entry   string
+			tickets int64
+		)
+		if err = rows.Scan(&entry, &tickets); err != nil {
+			return nil, fmt.Errorf("failed to scan row while counting sold tickets: %v", err)
+		}
+		sold[entry] = tickets
 	}
 
-	return bookingHotel, nil
+	return sold, rows.Err()
+}
+
+// parseReservationTime accepts a reservation time or a bare date, both are
+// the local time of the establishment and come back labelled UTC, see
+// entity.ReservationClock
+func parseReservationTime(value string) (time.Time, error) {
+	if t, err := time.Parse(entity.ReservationLayout, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
 }
 
-func (p *bookingRepo) URBUpdate(ctx context.Context, bookingRestaurant *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "URBUpdate")
+// GetStatus returns the current status of a booking together with the user who made it
+func (p *bookingRepo) GetStatus(ctx context.Context, bookingType, id string) (string, string, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "GetStatus")
 	defer span.End()
 
-	WA, err := time.Parse("2006-01-02", bookingRestaurant.WillArrive)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse will arrive: %v", err)
-	}
-	WL, err := time.Parse("2006-01-02", bookingRestaurant.WillLeave)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse will leave: %v", err)
-	}
-	clauses := map[string]interface{}{
-		"id":               bookingRestaurant.Id,
-		"user_id":          bookingRestaurant.UserId,
-		"hra_id":           bookingRestaurant.HraId,
-		"will_arrive":      WA,
-		"will_leave":       WL,
-		"number_of_people": bookingRestaurant.NumberOfPeople,
-		"is_canceled":      bookingRestaurant.IsCanceled,
-		"reason":           bookingRestaurant.Reason,
-		"created_at":       bookingRestaurant.CreatedAt,
-		"updated_at":       bookingRestaurant.UpdatedAt,
-	}
-	sqlStr, args, err := p.db.Sq.Builder.Update(p.bookingRestaurantTable).
-		SetMap(clauses).
-		Where(p.db.Sq.Equal("id", bookingRestaurant.Id)).
+	query, args, err := p.db.Sq.Builder.Select("status", "user_id").From(p.tableName).
+		Where(p.db.Sq.Equal("id", id)).
+		Where(p.db.Sq.Equal("booking_type", bookingType)).
 		Where(p.db.Sq.Equal("deleted_at", nil)).
 		ToSql()
 	if err != nil {
-		return bookingRestaurant, fmt.Errorf("failed to build SQL query for updating booked hotel: %v", err)
+		return "", "", fmt.Errorf("failed to build SQL query for getting booking status: %v", err)
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
-	if err != nil {
-		return bookingRestaurant, fmt.Errorf("failed to execute SQL query for updating booked hotel: %v", err)
+	var status, user_id string
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&status, &user_id); err != nil {
+		return "", "", p.db.Error(err)
 	}
 
-	if commandTag.RowsAffected() == 0 {
-		return bookingRestaurant, fmt.Errorf("no rows affected while updating booked hotel")
-	}
-
-	return bookingRestaurant, nil
+	return status, user_id, nil
 }
 
-func (p *bookingRepo) UABUpdate(ctx context.Context, bookingAttraction *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Repository", "UABUpdate")
+// UpdateStatus moves a booking from change.From to change.To and records the
+// change. It fails with *entity.ErrInvalidTransition when the booking is no
+// longer in status change.From, e.g. when it was moved concurrently.
+func (p *bookingRepo) UpdateStatus(ctx context.Context, change *entity.StatusChange) error {
+	ctx, span := otlp.Start(ctx, "Repository", "UpdateStatus")
 	defer span.End()
 
-	WA, err := time.Parse("2006-01-02", bookingAttraction.WillArrive)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse date of birth: %v", err)
+	clauses := map[string]interface{}{
+		"status":     change.To,
+		"updated_at": change.CreatedAt,
 	}
-	WL, err := time.Parse("2006-01-02", bookingAttraction.WillLeave)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse date of birth: %v", err)
+	// the booking keeps the reason it was cancelled for
+	if change.To == entity.BookingCancelled && change.Reason != "" {
+		clauses["reason"] = change.Reason
 	}
-	clauses := map[string]interface{}{
-		"id":               bookingAttraction.Id,
-		"user_id":          bookingAttraction.UserId,
-		"hra_id":           bookingAttraction.HraId,
-		"will_arrive":      WA,
-		"will_leave":       WL,
-		"number_of_people": bookingAttraction.NumberOfPeople,
-		"is_canceled":      bookingAttraction.IsCanceled,
-		"reason":           bookingAttraction.Reason,
-		"created_at":       bookingAttraction.CreatedAt,
-		"updated_at":       bookingAttraction.UpdatedAt,
+	// a booking waits for the owner only until its status changes, unless
+	// the change starts the wait
+	if change.ApprovalDueAt.IsZero() {
+		clauses["approval_due_at"] = nil
+	} else {
+		clauses["approval_due_at"] = change.ApprovalDueAt
 	}
-	sqlStr, args, err := p.db.Sq.Builder.Update(p.bookingAttractionTable).
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).
 		SetMap(clauses).
-		Where(p.db.Sq.Equal("id", bookingAttraction.Id)).
+		Where(p.db.Sq.Equal("id", change.BookingId)).
+		Where(p.db.Sq.Equal("booking_type", change.BookingType)).
+		Where(p.db.Sq.Equal("status", change.From)).
 		Where(p.db.Sq.Equal("deleted_at", nil)).
 		ToSql()
 	if err != nil {
-		return bookingAttraction, fmt.Errorf("failed to build SQL query for updating booked hotel: %v", err)
+		return fmt.Errorf("failed to build SQL query for updating booking status: %v", err)
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
-		return bookingAttraction, fmt.Errorf("failed to execute SQL query for updating booked hotel: %v", err)
+		return fmt.Errorf("failed to begin transaction for updating booking status: %v", err)
 	}
+	defer tx.Rollback(ctx)
 
+	commandTag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for updating booking status: %v", err)
+	}
 	if commandTag.RowsAffected() == 0 {
-		return bookingAttraction, fmt.Errorf("no rows affected while updating booked hotel")
+		return entity.NewErrInvalidTransition("booking", change.From, change.To)
 	}
 
-	return bookingAttraction, nil
+	if err = p.insertStatusChange(ctx, tx, change); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit booking status: %v", err)
+	}
+
+	return nil
 }
 
-// Delete
-func (p *bookingRepo) UHBDelete(ctx context.Context, id string) error {
-	ctx, span := otlp.Start(ctx, "Repository", "UHBDelete")
+// ExpireHolds moves the holds that ran out by now to expired, which gives
+// their place back, and returns them
+func (p *bookingRepo) ExpireHolds(ctx context.Context, now time.Time) ([]*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ExpireHolds")
 	defer span.End()
 
-	var deletedAt sql.NullTime
-	err := p.db.QueryRow(ctx, "SELECT deleted_at FROM "+p.bookingHotelTable+" WHERE id = $1", id).Scan(&deletedAt)
+	return p.moveDue(ctx, entity.BookingHeld, entity.BookingExpired, "hold expired",
+		squirrel.Expr("hold_expires_at <= ?", now), now)
+}
+
+// MarkNoShows moves the confirmed bookings of bookingType that were due to
+// start before arrivedBefore and were never checked in to no_show, and
+// returns them. arrivedBefore is a reservation time, now is recorded with the
+// change.
+func (p *bookingRepo) MarkNoShows(ctx context.Context, bookingType string, arrivedBefore, now time.Time) ([]*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "MarkNoShows")
+	defer span.End()
+
+	return p.moveDue(ctx, entity.BookingConfirmed, entity.BookingNoShow, "guest did not arrive",
+		squirrel.And{
+			p.db.Sq.Equal("booking_type", bookingType),
+			squirrel.Expr("will_arrive < ?", arrivedBefore),
+		}, now)
+}
+
+// CompleteBookings moves the checked in bookings that ended by endedBy to
+// completed, and returns them. Bookings without a will_leave end when they
+// start. endedBy is a reservation time, now is recorded with the change.
+func (p *bookingRepo) CompleteBookings(ctx context.Context, endedBy, now time.Time) ([]*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "CompleteBookings")
+	defer span.End()
+
+	return p.moveDue(ctx, entity.BookingCheckedIn, entity.BookingCompleted, "booking ended",
+		squirrel.Expr("COALESCE(will_leave, will_arrive) <= ?", endedBy), now)
+}
+
+// DeclineUnanswered cancels the pending bookings the owner did not accept
+// or decline by their approval_due_at, and returns them
+func (p *bookingRepo) DeclineUnanswered(ctx context.Context, now time.Time) ([]*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "DeclineUnanswered")
+	defer span.End()
+
+	return p.moveDue(ctx, entity.BookingPending, entity.BookingCancelled, "not answered by the establishment in time",
+		squirrel.Expr("approval_due_at <= ?", now), now)
+}
+
+// moveDue moves the bookings in status from that match due to status to and
+// records the change for each of them. Bookings locked by another
+// transaction are left for the next run.
+func (p *bookingRepo) moveDue(ctx context.Context, from, to, reason string, due squirrel.Sqlizer, now time.Time) ([]*entity.GeneralBooking, error) {
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return fmt.Errorf("%s: not found", id)
-		}
-		return fmt.Errorf("failed to query booking: %v", err)
-	}
-	if deletedAt.Valid && !deletedAt.Time.IsZero() {
-		return fmt.Errorf("%s: is already soft-deleted", id)
+		return nil, fmt.Errorf("failed to begin transaction for moving %s bookings to %s: %v", from, to, err)
 	}
+	defer tx.Rollback(ctx)
 
-	clauses := map[string]interface{}{
-		"deleted_at": time.Now().Format("2006-01-02T15:04:05"),
+	query, args, err := p.Selecter().
+		Where(p.db.Sq.Equal("status", from)).
+		Where(due).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for listing due %s bookings: %v", from, err)
 	}
-	sqlBuilder := p.db.Sq.Builder.Update(p.bookingHotelTable).
-		SetMap(clauses).
-		Where(p.db.Sq.Equal("id", id))
 
-	sqlStr, args, err := sqlBuilder.ToSql()
+	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to build SQL query for canceling booking: %v", err)
+		return nil, fmt.Errorf("failed to execute SQL query for listing due %s bookings: %v", from, err)
+	}
+	var (
+		bookings []*entity.GeneralBooking
+		ids      []string
+	)
+	for rows.Next() {
+		booking, err := scanBooking(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan row while listing due %s bookings: %v", from, err)
+		}
+		booking.Status = to
+		bookings = append(bookings, booking)
+		ids = append(ids, booking.Id.String())
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error encountered while iterating over due %s bookings: %v", from, err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	clauses := map[string]interface{}{
+		"status":          to,
+		"updated_at":      now,
+		"approval_due_at": nil,
+	}
+	if to == entity.BookingCancelled {
+		clauses["reason"] = reason
+	}
+	query, args, err = p.db.Sq.Builder.Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", ids)).
+		ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to execute SQL query for canceling booking: %v", err)
+		return nil, fmt.Errorf("failed to build SQL query for moving %s bookings to %s: %v", from, to, err)
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for moving %s bookings to %s: %v", from, to, err)
+	}
+	for _, booking := range bookings {
+		if err = p.insertStatusChange(ctx, tx, &entity.StatusChange{
+			BookingId:   booking.Id.String(),
+			BookingType: booking.BookingType,
+			From:        from,
+			To:          to,
+			Reason:      reason,
+			ChangedBy:   "system",
+			CreatedAt:   now,
+		}); err != nil {
+			return nil, err
+		}
 	}
 
-	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("no rows affected while canceling booking")
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit %s bookings: %v", to, err)
 	}
 
-	return nil
+	return bookings, nil
 }
 
-func (p *bookingRepo) URBDelete(ctx context.Context, id string) error {
-	ctx, span := otlp.Start(ctx, "Repository", "URBUpdate")
+// ListDueReminders lists the confirmed bookings starting after now and no
+// later than until that were not reminded of yet, soonest first
+func (p *bookingRepo) ListDueReminders(ctx context.Context, now, until time.Time) ([]*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ListDueReminders")
 	defer span.End()
 
-	var deletedAt sql.NullTime
-	err := p.db.QueryRow(ctx, "SELECT deleted_at FROM "+p.bookingRestaurantTable+" WHERE id = $1", id).Scan(&deletedAt)
+	query, args, err := p.Selecter().
+		Where(p.db.Sq.Equal("status", entity.BookingConfirmed)).
+		Where("will_arrive > ?", now).
+		Where("will_arrive <= ?", until).
+		Where(p.db.Sq.Equal("reminded_at", nil)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		OrderBy("will_arrive").
+		ToSql()
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return fmt.Errorf("%s: not found", id)
-		}
-		return fmt.Errorf("failed to query user: %v", err)
+		return nil, fmt.Errorf("failed to build SQL query for listing due reminders: %v", err)
 	}
-	if deletedAt.Valid && !deletedAt.Time.IsZero() {
-		return fmt.Errorf("%s: is already soft-deleted", id)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing due reminders: %v", err)
 	}
+	defer rows.Close()
 
-	clauses := map[string]interface{}{
-		"deleted_at": time.Now().Format("2006-01-02T15:04:05"),
+	bookings := make([]*entity.GeneralBooking, 0)
+	for rows.Next() {
+		booking, err := scanBooking(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing due reminders: %v", err)
+		}
+		bookings = append(bookings, booking)
 	}
-	sqlBuilder := p.db.Sq.Builder.Update(p.bookingRestaurantTable).
-		SetMap(clauses).
-		Where(p.db.Sq.Equal("id", id))
 
-	sqlStr, args, err := sqlBuilder.ToSql()
-	if err != nil {
-		return fmt.Errorf("failed to build SQL query for soft deleting user: %v", err)
+	return bookings, rows.Err()
+}
+
+// MarkReminded records that the bookings were reminded of at
+func (p *bookingRepo) MarkReminded(ctx context.Context, ids []string, at time.Time) error {
+	ctx, span := otlp.Start(ctx, "Repository", "MarkReminded")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).
+		Set("reminded_at", at).
+		Where(p.db.Sq.Equal("id", ids)).
+		ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to execute SQL query for soft deleting user: %v", err)
+		return fmt.Errorf("failed to build SQL query for marking bookings reminded: %v", err)
 	}
-
-	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("no rows affected while soft deleting user")
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to execute SQL query for marking bookings reminded: %v", err)
 	}
 
 	return nil
 }
 
-func (p *bookingRepo) UABDelete(ctx context.Context, id string) error {
-	ctx, span := otlp.Start(ctx, "Repository", "UABUpdate")
+// StatusHistory lists the status changes of a booking, oldest first
+func (p *bookingRepo) StatusHistory(ctx context.Context, booking_id string) ([]*entity.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "StatusHistory")
 	defer span.End()
 
-	var deletedAt sql.NullTime
-	err := p.db.QueryRow(ctx, "SELECT deleted_at FROM "+p.bookingAttractionTable+" WHERE id = $1", id).Scan(&deletedAt)
+	query, args, err := p.db.Sq.Builder.Select(
+		"id",
+		"booking_id",
+		"booking_type",
+		"from_status",
+		"to_status",
+		"reason",
+		"changed_by",
+		"created_at",
+		"refund_percent",
+		"refund_amount",
+		"currency",
+		"from_will_arrive",
+		"from_will_leave",
+		"to_will_arrive",
+		"to_will_leave",
+		"price_difference",
+		"change_fee",
+	).From(bookingStatusHistoryTable).
+		Where(p.db.Sq.Equal("booking_id", booking_id)).
+		OrderBy("created_at").
+		ToSql()
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return fmt.Errorf("%s: not found", id)
-		}
-		return fmt.Errorf("failed to query user: %v", err)
-	}
-	if deletedAt.Valid && !deletedAt.Time.IsZero() {
-		return fmt.Errorf("%s: is already soft-deleted", id)
+		return nil, fmt.Errorf("failed to build SQL query for listing booking status history: %v", err)
 	}
 
-	clauses := map[string]interface{}{
-		"deleted_at": time.Now().Format("2006-01-02T15:04:05"),
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing booking status history: %v", err)
 	}
-	sqlBuilder := p.db.Sq.Builder.Update(p.bookingAttractionTable).
-		SetMap(clauses).
-		Where(p.db.Sq.Equal("id", id))
+	defer rows.Close()
 
-	sqlStr, args, err := sqlBuilder.ToSql()
-	if err != nil {
-		return fmt.Errorf("failed to build SQL query for soft deleting user: %v", err)
+	changes := make([]*entity.StatusChange, 0)
+	for rows.Next() {
+		var change entity.StatusChange
+		if err = rows.Scan(
+			&change.Id,
+			&change.BookingId,
+			&change.BookingType,
+			&change.From,
+			&change.To,
+			&change.Reason,
+			&change.ChangedBy,
+			&change.CreatedAt,
+			&change.RefundPercent,
+			&change.RefundAmount,
+			&change.Currency,
+			&change.FromWillArrive,
+			&change.FromWillLeave,
+			&change.ToWillArrive,
+			&change.ToWillLeave,
+			&change.PriceDifference,
+			&change.ChangeFee,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing booking status history: %v", err)
+		}
+		changes = append(changes, &change)
 	}
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	return changes, rows.Err()
+}
+
+func (p *bookingRepo) insertStatusChange(ctx context.Context, tx pgx.Tx, change *entity.StatusChange) error {
+	if change.Id == "" {
+		change.Id = uuid.NewString()
+	}
+
+	query, args, err := p.db.Sq.Builder.Insert(bookingStatusHistoryTable).SetMap(map[string]interface{}{
+		"id":               change.Id,
+		"booking_id":       change.BookingId,
+		"booking_type":     change.BookingType,
+		"from_status":      change.From,
+		"to_status":        change.To,
+		"reason":           change.Reason,
+		"changed_by":       change.ChangedBy,
+		"created_at":       change.CreatedAt,
+		"refund_percent":   change.RefundPercent,
+		"refund_amount":    change.RefundAmount,
+		"currency":         change.Currency,
+		"from_will_arrive": change.FromWillArrive,
+		"from_will_leave":  change.FromWillLeave,
+		"to_will_arrive":   change.ToWillArrive,
+		"to_will_leave":    change.ToWillLeave,
+		"price_difference": change.PriceDifference,
+		"change_fee":       change.ChangeFee,
+	}).ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to execute SQL query for soft deleting user: %v", err)
+		return fmt.Errorf("failed to build SQL query for recording booking status: %v", err)
 	}
 
-	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("no rows affected while soft deleting user")
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to execute SQL query for recording booking status: %v", err)
 	}
 
 	return nil
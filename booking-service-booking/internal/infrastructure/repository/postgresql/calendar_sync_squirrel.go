@@ -0,0 +1,327 @@
+package postgresql
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"Booking/booking-service-booking/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	calendarSourceTable = "booking_calendar_source"
+	externalBlockTable  = "booking_external_block"
+
+	// blocks are inserted in batches of this many rows to stay far below the
+	// limit on query parameters
+	blockBatchSize = 1000
+)
+
+type calendarSyncRepo struct {
+	sourceTable string
+	blockTable  string
+	db          *postgres.PostgresDB
+}
+
+func NewCalendarSyncRepo(db *postgres.PostgresDB) *calendarSyncRepo {
+	return &calendarSyncRepo{
+		sourceTable: calendarSourceTable,
+		blockTable:  externalBlockTable,
+		db:          db,
+	}
+}
+
+func (p *calendarSyncRepo) sourceSelecter() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.Select(
+		"id",
+		"room_id",
+		"name",
+		"url",
+		"last_synced_at",
+		"last_error",
+		fmt.Sprintf("(SELECT COUNT(*) FROM %s WHERE source_id = %s.id) AS blocks", p.blockTable, p.sourceTable),
+		"created_at",
+		"updated_at",
+	).From(p.sourceTable)
+}
+
+// scanCalendarSource reads a row built by sourceSelecter
+func scanCalendarSource(row pgx.Row) (*entity.CalendarSource, error) {
+	var (
+		source       entity.CalendarSource
+		lastSyncedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&source.Id,
+		&source.RoomId,
+		&source.Name,
+		&source.Url,
+		&lastSyncedAt,
+		&source.LastError,
+		&source.Blocks,
+		&source.CreatedAt,
+		&source.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if lastSyncedAt.Valid {
+		source.LastSyncedAt = lastSyncedAt.Time
+	}
+
+	return &source, nil
+}
+
+// CreateSource stores a calendar source without any blocks
+func (p *calendarSyncRepo) CreateSource(ctx context.Context, source *entity.CalendarSource) (*entity.CalendarSource, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "CalendarSourceCreate")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Insert(p.sourceTable).SetMap(map[string]interface{}{
+		"id":         source.Id,
+		"room_id":    source.RoomId,
+		"name":       source.Name,
+		"url":        source.Url,
+		"created_at": source.CreatedAt,
+		"updated_at": source.UpdatedAt,
+	}).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for creating calendar source: %v", err)
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return source, nil
+}
+
+func (p *calendarSyncRepo) GetSource(ctx context.Context, id string) (*entity.CalendarSource, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "CalendarSourceGet")
+	defer span.End()
+
+	query, args, err := p.sourceSelecter().
+		Where(p.db.Sq.Equal("id", id)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting calendar source: %v", err)
+	}
+
+	source, err := scanCalendarSource(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return source, nil
+}
+
+// ListSources lists the calendar sources of a room, an empty room_id lists
+// the sources of every room
+func (p *calendarSyncRepo) ListSources(ctx context.Context, room_id string) ([]*entity.CalendarSource, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "CalendarSourceList")
+	defer span.End()
+
+	builder := p.sourceSelecter().OrderBy("created_at")
+	if room_id != "" {
+		builder = builder.Where(p.db.Sq.Equal("room_id", room_id))
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for listing calendar sources: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing calendar sources: %v", err)
+	}
+	defer rows.Close()
+
+	sources := make([]*entity.CalendarSource, 0)
+	for rows.Next() {
+		source, err := scanCalendarSource(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing calendar sources: %v", err)
+		}
+		sources = append(sources, source)
+	}
+
+	return sources, rows.Err()
+}
+
+// DeleteSource removes a calendar source together with its blocks
+func (p *calendarSyncRepo) DeleteSource(ctx context.Context, id string) error {
+	ctx, span := otlp.Start(ctx, "Repository", "CalendarSourceDelete")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Delete(p.sourceTable).
+		Where(p.db.Sq.Equal("id", id)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for deleting calendar source: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for deleting calendar source: %v", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("calendar source")
+	}
+
+	return nil
+}
+
+// ReplaceBlocks swaps the blocks of a source for the ones just imported and
+// marks the source synced. Syncs of the same source run one after another.
+func (p *calendarSyncRepo) ReplaceBlocks(ctx context.Context, source *entity.CalendarSource, blocks []*entity.ExternalBlock, syncedAt time.Time) error {
+	ctx, span := otlp.Start(ctx, "Repository", "ExternalBlockReplace")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction for replacing external blocks: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := p.db.Sq.Builder.Update(p.sourceTable).
+		SetMap(map[string]interface{}{
+			"last_synced_at": syncedAt,
+			"last_error":     "",
+			"updated_at":     syncedAt,
+		}).
+		Where(p.db.Sq.Equal("id", source.Id)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for marking calendar source synced: %v", err)
+	}
+	commandTag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for marking calendar source synced: %v", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("calendar source")
+	}
+
+	query, args, err = p.db.Sq.Builder.Delete(p.blockTable).
+		Where(p.db.Sq.Equal("source_id", source.Id)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for deleting external blocks: %v", err)
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to execute SQL query for deleting external blocks: %v", err)
+	}
+
+	for start := 0; start < len(blocks); start += blockBatchSize {
+		end := start + blockBatchSize
+		if end > len(blocks) {
+			end = len(blocks)
+		}
+
+		builder := p.db.Sq.Builder.Insert(p.blockTable).
+			Columns("id", "source_id", "room_id", "uid", "summary", "starts_on", "ends_on")
+		for _, block := range blocks[start:end] {
+			builder = builder.Values(block.Id, source.Id, source.RoomId, block.Uid, block.Summary, block.StartsOn, block.EndsOn)
+		}
+		query, args, err = builder.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build SQL query for inserting external blocks: %v", err)
+		}
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to execute SQL query for inserting external blocks: %v", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit external blocks: %v", err)
+	}
+
+	source.LastSyncedAt = syncedAt
+	source.LastError = ""
+	source.Blocks = int64(len(blocks))
+	source.UpdatedAt = syncedAt
+	return nil
+}
+
+// SetSyncError records why the last sync of a source failed, its blocks
+// stay as they were
+func (p *calendarSyncRepo) SetSyncError(ctx context.Context, source *entity.CalendarSource, syncError string, at time.Time) error {
+	ctx, span := otlp.Start(ctx, "Repository", "CalendarSourceSetSyncError")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Update(p.sourceTable).
+		SetMap(map[string]interface{}{
+			"last_error": syncError,
+			"updated_at": at,
+		}).
+		Where(p.db.Sq.Equal("id", source.Id)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for recording calendar sync error: %v", err)
+	}
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to execute SQL query for recording calendar sync error: %v", err)
+	}
+
+	source.LastError = syncError
+	source.UpdatedAt = at
+	return nil
+}
+
+// ListBlocks lists the blocks of a room taking any night from from up to to,
+// zero times leave that side open
+func (p *calendarSyncRepo) ListBlocks(ctx context.Context, room_id string, from, to time.Time) ([]*entity.ExternalBlock, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ExternalBlockList")
+	defer span.End()
+
+	builder := p.db.Sq.Builder.Select(
+		"id",
+		"source_id",
+		"room_id",
+		"uid",
+		"summary",
+		"starts_on",
+		"ends_on",
+	).From(p.blockTable).
+		Where(p.db.Sq.Equal("room_id", room_id)).
+		OrderBy("starts_on")
+	if !from.IsZero() {
+		builder = builder.Where("ends_on > ?", from)
+	}
+	if !to.IsZero() {
+		builder = builder.Where("starts_on < ?", to)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for listing external blocks: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing external blocks: %v", err)
+	}
+	defer rows.Close()
+
+	blocks := make([]*entity.ExternalBlock, 0)
+	for rows.Next() {
+		var block entity.ExternalBlock
+		if err = rows.Scan(
+			&block.Id,
+			&block.SourceId,
+			&block.RoomId,
+			&block.Uid,
+			&block.Summary,
+			&block.StartsOn,
+			&block.EndsOn,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing external blocks: %v", err)
+		}
+		blocks = append(blocks, &block)
+	}
+
+	return blocks, rows.Err()
+}
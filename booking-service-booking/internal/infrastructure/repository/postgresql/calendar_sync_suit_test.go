@@ -0,0 +1,101 @@
+package postgresql
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/config"
+	"Booking/booking-service-booking/internal/pkg/postgres"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarSyncRepo(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewCalendarSyncRepo(db)
+	bookings := NewBookingRepo(db)
+	ctx := context.Background()
+	roomId := uuid.NewString()
+	now := time.Now().UTC().Truncate(time.Second)
+	day := func(value string) time.Time {
+		parsed, err := time.Parse("2006-01-02", value)
+		assert.NoError(t, err)
+		return parsed
+	}
+
+	source, err := repo.CreateSource(ctx, &entity.CalendarSource{
+		Id:        uuid.NewString(),
+		RoomId:    roomId,
+		Name:      "other platform",
+		Url:       "http://calendar.test/listing.ics",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	assert.NoError(t, err)
+
+	err = repo.ReplaceBlocks(ctx, source, []*entity.ExternalBlock{
+		{Id: uuid.NewString(), Uid: "stay-1", StartsOn: day("2030-06-01"), EndsOn: day("2030-06-04")},
+		{Id: uuid.NewString(), Uid: "stay-2", StartsOn: day("2030-06-10"), EndsOn: day("2030-06-12")},
+	}, now)
+	assert.NoError(t, err)
+
+	got, err := repo.GetSource(ctx, source.Id)
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, int64(2), got.Blocks)
+		assert.Equal(t, now, got.LastSyncedAt)
+	}
+
+	blocks, err := repo.ListBlocks(ctx, roomId, day("2030-06-03"), day("2030-06-11"))
+	assert.NoError(t, err)
+	assert.Len(t, blocks, 2)
+
+	// a blocked night takes the only room
+	book := func(arrive, leave string) error {
+		_, err := bookings.Create(ctx, &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    entity.BookingHotel,
+			UserId:         uuid.NewString(),
+			HraId:          roomId,
+			WillArrive:     arrive,
+			WillLeave:      leave,
+			NumberOfPeople: 2,
+			Status:         entity.BookingPending,
+			CreatedAt:      now,
+		}, entity.Capacity{Rooms: 1})
+		return err
+	}
+	var errOverbooking *entity.ErrOverbooking
+	assert.ErrorAs(t, book("2030-06-03", "2030-06-05"), &errOverbooking)
+	assert.NoError(t, book("2030-06-04", "2030-06-06"))
+
+	booked, err := bookings.UHBCountBooked(ctx, []string{roomId}, "2030-06-10", "2030-06-11")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), booked[roomId])
+
+	// a sync replaces what the previous one imported
+	err = repo.ReplaceBlocks(ctx, source, nil, now)
+	assert.NoError(t, err)
+	assert.NoError(t, book("2030-06-01", "2030-06-03"))
+
+	err = repo.SetSyncError(ctx, source, "calendar responded with 404 Not Found", now)
+	assert.NoError(t, err)
+
+	sources, err := repo.ListSources(ctx, roomId)
+	assert.NoError(t, err)
+	if assert.Len(t, sources, 1) {
+		assert.Equal(t, "calendar responded with 404 Not Found", sources[0].LastError)
+	}
+
+	assert.NoError(t, repo.DeleteSource(ctx, source.Id))
+	_, err = repo.GetSource(ctx, source.Id)
+	assert.ErrorIs(t, err, entity.ErrorNotFound)
+}
@@ -0,0 +1,164 @@
+package postgresql
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"Booking/booking-service-booking/internal/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const itineraryTable = "booking_itinerary"
+
+type itineraryRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewItineraryRepo(db *postgres.PostgresDB) *itineraryRepo {
+	return &itineraryRepo{
+		tableName: itineraryTable,
+		db:        db,
+	}
+}
+
+func (p *itineraryRepo) itinerarySelecter() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.Select(
+		"id",
+		"user_id",
+		"status",
+		"created_at",
+		"updated_at",
+	).From(p.tableName)
+}
+
+// scanItinerary reads a row built by itinerarySelecter
+func scanItinerary(row pgx.Row) (*entity.Itinerary, error) {
+	var itinerary entity.Itinerary
+	if err := row.Scan(
+		&itinerary.Id,
+		&itinerary.UserId,
+		&itinerary.Status,
+		&itinerary.CreatedAt,
+		&itinerary.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &itinerary, nil
+}
+
+// Create stores an itinerary without its bookings, they are created one by
+// one with the itinerary id
+func (p *itineraryRepo) Create(ctx context.Context, itinerary *entity.Itinerary) (*entity.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ItineraryCreate")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(map[string]interface{}{
+		"id":         itinerary.Id,
+		"user_id":    itinerary.UserId,
+		"status":     itinerary.Status,
+		"created_at": itinerary.CreatedAt,
+		"updated_at": itinerary.UpdatedAt,
+	}).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for creating itinerary: %v", err)
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return itinerary, nil
+}
+
+func (p *itineraryRepo) Get(ctx context.Context, id string) (*entity.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ItineraryGet")
+	defer span.End()
+
+	query, args, err := p.itinerarySelecter().
+		Where(p.db.Sq.Equal("id", id)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting itinerary: %v", err)
+	}
+
+	itinerary, err := scanItinerary(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return itinerary, nil
+}
+
+// ListByUser lists the itineraries of a user, the latest first
+func (p *itineraryRepo) ListByUser(ctx context.Context, user_id string) ([]*entity.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "ItineraryListByUser")
+	defer span.End()
+
+	query, args, err := p.itinerarySelecter().
+		Where(p.db.Sq.Equal("user_id", user_id)).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for listing itineraries: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing itineraries: %v", err)
+	}
+	defer rows.Close()
+
+	itineraries := make([]*entity.Itinerary, 0)
+	for rows.Next() {
+		itinerary, err := scanItinerary(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing itineraries: %v", err)
+		}
+		itineraries = append(itineraries, itinerary)
+	}
+
+	return itineraries, rows.Err()
+}
+
+// UpdateStatus moves an itinerary from status from to status to. It fails
+// with *entity.ErrInvalidTransition when the itinerary is no longer in status
+// from.
+func (p *itineraryRepo) UpdateStatus(ctx context.Context, itinerary *entity.Itinerary, from, to string) error {
+	ctx, span := otlp.Start(ctx, "Repository", "ItineraryUpdateStatus")
+	defer span.End()
+
+	if !entity.CanMoveItinerary(from, to) {
+		return entity.NewErrInvalidTransition("itinerary", from, to)
+	}
+
+	updatedAt := time.Now().UTC()
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(map[string]interface{}{
+			"status":     to,
+			"updated_at": updatedAt,
+		}).
+		Where(p.db.Sq.Equal("id", itinerary.Id)).
+		Where(p.db.Sq.Equal("status", from)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for updating itinerary: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for updating itinerary: %v", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrInvalidTransition("itinerary", from, to)
+	}
+
+	itinerary.Status = to
+	itinerary.UpdatedAt = updatedAt
+	return nil
+}
@@ -0,0 +1,86 @@
+package postgresql
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/config"
+	"Booking/booking-service-booking/internal/pkg/postgres"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItineraryRepo(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewItineraryRepo(db)
+	bookings := NewBookingRepo(db)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	itinerary, err := repo.Create(ctx, &entity.Itinerary{
+		Id:        uuid.NewString(),
+		UserId:    uuid.NewString(),
+		Status:    entity.ItineraryPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	assert.NoError(t, err)
+
+	// bookings of the trip carry the itinerary id
+	hotel, err := bookings.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingHotel,
+		UserId:         itinerary.UserId,
+		HraId:          uuid.NewString(),
+		WillArrive:     "2030-08-01",
+		WillLeave:      "2030-08-03",
+		NumberOfPeople: 2,
+		Status:         entity.BookingPending,
+		ItineraryId:    itinerary.Id,
+		CreatedAt:      now,
+	}, entity.Capacity{Rooms: 1})
+	assert.NoError(t, err)
+	_, err = bookings.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingRestaurant,
+		UserId:         itinerary.UserId,
+		HraId:          uuid.NewString(),
+		WillArrive:     "2030-08-01 19:00",
+		WillLeave:      "2030-08-01 21:00",
+		NumberOfPeople: 2,
+		Status:         entity.BookingConfirmed,
+		CreatedAt:      now,
+	}, entity.Capacity{Seats: 10})
+	assert.NoError(t, err)
+
+	parts, err := bookings.ListByItinerary(ctx, itinerary.Id)
+	assert.NoError(t, err)
+	if assert.Len(t, parts, 1) {
+		assert.Equal(t, hotel.Id, parts[0].Id)
+		assert.Equal(t, itinerary.Id, parts[0].ItineraryId)
+	}
+
+	// only pending itineraries fail
+	assert.NoError(t, repo.UpdateStatus(ctx, itinerary, entity.ItineraryPending, entity.ItineraryConfirmed))
+	var errTransition *entity.ErrInvalidTransition
+	assert.ErrorAs(t, repo.UpdateStatus(ctx, itinerary, entity.ItineraryPending, entity.ItineraryFailed), &errTransition)
+
+	got, err := repo.Get(ctx, itinerary.Id)
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, entity.ItineraryConfirmed, got.Status)
+		assert.Equal(t, itinerary.UserId, got.UserId)
+	}
+
+	listed, err := repo.ListByUser(ctx, itinerary.UserId)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 1)
+}
@@ -0,0 +1,217 @@
+package postgresql
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"Booking/booking-service-booking/internal/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const (
+	paymentTable        = "payment_table"
+	paymentAttemptTable = "payment_attempt_table"
+)
+
+type paymentRepo struct {
+	paymentTable        string
+	paymentAttemptTable string
+	db                  *postgres.PostgresDB
+}
+
+func NewPaymentRepo(db *postgres.PostgresDB) *paymentRepo {
+	return &paymentRepo{
+		paymentTable:        paymentTable,
+		paymentAttemptTable: paymentAttemptTable,
+		db:                  db,
+	}
+}
+
+func (p *paymentRepo) paymentSelecter() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.Select(
+		"payment_id",
+		"booking_id",
+		"user_id",
+		"amount",
+		"currency",
+		"refunded_amount",
+		"status",
+		"provider",
+		"provider_ref",
+		"created_at",
+		"updated_at",
+	).From(p.paymentTable)
+}
+
+// Create inserts a pending payment, a booking has at most one payment
+func (p *paymentRepo) Create(ctx context.Context, payment *entity.Payment) (*entity.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "PaymentCreate")
+	defer span.End()
+
+	data := map[string]interface{}{
+		"payment_id":   payment.Id,
+		"booking_id":   payment.BookingId,
+		"user_id":      payment.UserId,
+		"amount":       payment.Amount,
+		"currency":     payment.Currency,
+		"status":       payment.Status,
+		"provider":     payment.Provider,
+		"provider_ref": payment.ProviderRef,
+		"created_at":   payment.CreatedAt,
+		"updated_at":   payment.UpdatedAt,
+	}
+	query, args, err := p.db.Sq.Builder.Insert(p.paymentTable).SetMap(data).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for creating payment: %v", err)
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return payment, nil
+}
+
+func (p *paymentRepo) GetByBookingId(ctx context.Context, booking_id string) (*entity.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "PaymentGetByBookingId")
+	defer span.End()
+
+	return p.get(ctx, p.db.Sq.Equal("booking_id", booking_id))
+}
+
+func (p *paymentRepo) GetByProviderRef(ctx context.Context, provider_ref string) (*entity.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "PaymentGetByProviderRef")
+	defer span.End()
+
+	return p.get(ctx, p.db.Sq.Equal("provider_ref", provider_ref))
+}
+
+// get returns the payment matching where together with its attempts, oldest first
+func (p *paymentRepo) get(ctx context.Context, where squirrel.Sqlizer) (*entity.Payment, error) {
+	query, args, err := p.paymentSelecter().Where(where).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting payment: %v", err)
+	}
+
+	var payment entity.Payment
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&payment.Id,
+		&payment.BookingId,
+		&payment.UserId,
+		&payment.Amount,
+		&payment.Currency,
+		&payment.RefundedAmount,
+		&payment.Status,
+		&payment.Provider,
+		&payment.ProviderRef,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	query, args, err = p.db.Sq.Builder.Select(
+		"attempt_id",
+		"payment_id",
+		"action",
+		"status",
+		"error",
+		"created_at",
+	).From(p.paymentAttemptTable).
+		Where(p.db.Sq.Equal("payment_id", payment.Id)).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for listing payment attempts: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing payment attempts: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var attempt entity.PaymentAttempt
+		if err = rows.Scan(
+			&attempt.Id,
+			&attempt.PaymentId,
+			&attempt.Action,
+			&attempt.Status,
+			&attempt.Error,
+			&attempt.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing payment attempts: %v", err)
+		}
+		payment.Attempts = append(payment.Attempts, &attempt)
+	}
+
+	return &payment, rows.Err()
+}
+
+// UpdateStatus moves the payment from status from to status to, together with
+// its provider reference and the amounts it was charged and refunded with. It
+// fails with *entity.ErrInvalidTransition when the payment is no longer in
+// status from, e.g. when it was moved concurrently.
+func (p *paymentRepo) UpdateStatus(ctx context.Context, payment *entity.Payment, from, to string) error {
+	ctx, span := otlp.Start(ctx, "Repository", "PaymentUpdateStatus")
+	defer span.End()
+
+	if !entity.CanMovePayment(from, to) {
+		return entity.NewErrInvalidTransition("payment", from, to)
+	}
+
+	payment.UpdatedAt = time.Now().UTC()
+	query, args, err := p.db.Sq.Builder.Update(p.paymentTable).
+		SetMap(map[string]interface{}{
+			"status":          to,
+			"provider_ref":    payment.ProviderRef,
+			"amount":          payment.Amount,
+			"currency":        payment.Currency,
+			"refunded_amount": payment.RefundedAmount,
+			"updated_at":      payment.UpdatedAt,
+		}).
+		Where(p.db.Sq.Equal("payment_id", payment.Id)).
+		Where(p.db.Sq.Equal("status", from)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for updating payment status: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for updating payment status: %v", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrInvalidTransition("payment", from, to)
+	}
+
+	payment.Status = to
+	return nil
+}
+
+func (p *paymentRepo) AddAttempt(ctx context.Context, attempt *entity.PaymentAttempt) error {
+	ctx, span := otlp.Start(ctx, "Repository", "PaymentAddAttempt")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Insert(p.paymentAttemptTable).SetMap(map[string]interface{}{
+		"attempt_id": attempt.Id,
+		"payment_id": attempt.PaymentId,
+		"action":     attempt.Action,
+		"status":     attempt.Status,
+		"error":      attempt.Error,
+		"created_at": attempt.CreatedAt,
+	}).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for adding payment attempt: %v", err)
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to execute SQL query for adding payment attempt: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package postgresql
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/config"
+	"Booking/booking-service-booking/internal/pkg/postgres"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentRepo(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewPaymentRepo(db)
+	ctx := context.Background()
+
+	payment := &entity.Payment{
+		Id:        uuid.NewString(),
+		BookingId: uuid.NewString(),
+		UserId:    uuid.NewString(),
+		Amount:    120.5,
+		Currency:  "USD",
+		Status:    entity.PaymentPending,
+		Provider:  "fake",
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+	_, err = repo.Create(ctx, payment)
+	assert.NoError(t, err)
+
+	// a booking has a single payment
+	duplicate := *payment
+	duplicate.Id = uuid.NewString()
+	_, err = repo.Create(ctx, &duplicate)
+	assert.ErrorIs(t, err, entity.ErrorConflict)
+
+	assert.NoError(t, repo.AddAttempt(ctx, &entity.PaymentAttempt{
+		Id:        uuid.NewString(),
+		PaymentId: payment.Id,
+		Action:    entity.PaymentCharge,
+		Status:    entity.AttemptFailed,
+		Error:     "card was declined",
+		CreatedAt: time.Now().UTC(),
+	}))
+
+	// the booking was repriced while the payment was pending
+	payment.Amount = 140.5
+	payment.ProviderRef = "fake_" + uuid.NewString()
+	assert.NoError(t, repo.UpdateStatus(ctx, payment, entity.PaymentPending, entity.PaymentPaid))
+
+	// the payment is no longer pending
+	var errTransition *entity.ErrInvalidTransition
+	err = repo.UpdateStatus(ctx, payment, entity.PaymentPending, entity.PaymentPaid)
+	assert.True(t, errors.As(err, &errTransition))
+
+	got, err := repo.GetByProviderRef(ctx, payment.ProviderRef)
+	assert.NoError(t, err)
+	assert.Equal(t, payment.BookingId, got.BookingId)
+	assert.Equal(t, entity.PaymentPaid, got.Status)
+	assert.Equal(t, 140.5, got.Amount)
+	assert.Len(t, got.Attempts, 1)
+
+	// the cancellation policy refunded half of the price
+	payment.RefundedAmount = 70.25
+	assert.NoError(t, repo.UpdateStatus(ctx, payment, entity.PaymentPaid, entity.PaymentRefunded))
+	got, err = repo.GetByBookingId(ctx, payment.BookingId)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.PaymentRefunded, got.Status)
+	assert.Equal(t, 70.25, got.RefundedAmount)
+
+	_, err = repo.GetByBookingId(ctx, uuid.NewString())
+	assert.ErrorIs(t, err, entity.ErrorNotFound)
+}
@@ -24,12 +24,13 @@ func TestUserPostgres(t *testing.T) {
 	repo := NewBookingRepo(db)
 	hotel := &entity.GeneralBooking{
 		Id:             uuid.MustParse(uuid.NewString()),
+		BookingType:    entity.BookingHotel,
 		UserId:         uuid.NewString(),
 		HraId:          uuid.NewString(),
 		WillArrive:     "2006-01-02",
 		WillLeave:      "2006-01-03",
 		NumberOfPeople: 1,
-		IsCanceled:     false,
+		Status:         entity.BookingPending,
 		Reason:         "",
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Time{},
@@ -43,7 +44,7 @@ func TestUserPostgres(t *testing.T) {
 	hotelMap["will_arrive"] = hotel.WillArrive
 	hotelMap["will_leave"] = hotel.WillLeave
 	hotelMap["number_of_people"] = hotel.NumberOfPeople
-	hotelMap["is_canceled"] = hotel.IsCanceled
+	hotelMap["status"] = hotel.Status
 	hotelMap["reason"] = hotel.Reason
 	hotelMap["created_at"] = hotel.CreatedAt.Format("2006-01-02T15:04:05")
 	// hotelMap["updated_at"] = hotel.UpdatedAt.Format("2006-01-02T15:04:05")
@@ -51,7 +52,7 @@ func TestUserPostgres(t *testing.T) {
 
 	ctx := context.Background()
 
-	createdHotel, err := repo.UHBCreate(ctx, hotel)
+	createdHotel, err := repo.Create(ctx, hotel, entity.Capacity{Rooms: 1})
 	assert.NoError(t, err)
 	assert.Equal(t, hotel.Id, createdHotel.Id)
 	assert.Equal(t, hotel.UserId, createdHotel.UserId)
@@ -59,7 +60,7 @@ func TestUserPostgres(t *testing.T) {
 	assert.Equal(t, hotel.WillArrive, createdHotel.WillArrive)
 	assert.Equal(t, hotel.WillLeave, createdHotel.WillLeave)
 	assert.Equal(t, hotel.NumberOfPeople, createdHotel.NumberOfPeople)
-	assert.Equal(t, hotel.IsCanceled, createdHotel.IsCanceled)
+	assert.Equal(t, hotel.Status, createdHotel.Status)
 	assert.Equal(t, hotel.Reason, createdHotel.Reason)
 	assert.Equal(t, hotel.CreatedAt, createdHotel.CreatedAt)
 
@@ -67,11 +68,10 @@ func TestUserPostgres(t *testing.T) {
 	hotel.WillArrive = "2013-01-01"
 	hotel.WillArrive = "2013-01-02"
 	hotel.NumberOfPeople = 2
-	hotel.IsCanceled = true
 	hotel.Reason = "Gender Equality"
 	hotel.CreatedAt = time.Now()
 	hotel.UpdatedAt = time.Now()
-	updHotel, err := repo.UHBUpdate(ctx, hotel)
+	updHotel, err := repo.Update(ctx, hotel)
 	assert.NoError(t, err)
 	assert.Equal(t, hotel.Id, updHotel.Id)
 	assert.Equal(t, hotel.UserId, updHotel.UserId)
@@ -79,10 +79,991 @@ func TestUserPostgres(t *testing.T) {
 	assert.Equal(t, hotel.WillArrive, updHotel.WillArrive)
 	assert.Equal(t, hotel.WillLeave, updHotel.WillLeave)
 	assert.Equal(t, hotel.NumberOfPeople, updHotel.NumberOfPeople)
-	assert.Equal(t, hotel.IsCanceled, updHotel.IsCanceled)
+	assert.Equal(t, hotel.Status, updHotel.Status)
 	assert.Equal(t, hotel.Reason, updHotel.Reason)
 	assert.Equal(t, hotel.CreatedAt, updHotel.CreatedAt)
 	assert.Equal(t, hotel.UpdatedAt, updHotel.UpdatedAt)
 
 	//Test Method Get
-}
\ No newline at end of file
+}
+func TestUHBCreateOverbooking(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	roomId := uuid.NewString()
+
+	newBooking := func(willArrive, willLeave string) *entity.GeneralBooking {
+		return &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    entity.BookingHotel,
+			UserId:         uuid.NewString(),
+			HraId:          roomId,
+			WillArrive:     willArrive,
+			WillLeave:      willLeave,
+			NumberOfPeople: 2,
+			CreatedAt:      time.Now(),
+		}
+	}
+
+	// the only room of this type is taken from the 10th to the 12th
+	_, err = repo.Create(ctx, newBooking("2030-01-10", "2030-01-12"), entity.Capacity{Rooms: 1})
+	assert.NoError(t, err)
+
+	// overlapping stay is rejected
+	_, err = repo.Create(ctx, newBooking("2030-01-11", "2030-01-13"), entity.Capacity{Rooms: 1})
+	var errOverbooking *entity.ErrOverbooking
+	assert.ErrorAs(t, err, &errOverbooking)
+
+	// stay starting on the check-out day does not overlap
+	_, err = repo.Create(ctx, newBooking("2030-01-12", "2030-01-14"), entity.Capacity{Rooms: 1})
+	assert.NoError(t, err)
+
+	// with two rooms of this type the stay overlapping both bookings fits, as
+	// they never take the same night
+	_, err = repo.Create(ctx, newBooking("2030-01-11", "2030-01-13"), entity.Capacity{Rooms: 2})
+	assert.NoError(t, err)
+
+	// the night of the 11th now has both rooms taken
+	_, err = repo.Create(ctx, newBooking("2030-01-11", "2030-01-12"), entity.Capacity{Rooms: 2})
+	assert.ErrorAs(t, err, &errOverbooking)
+
+	// with three rooms of this type it fits
+	_, err = repo.Create(ctx, newBooking("2030-01-11", "2030-01-12"), entity.Capacity{Rooms: 3})
+	assert.NoError(t, err)
+}
+
+func TestUHBCountBooked(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	roomId := uuid.NewString()
+
+	_, err = repo.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingHotel,
+		UserId:         uuid.NewString(),
+		HraId:          roomId,
+		WillArrive:     "2031-03-01",
+		WillLeave:      "2031-03-05",
+		NumberOfPeople: 1,
+		CreatedAt:      time.Now(),
+	}, entity.Capacity{Rooms: 3})
+	assert.NoError(t, err)
+
+	booked, err := repo.UHBCountBooked(ctx, []string{roomId}, "2031-03-04", "2031-03-06")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), booked[roomId])
+
+	booked, err = repo.UHBCountBooked(ctx, []string{roomId}, "2031-03-05", "2031-03-06")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), booked[roomId])
+
+	// a stay starting on the check-out day never shares a night with the first
+	_, err = repo.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingHotel,
+		UserId:         uuid.NewString(),
+		HraId:          roomId,
+		WillArrive:     "2031-03-05",
+		WillLeave:      "2031-03-08",
+		NumberOfPeople: 1,
+		CreatedAt:      time.Now(),
+	}, entity.Capacity{Rooms: 3})
+	assert.NoError(t, err)
+
+	booked, err = repo.UHBCountBooked(ctx, []string{roomId}, "2031-03-01", "2031-03-08")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), booked[roomId])
+}
+
+func TestUHBStoredTotal(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	userId := uuid.NewString()
+
+	_, err = repo.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingHotel,
+		UserId:         userId,
+		HraId:          uuid.NewString(),
+		WillArrive:     "2031-04-01",
+		WillLeave:      "2031-04-03",
+		NumberOfPeople: 2,
+		TotalPrice:     250.5,
+		Currency:       "USD",
+		CreatedAt:      time.Now(),
+	}, entity.Capacity{Rooms: 1})
+	assert.NoError(t, err)
+
+	bookings, count, err := repo.GetAllByUId(ctx, entity.BookingHotel, 10, 0, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, 250.5, bookings[0].TotalPrice)
+	assert.Equal(t, "USD", bookings[0].Currency)
+}
+
+func TestBookingListByType(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	userId := uuid.NewString()
+
+	hotel, err := repo.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingHotel,
+		UserId:         userId,
+		HraId:          uuid.NewString(),
+		WillArrive:     "2031-05-01",
+		WillLeave:      "2031-05-02",
+		NumberOfPeople: 1,
+		Status:         entity.BookingPending,
+		CreatedAt:      time.Now(),
+	}, entity.Capacity{Rooms: 1})
+	assert.NoError(t, err)
+
+	restaurant, err := repo.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingRestaurant,
+		UserId:         userId,
+		HraId:          uuid.NewString(),
+		WillArrive:     "2031-05-01 19:00",
+		WillLeave:      "2031-05-01 21:00",
+		NumberOfPeople: 2,
+		Status:         entity.BookingConfirmed,
+		CreatedAt:      time.Now(),
+	}, entity.Capacity{Seats: 10})
+	assert.NoError(t, err)
+
+	bookings, count, err := repo.GetAllByUId(ctx, "", 10, 0, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.Len(t, bookings, 2)
+
+	bookings, count, err = repo.GetAllByUId(ctx, entity.BookingRestaurant, 10, 0, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, restaurant.Id, bookings[0].Id)
+	assert.Equal(t, "2031-05-01 19:00", bookings[0].WillArrive)
+
+	got, err := repo.Get(ctx, "", hotel.Id.String())
+	assert.NoError(t, err)
+	assert.Equal(t, entity.BookingHotel, got.BookingType)
+	assert.Equal(t, "2031-05-01", got.WillArrive)
+
+	// a booking is not found under another type
+	_, err = repo.Get(ctx, entity.BookingAttraction, hotel.Id.String())
+	assert.Error(t, err)
+
+	assert.NoError(t, repo.Delete(ctx, entity.BookingHotel, hotel.Id.String()))
+	_, count, err = repo.GetAllByUId(ctx, "", 10, 0, userId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestURBCreateSeatCapacity(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	restaurantId := uuid.NewString()
+
+	newReservation := func(willArrive, willLeave string, people int64) *entity.GeneralBooking {
+		return &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    entity.BookingRestaurant,
+			UserId:         uuid.NewString(),
+			HraId:          restaurantId,
+			WillArrive:     willArrive,
+			WillLeave:      willLeave,
+			NumberOfPeople: people,
+			CreatedAt:      time.Now(),
+		}
+	}
+
+	// six of ten seats are taken from 19:00 to 21:00
+	_, err = repo.Create(ctx, newReservation("2030-02-01 19:00", "2030-02-01 21:00", 6), entity.Capacity{Seats: 10})
+	assert.NoError(t, err)
+
+	// five more guests at 20:00 do not fit
+	_, err = repo.Create(ctx, newReservation("2030-02-01 20:00", "2030-02-01 22:00", 5), entity.Capacity{Seats: 10})
+	var errOverbooking *entity.ErrOverbooking
+	assert.ErrorAs(t, err, &errOverbooking)
+
+	// four guests fill the restaurant
+	_, err = repo.Create(ctx, newReservation("2030-02-01 20:00", "2030-02-01 22:00", 4), entity.Capacity{Seats: 10})
+	assert.NoError(t, err)
+
+	// the first six seats are free again from 21:00
+	_, err = repo.Create(ctx, newReservation("2030-02-01 21:00", "2030-02-01 23:00", 6), entity.Capacity{Seats: 10})
+	assert.NoError(t, err)
+
+	reservations, err := repo.URBListBetween(ctx, restaurantId, time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2030, 2, 2, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Len(t, reservations, 3)
+	assert.Equal(t, "2030-02-01 19:00", reservations[0].WillArrive)
+
+	// six guests sit from 18:00 to 20:00 and six others from 20:00 to 22:00,
+	// at no time more than six seats are taken
+	_, err = repo.Create(ctx, newReservation("2030-02-02 18:00", "2030-02-02 20:00", 6), entity.Capacity{Seats: 10})
+	assert.NoError(t, err)
+	_, err = repo.Create(ctx, newReservation("2030-02-02 20:00", "2030-02-02 22:00", 6), entity.Capacity{Seats: 10})
+	assert.NoError(t, err)
+
+	// four guests staying through both fill the restaurant
+	_, err = repo.Create(ctx, newReservation("2030-02-02 18:00", "2030-02-02 22:00", 4), entity.Capacity{Seats: 10})
+	assert.NoError(t, err)
+
+	// one more guest at 19:00 does not fit
+	_, err = repo.Create(ctx, newReservation("2030-02-02 19:00", "2030-02-02 21:00", 1), entity.Capacity{Seats: 10})
+	assert.ErrorAs(t, err, &errOverbooking)
+}
+
+func TestUABCreateQuota(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	attractionId := uuid.NewString()
+	quota := entity.TicketQuota{Daily: 10, Window: 6}
+
+	newTickets := func(willArrive, willLeave string, adults, children int64) *entity.GeneralBooking {
+		return &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    entity.BookingAttraction,
+			UserId:         uuid.NewString(),
+			HraId:          attractionId,
+			WillArrive:     willArrive,
+			WillLeave:      willLeave,
+			NumberOfPeople: adults + children,
+			AdultTickets:   adults,
+			ChildTickets:   children,
+			CreatedAt:      time.Now(),
+		}
+	}
+
+	_, err = repo.Create(ctx, newTickets("2030-03-01 10:00", "2030-03-01 11:00", 2, 2), entity.Capacity{Tickets: quota})
+	assert.NoError(t, err)
+
+	// the 10:00 window holds six visitors
+	_, err = repo.Create(ctx, newTickets("2030-03-01 10:00", "2030-03-01 11:00", 3, 0), entity.Capacity{Tickets: quota})
+	var errOverbooking *entity.ErrOverbooking
+	assert.ErrorAs(t, err, &errOverbooking)
+
+	_, err = repo.Create(ctx, newTickets("2030-03-01 11:00", "2030-03-01 12:00", 4, 1), entity.Capacity{Tickets: quota})
+	assert.NoError(t, err)
+
+	// nine of ten tickets of the day are sold
+	_, err = repo.Create(ctx, newTickets("2030-03-01 12:00", "2030-03-01 13:00", 2, 0), entity.Capacity{Tickets: quota})
+	assert.ErrorAs(t, err, &errOverbooking)
+
+	// the quota is daily
+	_, err = repo.Create(ctx, newTickets("2030-03-02 12:00", "2030-03-02 13:00", 2, 0), entity.Capacity{Tickets: quota})
+	assert.NoError(t, err)
+
+	sold, err := repo.UABTicketsByEntry(ctx, attractionId, time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{
+		"2030-03-01 10:00": 4,
+		"2030-03-01 11:00": 5,
+	}, sold)
+}
+
+func TestBookingUpdateStatus(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+
+	hotel, err := repo.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingHotel,
+		UserId:         uuid.NewString(),
+		HraId:          uuid.NewString(),
+		WillArrive:     "2030-04-01",
+		WillLeave:      "2030-04-03",
+		NumberOfPeople: 1,
+		Status:         entity.BookingPending,
+		CreatedAt:      time.Now(),
+	}, entity.Capacity{Rooms: 1})
+	assert.NoError(t, err)
+
+	change := func(from, to string) *entity.StatusChange {
+		return &entity.StatusChange{
+			BookingId:   hotel.Id.String(),
+			BookingType: entity.BookingHotel,
+			From:        from,
+			To:          to,
+			ChangedBy:   hotel.UserId,
+			CreatedAt:   time.Now(),
+		}
+	}
+
+	assert.NoError(t, repo.UpdateStatus(ctx, change(entity.BookingPending, entity.BookingConfirmed)))
+
+	// the booking is no longer pending
+	err = repo.UpdateStatus(ctx, change(entity.BookingPending, entity.BookingCancelled))
+	var errTransition *entity.ErrInvalidTransition
+	assert.ErrorAs(t, err, &errTransition)
+
+	status, user_id, err := repo.GetStatus(ctx, entity.BookingHotel, hotel.Id.String())
+	assert.NoError(t, err)
+	assert.Equal(t, entity.BookingConfirmed, status)
+	assert.Equal(t, hotel.UserId, user_id)
+
+	// the refund owed for a cancellation is recorded with it
+	cancellation := change(entity.BookingConfirmed, entity.BookingCancelled)
+	cancellation.RefundPercent = 50
+	cancellation.RefundAmount = 60
+	cancellation.Currency = "USD"
+	assert.NoError(t, repo.UpdateStatus(ctx, cancellation))
+
+	history, err := repo.StatusHistory(ctx, hotel.Id.String())
+	assert.NoError(t, err)
+	if assert.Len(t, history, 3) {
+		assert.Equal(t, "", history[0].From)
+		assert.Equal(t, entity.BookingPending, history[0].To)
+		assert.Equal(t, entity.BookingPending, history[1].From)
+		assert.Equal(t, entity.BookingConfirmed, history[1].To)
+		assert.Equal(t, entity.BookingCancelled, history[2].To)
+		assert.Equal(t, 60.0, history[2].RefundAmount)
+	}
+}
+
+func TestBookingReschedule(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	roomId := uuid.NewString()
+
+	newBooking := func(willArrive, willLeave string) *entity.GeneralBooking {
+		booking, err := repo.Create(ctx, &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    entity.BookingHotel,
+			UserId:         uuid.NewString(),
+			HraId:          roomId,
+			WillArrive:     willArrive,
+			WillLeave:      willLeave,
+			NumberOfPeople: 2,
+			Status:         entity.BookingConfirmed,
+			TotalPrice:     200,
+			CreatedAt:      time.Now(),
+		}, entity.Capacity{Rooms: 1})
+		assert.NoError(t, err)
+		return booking
+	}
+	moving := newBooking("2030-06-10", "2030-06-12")
+	newBooking("2030-06-20", "2030-06-22")
+
+	change := func(willArrive, willLeave string) (*entity.GeneralBooking, *entity.StatusChange) {
+		moved := *moving
+		moved.WillArrive, moved.WillLeave = willArrive, willLeave
+		moved.TotalPrice = 300
+		return &moved, &entity.StatusChange{
+			BookingId:       moving.Id.String(),
+			BookingType:     entity.BookingHotel,
+			From:            entity.BookingConfirmed,
+			To:              entity.BookingConfirmed,
+			ChangedBy:       moving.UserId,
+			FromWillArrive:  moving.WillArrive,
+			FromWillLeave:   moving.WillLeave,
+			ToWillArrive:    willArrive,
+			ToWillLeave:     willLeave,
+			PriceDifference: 100,
+			CreatedAt:       time.Now(),
+		}
+	}
+
+	// the room is taken by the other booking then
+	moved, rescheduled := change("2030-06-21", "2030-06-24")
+	err = repo.Reschedule(ctx, moved, entity.Capacity{Rooms: 1}, rescheduled)
+	var errOverbooking *entity.ErrOverbooking
+	assert.ErrorAs(t, err, &errOverbooking)
+
+	// a booking does not compete with its own dates
+	moved, rescheduled = change("2030-06-11", "2030-06-14")
+	assert.NoError(t, repo.Reschedule(ctx, moved, entity.Capacity{Rooms: 1}, rescheduled))
+
+	got, err := repo.Get(ctx, entity.BookingHotel, moving.Id.String())
+	assert.NoError(t, err)
+	assert.Equal(t, "2030-06-11", got.WillArrive)
+	assert.Equal(t, "2030-06-14", got.WillLeave)
+	assert.Equal(t, 300.0, got.TotalPrice)
+
+	history, err := repo.StatusHistory(ctx, moving.Id.String())
+	assert.NoError(t, err)
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, "2030-06-10", history[1].FromWillArrive)
+		assert.Equal(t, "2030-06-14", history[1].ToWillLeave)
+		assert.Equal(t, 100.0, history[1].PriceDifference)
+	}
+}
+
+func TestBookingHolds(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	roomId := uuid.NewString()
+	now := time.Now().UTC()
+
+	hold := func(expiresAt time.Time) (*entity.GeneralBooking, error) {
+		return repo.Create(ctx, &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    entity.BookingHotel,
+			UserId:         uuid.NewString(),
+			HraId:          roomId,
+			WillArrive:     "2030-09-10",
+			WillLeave:      "2030-09-12",
+			NumberOfPeople: 2,
+			Status:         entity.BookingHeld,
+			HoldExpiresAt:  expiresAt,
+			CreatedAt:      now,
+		}, entity.Capacity{Rooms: 1})
+	}
+
+	// a hold takes the last room like any booking
+	held, err := hold(now.Add(-time.Minute))
+	assert.NoError(t, err)
+	_, err = hold(now.Add(time.Hour))
+	var errOverbooking *entity.ErrOverbooking
+	assert.ErrorAs(t, err, &errOverbooking)
+
+	// once it runs out the room is free again
+	expired, err := repo.ExpireHolds(ctx, now)
+	assert.NoError(t, err)
+	var expiredIds []uuid.UUID
+	for _, booking := range expired {
+		expiredIds = append(expiredIds, booking.Id)
+	}
+	assert.Contains(t, expiredIds, held.Id)
+
+	got, err := repo.Get(ctx, entity.BookingHotel, held.Id.String())
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, entity.BookingExpired, got.Status)
+		assert.False(t, got.HoldExpiresAt.IsZero())
+	}
+
+	_, err = hold(now.Add(time.Hour))
+	assert.NoError(t, err)
+}
+
+func TestBookingListByHraIds(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	roomIds := []string{uuid.NewString(), uuid.NewString()}
+	now := time.Now().UTC()
+
+	book := func(roomId, arrive, leave, status string) *entity.GeneralBooking {
+		booking, err := repo.Create(ctx, &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    entity.BookingHotel,
+			UserId:         uuid.NewString(),
+			HraId:          roomId,
+			WillArrive:     arrive,
+			WillLeave:      leave,
+			NumberOfPeople: 2,
+			Status:         status,
+			HoldExpiresAt:  now.Add(time.Hour),
+			CreatedAt:      now,
+		}, entity.Capacity{Rooms: 5})
+		assert.NoError(t, err)
+		return booking
+	}
+
+	past := book(roomIds[0], "2030-01-01", "2030-01-03", entity.BookingConfirmed)
+	first := book(roomIds[0], "2030-02-01", "2030-02-03", entity.BookingPending)
+	second := book(roomIds[1], "2030-02-05", "2030-02-07", entity.BookingCancelled)
+	held := book(roomIds[1], "2030-02-10", "2030-02-12", entity.BookingHeld)
+	if past == nil || first == nil || second == nil || held == nil {
+		return
+	}
+
+	bookings, err := repo.ListByHraIds(ctx, entity.BookingHotel, roomIds, time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	var ids []uuid.UUID
+	for _, booking := range bookings {
+		ids = append(ids, booking.Id)
+	}
+	assert.Equal(t, []uuid.UUID{first.Id, second.Id}, ids)
+
+	bookings, err = repo.ListByHraIds(ctx, entity.BookingHotel, nil, time.Time{})
+	assert.NoError(t, err)
+	assert.Empty(t, bookings)
+}
+
+func TestBookingGetByConfirmationCode(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+
+	booking, err := repo.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingHotel,
+		UserId:         uuid.NewString(),
+		HraId:          uuid.NewString(),
+		WillArrive:     "2030-03-01",
+		WillLeave:      "2030-03-02",
+		NumberOfPeople: 2,
+		Status:         entity.BookingPending,
+		CreatedAt:      time.Now().UTC(),
+	}, entity.Capacity{Rooms: 1})
+	assert.NoError(t, err)
+	if !assert.NotNil(t, booking) {
+		return
+	}
+	code, ok := entity.NormalizeConfirmationCode(booking.ConfirmationCode)
+	assert.True(t, ok)
+	assert.Equal(t, booking.ConfirmationCode, code)
+
+	got, err := repo.GetByConfirmationCode(ctx, booking.ConfirmationCode)
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, booking.Id, got.Id)
+		assert.Equal(t, booking.ConfirmationCode, got.ConfirmationCode)
+	}
+
+	assert.NoError(t, repo.Delete(ctx, entity.BookingHotel, booking.Id.String()))
+	_, err = repo.GetByConfirmationCode(ctx, booking.ConfirmationCode)
+	assert.ErrorIs(t, err, entity.ErrorNotFound)
+}
+
+func TestBookingScheduledMoves(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	at := func(offset time.Duration) string {
+		return now.Add(offset).Format("2006-01-02 15:04")
+	}
+
+	book := func(arrive, leave, status string) *entity.GeneralBooking {
+		booking, err := repo.Create(ctx, &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    entity.BookingRestaurant,
+			UserId:         uuid.NewString(),
+			HraId:          uuid.NewString(),
+			WillArrive:     arrive,
+			WillLeave:      leave,
+			NumberOfPeople: 2,
+			Status:         status,
+			CreatedAt:      now,
+		}, entity.Capacity{Seats: 10})
+		assert.NoError(t, err)
+		return booking
+	}
+	ids := func(bookings []*entity.GeneralBooking) []uuid.UUID {
+		var res []uuid.UUID
+		for _, booking := range bookings {
+			res = append(res, booking.Id)
+		}
+		return res
+	}
+
+	missed := book(at(-48*time.Hour), at(-46*time.Hour), entity.BookingConfirmed)
+	late := book(at(-2*time.Hour), at(time.Hour), entity.BookingConfirmed)
+	ended := book(at(-3*time.Hour), at(-time.Hour), entity.BookingCheckedIn)
+	soon := book(at(3*time.Hour), at(5*time.Hour), entity.BookingConfirmed)
+	later := book(at(72*time.Hour), at(74*time.Hour), entity.BookingConfirmed)
+	if missed == nil || late == nil || ended == nil || soon == nil || later == nil {
+		return
+	}
+
+	// the grace is per type, restaurant bookings are not marked with the grace of hotels
+	noShows, err := repo.MarkNoShows(ctx, entity.BookingHotel, now.Add(-time.Hour), now)
+	assert.NoError(t, err)
+	assert.NotContains(t, ids(noShows), missed.Id)
+	assert.NotContains(t, ids(noShows), late.Id)
+
+	noShows, err = repo.MarkNoShows(ctx, entity.BookingRestaurant, now.Add(-24*time.Hour), now)
+	assert.NoError(t, err)
+	assert.Contains(t, ids(noShows), missed.Id)
+	assert.NotContains(t, ids(noShows), late.Id)
+
+	completed, err := repo.CompleteBookings(ctx, now, now)
+	assert.NoError(t, err)
+	assert.Contains(t, ids(completed), ended.Id)
+
+	got, err := repo.Get(ctx, entity.BookingRestaurant, missed.Id.String())
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, entity.BookingNoShow, got.Status)
+	}
+	history, err := repo.StatusHistory(ctx, ended.Id.String())
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, history) {
+		assert.Equal(t, entity.BookingCompleted, history[len(history)-1].To)
+		assert.Equal(t, "system", history[len(history)-1].ChangedBy)
+	}
+
+	due, err := repo.ListDueReminders(ctx, now, now.Add(24*time.Hour))
+	assert.NoError(t, err)
+	assert.Contains(t, ids(due), soon.Id)
+	assert.NotContains(t, ids(due), later.Id)
+	assert.NotContains(t, ids(due), late.Id)
+
+	// a booking is reminded of once
+	assert.NoError(t, repo.MarkReminded(ctx, []string{soon.Id.String()}, now))
+	due, err = repo.ListDueReminders(ctx, now, now.Add(24*time.Hour))
+	assert.NoError(t, err)
+	assert.NotContains(t, ids(due), soon.Id)
+}
+
+func TestBookingListForOwner(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	room_id, restaurant_id := uuid.NewString(), uuid.NewString()
+
+	book := func(bookingType, hra_id, arrive, leave, status string) *entity.GeneralBooking {
+		booking, err := repo.Create(ctx, &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    bookingType,
+			UserId:         uuid.NewString(),
+			HraId:          hra_id,
+			WillArrive:     arrive,
+			WillLeave:      leave,
+			NumberOfPeople: 2,
+			Status:         status,
+			CreatedAt:      time.Now().UTC(),
+		}, entity.Capacity{Rooms: 1, Seats: 10})
+		assert.NoError(t, err)
+		return booking
+	}
+	stay := book(entity.BookingHotel, room_id, "2031-05-01", "2031-05-04", entity.BookingConfirmed)
+	dinner := book(entity.BookingRestaurant, restaurant_id, "2031-05-02 19:00", "", entity.BookingPending)
+	later := book(entity.BookingRestaurant, restaurant_id, "2031-06-01 19:00", "", entity.BookingCancelled)
+	book(entity.BookingRestaurant, uuid.NewString(), "2031-05-02 19:00", "", entity.BookingPending)
+	if stay == nil || dinner == nil || later == nil {
+		return
+	}
+
+	hra_ids := []string{room_id, restaurant_id}
+	at := func(date string) time.Time {
+		day, _ := time.Parse("2006-01-02", date)
+		return day
+	}
+
+	// the stay is still running on the 3rd and comes first
+	bookings, count, err := repo.ListForOwner(ctx, hra_ids, &entity.OwnerBookingFilter{
+		From: at("2031-05-03"),
+		To:   at("2031-05-04"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	if assert.Len(t, bookings, 1) {
+		assert.Equal(t, stay.Id, bookings[0].Id)
+	}
+
+	bookings, count, err = repo.ListForOwner(ctx, hra_ids, &entity.OwnerBookingFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	if assert.Len(t, bookings, 3) {
+		assert.Equal(t, stay.Id, bookings[0].Id)
+		assert.Equal(t, dinner.Id, bookings[1].Id)
+		assert.Equal(t, later.Id, bookings[2].Id)
+	}
+
+	bookings, count, err = repo.ListForOwner(ctx, hra_ids, &entity.OwnerBookingFilter{
+		Statuses: []string{entity.BookingPending, entity.BookingCancelled},
+		Limit:    1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	if assert.Len(t, bookings, 1) {
+		assert.Equal(t, dinner.Id, bookings[0].Id)
+	}
+
+	bookings, count, err = repo.ListForOwner(ctx, nil, &entity.OwnerBookingFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+	assert.Empty(t, bookings)
+
+	for _, booking := range []*entity.GeneralBooking{stay, dinner, later} {
+		assert.NoError(t, repo.Delete(ctx, booking.BookingType, booking.Id.String()))
+	}
+}
+
+func TestBookingApproval(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	book := func(due time.Time) *entity.GeneralBooking {
+		booking, err := repo.Create(ctx, &entity.GeneralBooking{
+			Id:             uuid.New(),
+			BookingType:    entity.BookingRestaurant,
+			UserId:         uuid.NewString(),
+			HraId:          uuid.NewString(),
+			WillArrive:     now.Add(72 * time.Hour).Format("2006-01-02 15:04"),
+			NumberOfPeople: 2,
+			Status:         entity.BookingPending,
+			ApprovalDueAt:  due,
+			CreatedAt:      now,
+		}, entity.Capacity{Seats: 10})
+		assert.NoError(t, err)
+		return booking
+	}
+
+	overdue := book(now.Add(-time.Minute))
+	waiting := book(now.Add(time.Hour))
+	accepted := book(now.Add(-time.Minute))
+	if overdue == nil || waiting == nil || accepted == nil {
+		return
+	}
+
+	got, err := repo.Get(ctx, entity.BookingRestaurant, waiting.Id.String())
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.True(t, got.AwaitingApproval())
+		assert.WithinDuration(t, now.Add(time.Hour), got.ApprovalDueAt, time.Second)
+	}
+
+	// accepting the booking ends the wait before the sweep runs
+	assert.NoError(t, repo.UpdateStatus(ctx, &entity.StatusChange{
+		Id:          uuid.NewString(),
+		BookingId:   accepted.Id.String(),
+		BookingType: entity.BookingRestaurant,
+		From:        entity.BookingPending,
+		To:          entity.BookingConfirmed,
+		ChangedBy:   uuid.NewString(),
+		CreatedAt:   now,
+	}))
+
+	declined, err := repo.DeclineUnanswered(ctx, now)
+	assert.NoError(t, err)
+	var declinedIds []uuid.UUID
+	for _, booking := range declined {
+		declinedIds = append(declinedIds, booking.Id)
+	}
+	assert.Contains(t, declinedIds, overdue.Id)
+	assert.NotContains(t, declinedIds, waiting.Id)
+	assert.NotContains(t, declinedIds, accepted.Id)
+
+	got, err = repo.Get(ctx, entity.BookingRestaurant, overdue.Id.String())
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, entity.BookingCancelled, got.Status)
+		assert.Equal(t, "not answered by the establishment in time", got.Reason)
+		assert.False(t, got.AwaitingApproval())
+	}
+
+	got, err = repo.Get(ctx, entity.BookingRestaurant, accepted.Id.String())
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, entity.BookingConfirmed, got.Status)
+		assert.True(t, got.ApprovalDueAt.IsZero())
+	}
+
+	for _, booking := range []*entity.GeneralBooking{overdue, waiting, accepted} {
+		assert.NoError(t, repo.Delete(ctx, booking.BookingType, booking.Id.String()))
+	}
+}
+
+func TestBookingGuests(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	booking, err := repo.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingHotel,
+		UserId:         uuid.NewString(),
+		HraId:          uuid.NewString(),
+		WillArrive:     now.AddDate(0, 0, 10).Format("2006-01-02"),
+		WillLeave:      now.AddDate(0, 0, 12).Format("2006-01-02"),
+		NumberOfPeople: 3,
+		Status:         entity.BookingPending,
+		Guests: []entity.BookingGuest{
+			{FullName: "Anna Smith", Age: 34, DocumentNumber: "AB1234567"},
+			{FullName: "Tom Smith", Age: 1},
+		},
+		SpecialRequests:    []string{entity.RequestLateArrival, entity.RequestCrib},
+		SpecialRequestNote: "arriving around midnight",
+		CreatedAt:          now,
+	}, entity.Capacity{Rooms: 1})
+	assert.NoError(t, err)
+	if booking == nil {
+		return
+	}
+
+	got, err := repo.Get(ctx, entity.BookingHotel, booking.Id.String())
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, booking.Guests, got.Guests)
+		assert.Equal(t, booking.SpecialRequests, got.SpecialRequests)
+		assert.Equal(t, booking.SpecialRequestNote, got.SpecialRequestNote)
+	}
+
+	bookings, _, err := repo.GetAllByUId(ctx, entity.BookingHotel, 10, 0, booking.UserId)
+	assert.NoError(t, err)
+	if assert.Len(t, bookings, 1) {
+		assert.Equal(t, booking.Guests, bookings[0].Guests)
+	}
+
+	// updating the booking replaces its guests and special requests
+	got.Guests = []entity.BookingGuest{{FullName: "Anna Smith", Age: 34, DocumentNumber: "AB1234567"}}
+	got.SpecialRequests = nil
+	got.SpecialRequestNote = ""
+	got.UpdatedAt = now
+	_, err = repo.Update(ctx, got)
+	assert.NoError(t, err)
+
+	got, err = repo.Get(ctx, entity.BookingHotel, booking.Id.String())
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Len(t, got.Guests, 1)
+		assert.Empty(t, got.SpecialRequests)
+		assert.Empty(t, got.SpecialRequestNote)
+	}
+
+	assert.NoError(t, repo.Delete(ctx, booking.BookingType, booking.Id.String()))
+}
+
+func TestBookingAddOns(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingRepo(db)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	breakfast := entity.BookingAddOn{AddOnId: uuid.NewString(), Name: "Breakfast", Quantity: 4}
+	breakfast.SetUnitPrice(12.5)
+	parking := entity.BookingAddOn{AddOnId: uuid.NewString(), Name: "Parking", Quantity: 2}
+	parking.SetUnitPrice(8)
+
+	booking, err := repo.Create(ctx, &entity.GeneralBooking{
+		Id:             uuid.New(),
+		BookingType:    entity.BookingHotel,
+		UserId:         uuid.NewString(),
+		HraId:          uuid.NewString(),
+		WillArrive:     now.AddDate(0, 0, 20).Format("2006-01-02"),
+		WillLeave:      now.AddDate(0, 0, 22).Format("2006-01-02"),
+		NumberOfPeople: 2,
+		Status:         entity.BookingPending,
+		TotalPrice:     entity.WithAddOns(200, []entity.BookingAddOn{breakfast, parking}),
+		Currency:       "USD",
+		AddOns:         []entity.BookingAddOn{breakfast, parking},
+		CreatedAt:      now,
+	}, entity.Capacity{Rooms: 1})
+	assert.NoError(t, err)
+	if booking == nil {
+		return
+	}
+
+	got, err := repo.Get(ctx, entity.BookingHotel, booking.Id.String())
+	assert.NoError(t, err)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, []entity.BookingAddOn{breakfast, parking}, got.AddOns)
+		assert.Equal(t, 266.0, got.TotalPrice)
+	}
+
+	// updating the booking replaces its add-ons
+	got.AddOns = []entity.BookingAddOn{parking}
+	got.UpdatedAt = now
+	_, err = repo.Update(ctx, got)
+	assert.NoError(t, err)
+
+	bookings, _, err := repo.GetAllByUId(ctx, entity.BookingHotel, 10, 0, booking.UserId)
+	assert.NoError(t, err)
+	if assert.Len(t, bookings, 1) {
+		assert.Equal(t, []entity.BookingAddOn{parking}, bookings[0].AddOns)
+	}
+
+	assert.NoError(t, repo.Delete(ctx, booking.BookingType, booking.Id.String()))
+}
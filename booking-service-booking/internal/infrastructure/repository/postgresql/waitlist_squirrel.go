@@ -0,0 +1,318 @@
+package postgresql
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"Booking/booking-service-booking/internal/pkg/postgres"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const waitlistTable = "booking_waitlist"
+
+type waitlistRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewWaitlistRepo(db *postgres.PostgresDB) *waitlistRepo {
+	return &waitlistRepo{
+		tableName: waitlistTable,
+		db:        db,
+	}
+}
+
+// waitlistSelecter keeps the time of restaurant slots and bare dates of hotel stays
+func (p *waitlistRepo) waitlistSelecter() squirrel.SelectBuilder {
+	format := fmt.Sprintf("CASE WHEN booking_type = '%s' THEN 'YYYY-MM-DD' ELSE 'YYYY-MM-DD HH24:MI' END", entity.BookingHotel)
+
+	return p.db.Sq.Builder.Select(
+		"id",
+		"booking_type",
+		"user_id",
+		"hra_id",
+		"TO_CHAR(will_arrive, "+format+") AS will_arrive",
+		"TO_CHAR(will_leave, "+format+") AS will_leave",
+		"number_of_people",
+		"reason",
+		"status",
+		"offer_expires_at",
+		"COALESCE(booking_id::text, '')",
+		"created_at",
+		"updated_at",
+	).From(p.tableName)
+}
+
+// scanWaitlistEntry reads a row built by waitlistSelecter
+func scanWaitlistEntry(row pgx.Row) (*entity.WaitlistEntry, error) {
+	var (
+		entry          entity.WaitlistEntry
+		offerExpiresAt sql.NullTime
+	)
+	if err := row.Scan(
+		&entry.Id,
+		&entry.BookingType,
+		&entry.UserId,
+		&entry.HraId,
+		&entry.WillArrive,
+		&entry.WillLeave,
+		&entry.NumberOfPeople,
+		&entry.Reason,
+		&entry.Status,
+		&offerExpiresAt,
+		&entry.BookingId,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	entry.OfferExpiresAt = offerExpiresAt.Time
+
+	return &entry, nil
+}
+
+// Create puts a guest on the waitlist
+func (p *waitlistRepo) Create(ctx context.Context, entry *entity.WaitlistEntry) (*entity.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "WaitlistCreate")
+	defer span.End()
+
+	WA, err := parseReservationTime(entry.WillArrive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse will arrive: %v", err)
+	}
+	WL, err := parseReservationTime(entry.WillLeave)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse will leave: %v", err)
+	}
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(map[string]interface{}{
+		"id":               entry.Id,
+		"booking_type":     entry.BookingType,
+		"user_id":          entry.UserId,
+		"hra_id":           entry.HraId,
+		"will_arrive":      WA,
+		"will_leave":       WL,
+		"number_of_people": entry.NumberOfPeople,
+		"reason":           entry.Reason,
+		"status":           entry.Status,
+		"created_at":       entry.CreatedAt,
+		"updated_at":       entry.UpdatedAt,
+	}).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for joining waitlist: %v", err)
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return entry, nil
+}
+
+func (p *waitlistRepo) Get(ctx context.Context, id string) (*entity.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "WaitlistGet")
+	defer span.End()
+
+	query, args, err := p.waitlistSelecter().
+		Where(p.db.Sq.Equal("id", id)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting waitlist entry: %v", err)
+	}
+
+	entry, err := scanWaitlistEntry(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return entry, nil
+}
+
+// ListByUser lists the waitlist entries of a user, the latest first
+func (p *waitlistRepo) ListByUser(ctx context.Context, user_id string) ([]*entity.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "WaitlistListByUser")
+	defer span.End()
+
+	query, args, err := p.waitlistSelecter().
+		Where(p.db.Sq.Equal("user_id", user_id)).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for listing waitlist: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing waitlist: %v", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*entity.WaitlistEntry, 0)
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing waitlist: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// UpdateStatus moves an entry from status from to status to together with its
+// booking, and the time it runs out when it is offered. It fails with *entity.ErrInvalidTransition when the
+// entry is no longer in status from.
+func (p *waitlistRepo) UpdateStatus(ctx context.Context, entry *entity.WaitlistEntry, from, to string) error {
+	ctx, span := otlp.Start(ctx, "Repository", "WaitlistUpdateStatus")
+	defer span.End()
+
+	if !entity.CanMoveWaitlist(from, to) {
+		return entity.NewErrInvalidTransition("waitlist entry", from, to)
+	}
+
+	clauses := map[string]interface{}{
+		"status":     to,
+		"updated_at": time.Now().UTC(),
+	}
+	if entry.BookingId != "" {
+		clauses["booking_id"] = entry.BookingId
+	}
+	if to == entity.WaitlistOffered {
+		clauses["offer_expires_at"] = entry.OfferExpiresAt
+	}
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("id", entry.Id)).
+		Where(p.db.Sq.Equal("status", from)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for updating waitlist entry: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for updating waitlist entry: %v", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrInvalidTransition("waitlist entry", from, to)
+	}
+
+	entry.Status = to
+	return nil
+}
+
+// ListWaiting lists the entries waiting for bookingType at hra_id on dates
+// overlapping willArrive and willLeave, the guest who waited longest first
+func (p *waitlistRepo) ListWaiting(ctx context.Context, bookingType, hra_id, willArrive, willLeave string) ([]*entity.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "WaitlistListWaiting")
+	defer span.End()
+
+	WA, err := parseReservationTime(willArrive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse will arrive: %v", err)
+	}
+	WL, err := parseReservationTime(willLeave)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse will leave: %v", err)
+	}
+
+	query, args, err := p.waitlistSelecter().
+		Where(p.db.Sq.Equal("booking_type", bookingType)).
+		Where(p.db.Sq.Equal("hra_id", hra_id)).
+		Where(p.db.Sq.Equal("status", entity.WaitlistWaiting)).
+		Where("will_arrive < ? AND will_leave > ?", WL, WA).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for listing waiting entries: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing waiting entries: %v", err)
+	}
+	defer rows.Close()
+
+	var entries []*entity.WaitlistEntry
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing waiting entries: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// ExpireOffers moves the offers that ran out by now to expired and returns them
+func (p *waitlistRepo) ExpireOffers(ctx context.Context, now time.Time) ([]*entity.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Repository", "WaitlistExpireOffers")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction for expiring waitlist offers: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := p.waitlistSelecter().
+		Where(p.db.Sq.Equal("status", entity.WaitlistOffered)).
+		Where("offer_expires_at <= ?", now).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for listing expired waitlist offers: %v", err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing expired waitlist offers: %v", err)
+	}
+	var (
+		entries []*entity.WaitlistEntry
+		ids     []string
+	)
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan row while listing expired waitlist offers: %v", err)
+		}
+		entry.Status = entity.WaitlistExpired
+		entries = append(entries, entry)
+		ids = append(ids, entry.Id)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error encountered while iterating over expired waitlist offers: %v", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query, args, err = p.db.Sq.Builder.Update(p.tableName).
+		SetMap(map[string]interface{}{
+			"status":     entity.WaitlistExpired,
+			"updated_at": now,
+		}).
+		Where(p.db.Sq.Equal("id", ids)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for expiring waitlist offers: %v", err)
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for expiring waitlist offers: %v", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit expired waitlist offers: %v", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,96 @@
+package postgresql
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/config"
+	"Booking/booking-service-booking/internal/pkg/postgres"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitlistRepo(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewWaitlistRepo(db)
+	ctx := context.Background()
+	roomId := uuid.NewString()
+
+	join := func(willArrive, willLeave string, joinedAt time.Time) *entity.WaitlistEntry {
+		entry, err := repo.Create(ctx, &entity.WaitlistEntry{
+			Id:             uuid.NewString(),
+			BookingType:    entity.BookingHotel,
+			UserId:         uuid.NewString(),
+			HraId:          roomId,
+			WillArrive:     willArrive,
+			WillLeave:      willLeave,
+			NumberOfPeople: 2,
+			Status:         entity.WaitlistWaiting,
+			CreatedAt:      joinedAt,
+			UpdatedAt:      joinedAt,
+		})
+		assert.NoError(t, err)
+		return entry
+	}
+	now := time.Now().UTC()
+	first := join("2030-07-10", "2030-07-12", now.Add(-2*time.Hour))
+	second := join("2030-07-11", "2030-07-13", now.Add(-time.Hour))
+	join("2030-07-20", "2030-07-22", now.Add(-3*time.Hour))
+
+	// the guests who waited longest for overlapping dates come first
+	waiting, err := repo.ListWaiting(ctx, entity.BookingHotel, roomId, "2030-07-10", "2030-07-12")
+	assert.NoError(t, err)
+	if assert.Len(t, waiting, 2) {
+		assert.Equal(t, first.Id, waiting[0].Id)
+		assert.Equal(t, second.Id, waiting[1].Id)
+		assert.Equal(t, "2030-07-10", waiting[0].WillArrive)
+	}
+
+	first.BookingId = uuid.NewString()
+	first.OfferExpiresAt = now.Add(time.Hour)
+	assert.NoError(t, repo.UpdateStatus(ctx, first, entity.WaitlistWaiting, entity.WaitlistOffered))
+
+	// waiting entries can not accept
+	err = repo.UpdateStatus(ctx, second, entity.WaitlistWaiting, entity.WaitlistAccepted)
+	var errTransition *entity.ErrInvalidTransition
+	assert.ErrorAs(t, err, &errTransition)
+
+	// the offer to the second guest runs out
+	second.BookingId = uuid.NewString()
+	second.OfferExpiresAt = now.Add(-time.Minute)
+	assert.NoError(t, repo.UpdateStatus(ctx, second, entity.WaitlistWaiting, entity.WaitlistOffered))
+	expired, err := repo.ExpireOffers(ctx, now)
+	assert.NoError(t, err)
+	var expiredIds []string
+	for _, entry := range expired {
+		expiredIds = append(expiredIds, entry.Id)
+	}
+	assert.Contains(t, expiredIds, second.Id)
+	assert.NotContains(t, expiredIds, first.Id)
+
+	// nobody else waits for these dates
+	waiting, err = repo.ListWaiting(ctx, entity.BookingHotel, roomId, "2030-07-10", "2030-07-12")
+	assert.NoError(t, err)
+	assert.Empty(t, waiting)
+
+	assert.NoError(t, repo.UpdateStatus(ctx, first, entity.WaitlistOffered, entity.WaitlistAccepted))
+
+	got, err := repo.Get(ctx, first.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.WaitlistAccepted, got.Status)
+	assert.Equal(t, first.BookingId, got.BookingId)
+
+	entries, err := repo.ListByUser(ctx, second.UserId)
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, entity.WaitlistExpired, entries[0].Status)
+	}
+}
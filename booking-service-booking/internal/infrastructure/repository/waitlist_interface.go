@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"context"
+	"time"
+)
+
+type Waitlist interface {
+	Create(ctx context.Context, entry *entity.WaitlistEntry) (*entity.WaitlistEntry, error)
+	Get(ctx context.Context, id string) (*entity.WaitlistEntry, error)
+	ListByUser(ctx context.Context, user_id string) ([]*entity.WaitlistEntry, error)
+	UpdateStatus(ctx context.Context, entry *entity.WaitlistEntry, from, to string) error
+	ListWaiting(ctx context.Context, bookingType, hra_id, willArrive, willLeave string) ([]*entity.WaitlistEntry, error)
+	ExpireOffers(ctx context.Context, now time.Time) ([]*entity.WaitlistEntry, error)
+}
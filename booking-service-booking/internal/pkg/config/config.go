@@ -5,6 +5,11 @@ import (
 	"strings"
 )
 
+type webAddress struct {
+	Host string
+	Port string
+}
+
 type Config struct {
 	APP         string
 	Environment string
@@ -27,13 +32,56 @@ type Config struct {
 	Kafka struct {
 		Address []string
 		Topic   struct {
-			UserService string
+			UserService     string
+			BookingReminder string
 		}
 	}
 	OTLPCollector struct {
 		Host string
 		Port string
 	}
+
+	EstablishmentService webAddress
+
+	Pricing struct {
+		Currency         string
+		HolidaySurcharge string
+	}
+
+	Payment struct {
+		Provider string
+	}
+
+	Waitlist struct {
+		OfferTTL      string
+		SweepInterval string
+	}
+
+	Hold struct {
+		TTL           string
+		SweepInterval string
+	}
+
+	Approval struct {
+		Timeout string
+	}
+
+	CalendarSync struct {
+		Interval     string
+		FetchTimeout string
+	}
+
+	Scheduler struct {
+		Interval              string
+		HotelNoShowAfter      string
+		RestaurantNoShowAfter string
+		AttractionNoShowAfter string
+		ReminderBefore        string
+	}
+
+	Reservation struct {
+		TimeZone string
+	}
 }
 
 func New() *Config {
@@ -57,6 +105,48 @@ func New() *Config {
 	// kafka configuration
 	config.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
 	config.Kafka.Topic.UserService = getEnv("KAFKA_TOPIC_USER_SERVICE", "user.service")
+	config.Kafka.Topic.BookingReminder = getEnv("KAFKA_TOPIC_BOOKING_REMINDER", "booking.reminder")
+
+	// establishment service configuration
+	config.EstablishmentService.Host = getEnv("ESTABLISHMENT_SERVICE_GRPC_HOST", "establishment-service")
+	config.EstablishmentService.Port = getEnv("ESTABLISHMENT_SERVICE_GRPC_PORT", ":50024")
+
+	// pricing configuration
+	config.Pricing.Currency = getEnv("PRICING_CURRENCY", "USD")
+	config.Pricing.HolidaySurcharge = getEnv("PRICING_HOLIDAY_SURCHARGE", "20")
+
+	// payment configuration
+	config.Payment.Provider = getEnv("PAYMENT_PROVIDER", "fake")
+
+	// waitlist configuration
+	config.Waitlist.OfferTTL = getEnv("WAITLIST_OFFER_TTL", "2h")
+	config.Waitlist.SweepInterval = getEnv("WAITLIST_SWEEP_INTERVAL", "1m")
+
+	// hold configuration
+	config.Hold.TTL = getEnv("HOLD_TTL", "10m")
+	config.Hold.SweepInterval = getEnv("HOLD_SWEEP_INTERVAL", "30s")
+
+	// approval configuration, bookings of establishments taking bookings on
+	// request are declined when the owner did not answer within the timeout
+	config.Approval.Timeout = getEnv("BOOKING_APPROVAL_TIMEOUT", "24h")
+
+	// external calendar sync configuration
+	config.CalendarSync.Interval = getEnv("CALENDAR_SYNC_INTERVAL", "15m")
+	config.CalendarSync.FetchTimeout = getEnv("CALENDAR_FETCH_TIMEOUT", "10s")
+
+	// scheduler configuration, confirmed bookings turn into no-shows when
+	// their guests did not arrive within the grace of their type after they
+	// were due to start, and guests are reminded SCHEDULER_REMINDER_BEFORE
+	// they start
+	config.Scheduler.Interval = getEnv("SCHEDULER_INTERVAL", "5m")
+	config.Scheduler.HotelNoShowAfter = getEnv("SCHEDULER_HOTEL_NO_SHOW_AFTER", "24h")
+	config.Scheduler.RestaurantNoShowAfter = getEnv("SCHEDULER_RESTAURANT_NO_SHOW_AFTER", "30m")
+	config.Scheduler.AttractionNoShowAfter = getEnv("SCHEDULER_ATTRACTION_NO_SHOW_AFTER", "2h")
+	config.Scheduler.ReminderBefore = getEnv("SCHEDULER_REMINDER_BEFORE", "24h")
+
+	// reservation configuration, reservation times are the local time of the
+	// establishments in RESERVATION_TIME_ZONE
+	config.Reservation.TimeZone = getEnv("RESERVATION_TIME_ZONE", "UTC")
 
 	// otlp collector configuration
 	config.OTLPCollector.Host = getEnv("OTLP_COLLECTOR_HOST", "otel-collector")
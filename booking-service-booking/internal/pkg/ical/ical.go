@@ -0,0 +1,211 @@
+// Package ical reads the events of iCalendar (RFC 5545) feeds
+package ical
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// event statuses
+	StatusCancelled = "CANCELLED"
+
+	dateFormat          = "20060102"
+	dateTimeFormat      = "20060102T150405"
+	utcDateTimeFormat   = "20060102T150405Z"
+	maxCalendarSize     = 5 << 20
+	maxContentLineBytes = 1 << 20
+)
+
+var ErrNotCalendar = errors.New("not an iCalendar file")
+
+// Event is a VEVENT. Times with a TZID are read in that zone when it is
+// known, floating times are read as UTC.
+type Event struct {
+	UID         string
+	Summary     string
+	Status      string
+	Transparent bool
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+}
+
+// Parse reads the events of a calendar, components other than VEVENT and the
+// properties of an event that are not in Event are skipped
+func Parse(r io.Reader) ([]*Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, ErrNotCalendar
+	}
+
+	var (
+		events []*Event
+		event  *Event
+		// nested components of an event such as VALARM
+		depth int
+	)
+	for number, line := range lines {
+		name, params, value, ok := splitLine(line)
+		if !ok {
+			return nil, fmt.Errorf("line %d: malformed content line", number+1)
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT") && event == nil:
+			event = &Event{}
+			continue
+		case name == "END" && strings.EqualFold(value, "VEVENT") && depth == 0 && event != nil:
+			if event.Start.IsZero() {
+				return nil, fmt.Errorf("line %d: event %q has no DTSTART", number+1, event.UID)
+			}
+			events = append(events, event)
+			event = nil
+			continue
+		case event == nil:
+			continue
+		case name == "BEGIN":
+			depth++
+			continue
+		case name == "END":
+			depth--
+			continue
+		case depth > 0:
+			continue
+		}
+
+		switch name {
+		case "UID":
+			event.UID = value
+		case "SUMMARY":
+			event.Summary = unescapeText(value)
+		case "STATUS":
+			event.Status = strings.ToUpper(value)
+		case "TRANSP":
+			event.Transparent = strings.EqualFold(value, "TRANSPARENT")
+		case "DTSTART":
+			event.Start, event.AllDay, err = parseTime(params, value)
+		case "DTEND":
+			event.End, _, err = parseTime(params, value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %v", number+1, name, err)
+		}
+	}
+	if event != nil {
+		return nil, fmt.Errorf("event %q is not closed", event.UID)
+	}
+
+	return events, nil
+}
+
+// Fetch downloads the calendar at url and parses it
+func Fetch(ctx context.Context, client *http.Client, url string) ([]*Event, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/calendar")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("calendar responded with %s", res.Status)
+	}
+
+	return Parse(io.LimitReader(res.Body, maxCalendarSize))
+}
+
+// unfold joins folded content lines and drops empty ones
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxContentLineBytes)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+// splitLine splits a content line into its upper-cased name, its parameters
+// and its value. Colons and semicolons inside quoted parameter values do not
+// split.
+func splitLine(line string) (name string, params map[string]string, value string, ok bool) {
+	quoted := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return "", nil, "", false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	params = make(map[string]string, len(parts)-1)
+	for _, param := range parts[1:] {
+		key, val, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+
+	return strings.ToUpper(parts[0]), params, line[colon+1:], true
+}
+
+// parseTime reads a DATE or DATE-TIME value
+func parseTime(params map[string]string, value string) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len(dateFormat) {
+		t, err := time.Parse(dateFormat, value)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(utcDateTimeFormat, value)
+		return t, false, err
+	}
+
+	location := time.UTC
+	if tzid, ok := params["TZID"]; ok {
+		if zone, err := time.LoadLocation(tzid); err == nil {
+			location = zone
+		}
+	}
+	t, err := time.ParseInLocation(dateTimeFormat, value, location)
+	return t, false, err
+}
+
+func unescapeText(text string) string {
+	return strings.NewReplacer(
+		`\\`, `\`,
+		`\;`, ";",
+		`\,`, ",",
+		`\n`, "\n",
+		`\N`, "\n",
+	).Replace(text)
+}
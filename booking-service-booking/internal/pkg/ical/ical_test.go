@@ -0,0 +1,79 @@
+package ical
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const feed = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Other//Listing//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:stay-1@other\r\n" +
+	"DTSTART;VALUE=DATE:20300501\r\n" +
+	"DTEND;VALUE=DATE:20300504\r\n" +
+	"SUMMARY:Reserved\\, guest\r\n" +
+	"  from abroad\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:stay-2@other\r\n" +
+	"DTSTART:20300510T140000Z\r\n" +
+	"DTEND;TZID=\"Europe/Berlin\":20300512T110000\r\n" +
+	"STATUS:cancelled\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	events, err := Parse(strings.NewReader(feed))
+	assert.NoError(t, err)
+	if !assert.Len(t, events, 2) {
+		return
+	}
+
+	assert.Equal(t, "stay-1@other", events[0].UID)
+	assert.Equal(t, "Reserved, guest from abroad", events[0].Summary)
+	assert.True(t, events[0].AllDay)
+	assert.Equal(t, time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC), events[0].Start)
+	assert.Equal(t, time.Date(2030, 5, 4, 0, 0, 0, 0, time.UTC), events[0].End)
+
+	assert.False(t, events[1].AllDay)
+	assert.Equal(t, StatusCancelled, events[1].Status)
+	assert.Equal(t, time.Date(2030, 5, 10, 14, 0, 0, 0, time.UTC), events[1].Start)
+	assert.Equal(t, 2030, events[1].End.Year())
+	assert.Equal(t, 11, events[1].End.Hour())
+
+	_, err = Parse(strings.NewReader("<html></html>"))
+	assert.ErrorIs(t, err, ErrNotCalendar)
+
+	_, err = Parse(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:x\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"))
+	assert.Error(t, err)
+}
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/listing.ics" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar")
+		w.Write([]byte(feed))
+	}))
+	defer server.Close()
+
+	events, err := Fetch(context.Background(), server.Client(), server.URL+"/listing.ics")
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	_, err = Fetch(context.Background(), server.Client(), server.URL+"/missing.ics")
+	assert.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// LeaderLock elects one of the replicas sharing the database with a session
+// level advisory lock. The replica holding the lock leads until it resigns or
+// its connection drops, which makes the database release the lock.
+type LeaderLock struct {
+	pool *pgxpool.Pool
+	key  int64
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+// LeaderLock returns an election on the advisory lock key, every replica has
+// to use the same key
+func (p *PostgresDB) LeaderLock(key int64) *LeaderLock {
+	return &LeaderLock{
+		pool: p.Pool,
+		key:  key,
+	}
+}
+
+// Lead reports whether this replica leads, taking the lock when no other
+// replica holds it
+func (l *LeaderLock) Lead(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.Ping(ctx); err == nil {
+			return true, nil
+		}
+		// the lock is gone together with the connection
+		l.drop()
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	var locked bool
+	if err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&locked); err != nil {
+		conn.Release()
+		return false, err
+	}
+	if !locked {
+		conn.Release()
+		return false, nil
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// Resign gives the lock up so another replica can lead
+func (l *LeaderLock) Resign(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+	if _, err := l.conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		l.drop()
+		return err
+	}
+
+	l.conn.Release()
+	l.conn = nil
+	return nil
+}
+
+// drop closes the connection holding the lock instead of putting it back into
+// the pool, where it would keep holding the lock
+func (l *LeaderLock) drop() {
+	l.conn.Conn().Close(context.Background())
+	l.conn.Release()
+	l.conn = nil
+}
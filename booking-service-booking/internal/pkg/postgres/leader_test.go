@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"Booking/booking-service-booking/internal/pkg/config"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderLock(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+	db, err := New(cfg)
+	if err != nil {
+		return
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	const key = 0x7465737431
+	first, second := db.LeaderLock(key), db.LeaderLock(key)
+
+	lead, err := first.Lead(ctx)
+	assert.NoError(t, err)
+	assert.True(t, lead)
+
+	// the leader keeps leading, nobody else gets the lock meanwhile
+	lead, err = first.Lead(ctx)
+	assert.NoError(t, err)
+	assert.True(t, lead)
+	lead, err = second.Lead(ctx)
+	assert.NoError(t, err)
+	assert.False(t, lead)
+
+	assert.NoError(t, first.Resign(ctx))
+	lead, err = second.Lead(ctx)
+	assert.NoError(t, err)
+	assert.True(t, lead)
+	assert.NoError(t, second.Resign(ctx))
+}
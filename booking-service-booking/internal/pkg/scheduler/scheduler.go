@@ -0,0 +1,94 @@
+// Package scheduler runs the periodic jobs of the service on the one replica
+// that leads
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Leader elects the replica that runs the jobs
+type Leader interface {
+	// Lead reports whether this replica leads, becoming the leader when
+	// there is none
+	Lead(ctx context.Context) (bool, error)
+	// Resign stops leading
+	Resign(ctx context.Context) error
+}
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+}
+
+type Scheduler struct {
+	logger *zap.Logger
+	leader Leader
+	jobs   []job
+}
+
+func New(logger *zap.Logger, leader Leader) *Scheduler {
+	return &Scheduler{
+		logger: logger,
+		leader: leader,
+	}
+}
+
+// Every adds a job that runs every interval, jobs have to be added before
+// Run is called
+func (s *Scheduler) Every(name string, interval time.Duration, run func(ctx context.Context) error) {
+	s.jobs = append(s.jobs, job{
+		name:     name,
+		interval: interval,
+		run:      run,
+	})
+}
+
+// Run runs every job on its own ticker until ctx is done and resigns the
+// leadership after that. On replicas that do not lead the ticks pass without
+// running anything. A failed job is logged and runs again on the next tick.
+func (s *Scheduler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, j := range s.jobs {
+		wg.Add(1)
+		go func(j job) {
+			defer wg.Done()
+			s.runEvery(ctx, j)
+		}(j)
+	}
+	wg.Wait()
+
+	resignCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.leader.Resign(resignCtx); err != nil {
+		s.logger.Error("failed to resign scheduler leadership", zap.Error(err))
+	}
+}
+
+func (s *Scheduler) runEvery(ctx context.Context, j job) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			lead, err := s.leader.Lead(ctx)
+			if err != nil {
+				s.logger.Error("failed to elect scheduler leader", zap.String("job", j.name), zap.Error(err))
+				continue
+			}
+			if !lead {
+				continue
+			}
+			if err = j.run(ctx); err != nil {
+				s.logger.Error(j.name+" failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type fakeLeader struct {
+	lead     atomic.Bool
+	resigned atomic.Bool
+}
+
+func (l *fakeLeader) Lead(ctx context.Context) (bool, error) {
+	return l.lead.Load(), nil
+}
+
+func (l *fakeLeader) Resign(ctx context.Context) error {
+	l.resigned.Store(true)
+	return nil
+}
+
+func TestSchedulerRunsOnlyOnLeader(t *testing.T) {
+	leader := &fakeLeader{}
+	s := New(zap.NewNop(), leader)
+
+	var runs atomic.Int64
+	s.Every("count", time.Millisecond, func(ctx context.Context) error {
+		runs.Add(1)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Run(ctx)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int64(0), runs.Load())
+
+	leader.lead.Store(true)
+	assert.Eventually(t, func() bool {
+		return runs.Load() > 0
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+	assert.True(t, leader.resigned.Load())
+}
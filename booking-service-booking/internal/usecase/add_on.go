@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	pbe "Booking/booking-service-booking/genproto/establishment-proto"
+	"Booking/booking-service-booking/internal/entity"
+	"context"
+	"fmt"
+)
+
+// a single add-on is booked this many times at most
+const maxAddOnQuantity = 100
+
+// priceAddOns checks addOns against the catalogue of the establishment booked
+// with hra_id and copies the name and price of each of them from there
+func (s BookingService) priceAddOns(ctx context.Context, bookingType, hra_id string, addOns []entity.BookingAddOn) error {
+	if len(addOns) == 0 {
+		return nil
+	}
+
+	establishment_id, err := s.establishmentOf(ctx, bookingType, hra_id)
+	if err != nil {
+		return err
+	}
+
+	catalogue, err := s.serviceClients.EstablishmentService().ListAddOns(ctx, &pbe.ListAddOnsRequest{
+		EstablishmentId: establishment_id,
+	})
+	if err != nil {
+		return s.Error("failed to list add-ons", err)
+	}
+	offered := make(map[string]*pbe.AddOn, len(catalogue.AddOns))
+	for _, addOn := range catalogue.AddOns {
+		offered[addOn.AddOnId] = addOn
+	}
+
+	errValidation := entity.NewErrValidation()
+	selected := make(map[string]bool, len(addOns))
+	for i := range addOns {
+		addOn := &addOns[i]
+		field := fmt.Sprintf("add_ons[%d]", i)
+
+		if addOn.Quantity <= 0 || addOn.Quantity > maxAddOnQuantity {
+			errValidation.Errors[field+".quantity"] = fmt.Sprintf("must be between 1 and %d", maxAddOnQuantity)
+		}
+
+		item, ok := offered[addOn.AddOnId]
+		switch {
+		case !ok:
+			errValidation.Errors[field+".add_on_id"] = "is not offered by the establishment"
+		case selected[addOn.AddOnId]:
+			errValidation.Errors[field+".add_on_id"] = "is selected twice"
+		default:
+			addOn.Name = item.Name
+			addOn.SetUnitPrice(item.Price)
+		}
+		selected[addOn.AddOnId] = true
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid add-ons")
+		return errValidation
+	}
+
+	return nil
+}
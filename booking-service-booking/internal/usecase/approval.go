@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	pbe "Booking/booking-service-booking/genproto/establishment-proto"
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Accept lets the owner take a booking that waits for approval. Hotel
+// bookings stay pending until they are paid, other bookings are confirmed.
+func (s BookingService) Accept(ctx context.Context, req *entity.StatusChange) (*entity.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "Accept")
+	span.SetAttributes(
+		attribute.Key("BookingId").String(req.BookingId),
+	)
+	defer span.End()
+
+	if err := s.awaitingApproval(ctx, req, entity.BookedStatus(req.BookingType)); err != nil {
+		return nil, err
+	}
+	if req.Reason == "" {
+		req.Reason = "accepted by the establishment"
+	}
+
+	req.From = entity.BookingPending
+	req.To = entity.BookedStatus(req.BookingType)
+	return s.moveStatus(ctx, req)
+}
+
+// Decline lets the owner turn down a booking that waits for approval, nothing
+// was paid for it. The place it held is offered to the waitlist.
+func (s BookingService) Decline(ctx context.Context, req *entity.StatusChange) (*entity.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "Decline")
+	span.SetAttributes(
+		attribute.Key("BookingId").String(req.BookingId),
+	)
+	defer span.End()
+
+	if err := s.awaitingApproval(ctx, req, entity.BookingCancelled); err != nil {
+		return nil, err
+	}
+	if req.Reason == "" {
+		req.Reason = "declined by the establishment"
+	}
+
+	req.From = entity.BookingPending
+	req.To = entity.BookingCancelled
+	change, err := s.moveStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := s.repo.Get(ctx, req.BookingType, req.BookingId)
+	if err != nil {
+		span.RecordError(err)
+		return change, nil
+	}
+	if err := s.offerNext(ctx, booking); err != nil {
+		span.RecordError(err)
+	}
+
+	return change, nil
+}
+
+// DeclineUnanswered declines the bookings the owners left unanswered past
+// their deadline and offers their place to the waitlist. It returns how many
+// bookings were declined.
+func (s BookingService) DeclineUnanswered(ctx context.Context) (int, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "DeclineUnanswered")
+	defer span.End()
+
+	declined, err := s.repo.DeclineUnanswered(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	for _, booking := range declined {
+		if err := s.offerNext(ctx, booking); err != nil {
+			return len(declined), err
+		}
+	}
+
+	return len(declined), nil
+}
+
+// awaitingApproval fails unless the booking of req waits for the owner
+func (s BookingService) awaitingApproval(ctx context.Context, req *entity.StatusChange, to string) error {
+	if err := validateBookingType(req.BookingType); err != nil {
+		return err
+	}
+
+	booking, err := s.repo.Get(ctx, req.BookingType, req.BookingId)
+	if err != nil {
+		return err
+	}
+	if !booking.AwaitingApproval() {
+		return entity.NewErrInvalidTransition("booking", booking.Status, to)
+	}
+
+	return nil
+}
+
+// bookedStatus returns the status a new booking starts in together with the
+// deadline for the owner to answer, which is zero unless the establishment
+// takes bookings on request
+func (s BookingService) bookedStatus(ctx context.Context, booking *entity.GeneralBooking, now time.Time) (string, time.Time, error) {
+	establishment_id, err := s.establishmentOf(ctx, booking.BookingType, booking.HraId)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	mode, err := s.serviceClients.EstablishmentService().GetBookingMode(ctx, &pbe.GetBookingModeRequest{
+		EstablishmentId: establishment_id,
+	})
+	if err != nil {
+		return "", time.Time{}, s.Error("failed to get booking mode", err)
+	}
+	if mode.Mode == entity.BookingModeRequest {
+		return entity.BookingPending, now.Add(s.approvalTTL), nil
+	}
+
+	return entity.BookedStatus(booking.BookingType), time.Time{}, nil
+}
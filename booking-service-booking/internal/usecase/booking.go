@@ -1,306 +1,1282 @@
 package usecase
 
 import (
+	pbe "Booking/booking-service-booking/genproto/establishment-proto"
 	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/infrastructure/grpc_service_clients"
 	"Booking/booking-service-booking/internal/infrastructure/repository"
 	"Booking/booking-service-booking/internal/pkg/otlp"
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
-	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
 )
 
 type Booking interface {
-	UHBCreate(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.GeneralBooking, error)
-	URBCreate(ctx context.Context, bookingRestaurant *entity.GeneralBooking) (*entity.GeneralBooking, error)
-	UABCreate(ctx context.Context, bookingAttraction *entity.GeneralBooking) (*entity.GeneralBooking, error)
+	Create(ctx context.Context, booking *entity.GeneralBooking) (*entity.GeneralBooking, error)
+	Get(ctx context.Context, bookingType, id string) (*entity.GeneralBooking, error)
+	GetByConfirmationCode(ctx context.Context, code string) (*entity.GeneralBooking, error)
+	GetAllByUId(ctx context.Context, bookingType string, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error)
+	GetAllByHraId(ctx context.Context, bookingType string, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error)
+	List(ctx context.Context, bookingType string, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
+	ListDeleted(ctx context.Context, bookingType string, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
+	Update(ctx context.Context, booking *entity.GeneralBooking) (*entity.GeneralBooking, error)
+	Delete(ctx context.Context, bookingType, id string) error
+	Reschedule(ctx context.Context, req *entity.Reschedule, user_id string) (*entity.StatusChange, error)
+	ListByEstablishment(ctx context.Context, bookingType, establishment_id, since string) ([]*entity.GeneralBooking, error)
+	ListForOwner(ctx context.Context, filter *entity.OwnerBookingFilter, from, to string) ([]*entity.OwnerBooking, int64, error)
 
-	UHBGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error)
-	URBGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error)
-	UABGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error)
+	SearchAvailableHotels(ctx context.Context, filter *entity.AvailabilityFilter) ([]*entity.AvailableHotel, error)
+	URBFreeSlots(ctx context.Context, restaurant_id, date string) ([]*entity.FreeSlot, int64, error)
+	UABRemainingQuota(ctx context.Context, attraction_id, date string) (*entity.AttractionQuota, error)
+	UHBQuote(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.PriceQuote, error)
 
-	UHBGetAllByHId(ctx context.Context, limit, offset uint64, room_id string) ([]*entity.Id, int64, error)
-	URBGetAllByRId(ctx context.Context, limit, offset uint64, restaurant_id string) ([]*entity.Id, int64, error)
-	UABGetAllByAId(ctx context.Context, limit, offset uint64, attraction_id string) ([]*entity.Id, int64, error)
+	Confirm(ctx context.Context, req *entity.StatusChange, user_id string) (*entity.StatusChange, error)
+	Cancel(ctx context.Context, req *entity.StatusChange, user_id string) (*entity.StatusChange, error)
+	CheckIn(ctx context.Context, req *entity.StatusChange, user_id string) (*entity.StatusChange, error)
+	Accept(ctx context.Context, req *entity.StatusChange) (*entity.StatusChange, error)
+	Decline(ctx context.Context, req *entity.StatusChange) (*entity.StatusChange, error)
+	DeclineUnanswered(ctx context.Context) (int, error)
+	StatusHistory(ctx context.Context, bookingType, booking_id, user_id string) (string, []*entity.StatusChange, error)
 
-	UHBList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-	URBList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-	UABList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
+	WaitlistJoin(ctx context.Context, entry *entity.WaitlistEntry) (*entity.WaitlistEntry, error)
+	WaitlistList(ctx context.Context, user_id string) ([]*entity.WaitlistEntry, error)
+	WaitlistLeave(ctx context.Context, id, user_id string) (*entity.WaitlistEntry, error)
+	WaitlistAccept(ctx context.Context, id, user_id string) (*entity.GeneralBooking, error)
+	WaitlistDecline(ctx context.Context, id, user_id string) (*entity.WaitlistEntry, error)
+	WaitlistExpireOffers(ctx context.Context) (int, error)
 
-	UHBListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-	URBListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
-	UABListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error)
+	ItineraryCreate(ctx context.Context, itinerary *entity.Itinerary) (*entity.Itinerary, error)
+	ItineraryGet(ctx context.Context, id, user_id string) (*entity.Itinerary, error)
+	ItineraryList(ctx context.Context, user_id string) ([]*entity.Itinerary, error)
+	ItineraryCancel(ctx context.Context, id, reason, changed_by, user_id string) (*entity.Itinerary, error)
 
-	UHBUpdate(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.GeneralBooking, error)
-	URBUpdate(ctx context.Context, bookingRestaurant *entity.GeneralBooking) (*entity.GeneralBooking, error)
-	UABUpdate(ctx context.Context, bookingAttraction *entity.GeneralBooking) (*entity.GeneralBooking, error)
-
-	UHBDelete(ctx context.Context, id string) error
-	URBDelete(ctx context.Context, id string) error
-	UABDelete(ctx context.Context, id string) error
+	Hold(ctx context.Context, booking *entity.GeneralBooking) (*entity.GeneralBooking, error)
+	HoldConfirm(ctx context.Context, id, user_id string) (*entity.GeneralBooking, error)
+	HoldRelease(ctx context.Context, id, user_id string) (*entity.GeneralBooking, error)
+	ExpireHolds(ctx context.Context) (int, error)
 }
 
 type BookingService struct {
 	BaseUseCase
-	repo       repository.Booking
-	ctxTimeout time.Duration
+	repo           repository.Booking
+	waitlist       repository.Waitlist
+	itineraries    repository.Itinerary
+	serviceClients grpc_service_clients.ServiceClients
+	pricing        entity.Pricing
+	offerTTL       time.Duration
+	holdTTL        time.Duration
+	approvalTTL    time.Duration
+	ctxTimeout     time.Duration
+	zone           *time.Location
 }
 
-func NewBookingService(ctxTimeout time.Duration, repo repository.Booking) BookingService {
+// NewBookingService builds the booking usecase, waitlist offers stay open for
+// offerTTL, holds for holdTTL and bookings made on request wait approvalTTL
+// for the owner. Reservation times are in zone.
+func NewBookingService(ctxTimeout time.Duration, repo repository.Booking, waitlist repository.Waitlist, itineraries repository.Itinerary, serviceClients grpc_service_clients.ServiceClients, pricing entity.Pricing, offerTTL, holdTTL, approvalTTL time.Duration, zone *time.Location) BookingService {
 	return BookingService{
-		ctxTimeout: ctxTimeout,
-		repo:       repo,
+		ctxTimeout:     ctxTimeout,
+		repo:           repo,
+		waitlist:       waitlist,
+		itineraries:    itineraries,
+		serviceClients: serviceClients,
+		pricing:        pricing,
+		offerTTL:       offerTTL,
+		holdTTL:        holdTTL,
+		approvalTTL:    approvalTTL,
+		zone:           zone,
 	}
 }
 
 // CREATE
-func (s BookingService) UHBCreate(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UHBCreate")
+func (s BookingService) Create(ctx context.Context, booking *entity.GeneralBooking) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "Create")
 	span.SetAttributes(
-		attribute.Key("Id").String(bookingHotel.Id.String()),
+		attribute.Key("Id").String(booking.Id.String()),
+		attribute.Key("BookingType").String(booking.BookingType),
 	)
 	defer span.End()
 
-	s.beforeRequest(nil, &bookingHotel.CreatedAt, nil, nil)
-	return s.repo.UHBCreate(ctx, bookingHotel)
+	if err := s.priceAddOns(ctx, booking.BookingType, booking.HraId, booking.AddOns); err != nil {
+		return nil, err
+	}
+	capacity, err := s.checkBooking(ctx, booking)
+	if err != nil {
+		return nil, err
+	}
+
+	s.beforeRequest(nil, &booking.CreatedAt, nil, nil)
+	booking.Status, booking.ApprovalDueAt, err = s.bookedStatus(ctx, booking, booking.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, booking, capacity)
 }
 
-func (s BookingService) URBCreate(ctx context.Context, bookingRestaurant *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "URBCreate")
+// checkBooking validates a booking against the establishment it is made for
+// and returns the capacity the booking has to fit into. Hotel stays are priced
+// on the way and add-ons already priced are added on top.
+func (s BookingService) checkBooking(ctx context.Context, booking *entity.GeneralBooking) (entity.Capacity, error) {
+	booking.TotalPrice = 0
+	capacity, err := s.checkEstablishment(ctx, booking)
+	if err != nil {
+		return entity.Capacity{}, err
+	}
+
+	// the dates are only known to be valid once the establishment checked them
+	if err := s.checkClosures(ctx, booking); err != nil {
+		return entity.Capacity{}, err
+	}
+
+	// the party size is only settled once the establishment checked it
+	if err := validateManifest(booking); err != nil {
+		return entity.Capacity{}, err
+	}
+
+	booking.TotalPrice = entity.WithAddOns(booking.TotalPrice, booking.AddOns)
+	if booking.TotalPrice > 0 && booking.Currency == "" {
+		booking.Currency = s.pricing.Currency
+	}
+
+	return capacity, nil
+}
+
+func (s BookingService) checkEstablishment(ctx context.Context, booking *entity.GeneralBooking) (entity.Capacity, error) {
+	// tickets are sold by attractions only
+	if booking.BookingType != entity.BookingAttraction {
+		booking.AdultTickets, booking.ChildTickets = 0, 0
+	}
+
+	switch booking.BookingType {
+	case entity.BookingHotel:
+		if err := validateStay(booking.WillArrive, booking.WillLeave); err != nil {
+			return entity.Capacity{}, err
+		}
+
+		room, err := s.serviceClients.EstablishmentService().GetRoom(ctx, &pbe.GetRoomRequest{
+			RoomId: booking.HraId,
+		})
+		if err != nil {
+			return entity.Capacity{}, s.Error("failed to get room", err)
+		}
+
+		quote, err := s.quoteStay(booking, room.Room)
+		if err != nil {
+			return entity.Capacity{}, err
+		}
+		booking.TotalPrice = quote.Total
+		booking.Currency = quote.Currency
+
+		return entity.Capacity{Rooms: room.Room.NumberOfRooms}, nil
+	case entity.BookingRestaurant:
+		restaurant, err := s.serviceClients.EstablishmentService().GetRestaurant(ctx, &pbe.GetRestaurantRequest{
+			RestaurantId: booking.HraId,
+		})
+		if err != nil {
+			return entity.Capacity{}, s.Error("failed to get restaurant", err)
+		}
+
+		if err := validateReservation(booking, restaurant.Restaurant); err != nil {
+			return entity.Capacity{}, err
+		}
+
+		return entity.Capacity{Seats: restaurant.Restaurant.SeatCapacity}, nil
+	case entity.BookingAttraction:
+		attraction, err := s.serviceClients.EstablishmentService().GetAttraction(ctx, &pbe.GetAttractionRequest{
+			AttractionId: booking.HraId,
+		})
+		if err != nil {
+			return entity.Capacity{}, s.Error("failed to get attraction", err)
+		}
+
+		if err := validateEntry(booking, attraction.Attraction); err != nil {
+			return entity.Capacity{}, err
+		}
+
+		return entity.Capacity{Tickets: entity.TicketQuota{
+			Daily:  attraction.Attraction.DailyQuota,
+			Window: attraction.Attraction.WindowQuota,
+		}}, nil
+	}
+
+	return entity.Capacity{}, validateBookingType(booking.BookingType)
+}
+
+// GET
+func (s BookingService) Get(ctx context.Context, bookingType, id string) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "Get")
 	span.SetAttributes(
-		attribute.Key("Id").String(bookingRestaurant.Id.String()),
+		attribute.Key("Id").String(id),
+		attribute.Key("BookingType").String(bookingType),
 	)
 	defer span.End()
 
-	s.beforeRequest(nil, &bookingRestaurant.CreatedAt, nil, nil)
-	return s.repo.URBCreate(ctx, bookingRestaurant)
+	if err := validateListType(bookingType); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Get(ctx, bookingType, id)
 }
 
-func (s BookingService) UABCreate(ctx context.Context, bookingAttraction *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UABCreate")
-	span.SetAttributes(
-		attribute.Key("Id").String(bookingAttraction.Id.String()),
-	)
+// GetByConfirmationCode finds the booking a guest shows its confirmation code
+// for, the code may be typed in lower case or with dashes
+func (s BookingService) GetByConfirmationCode(ctx context.Context, code string) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "GetByConfirmationCode")
 	defer span.End()
 
-	s.beforeRequest(nil, &bookingAttraction.CreatedAt, nil, nil)
-	return s.repo.UABCreate(ctx, bookingAttraction)
+	normalized, ok := entity.NormalizeConfirmationCode(code)
+	if !ok {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["code"] = fmt.Sprintf("must be %d letters and digits", entity.ConfirmationCodeLength)
+		errValidation.Err = fmt.Errorf("invalid confirmation code")
+		return nil, errValidation
+	}
+
+	return s.repo.GetByConfirmationCode(ctx, normalized)
 }
 
 // GET ALL BY USER ID
-func (s BookingService) UHBGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UHBGetAllByUId")
+func (s BookingService) GetAllByUId(ctx context.Context, bookingType string, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "GetAllByUId")
 	span.SetAttributes(
 		attribute.Key("UserId").String(user_id),
+		attribute.Key("BookingType").String(bookingType),
 		attribute.Key("Limit").String(fmt.Sprint(limit)),
 		attribute.Key("Offset").String(fmt.Sprint(offset)),
 	)
 	defer span.End()
 
-	return s.repo.UHBGetAllByUId(ctx, limit, offset, user_id)
+	if err := validateListType(bookingType); err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.GetAllByUId(ctx, bookingType, limit, offset, user_id)
 }
 
-func (s BookingService) URBGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "URBGetAllByUId")
+// GET ALL BY HRA ID
+func (s BookingService) GetAllByHraId(ctx context.Context, bookingType string, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "GetAllByHraId")
 	span.SetAttributes(
-		attribute.Key("UserId").String(user_id),
+		attribute.Key("HraId").String(hra_id),
+		attribute.Key("BookingType").String(bookingType),
 		attribute.Key("Limit").String(fmt.Sprint(limit)),
 		attribute.Key("Offset").String(fmt.Sprint(offset)),
 	)
 	defer span.End()
 
-	return s.repo.URBGetAllByUId(ctx, limit, offset, user_id)
+	if err := validateListType(bookingType); err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.GetAllByHraId(ctx, bookingType, limit, offset, hra_id)
 }
 
-func (s BookingService) UABGetAllByUId(ctx context.Context, limit, offset uint64, user_id string) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UABGetAllByUId")
+// LIST BOOKINGS FOR ADMIN
+func (s BookingService) List(ctx context.Context, bookingType string, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "List")
 	span.SetAttributes(
-		attribute.Key("UserId").String(user_id),
+		attribute.Key("BookingType").String(bookingType),
 		attribute.Key("Limit").String(fmt.Sprint(limit)),
 		attribute.Key("Offset").String(fmt.Sprint(offset)),
 	)
 	defer span.End()
 
-	return s.repo.UABGetAllByUId(ctx, limit, offset, user_id)
+	if err := validateListType(bookingType); err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.List(ctx, bookingType, limit, offset)
 }
 
-// GET ALL BY HRA ID
-func (s BookingService) UHBGetAllByHId(ctx context.Context, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UHBGetAllByHId")
+// LIST DELETED BOOKINGS FOR ADMIN
+func (s BookingService) ListDeleted(ctx context.Context, bookingType string, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "ListDeleted")
 	span.SetAttributes(
-		attribute.Key("HotelId").String(hra_id),
+		attribute.Key("BookingType").String(bookingType),
 		attribute.Key("Limit").String(fmt.Sprint(limit)),
 		attribute.Key("Offset").String(fmt.Sprint(offset)),
 	)
 	defer span.End()
 
-	return s.repo.UHBGetAllByHId(ctx, limit, offset, hra_id)
+	if err := validateListType(bookingType); err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListDeleted(ctx, bookingType, limit, offset)
 }
 
-func (s BookingService) URBGetAllByRId(ctx context.Context, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "URBGetAllByRId")
+// ListByEstablishment lists the bookings of a hotel, restaurant or attraction
+// that end on or after since (YYYY-MM-DD), an empty since lists all of them.
+// Hotel bookings are made for rooms, so a hotel lists the bookings of all its
+// rooms.
+func (s BookingService) ListByEstablishment(ctx context.Context, bookingType, establishment_id, since string) ([]*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "ListByEstablishment")
 	span.SetAttributes(
-		attribute.Key("RestaurantId").String(hra_id),
-		attribute.Key("Limit").String(fmt.Sprint(limit)),
-		attribute.Key("Offset").String(fmt.Sprint(offset)),
+		attribute.Key("EstablishmentId").String(establishment_id),
+		attribute.Key("BookingType").String(bookingType),
 	)
 	defer span.End()
 
-	return s.repo.URBGetAllByRId(ctx, limit, offset, hra_id)
+	if err := validateBookingType(bookingType); err != nil {
+		return nil, err
+	}
+
+	var from time.Time
+	if since != "" {
+		var err error
+		from, err = time.Parse("2006-01-02", since)
+		if err != nil {
+			errValidation := entity.NewErrValidation()
+			errValidation.Errors["since"] = "must be a date as YYYY-MM-DD"
+			errValidation.Err = err
+			return nil, errValidation
+		}
+	}
+
+	hra_ids := []string{establishment_id}
+	if bookingType == entity.BookingHotel {
+		rooms, err := s.serviceClients.EstablishmentService().ListRoomsByHotel(ctx, &pbe.ListRoomsByHotelRequest{
+			HotelId: establishment_id,
+		})
+		if err != nil {
+			return nil, s.Error("failed to list rooms", err)
+		}
+		hra_ids = hra_ids[:0]
+		for _, room := range rooms.Rooms {
+			hra_ids = append(hra_ids, room.RoomId)
+		}
+	}
+
+	return s.repo.ListByHraIds(ctx, bookingType, hra_ids, from)
 }
 
-func (s BookingService) UABGetAllByAId(ctx context.Context, limit, offset uint64, hra_id string) ([]*entity.Id, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UABGetAllByAId")
+// ListForOwner lists the bookings of the establishments of an owner that take
+// any day from from to to (YYYY-MM-DD, both included), an empty date leaves
+// that side open. Hotel bookings are made for rooms, so they are listed for
+// the hotel of the room.
+func (s BookingService) ListForOwner(ctx context.Context, filter *entity.OwnerBookingFilter, from, to string) ([]*entity.OwnerBooking, int64, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "ListForOwner")
 	span.SetAttributes(
-		attribute.Key("AttractionId").String(hra_id),
-		attribute.Key("Limit").String(fmt.Sprint(limit)),
-		attribute.Key("Offset").String(fmt.Sprint(offset)),
+		attribute.Key("Establishments").String(fmt.Sprint(len(filter.EstablishmentIds))),
 	)
 	defer span.End()
 
-	return s.repo.UABGetAllByAId(ctx, limit, offset, hra_id)
+	errValidation := entity.NewErrValidation()
+	var err error
+	if from != "" {
+		if filter.From, err = time.Parse("2006-01-02", from); err != nil {
+			errValidation.Errors["from"] = "must be a date as YYYY-MM-DD"
+		}
+	}
+	if to != "" {
+		if filter.To, err = time.Parse("2006-01-02", to); err != nil {
+			errValidation.Errors["to"] = "must be a date as YYYY-MM-DD"
+		}
+		filter.To = filter.To.AddDate(0, 0, 1)
+	}
+	if len(errValidation.Errors) == 0 && !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		errValidation.Errors["to"] = "must not be before from"
+	}
+	for _, status := range filter.Statuses {
+		if !entity.IsBookingStatus(status) {
+			errValidation.Errors["status"] = fmt.Sprintf("unknown status %q", status)
+		}
+	}
+	if len(errValidation.Errors) > 0 {
+		errValidation.Err = fmt.Errorf("invalid owner booking filter")
+		return nil, 0, errValidation
+	}
+
+	// restaurants and attractions are booked by their own id, hotels have
+	// no rooms of other establishments
+	establishments := make(map[string]string)
+	hra_ids := make([]string, 0, len(filter.EstablishmentIds))
+	for _, establishment_id := range filter.EstablishmentIds {
+		establishments[establishment_id] = establishment_id
+		hra_ids = append(hra_ids, establishment_id)
+
+		rooms, err := s.serviceClients.EstablishmentService().ListRoomsByHotel(ctx, &pbe.ListRoomsByHotelRequest{
+			HotelId: establishment_id,
+		})
+		if err != nil {
+			return nil, 0, s.Error("failed to list rooms", err)
+		}
+		for _, room := range rooms.Rooms {
+			establishments[room.RoomId] = establishment_id
+			hra_ids = append(hra_ids, room.RoomId)
+		}
+	}
+
+	bookings, count, err := s.repo.ListForOwner(ctx, hra_ids, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res := make([]*entity.OwnerBooking, 0, len(bookings))
+	for _, booking := range bookings {
+		res = append(res, &entity.OwnerBooking{
+			Booking:         booking,
+			EstablishmentId: establishments[booking.HraId],
+		})
+	}
+
+	return res, count, nil
 }
 
-// LIST BOOKINGS FOR ADMIN
-func (s BookingService) UHBList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UHBList")
+// UPDATE
+
+// Update changes the reason of a pending or confirmed booking. The dates, the
+// party and what is booked stay as they are, moving a booking goes through
+// Reschedule. Nil guests or add-ons leave the stored ones as they are, nil
+// special requests leave the stored special requests and their note.
+func (s BookingService) Update(ctx context.Context, booking *entity.GeneralBooking) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "Update")
 	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(limit)),
-		attribute.Key("Offset").String(fmt.Sprint(offset)),
+		attribute.Key("Id").String(booking.Id.String()),
+		attribute.Key("BookingType").String(booking.BookingType),
 	)
 	defer span.End()
 
-	return s.repo.UHBList(ctx, limit, offset)
+	if err := validateBookingType(booking.BookingType); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.repo.Get(ctx, booking.BookingType, booking.Id.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEditable(stored.Status); err != nil {
+		return nil, err
+	}
+
+	stored.Reason = booking.Reason
+	if booking.Guests != nil {
+		stored.Guests = booking.Guests
+	}
+	if booking.SpecialRequests != nil {
+		stored.SpecialRequests = booking.SpecialRequests
+		stored.SpecialRequestNote = booking.SpecialRequestNote
+	}
+	if err := validateManifest(stored); err != nil {
+		return nil, err
+	}
+
+	if booking.AddOns != nil && !entity.SameAddOns(stored.AddOns, booking.AddOns) {
+		// a paid stay keeps the add-ons it was paid with
+		if stored.BookingType == entity.BookingHotel && stored.Status != entity.BookingPending {
+			errValidation := entity.NewErrValidation()
+			errValidation.Errors["add_ons"] = fmt.Sprintf("add-ons of a %s stay can not be changed", stored.Status)
+			errValidation.Err = fmt.Errorf("booking is %s", stored.Status)
+			return nil, errValidation
+		}
+		if err := s.priceAddOns(ctx, stored.BookingType, stored.HraId, booking.AddOns); err != nil {
+			return nil, err
+		}
+
+		withoutAddOns := entity.PriceDifference(entity.AddOnsTotal(stored.AddOns), stored.TotalPrice)
+		stored.AddOns = booking.AddOns
+		stored.TotalPrice = entity.WithAddOns(withoutAddOns, stored.AddOns)
+		if stored.TotalPrice > 0 && stored.Currency == "" {
+			stored.Currency = s.pricing.Currency
+		}
+	}
+
+	s.beforeRequest(nil, nil, &stored.UpdatedAt, nil)
+	return s.repo.Update(ctx, stored)
 }
 
-func (s BookingService) URBList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "URBList")
+// Reschedule moves a pending or confirmed booking to new dates or changes the
+// size of its party. The booking has to fit into what is left at the new
+// dates and hotel stays are priced again. Guests moving their own booking are
+// held to the change rules of the establishment and pay the change fee once
+// the booking is paid. A non-empty user_id restricts the change to bookings
+// made by that user.
+func (s BookingService) Reschedule(ctx context.Context, req *entity.Reschedule, user_id string) (*entity.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "Reschedule")
 	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(limit)),
-		attribute.Key("Offset").String(fmt.Sprint(offset)),
+		attribute.Key("BookingId").String(req.BookingId),
 	)
 	defer span.End()
 
-	return s.repo.URBList(ctx, limit, offset)
+	if err := validateBookingType(req.BookingType); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.repo.Get(ctx, req.BookingType, req.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	if user_id != "" && booking.UserId != user_id {
+		return nil, entity.NewErrNotFound("booking")
+	}
+	if err := validateEditable(booking.Status); err != nil {
+		return nil, err
+	}
+
+	feePercent := 0.0
+	if user_id != "" {
+		rules, err := s.cancellationRules(ctx, req.BookingType, booking.HraId)
+		if err != nil {
+			return nil, err
+		}
+		arrive, err := arrivalTime(req.BookingType, booking.WillArrive)
+		if err != nil {
+			return nil, s.Error("failed to parse arrival of booking", err)
+		}
+		feePercent, err = entity.ChangeFee(rules, arrive, entity.ReservationClock(time.Now(), s.zone))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	moved := *booking
+	moved.WillArrive = req.WillArrive
+	moved.WillLeave = req.WillLeave
+	moved.NumberOfPeople = req.NumberOfPeople
+	moved.AdultTickets = req.AdultTickets
+	moved.ChildTickets = req.ChildTickets
+	capacity, err := s.checkBooking(ctx, &moved)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &entity.StatusChange{
+		BookingId:       req.BookingId,
+		BookingType:     req.BookingType,
+		From:            booking.Status,
+		To:              booking.Status,
+		Reason:          req.Reason,
+		ChangedBy:       req.ChangedBy,
+		Currency:        moved.Currency,
+		FromWillArrive:  booking.WillArrive,
+		FromWillLeave:   booking.WillLeave,
+		ToWillArrive:    moved.WillArrive,
+		ToWillLeave:     moved.WillLeave,
+		PriceDifference: entity.PriceDifference(booking.TotalPrice, moved.TotalPrice),
+	}
+	// pending bookings are not paid yet and owe no fee
+	if booking.Status != entity.BookingPending {
+		change.ChangeFee = entity.ChangeFeeAmount(booking.TotalPrice, feePercent)
+	}
+
+	s.beforeRequest(&change.Id, &change.CreatedAt, nil, nil)
+	if err := s.repo.Reschedule(ctx, &moved, capacity, change); err != nil {
+		return nil, err
+	}
+
+	return change, nil
 }
 
-func (s BookingService) UABList(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UABList")
-	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(limit)),
-		attribute.Key("Offset").String(fmt.Sprint(offset)),
-	)
-	defer span.End()
+// validateEditable rejects changes to bookings that are no longer pending or confirmed
+func validateEditable(status string) error {
+	if !entity.BookingEditable(status) {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["status"] = fmt.Sprintf("%s booking can not be changed", status)
+		errValidation.Err = fmt.Errorf("booking is %s", status)
+		return errValidation
+	}
 
-	return s.repo.UABList(ctx, limit, offset)
+	return nil
 }
 
-// LIST DELETED BOOKINGS FOR ADMIN
-func (s BookingService) UHBListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UHBListDeleted")
+// SOFT DELETE
+
+// Delete removes a cancelled, no-show or expired booking. Bookings that still
+// hold their place have to be cancelled first, so that the cancellation
+// policy, the refund and the waitlist are taken care of.
+func (s BookingService) Delete(ctx context.Context, bookingType, id string) error {
+	ctx, span := otlp.Start(ctx, "Usecase", "Delete")
 	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(limit)),
-		attribute.Key("Offset").String(fmt.Sprint(offset)),
+		attribute.Key("Id").String(id),
+		attribute.Key("BookingType").String(bookingType),
 	)
 	defer span.End()
 
-	return s.repo.UHBListDeleted(ctx, limit, offset)
+	if err := validateBookingType(bookingType); err != nil {
+		return err
+	}
+
+	booking, err := s.repo.Get(ctx, bookingType, id)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(entity.ReleasedStatuses, booking.Status) {
+		return entity.NewErrInvalidTransition("booking", booking.Status, "deleted")
+	}
+
+	return s.repo.Delete(ctx, bookingType, id)
 }
 
-func (s BookingService) URBListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "URBListDeleted")
+// AVAILABILITY
+const hotelsPageSize = 100
+
+func (s BookingService) SearchAvailableHotels(ctx context.Context, filter *entity.AvailabilityFilter) ([]*entity.AvailableHotel, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "SearchAvailableHotels")
 	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(limit)),
-		attribute.Key("Offset").String(fmt.Sprint(offset)),
+		attribute.Key("City").String(filter.City),
+		attribute.Key("WillArrive").String(filter.WillArrive),
+		attribute.Key("WillLeave").String(filter.WillLeave),
 	)
 	defer span.End()
 
-	return s.repo.URBListDeleted(ctx, limit, offset)
+	if err := validateStay(filter.WillArrive, filter.WillLeave); err != nil {
+		return nil, err
+	}
+	if filter.PartySize <= 0 {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["party_size"] = "must be greater than zero"
+		errValidation.Err = fmt.Errorf("invalid party size")
+		return nil, errValidation
+	}
+
+	var available []*entity.AvailableHotel
+
+	for offset := uint64(0); ; offset += hotelsPageSize {
+		hotels, err := s.serviceClients.EstablishmentService().ListHotelsByLocation(ctx, &pbe.ListHotelsByLocationRequest{
+			Offset: offset,
+			Limit:  hotelsPageSize,
+			City:   filter.City,
+		})
+		if err != nil {
+			return nil, s.Error("failed to list hotels", err)
+		}
+
+		for _, hotel := range hotels.Hotels {
+			rooms, err := s.serviceClients.EstablishmentService().ListRoomsByHotel(ctx, &pbe.ListRoomsByHotelRequest{
+				HotelId: hotel.HotelId,
+			})
+			if err != nil {
+				return nil, s.Error("failed to list rooms", err)
+			}
+
+			var roomIds []string
+			for _, room := range rooms.Rooms {
+				if room.Capacity >= filter.PartySize {
+					roomIds = append(roomIds, room.RoomId)
+				}
+			}
+
+			booked, err := s.repo.UHBCountBooked(ctx, roomIds, filter.WillArrive, filter.WillLeave)
+			if err != nil {
+				return nil, err
+			}
+
+			// rooms are listed cheapest first
+			for _, room := range rooms.Rooms {
+				if room.Capacity < filter.PartySize || booked[room.RoomId] >= room.NumberOfRooms {
+					continue
+				}
+
+				availableHotel := &entity.AvailableHotel{
+					HotelId:         hotel.HotelId,
+					HotelName:       hotel.HotelName,
+					Rating:          hotel.Rating,
+					RoomId:          room.RoomId,
+					RoomDescription: room.Description,
+					RoomCapacity:    room.Capacity,
+					NightlyPrice:    room.Price,
+					FreeRooms:       room.NumberOfRooms - booked[room.RoomId],
+				}
+				if hotel.Location != nil {
+					availableHotel.Address = hotel.Location.Address
+					availableHotel.City = hotel.Location.City
+				}
+				available = append(available, availableHotel)
+				break
+			}
+		}
+
+		if uint64(len(hotels.Hotels)) < hotelsPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(available, func(i, j int) bool {
+		return available[i].NightlyPrice < available[j].NightlyPrice
+	})
+
+	return available, nil
 }
 
-func (s BookingService) UABListDeleted(ctx context.Context, limit, offset uint64) ([]*entity.GeneralBooking, int64, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UABListDeleted")
+// PRICE QUOTE
+func (s BookingService) UHBQuote(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.PriceQuote, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "UHBQuote")
 	span.SetAttributes(
-		attribute.Key("Limit").String(fmt.Sprint(limit)),
-		attribute.Key("Offset").String(fmt.Sprint(offset)),
+		attribute.Key("RoomId").String(bookingHotel.HraId),
+		attribute.Key("WillArrive").String(bookingHotel.WillArrive),
+		attribute.Key("WillLeave").String(bookingHotel.WillLeave),
 	)
 	defer span.End()
 
-	return s.repo.UABListDeleted(ctx, limit, offset)
+	if err := validateStay(bookingHotel.WillArrive, bookingHotel.WillLeave); err != nil {
+		return nil, err
+	}
+
+	room, err := s.serviceClients.EstablishmentService().GetRoom(ctx, &pbe.GetRoomRequest{
+		RoomId: bookingHotel.HraId,
+	})
+	if err != nil {
+		return nil, s.Error("failed to get room", err)
+	}
+
+	return s.quoteStay(bookingHotel, room.Room)
 }
 
-// UPDATE
-func (s BookingService) UHBUpdate(ctx context.Context, bookingHotel *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UHBUpdate")
+// quoteStay prices a stay that already passed validateStay
+func (s BookingService) quoteStay(bookingHotel *entity.GeneralBooking, room *pbe.Room) (*entity.PriceQuote, error) {
+	holidays, err := entity.ParseHolidays(room.Holidays)
+	if err != nil {
+		return nil, s.Error("failed to parse holidays of room", err)
+	}
+
+	arrive, _ := time.Parse("2006-01-02", bookingHotel.WillArrive)
+	leave, _ := time.Parse("2006-01-02", bookingHotel.WillLeave)
+
+	return entity.QuoteStay(arrive, leave, room.Price, room.Discount, holidays, s.pricing), nil
+}
+
+// RESTAURANT SLOTS
+const defaultSlotMinutes = 120
+
+func (s BookingService) URBFreeSlots(ctx context.Context, restaurant_id, date string) ([]*entity.FreeSlot, int64, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "URBFreeSlots")
 	span.SetAttributes(
-		attribute.Key("Id").String(bookingHotel.Id.String()),
+		attribute.Key("RestaurantId").String(restaurant_id),
+		attribute.Key("Date").String(date),
 	)
 	defer span.End()
 
-	s.beforeRequest(nil, nil, &bookingHotel.UpdatedAt, nil)
-	return s.repo.UHBUpdate(ctx, bookingHotel)
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["date"] = "must be a date in YYYY-MM-DD format"
+		errValidation.Err = fmt.Errorf("invalid date")
+		return nil, 0, errValidation
+	}
+
+	restaurant, err := s.serviceClients.EstablishmentService().GetRestaurant(ctx, &pbe.GetRestaurantRequest{
+		RestaurantId: restaurant_id,
+	})
+	if err != nil {
+		return nil, 0, s.Error("failed to get restaurant", err)
+	}
+
+	hours, err := entity.ParseOpeningHours(restaurant.Restaurant.OpeningHours)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse opening hours of restaurant: %w", err)
+	}
+
+	length := slotLength(restaurant.Restaurant)
+	starts := hours.Slots(day, length)
+	if len(starts) == 0 {
+		return []*entity.FreeSlot{}, restaurant.Restaurant.SeatCapacity, nil
+	}
+
+	reservations, err := s.repo.URBListBetween(ctx, restaurant_id, starts[0], starts[len(starts)-1].Add(length))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	slots := make([]*entity.FreeSlot, 0, len(starts))
+	for _, start := range starts {
+		end := start.Add(length)
+
+		var seated int64
+		for _, reservation := range reservations {
+			arrive, err := time.Parse(entity.ReservationLayout, reservation.WillArrive)
+			if err != nil {
+				continue
+			}
+			leave, err := time.Parse(entity.ReservationLayout, reservation.WillLeave)
+			if err != nil {
+				continue
+			}
+			if arrive.Before(end) && leave.After(start) {
+				seated += reservation.NumberOfPeople
+			}
+		}
+
+		free := restaurant.Restaurant.SeatCapacity - seated
+		if free < 0 {
+			free = 0
+		}
+		slots = append(slots, &entity.FreeSlot{
+			Start:     start.Format(entity.ReservationLayout),
+			End:       end.Format(entity.ReservationLayout),
+			FreeSeats: free,
+		})
+	}
+
+	return slots, restaurant.Restaurant.SeatCapacity, nil
+}
+
+// slotLength is the length of one reservation at the restaurant
+func slotLength(restaurant *pbe.Restaurant) time.Duration {
+	if restaurant.SlotMinutes <= 0 {
+		return defaultSlotMinutes * time.Minute
+	}
+	return time.Duration(restaurant.SlotMinutes) * time.Minute
+}
+
+const (
+	maxGuestAge                 = 130
+	maxSpecialRequestNoteLength = 500
+)
+
+// validateManifest checks the guests and special requests of a booking. The
+// guests named may not outnumber the party and every special request is made
+// once.
+func validateManifest(booking *entity.GeneralBooking) error {
+	errValidation := entity.NewErrValidation()
+
+	if int64(len(booking.Guests)) > booking.NumberOfPeople {
+		errValidation.Errors["guests"] = fmt.Sprintf("must not name more than the %d people of the booking", booking.NumberOfPeople)
+	}
+	for i, guest := range booking.Guests {
+		field := fmt.Sprintf("guests[%d]", i)
+		if strings.TrimSpace(guest.FullName) == "" {
+			errValidation.Errors[field+".full_name"] = "must not be empty"
+		}
+		if guest.Age < 0 || guest.Age > maxGuestAge {
+			errValidation.Errors[field+".age"] = fmt.Sprintf("must be between 0 and %d", maxGuestAge)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, code := range booking.SpecialRequests {
+		if !entity.IsSpecialRequest(code) {
+			errValidation.Errors["special_requests"] = "unknown special request " + code
+		} else if seen[code] {
+			errValidation.Errors["special_requests"] = "special request " + code + " is made twice"
+		}
+		seen[code] = true
+	}
+	if utf8.RuneCountInString(booking.SpecialRequestNote) > maxSpecialRequestNoteLength {
+		errValidation.Errors["special_request_note"] = fmt.Sprintf("must not be longer than %d characters", maxSpecialRequestNoteLength)
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid guest manifest")
+		return errValidation
+	}
+
+	return nil
+}
+
+// validateReservation checks the visit time and party size of a reservation
+// against the restaurant and sets the end of the visit
+func validateReservation(bookingRestaurant *entity.GeneralBooking, restaurant *pbe.Restaurant) error {
+	errValidation := entity.NewErrValidation()
+
+	if bookingRestaurant.NumberOfPeople <= 0 {
+		errValidation.Errors["number_of_people"] = "must be greater than zero"
+	} else if bookingRestaurant.NumberOfPeople > restaurant.SeatCapacity {
+		errValidation.Errors["number_of_people"] = fmt.Sprintf("must not exceed the %d seats of the restaurant", restaurant.SeatCapacity)
+	}
+
+	arrive, err := time.Parse(entity.ReservationLayout, bookingRestaurant.WillArrive)
+	if err != nil {
+		errValidation.Errors["will_arrive"] = "must be a time in YYYY-MM-DD HH:MM format"
+	} else {
+		hours, err := entity.ParseOpeningHours(restaurant.OpeningHours)
+		if err != nil {
+			return fmt.Errorf("failed to parse opening hours of restaurant: %w", err)
+		}
+
+		length := slotLength(restaurant)
+		if !hours.Fits(arrive, length) {
+			errValidation.Errors["will_arrive"] = "must be within the opening hours " + restaurant.OpeningHours
+		}
+		bookingRestaurant.WillLeave = arrive.Add(length).Format(entity.ReservationLayout)
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid reservation")
+		return errValidation
+	}
+
+	return nil
 }
 
-func (s BookingService) URBUpdate(ctx context.Context, bookingRestaurant *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "URBUpdate")
+// ATTRACTION QUOTA
+const defaultEntryWindowMinutes = 60
+
+func (s BookingService) UABRemainingQuota(ctx context.Context, attraction_id, date string) (*entity.AttractionQuota, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "UABRemainingQuota")
 	span.SetAttributes(
-		attribute.Key("Id").String(bookingRestaurant.Id.String()),
+		attribute.Key("AttractionId").String(attraction_id),
+		attribute.Key("Date").String(date),
 	)
 	defer span.End()
 
-	s.beforeRequest(nil, nil, &bookingRestaurant.UpdatedAt, nil)
-	return s.repo.URBUpdate(ctx, bookingRestaurant)
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["date"] = "must be a date in YYYY-MM-DD format"
+		errValidation.Err = fmt.Errorf("invalid date")
+		return nil, errValidation
+	}
+
+	attraction, err := s.serviceClients.EstablishmentService().GetAttraction(ctx, &pbe.GetAttractionRequest{
+		AttractionId: attraction_id,
+	})
+	if err != nil {
+		return nil, s.Error("failed to get attraction", err)
+	}
+
+	hours, err := entity.ParseOpeningHours(attraction.Attraction.OpeningHours)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse opening hours of attraction: %w", err)
+	}
+
+	sold, err := s.repo.UABTicketsByEntry(ctx, attraction_id, day)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := &entity.AttractionQuota{
+		DailyQuota:     attraction.Attraction.DailyQuota,
+		DailyRemaining: attraction.Attraction.DailyQuota,
+		WindowQuota:    attraction.Attraction.WindowQuota,
+		Windows:        []*entity.EntryWindow{},
+	}
+	for _, tickets := range sold {
+		quota.DailyRemaining -= tickets
+	}
+	if quota.DailyRemaining < 0 {
+		quota.DailyRemaining = 0
+	}
+
+	length := entryWindowLength(attraction.Attraction)
+	for _, start := range hours.Slots(day, length) {
+		remaining := quota.DailyRemaining
+		if quota.WindowQuota > 0 && quota.WindowQuota-sold[start.Format(entity.ReservationLayout)] < remaining {
+			remaining = quota.WindowQuota - sold[start.Format(entity.ReservationLayout)]
+		}
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		quota.Windows = append(quota.Windows, &entity.EntryWindow{
+			Start:     start.Format(entity.ReservationLayout),
+			End:       start.Add(length).Format(entity.ReservationLayout),
+			Remaining: remaining,
+		})
+	}
+
+	return quota, nil
 }
 
-func (s BookingService) UABUpdate(ctx context.Context, bookingAttraction *entity.GeneralBooking) (*entity.GeneralBooking, error) {
-	ctx, span := otlp.Start(ctx, "Usecase", "UABUpdate")
+// entryWindowLength is the length of one timed-entry window of the attraction
+func entryWindowLength(attraction *pbe.Attraction) time.Duration {
+	if attraction.EntryWindowMinutes <= 0 {
+		return defaultEntryWindowMinutes * time.Minute
+	}
+	return time.Duration(attraction.EntryWindowMinutes) * time.Minute
+}
+
+// validateEntry checks the tickets and the entry window of an attraction booking
+// and sets the number of people and the end of the entry window
+func validateEntry(bookingAttraction *entity.GeneralBooking, attraction *pbe.Attraction) error {
+	errValidation := entity.NewErrValidation()
+
+	// bookings without tickets count every person as an adult
+	if bookingAttraction.AdultTickets == 0 && bookingAttraction.ChildTickets == 0 {
+		bookingAttraction.AdultTickets = bookingAttraction.NumberOfPeople
+	}
+	bookingAttraction.NumberOfPeople = bookingAttraction.AdultTickets + bookingAttraction.ChildTickets
+
+	offered := make(map[string]bool)
+	for _, ticketType := range attraction.TicketTypes {
+		offered[ticketType.Name] = true
+	}
+	if bookingAttraction.AdultTickets < 0 || bookingAttraction.ChildTickets < 0 || bookingAttraction.NumberOfPeople <= 0 {
+		errValidation.Errors["tickets"] = "must book at least one ticket"
+	}
+	if bookingAttraction.AdultTickets > 0 && !offered["adult"] {
+		errValidation.Errors["adult_tickets"] = "attraction does not sell adult tickets"
+	}
+	if bookingAttraction.ChildTickets > 0 && !offered["child"] {
+		errValidation.Errors["child_tickets"] = "attraction does not sell child tickets"
+	}
+
+	arrive, err := time.Parse(entity.ReservationLayout, bookingAttraction.WillArrive)
+	if err != nil {
+		errValidation.Errors["will_arrive"] = "must be a time in YYYY-MM-DD HH:MM format"
+	} else {
+		hours, err := entity.ParseOpeningHours(attraction.OpeningHours)
+		if err != nil {
+			return fmt.Errorf("failed to parse opening hours of attraction: %w", err)
+		}
+
+		length := entryWindowLength(attraction)
+		isWindowStart := false
+		for _, start := range hours.Slots(arrive, length) {
+			if start.Equal(arrive) {
+				isWindowStart = true
+				break
+			}
+		}
+		if !isWindowStart {
+			errValidation.Errors["will_arrive"] = "must be the start of an entry window"
+		}
+		bookingAttraction.WillLeave = arrive.Add(length).Format(entity.ReservationLayout)
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid tickets")
+		return errValidation
+	}
+
+	return nil
+}
+
+// validateStay checks that a stay has both dates and lasts at least one night
+func validateStay(willArrive, willLeave string) error {
+	errValidation := entity.NewErrValidation()
+
+	arrive, err := time.Parse("2006-01-02", willArrive)
+	if err != nil {
+		errValidation.Errors["will_arrive"] = "must be a date in YYYY-MM-DD format"
+	}
+	leave, err := time.Parse("2006-01-02", willLeave)
+	if err != nil {
+		errValidation.Errors["will_leave"] = "must be a date in YYYY-MM-DD format"
+	}
+	if len(errValidation.Errors) == 0 && !leave.After(arrive) {
+		errValidation.Errors["will_leave"] = "must be after will_arrive"
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid stay dates")
+		return errValidation
+	}
+
+	return nil
+}
+
+// STATUS
+func (s BookingService) Confirm(ctx context.Context, req *entity.StatusChange, user_id string) (*entity.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "Confirm")
 	span.SetAttributes(
-		attribute.Key("Id").String(bookingAttraction.Id.String()),
+		attribute.Key("BookingId").String(req.BookingId),
 	)
 	defer span.End()
 
-	s.beforeRequest(nil, nil, &bookingAttraction.UpdatedAt, nil)
-	return s.repo.UABUpdate(ctx, bookingAttraction)
+	req.To = entity.BookingConfirmed
+	return s.changeStatus(ctx, req, user_id)
 }
 
-// SOFT DELETE
-func (s BookingService) UHBDelete(ctx context.Context, id string) error {
-	ctx, span := otlp.Start(ctx, "Usecase", "UHBDelete")
+// Cancel cancels a pending or confirmed booking. Guests cancelling their own
+// booking are held to the cancellation policy of the establishment, while the
+// establishment side always cancels with a full refund. Pending bookings are
+// not paid yet and owe no refund. The place the booking held is offered to
+// the waitlist.
+func (s BookingService) Cancel(ctx context.Context, req *entity.StatusChange, user_id string) (*entity.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "Cancel")
 	span.SetAttributes(
-		attribute.Key("Id").String(id),
+		attribute.Key("BookingId").String(req.BookingId),
 	)
 	defer span.End()
 
-	var bookingHotel entity.GeneralBooking
-	bookingHotel.Id = uuid.MustParse(id)
-	s.beforeRequest(nil, nil, nil, &bookingHotel.DeletedAt)
-	return s.repo.UHBDelete(ctx, id)
+	if err := validateBookingType(req.BookingType); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.repo.Get(ctx, req.BookingType, req.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	if user_id != "" && booking.UserId != user_id {
+		return nil, entity.NewErrNotFound("booking")
+	}
+	// holds are given up with HoldRelease
+	if booking.Status == entity.BookingHeld || !entity.CanMoveBooking(booking.Status, entity.BookingCancelled) {
+		return nil, entity.NewErrInvalidTransition("booking", booking.Status, entity.BookingCancelled)
+	}
+
+	percent := 100.0
+	if user_id != "" {
+		rules, err := s.cancellationRules(ctx, req.BookingType, booking.HraId)
+		if err != nil {
+			return nil, err
+		}
+		arrive, err := arrivalTime(req.BookingType, booking.WillArrive)
+		if err != nil {
+			return nil, s.Error("failed to parse arrival of booking", err)
+		}
+		percent, err = entity.CancellationRefund(rules, arrive, entity.ReservationClock(time.Now(), s.zone))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req.From = booking.Status
+	req.To = entity.BookingCancelled
+	req.Currency = booking.Currency
+	if booking.Status != entity.BookingPending {
+		req.RefundPercent = percent
+		req.RefundAmount = entity.RefundAmount(booking.TotalPrice, percent)
+	}
+
+	change, err := s.moveStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cancellation stands even when nobody could be offered its place
+	if err := s.offerNext(ctx, booking); err != nil {
+		span.RecordError(err)
+	}
+
+	return change, nil
 }
 
-func (s BookingService) URBDelete(ctx context.Context, id string) error {
-	ctx, span := otlp.Start(ctx, "Usecase", "URBDelete")
+func (s BookingService) CheckIn(ctx context.Context, req *entity.StatusChange, user_id string) (*entity.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "CheckIn")
 	span.SetAttributes(
-		attribute.Key("Id").String(id),
+		attribute.Key("BookingId").String(req.BookingId),
 	)
 	defer span.End()
 
-	var bookingRestaurant entity.GeneralBooking
-	bookingRestaurant.Id = uuid.Must(uuid.Parse(id))
-	s.beforeRequest(nil, nil, nil, &bookingRestaurant.DeletedAt)
-	return s.repo.URBDelete(ctx, id)
+	req.To = entity.BookingCheckedIn
+	return s.changeStatus(ctx, req, user_id)
 }
 
-func (s BookingService) UABDelete(ctx context.Context, id string) error {
-	ctx, span := otlp.Start(ctx, "Usecase", "UABDelete")
+// StatusHistory returns the current status of a booking and all changes that led to it
+func (s BookingService) StatusHistory(ctx context.Context, bookingType, booking_id, user_id string) (string, []*entity.StatusChange, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "StatusHistory")
 	span.SetAttributes(
-		attribute.Key("Id").String(id),
+		attribute.Key("BookingId").String(booking_id),
 	)
 	defer span.End()
 
-	var bookingAttraction entity.GeneralBooking
-	bookingAttraction.Id = uuid.Must(uuid.Parse(id))
-	s.beforeRequest(nil, nil, nil, &bookingAttraction.DeletedAt)
-	return s.repo.UABDelete(ctx, id)
+	if err := validateBookingType(bookingType); err != nil {
+		return "", nil, err
+	}
+
+	status, owner, err := s.repo.GetStatus(ctx, bookingType, booking_id)
+	if err != nil {
+		return "", nil, err
+	}
+	if user_id != "" && owner != user_id {
+		return "", nil, entity.NewErrNotFound("booking")
+	}
+
+	changes, err := s.repo.StatusHistory(ctx, booking_id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return status, changes, nil
+}
+
+// changeStatus moves a booking to req.To when its current status allows it.
+// A non-empty user_id restricts the change to bookings made by that user.
+func (s BookingService) changeStatus(ctx context.Context, req *entity.StatusChange, user_id string) (*entity.StatusChange, error) {
+	if err := validateBookingType(req.BookingType); err != nil {
+		return nil, err
+	}
+
+	status, owner, err := s.repo.GetStatus(ctx, req.BookingType, req.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	if user_id != "" && owner != user_id {
+		return nil, entity.NewErrNotFound("booking")
+	}
+	// holds move on only through HoldConfirm, HoldRelease and their expiry
+	if status == entity.BookingHeld || !entity.CanMoveBooking(status, req.To) {
+		return nil, entity.NewErrInvalidTransition("booking", status, req.To)
+	}
+
+	req.From = status
+	return s.moveStatus(ctx, req)
+}
+
+// moveStatus records req, which already passed entity.CanMoveBooking
+func (s BookingService) moveStatus(ctx context.Context, req *entity.StatusChange) (*entity.StatusChange, error) {
+	s.beforeRequest(&req.Id, &req.CreatedAt, nil, nil)
+	if err := s.repo.UpdateStatus(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// establishmentOf returns the establishment booked with hra_id, rooms belong
+// to their hotel
+func (s BookingService) establishmentOf(ctx context.Context, bookingType, hra_id string) (string, error) {
+	if bookingType != entity.BookingHotel {
+		return hra_id, nil
+	}
+
+	room, err := s.serviceClients.EstablishmentService().GetRoom(ctx, &pbe.GetRoomRequest{
+		RoomId: hra_id,
+	})
+	if err != nil {
+		return "", s.Error("failed to get room", err)
+	}
+	return room.Room.HotelId, nil
+}
+
+// cancellationRules returns the cancellation policy of the establishment
+// booked with hra_id, rooms follow the policy of their hotel
+func (s BookingService) cancellationRules(ctx context.Context, bookingType, hra_id string) ([]*entity.CancellationRule, error) {
+	establishment_id, err := s.establishmentOf(ctx, bookingType, hra_id)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.serviceClients.EstablishmentService().GetCancellationPolicy(ctx, &pbe.GetCancellationPolicyRequest{
+		EstablishmentId: establishment_id,
+	})
+	if err != nil {
+		return nil, s.Error("failed to get cancellation policy", err)
+	}
+
+	var rules []*entity.CancellationRule
+	for _, rule := range policy.Rules {
+		rules = append(rules, &entity.CancellationRule{
+			HoursBefore:      rule.HoursBefore,
+			RefundPercent:    rule.RefundPercent,
+			ChangeFeePercent: rule.ChangeFeePercent,
+		})
+	}
+
+	return rules, nil
+}
+
+// arrivalTime parses will_arrive of a booking, hotel stays start at midnight
+func arrivalTime(bookingType, willArrive string) (time.Time, error) {
+	if bookingType == entity.BookingHotel {
+		return time.Parse("2006-01-02", willArrive)
+	}
+	return time.Parse("2006-01-02 15:04", willArrive)
+}
+
+func validateBookingType(bookingType string) error {
+	switch bookingType {
+	case entity.BookingHotel, entity.BookingRestaurant, entity.BookingAttraction:
+		return nil
+	}
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Errors["booking_type"] = "must be one of hotel, restaurant, attraction"
+	errValidation.Err = fmt.Errorf("unknown booking type %q", bookingType)
+	return errValidation
+}
+
+// validateListType accepts any booking type and an empty one, which stands for all of them
+func validateListType(bookingType string) error {
+	if bookingType == "" {
+		return nil
+	}
+	return validateBookingType(bookingType)
 }
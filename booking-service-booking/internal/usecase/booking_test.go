@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/infrastructure/repository"
+)
+
+// fakeBookings keeps one booking, the methods Update and Delete do not call
+// are left to the embedded nil interface
+type fakeBookings struct {
+	repository.Booking
+	booking *entity.GeneralBooking
+}
+
+func (r *fakeBookings) Get(ctx context.Context, bookingType, id string) (*entity.GeneralBooking, error) {
+	booking := *r.booking
+	return &booking, nil
+}
+
+func (r *fakeBookings) Update(ctx context.Context, booking *entity.GeneralBooking) (*entity.GeneralBooking, error) {
+	r.booking = booking
+	return booking, nil
+}
+
+func (r *fakeBookings) Delete(ctx context.Context, bookingType, id string) error {
+	r.booking.DeletedAt = time.Now()
+	return nil
+}
+
+func TestUpdateGuests(t *testing.T) {
+	stored := []entity.BookingGuest{
+		{FullName: "Ali Valiyev", Age: 34},
+		{FullName: "Laylo Valiyeva", Age: 31},
+	}
+
+	tests := []struct {
+		name   string
+		guests []entity.BookingGuest
+		want   []entity.BookingGuest
+	}{
+		{
+			name:   "guests left out",
+			guests: nil,
+			want:   stored,
+		},
+		{
+			name:   "guests cleared",
+			guests: []entity.BookingGuest{},
+			want:   []entity.BookingGuest{},
+		},
+		{
+			name:   "guests replaced",
+			guests: []entity.BookingGuest{{FullName: "Ali Valiyev", Age: 34}},
+			want:   []entity.BookingGuest{{FullName: "Ali Valiyev", Age: 34}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+			repo := &fakeBookings{booking: &entity.GeneralBooking{
+				Id:             id,
+				BookingType:    entity.BookingHotel,
+				NumberOfPeople: 2,
+				Status:         entity.BookingConfirmed,
+				Guests:         stored,
+				TotalPrice:     300,
+				Currency:       "USD",
+			}}
+			s := NewBookingService(time.Second, repo, nil, nil, nil, entity.Pricing{}, 0, 0, 0, time.UTC)
+
+			booking, err := s.Update(context.Background(), &entity.GeneralBooking{
+				Id:          id,
+				BookingType: entity.BookingHotel,
+				Reason:      "late arrival",
+				Guests:      tt.guests,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, booking.Guests)
+			assert.Equal(t, "late arrival", booking.Reason)
+			assert.Equal(t, 300.0, booking.TotalPrice)
+		})
+	}
+}
+
+func TestUpdateAddOns(t *testing.T) {
+	stored := []entity.BookingAddOn{
+		{AddOnId: "breakfast", Name: "Breakfast", Quantity: 2, UnitPrice: 15, Total: 30},
+	}
+
+	tests := []struct {
+		name      string
+		status    string
+		addOns    []entity.BookingAddOn
+		want      []entity.BookingAddOn
+		wantTotal float64
+		wantErr   bool
+	}{
+		{
+			name:      "add-ons left out of a confirmed stay",
+			status:    entity.BookingConfirmed,
+			addOns:    nil,
+			want:      stored,
+			wantTotal: 330,
+		},
+		{
+			name:      "add-ons left out of a pending stay",
+			status:    entity.BookingPending,
+			addOns:    nil,
+			want:      stored,
+			wantTotal: 330,
+		},
+		{
+			name:      "same add-ons of a confirmed stay",
+			status:    entity.BookingConfirmed,
+			addOns:    []entity.BookingAddOn{{AddOnId: "breakfast", Quantity: 2}},
+			want:      stored,
+			wantTotal: 330,
+		},
+		{
+			name:      "add-ons cleared from a pending stay",
+			status:    entity.BookingPending,
+			addOns:    []entity.BookingAddOn{},
+			want:      []entity.BookingAddOn{},
+			wantTotal: 300,
+		},
+		{
+			name:    "add-ons cleared from a confirmed stay",
+			status:  entity.BookingConfirmed,
+			addOns:  []entity.BookingAddOn{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+			repo := &fakeBookings{booking: &entity.GeneralBooking{
+				Id:             id,
+				BookingType:    entity.BookingHotel,
+				NumberOfPeople: 2,
+				Status:         tt.status,
+				AddOns:         stored,
+				TotalPrice:     330,
+				Currency:       "USD",
+			}}
+			s := NewBookingService(time.Second, repo, nil, nil, nil, entity.Pricing{}, 0, 0, 0, time.UTC)
+
+			booking, err := s.Update(context.Background(), &entity.GeneralBooking{
+				Id:          id,
+				BookingType: entity.BookingHotel,
+				AddOns:      tt.addOns,
+			})
+			if tt.wantErr {
+				var errValidation *entity.ErrValidation
+				assert.ErrorAs(t, err, &errValidation)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, booking.AddOns)
+			assert.Equal(t, tt.wantTotal, booking.TotalPrice)
+		})
+	}
+}
+
+func TestUpdateSpecialRequests(t *testing.T) {
+	tests := []struct {
+		name            string
+		specialRequests []string
+		note            string
+		want            []string
+		wantNote        string
+	}{
+		{
+			name:     "special requests left out",
+			want:     []string{"late_arrival", "crib"},
+			wantNote: "arriving after midnight",
+		},
+		{
+			name:            "special requests cleared",
+			specialRequests: []string{},
+			want:            []string{},
+		},
+		{
+			name:            "special requests replaced",
+			specialRequests: []string{"crib"},
+			note:            "one crib is enough",
+			want:            []string{"crib"},
+			wantNote:        "one crib is enough",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+			repo := &fakeBookings{booking: &entity.GeneralBooking{
+				Id:                 id,
+				BookingType:        entity.BookingHotel,
+				NumberOfPeople:     2,
+				Status:             entity.BookingConfirmed,
+				SpecialRequests:    []string{"late_arrival", "crib"},
+				SpecialRequestNote: "arriving after midnight",
+				TotalPrice:         300,
+				Currency:           "USD",
+			}}
+			s := NewBookingService(time.Second, repo, nil, nil, nil, entity.Pricing{}, 0, 0, 0, time.UTC)
+
+			booking, err := s.Update(context.Background(), &entity.GeneralBooking{
+				Id:                 id,
+				BookingType:        entity.BookingHotel,
+				Reason:             "late arrival",
+				SpecialRequests:    tt.specialRequests,
+				SpecialRequestNote: tt.note,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, booking.SpecialRequests)
+			assert.Equal(t, tt.wantNote, booking.SpecialRequestNote)
+			assert.Equal(t, "late arrival", booking.Reason)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		status  string
+		wantErr bool
+	}{
+		{status: entity.BookingPending, wantErr: true},
+		{status: entity.BookingConfirmed, wantErr: true},
+		{status: entity.BookingCheckedIn, wantErr: true},
+		{status: entity.BookingCompleted, wantErr: true},
+		{status: entity.BookingCancelled},
+		{status: entity.BookingNoShow},
+		{status: entity.BookingExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			id := uuid.New()
+			repo := &fakeBookings{booking: &entity.GeneralBooking{
+				Id:          id,
+				BookingType: entity.BookingHotel,
+				Status:      tt.status,
+			}}
+			s := NewBookingService(time.Second, repo, nil, nil, nil, entity.Pricing{}, 0, 0, 0, time.UTC)
+
+			err := s.Delete(context.Background(), entity.BookingHotel, id.String())
+			if tt.wantErr {
+				var errTransition *entity.ErrInvalidTransition
+				assert.ErrorAs(t, err, &errTransition)
+				assert.True(t, repo.booking.DeletedAt.IsZero())
+				return
+			}
+			assert.NoError(t, err)
+			assert.False(t, repo.booking.DeletedAt.IsZero())
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	pbe "Booking/booking-service-booking/genproto/establishment-proto"
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/infrastructure/grpc_service_clients"
+	"Booking/booking-service-booking/internal/infrastructure/repository"
+	"Booking/booking-service-booking/internal/pkg/ical"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type CalendarSync interface {
+	CalendarSourceCreate(ctx context.Context, source *entity.CalendarSource, content string) (*entity.CalendarSource, error)
+	CalendarSourceList(ctx context.Context, room_id string) ([]*entity.CalendarSource, error)
+	CalendarSourceSync(ctx context.Context, id, content string) (*entity.CalendarSource, error)
+	CalendarSourceDelete(ctx context.Context, id string) error
+	ExternalBlockList(ctx context.Context, room_id, from, to string) ([]*entity.ExternalBlock, error)
+	CalendarSyncAll(ctx context.Context) (int, error)
+}
+
+type CalendarSyncService struct {
+	BaseUseCase
+	repo           repository.CalendarSync
+	serviceClients grpc_service_clients.ServiceClients
+	client         *http.Client
+	ctxTimeout     time.Duration
+}
+
+func NewCalendarSyncService(ctxTimeout time.Duration, repo repository.CalendarSync, serviceClients grpc_service_clients.ServiceClients, client *http.Client) CalendarSyncService {
+	return CalendarSyncService{
+		ctxTimeout:     ctxTimeout,
+		repo:           repo,
+		serviceClients: serviceClients,
+		client:         client,
+	}
+}
+
+// CalendarSourceCreate adds a calendar of another platform to a hotel room.
+// An uploaded file is imported right away and must be a valid calendar. A
+// calendar with a url is fetched right away too, but a failed fetch only
+// shows up as the last error of the source and is tried again on the next
+// periodic sync.
+func (s CalendarSyncService) CalendarSourceCreate(ctx context.Context, source *entity.CalendarSource, content string) (*entity.CalendarSource, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "CalendarSourceCreate")
+	span.SetAttributes(
+		attribute.Key("RoomId").String(source.RoomId),
+	)
+	defer span.End()
+
+	errValidation := entity.NewErrValidation()
+	switch {
+	case source.Url == "" && content == "":
+		errValidation.Errors["url"] = "a calendar url or file is required"
+	case source.Url != "" && content != "":
+		errValidation.Errors["url"] = "a calendar is either synced from its url or uploaded, not both"
+	case source.Url != "":
+		if err := validateCalendarUrl(source.Url); err != nil {
+			errValidation.Errors["url"] = err.Error()
+		}
+		// calendar apps subscribe to webcal links, they are served over https
+		if strings.HasPrefix(source.Url, "webcal://") {
+			source.Url = "https://" + strings.TrimPrefix(source.Url, "webcal://")
+		}
+	}
+	var events []*ical.Event
+	if content != "" {
+		var err error
+		if events, err = ical.Parse(strings.NewReader(content)); err != nil {
+			errValidation.Errors["file"] = err.Error()
+		}
+	}
+	if len(errValidation.Errors) > 0 {
+		errValidation.Err = fmt.Errorf("invalid calendar source")
+		return nil, errValidation
+	}
+
+	if _, err := s.serviceClients.EstablishmentService().GetRoom(ctx, &pbe.GetRoomRequest{
+		RoomId: source.RoomId,
+	}); err != nil {
+		return nil, s.Error("failed to get room", err)
+	}
+
+	s.beforeRequest(&source.Id, &source.CreatedAt, &source.UpdatedAt, nil)
+	source, err := s.repo.CreateSource(ctx, source)
+	if err != nil {
+		return nil, s.Error("failed to create calendar source", err)
+	}
+
+	if content != "" {
+		err = s.replaceBlocks(ctx, source, events)
+	} else {
+		err = s.fetch(ctx, source)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
+func (s CalendarSyncService) CalendarSourceList(ctx context.Context, room_id string) ([]*entity.CalendarSource, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "CalendarSourceList")
+	span.SetAttributes(
+		attribute.Key("RoomId").String(room_id),
+	)
+	defer span.End()
+
+	return s.repo.ListSources(ctx, room_id)
+}
+
+// CalendarSourceSync syncs a calendar with a url now, an uploaded calendar is
+// replaced by content
+func (s CalendarSyncService) CalendarSourceSync(ctx context.Context, id, content string) (*entity.CalendarSource, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "CalendarSourceSync")
+	span.SetAttributes(
+		attribute.Key("Id").String(id),
+	)
+	defer span.End()
+
+	source, err := s.repo.GetSource(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.Url != "" {
+		if content != "" {
+			errValidation := entity.NewErrValidation()
+			errValidation.Errors["file"] = "the calendar is synced from its url"
+			errValidation.Err = fmt.Errorf("calendar source has a url")
+			return nil, errValidation
+		}
+		if err = s.fetch(ctx, source); err != nil {
+			return nil, err
+		}
+		return source, nil
+	}
+
+	events, err := ical.Parse(strings.NewReader(content))
+	if err != nil {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["file"] = err.Error()
+		errValidation.Err = err
+		return nil, errValidation
+	}
+	if err = s.replaceBlocks(ctx, source, events); err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
+func (s CalendarSyncService) CalendarSourceDelete(ctx context.Context, id string) error {
+	ctx, span := otlp.Start(ctx, "Usecase", "CalendarSourceDelete")
+	span.SetAttributes(
+		attribute.Key("Id").String(id),
+	)
+	defer span.End()
+
+	return s.repo.DeleteSource(ctx, id)
+}
+
+// ExternalBlockList lists the blocks of a room taking any night between from
+// and to (YYYY-MM-DD), an empty date leaves that side open
+func (s CalendarSyncService) ExternalBlockList(ctx context.Context, room_id, from, to string) ([]*entity.ExternalBlock, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "ExternalBlockList")
+	span.SetAttributes(
+		attribute.Key("RoomId").String(room_id),
+	)
+	defer span.End()
+
+	errValidation := entity.NewErrValidation()
+	fromDay, err := parseBlockDay(from)
+	if err != nil {
+		errValidation.Errors["from"] = "must be a date as YYYY-MM-DD"
+	}
+	toDay, err := parseBlockDay(to)
+	if err != nil {
+		errValidation.Errors["to"] = "must be a date as YYYY-MM-DD"
+	}
+	if len(errValidation.Errors) > 0 {
+		errValidation.Err = fmt.Errorf("invalid block dates")
+		return nil, errValidation
+	}
+
+	return s.repo.ListBlocks(ctx, room_id, fromDay, toDay)
+}
+
+// CalendarSyncAll fetches every calendar with a url again and returns how many
+// of them synced. A calendar that fails keeps its blocks and records the error.
+func (s CalendarSyncService) CalendarSyncAll(ctx context.Context) (int, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "CalendarSyncAll")
+	defer span.End()
+
+	sources, err := s.repo.ListSources(ctx, "")
+	if err != nil {
+		return 0, s.Error("failed to list calendar sources", err)
+	}
+
+	synced := 0
+	var errs []error
+	for _, source := range sources {
+		if source.Url == "" {
+			continue
+		}
+		if err = s.fetch(ctx, source); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if source.LastError != "" {
+			errs = append(errs, fmt.Errorf("calendar source %s: %s", source.Id, source.LastError))
+			continue
+		}
+		synced++
+	}
+
+	return synced, errors.Join(errs...)
+}
+
+// fetch syncs a calendar from its url, a calendar that can not be fetched or
+// read keeps its blocks and records why
+func (s CalendarSyncService) fetch(ctx context.Context, source *entity.CalendarSource) error {
+	events, err := ical.Fetch(ctx, s.client, source.Url)
+	if err != nil {
+		if err = s.repo.SetSyncError(ctx, source, err.Error(), time.Now().UTC()); err != nil {
+			return s.Error("failed to record calendar sync error", err)
+		}
+		return nil
+	}
+
+	return s.replaceBlocks(ctx, source, events)
+}
+
+// replaceBlocks turns the events still ahead into the blocks of the source,
+// cancelled events and events marked free do not block the room
+func (s CalendarSyncService) replaceBlocks(ctx context.Context, source *entity.CalendarSource, events []*ical.Event) error {
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	blocks := make([]*entity.ExternalBlock, 0, len(events))
+	for _, event := range events {
+		if event.Status == ical.StatusCancelled || event.Transparent {
+			continue
+		}
+		startsOn, endsOn := entity.BlockNights(event.Start, event.End)
+		if !endsOn.After(today) {
+			continue
+		}
+		blocks = append(blocks, &entity.ExternalBlock{
+			Id:       uuid.NewString(),
+			SourceId: source.Id,
+			RoomId:   source.RoomId,
+			Uid:      event.UID,
+			Summary:  event.Summary,
+			StartsOn: startsOn,
+			EndsOn:   endsOn,
+		})
+	}
+
+	if err := s.repo.ReplaceBlocks(ctx, source, blocks, now); err != nil {
+		return s.Error("failed to replace external blocks", err)
+	}
+	return nil
+}
+
+func validateCalendarUrl(rawUrl string) error {
+	parsed, err := url.Parse(rawUrl)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https" && parsed.Scheme != "webcal") {
+		return fmt.Errorf("must be an http, https or webcal url")
+	}
+	return nil
+}
+
+// parseBlockDay reads a YYYY-MM-DD date, an empty one is the zero time
+func parseBlockDay(day string) (time.Time, error) {
+	if day == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse("2006-01-02", day)
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	pbe "Booking/booking-service-booking/genproto/establishment-proto"
+	"Booking/booking-service-booking/internal/entity"
+	"context"
+	"fmt"
+	"time"
+)
+
+// checkClosures rejects a booking whose dates fall in the closure calendar of
+// the establishment, hotels must be open every night of the stay and
+// restaurants and attractions on the day of the visit
+func (s BookingService) checkClosures(ctx context.Context, booking *entity.GeneralBooking) error {
+	var from, until time.Time
+	if booking.BookingType == entity.BookingHotel {
+		from, _ = time.Parse("2006-01-02", booking.WillArrive)
+		until, _ = time.Parse("2006-01-02", booking.WillLeave)
+	} else {
+		from, _ = time.Parse(entity.ReservationLayout, booking.WillArrive)
+		until = from.AddDate(0, 0, 1)
+	}
+
+	establishment_id, err := s.establishmentOf(ctx, booking.BookingType, booking.HraId)
+	if err != nil {
+		return err
+	}
+
+	calendar, err := s.serviceClients.EstablishmentService().ListClosures(ctx, &pbe.ListClosuresRequest{
+		EstablishmentId: establishment_id,
+	})
+	if err != nil {
+		return s.Error("failed to list closures", err)
+	}
+	if len(calendar.Closures) == 0 {
+		return nil
+	}
+
+	closures := make([]entity.Closure, 0, len(calendar.Closures))
+	for _, item := range calendar.Closures {
+		closure, err := entity.ParseClosure(item.StartDate, item.EndDate, item.Weekdays, item.Reason)
+		if err != nil {
+			return fmt.Errorf("failed to parse closure %s: %w", item.ClosureId, err)
+		}
+		closures = append(closures, closure)
+	}
+
+	day, closure, closed := entity.FirstClosedDay(closures, from, until)
+	if !closed {
+		return nil
+	}
+
+	message := "the establishment is closed on " + day.Format("2006-01-02")
+	if closure.Reason != "" {
+		message += " (" + closure.Reason + ")"
+	}
+	errValidation := entity.NewErrValidation()
+	errValidation.Errors["will_arrive"] = message
+	errValidation.Err = fmt.Errorf("establishment is closed")
+	return errValidation
+}
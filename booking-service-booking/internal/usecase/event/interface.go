@@ -22,5 +22,6 @@ type BrokerProducer interface {
 	ProduceHotelContent(ctx context.Context, key string, value *entity.GeneralBooking) error
 	ProduceRestaurantContent(ctx context.Context, key string, value *entity.GeneralBooking) error
 	ProduceAttractionContent(ctx context.Context, key string, value *entity.GeneralBooking) error
+	ProduceBookingReminder(ctx context.Context, key string, value *entity.GeneralBooking) error
 	Close()
 }
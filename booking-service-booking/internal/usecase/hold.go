@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Hold reserves what a booking asks for while the guest checks out. The hold
+// counts against the establishment like a booking, but it is released on its
+// own once holdTTL passes without HoldConfirm.
+func (s BookingService) Hold(ctx context.Context, booking *entity.GeneralBooking) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "Hold")
+	span.SetAttributes(
+		attribute.Key("Id").String(booking.Id.String()),
+		attribute.Key("BookingType").String(booking.BookingType),
+	)
+	defer span.End()
+
+	if err := s.priceAddOns(ctx, booking.BookingType, booking.HraId, booking.AddOns); err != nil {
+		return nil, err
+	}
+	capacity, err := s.checkBooking(ctx, booking)
+	if err != nil {
+		return nil, err
+	}
+
+	booking.Status = entity.BookingHeld
+	s.beforeRequest(nil, &booking.CreatedAt, nil, nil)
+	booking.HoldExpiresAt = booking.CreatedAt.Add(s.holdTTL)
+	return s.repo.Create(ctx, booking, capacity)
+}
+
+// HoldConfirm turns a hold into a booking, which starts in the status a new
+// booking of its type starts in, or waits for the owner when the
+// establishment takes bookings on request. A hold that ran out is expired on
+// the way and can not be confirmed any more.
+func (s BookingService) HoldConfirm(ctx context.Context, id, user_id string) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "HoldConfirm")
+	span.SetAttributes(
+		attribute.Key("Id").String(id),
+	)
+	defer span.End()
+
+	booking, err := s.heldBooking(ctx, id, user_id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	to, approvalDueAt := entity.BookingExpired, time.Time{}
+	if now.Before(booking.HoldExpiresAt) {
+		if to, approvalDueAt, err = s.bookedStatus(ctx, booking, now); err != nil {
+			return nil, err
+		}
+	}
+	change, err := s.moveStatus(ctx, &entity.StatusChange{
+		BookingId:     booking.Id.String(),
+		BookingType:   booking.BookingType,
+		From:          entity.BookingHeld,
+		To:            to,
+		ChangedBy:     booking.UserId,
+		ApprovalDueAt: approvalDueAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if to == entity.BookingExpired {
+		if err := s.offerNext(ctx, booking); err != nil {
+			span.RecordError(err)
+		}
+		return nil, entity.NewErrInvalidTransition("booking", entity.BookingExpired, entity.BookedStatus(booking.BookingType))
+	}
+
+	booking.Status = change.To
+	booking.UpdatedAt = change.CreatedAt
+	booking.ApprovalDueAt = approvalDueAt
+	return booking, nil
+}
+
+// HoldRelease gives up a hold before it runs out, nothing was paid for it
+func (s BookingService) HoldRelease(ctx context.Context, id, user_id string) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "HoldRelease")
+	span.SetAttributes(
+		attribute.Key("Id").String(id),
+	)
+	defer span.End()
+
+	booking, err := s.heldBooking(ctx, id, user_id)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := s.moveStatus(ctx, &entity.StatusChange{
+		BookingId:   booking.Id.String(),
+		BookingType: booking.BookingType,
+		From:        entity.BookingHeld,
+		To:          entity.BookingCancelled,
+		Reason:      "hold released",
+		ChangedBy:   booking.UserId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.offerNext(ctx, booking); err != nil {
+		span.RecordError(err)
+	}
+
+	booking.Status = change.To
+	booking.UpdatedAt = change.CreatedAt
+	return booking, nil
+}
+
+// ExpireHolds releases the holds that ran out and offers their place to the
+// waitlist. It returns how many holds expired.
+func (s BookingService) ExpireHolds(ctx context.Context) (int, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "ExpireHolds")
+	defer span.End()
+
+	expired, err := s.repo.ExpireHolds(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	for _, booking := range expired {
+		if err := s.offerNext(ctx, booking); err != nil {
+			return len(expired), err
+		}
+	}
+
+	return len(expired), nil
+}
+
+// heldBooking returns a booking that is still held, a non-empty user_id
+// restricts it to holds of that user
+func (s BookingService) heldBooking(ctx context.Context, id, user_id string) (*entity.GeneralBooking, error) {
+	booking, err := s.repo.Get(ctx, "", id)
+	if err != nil {
+		return nil, err
+	}
+	if user_id != "" && booking.UserId != user_id {
+		return nil, entity.NewErrNotFound("hold")
+	}
+	if booking.Status != entity.BookingHeld {
+		return nil, entity.NewErrInvalidTransition("booking", booking.Status, entity.BookedStatus(booking.BookingType))
+	}
+
+	return booking, nil
+}
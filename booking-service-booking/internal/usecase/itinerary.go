@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ItineraryCreate books all parts of a trip as a whole. The bookings are made
+// one after another, and when one of them fails the ones already made are
+// cancelled again, so the guest is never left with half a trip.
+func (s BookingService) ItineraryCreate(ctx context.Context, itinerary *entity.Itinerary) (*entity.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "ItineraryCreate")
+	span.SetAttributes(
+		attribute.Key("UserId").String(itinerary.UserId),
+		attribute.Key("Bookings").Int(len(itinerary.Bookings)),
+	)
+	defer span.End()
+
+	if len(itinerary.Bookings) == 0 {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["bookings"] = "must hold at least one booking"
+		errValidation.Err = fmt.Errorf("itinerary without bookings")
+		return nil, errValidation
+	}
+
+	itinerary.Status = entity.ItineraryPending
+	s.beforeRequest(&itinerary.Id, &itinerary.CreatedAt, &itinerary.UpdatedAt, nil)
+	if _, err := s.itineraries.Create(ctx, itinerary); err != nil {
+		return nil, err
+	}
+
+	booked := make([]*entity.GeneralBooking, 0, len(itinerary.Bookings))
+	for i, booking := range itinerary.Bookings {
+		booking.Id = uuid.New()
+		booking.UserId = itinerary.UserId
+		booking.ItineraryId = itinerary.Id
+
+		created, err := s.Create(ctx, booking)
+		if err != nil {
+			err = s.Error(fmt.Sprintf("booking %d of the itinerary", i+1), err)
+			if compensateErr := s.compensateItinerary(ctx, itinerary, booked); compensateErr != nil {
+				// bookings left behind are an internal failure, whatever failed first
+				return nil, fmt.Errorf("%v: %v", compensateErr, err)
+			}
+			return nil, err
+		}
+		booked = append(booked, created)
+	}
+
+	if err := s.itineraries.UpdateStatus(ctx, itinerary, entity.ItineraryPending, entity.ItineraryConfirmed); err != nil {
+		return nil, err
+	}
+	itinerary.Bookings = booked
+
+	return itinerary, nil
+}
+
+// compensateItinerary cancels the bookings an itinerary made before one of
+// its bookings failed, the latest first, and fails the itinerary
+func (s BookingService) compensateItinerary(ctx context.Context, itinerary *entity.Itinerary, booked []*entity.GeneralBooking) error {
+	var failed []string
+	for i := len(booked) - 1; i >= 0; i-- {
+		booking := booked[i]
+		req := &entity.StatusChange{
+			BookingId:   booking.Id.String(),
+			BookingType: booking.BookingType,
+			From:        booking.Status,
+			To:          entity.BookingCancelled,
+			Reason:      "itinerary could not be booked as a whole",
+			ChangedBy:   itinerary.UserId,
+			Currency:    booking.Currency,
+		}
+		if booking.Status != entity.BookingPending {
+			req.RefundPercent = 100
+			req.RefundAmount = booking.TotalPrice
+		}
+		if _, err := s.moveStatus(ctx, req); err != nil {
+			failed = append(failed, booking.Id.String())
+		}
+	}
+
+	if err := s.itineraries.UpdateStatus(ctx, itinerary, entity.ItineraryPending, entity.ItineraryFailed); err != nil {
+		return s.Error("failed to fail itinerary "+itinerary.Id, err)
+	}
+	if len(failed) != 0 {
+		return fmt.Errorf("failed to cancel bookings %v of itinerary %s", failed, itinerary.Id)
+	}
+
+	return nil
+}
+
+// ItineraryGet returns an itinerary with its bookings, a non-empty user_id
+// restricts it to itineraries of that user
+func (s BookingService) ItineraryGet(ctx context.Context, id, user_id string) (*entity.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "ItineraryGet")
+	span.SetAttributes(
+		attribute.Key("Id").String(id),
+	)
+	defer span.End()
+
+	itinerary, err := s.itineraries.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user_id != "" && itinerary.UserId != user_id {
+		return nil, entity.NewErrNotFound("itinerary")
+	}
+
+	itinerary.Bookings, err = s.repo.ListByItinerary(ctx, itinerary.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return itinerary, nil
+}
+
+// ItineraryList lists the itineraries of a user with their bookings
+func (s BookingService) ItineraryList(ctx context.Context, user_id string) ([]*entity.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "ItineraryList")
+	span.SetAttributes(
+		attribute.Key("UserId").String(user_id),
+	)
+	defer span.End()
+
+	itineraries, err := s.itineraries.ListByUser(ctx, user_id)
+	if err != nil {
+		return nil, err
+	}
+	for _, itinerary := range itineraries {
+		itinerary.Bookings, err = s.repo.ListByItinerary(ctx, itinerary.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return itineraries, nil
+}
+
+// ItineraryCancel cancels every booking of a trip that can still be
+// cancelled, each one under the cancellation policy of its establishment.
+// Bookings cancelled before are left alone, so a cancellation that failed
+// halfway can be retried.
+func (s BookingService) ItineraryCancel(ctx context.Context, id, reason, changed_by, user_id string) (*entity.Itinerary, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "ItineraryCancel")
+	span.SetAttributes(
+		attribute.Key("Id").String(id),
+	)
+	defer span.End()
+
+	itinerary, err := s.ItineraryGet(ctx, id, user_id)
+	if err != nil {
+		return nil, err
+	}
+	if !entity.CanMoveItinerary(itinerary.Status, entity.ItineraryCancelled) {
+		return nil, entity.NewErrInvalidTransition("itinerary", itinerary.Status, entity.ItineraryCancelled)
+	}
+
+	for _, booking := range itinerary.Bookings {
+		if !entity.CanMoveBooking(booking.Status, entity.BookingCancelled) {
+			continue
+		}
+		_, err := s.Cancel(ctx, &entity.StatusChange{
+			BookingId:   booking.Id.String(),
+			BookingType: booking.BookingType,
+			Reason:      reason,
+			ChangedBy:   changed_by,
+		}, user_id)
+		var errTransition *entity.ErrInvalidTransition
+		if errors.As(err, &errTransition) {
+			// cancelled or checked in meanwhile
+			continue
+		}
+		if err != nil {
+			return nil, s.Error(fmt.Sprintf("failed to cancel booking %s of the itinerary", booking.Id), err)
+		}
+	}
+
+	if err := s.itineraries.UpdateStatus(ctx, itinerary, entity.ItineraryConfirmed, entity.ItineraryCancelled); err != nil {
+		return nil, err
+	}
+
+	return s.ItineraryGet(ctx, id, user_id)
+}
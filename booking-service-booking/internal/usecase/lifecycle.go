@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/infrastructure/repository"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"Booking/booking-service-booking/internal/usecase/event"
+	"context"
+	"time"
+)
+
+// Lifecycle moves bookings on as time passes, its methods are run by the
+// scheduler of the service
+type Lifecycle interface {
+	MarkNoShows(ctx context.Context) (int, error)
+	CompleteBookings(ctx context.Context) (int, error)
+	SendReminders(ctx context.Context) (int, error)
+}
+
+type LifecycleService struct {
+	BaseUseCase
+	repo           repository.Booking
+	producer       event.BrokerProducer
+	ctxTimeout     time.Duration
+	noShowAfter    map[string]time.Duration
+	reminderBefore time.Duration
+	zone           *time.Location
+}
+
+// NewLifecycleService builds the lifecycle usecase, noShowAfter is the grace
+// of each booking type and zone the time zone reservation times are in
+func NewLifecycleService(ctxTimeout time.Duration, repo repository.Booking, producer event.BrokerProducer, noShowAfter map[string]time.Duration, reminderBefore time.Duration, zone *time.Location) LifecycleService {
+	return LifecycleService{
+		ctxTimeout:     ctxTimeout,
+		repo:           repo,
+		producer:       producer,
+		noShowAfter:    noShowAfter,
+		reminderBefore: reminderBefore,
+		zone:           zone,
+	}
+}
+
+// MarkNoShows turns confirmed bookings whose guests were not checked in
+// within the grace of their type after the start into no-shows
+func (s LifecycleService) MarkNoShows(ctx context.Context) (int, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "MarkNoShows")
+	defer span.End()
+
+	now := time.Now().UTC()
+	clock := entity.ReservationClock(now, s.zone)
+
+	marked := 0
+	for _, bookingType := range []string{entity.BookingHotel, entity.BookingRestaurant, entity.BookingAttraction} {
+		bookings, err := s.repo.MarkNoShows(ctx, bookingType, clock.Add(-s.noShowAfter[bookingType]), now)
+		if err != nil {
+			return marked, s.Error("failed to mark no-shows", err)
+		}
+		marked += len(bookings)
+	}
+
+	return marked, nil
+}
+
+// CompleteBookings completes the checked in bookings that ended
+func (s LifecycleService) CompleteBookings(ctx context.Context) (int, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "CompleteBookings")
+	defer span.End()
+
+	now := time.Now().UTC()
+	bookings, err := s.repo.CompleteBookings(ctx, entity.ReservationClock(now, s.zone), now)
+	if err != nil {
+		return 0, s.Error("failed to complete bookings", err)
+	}
+
+	return len(bookings), nil
+}
+
+// SendReminders emits a reminder event for every confirmed booking starting
+// within reminderBefore, once per booking. A booking whose event could not be
+// written is tried again on the next run.
+func (s LifecycleService) SendReminders(ctx context.Context) (int, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "SendReminders")
+	defer span.End()
+
+	now := time.Now().UTC()
+	clock := entity.ReservationClock(now, s.zone)
+	bookings, err := s.repo.ListDueReminders(ctx, clock, clock.Add(s.reminderBefore))
+	if err != nil {
+		return 0, s.Error("failed to list due reminders", err)
+	}
+
+	var (
+		reminded []string
+		errSend  error
+	)
+	for _, booking := range bookings {
+		if errSend = s.producer.ProduceBookingReminder(ctx, booking.Id.String(), booking); errSend != nil {
+			errSend = s.Error("failed to send booking reminder", errSend)
+			break
+		}
+		reminded = append(reminded, booking.Id.String())
+	}
+
+	if err = s.repo.MarkReminded(ctx, reminded, now); err != nil {
+		return 0, s.Error("failed to mark bookings reminded", err)
+	}
+
+	return len(reminded), errSend
+}
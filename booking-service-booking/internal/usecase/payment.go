@@ -0,0 +1,309 @@
+package usecase
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/infrastructure/repository"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"Booking/booking-service-booking/internal/usecase/payment"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type Payment interface {
+	PaymentCreate(ctx context.Context, booking_id, user_id, cardToken string) (*entity.Payment, error)
+	PaymentGet(ctx context.Context, booking_id string) (*entity.Payment, error)
+	PaymentRefund(ctx context.Context, booking_id string) (*entity.Payment, error)
+	PaymentWebhook(ctx context.Context, event *entity.PaymentEvent) (*entity.Payment, error)
+}
+
+type PaymentService struct {
+	BaseUseCase
+	repo        repository.Payment
+	bookingRepo repository.Booking
+	provider    payment.Provider
+	ctxTimeout  time.Duration
+}
+
+func NewPaymentService(ctxTimeout time.Duration, repo repository.Payment, bookingRepo repository.Booking, provider payment.Provider) PaymentService {
+	return PaymentService{
+		ctxTimeout:  ctxTimeout,
+		repo:        repo,
+		bookingRepo: bookingRepo,
+		provider:    provider,
+	}
+}
+
+// PaymentCreate charges the total price of a hotel booking. The payment is
+// claimed by moving it to processing before the charge, so concurrent requests
+// can not charge it twice. A declined charge moves the payment back to pending
+// so that it can be retried with another card, the retry charges the price the
+// booking has by then.
+func (s PaymentService) PaymentCreate(ctx context.Context, booking_id, user_id, cardToken string) (*entity.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "PaymentCreate")
+	span.SetAttributes(
+		attribute.Key("BookingId").String(booking_id),
+	)
+	defer span.End()
+
+	booking, err := s.bookingRepo.Get(ctx, entity.BookingHotel, booking_id)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserId != user_id {
+		return nil, entity.NewErrNotFound("booking")
+	}
+	if !entity.BookingEditable(booking.Status) {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["booking_id"] = fmt.Sprintf("%s booking can not be paid", booking.Status)
+		errValidation.Err = fmt.Errorf("booking is %s", booking.Status)
+		return nil, errValidation
+	}
+	if booking.AwaitingApproval() {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["booking_id"] = "booking waits for the establishment to accept it"
+		errValidation.Err = fmt.Errorf("booking is not accepted yet")
+		return nil, errValidation
+	}
+	if booking.TotalPrice <= 0 {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["booking_id"] = "booking has nothing to pay"
+		errValidation.Err = fmt.Errorf("booking has no price")
+		return nil, errValidation
+	}
+
+	pay, err := s.repo.GetByBookingId(ctx, booking_id)
+	if errors.Is(err, entity.ErrorNotFound) {
+		pay = &entity.Payment{
+			BookingId: booking_id,
+			UserId:    booking.UserId,
+			Amount:    booking.TotalPrice,
+			Currency:  booking.Currency,
+			Status:    entity.PaymentPending,
+			Provider:  s.provider.Name(),
+		}
+		s.beforeRequest(&pay.Id, &pay.CreatedAt, &pay.UpdatedAt, nil)
+		pay, err = s.repo.Create(ctx, pay)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pay.Status != entity.PaymentPending {
+		return nil, entity.NewErrInvalidTransition("payment", pay.Status, entity.PaymentPaid)
+	}
+
+	// the booking may have been rescheduled or got other add-ons since the
+	// payment was created
+	pay.Amount = booking.TotalPrice
+	pay.Currency = booking.Currency
+
+	// only the request that moves the payment out of pending charges it
+	if err := s.repo.UpdateStatus(ctx, pay, entity.PaymentPending, entity.PaymentProcessing); err != nil {
+		return nil, err
+	}
+
+	providerRef, chargeErr := s.provider.Charge(ctx, pay, cardToken)
+	if err := s.addAttempt(ctx, pay, entity.PaymentCharge, chargeErr); err != nil {
+		return nil, err
+	}
+	if chargeErr != nil {
+		if err := s.repo.UpdateStatus(ctx, pay, entity.PaymentProcessing, entity.PaymentPending); err != nil {
+			return nil, err
+		}
+		return nil, s.Error("failed to charge payment", chargeErr)
+	}
+
+	pay.ProviderRef = providerRef
+	if err := s.repo.UpdateStatus(ctx, pay, entity.PaymentProcessing, entity.PaymentPaid); err != nil {
+		return nil, err
+	}
+	if err := s.confirmBooking(ctx, pay); err != nil {
+		return nil, err
+	}
+
+	return pay, nil
+}
+
+func (s PaymentService) PaymentGet(ctx context.Context, booking_id string) (*entity.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "PaymentGet")
+	span.SetAttributes(
+		attribute.Key("BookingId").String(booking_id),
+	)
+	defer span.End()
+
+	return s.repo.GetByBookingId(ctx, booking_id)
+}
+
+// PaymentRefund gives back what the cancellation policy of the establishment
+// granted when the booking of a paid payment was cancelled
+func (s PaymentService) PaymentRefund(ctx context.Context, booking_id string) (*entity.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "PaymentRefund")
+	span.SetAttributes(
+		attribute.Key("BookingId").String(booking_id),
+	)
+	defer span.End()
+
+	pay, err := s.repo.GetByBookingId(ctx, booking_id)
+	if err != nil {
+		return nil, err
+	}
+	if !entity.CanMovePayment(pay.Status, entity.PaymentRefunded) {
+		return nil, entity.NewErrInvalidTransition("payment", pay.Status, entity.PaymentRefunded)
+	}
+
+	amount, err := s.cancellationRefund(ctx, pay)
+	if err != nil {
+		return nil, err
+	}
+
+	refundErr := s.provider.Refund(ctx, pay, amount)
+	if err := s.addAttempt(ctx, pay, entity.PaymentRefund, refundErr); err != nil {
+		return nil, err
+	}
+	if refundErr != nil {
+		return nil, s.Error("failed to refund payment", refundErr)
+	}
+
+	pay.RefundedAmount = amount
+	if err := s.repo.UpdateStatus(ctx, pay, entity.PaymentPaid, entity.PaymentRefunded); err != nil {
+		return nil, err
+	}
+
+	return pay, nil
+}
+
+// PaymentWebhook applies an event reported by the payment provider. Events
+// that were already applied, e.g. because the provider retried the delivery,
+// leave the payment as it is, events the payment can not move by are rejected.
+func (s PaymentService) PaymentWebhook(ctx context.Context, event *entity.PaymentEvent) (*entity.Payment, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "PaymentWebhook")
+	span.SetAttributes(
+		attribute.Key("Event").String(event.Event),
+		attribute.Key("ProviderRef").String(event.ProviderRef),
+	)
+	defer span.End()
+
+	var next string
+	switch event.Event {
+	case entity.EventPaymentSucceeded:
+		next = entity.PaymentPaid
+	case entity.EventPaymentRefunded:
+		next = entity.PaymentRefunded
+	case entity.EventPaymentFailed:
+	default:
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["event"] = "unknown payment event"
+		errValidation.Err = fmt.Errorf("unknown payment event %q", event.Event)
+		return nil, errValidation
+	}
+
+	pay, err := s.repo.GetByProviderRef(ctx, event.ProviderRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventErr error
+	if next == "" {
+		eventErr = fmt.Errorf("provider reported %s", event.Event)
+	}
+	if err := s.addAttempt(ctx, pay, entity.PaymentWebhook, eventErr); err != nil {
+		return nil, err
+	}
+
+	if next == "" || pay.Status == next {
+		return pay, nil
+	}
+	// a replayed or late event must not move the payment backwards, e.g. a
+	// refunded payment back to paid
+	if !entity.CanMovePayment(pay.Status, next) {
+		return nil, entity.NewErrInvalidTransition("payment", pay.Status, next)
+	}
+	if err := s.repo.UpdateStatus(ctx, pay, pay.Status, next); err != nil {
+		return nil, err
+	}
+	if next == entity.PaymentPaid {
+		if err := s.confirmBooking(ctx, pay); err != nil {
+			return nil, err
+		}
+	}
+
+	return pay, nil
+}
+
+// cancellationRefund returns the refund recorded when the booking of pay was
+// cancelled, never more than was charged
+func (s PaymentService) cancellationRefund(ctx context.Context, pay *entity.Payment) (float64, error) {
+	history, err := s.bookingRepo.StatusHistory(ctx, pay.BookingId)
+	if err != nil {
+		return 0, err
+	}
+
+	var cancellation *entity.StatusChange
+	for _, change := range history {
+		if change.To == entity.BookingCancelled {
+			cancellation = change
+		}
+	}
+	if cancellation == nil {
+		return 0, entity.NewErrInvalidTransition("payment of a booking that is not cancelled", pay.Status, entity.PaymentRefunded)
+	}
+	if cancellation.RefundAmount <= 0 {
+		return 0, entity.NewErrInvalidTransition("payment of a booking cancelled without refund", pay.Status, entity.PaymentRefunded)
+	}
+
+	return math.Min(cancellation.RefundAmount, pay.Amount), nil
+}
+
+// confirmBooking confirms the pending hotel booking of a paid payment. A
+// booking that was already moved on, e.g. cancelled meanwhile, is left as it is.
+func (s PaymentService) confirmBooking(ctx context.Context, pay *entity.Payment) error {
+	status, _, err := s.bookingRepo.GetStatus(ctx, entity.BookingHotel, pay.BookingId)
+	if err != nil {
+		return err
+	}
+	if status != entity.BookingPending {
+		return nil
+	}
+
+	change := &entity.StatusChange{
+		BookingId:   pay.BookingId,
+		BookingType: entity.BookingHotel,
+		From:        entity.BookingPending,
+		To:          entity.BookingConfirmed,
+		Reason:      "payment received",
+		ChangedBy:   pay.UserId,
+	}
+	s.beforeRequest(&change.Id, &change.CreatedAt, nil, nil)
+
+	err = s.bookingRepo.UpdateStatus(ctx, change)
+	var errTransition *entity.ErrInvalidTransition
+	if errors.As(err, &errTransition) {
+		return nil
+	}
+	return err
+}
+
+// addAttempt records the outcome of an action on the payment
+func (s PaymentService) addAttempt(ctx context.Context, pay *entity.Payment, action string, actionErr error) error {
+	attempt := &entity.PaymentAttempt{
+		PaymentId: pay.Id,
+		Action:    action,
+		Status:    entity.AttemptSucceeded,
+	}
+	if actionErr != nil {
+		attempt.Status = entity.AttemptFailed
+		attempt.Error = actionErr.Error()
+	}
+	s.beforeRequest(&attempt.Id, &attempt.CreatedAt, nil, nil)
+
+	if err := s.repo.AddAttempt(ctx, attempt); err != nil {
+		return err
+	}
+	pay.Attempts = append(pay.Attempts, attempt)
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package payment
+
+import (
+	"context"
+	"Booking/booking-service-booking/internal/entity"
+)
+
+// Provider charges and refunds payments through a payment provider. A charge
+// that the provider refuses returns *entity.ErrPaymentDeclined. Refund gives
+// amount of the charge back, which may be less than the charged amount.
+type Provider interface {
+	Name() string
+	Charge(ctx context.Context, payment *entity.Payment, cardToken string) (providerRef string, err error)
+	Refund(ctx context.Context, payment *entity.Payment, amount float64) error
+}
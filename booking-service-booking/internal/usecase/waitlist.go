@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"Booking/booking-service-booking/internal/entity"
+	"Booking/booking-service-booking/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// WaitlistJoin queues a guest for a room or a restaurant slot. The entry is
+// validated like a booking, whether the establishment is full or not.
+func (s BookingService) WaitlistJoin(ctx context.Context, entry *entity.WaitlistEntry) (*entity.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "WaitlistJoin")
+	span.SetAttributes(
+		attribute.Key("HraId").String(entry.HraId),
+		attribute.Key("BookingType").String(entry.BookingType),
+	)
+	defer span.End()
+
+	if err := validateWaitlistType(entry.BookingType); err != nil {
+		return nil, err
+	}
+
+	booking := waitlistBooking(entry)
+	if _, err := s.checkBooking(ctx, booking); err != nil {
+		return nil, err
+	}
+	// restaurant slots end when the restaurant says so
+	entry.WillLeave = booking.WillLeave
+
+	entry.Status = entity.WaitlistWaiting
+	s.beforeRequest(&entry.Id, &entry.CreatedAt, &entry.UpdatedAt, nil)
+	return s.waitlist.Create(ctx, entry)
+}
+
+// WaitlistList lists the waitlist entries of a user
+func (s BookingService) WaitlistList(ctx context.Context, user_id string) ([]*entity.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "WaitlistList")
+	span.SetAttributes(
+		attribute.Key("UserId").String(user_id),
+	)
+	defer span.End()
+
+	return s.waitlist.ListByUser(ctx, user_id)
+}
+
+// WaitlistLeave takes a guest off the waitlist, an open offer passes on to
+// the next guest
+func (s BookingService) WaitlistLeave(ctx context.Context, id, user_id string) (*entity.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "WaitlistLeave")
+	span.SetAttributes(
+		attribute.Key("Id").String(id),
+	)
+	defer span.End()
+
+	entry, err := s.waitlistEntry(ctx, id, user_id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.closeOffer(ctx, entry, entity.WaitlistLeft)
+}
+
+// WaitlistDecline turns an offer down and passes it on to the next guest
+func (s BookingService) WaitlistDecline(ctx context.Context, id, user_id string) (*entity.WaitlistEntry, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "WaitlistDecline")
+	span.SetAttributes(
+		attribute.Key("Id").String(id),
+	)
+	defer span.End()
+
+	entry, err := s.waitlistEntry(ctx, id, user_id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.closeOffer(ctx, entry, entity.WaitlistDeclined)
+}
+
+// WaitlistAccept confirms the booking held for an open offer. Accepting an
+// offer that ran out expires it and passes it on.
+func (s BookingService) WaitlistAccept(ctx context.Context, id, user_id string) (*entity.GeneralBooking, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "WaitlistAccept")
+	span.SetAttributes(
+		attribute.Key("Id").String(id),
+	)
+	defer span.End()
+
+	entry, err := s.waitlistEntry(ctx, id, user_id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Status == entity.WaitlistOffered && !entry.OfferOpen(time.Now().UTC()) {
+		if _, err := s.closeOffer(ctx, entry, entity.WaitlistExpired); err != nil {
+			return nil, err
+		}
+	}
+	if !entity.CanMoveWaitlist(entry.Status, entity.WaitlistAccepted) {
+		return nil, entity.NewErrInvalidTransition("waitlist entry", entry.Status, entity.WaitlistAccepted)
+	}
+
+	booking, err := s.HoldConfirm(ctx, entry.BookingId, entry.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.waitlist.UpdateStatus(ctx, entry, entity.WaitlistOffered, entity.WaitlistAccepted); err != nil {
+		return nil, s.Error(fmt.Sprintf("booking %s was made but the waitlist entry was not updated", booking.Id), err)
+	}
+
+	return booking, nil
+}
+
+// WaitlistExpireOffers expires the offers that ran out, gives up the places
+// they held and passes each of them on to the next guest. It returns how many
+// offers expired.
+func (s BookingService) WaitlistExpireOffers(ctx context.Context) (int, error) {
+	ctx, span := otlp.Start(ctx, "Usecase", "WaitlistExpireOffers")
+	defer span.End()
+
+	expired, err := s.waitlist.ExpireOffers(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	for _, entry := range expired {
+		if err := s.releaseOffer(ctx, entry); err != nil {
+			return len(expired), err
+		}
+		if err := s.offerNext(ctx, waitlistBooking(entry)); err != nil {
+			return len(expired), err
+		}
+	}
+
+	return len(expired), nil
+}
+
+// waitlistEntry returns an entry of the waitlist, a non-empty user_id
+// restricts it to entries of that user
+func (s BookingService) waitlistEntry(ctx context.Context, id, user_id string) (*entity.WaitlistEntry, error) {
+	entry, err := s.waitlist.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user_id != "" && entry.UserId != user_id {
+		return nil, entity.NewErrNotFound("waitlist entry")
+	}
+
+	return entry, nil
+}
+
+// closeOffer moves entry to status to, the place held for an offer it had is
+// given up and offered to the next guest
+func (s BookingService) closeOffer(ctx context.Context, entry *entity.WaitlistEntry, to string) (*entity.WaitlistEntry, error) {
+	from := entry.Status
+	if err := s.waitlist.UpdateStatus(ctx, entry, from, to); err != nil {
+		return nil, err
+	}
+	if from == entity.WaitlistOffered {
+		if err := s.releaseOffer(ctx, entry); err != nil {
+			return nil, err
+		}
+		if err := s.offerNext(ctx, waitlistBooking(entry)); err != nil {
+			return nil, err
+		}
+	}
+
+	return entry, nil
+}
+
+// offerNext offers the place booking gave up to the guest who waited longest
+// for it and whose party fits what is free, if anybody did
+func (s BookingService) offerNext(ctx context.Context, booking *entity.GeneralBooking) error {
+	if validateWaitlistType(booking.BookingType) != nil {
+		return nil
+	}
+
+	waiting, err := s.waitlist.ListWaiting(ctx, booking.BookingType, booking.HraId, booking.WillArrive, booking.WillLeave)
+	if err != nil {
+		return err
+	}
+	for _, entry := range waiting {
+		offered, err := s.offer(ctx, entry)
+		if err != nil || offered {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// offer holds the booking entry waits for until the offer runs out, so that
+// nobody else can take the place meanwhile. It returns false when the booking
+// does not fit any more, e.g. because the party is larger than what is free.
+func (s BookingService) offer(ctx context.Context, entry *entity.WaitlistEntry) (bool, error) {
+	booking := waitlistBooking(entry)
+	booking.Id = uuid.New()
+
+	var (
+		errValidation  *entity.ErrValidation
+		errOverbooking *entity.ErrOverbooking
+		errTransition  *entity.ErrInvalidTransition
+	)
+	capacity, err := s.checkBooking(ctx, booking)
+	if errors.As(err, &errValidation) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	booking.Status = entity.BookingHeld
+	s.beforeRequest(nil, &booking.CreatedAt, nil, nil)
+	booking.HoldExpiresAt = booking.CreatedAt.Add(s.offerTTL)
+	_, err = s.repo.Create(ctx, booking, capacity)
+	if errors.As(err, &errOverbooking) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	entry.BookingId = booking.Id.String()
+	entry.OfferExpiresAt = booking.HoldExpiresAt
+	err = s.waitlist.UpdateStatus(ctx, entry, entity.WaitlistWaiting, entity.WaitlistOffered)
+	if err == nil {
+		return true, nil
+	}
+	// the entry left the waitlist or got another offer meanwhile
+	if releaseErr := s.releaseOffer(ctx, entry); releaseErr != nil {
+		return false, releaseErr
+	}
+	if errors.As(err, &errTransition) {
+		return false, nil
+	}
+	return false, err
+}
+
+// releaseOffer gives up the place held for the offer of entry. A hold that
+// ran out or was confirmed meanwhile is left as it is.
+func (s BookingService) releaseOffer(ctx context.Context, entry *entity.WaitlistEntry) error {
+	if entry.BookingId == "" {
+		return nil
+	}
+
+	_, err := s.moveStatus(ctx, &entity.StatusChange{
+		BookingId:   entry.BookingId,
+		BookingType: entry.BookingType,
+		From:        entity.BookingHeld,
+		To:          entity.BookingCancelled,
+		Reason:      "waitlist offer " + entry.Status,
+		ChangedBy:   entry.UserId,
+	})
+	var errTransition *entity.ErrInvalidTransition
+	if errors.As(err, &errTransition) {
+		return nil
+	}
+
+	return err
+}
+
+// waitlistBooking is the booking a waitlist entry asks for
+func waitlistBooking(entry *entity.WaitlistEntry) *entity.GeneralBooking {
+	return &entity.GeneralBooking{
+		BookingType:    entry.BookingType,
+		UserId:         entry.UserId,
+		HraId:          entry.HraId,
+		WillArrive:     entry.WillArrive,
+		WillLeave:      entry.WillLeave,
+		NumberOfPeople: entry.NumberOfPeople,
+		Reason:         entry.Reason,
+	}
+}
+
+func validateWaitlistType(bookingType string) error {
+	for _, waitlistType := range entity.WaitlistTypes {
+		if bookingType == waitlistType {
+			return nil
+		}
+	}
+
+	errValidation := entity.NewErrValidation()
+	errValidation.Errors["booking_type"] = "must be one of hotel, restaurant"
+	errValidation.Err = fmt.Errorf("booking type %q has no waitlist", bookingType)
+	return errValidation
+}
@@ -260,22 +260,27 @@ func (m *Location) GetDeletedAt() string {
 
 // ATTRACTION
 type Attraction struct {
-	AttractionId         string    `protobuf:"bytes,1,opt,name=attraction_id,json=attractionId,proto3" json:"attraction_id"`
-	OwnerId              string    `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id"`
-	AttractionName       string    `protobuf:"bytes,3,opt,name=attraction_name,json=attractionName,proto3" json:"attraction_name"`
-	Description          string    `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
-	Rating               float32   `protobuf:"fixed32,5,opt,name=rating,proto3" json:"rating"`
-	ContactNumber        string    `protobuf:"bytes,6,opt,name=contact_number,json=contactNumber,proto3" json:"contact_number"`
-	LicenceUrl           string    `protobuf:"bytes,7,opt,name=licence_url,json=licenceUrl,proto3" json:"licence_url"`
-	WebsiteUrl           string    `protobuf:"bytes,8,opt,name=website_url,json=websiteUrl,proto3" json:"website_url"`
-	Images               []*Image  `protobuf:"bytes,9,rep,name=images,proto3" json:"images"`
-	Location             *Location `protobuf:"bytes,10,opt,name=location,proto3" json:"location"`
-	CreatedAt            string    `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string    `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string    `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	AttractionId         string        `protobuf:"bytes,1,opt,name=attraction_id,json=attractionId,proto3" json:"attraction_id"`
+	OwnerId              string        `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id"`
+	AttractionName       string        `protobuf:"bytes,3,opt,name=attraction_name,json=attractionName,proto3" json:"attraction_name"`
+	Description          string        `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	Rating               float32       `protobuf:"fixed32,5,opt,name=rating,proto3" json:"rating"`
+	ContactNumber        string        `protobuf:"bytes,6,opt,name=contact_number,json=contactNumber,proto3" json:"contact_number"`
+	LicenceUrl           string        `protobuf:"bytes,7,opt,name=licence_url,json=licenceUrl,proto3" json:"licence_url"`
+	WebsiteUrl           string        `protobuf:"bytes,8,opt,name=website_url,json=websiteUrl,proto3" json:"website_url"`
+	Images               []*Image      `protobuf:"bytes,9,rep,name=images,proto3" json:"images"`
+	Location             *Location     `protobuf:"bytes,10,opt,name=location,proto3" json:"location"`
+	CreatedAt            string        `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string        `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string        `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	OpeningHours         string        `protobuf:"bytes,14,opt,name=opening_hours,json=openingHours,proto3" json:"opening_hours"`
+	EntryWindowMinutes   int64         `protobuf:"varint,15,opt,name=entry_window_minutes,json=entryWindowMinutes,proto3" json:"entry_window_minutes"`
+	DailyQuota           int64         `protobuf:"varint,16,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota"`
+	WindowQuota          int64         `protobuf:"varint,17,opt,name=window_quota,json=windowQuota,proto3" json:"window_quota"`
+	TicketTypes          []*TicketType `protobuf:"bytes,18,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Attraction) Reset()         { *m = Attraction{} }
@@ -402,6 +407,41 @@ func (m *Attraction) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Attraction) GetOpeningHours() string {
+	if m != nil {
+		return m.OpeningHours
+	}
+	return ""
+}
+
+func (m *Attraction) GetEntryWindowMinutes() int64 {
+	if m != nil {
+		return m.EntryWindowMinutes
+	}
+	return 0
+}
+
+func (m *Attraction) GetDailyQuota() int64 {
+	if m != nil {
+		return m.DailyQuota
+	}
+	return 0
+}
+
+func (m *Attraction) GetWindowQuota() int64 {
+	if m != nil {
+		return m.WindowQuota
+	}
+	return 0
+}
+
+func (m *Attraction) GetTicketTypes() []*TicketType {
+	if m != nil {
+		return m.TicketTypes
+	}
+	return nil
+}
+
 type GetAttractionRequest struct {
 	AttractionId         string   `protobuf:"bytes,1,opt,name=attraction_id,json=attractionId,proto3" json:"attraction_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1045,6 +1085,8 @@ type Restaurant struct {
 	CreatedAt            string    `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string    `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string    `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	SeatCapacity         int64     `protobuf:"varint,15,opt,name=seat_capacity,json=seatCapacity,proto3" json:"seat_capacity"`
+	SlotMinutes          int64     `protobuf:"varint,16,opt,name=slot_minutes,json=slotMinutes,proto3" json:"slot_minutes"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -1181,6 +1223,20 @@ func (m *Restaurant) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Restaurant) GetSeatCapacity() int64 {
+	if m != nil {
+		return m.SeatCapacity
+	}
+	return 0
+}
+
+func (m *Restaurant) GetSlotMinutes() int64 {
+	if m != nil {
+		return m.SlotMinutes
+	}
+	return 0
+}
+
 type GetRestaurantRequest struct {
 	RestaurantId         string   `protobuf:"bytes,1,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1823,6 +1879,7 @@ type Hotel struct {
 	CreatedAt            string    `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string    `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string    `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Rooms                []*Room   `protobuf:"bytes,14,rep,name=rooms,proto3" json:"rooms"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -1952,6 +2009,13 @@ func (m *Hotel) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Hotel) GetRooms() []*Room {
+	if m != nil {
+		return m.Rooms
+	}
+	return nil
+}
+
 type GetHotelRequest struct {
 	HotelId              string   `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2580,30 +2644,36 @@ func (m *FindHotelsByNameResponse) GetCount() uint64 {
 	return 0
 }
 
-type Favourite struct {
-	FavouriteId          string   `protobuf:"bytes,1,opt,name=favourite_id,json=favouriteId,proto3" json:"favourite_id"`
-	EstablishmentId      string   `protobuf:"bytes,2,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
-	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	CreatedAt            string   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+// ROOM
+type Room struct {
+	RoomId               string   `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id"`
+	HotelId              string   `protobuf:"bytes,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id"`
+	Price                float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	NumberOfRooms        int64    `protobuf:"varint,5,opt,name=number_of_rooms,json=numberOfRooms,proto3" json:"number_of_rooms"`
+	Holidays             string   `protobuf:"bytes,6,opt,name=holidays,proto3" json:"holidays"`
+	Discount             float64  `protobuf:"fixed64,8,opt,name=discount,proto3" json:"discount"`
+	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string   `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Capacity             int64    `protobuf:"varint,12,opt,name=capacity,proto3" json:"capacity"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Favourite) Reset()         { *m = Favourite{} }
-func (m *Favourite) String() string { return proto.CompactTextString(m) }
-func (*Favourite) ProtoMessage()    {}
-func (*Favourite) Descriptor() ([]byte, []int) {
+func (m *Room) Reset()         { *m = Room{} }
+func (m *Room) String() string { return proto.CompactTextString(m) }
+func (*Room) ProtoMessage()    {}
+func (*Room) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f4f0074a4a4eb033, []int{41}
 }
-func (m *Favourite) XXX_Unmarshal(b []byte) error {
+func (m *Room) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Favourite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Room) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Favourite.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Room.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2613,79 +2683,114 @@ func (m *Favourite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Favourite) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Favourite.Merge(m, src)
+func (m *Room) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Room.Merge(m, src)
 }
-func (m *Favourite) XXX_Size() int {
+func (m *Room) XXX_Size() int {
 	return m.Size()
 }
-func (m *Favourite) XXX_DiscardUnknown() {
-	xxx_messageInfo_Favourite.DiscardUnknown(m)
+func (m *Room) XXX_DiscardUnknown() {
+	xxx_messageInfo_Room.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Favourite proto.InternalMessageInfo
+var xxx_messageInfo_Room proto.InternalMessageInfo
 
-func (m *Favourite) GetFavouriteId() string {
+func (m *Room) GetRoomId() string {
 	if m != nil {
-		return m.FavouriteId
+		return m.RoomId
 	}
 	return ""
 }
 
-func (m *Favourite) GetEstablishmentId() string {
+func (m *Room) GetHotelId() string {
 	if m != nil {
-		return m.EstablishmentId
+		return m.HotelId
 	}
 	return ""
 }
 
-func (m *Favourite) GetUserId() string {
+func (m *Room) GetPrice() float64 {
 	if m != nil {
-		return m.UserId
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Room) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
 	return ""
 }
 
-func (m *Favourite) GetCreatedAt() string {
+func (m *Room) GetNumberOfRooms() int64 {
+	if m != nil {
+		return m.NumberOfRooms
+	}
+	return 0
+}
+
+func (m *Room) GetHolidays() string {
+	if m != nil {
+		return m.Holidays
+	}
+	return ""
+}
+
+func (m *Room) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+func (m *Room) GetCreatedAt() string {
 	if m != nil {
 		return m.CreatedAt
 	}
 	return ""
 }
 
-func (m *Favourite) GetUpdatedAt() string {
+func (m *Room) GetUpdatedAt() string {
 	if m != nil {
 		return m.UpdatedAt
 	}
 	return ""
 }
 
-func (m *Favourite) GetDeletedAt() string {
+func (m *Room) GetDeletedAt() string {
 	if m != nil {
 		return m.DeletedAt
 	}
 	return ""
 }
 
-type AddToFavouritesRequest struct {
-	Favourite            *Favourite `protobuf:"bytes,1,opt,name=favourite,proto3" json:"favourite"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *Room) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
 
-func (m *AddToFavouritesRequest) Reset()         { *m = AddToFavouritesRequest{} }
-func (m *AddToFavouritesRequest) String() string { return proto.CompactTextString(m) }
-func (*AddToFavouritesRequest) ProtoMessage()    {}
-func (*AddToFavouritesRequest) Descriptor() ([]byte, []int) {
+type GetRoomRequest struct {
+	RoomId               string   `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRoomRequest) Reset()         { *m = GetRoomRequest{} }
+func (m *GetRoomRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRoomRequest) ProtoMessage()    {}
+func (*GetRoomRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f4f0074a4a4eb033, []int{42}
 }
-func (m *AddToFavouritesRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetRoomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddToFavouritesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRoomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddToFavouritesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRoomRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2695,44 +2800,44 @@ func (m *AddToFavouritesRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *AddToFavouritesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddToFavouritesRequest.Merge(m, src)
+func (m *GetRoomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoomRequest.Merge(m, src)
 }
-func (m *AddToFavouritesRequest) XXX_Size() int {
+func (m *GetRoomRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddToFavouritesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddToFavouritesRequest.DiscardUnknown(m)
+func (m *GetRoomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoomRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddToFavouritesRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetRoomRequest proto.InternalMessageInfo
 
-func (m *AddToFavouritesRequest) GetFavourite() *Favourite {
+func (m *GetRoomRequest) GetRoomId() string {
 	if m != nil {
-		return m.Favourite
+		return m.RoomId
 	}
-	return nil
+	return ""
 }
 
-type AddToFavouritesResponse struct {
-	Favourite            *Favourite `protobuf:"bytes,1,opt,name=favourite,proto3" json:"favourite"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetRoomResponse struct {
+	Room                 *Room    `protobuf:"bytes,1,opt,name=room,proto3" json:"room"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddToFavouritesResponse) Reset()         { *m = AddToFavouritesResponse{} }
-func (m *AddToFavouritesResponse) String() string { return proto.CompactTextString(m) }
-func (*AddToFavouritesResponse) ProtoMessage()    {}
-func (*AddToFavouritesResponse) Descriptor() ([]byte, []int) {
+func (m *GetRoomResponse) Reset()         { *m = GetRoomResponse{} }
+func (m *GetRoomResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRoomResponse) ProtoMessage()    {}
+func (*GetRoomResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f4f0074a4a4eb033, []int{43}
 }
-func (m *AddToFavouritesResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetRoomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddToFavouritesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRoomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddToFavouritesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRoomResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2742,44 +2847,44 @@ func (m *AddToFavouritesResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *AddToFavouritesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddToFavouritesResponse.Merge(m, src)
+func (m *GetRoomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoomResponse.Merge(m, src)
 }
-func (m *AddToFavouritesResponse) XXX_Size() int {
+func (m *GetRoomResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddToFavouritesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddToFavouritesResponse.DiscardUnknown(m)
+func (m *GetRoomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoomResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddToFavouritesResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetRoomResponse proto.InternalMessageInfo
 
-func (m *AddToFavouritesResponse) GetFavourite() *Favourite {
+func (m *GetRoomResponse) GetRoom() *Room {
 	if m != nil {
-		return m.Favourite
+		return m.Room
 	}
 	return nil
 }
 
-type RemoveFromFavouritesRequest struct {
-	FavouriteId          string   `protobuf:"bytes,1,opt,name=favourite_id,json=favouriteId,proto3" json:"favourite_id"`
+type ListRoomsByHotelRequest struct {
+	HotelId              string   `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveFromFavouritesRequest) Reset()         { *m = RemoveFromFavouritesRequest{} }
-func (m *RemoveFromFavouritesRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveFromFavouritesRequest) ProtoMessage()    {}
-func (*RemoveFromFavouritesRequest) Descriptor() ([]byte, []int) {
+func (m *ListRoomsByHotelRequest) Reset()         { *m = ListRoomsByHotelRequest{} }
+func (m *ListRoomsByHotelRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRoomsByHotelRequest) ProtoMessage()    {}
+func (*ListRoomsByHotelRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f4f0074a4a4eb033, []int{44}
 }
-func (m *RemoveFromFavouritesRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListRoomsByHotelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveFromFavouritesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListRoomsByHotelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveFromFavouritesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListRoomsByHotelRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2789,44 +2894,45 @@ func (m *RemoveFromFavouritesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RemoveFromFavouritesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveFromFavouritesRequest.Merge(m, src)
+func (m *ListRoomsByHotelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoomsByHotelRequest.Merge(m, src)
 }
-func (m *RemoveFromFavouritesRequest) XXX_Size() int {
+func (m *ListRoomsByHotelRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveFromFavouritesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveFromFavouritesRequest.DiscardUnknown(m)
+func (m *ListRoomsByHotelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoomsByHotelRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveFromFavouritesRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListRoomsByHotelRequest proto.InternalMessageInfo
 
-func (m *RemoveFromFavouritesRequest) GetFavouriteId() string {
+func (m *ListRoomsByHotelRequest) GetHotelId() string {
 	if m != nil {
-		return m.FavouriteId
+		return m.HotelId
 	}
 	return ""
 }
 
-type RemoveFromFavouritesResponse struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+type ListRoomsByHotelResponse struct {
+	Rooms                []*Room  `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveFromFavouritesResponse) Reset()         { *m = RemoveFromFavouritesResponse{} }
-func (m *RemoveFromFavouritesResponse) String() string { return proto.CompactTextString(m) }
-func (*RemoveFromFavouritesResponse) ProtoMessage()    {}
-func (*RemoveFromFavouritesResponse) Descriptor() ([]byte, []int) {
+func (m *ListRoomsByHotelResponse) Reset()         { *m = ListRoomsByHotelResponse{} }
+func (m *ListRoomsByHotelResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRoomsByHotelResponse) ProtoMessage()    {}
+func (*ListRoomsByHotelResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f4f0074a4a4eb033, []int{45}
 }
-func (m *RemoveFromFavouritesResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListRoomsByHotelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RemoveFromFavouritesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListRoomsByHotelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RemoveFromFavouritesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListRoomsByHotelResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2836,44 +2942,51 @@ func (m *RemoveFromFavouritesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *RemoveFromFavouritesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveFromFavouritesResponse.Merge(m, src)
+func (m *ListRoomsByHotelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoomsByHotelResponse.Merge(m, src)
 }
-func (m *RemoveFromFavouritesResponse) XXX_Size() int {
+func (m *ListRoomsByHotelResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RemoveFromFavouritesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveFromFavouritesResponse.DiscardUnknown(m)
+func (m *ListRoomsByHotelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoomsByHotelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveFromFavouritesResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListRoomsByHotelResponse proto.InternalMessageInfo
 
-func (m *RemoveFromFavouritesResponse) GetSuccess() bool {
+func (m *ListRoomsByHotelResponse) GetRooms() []*Room {
 	if m != nil {
-		return m.Success
+		return m.Rooms
 	}
-	return false
+	return nil
 }
 
-type ListFavouritesByUserIdRequest struct {
-	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+func (m *ListRoomsByHotelResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type UpdateRoomRequest struct {
+	Room                 *Room    `protobuf:"bytes,1,opt,name=room,proto3" json:"room"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListFavouritesByUserIdRequest) Reset()         { *m = ListFavouritesByUserIdRequest{} }
-func (m *ListFavouritesByUserIdRequest) String() string { return proto.CompactTextString(m) }
-func (*ListFavouritesByUserIdRequest) ProtoMessage()    {}
-func (*ListFavouritesByUserIdRequest) Descriptor() ([]byte, []int) {
+func (m *UpdateRoomRequest) Reset()         { *m = UpdateRoomRequest{} }
+func (m *UpdateRoomRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoomRequest) ProtoMessage()    {}
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f4f0074a4a4eb033, []int{46}
 }
-func (m *ListFavouritesByUserIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *UpdateRoomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListFavouritesByUserIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateRoomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListFavouritesByUserIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateRoomRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2883,44 +2996,44 @@ func (m *ListFavouritesByUserIdRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ListFavouritesByUserIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListFavouritesByUserIdRequest.Merge(m, src)
+func (m *UpdateRoomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoomRequest.Merge(m, src)
 }
-func (m *ListFavouritesByUserIdRequest) XXX_Size() int {
+func (m *UpdateRoomRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListFavouritesByUserIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListFavouritesByUserIdRequest.DiscardUnknown(m)
+func (m *UpdateRoomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoomRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListFavouritesByUserIdRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateRoomRequest proto.InternalMessageInfo
 
-func (m *ListFavouritesByUserIdRequest) GetUserId() string {
+func (m *UpdateRoomRequest) GetRoom() *Room {
 	if m != nil {
-		return m.UserId
+		return m.Room
 	}
-	return ""
+	return nil
 }
 
-type ListFavouritesByUserIdResponse struct {
-	Favourites           []*Favourite `protobuf:"bytes,1,rep,name=favourites,proto3" json:"favourites"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type UpdateRoomResponse struct {
+	Room                 *Room    `protobuf:"bytes,1,opt,name=room,proto3" json:"room"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListFavouritesByUserIdResponse) Reset()         { *m = ListFavouritesByUserIdResponse{} }
-func (m *ListFavouritesByUserIdResponse) String() string { return proto.CompactTextString(m) }
-func (*ListFavouritesByUserIdResponse) ProtoMessage()    {}
-func (*ListFavouritesByUserIdResponse) Descriptor() ([]byte, []int) {
+func (m *UpdateRoomResponse) Reset()         { *m = UpdateRoomResponse{} }
+func (m *UpdateRoomResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoomResponse) ProtoMessage()    {}
+func (*UpdateRoomResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f4f0074a4a4eb033, []int{47}
 }
-func (m *ListFavouritesByUserIdResponse) XXX_Unmarshal(b []byte) error {
+func (m *UpdateRoomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListFavouritesByUserIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateRoomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListFavouritesByUserIdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateRoomResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2930,51 +3043,44 @@ func (m *ListFavouritesByUserIdResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *ListFavouritesByUserIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListFavouritesByUserIdResponse.Merge(m, src)
+func (m *UpdateRoomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoomResponse.Merge(m, src)
 }
-func (m *ListFavouritesByUserIdResponse) XXX_Size() int {
+func (m *UpdateRoomResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListFavouritesByUserIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListFavouritesByUserIdResponse.DiscardUnknown(m)
+func (m *UpdateRoomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoomResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListFavouritesByUserIdResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateRoomResponse proto.InternalMessageInfo
 
-func (m *ListFavouritesByUserIdResponse) GetFavourites() []*Favourite {
+func (m *UpdateRoomResponse) GetRoom() *Room {
 	if m != nil {
-		return m.Favourites
+		return m.Room
 	}
 	return nil
 }
 
-type Review struct {
-	ReviewId             string   `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id"`
-	EstablishmentId      string   `protobuf:"bytes,2,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
-	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
-	Rating               float32  `protobuf:"fixed32,4,opt,name=rating,proto3" json:"rating"`
-	Comment              string   `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment"`
-	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+type DeleteRoomRequest struct {
+	RoomId               string   `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Review) Reset()         { *m = Review{} }
-func (m *Review) String() string { return proto.CompactTextString(m) }
-func (*Review) ProtoMessage()    {}
-func (*Review) Descriptor() ([]byte, []int) {
+func (m *DeleteRoomRequest) Reset()         { *m = DeleteRoomRequest{} }
+func (m *DeleteRoomRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRoomRequest) ProtoMessage()    {}
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f4f0074a4a4eb033, []int{48}
 }
-func (m *Review) XXX_Unmarshal(b []byte) error {
+func (m *DeleteRoomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Review) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteRoomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Review.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteRoomRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2984,93 +3090,187 @@ func (m *Review) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Review) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Review.Merge(m, src)
+func (m *DeleteRoomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRoomRequest.Merge(m, src)
 }
-func (m *Review) XXX_Size() int {
+func (m *DeleteRoomRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Review) XXX_DiscardUnknown() {
-	xxx_messageInfo_Review.DiscardUnknown(m)
+func (m *DeleteRoomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRoomRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Review proto.InternalMessageInfo
+var xxx_messageInfo_DeleteRoomRequest proto.InternalMessageInfo
 
-func (m *Review) GetReviewId() string {
+func (m *DeleteRoomRequest) GetRoomId() string {
 	if m != nil {
-		return m.ReviewId
+		return m.RoomId
 	}
 	return ""
 }
 
-func (m *Review) GetEstablishmentId() string {
+type DeleteRoomResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRoomResponse) Reset()         { *m = DeleteRoomResponse{} }
+func (m *DeleteRoomResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRoomResponse) ProtoMessage()    {}
+func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{49}
+}
+func (m *DeleteRoomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRoomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRoomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteRoomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRoomResponse.Merge(m, src)
+}
+func (m *DeleteRoomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRoomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRoomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRoomResponse proto.InternalMessageInfo
+
+func (m *DeleteRoomResponse) GetSuccess() bool {
 	if m != nil {
-		return m.EstablishmentId
+		return m.Success
 	}
-	return ""
+	return false
 }
 
-func (m *Review) GetUserId() string {
+// TICKET TYPE
+type TicketType struct {
+	TicketTypeId         string   `protobuf:"bytes,1,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id"`
+	AttractionId         string   `protobuf:"bytes,2,opt,name=attraction_id,json=attractionId,proto3" json:"attraction_id"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
+	CreatedAt            string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string   `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketType) Reset()         { *m = TicketType{} }
+func (m *TicketType) String() string { return proto.CompactTextString(m) }
+func (*TicketType) ProtoMessage()    {}
+func (*TicketType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{50}
+}
+func (m *TicketType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TicketType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TicketType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TicketType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketType.Merge(m, src)
+}
+func (m *TicketType) XXX_Size() int {
+	return m.Size()
+}
+func (m *TicketType) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketType proto.InternalMessageInfo
+
+func (m *TicketType) GetTicketTypeId() string {
 	if m != nil {
-		return m.UserId
+		return m.TicketTypeId
 	}
 	return ""
 }
 
-func (m *Review) GetRating() float32 {
+func (m *TicketType) GetAttractionId() string {
 	if m != nil {
-		return m.Rating
+		return m.AttractionId
 	}
-	return 0
+	return ""
 }
 
-func (m *Review) GetComment() string {
+func (m *TicketType) GetName() string {
 	if m != nil {
-		return m.Comment
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Review) GetCreatedAt() string {
+func (m *TicketType) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *TicketType) GetCreatedAt() string {
 	if m != nil {
 		return m.CreatedAt
 	}
 	return ""
 }
 
-func (m *Review) GetUpdatedAt() string {
+func (m *TicketType) GetUpdatedAt() string {
 	if m != nil {
 		return m.UpdatedAt
 	}
 	return ""
 }
 
-func (m *Review) GetDeletedAt() string {
+func (m *TicketType) GetDeletedAt() string {
 	if m != nil {
 		return m.DeletedAt
 	}
 	return ""
 }
 
-type CreateReviewRequest struct {
-	Review               *Review  `protobuf:"bytes,1,opt,name=review,proto3" json:"review"`
+type ListTicketTypesByAttractionRequest struct {
+	AttractionId         string   `protobuf:"bytes,1,opt,name=attraction_id,json=attractionId,proto3" json:"attraction_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateReviewRequest) Reset()         { *m = CreateReviewRequest{} }
-func (m *CreateReviewRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateReviewRequest) ProtoMessage()    {}
-func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4f0074a4a4eb033, []int{49}
+func (m *ListTicketTypesByAttractionRequest) Reset()         { *m = ListTicketTypesByAttractionRequest{} }
+func (m *ListTicketTypesByAttractionRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTicketTypesByAttractionRequest) ProtoMessage()    {}
+func (*ListTicketTypesByAttractionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{51}
 }
-func (m *CreateReviewRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListTicketTypesByAttractionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListTicketTypesByAttractionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateReviewRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListTicketTypesByAttractionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3080,44 +3280,45 @@ func (m *CreateReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CreateReviewRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateReviewRequest.Merge(m, src)
+func (m *ListTicketTypesByAttractionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTicketTypesByAttractionRequest.Merge(m, src)
 }
-func (m *CreateReviewRequest) XXX_Size() int {
+func (m *ListTicketTypesByAttractionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateReviewRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateReviewRequest.DiscardUnknown(m)
+func (m *ListTicketTypesByAttractionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTicketTypesByAttractionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateReviewRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListTicketTypesByAttractionRequest proto.InternalMessageInfo
 
-func (m *CreateReviewRequest) GetReview() *Review {
+func (m *ListTicketTypesByAttractionRequest) GetAttractionId() string {
 	if m != nil {
-		return m.Review
+		return m.AttractionId
 	}
-	return nil
+	return ""
 }
 
-type CreateReviewResponse struct {
-	Review               *Review  `protobuf:"bytes,1,opt,name=review,proto3" json:"review"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListTicketTypesByAttractionResponse struct {
+	TicketTypes          []*TicketType `protobuf:"bytes,1,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types"`
+	Count                uint64        `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *CreateReviewResponse) Reset()         { *m = CreateReviewResponse{} }
-func (m *CreateReviewResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateReviewResponse) ProtoMessage()    {}
-func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4f0074a4a4eb033, []int{50}
+func (m *ListTicketTypesByAttractionResponse) Reset()         { *m = ListTicketTypesByAttractionResponse{} }
+func (m *ListTicketTypesByAttractionResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTicketTypesByAttractionResponse) ProtoMessage()    {}
+func (*ListTicketTypesByAttractionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{52}
 }
-func (m *CreateReviewResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListTicketTypesByAttractionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListTicketTypesByAttractionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateReviewResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListTicketTypesByAttractionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3127,44 +3328,51 @@ func (m *CreateReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *CreateReviewResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateReviewResponse.Merge(m, src)
+func (m *ListTicketTypesByAttractionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTicketTypesByAttractionResponse.Merge(m, src)
 }
-func (m *CreateReviewResponse) XXX_Size() int {
+func (m *ListTicketTypesByAttractionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateReviewResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateReviewResponse.DiscardUnknown(m)
+func (m *ListTicketTypesByAttractionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTicketTypesByAttractionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateReviewResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListTicketTypesByAttractionResponse proto.InternalMessageInfo
 
-func (m *CreateReviewResponse) GetReview() *Review {
+func (m *ListTicketTypesByAttractionResponse) GetTicketTypes() []*TicketType {
 	if m != nil {
-		return m.Review
+		return m.TicketTypes
 	}
 	return nil
 }
 
-type ListReviewsRequest struct {
-	EstablishmentId      string   `protobuf:"bytes,1,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListTicketTypesByAttractionResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *ListReviewsRequest) Reset()         { *m = ListReviewsRequest{} }
-func (m *ListReviewsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListReviewsRequest) ProtoMessage()    {}
-func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4f0074a4a4eb033, []int{51}
+type UpdateTicketTypeRequest struct {
+	TicketType           *TicketType `protobuf:"bytes,1,opt,name=ticket_type,json=ticketType,proto3" json:"ticket_type"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *ListReviewsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *UpdateTicketTypeRequest) Reset()         { *m = UpdateTicketTypeRequest{} }
+func (m *UpdateTicketTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTicketTypeRequest) ProtoMessage()    {}
+func (*UpdateTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{53}
+}
+func (m *UpdateTicketTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListReviewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateTicketTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListReviewsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateTicketTypeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3174,45 +3382,44 @@ func (m *ListReviewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ListReviewsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListReviewsRequest.Merge(m, src)
+func (m *UpdateTicketTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTicketTypeRequest.Merge(m, src)
 }
-func (m *ListReviewsRequest) XXX_Size() int {
+func (m *UpdateTicketTypeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListReviewsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListReviewsRequest.DiscardUnknown(m)
+func (m *UpdateTicketTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTicketTypeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListReviewsRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateTicketTypeRequest proto.InternalMessageInfo
 
-func (m *ListReviewsRequest) GetEstablishmentId() string {
+func (m *UpdateTicketTypeRequest) GetTicketType() *TicketType {
 	if m != nil {
-		return m.EstablishmentId
+		return m.TicketType
 	}
-	return ""
+	return nil
 }
 
-type ListReviewsResponse struct {
-	Reviews              []*Review `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews"`
-	Count                uint64    `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type UpdateTicketTypeResponse struct {
+	TicketType           *TicketType `protobuf:"bytes,1,opt,name=ticket_type,json=ticketType,proto3" json:"ticket_type"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ListReviewsResponse) Reset()         { *m = ListReviewsResponse{} }
-func (m *ListReviewsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListReviewsResponse) ProtoMessage()    {}
-func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4f0074a4a4eb033, []int{52}
+func (m *UpdateTicketTypeResponse) Reset()         { *m = UpdateTicketTypeResponse{} }
+func (m *UpdateTicketTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTicketTypeResponse) ProtoMessage()    {}
+func (*UpdateTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{54}
 }
-func (m *ListReviewsResponse) XXX_Unmarshal(b []byte) error {
+func (m *UpdateTicketTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListReviewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateTicketTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListReviewsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateTicketTypeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3222,51 +3429,44 @@ func (m *ListReviewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ListReviewsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListReviewsResponse.Merge(m, src)
+func (m *UpdateTicketTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTicketTypeResponse.Merge(m, src)
 }
-func (m *ListReviewsResponse) XXX_Size() int {
+func (m *UpdateTicketTypeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListReviewsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListReviewsResponse.DiscardUnknown(m)
+func (m *UpdateTicketTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTicketTypeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListReviewsResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateTicketTypeResponse proto.InternalMessageInfo
 
-func (m *ListReviewsResponse) GetReviews() []*Review {
+func (m *UpdateTicketTypeResponse) GetTicketType() *TicketType {
 	if m != nil {
-		return m.Reviews
+		return m.TicketType
 	}
 	return nil
 }
 
-func (m *ListReviewsResponse) GetCount() uint64 {
-	if m != nil {
-		return m.Count
-	}
-	return 0
-}
-
-type DeleteReviewRequest struct {
-	ReviewId             string   `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id"`
+type DeleteTicketTypeRequest struct {
+	TicketTypeId         string   `protobuf:"bytes,1,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteReviewRequest) Reset()         { *m = DeleteReviewRequest{} }
-func (m *DeleteReviewRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteReviewRequest) ProtoMessage()    {}
-func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4f0074a4a4eb033, []int{53}
+func (m *DeleteTicketTypeRequest) Reset()         { *m = DeleteTicketTypeRequest{} }
+func (m *DeleteTicketTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTicketTypeRequest) ProtoMessage()    {}
+func (*DeleteTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{55}
 }
-func (m *DeleteReviewRequest) XXX_Unmarshal(b []byte) error {
+func (m *DeleteTicketTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteTicketTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteReviewRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteTicketTypeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3276,44 +3476,44 @@ func (m *DeleteReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DeleteReviewRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteReviewRequest.Merge(m, src)
+func (m *DeleteTicketTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTicketTypeRequest.Merge(m, src)
 }
-func (m *DeleteReviewRequest) XXX_Size() int {
+func (m *DeleteTicketTypeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteReviewRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteReviewRequest.DiscardUnknown(m)
+func (m *DeleteTicketTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTicketTypeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteReviewRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteTicketTypeRequest proto.InternalMessageInfo
 
-func (m *DeleteReviewRequest) GetReviewId() string {
+func (m *DeleteTicketTypeRequest) GetTicketTypeId() string {
 	if m != nil {
-		return m.ReviewId
+		return m.TicketTypeId
 	}
 	return ""
 }
 
-type DeleteReviewResponse struct {
+type DeleteTicketTypeResponse struct {
 	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteReviewResponse) Reset()         { *m = DeleteReviewResponse{} }
-func (m *DeleteReviewResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteReviewResponse) ProtoMessage()    {}
-func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4f0074a4a4eb033, []int{54}
+func (m *DeleteTicketTypeResponse) Reset()         { *m = DeleteTicketTypeResponse{} }
+func (m *DeleteTicketTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTicketTypeResponse) ProtoMessage()    {}
+func (*DeleteTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{56}
 }
-func (m *DeleteReviewResponse) XXX_Unmarshal(b []byte) error {
+func (m *DeleteTicketTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteTicketTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteReviewResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteTicketTypeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3323,44 +3523,47 @@ func (m *DeleteReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *DeleteReviewResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteReviewResponse.Merge(m, src)
+func (m *DeleteTicketTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTicketTypeResponse.Merge(m, src)
 }
-func (m *DeleteReviewResponse) XXX_Size() int {
+func (m *DeleteTicketTypeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteReviewResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteReviewResponse.DiscardUnknown(m)
+func (m *DeleteTicketTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTicketTypeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteReviewResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteTicketTypeResponse proto.InternalMessageInfo
 
-func (m *DeleteReviewResponse) GetSuccess() bool {
+func (m *DeleteTicketTypeResponse) GetSuccess() bool {
 	if m != nil {
 		return m.Success
 	}
 	return false
 }
 
-type CreateImageRes struct {
-	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+// CANCELLATION POLICY
+type CancellationRule struct {
+	HoursBefore          int64    `protobuf:"varint,1,opt,name=hours_before,json=hoursBefore,proto3" json:"hours_before"`
+	RefundPercent        float64  `protobuf:"fixed64,2,opt,name=refund_percent,json=refundPercent,proto3" json:"refund_percent"`
+	ChangeFeePercent     float64  `protobuf:"fixed64,3,opt,name=change_fee_percent,json=changeFeePercent,proto3" json:"change_fee_percent"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateImageRes) Reset()         { *m = CreateImageRes{} }
-func (m *CreateImageRes) String() string { return proto.CompactTextString(m) }
-func (*CreateImageRes) ProtoMessage()    {}
-func (*CreateImageRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f4f0074a4a4eb033, []int{55}
+func (m *CancellationRule) Reset()         { *m = CancellationRule{} }
+func (m *CancellationRule) String() string { return proto.CompactTextString(m) }
+func (*CancellationRule) ProtoMessage()    {}
+func (*CancellationRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{57}
 }
-func (m *CreateImageRes) XXX_Unmarshal(b []byte) error {
+func (m *CancellationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateImageRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CancellationRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateImageRes.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CancellationRule.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3370,5231 +3573,15399 @@ func (m *CreateImageRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *CreateImageRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateImageRes.Merge(m, src)
+func (m *CancellationRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancellationRule.Merge(m, src)
 }
-func (m *CreateImageRes) XXX_Size() int {
+func (m *CancellationRule) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateImageRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateImageRes.DiscardUnknown(m)
+func (m *CancellationRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancellationRule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateImageRes proto.InternalMessageInfo
+var xxx_messageInfo_CancellationRule proto.InternalMessageInfo
 
-func (m *CreateImageRes) GetResult() string {
+func (m *CancellationRule) GetHoursBefore() int64 {
 	if m != nil {
-		return m.Result
+		return m.HoursBefore
 	}
-	return ""
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*Image)(nil), "establishment_service.Image")
-	proto.RegisterType((*Location)(nil), "establishment_service.Location")
-	proto.RegisterType((*Attraction)(nil), "establishment_service.Attraction")
-	proto.RegisterType((*GetAttractionRequest)(nil), "establishment_service.GetAttractionRequest")
-	proto.RegisterType((*GetAttractionResponse)(nil), "establishment_service.GetAttractionResponse")
-	proto.RegisterType((*ListAttractionsRequest)(nil), "establishment_service.ListAttractionsRequest")
-	proto.RegisterType((*ListAttractionsResponse)(nil), "establishment_service.ListAttractionsResponse")
-	proto.RegisterType((*UpdateAttractionRequest)(nil), "establishment_service.UpdateAttractionRequest")
-	proto.RegisterType((*UpdateAttractionResponse)(nil), "establishment_service.UpdateAttractionResponse")
-	proto.RegisterType((*DeleteAttractionRequest)(nil), "establishment_service.DeleteAttractionRequest")
-	proto.RegisterType((*DeleteAttractionResponse)(nil), "establishment_service.DeleteAttractionResponse")
-	proto.RegisterType((*ListAttractionsByLocationRequest)(nil), "establishment_service.ListAttractionsByLocationRequest")
-	proto.RegisterType((*ListAttractionsByLocationResponse)(nil), "establishment_service.ListAttractionsByLocationResponse")
-	proto.RegisterType((*FindAttractionsByNameRequest)(nil), "establishment_service.FindAttractionsByNameRequest")
-	proto.RegisterType((*FindAttractionsByNameResponse)(nil), "establishment_service.FindAttractionsByNameResponse")
-	proto.RegisterType((*Restaurant)(nil), "establishment_service.Restaurant")
-	proto.RegisterType((*GetRestaurantRequest)(nil), "establishment_service.GetRestaurantRequest")
-	proto.RegisterType((*GetRestaurantResponse)(nil), "establishment_service.GetRestaurantResponse")
-	proto.RegisterType((*ListRestaurantsRequest)(nil), "establishment_service.ListRestaurantsRequest")
-	proto.RegisterType((*ListRestaurantsResponse)(nil), "establishment_service.ListRestaurantsResponse")
-	proto.RegisterType((*UpdateRestaurantRequest)(nil), "establishment_service.UpdateRestaurantRequest")
-	proto.RegisterType((*UpdateRestaurantResponse)(nil), "establishment_service.UpdateRestaurantResponse")
-	proto.RegisterType((*DeleteRestaurantRequest)(nil), "establishment_service.DeleteRestaurantRequest")
-	proto.RegisterType((*DeleteRestaurantResponse)(nil), "establishment_service.DeleteRestaurantResponse")
-	proto.RegisterType((*ListRestaurantsByLocationRequest)(nil), "establishment_service.ListRestaurantsByLocationRequest")
-	proto.RegisterType((*ListRestaurantsByLocationResponse)(nil), "establishment_service.ListRestaurantsByLocationResponse")
-	proto.RegisterType((*FindRestaurantsByNameRequest)(nil), "establishment_service.FindRestaurantsByNameRequest")
-	proto.RegisterType((*FindRestaurantsByNameResponse)(nil), "establishment_service.FindRestaurantsByNameResponse")
-	proto.RegisterType((*Hotel)(nil), "establishment_service.Hotel")
-	proto.RegisterType((*GetHotelRequest)(nil), "establishment_service.GetHotelRequest")
-	proto.RegisterType((*GetHotelResponse)(nil), "establishment_service.GetHotelResponse")
-	proto.RegisterType((*ListHotelsRequest)(nil), "establishment_service.ListHotelsRequest")
-	proto.RegisterType((*ListHotelsResponse)(nil), "establishment_service.ListHotelsResponse")
-	proto.RegisterType((*UpdateHotelRequest)(nil), "establishment_service.UpdateHotelRequest")
-	proto.RegisterType((*UpdateHotelResponse)(nil), "establishment_service.UpdateHotelResponse")
-	proto.RegisterType((*DeleteHotelRequest)(nil), "establishment_service.DeleteHotelRequest")
-	proto.RegisterType((*DeleteHotelResponse)(nil), "establishment_service.DeleteHotelResponse")
-	proto.RegisterType((*ListHotelsByLocationRequest)(nil), "establishment_service.ListHotelsByLocationRequest")
-	proto.RegisterType((*ListHotelsByLocationResponse)(nil), "establishment_service.ListHotelsByLocationResponse")
-	proto.RegisterType((*FindHotelsByNameRequest)(nil), "establishment_service.FindHotelsByNameRequest")
-	proto.RegisterType((*FindHotelsByNameResponse)(nil), "establishment_service.FindHotelsByNameResponse")
-	proto.RegisterType((*Favourite)(nil), "establishment_service.Favourite")
-	proto.RegisterType((*AddToFavouritesRequest)(nil), "establishment_service.AddToFavouritesRequest")
-	proto.RegisterType((*AddToFavouritesResponse)(nil), "establishment_service.AddToFavouritesResponse")
-	proto.RegisterType((*RemoveFromFavouritesRequest)(nil), "establishment_service.RemoveFromFavouritesRequest")
-	proto.RegisterType((*RemoveFromFavouritesResponse)(nil), "establishment_service.RemoveFromFavouritesResponse")
-	proto.RegisterType((*ListFavouritesByUserIdRequest)(nil), "establishment_service.ListFavouritesByUserIdRequest")
-	proto.RegisterType((*ListFavouritesByUserIdResponse)(nil), "establishment_service.ListFavouritesByUserIdResponse")
-	proto.RegisterType((*Review)(nil), "establishment_service.Review")
-	proto.RegisterType((*CreateReviewRequest)(nil), "establishment_service.CreateReviewRequest")
-	proto.RegisterType((*CreateReviewResponse)(nil), "establishment_service.CreateReviewResponse")
-	proto.RegisterType((*ListReviewsRequest)(nil), "establishment_service.ListReviewsRequest")
-	proto.RegisterType((*ListReviewsResponse)(nil), "establishment_service.ListReviewsResponse")
-	proto.RegisterType((*DeleteReviewRequest)(nil), "establishment_service.DeleteReviewRequest")
-	proto.RegisterType((*DeleteReviewResponse)(nil), "establishment_service.DeleteReviewResponse")
-	proto.RegisterType((*CreateImageRes)(nil), "establishment_service.CreateImageRes")
+func (m *CancellationRule) GetRefundPercent() float64 {
+	if m != nil {
+		return m.RefundPercent
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("establishment-proto/establishment.proto", fileDescriptor_f4f0074a4a4eb033)
+func (m *CancellationRule) GetChangeFeePercent() float64 {
+	if m != nil {
+		return m.ChangeFeePercent
+	}
+	return 0
 }
 
-var fileDescriptor_f4f0074a4a4eb033 = []byte{
-	// 1888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0xc9, 0x6e, 0xdc, 0xc6,
-	0xf2, 0xd1, 0x9a, 0xb5, 0x66, 0xb4, 0xbc, 0x96, 0x2c, 0x8d, 0x47, 0x8b, 0x65, 0x1a, 0x7e, 0x96,
-	0xbc, 0x48, 0x0f, 0x63, 0x19, 0x36, 0x60, 0xc0, 0xcf, 0xb2, 0xdf, 0x93, 0x2d, 0xc0, 0x36, 0x1e,
-	0x26, 0x31, 0xe0, 0x6c, 0x10, 0x28, 0xb2, 0x25, 0xd3, 0x98, 0x21, 0x15, 0x92, 0x23, 0x47, 0x39,
-	0xc4, 0x40, 0x80, 0x1c, 0x93, 0x73, 0x8e, 0xb9, 0xe4, 0x5f, 0x72, 0x73, 0xce, 0x39, 0x05, 0xf6,
-	0x25, 0x9f, 0xe0, 0x63, 0xc0, 0x5e, 0xc8, 0xe6, 0x90, 0xdd, 0xa4, 0x36, 0xc4, 0x87, 0xdc, 0xa6,
-	0xab, 0xab, 0xba, 0xf6, 0x2a, 0x56, 0x49, 0x70, 0x19, 0xfb, 0x81, 0xb1, 0xdd, 0xb3, 0xfd, 0x17,
-	0x7d, 0xec, 0x04, 0xd7, 0xf7, 0x3c, 0x37, 0x70, 0x57, 0x13, 0xb0, 0x15, 0x02, 0x43, 0x67, 0x13,
-	0xc0, 0x2d, 0x1f, 0x7b, 0xfb, 0xb6, 0x89, 0xf5, 0x77, 0x1a, 0x94, 0x37, 0xfb, 0xc6, 0x2e, 0x46,
-	0xe7, 0xa0, 0x66, 0x87, 0x3f, 0xb6, 0x6c, 0xab, 0xa5, 0x2d, 0x6a, 0x4b, 0xf5, 0x6e, 0x95, 0x9c,
-	0x37, 0x2d, 0xb4, 0x0c, 0x13, 0x49, 0x6a, 0xdb, 0x6a, 0x9d, 0x21, 0x28, 0xe3, 0x09, 0xf8, 0xa6,
-	0x85, 0x66, 0xa1, 0x4e, 0x5f, 0x19, 0x78, 0xbd, 0xd6, 0x08, 0xc1, 0xa1, 0xcf, 0x3e, 0xf3, 0x7a,
-	0xa8, 0x0d, 0x35, 0xd3, 0x08, 0xf0, 0xae, 0xeb, 0x1d, 0xb4, 0x4a, 0xf4, 0x8e, 0x9f, 0xd1, 0x3c,
-	0x80, 0xe9, 0x61, 0x23, 0xc0, 0xd6, 0x96, 0x11, 0xb4, 0xca, 0xe4, 0xb6, 0xce, 0x20, 0xeb, 0x41,
-	0x78, 0x3d, 0xd8, 0xb3, 0xf8, 0x75, 0x85, 0x5e, 0x33, 0x08, 0xbd, 0xb6, 0x70, 0x0f, 0xb3, 0xeb,
-	0x2a, 0xbd, 0x66, 0x90, 0xf5, 0x40, 0x7f, 0x7f, 0x06, 0x6a, 0x8f, 0x5d, 0xd3, 0x08, 0x6c, 0xd7,
-	0x41, 0xe7, 0xa1, 0xd1, 0x63, 0xbf, 0x63, 0x5d, 0x81, 0x83, 0x0e, 0xa7, 0x6e, 0x0b, 0xaa, 0x86,
-	0x65, 0x79, 0xd8, 0xf7, 0x99, 0xb2, 0xfc, 0x18, 0xea, 0xda, 0x33, 0x02, 0x3b, 0x18, 0x58, 0x98,
-	0xe8, 0x7a, 0xa6, 0x1b, 0x9d, 0xd1, 0x1c, 0xd4, 0x7b, 0xae, 0xb3, 0x4b, 0x2f, 0xcb, 0xe4, 0x32,
-	0x06, 0x84, 0x6f, 0x9a, 0xee, 0xc0, 0x09, 0xbc, 0x03, 0xa6, 0x27, 0x3f, 0x22, 0x04, 0x25, 0xd3,
-	0x0e, 0x0e, 0x98, 0x7e, 0xe4, 0x37, 0xba, 0x04, 0x63, 0x7e, 0x60, 0x04, 0x78, 0x6b, 0xcf, 0x73,
-	0xf7, 0x6d, 0xc7, 0xc4, 0xad, 0x1a, 0xb9, 0x1d, 0x25, 0xd0, 0xff, 0x33, 0x60, 0xc2, 0xf4, 0x75,
-	0xa5, 0xe9, 0x41, 0x6d, 0xfa, 0x86, 0xda, 0xf4, 0xcd, 0x61, 0xd3, 0xff, 0x31, 0x02, 0xb0, 0x1e,
-	0x04, 0x9e, 0x61, 0x12, 0xe3, 0x5f, 0x84, 0x51, 0x23, 0x3a, 0xc5, 0xe6, 0x6f, 0xc6, 0xc0, 0x4d,
-	0x2b, 0x0c, 0x45, 0xf7, 0x95, 0x83, 0xbd, 0xd8, 0xf0, 0x55, 0x72, 0xde, 0xb4, 0xd0, 0x65, 0x18,
-	0x17, 0xe8, 0x1d, 0xa3, 0x8f, 0x99, 0xe1, 0xc7, 0x62, 0xf0, 0x53, 0xa3, 0x8f, 0xd1, 0x22, 0x34,
-	0x2c, 0xec, 0x9b, 0x9e, 0xbd, 0x17, 0x82, 0x58, 0xb8, 0x89, 0x20, 0x34, 0x0d, 0x15, 0xcf, 0x08,
-	0x6c, 0x67, 0x97, 0xb9, 0x80, 0x9d, 0x42, 0x8b, 0x9a, 0xae, 0x13, 0x18, 0x66, 0xb0, 0xe5, 0x0c,
-	0xfa, 0xdb, 0xd8, 0x63, 0x6e, 0x18, 0x65, 0xd0, 0xa7, 0x04, 0x48, 0xc2, 0xc8, 0x36, 0xb1, 0x63,
-	0xd2, 0x58, 0xaf, 0xb2, 0x30, 0xa2, 0xa0, 0x30, 0xda, 0xcf, 0x43, 0xe3, 0x15, 0xde, 0xf6, 0xed,
-	0x80, 0x22, 0x50, 0xb7, 0x00, 0x03, 0x85, 0x08, 0x6b, 0x50, 0x21, 0xa9, 0xe1, 0xb7, 0xea, 0x8b,
-	0x23, 0x4b, 0x8d, 0xce, 0xdc, 0x4a, 0x66, 0x8e, 0xae, 0x90, 0xfc, 0xec, 0x32, 0x5c, 0x74, 0x07,
-	0x6a, 0x3c, 0x56, 0x89, 0xaf, 0x1a, 0x9d, 0xf3, 0x12, 0x3a, 0x1e, 0xf1, 0xdd, 0x88, 0x60, 0xc8,
-	0xd5, 0x0d, 0xb5, 0xab, 0x9b, 0x6a, 0x57, 0x8f, 0x0e, 0xbb, 0xfa, 0x0e, 0x4c, 0x3d, 0xc4, 0x41,
-	0xec, 0xec, 0x2e, 0xfe, 0x72, 0x80, 0xfd, 0xa0, 0x90, 0xcf, 0xf5, 0x4f, 0xe1, 0xec, 0x10, 0xb1,
-	0xbf, 0xe7, 0x3a, 0x3e, 0x46, 0xeb, 0x00, 0x31, 0x22, 0x21, 0x6d, 0x74, 0x2e, 0x48, 0x34, 0x16,
-	0xc8, 0x05, 0x22, 0x7d, 0x03, 0xa6, 0x1f, 0xdb, 0xbe, 0xf0, 0xb8, 0xcf, 0x45, 0x9b, 0x86, 0x8a,
-	0xbb, 0xb3, 0xe3, 0xe3, 0x80, 0x3c, 0x3c, 0xd2, 0x65, 0x27, 0x34, 0x05, 0xe5, 0x9e, 0xdd, 0xb7,
-	0x03, 0x12, 0x7e, 0x23, 0x5d, 0x7a, 0xd0, 0xbf, 0x82, 0x99, 0xd4, 0x3b, 0x4c, 0xca, 0x07, 0xd0,
-	0x88, 0x19, 0xfa, 0x2d, 0x8d, 0x38, 0xb4, 0x80, 0x98, 0x22, 0x55, 0x98, 0xf9, 0xee, 0x3e, 0xf6,
-	0x8c, 0x5e, 0x8f, 0xf0, 0x2d, 0x75, 0xf9, 0x51, 0xff, 0x1c, 0x66, 0x9e, 0x11, 0x37, 0xa4, 0xad,
-	0x7b, 0x02, 0xf6, 0xf9, 0x02, 0x5a, 0xe9, 0xd7, 0x4f, 0xce, 0xfc, 0x77, 0x61, 0xe6, 0xbf, 0x24,
-	0x48, 0x8e, 0x18, 0x1a, 0x6b, 0xd0, 0x4a, 0xd3, 0x33, 0xf1, 0x5a, 0x50, 0xf5, 0x07, 0xa6, 0x19,
-	0x16, 0xe0, 0x90, 0xb4, 0xd6, 0xe5, 0x47, 0xfd, 0x67, 0x0d, 0x16, 0x87, 0xbc, 0x75, 0xff, 0x20,
-	0x4a, 0x89, 0x4c, 0xff, 0x97, 0xb2, 0xfd, 0x5f, 0x62, 0xfe, 0x17, 0x2b, 0xf3, 0x48, 0x76, 0x65,
-	0x2e, 0x29, 0x2b, 0x73, 0x39, 0xa3, 0x32, 0xeb, 0xdf, 0xc0, 0x05, 0x85, 0x98, 0x71, 0x78, 0xad,
-	0x1f, 0x29, 0xbc, 0x04, 0xaa, 0x50, 0x29, 0x22, 0x2f, 0x0f, 0x6a, 0x72, 0xd0, 0x3b, 0x30, 0xb7,
-	0x61, 0x3b, 0x56, 0x82, 0x7f, 0x58, 0x41, 0xb9, 0x89, 0x10, 0x94, 0x48, 0x99, 0xa5, 0x9e, 0x21,
-	0xbf, 0xf5, 0xaf, 0x61, 0x5e, 0x42, 0x73, 0x6a, 0xf2, 0x96, 0xb8, 0xbc, 0xdf, 0x97, 0x00, 0xba,
-	0xe1, 0x43, 0x03, 0xcf, 0x70, 0x48, 0x04, 0x79, 0xd1, 0x49, 0x88, 0xa0, 0x18, 0x98, 0xdb, 0x50,
-	0x04, 0x7a, 0xb1, 0xa1, 0xc4, 0xe0, 0x63, 0x36, 0x94, 0x8b, 0x30, 0xea, 0xee, 0x61, 0xc7, 0x76,
-	0x76, 0xb7, 0x5e, 0xb8, 0x03, 0xcf, 0x67, 0xfd, 0xa4, 0xc9, 0x80, 0x8f, 0x42, 0x58, 0x46, 0xd7,
-	0xa9, 0x16, 0xe8, 0x3a, 0xb5, 0xbc, 0xae, 0x53, 0x57, 0x74, 0x1d, 0x38, 0x62, 0xd7, 0x69, 0x1c,
-	0xaf, 0xeb, 0x34, 0xd5, 0x5d, 0x67, 0x54, 0xdd, 0x75, 0xc6, 0xb2, 0xbb, 0x4e, 0x1c, 0x11, 0x42,
-	0x69, 0xc9, 0x0d, 0x0c, 0xd6, 0x75, 0x44, 0xe2, 0xb8, 0xec, 0xc5, 0x88, 0x39, 0x65, 0x4f, 0x20,
-	0x17, 0x88, 0x78, 0xd7, 0x89, 0x6f, 0x8f, 0xd7, 0x75, 0x12, 0xef, 0xc4, 0x69, 0x16, 0x33, 0xcc,
-	0x4b, 0x33, 0x41, 0x4c, 0x91, 0xaa, 0x48, 0xd7, 0x49, 0x5b, 0xf7, 0x04, 0xec, 0x13, 0x75, 0x9d,
-	0xd3, 0x31, 0x7f, 0xd4, 0x75, 0x8e, 0x18, 0x1a, 0x51, 0xd7, 0xc9, 0x10, 0x2f, 0xbf, 0xeb, 0x08,
-	0xde, 0xfa, 0x90, 0xbb, 0x8e, 0x44, 0xcc, 0x93, 0x0c, 0x2f, 0x65, 0xd7, 0x49, 0xf0, 0x2f, 0xd8,
-	0x75, 0x32, 0x68, 0x4e, 0x4d, 0xde, 0xa8, 0xeb, 0xbc, 0x19, 0x81, 0xf2, 0x23, 0x37, 0xc0, 0xbd,
-	0xb0, 0x97, 0xbc, 0x08, 0x7f, 0x08, 0x73, 0x32, 0x39, 0xab, 0xdb, 0xcc, 0x3c, 0x00, 0xa5, 0x12,
-	0x3a, 0x4c, 0x9d, 0x40, 0xfe, 0x9e, 0x56, 0xfe, 0x9a, 0x69, 0xe5, 0x1a, 0x8c, 0x3f, 0xc4, 0x01,
-	0xf1, 0x29, 0x0f, 0x3a, 0xb9, 0x6b, 0xf5, 0x0d, 0x98, 0x88, 0xb1, 0x59, 0xb8, 0x75, 0xa0, 0x4c,
-	0xae, 0x59, 0x7d, 0x92, 0x19, 0x84, 0x12, 0x51, 0x54, 0x7d, 0x1d, 0xfe, 0x19, 0xe6, 0x1d, 0x81,
-	0x1d, 0xb1, 0x1f, 0x58, 0x80, 0xc4, 0x27, 0x98, 0x30, 0x6b, 0x50, 0x21, 0x1c, 0x78, 0xd8, 0xab,
-	0xa5, 0x61, 0xb8, 0x8a, 0xda, 0xff, 0x08, 0x10, 0xad, 0xce, 0x09, 0x0b, 0x1d, 0x45, 0xe5, 0x4d,
-	0x98, 0x4c, 0xbc, 0x74, 0x0c, 0xeb, 0xad, 0x02, 0xa2, 0x35, 0xb9, 0xa8, 0xdb, 0x56, 0x61, 0x32,
-	0x41, 0x90, 0x5b, 0xbf, 0x7f, 0xd2, 0x60, 0x36, 0xb6, 0xee, 0x07, 0x59, 0xba, 0x5f, 0xc2, 0x5c,
-	0xb6, 0x84, 0xc7, 0x8a, 0x84, 0xec, 0xb2, 0x77, 0x1d, 0x66, 0xc2, 0x92, 0xcb, 0x79, 0xe5, 0x55,
-	0xe8, 0x1d, 0x68, 0xa5, 0xd1, 0x4f, 0x41, 0xac, 0x37, 0x1a, 0xd4, 0x37, 0x8c, 0x7d, 0x77, 0xe0,
-	0xd9, 0x01, 0x46, 0x17, 0xa0, 0xb9, 0xc3, 0x0f, 0x71, 0x0c, 0x34, 0x22, 0xd8, 0xe1, 0x56, 0x7a,
-	0x33, 0x50, 0x1d, 0xf8, 0xb4, 0x86, 0x53, 0x9f, 0x55, 0xc2, 0x23, 0x2d, 0xe1, 0x42, 0x35, 0x2a,
-	0xa9, 0xab, 0x51, 0x59, 0x5d, 0x8d, 0x2a, 0xc3, 0xd5, 0xe8, 0x39, 0x4c, 0xaf, 0x5b, 0xd6, 0xc7,
-	0x6e, 0xa4, 0x55, 0x54, 0x1c, 0xee, 0x42, 0x3d, 0xd2, 0x84, 0xe5, 0xca, 0xa2, 0xc4, 0x74, 0x11,
-	0x71, 0x37, 0x26, 0xd1, 0x3f, 0x81, 0x99, 0xd4, 0xcb, 0xcc, 0x25, 0xc7, 0x7d, 0xfa, 0x1e, 0xcc,
-	0x76, 0x71, 0xdf, 0xdd, 0xc7, 0x1b, 0x9e, 0xdb, 0x4f, 0x4b, 0x9e, 0xef, 0x17, 0xfd, 0x36, 0xcc,
-	0x65, 0xbf, 0x90, 0x9b, 0xa8, 0xb7, 0x61, 0x3e, 0xcc, 0x82, 0x98, 0xe6, 0xfe, 0xc1, 0x33, 0xe2,
-	0x27, 0xce, 0x5d, 0xf0, 0xa3, 0x26, 0xfa, 0x51, 0xdf, 0x86, 0x05, 0x19, 0x25, 0xe3, 0x7a, 0x0f,
-	0x20, 0x12, 0x92, 0x87, 0x6b, 0xbe, 0x61, 0x04, 0x1a, 0xfd, 0xbd, 0x06, 0x95, 0x2e, 0xde, 0xb7,
-	0xf1, 0x2b, 0x34, 0x0b, 0x75, 0x8f, 0xfc, 0x8a, 0x25, 0xa9, 0x51, 0xc0, 0x09, 0xc5, 0x65, 0xfc,
-	0x65, 0x50, 0x4a, 0x7c, 0x19, 0x90, 0xe2, 0xd3, 0x0f, 0xa9, 0x59, 0x34, 0xf2, 0xe3, 0x50, 0x24,
-	0x57, 0xd4, 0x91, 0x5c, 0x55, 0x47, 0x72, 0x6d, 0x38, 0x92, 0x1f, 0xc3, 0xe4, 0x03, 0xf2, 0x14,
-	0xd5, 0x9f, 0xbb, 0xe3, 0x26, 0x54, 0xa8, 0xd6, 0x2c, 0xd0, 0xe6, 0xa5, 0x9f, 0x65, 0x84, 0x8a,
-	0x21, 0xeb, 0x4f, 0x60, 0x2a, 0xf9, 0x1a, 0x73, 0xd1, 0x11, 0x9f, 0xfb, 0x0f, 0xed, 0x9d, 0x14,
-	0x1a, 0x05, 0x6a, 0x96, 0x17, 0xb4, 0x4c, 0x2f, 0xe8, 0x16, 0x4c, 0x26, 0x1e, 0x60, 0xe2, 0xdc,
-	0x82, 0x2a, 0xe5, 0xc0, 0xc3, 0x25, 0x47, 0x1e, 0x8e, 0x2d, 0xa9, 0x6f, 0x1d, 0xde, 0xb6, 0x92,
-	0x36, 0x54, 0x85, 0x92, 0xfe, 0x6f, 0x98, 0x4a, 0xd2, 0xe4, 0xa6, 0xd0, 0x12, 0x8c, 0x51, 0xdb,
-	0xd2, 0x4f, 0x36, 0xec, 0x93, 0x50, 0xc2, 0xfe, 0xa0, 0x17, 0xf0, 0x94, 0xa1, 0xa7, 0xce, 0x6f,
-	0x2d, 0x98, 0xfa, 0x9f, 0xa8, 0xcf, 0x47, 0x54, 0x1d, 0xf4, 0x1c, 0x26, 0xe8, 0x13, 0xc2, 0x8a,
-	0x3f, 0x7f, 0xcd, 0xd3, 0xce, 0x47, 0x41, 0x2f, 0x61, 0x34, 0xb1, 0x0f, 0x46, 0x57, 0x25, 0x34,
-	0x59, 0x2b, 0xe7, 0xf6, 0xb5, 0x62, 0xc8, 0xcc, 0x44, 0x7b, 0x30, 0x3e, 0xb4, 0x82, 0x43, 0xd7,
-	0x65, 0x5f, 0xa9, 0x99, 0x7b, 0xe4, 0xf6, 0x4a, 0x51, 0x74, 0xc6, 0xd1, 0x87, 0x89, 0xe1, 0x8d,
-	0x2b, 0x92, 0xbd, 0x21, 0x59, 0xfc, 0xb6, 0x57, 0x0b, 0xe3, 0xc7, 0x4c, 0x87, 0xf7, 0xa8, 0x52,
-	0xa6, 0x92, 0x85, 0xad, 0x94, 0xa9, 0x74, 0x41, 0xfb, 0xad, 0x06, 0x67, 0x33, 0x77, 0x85, 0xe8,
-	0x86, 0xac, 0xa2, 0x2a, 0xb6, 0x91, 0xed, 0xb5, 0xc3, 0x11, 0x31, 0x21, 0x7e, 0xd0, 0xe0, 0x9c,
-	0x74, 0xc9, 0x8a, 0x6e, 0x15, 0x73, 0x5e, 0xea, 0x63, 0xb0, 0x7d, 0xfb, 0xf0, 0x84, 0x4c, 0xa0,
-	0x28, 0x6f, 0x84, 0x4d, 0x66, 0xfe, 0xa0, 0xda, 0xce, 0x47, 0x61, 0x79, 0x23, 0x00, 0x14, 0x79,
-	0x93, 0xda, 0x8c, 0xa8, 0xf2, 0x26, 0x63, 0x0d, 0xc2, 0xf2, 0x46, 0x18, 0xc8, 0x95, 0x79, 0x93,
-	0xde, 0x84, 0x29, 0xf3, 0x26, 0x6b, 0xe1, 0x15, 0xe5, 0x8d, 0xa0, 0xa0, 0x3a, 0x6f, 0xd2, 0x3a,
-	0xae, 0x16, 0xc6, 0x1f, 0xce, 0x9b, 0x02, 0x4c, 0x25, 0x2b, 0xa7, 0x9c, 0xbc, 0xc9, 0x60, 0xca,
-	0xf3, 0x26, 0xb5, 0xed, 0x50, 0xe6, 0x8d, 0x6c, 0x9f, 0xa2, 0xcc, 0x1b, 0xf9, 0x42, 0x85, 0xe7,
-	0x4d, 0xe6, 0x9a, 0x48, 0x99, 0x37, 0xaa, 0xfd, 0x97, 0x32, 0x6f, 0xd4, 0x1b, 0xa9, 0x4d, 0x68,
-	0xd0, 0xbc, 0xa1, 0xbb, 0x18, 0xe5, 0x0c, 0xd1, 0x56, 0xde, 0xa2, 0xcf, 0xa0, 0xc6, 0x27, 0x7a,
-	0xf4, 0x2f, 0x79, 0xd8, 0x8b, 0x93, 0x66, 0xfb, 0x72, 0x2e, 0x1e, 0x93, 0xd3, 0x00, 0x88, 0x67,
-	0x34, 0xb4, 0xa4, 0xd0, 0x37, 0xb1, 0x09, 0x68, 0x2f, 0x17, 0xc0, 0x64, 0x2c, 0x2c, 0x68, 0x08,
-	0x63, 0x35, 0x5a, 0x56, 0x46, 0x75, 0x42, 0x8b, 0x2b, 0x45, 0x50, 0x63, 0x2e, 0xc2, 0x00, 0x2d,
-	0xe5, 0x92, 0x9e, 0xca, 0xa5, 0x5c, 0xb2, 0xe6, 0x71, 0x1f, 0x26, 0x86, 0xe7, 0x46, 0x69, 0x86,
-	0x49, 0xe6, 0x51, 0x69, 0x86, 0x49, 0x07, 0xd2, 0xd7, 0x30, 0x95, 0x35, 0x47, 0xa3, 0x4e, 0xae,
-	0x0f, 0xd2, 0x11, 0x7d, 0xe3, 0x50, 0x34, 0x71, 0xf9, 0x1c, 0x9a, 0xcc, 0xa4, 0xe5, 0x33, 0x7b,
-	0x36, 0x94, 0x96, 0x4f, 0xd9, 0xc0, 0xf7, 0x1a, 0xa6, 0xb2, 0xc6, 0x2d, 0xa9, 0xca, 0x8a, 0xe9,
-	0x4e, 0xaa, 0xb2, 0x72, 0x9e, 0xfb, 0x4e, 0xa3, 0x7f, 0x14, 0x49, 0x0f, 0x5f, 0x68, 0x4d, 0x61,
-	0x42, 0xe9, 0x94, 0xd7, 0xbe, 0x79, 0x48, 0x2a, 0x26, 0xc7, 0x2e, 0x34, 0xc5, 0xb1, 0x02, 0xc9,
-	0x82, 0x35, 0x63, 0x92, 0x69, 0x5f, 0x2d, 0x84, 0x1b, 0xe7, 0x8f, 0x30, 0x2f, 0xa0, 0x65, 0x65,
-	0xe5, 0x13, 0x87, 0x12, 0x69, 0xfe, 0x64, 0x8d, 0x1f, 0xbb, 0xd0, 0x14, 0xbf, 0xfd, 0xd1, 0x95,
-	0x9c, 0x6e, 0x53, 0x44, 0x9d, 0xcc, 0x61, 0xa2, 0xcb, 0xeb, 0xef, 0x13, 0x6c, 0xd9, 0x06, 0x52,
-	0xee, 0x80, 0xdb, 0x97, 0x94, 0x86, 0xe2, 0x43, 0xc7, 0xfd, 0x89, 0x5f, 0xde, 0x2e, 0x68, 0xbf,
-	0xbe, 0x5d, 0xd0, 0x7e, 0x7f, 0xbb, 0xa0, 0xfd, 0xf8, 0x6e, 0xe1, 0x1f, 0xdb, 0x15, 0xf2, 0x2f,
-	0x6b, 0x37, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x98, 0x47, 0xba, 0xdd, 0x26, 0x00, 0x00,
+type CancellationPolicy struct {
+	EstablishmentId      string              `protobuf:"bytes,1,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	Rules                []*CancellationRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules"`
+	UpdatedAt            string              `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// EstablishmentServiceClient is the client API for EstablishmentService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type EstablishmentServiceClient interface {
-	// ATTRACTION
-	CreateAttraction(ctx context.Context, in *Attraction, opts ...grpc.CallOption) (*Attraction, error)
-	GetAttraction(ctx context.Context, in *GetAttractionRequest, opts ...grpc.CallOption) (*GetAttractionResponse, error)
-	ListAttractions(ctx context.Context, in *ListAttractionsRequest, opts ...grpc.CallOption) (*ListAttractionsResponse, error)
-	UpdateAttraction(ctx context.Context, in *UpdateAttractionRequest, opts ...grpc.CallOption) (*UpdateAttractionResponse, error)
-	DeleteAttraction(ctx context.Context, in *DeleteAttractionRequest, opts ...grpc.CallOption) (*DeleteAttractionResponse, error)
-	FindAttractionsByName(ctx context.Context, in *FindAttractionsByNameRequest, opts ...grpc.CallOption) (*FindAttractionsByNameResponse, error)
-	ListAttractionsByLocation(ctx context.Context, in *ListAttractionsByLocationRequest, opts ...grpc.CallOption) (*ListAttractionsByLocationResponse, error)
-	// RESTAURANT
-	CreateRestaurant(ctx context.Context, in *Restaurant, opts ...grpc.CallOption) (*Restaurant, error)
-	GetRestaurant(ctx context.Context, in *GetRestaurantRequest, opts ...grpc.CallOption) (*GetRestaurantResponse, error)
-	ListRestaurants(ctx context.Context, in *ListRestaurantsRequest, opts ...grpc.CallOption) (*ListRestaurantsResponse, error)
-	UpdateRestaurant(ctx context.Context, in *UpdateRestaurantRequest, opts ...grpc.CallOption) (*UpdateRestaurantResponse, error)
-	DeleteRestaurant(ctx context.Context, in *DeleteRestaurantRequest, opts ...grpc.CallOption) (*DeleteRestaurantResponse, error)
-	FindRestaurantsByName(ctx context.Context, in *FindRestaurantsByNameRequest, opts ...grpc.CallOption) (*FindRestaurantsByNameResponse, error)
-	ListRestaurantsByLocation(ctx context.Context, in *ListRestaurantsByLocationRequest, opts ...grpc.CallOption) (*ListRestaurantsByLocationResponse, error)
-	// HOTEL
-	CreateHotel(ctx context.Context, in *Hotel, opts ...grpc.CallOption) (*Hotel, error)
-	GetHotel(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*GetHotelResponse, error)
-	ListHotels(ctx context.Context, in *ListHotelsRequest, opts ...grpc.CallOption) (*ListHotelsResponse, error)
-	UpdateHotel(ctx context.Context, in *UpdateHotelRequest, opts ...grpc.CallOption) (*UpdateHotelResponse, error)
-	DeleteHotel(ctx context.Context, in *DeleteHotelRequest, opts ...grpc.CallOption) (*DeleteHotelResponse, error)
-	FindHotelsByName(ctx context.Context, in *FindHotelsByNameRequest, opts ...grpc.CallOption) (*FindHotelsByNameResponse, error)
-	ListHotelsByLocation(ctx context.Context, in *ListHotelsByLocationRequest, opts ...grpc.CallOption) (*ListHotelsByLocationResponse, error)
-	// FAVOURITES
-	AddToFavourites(ctx context.Context, in *AddToFavouritesRequest, opts ...grpc.CallOption) (*AddToFavouritesResponse, error)
-	RemoveFromFavourites(ctx context.Context, in *RemoveFromFavouritesRequest, opts ...grpc.CallOption) (*RemoveFromFavouritesResponse, error)
-	ListFavouritesByUserId(ctx context.Context, in *ListFavouritesByUserIdRequest, opts ...grpc.CallOption) (*ListFavouritesByUserIdResponse, error)
-	// REVIEW
-	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error)
-	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
-	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error)
-	// MEDIA
-	CreateMedia(ctx context.Context, in *Image, opts ...grpc.CallOption) (*CreateImageRes, error)
+func (m *CancellationPolicy) Reset()         { *m = CancellationPolicy{} }
+func (m *CancellationPolicy) String() string { return proto.CompactTextString(m) }
+func (*CancellationPolicy) ProtoMessage()    {}
+func (*CancellationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{58}
 }
-
-type establishmentServiceClient struct {
-	cc *grpc.ClientConn
+func (m *CancellationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewEstablishmentServiceClient(cc *grpc.ClientConn) EstablishmentServiceClient {
-	return &establishmentServiceClient{cc}
+func (m *CancellationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancellationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancellationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancellationPolicy.Merge(m, src)
+}
+func (m *CancellationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancellationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancellationPolicy.DiscardUnknown(m)
 }
 
-func (c *establishmentServiceClient) CreateAttraction(ctx context.Context, in *Attraction, opts ...grpc.CallOption) (*Attraction, error) {
-	out := new(Attraction)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateAttraction", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CancellationPolicy proto.InternalMessageInfo
+
+func (m *CancellationPolicy) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) GetAttraction(ctx context.Context, in *GetAttractionRequest, opts ...grpc.CallOption) (*GetAttractionResponse, error) {
-	out := new(GetAttractionResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/GetAttraction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CancellationPolicy) GetRules() []*CancellationRule {
+	if m != nil {
+		return m.Rules
 	}
-	return out, nil
+	return nil
 }
 
-func (c *establishmentServiceClient) ListAttractions(ctx context.Context, in *ListAttractionsRequest, opts ...grpc.CallOption) (*ListAttractionsResponse, error) {
-	out := new(ListAttractionsResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListAttractions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CancellationPolicy) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) UpdateAttraction(ctx context.Context, in *UpdateAttractionRequest, opts ...grpc.CallOption) (*UpdateAttractionResponse, error) {
-	out := new(UpdateAttractionResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateAttraction", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetCancellationPolicyRequest struct {
+	EstablishmentId      string   `protobuf:"bytes,1,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *establishmentServiceClient) DeleteAttraction(ctx context.Context, in *DeleteAttractionRequest, opts ...grpc.CallOption) (*DeleteAttractionResponse, error) {
-	out := new(DeleteAttractionResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteAttraction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetCancellationPolicyRequest) Reset()         { *m = GetCancellationPolicyRequest{} }
+func (m *GetCancellationPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCancellationPolicyRequest) ProtoMessage()    {}
+func (*GetCancellationPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{59}
+}
+func (m *GetCancellationPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCancellationPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCancellationPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetCancellationPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCancellationPolicyRequest.Merge(m, src)
+}
+func (m *GetCancellationPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCancellationPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCancellationPolicyRequest.DiscardUnknown(m)
 }
 
-func (c *establishmentServiceClient) FindAttractionsByName(ctx context.Context, in *FindAttractionsByNameRequest, opts ...grpc.CallOption) (*FindAttractionsByNameResponse, error) {
-	out := new(FindAttractionsByNameResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/FindAttractionsByName", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetCancellationPolicyRequest proto.InternalMessageInfo
+
+func (m *GetCancellationPolicyRequest) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) ListAttractionsByLocation(ctx context.Context, in *ListAttractionsByLocationRequest, opts ...grpc.CallOption) (*ListAttractionsByLocationResponse, error) {
-	out := new(ListAttractionsByLocationResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListAttractionsByLocation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DeleteCancellationPolicyRequest struct {
+	EstablishmentId      string   `protobuf:"bytes,1,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *establishmentServiceClient) CreateRestaurant(ctx context.Context, in *Restaurant, opts ...grpc.CallOption) (*Restaurant, error) {
-	out := new(Restaurant)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateRestaurant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteCancellationPolicyRequest) Reset()         { *m = DeleteCancellationPolicyRequest{} }
+func (m *DeleteCancellationPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCancellationPolicyRequest) ProtoMessage()    {}
+func (*DeleteCancellationPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{60}
+}
+func (m *DeleteCancellationPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteCancellationPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCancellationPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DeleteCancellationPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCancellationPolicyRequest.Merge(m, src)
+}
+func (m *DeleteCancellationPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCancellationPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCancellationPolicyRequest.DiscardUnknown(m)
 }
 
-func (c *establishmentServiceClient) GetRestaurant(ctx context.Context, in *GetRestaurantRequest, opts ...grpc.CallOption) (*GetRestaurantResponse, error) {
-	out := new(GetRestaurantResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/GetRestaurant", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteCancellationPolicyRequest proto.InternalMessageInfo
+
+func (m *DeleteCancellationPolicyRequest) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) ListRestaurants(ctx context.Context, in *ListRestaurantsRequest, opts ...grpc.CallOption) (*ListRestaurantsResponse, error) {
-	out := new(ListRestaurantsResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListRestaurants", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DeleteCancellationPolicyResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *establishmentServiceClient) UpdateRestaurant(ctx context.Context, in *UpdateRestaurantRequest, opts ...grpc.CallOption) (*UpdateRestaurantResponse, error) {
-	out := new(UpdateRestaurantResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateRestaurant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DeleteCancellationPolicyResponse) Reset()         { *m = DeleteCancellationPolicyResponse{} }
+func (m *DeleteCancellationPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteCancellationPolicyResponse) ProtoMessage()    {}
+func (*DeleteCancellationPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{61}
 }
-
-func (c *establishmentServiceClient) DeleteRestaurant(ctx context.Context, in *DeleteRestaurantRequest, opts ...grpc.CallOption) (*DeleteRestaurantResponse, error) {
-	out := new(DeleteRestaurantResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteRestaurant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DeleteCancellationPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *establishmentServiceClient) FindRestaurantsByName(ctx context.Context, in *FindRestaurantsByNameRequest, opts ...grpc.CallOption) (*FindRestaurantsByNameResponse, error) {
-	out := new(FindRestaurantsByNameResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/FindRestaurantsByName", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteCancellationPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteCancellationPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *establishmentServiceClient) ListRestaurantsByLocation(ctx context.Context, in *ListRestaurantsByLocationRequest, opts ...grpc.CallOption) (*ListRestaurantsByLocationResponse, error) {
-	out := new(ListRestaurantsByLocationResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListRestaurantsByLocation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DeleteCancellationPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCancellationPolicyResponse.Merge(m, src)
 }
-
-func (c *establishmentServiceClient) CreateHotel(ctx context.Context, in *Hotel, opts ...grpc.CallOption) (*Hotel, error) {
-	out := new(Hotel)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateHotel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DeleteCancellationPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteCancellationPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCancellationPolicyResponse.DiscardUnknown(m)
 }
 
-func (c *establishmentServiceClient) GetHotel(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*GetHotelResponse, error) {
-	out := new(GetHotelResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/GetHotel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteCancellationPolicyResponse proto.InternalMessageInfo
+
+func (m *DeleteCancellationPolicyResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return out, nil
+	return false
 }
 
-func (c *establishmentServiceClient) ListHotels(ctx context.Context, in *ListHotelsRequest, opts ...grpc.CallOption) (*ListHotelsResponse, error) {
-	out := new(ListHotelsResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListHotels", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Favourite struct {
+	FavouriteId          string   `protobuf:"bytes,1,opt,name=favourite_id,json=favouriteId,proto3" json:"favourite_id"`
+	EstablishmentId      string   `protobuf:"bytes,2,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	CreatedAt            string   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string   `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *establishmentServiceClient) UpdateHotel(ctx context.Context, in *UpdateHotelRequest, opts ...grpc.CallOption) (*UpdateHotelResponse, error) {
-	out := new(UpdateHotelResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateHotel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Favourite) Reset()         { *m = Favourite{} }
+func (m *Favourite) String() string { return proto.CompactTextString(m) }
+func (*Favourite) ProtoMessage()    {}
+func (*Favourite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{62}
+}
+func (m *Favourite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Favourite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Favourite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Favourite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Favourite.Merge(m, src)
+}
+func (m *Favourite) XXX_Size() int {
+	return m.Size()
+}
+func (m *Favourite) XXX_DiscardUnknown() {
+	xxx_messageInfo_Favourite.DiscardUnknown(m)
 }
 
-func (c *establishmentServiceClient) DeleteHotel(ctx context.Context, in *DeleteHotelRequest, opts ...grpc.CallOption) (*DeleteHotelResponse, error) {
-	out := new(DeleteHotelResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteHotel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Favourite proto.InternalMessageInfo
+
+func (m *Favourite) GetFavouriteId() string {
+	if m != nil {
+		return m.FavouriteId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) FindHotelsByName(ctx context.Context, in *FindHotelsByNameRequest, opts ...grpc.CallOption) (*FindHotelsByNameResponse, error) {
-	out := new(FindHotelsByNameResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/FindHotelsByName", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Favourite) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) ListHotelsByLocation(ctx context.Context, in *ListHotelsByLocationRequest, opts ...grpc.CallOption) (*ListHotelsByLocationResponse, error) {
-	out := new(ListHotelsByLocationResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListHotelsByLocation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Favourite) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) AddToFavourites(ctx context.Context, in *AddToFavouritesRequest, opts ...grpc.CallOption) (*AddToFavouritesResponse, error) {
-	out := new(AddToFavouritesResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/AddToFavourites", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Favourite) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) RemoveFromFavourites(ctx context.Context, in *RemoveFromFavouritesRequest, opts ...grpc.CallOption) (*RemoveFromFavouritesResponse, error) {
-	out := new(RemoveFromFavouritesResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/RemoveFromFavourites", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Favourite) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) ListFavouritesByUserId(ctx context.Context, in *ListFavouritesByUserIdRequest, opts ...grpc.CallOption) (*ListFavouritesByUserIdResponse, error) {
-	out := new(ListFavouritesByUserIdResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListFavouritesByUserId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Favourite) GetDeletedAt() string {
+	if m != nil {
+		return m.DeletedAt
 	}
-	return out, nil
+	return ""
 }
 
-func (c *establishmentServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error) {
-	out := new(CreateReviewResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateReview", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AddToFavouritesRequest struct {
+	Favourite            *Favourite `protobuf:"bytes,1,opt,name=favourite,proto3" json:"favourite"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (c *establishmentServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
-	out := new(ListReviewsResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListReviews", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddToFavouritesRequest) Reset()         { *m = AddToFavouritesRequest{} }
+func (m *AddToFavouritesRequest) String() string { return proto.CompactTextString(m) }
+func (*AddToFavouritesRequest) ProtoMessage()    {}
+func (*AddToFavouritesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{63}
+}
+func (m *AddToFavouritesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToFavouritesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToFavouritesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AddToFavouritesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToFavouritesRequest.Merge(m, src)
+}
+func (m *AddToFavouritesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToFavouritesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToFavouritesRequest.DiscardUnknown(m)
 }
 
-func (c *establishmentServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error) {
-	out := new(DeleteReviewResponse)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteReview", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddToFavouritesRequest proto.InternalMessageInfo
+
+func (m *AddToFavouritesRequest) GetFavourite() *Favourite {
+	if m != nil {
+		return m.Favourite
 	}
-	return out, nil
+	return nil
 }
 
-func (c *establishmentServiceClient) CreateMedia(ctx context.Context, in *Image, opts ...grpc.CallOption) (*CreateImageRes, error) {
-	out := new(CreateImageRes)
-	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateMedia", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type AddToFavouritesResponse struct {
+	Favourite            *Favourite `protobuf:"bytes,1,opt,name=favourite,proto3" json:"favourite"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-// EstablishmentServiceServer is the server API for EstablishmentService service.
-type EstablishmentServiceServer interface {
-	// ATTRACTION
-	CreateAttraction(context.Context, *Attraction) (*Attraction, error)
-	GetAttraction(context.Context, *GetAttractionRequest) (*GetAttractionResponse, error)
-	ListAttractions(context.Context, *ListAttractionsRequest) (*ListAttractionsResponse, error)
-	UpdateAttraction(context.Context, *UpdateAttractionRequest) (*UpdateAttractionResponse, error)
-	DeleteAttraction(context.Context, *DeleteAttractionRequest) (*DeleteAttractionResponse, error)
-	FindAttractionsByName(context.Context, *FindAttractionsByNameRequest) (*FindAttractionsByNameResponse, error)
-	ListAttractionsByLocation(context.Context, *ListAttractionsByLocationRequest) (*ListAttractionsByLocationResponse, error)
-	// RESTAURANT
-	CreateRestaurant(context.Context, *Restaurant) (*Restaurant, error)
-	GetRestaurant(context.Context, *GetRestaurantRequest) (*GetRestaurantResponse, error)
-	ListRestaurants(context.Context, *ListRestaurantsRequest) (*ListRestaurantsResponse, error)
-	UpdateRestaurant(context.Context, *UpdateRestaurantRequest) (*UpdateRestaurantResponse, error)
-	DeleteRestaurant(context.Context, *DeleteRestaurantRequest) (*DeleteRestaurantResponse, error)
-	FindRestaurantsByName(context.Context, *FindRestaurantsByNameRequest) (*FindRestaurantsByNameResponse, error)
-	ListRestaurantsByLocation(context.Context, *ListRestaurantsByLocationRequest) (*ListRestaurantsByLocationResponse, error)
-	// HOTEL
-	CreateHotel(context.Context, *Hotel) (*Hotel, error)
-	GetHotel(context.Context, *GetHotelRequest) (*GetHotelResponse, error)
-	ListHotels(context.Context, *ListHotelsRequest) (*ListHotelsResponse, error)
-	UpdateHotel(context.Context, *UpdateHotelRequest) (*UpdateHotelResponse, error)
-	DeleteHotel(context.Context, *DeleteHotelRequest) (*DeleteHotelResponse, error)
-	FindHotelsByName(context.Context, *FindHotelsByNameRequest) (*FindHotelsByNameResponse, error)
-	ListHotelsByLocation(context.Context, *ListHotelsByLocationRequest) (*ListHotelsByLocationResponse, error)
-	// FAVOURITES
-	AddToFavourites(context.Context, *AddToFavouritesRequest) (*AddToFavouritesResponse, error)
-	RemoveFromFavourites(context.Context, *RemoveFromFavouritesRequest) (*RemoveFromFavouritesResponse, error)
-	ListFavouritesByUserId(context.Context, *ListFavouritesByUserIdRequest) (*ListFavouritesByUserIdResponse, error)
-	// REVIEW
-	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error)
-	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
-	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
-	// MEDIA
-	CreateMedia(context.Context, *Image) (*CreateImageRes, error)
+func (m *AddToFavouritesResponse) Reset()         { *m = AddToFavouritesResponse{} }
+func (m *AddToFavouritesResponse) String() string { return proto.CompactTextString(m) }
+func (*AddToFavouritesResponse) ProtoMessage()    {}
+func (*AddToFavouritesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{64}
 }
-
-// UnimplementedEstablishmentServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedEstablishmentServiceServer struct {
+func (m *AddToFavouritesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedEstablishmentServiceServer) CreateAttraction(ctx context.Context, req *Attraction) (*Attraction, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAttraction not implemented")
+func (m *AddToFavouritesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToFavouritesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedEstablishmentServiceServer) GetAttraction(ctx context.Context, req *GetAttractionRequest) (*GetAttractionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAttraction not implemented")
+func (m *AddToFavouritesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToFavouritesResponse.Merge(m, src)
 }
-func (*UnimplementedEstablishmentServiceServer) ListAttractions(ctx context.Context, req *ListAttractionsRequest) (*ListAttractionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAttractions not implemented")
+func (m *AddToFavouritesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedEstablishmentServiceServer) UpdateAttraction(ctx context.Context, req *UpdateAttractionRequest) (*UpdateAttractionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttraction not implemented")
+func (m *AddToFavouritesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToFavouritesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedEstablishmentServiceServer) DeleteAttraction(ctx context.Context, req *DeleteAttractionRequest) (*DeleteAttractionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttraction not implemented")
+
+var xxx_messageInfo_AddToFavouritesResponse proto.InternalMessageInfo
+
+func (m *AddToFavouritesResponse) GetFavourite() *Favourite {
+	if m != nil {
+		return m.Favourite
+	}
+	return nil
 }
-func (*UnimplementedEstablishmentServiceServer) FindAttractionsByName(ctx context.Context, req *FindAttractionsByNameRequest) (*FindAttractionsByNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindAttractionsByName not implemented")
+
+type RemoveFromFavouritesRequest struct {
+	FavouriteId          string   `protobuf:"bytes,1,opt,name=favourite_id,json=favouriteId,proto3" json:"favourite_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedEstablishmentServiceServer) ListAttractionsByLocation(ctx context.Context, req *ListAttractionsByLocationRequest) (*ListAttractionsByLocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAttractionsByLocation not implemented")
+
+func (m *RemoveFromFavouritesRequest) Reset()         { *m = RemoveFromFavouritesRequest{} }
+func (m *RemoveFromFavouritesRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromFavouritesRequest) ProtoMessage()    {}
+func (*RemoveFromFavouritesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{65}
 }
-func (*UnimplementedEstablishmentServiceServer) CreateRestaurant(ctx context.Context, req *Restaurant) (*Restaurant, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRestaurant not implemented")
+func (m *RemoveFromFavouritesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedEstablishmentServiceServer) GetRestaurant(ctx context.Context, req *GetRestaurantRequest) (*GetRestaurantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRestaurant not implemented")
+func (m *RemoveFromFavouritesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromFavouritesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedEstablishmentServiceServer) ListRestaurants(ctx context.Context, req *ListRestaurantsRequest) (*ListRestaurantsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRestaurants not implemented")
+func (m *RemoveFromFavouritesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromFavouritesRequest.Merge(m, src)
 }
-func (*UnimplementedEstablishmentServiceServer) UpdateRestaurant(ctx context.Context, req *UpdateRestaurantRequest) (*UpdateRestaurantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRestaurant not implemented")
+func (m *RemoveFromFavouritesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedEstablishmentServiceServer) DeleteRestaurant(ctx context.Context, req *DeleteRestaurantRequest) (*DeleteRestaurantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRestaurant not implemented")
+func (m *RemoveFromFavouritesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromFavouritesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedEstablishmentServiceServer) FindRestaurantsByName(ctx context.Context, req *FindRestaurantsByNameRequest) (*FindRestaurantsByNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindRestaurantsByName not implemented")
+
+var xxx_messageInfo_RemoveFromFavouritesRequest proto.InternalMessageInfo
+
+func (m *RemoveFromFavouritesRequest) GetFavouriteId() string {
+	if m != nil {
+		return m.FavouriteId
+	}
+	return ""
 }
-func (*UnimplementedEstablishmentServiceServer) ListRestaurantsByLocation(ctx context.Context, req *ListRestaurantsByLocationRequest) (*ListRestaurantsByLocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListRestaurantsByLocation not implemented")
+
+type RemoveFromFavouritesResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedEstablishmentServiceServer) CreateHotel(ctx context.Context, req *Hotel) (*Hotel, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateHotel not implemented")
+
+func (m *RemoveFromFavouritesResponse) Reset()         { *m = RemoveFromFavouritesResponse{} }
+func (m *RemoveFromFavouritesResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromFavouritesResponse) ProtoMessage()    {}
+func (*RemoveFromFavouritesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{66}
 }
-func (*UnimplementedEstablishmentServiceServer) GetHotel(ctx context.Context, req *GetHotelRequest) (*GetHotelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHotel not implemented")
+func (m *RemoveFromFavouritesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedEstablishmentServiceServer) ListHotels(ctx context.Context, req *ListHotelsRequest) (*ListHotelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHotels not implemented")
+func (m *RemoveFromFavouritesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveFromFavouritesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedEstablishmentServiceServer) UpdateHotel(ctx context.Context, req *UpdateHotelRequest) (*UpdateHotelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHotel not implemented")
+func (m *RemoveFromFavouritesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromFavouritesResponse.Merge(m, src)
 }
-func (*UnimplementedEstablishmentServiceServer) DeleteHotel(ctx context.Context, req *DeleteHotelRequest) (*DeleteHotelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteHotel not implemented")
+func (m *RemoveFromFavouritesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedEstablishmentServiceServer) FindHotelsByName(ctx context.Context, req *FindHotelsByNameRequest) (*FindHotelsByNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindHotelsByName not implemented")
+func (m *RemoveFromFavouritesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromFavouritesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedEstablishmentServiceServer) ListHotelsByLocation(ctx context.Context, req *ListHotelsByLocationRequest) (*ListHotelsByLocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHotelsByLocation not implemented")
+
+var xxx_messageInfo_RemoveFromFavouritesResponse proto.InternalMessageInfo
+
+func (m *RemoveFromFavouritesResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
-func (*UnimplementedEstablishmentServiceServer) AddToFavourites(ctx context.Context, req *AddToFavouritesRequest) (*AddToFavouritesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddToFavourites not implemented")
+
+type ListFavouritesByUserIdRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedEstablishmentServiceServer) RemoveFromFavourites(ctx context.Context, req *RemoveFromFavouritesRequest) (*RemoveFromFavouritesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromFavourites not implemented")
+
+func (m *ListFavouritesByUserIdRequest) Reset()         { *m = ListFavouritesByUserIdRequest{} }
+func (m *ListFavouritesByUserIdRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFavouritesByUserIdRequest) ProtoMessage()    {}
+func (*ListFavouritesByUserIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{67}
 }
-func (*UnimplementedEstablishmentServiceServer) ListFavouritesByUserId(ctx context.Context, req *ListFavouritesByUserIdRequest) (*ListFavouritesByUserIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListFavouritesByUserId not implemented")
+func (m *ListFavouritesByUserIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedEstablishmentServiceServer) CreateReview(ctx context.Context, req *CreateReviewRequest) (*CreateReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+func (m *ListFavouritesByUserIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListFavouritesByUserIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedEstablishmentServiceServer) ListReviews(ctx context.Context, req *ListReviewsRequest) (*ListReviewsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+func (m *ListFavouritesByUserIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFavouritesByUserIdRequest.Merge(m, src)
 }
-func (*UnimplementedEstablishmentServiceServer) DeleteReview(ctx context.Context, req *DeleteReviewRequest) (*DeleteReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+func (m *ListFavouritesByUserIdRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedEstablishmentServiceServer) CreateMedia(ctx context.Context, req *Image) (*CreateImageRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMedia not implemented")
+func (m *ListFavouritesByUserIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFavouritesByUserIdRequest.DiscardUnknown(m)
 }
 
-func RegisterEstablishmentServiceServer(s *grpc.Server, srv EstablishmentServiceServer) {
-	s.RegisterService(&_EstablishmentService_serviceDesc, srv)
-}
+var xxx_messageInfo_ListFavouritesByUserIdRequest proto.InternalMessageInfo
 
-func _EstablishmentService_CreateAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Attraction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).CreateAttraction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/CreateAttraction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).CreateAttraction(ctx, req.(*Attraction))
+func (m *ListFavouritesByUserIdRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EstablishmentService_GetAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAttractionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).GetAttraction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/GetAttraction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).GetAttraction(ctx, req.(*GetAttractionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ListFavouritesByUserIdResponse struct {
+	Favourites           []*Favourite `protobuf:"bytes,1,rep,name=favourites,proto3" json:"favourites"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func _EstablishmentService_ListAttractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAttractionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).ListAttractions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/ListAttractions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).ListAttractions(ctx, req.(*ListAttractionsRequest))
+func (m *ListFavouritesByUserIdResponse) Reset()         { *m = ListFavouritesByUserIdResponse{} }
+func (m *ListFavouritesByUserIdResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFavouritesByUserIdResponse) ProtoMessage()    {}
+func (*ListFavouritesByUserIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{68}
+}
+func (m *ListFavouritesByUserIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListFavouritesByUserIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListFavouritesByUserIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ListFavouritesByUserIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFavouritesByUserIdResponse.Merge(m, src)
+}
+func (m *ListFavouritesByUserIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListFavouritesByUserIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFavouritesByUserIdResponse.DiscardUnknown(m)
 }
 
-func _EstablishmentService_UpdateAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAttractionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).UpdateAttraction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/UpdateAttraction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).UpdateAttraction(ctx, req.(*UpdateAttractionRequest))
+var xxx_messageInfo_ListFavouritesByUserIdResponse proto.InternalMessageInfo
+
+func (m *ListFavouritesByUserIdResponse) GetFavourites() []*Favourite {
+	if m != nil {
+		return m.Favourites
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _EstablishmentService_DeleteAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAttractionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).DeleteAttraction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/DeleteAttraction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).DeleteAttraction(ctx, req.(*DeleteAttractionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type Review struct {
+	ReviewId             string   `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id"`
+	EstablishmentId      string   `protobuf:"bytes,2,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	UserId               string   `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Rating               float32  `protobuf:"fixed32,4,opt,name=rating,proto3" json:"rating"`
+	Comment              string   `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment"`
+	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string   `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _EstablishmentService_FindAttractionsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindAttractionsByNameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).FindAttractionsByName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/FindAttractionsByName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).FindAttractionsByName(ctx, req.(*FindAttractionsByNameRequest))
+func (m *Review) Reset()         { *m = Review{} }
+func (m *Review) String() string { return proto.CompactTextString(m) }
+func (*Review) ProtoMessage()    {}
+func (*Review) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{69}
+}
+func (m *Review) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Review) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Review.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Review) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Review.Merge(m, src)
+}
+func (m *Review) XXX_Size() int {
+	return m.Size()
+}
+func (m *Review) XXX_DiscardUnknown() {
+	xxx_messageInfo_Review.DiscardUnknown(m)
 }
 
-func _EstablishmentService_ListAttractionsByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAttractionsByLocationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).ListAttractionsByLocation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/ListAttractionsByLocation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).ListAttractionsByLocation(ctx, req.(*ListAttractionsByLocationRequest))
+var xxx_messageInfo_Review proto.InternalMessageInfo
+
+func (m *Review) GetReviewId() string {
+	if m != nil {
+		return m.ReviewId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EstablishmentService_CreateRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Restaurant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).CreateRestaurant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/CreateRestaurant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).CreateRestaurant(ctx, req.(*Restaurant))
+func (m *Review) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EstablishmentService_GetRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRestaurantRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).GetRestaurant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/GetRestaurant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).GetRestaurant(ctx, req.(*GetRestaurantRequest))
+func (m *Review) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EstablishmentService_ListRestaurants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRestaurantsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).ListRestaurants(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/ListRestaurants",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).ListRestaurants(ctx, req.(*ListRestaurantsRequest))
+func (m *Review) GetRating() float32 {
+	if m != nil {
+		return m.Rating
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _EstablishmentService_UpdateRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRestaurantRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Review) GetComment() string {
+	if m != nil {
+		return m.Comment
 	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).UpdateRestaurant(ctx, in)
+	return ""
+}
+
+func (m *Review) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/UpdateRestaurant",
+	return ""
+}
+
+func (m *Review) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).UpdateRestaurant(ctx, req.(*UpdateRestaurantRequest))
+	return ""
+}
+
+func (m *Review) GetDeletedAt() string {
+	if m != nil {
+		return m.DeletedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EstablishmentService_DeleteRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRestaurantRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).DeleteRestaurant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/DeleteRestaurant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).DeleteRestaurant(ctx, req.(*DeleteRestaurantRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type CreateReviewRequest struct {
+	Review               *Review  `protobuf:"bytes,1,opt,name=review,proto3" json:"review"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _EstablishmentService_FindRestaurantsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindRestaurantsByNameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).FindRestaurantsByName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/FindRestaurantsByName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).FindRestaurantsByName(ctx, req.(*FindRestaurantsByNameRequest))
+func (m *CreateReviewRequest) Reset()         { *m = CreateReviewRequest{} }
+func (m *CreateReviewRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateReviewRequest) ProtoMessage()    {}
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{70}
+}
+func (m *CreateReviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateReviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CreateReviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReviewRequest.Merge(m, src)
+}
+func (m *CreateReviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateReviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReviewRequest.DiscardUnknown(m)
 }
 
-func _EstablishmentService_ListRestaurantsByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRestaurantsByLocationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).ListRestaurantsByLocation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/ListRestaurantsByLocation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).ListRestaurantsByLocation(ctx, req.(*ListRestaurantsByLocationRequest))
+var xxx_messageInfo_CreateReviewRequest proto.InternalMessageInfo
+
+func (m *CreateReviewRequest) GetReview() *Review {
+	if m != nil {
+		return m.Review
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _EstablishmentService_CreateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Hotel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).CreateHotel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/CreateHotel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).CreateHotel(ctx, req.(*Hotel))
-	}
-	return interceptor(ctx, in, info, handler)
+type CreateReviewResponse struct {
+	Review               *Review  `protobuf:"bytes,1,opt,name=review,proto3" json:"review"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _EstablishmentService_GetHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHotelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).GetHotel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/GetHotel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).GetHotel(ctx, req.(*GetHotelRequest))
+func (m *CreateReviewResponse) Reset()         { *m = CreateReviewResponse{} }
+func (m *CreateReviewResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateReviewResponse) ProtoMessage()    {}
+func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{71}
+}
+func (m *CreateReviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateReviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CreateReviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateReviewResponse.Merge(m, src)
+}
+func (m *CreateReviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateReviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateReviewResponse.DiscardUnknown(m)
 }
 
-func _EstablishmentService_ListHotels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHotelsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).ListHotels(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/ListHotels",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).ListHotels(ctx, req.(*ListHotelsRequest))
+var xxx_messageInfo_CreateReviewResponse proto.InternalMessageInfo
+
+func (m *CreateReviewResponse) GetReview() *Review {
+	if m != nil {
+		return m.Review
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _EstablishmentService_UpdateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHotelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).UpdateHotel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/UpdateHotel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).UpdateHotel(ctx, req.(*UpdateHotelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ListReviewsRequest struct {
+	EstablishmentId      string   `protobuf:"bytes,1,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _EstablishmentService_DeleteHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHotelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).DeleteHotel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/DeleteHotel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).DeleteHotel(ctx, req.(*DeleteHotelRequest))
+func (m *ListReviewsRequest) Reset()         { *m = ListReviewsRequest{} }
+func (m *ListReviewsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReviewsRequest) ProtoMessage()    {}
+func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{72}
+}
+func (m *ListReviewsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListReviewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListReviewsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ListReviewsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReviewsRequest.Merge(m, src)
+}
+func (m *ListReviewsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListReviewsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReviewsRequest.DiscardUnknown(m)
 }
 
-func _EstablishmentService_FindHotelsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindHotelsByNameRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).FindHotelsByName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/FindHotelsByName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).FindHotelsByName(ctx, req.(*FindHotelsByNameRequest))
+var xxx_messageInfo_ListReviewsRequest proto.InternalMessageInfo
+
+func (m *ListReviewsRequest) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EstablishmentService_ListHotelsByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHotelsByLocationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).ListHotelsByLocation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/ListHotelsByLocation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).ListHotelsByLocation(ctx, req.(*ListHotelsByLocationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ListReviewsResponse struct {
+	Reviews              []*Review `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews"`
+	Count                uint64    `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func _EstablishmentService_AddToFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddToFavouritesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).AddToFavourites(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/AddToFavourites",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).AddToFavourites(ctx, req.(*AddToFavouritesRequest))
+func (m *ListReviewsResponse) Reset()         { *m = ListReviewsResponse{} }
+func (m *ListReviewsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReviewsResponse) ProtoMessage()    {}
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{73}
+}
+func (m *ListReviewsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListReviewsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListReviewsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ListReviewsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReviewsResponse.Merge(m, src)
+}
+func (m *ListReviewsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListReviewsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReviewsResponse.DiscardUnknown(m)
 }
 
-func _EstablishmentService_RemoveFromFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveFromFavouritesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).RemoveFromFavourites(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/RemoveFromFavourites",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).RemoveFromFavourites(ctx, req.(*RemoveFromFavouritesRequest))
+var xxx_messageInfo_ListReviewsResponse proto.InternalMessageInfo
+
+func (m *ListReviewsResponse) GetReviews() []*Review {
+	if m != nil {
+		return m.Reviews
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _EstablishmentService_ListFavouritesByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListFavouritesByUserIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).ListFavouritesByUserId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/ListFavouritesByUserId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).ListFavouritesByUserId(ctx, req.(*ListFavouritesByUserIdRequest))
+func (m *ListReviewsResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _EstablishmentService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReviewRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).CreateReview(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/CreateReview",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DeleteReviewRequest struct {
+	ReviewId             string   `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _EstablishmentService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReviewsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).ListReviews(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/ListReviews",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
+func (m *DeleteReviewRequest) Reset()         { *m = DeleteReviewRequest{} }
+func (m *DeleteReviewRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteReviewRequest) ProtoMessage()    {}
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{74}
+}
+func (m *DeleteReviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteReviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DeleteReviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReviewRequest.Merge(m, src)
+}
+func (m *DeleteReviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteReviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReviewRequest.DiscardUnknown(m)
 }
 
-func _EstablishmentService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReviewRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).DeleteReview(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/DeleteReview",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+var xxx_messageInfo_DeleteReviewRequest proto.InternalMessageInfo
+
+func (m *DeleteReviewRequest) GetReviewId() string {
+	if m != nil {
+		return m.ReviewId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _EstablishmentService_CreateMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Image)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EstablishmentServiceServer).CreateMedia(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/establishment_service.EstablishmentService/CreateMedia",
+type DeleteReviewResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteReviewResponse) Reset()         { *m = DeleteReviewResponse{} }
+func (m *DeleteReviewResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteReviewResponse) ProtoMessage()    {}
+func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{75}
+}
+func (m *DeleteReviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteReviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EstablishmentServiceServer).CreateMedia(ctx, req.(*Image))
+}
+func (m *DeleteReviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteReviewResponse.Merge(m, src)
+}
+func (m *DeleteReviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteReviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteReviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteReviewResponse proto.InternalMessageInfo
+
+func (m *DeleteReviewResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _EstablishmentService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "establishment_service.EstablishmentService",
-	HandlerType: (*EstablishmentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateAttraction",
-			Handler:    _EstablishmentService_CreateAttraction_Handler,
-		},
-		{
-			MethodName: "GetAttraction",
-			Handler:    _EstablishmentService_GetAttraction_Handler,
-		},
-		{
-			MethodName: "ListAttractions",
-			Handler:    _EstablishmentService_ListAttractions_Handler,
-		},
-		{
-			MethodName: "UpdateAttraction",
-			Handler:    _EstablishmentService_UpdateAttraction_Handler,
-		},
-		{
-			MethodName: "DeleteAttraction",
-			Handler:    _EstablishmentService_DeleteAttraction_Handler,
-		},
-		{
-			MethodName: "FindAttractionsByName",
-			Handler:    _EstablishmentService_FindAttractionsByName_Handler,
-		},
-		{
-			MethodName: "ListAttractionsByLocation",
-			Handler:    _EstablishmentService_ListAttractionsByLocation_Handler,
-		},
-		{
-			MethodName: "CreateRestaurant",
-			Handler:    _EstablishmentService_CreateRestaurant_Handler,
-		},
-		{
-			MethodName: "GetRestaurant",
-			Handler:    _EstablishmentService_GetRestaurant_Handler,
-		},
-		{
-			MethodName: "ListRestaurants",
-			Handler:    _EstablishmentService_ListRestaurants_Handler,
-		},
-		{
-			MethodName: "UpdateRestaurant",
-			Handler:    _EstablishmentService_UpdateRestaurant_Handler,
-		},
-		{
-			MethodName: "DeleteRestaurant",
-			Handler:    _EstablishmentService_DeleteRestaurant_Handler,
-		},
-		{
-			MethodName: "FindRestaurantsByName",
-			Handler:    _EstablishmentService_FindRestaurantsByName_Handler,
-		},
-		{
-			MethodName: "ListRestaurantsByLocation",
-			Handler:    _EstablishmentService_ListRestaurantsByLocation_Handler,
-		},
-		{
-			MethodName: "CreateHotel",
-			Handler:    _EstablishmentService_CreateHotel_Handler,
-		},
-		{
-			MethodName: "GetHotel",
-			Handler:    _EstablishmentService_GetHotel_Handler,
-		},
-		{
-			MethodName: "ListHotels",
-			Handler:    _EstablishmentService_ListHotels_Handler,
-		},
-		{
-			MethodName: "UpdateHotel",
-			Handler:    _EstablishmentService_UpdateHotel_Handler,
-		},
-		{
-			MethodName: "DeleteHotel",
-			Handler:    _EstablishmentService_DeleteHotel_Handler,
-		},
-		{
-			MethodName: "FindHotelsByName",
-			Handler:    _EstablishmentService_FindHotelsByName_Handler,
-		},
-		{
-			MethodName: "ListHotelsByLocation",
-			Handler:    _EstablishmentService_ListHotelsByLocation_Handler,
-		},
-		{
-			MethodName: "AddToFavourites",
-			Handler:    _EstablishmentService_AddToFavourites_Handler,
-		},
-		{
-			MethodName: "RemoveFromFavourites",
-			Handler:    _EstablishmentService_RemoveFromFavourites_Handler,
-		},
-		{
-			MethodName: "ListFavouritesByUserId",
-			Handler:    _EstablishmentService_ListFavouritesByUserId_Handler,
-		},
-		{
-			MethodName: "CreateReview",
-			Handler:    _EstablishmentService_CreateReview_Handler,
-		},
-		{
-			MethodName: "ListReviews",
-			Handler:    _EstablishmentService_ListReviews_Handler,
-		},
-		{
-			MethodName: "DeleteReview",
-			Handler:    _EstablishmentService_DeleteReview_Handler,
-		},
-		{
-			MethodName: "CreateMedia",
-			Handler:    _EstablishmentService_CreateMedia_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "establishment-proto/establishment.proto",
+type CreateImageRes struct {
+	Result               string   `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Image) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CreateImageRes) Reset()         { *m = CreateImageRes{} }
+func (m *CreateImageRes) String() string { return proto.CompactTextString(m) }
+func (*CreateImageRes) ProtoMessage()    {}
+func (*CreateImageRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{76}
+}
+func (m *CreateImageRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateImageRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateImageRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Image) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CreateImageRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateImageRes.Merge(m, src)
+}
+func (m *CreateImageRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateImageRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateImageRes.DiscardUnknown(m)
 }
 
-func (m *Image) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Category) > 0 {
-		i -= len(m.Category)
-		copy(dAtA[i:], m.Category)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Category)))
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_CreateImageRes proto.InternalMessageInfo
+
+func (m *CreateImageRes) GetResult() string {
+	if m != nil {
+		return m.Result
 	}
-	if len(m.ImageUrl) > 0 {
-		i -= len(m.ImageUrl)
-		copy(dAtA[i:], m.ImageUrl)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ImageUrl)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+type BookingMode struct {
+	EstablishmentId      string   `protobuf:"bytes,1,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	Mode                 string   `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode"`
+	UpdatedAt            string   `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BookingMode) Reset()         { *m = BookingMode{} }
+func (m *BookingMode) String() string { return proto.CompactTextString(m) }
+func (*BookingMode) ProtoMessage()    {}
+func (*BookingMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{77}
+}
+func (m *BookingMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BookingMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BookingMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.EstablishmentId) > 0 {
-		i -= len(m.EstablishmentId)
-		copy(dAtA[i:], m.EstablishmentId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *BookingMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BookingMode.Merge(m, src)
+}
+func (m *BookingMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *BookingMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_BookingMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BookingMode proto.InternalMessageInfo
+
+func (m *BookingMode) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	if len(m.ImageId) > 0 {
-		i -= len(m.ImageId)
-		copy(dAtA[i:], m.ImageId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ImageId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *BookingMode) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Location) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BookingMode) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Location) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetBookingModeRequest struct {
+	EstablishmentId      string   `protobuf:"bytes,1,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x62
+func (m *GetBookingModeRequest) Reset()         { *m = GetBookingModeRequest{} }
+func (m *GetBookingModeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBookingModeRequest) ProtoMessage()    {}
+func (*GetBookingModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{78}
+}
+func (m *GetBookingModeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBookingModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBookingModeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x5a
+}
+func (m *GetBookingModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBookingModeRequest.Merge(m, src)
+}
+func (m *GetBookingModeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBookingModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBookingModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBookingModeRequest proto.InternalMessageInfo
+
+func (m *GetBookingModeRequest) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x52
+	return ""
+}
+
+// ADD-ON
+type AddOn struct {
+	AddOnId              string   `protobuf:"bytes,1,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id"`
+	EstablishmentId      string   `protobuf:"bytes,2,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	Price                float64  `protobuf:"fixed64,5,opt,name=price,proto3" json:"price"`
+	CreatedAt            string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string   `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddOn) Reset()         { *m = AddOn{} }
+func (m *AddOn) String() string { return proto.CompactTextString(m) }
+func (*AddOn) ProtoMessage()    {}
+func (*AddOn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{79}
+}
+func (m *AddOn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddOn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddOn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Category) > 0 {
-		i -= len(m.Category)
-		copy(dAtA[i:], m.Category)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Category)))
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *AddOn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddOn.Merge(m, src)
+}
+func (m *AddOn) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddOn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddOn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddOn proto.InternalMessageInfo
+
+func (m *AddOn) GetAddOnId() string {
+	if m != nil {
+		return m.AddOnId
 	}
-	if len(m.StateProvince) > 0 {
-		i -= len(m.StateProvince)
-		copy(dAtA[i:], m.StateProvince)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.StateProvince)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *AddOn) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	if len(m.City) > 0 {
-		i -= len(m.City)
-		copy(dAtA[i:], m.City)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.City)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *AddOn) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *AddOn) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if m.Longitude != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Longitude))))
-		i--
-		dAtA[i] = 0x2d
+	return ""
+}
+
+func (m *AddOn) GetPrice() float64 {
+	if m != nil {
+		return m.Price
 	}
-	if m.Latitude != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Latitude))))
-		i--
-		dAtA[i] = 0x25
+	return 0
+}
+
+func (m *AddOn) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *AddOn) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if len(m.EstablishmentId) > 0 {
-		i -= len(m.EstablishmentId)
-		copy(dAtA[i:], m.EstablishmentId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *AddOn) GetDeletedAt() string {
+	if m != nil {
+		return m.DeletedAt
 	}
-	if len(m.LocationId) > 0 {
-		i -= len(m.LocationId)
-		copy(dAtA[i:], m.LocationId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.LocationId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+type ListAddOnsRequest struct {
+	EstablishmentId      string   `protobuf:"bytes,1,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAddOnsRequest) Reset()         { *m = ListAddOnsRequest{} }
+func (m *ListAddOnsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAddOnsRequest) ProtoMessage()    {}
+func (*ListAddOnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{80}
+}
+func (m *ListAddOnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAddOnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAddOnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListAddOnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAddOnsRequest.Merge(m, src)
+}
+func (m *ListAddOnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAddOnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAddOnsRequest.DiscardUnknown(m)
 }
 
-func (m *Attraction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListAddOnsRequest proto.InternalMessageInfo
+
+func (m *ListAddOnsRequest) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Attraction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListAddOnsResponse struct {
+	AddOns               []*AddOn `protobuf:"bytes,1,rep,name=add_ons,json=addOns,proto3" json:"add_ons"`
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Attraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ListAddOnsResponse) Reset()         { *m = ListAddOnsResponse{} }
+func (m *ListAddOnsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAddOnsResponse) ProtoMessage()    {}
+func (*ListAddOnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{81}
+}
+func (m *ListAddOnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAddOnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAddOnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x6a
+}
+func (m *ListAddOnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAddOnsResponse.Merge(m, src)
+}
+func (m *ListAddOnsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAddOnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAddOnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAddOnsResponse proto.InternalMessageInfo
+
+func (m *ListAddOnsResponse) GetAddOns() []*AddOn {
+	if m != nil {
+		return m.AddOns
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x62
+	return nil
+}
+
+func (m *ListAddOnsResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Location != nil {
-		{
-			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Images) > 0 {
-		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.WebsiteUrl) > 0 {
-		i -= len(m.WebsiteUrl)
-		copy(dAtA[i:], m.WebsiteUrl)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.WebsiteUrl)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.LicenceUrl) > 0 {
-		i -= len(m.LicenceUrl)
-		copy(dAtA[i:], m.LicenceUrl)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.LicenceUrl)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ContactNumber) > 0 {
-		i -= len(m.ContactNumber)
-		copy(dAtA[i:], m.ContactNumber)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ContactNumber)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Rating != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
-		i--
-		dAtA[i] = 0x2d
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AttractionName) > 0 {
-		i -= len(m.AttractionName)
-		copy(dAtA[i:], m.AttractionName)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OwnerId) > 0 {
-		i -= len(m.OwnerId)
-		copy(dAtA[i:], m.OwnerId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.OwnerId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AttractionId) > 0 {
-		i -= len(m.AttractionId)
-		copy(dAtA[i:], m.AttractionId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetAttractionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type UpdateAddOnRequest struct {
+	AddOn                *AddOn   `protobuf:"bytes,1,opt,name=add_on,json=addOn,proto3" json:"add_on"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAttractionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpdateAddOnRequest) Reset()         { *m = UpdateAddOnRequest{} }
+func (m *UpdateAddOnRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAddOnRequest) ProtoMessage()    {}
+func (*UpdateAddOnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{82}
 }
-
-func (m *GetAttractionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.AttractionId) > 0 {
-		i -= len(m.AttractionId)
-		copy(dAtA[i:], m.AttractionId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionId)))
-		i--
-		dAtA[i] = 0xa
+func (m *UpdateAddOnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateAddOnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateAddOnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateAddOnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAddOnRequest.Merge(m, src)
+}
+func (m *UpdateAddOnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateAddOnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAddOnRequest.DiscardUnknown(m)
 }
 
-func (m *GetAttractionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateAddOnRequest proto.InternalMessageInfo
+
+func (m *UpdateAddOnRequest) GetAddOn() *AddOn {
+	if m != nil {
+		return m.AddOn
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetAttractionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdateAddOnResponse struct {
+	AddOn                *AddOn   `protobuf:"bytes,1,opt,name=add_on,json=addOn,proto3" json:"add_on"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAttractionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Attraction != nil {
-		{
-			size, err := m.Attraction.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+func (m *UpdateAddOnResponse) Reset()         { *m = UpdateAddOnResponse{} }
+func (m *UpdateAddOnResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAddOnResponse) ProtoMessage()    {}
+func (*UpdateAddOnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{83}
+}
+func (m *UpdateAddOnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateAddOnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateAddOnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateAddOnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAddOnResponse.Merge(m, src)
+}
+func (m *UpdateAddOnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateAddOnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAddOnResponse.DiscardUnknown(m)
 }
 
-func (m *ListAttractionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateAddOnResponse proto.InternalMessageInfo
+
+func (m *UpdateAddOnResponse) GetAddOn() *AddOn {
+	if m != nil {
+		return m.AddOn
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListAttractionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteAddOnRequest struct {
+	AddOnId              string   `protobuf:"bytes,1,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListAttractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Limit != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Offset != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x8
+func (m *DeleteAddOnRequest) Reset()         { *m = DeleteAddOnRequest{} }
+func (m *DeleteAddOnRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAddOnRequest) ProtoMessage()    {}
+func (*DeleteAddOnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{84}
+}
+func (m *DeleteAddOnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteAddOnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteAddOnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeleteAddOnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAddOnRequest.Merge(m, src)
+}
+func (m *DeleteAddOnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteAddOnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAddOnRequest.DiscardUnknown(m)
 }
 
-func (m *ListAttractionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteAddOnRequest proto.InternalMessageInfo
+
+func (m *DeleteAddOnRequest) GetAddOnId() string {
+	if m != nil {
+		return m.AddOnId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListAttractionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteAddOnResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListAttractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Overall != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Overall))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Attractions) > 0 {
-		for iNdEx := len(m.Attractions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DeleteAddOnResponse) Reset()         { *m = DeleteAddOnResponse{} }
+func (m *DeleteAddOnResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAddOnResponse) ProtoMessage()    {}
+func (*DeleteAddOnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{85}
+}
+func (m *DeleteAddOnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteAddOnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteAddOnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeleteAddOnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAddOnResponse.Merge(m, src)
+}
+func (m *DeleteAddOnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteAddOnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAddOnResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateAttractionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteAddOnResponse proto.InternalMessageInfo
+
+func (m *DeleteAddOnResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *UpdateAttractionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CLOSURE
+type Closure struct {
+	ClosureId            string   `protobuf:"bytes,1,opt,name=closure_id,json=closureId,proto3" json:"closure_id"`
+	EstablishmentId      string   `protobuf:"bytes,2,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	StartDate            string   `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date"`
+	EndDate              string   `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	Weekdays             []string `protobuf:"bytes,5,rep,name=weekdays,proto3" json:"weekdays"`
+	Reason               string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason"`
+	CreatedAt            string   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt            string   `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt            string   `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateAttractionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Attraction != nil {
-		{
-			size, err := m.Attraction.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+func (m *Closure) Reset()         { *m = Closure{} }
+func (m *Closure) String() string { return proto.CompactTextString(m) }
+func (*Closure) ProtoMessage()    {}
+func (*Closure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{86}
+}
+func (m *Closure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Closure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Closure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Closure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Closure.Merge(m, src)
+}
+func (m *Closure) XXX_Size() int {
+	return m.Size()
+}
+func (m *Closure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Closure.DiscardUnknown(m)
 }
 
-func (m *UpdateAttractionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Closure proto.InternalMessageInfo
+
+func (m *Closure) GetClosureId() string {
+	if m != nil {
+		return m.ClosureId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateAttractionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Closure) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
+	}
+	return ""
 }
 
-func (m *UpdateAttractionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Closure) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
 	}
-	if m.Attraction != nil {
-		{
-			size, err := m.Attraction.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Closure) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DeleteAttractionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Closure) GetWeekdays() []string {
+	if m != nil {
+		return m.Weekdays
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeleteAttractionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Closure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *DeleteAttractionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Closure) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.AttractionId) > 0 {
-		i -= len(m.AttractionId)
-		copy(dAtA[i:], m.AttractionId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Closure) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DeleteAttractionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Closure) GetDeletedAt() string {
+	if m != nil {
+		return m.DeletedAt
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DeleteAttractionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListClosuresRequest struct {
+	EstablishmentId      string   `protobuf:"bytes,1,opt,name=establishment_id,json=establishmentId,proto3" json:"establishment_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAttractionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ListClosuresRequest) Reset()         { *m = ListClosuresRequest{} }
+func (m *ListClosuresRequest) String() string { return proto.CompactTextString(m) }
+func (*ListClosuresRequest) ProtoMessage()    {}
+func (*ListClosuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{87}
+}
+func (m *ListClosuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListClosuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListClosuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListClosuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClosuresRequest.Merge(m, src)
+}
+func (m *ListClosuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListClosuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClosuresRequest.DiscardUnknown(m)
 }
 
-func (m *ListAttractionsByLocationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListClosuresRequest proto.InternalMessageInfo
+
+func (m *ListClosuresRequest) GetEstablishmentId() string {
+	if m != nil {
+		return m.EstablishmentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListAttractionsByLocationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListClosuresResponse struct {
+	Closures             []*Closure `protobuf:"bytes,1,rep,name=closures,proto3" json:"closures"`
+	Count                uint64     `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *ListAttractionsByLocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ListClosuresResponse) Reset()         { *m = ListClosuresResponse{} }
+func (m *ListClosuresResponse) String() string { return proto.CompactTextString(m) }
+func (*ListClosuresResponse) ProtoMessage()    {}
+func (*ListClosuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{88}
+}
+func (m *ListClosuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListClosuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListClosuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.StateProvince) > 0 {
-		i -= len(m.StateProvince)
-		copy(dAtA[i:], m.StateProvince)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.StateProvince)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.City) > 0 {
-		i -= len(m.City)
-		copy(dAtA[i:], m.City)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.City)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Limit != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Offset != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ListClosuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClosuresResponse.Merge(m, src)
+}
+func (m *ListClosuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListClosuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClosuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListClosuresResponse proto.InternalMessageInfo
+
+func (m *ListClosuresResponse) GetClosures() []*Closure {
+	if m != nil {
+		return m.Closures
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ListAttractionsByLocationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListClosuresResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ListAttractionsByLocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdateClosureRequest struct {
+	Closure              *Closure `protobuf:"bytes,1,opt,name=closure,proto3" json:"closure"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListAttractionsByLocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Attractions) > 0 {
-		for iNdEx := len(m.Attractions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *UpdateClosureRequest) Reset()         { *m = UpdateClosureRequest{} }
+func (m *UpdateClosureRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateClosureRequest) ProtoMessage()    {}
+func (*UpdateClosureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{89}
+}
+func (m *UpdateClosureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateClosureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateClosureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateClosureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateClosureRequest.Merge(m, src)
+}
+func (m *UpdateClosureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateClosureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateClosureRequest.DiscardUnknown(m)
 }
 
-func (m *FindAttractionsByNameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateClosureRequest proto.InternalMessageInfo
+
+func (m *UpdateClosureRequest) GetClosure() *Closure {
+	if m != nil {
+		return m.Closure
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *FindAttractionsByNameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdateClosureResponse struct {
+	Closure              *Closure `protobuf:"bytes,1,opt,name=closure,proto3" json:"closure"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindAttractionsByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *UpdateClosureResponse) Reset()         { *m = UpdateClosureResponse{} }
+func (m *UpdateClosureResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateClosureResponse) ProtoMessage()    {}
+func (*UpdateClosureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{90}
+}
+func (m *UpdateClosureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateClosureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateClosureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateClosureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateClosureResponse.Merge(m, src)
+}
+func (m *UpdateClosureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateClosureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateClosureResponse.DiscardUnknown(m)
 }
 
-func (m *FindAttractionsByNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateClosureResponse proto.InternalMessageInfo
+
+func (m *UpdateClosureResponse) GetClosure() *Closure {
+	if m != nil {
+		return m.Closure
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *FindAttractionsByNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteClosureRequest struct {
+	ClosureId            string   `protobuf:"bytes,1,opt,name=closure_id,json=closureId,proto3" json:"closure_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FindAttractionsByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Attractions) > 0 {
-		for iNdEx := len(m.Attractions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DeleteClosureRequest) Reset()         { *m = DeleteClosureRequest{} }
+func (m *DeleteClosureRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteClosureRequest) ProtoMessage()    {}
+func (*DeleteClosureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{91}
+}
+func (m *DeleteClosureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteClosureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteClosureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeleteClosureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteClosureRequest.Merge(m, src)
+}
+func (m *DeleteClosureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteClosureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteClosureRequest.DiscardUnknown(m)
 }
 
-func (m *Restaurant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteClosureRequest proto.InternalMessageInfo
+
+func (m *DeleteClosureRequest) GetClosureId() string {
+	if m != nil {
+		return m.ClosureId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Restaurant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DeleteClosureResponse struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Restaurant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x72
+func (m *DeleteClosureResponse) Reset()         { *m = DeleteClosureResponse{} }
+func (m *DeleteClosureResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteClosureResponse) ProtoMessage()    {}
+func (*DeleteClosureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f0074a4a4eb033, []int{92}
+}
+func (m *DeleteClosureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteClosureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteClosureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x6a
+}
+func (m *DeleteClosureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteClosureResponse.Merge(m, src)
+}
+func (m *DeleteClosureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteClosureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteClosureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteClosureResponse proto.InternalMessageInfo
+
+func (m *DeleteClosureResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.Location != nil {
-		{
-			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Images) > 0 {
-		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.WebsiteUrl) > 0 {
-		i -= len(m.WebsiteUrl)
-		copy(dAtA[i:], m.WebsiteUrl)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.WebsiteUrl)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.LicenceUrl) > 0 {
-		i -= len(m.LicenceUrl)
-		copy(dAtA[i:], m.LicenceUrl)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.LicenceUrl)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ContactNumber) > 0 {
-		i -= len(m.ContactNumber)
-		copy(dAtA[i:], m.ContactNumber)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ContactNumber)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.OpeningHours) > 0 {
-		i -= len(m.OpeningHours)
-		copy(dAtA[i:], m.OpeningHours)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.OpeningHours)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Rating != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
-		i--
-		dAtA[i] = 0x2d
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RestaurantName) > 0 {
-		i -= len(m.RestaurantName)
-		copy(dAtA[i:], m.RestaurantName)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RestaurantName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OwnerId) > 0 {
-		i -= len(m.OwnerId)
-		copy(dAtA[i:], m.OwnerId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.OwnerId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RestaurantId) > 0 {
-		i -= len(m.RestaurantId)
-		copy(dAtA[i:], m.RestaurantId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RestaurantId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *GetRestaurantRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*Image)(nil), "establishment_service.Image")
+	proto.RegisterType((*Location)(nil), "establishment_service.Location")
+	proto.RegisterType((*Attraction)(nil), "establishment_service.Attraction")
+	proto.RegisterType((*GetAttractionRequest)(nil), "establishment_service.GetAttractionRequest")
+	proto.RegisterType((*GetAttractionResponse)(nil), "establishment_service.GetAttractionResponse")
+	proto.RegisterType((*ListAttractionsRequest)(nil), "establishment_service.ListAttractionsRequest")
+	proto.RegisterType((*ListAttractionsResponse)(nil), "establishment_service.ListAttractionsResponse")
+	proto.RegisterType((*UpdateAttractionRequest)(nil), "establishment_service.UpdateAttractionRequest")
+	proto.RegisterType((*UpdateAttractionResponse)(nil), "establishment_service.UpdateAttractionResponse")
+	proto.RegisterType((*DeleteAttractionRequest)(nil), "establishment_service.DeleteAttractionRequest")
+	proto.RegisterType((*DeleteAttractionResponse)(nil), "establishment_service.DeleteAttractionResponse")
+	proto.RegisterType((*ListAttractionsByLocationRequest)(nil), "establishment_service.ListAttractionsByLocationRequest")
+	proto.RegisterType((*ListAttractionsByLocationResponse)(nil), "establishment_service.ListAttractionsByLocationResponse")
+	proto.RegisterType((*FindAttractionsByNameRequest)(nil), "establishment_service.FindAttractionsByNameRequest")
+	proto.RegisterType((*FindAttractionsByNameResponse)(nil), "establishment_service.FindAttractionsByNameResponse")
+	proto.RegisterType((*Restaurant)(nil), "establishment_service.Restaurant")
+	proto.RegisterType((*GetRestaurantRequest)(nil), "establishment_service.GetRestaurantRequest")
+	proto.RegisterType((*GetRestaurantResponse)(nil), "establishment_service.GetRestaurantResponse")
+	proto.RegisterType((*ListRestaurantsRequest)(nil), "establishment_service.ListRestaurantsRequest")
+	proto.RegisterType((*ListRestaurantsResponse)(nil), "establishment_service.ListRestaurantsResponse")
+	proto.RegisterType((*UpdateRestaurantRequest)(nil), "establishment_service.UpdateRestaurantRequest")
+	proto.RegisterType((*UpdateRestaurantResponse)(nil), "establishment_service.UpdateRestaurantResponse")
+	proto.RegisterType((*DeleteRestaurantRequest)(nil), "establishment_service.DeleteRestaurantRequest")
+	proto.RegisterType((*DeleteRestaurantResponse)(nil), "establishment_service.DeleteRestaurantResponse")
+	proto.RegisterType((*ListRestaurantsByLocationRequest)(nil), "establishment_service.ListRestaurantsByLocationRequest")
+	proto.RegisterType((*ListRestaurantsByLocationResponse)(nil), "establishment_service.ListRestaurantsByLocationResponse")
+	proto.RegisterType((*FindRestaurantsByNameRequest)(nil), "establishment_service.FindRestaurantsByNameRequest")
+	proto.RegisterType((*FindRestaurantsByNameResponse)(nil), "establishment_service.FindRestaurantsByNameResponse")
+	proto.RegisterType((*Hotel)(nil), "establishment_service.Hotel")
+	proto.RegisterType((*GetHotelRequest)(nil), "establishment_service.GetHotelRequest")
+	proto.RegisterType((*GetHotelResponse)(nil), "establishment_service.GetHotelResponse")
+	proto.RegisterType((*ListHotelsRequest)(nil), "establishment_service.ListHotelsRequest")
+	proto.RegisterType((*ListHotelsResponse)(nil), "establishment_service.ListHotelsResponse")
+	proto.RegisterType((*UpdateHotelRequest)(nil), "establishment_service.UpdateHotelRequest")
+	proto.RegisterType((*UpdateHotelResponse)(nil), "establishment_service.UpdateHotelResponse")
+	proto.RegisterType((*DeleteHotelRequest)(nil), "establishment_service.DeleteHotelRequest")
+	proto.RegisterType((*DeleteHotelResponse)(nil), "establishment_service.DeleteHotelResponse")
+	proto.RegisterType((*ListHotelsByLocationRequest)(nil), "establishment_service.ListHotelsByLocationRequest")
+	proto.RegisterType((*ListHotelsByLocationResponse)(nil), "establishment_service.ListHotelsByLocationResponse")
+	proto.RegisterType((*FindHotelsByNameRequest)(nil), "establishment_service.FindHotelsByNameRequest")
+	proto.RegisterType((*FindHotelsByNameResponse)(nil), "establishment_service.FindHotelsByNameResponse")
+	proto.RegisterType((*Room)(nil), "establishment_service.Room")
+	proto.RegisterType((*GetRoomRequest)(nil), "establishment_service.GetRoomRequest")
+	proto.RegisterType((*GetRoomResponse)(nil), "establishment_service.GetRoomResponse")
+	proto.RegisterType((*ListRoomsByHotelRequest)(nil), "establishment_service.ListRoomsByHotelRequest")
+	proto.RegisterType((*ListRoomsByHotelResponse)(nil), "establishment_service.ListRoomsByHotelResponse")
+	proto.RegisterType((*UpdateRoomRequest)(nil), "establishment_service.UpdateRoomRequest")
+	proto.RegisterType((*UpdateRoomResponse)(nil), "establishment_service.UpdateRoomResponse")
+	proto.RegisterType((*DeleteRoomRequest)(nil), "establishment_service.DeleteRoomRequest")
+	proto.RegisterType((*DeleteRoomResponse)(nil), "establishment_service.DeleteRoomResponse")
+	proto.RegisterType((*TicketType)(nil), "establishment_service.TicketType")
+	proto.RegisterType((*ListTicketTypesByAttractionRequest)(nil), "establishment_service.ListTicketTypesByAttractionRequest")
+	proto.RegisterType((*ListTicketTypesByAttractionResponse)(nil), "establishment_service.ListTicketTypesByAttractionResponse")
+	proto.RegisterType((*UpdateTicketTypeRequest)(nil), "establishment_service.UpdateTicketTypeRequest")
+	proto.RegisterType((*UpdateTicketTypeResponse)(nil), "establishment_service.UpdateTicketTypeResponse")
+	proto.RegisterType((*DeleteTicketTypeRequest)(nil), "establishment_service.DeleteTicketTypeRequest")
+	proto.RegisterType((*DeleteTicketTypeResponse)(nil), "establishment_service.DeleteTicketTypeResponse")
+	proto.RegisterType((*CancellationRule)(nil), "establishment_service.CancellationRule")
+	proto.RegisterType((*CancellationPolicy)(nil), "establishment_service.CancellationPolicy")
+	proto.RegisterType((*GetCancellationPolicyRequest)(nil), "establishment_service.GetCancellationPolicyRequest")
+	proto.RegisterType((*DeleteCancellationPolicyRequest)(nil), "establishment_service.DeleteCancellationPolicyRequest")
+	proto.RegisterType((*DeleteCancellationPolicyResponse)(nil), "establishment_service.DeleteCancellationPolicyResponse")
+	proto.RegisterType((*Favourite)(nil), "establishment_service.Favourite")
+	proto.RegisterType((*AddToFavouritesRequest)(nil), "establishment_service.AddToFavouritesRequest")
+	proto.RegisterType((*AddToFavouritesResponse)(nil), "establishment_service.AddToFavouritesResponse")
+	proto.RegisterType((*RemoveFromFavouritesRequest)(nil), "establishment_service.RemoveFromFavouritesRequest")
+	proto.RegisterType((*RemoveFromFavouritesResponse)(nil), "establishment_service.RemoveFromFavouritesResponse")
+	proto.RegisterType((*ListFavouritesByUserIdRequest)(nil), "establishment_service.ListFavouritesByUserIdRequest")
+	proto.RegisterType((*ListFavouritesByUserIdResponse)(nil), "establishment_service.ListFavouritesByUserIdResponse")
+	proto.RegisterType((*Review)(nil), "establishment_service.Review")
+	proto.RegisterType((*CreateReviewRequest)(nil), "establishment_service.CreateReviewRequest")
+	proto.RegisterType((*CreateReviewResponse)(nil), "establishment_service.CreateReviewResponse")
+	proto.RegisterType((*ListReviewsRequest)(nil), "establishment_service.ListReviewsRequest")
+	proto.RegisterType((*ListReviewsResponse)(nil), "establishment_service.ListReviewsResponse")
+	proto.RegisterType((*DeleteReviewRequest)(nil), "establishment_service.DeleteReviewRequest")
+	proto.RegisterType((*DeleteReviewResponse)(nil), "establishment_service.DeleteReviewResponse")
+	proto.RegisterType((*CreateImageRes)(nil), "establishment_service.CreateImageRes")
+	proto.RegisterType((*BookingMode)(nil), "establishment_service.BookingMode")
+	proto.RegisterType((*GetBookingModeRequest)(nil), "establishment_service.GetBookingModeRequest")
+	proto.RegisterType((*AddOn)(nil), "establishment_service.AddOn")
+	proto.RegisterType((*ListAddOnsRequest)(nil), "establishment_service.ListAddOnsRequest")
+	proto.RegisterType((*ListAddOnsResponse)(nil), "establishment_service.ListAddOnsResponse")
+	proto.RegisterType((*UpdateAddOnRequest)(nil), "establishment_service.UpdateAddOnRequest")
+	proto.RegisterType((*UpdateAddOnResponse)(nil), "establishment_service.UpdateAddOnResponse")
+	proto.RegisterType((*DeleteAddOnRequest)(nil), "establishment_service.DeleteAddOnRequest")
+	proto.RegisterType((*DeleteAddOnResponse)(nil), "establishment_service.DeleteAddOnResponse")
+	proto.RegisterType((*Closure)(nil), "establishment_service.Closure")
+	proto.RegisterType((*ListClosuresRequest)(nil), "establishment_service.ListClosuresRequest")
+	proto.RegisterType((*ListClosuresResponse)(nil), "establishment_service.ListClosuresResponse")
+	proto.RegisterType((*UpdateClosureRequest)(nil), "establishment_service.UpdateClosureRequest")
+	proto.RegisterType((*UpdateClosureResponse)(nil), "establishment_service.UpdateClosureResponse")
+	proto.RegisterType((*DeleteClosureRequest)(nil), "establishment_service.DeleteClosureRequest")
+	proto.RegisterType((*DeleteClosureResponse)(nil), "establishment_service.DeleteClosureResponse")
 }
 
-func (m *GetRestaurantRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("establishment-proto/establishment.proto", fileDescriptor_f4f0074a4a4eb033)
 }
 
-func (m *GetRestaurantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RestaurantId) > 0 {
-		i -= len(m.RestaurantId)
-		copy(dAtA[i:], m.RestaurantId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RestaurantId)))
-		i--
-		dAtA[i] = 0xa
+var fileDescriptor_f4f0074a4a4eb033 = []byte{
+	// 3147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x4d, 0x73, 0x1c, 0x47,
+	0x95, 0x91, 0xf6, 0xf3, 0xad, 0xbe, 0xdc, 0x96, 0xad, 0xcd, 0xf8, 0x4b, 0x99, 0x90, 0xd8, 0x52,
+	0x1c, 0x29, 0x91, 0x15, 0x62, 0x12, 0x48, 0x22, 0xd9, 0x51, 0xa2, 0x94, 0x1d, 0x3b, 0x9b, 0xa4,
+	0x30, 0x1f, 0x61, 0x6b, 0xb4, 0xd3, 0x92, 0x27, 0xde, 0xdd, 0x51, 0x66, 0x66, 0x2d, 0xc4, 0x01,
+	0x8a, 0x54, 0x71, 0x81, 0x2a, 0x4e, 0x1c, 0xb8, 0x40, 0x71, 0xe1, 0xbf, 0xc0, 0x09, 0x8a, 0x2a,
+	0x6e, 0x14, 0x45, 0x39, 0x3f, 0x82, 0x1c, 0xa9, 0xfe, 0x98, 0xe9, 0x9e, 0x8f, 0xee, 0x99, 0x5d,
+	0xc9, 0x45, 0x0e, 0xdc, 0xb6, 0x5f, 0xbf, 0xd7, 0xef, 0xf5, 0xeb, 0xf7, 0xd1, 0xf3, 0x5e, 0x2f,
+	0x5c, 0xc5, 0x41, 0x68, 0xef, 0xf5, 0xdd, 0xe0, 0xe1, 0x00, 0x0f, 0xc3, 0x97, 0x0e, 0x7d, 0x2f,
+	0xf4, 0xd6, 0x13, 0xb0, 0x35, 0x0a, 0x43, 0xe7, 0x12, 0xc0, 0x6e, 0x80, 0xfd, 0xc7, 0x6e, 0x0f,
+	0x5b, 0x5f, 0x1a, 0x50, 0xdd, 0x1d, 0xd8, 0x07, 0x18, 0x3d, 0x03, 0x0d, 0x97, 0xfc, 0xe8, 0xba,
+	0x4e, 0xdb, 0x58, 0x36, 0xae, 0x35, 0x3b, 0x75, 0x3a, 0xde, 0x75, 0xd0, 0x0a, 0x2c, 0x24, 0xa9,
+	0x5d, 0xa7, 0x3d, 0x45, 0x51, 0xe6, 0x13, 0xf0, 0x5d, 0x07, 0x5d, 0x80, 0x26, 0x5b, 0x65, 0xe4,
+	0xf7, 0xdb, 0xd3, 0x14, 0x87, 0x2d, 0xfb, 0x89, 0xdf, 0x47, 0x26, 0x34, 0x7a, 0x76, 0x88, 0x0f,
+	0x3c, 0xff, 0xb8, 0x5d, 0x61, 0x73, 0xd1, 0x18, 0x5d, 0x02, 0xe8, 0xf9, 0xd8, 0x0e, 0xb1, 0xd3,
+	0xb5, 0xc3, 0x76, 0x95, 0xce, 0x36, 0x39, 0x64, 0x2b, 0x24, 0xd3, 0xa3, 0x43, 0x27, 0x9a, 0xae,
+	0xb1, 0x69, 0x0e, 0x61, 0xd3, 0x0e, 0xee, 0x63, 0x3e, 0x5d, 0x67, 0xd3, 0x1c, 0xb2, 0x15, 0x5a,
+	0x5f, 0x4d, 0x41, 0xe3, 0x8e, 0xd7, 0xb3, 0x43, 0xd7, 0x1b, 0xa2, 0x2b, 0xd0, 0xea, 0xf3, 0xdf,
+	0x62, 0xaf, 0x10, 0x81, 0xc6, 0xdb, 0x6e, 0x1b, 0xea, 0xb6, 0xe3, 0xf8, 0x38, 0x08, 0xf8, 0x66,
+	0xa3, 0x21, 0xd9, 0x6b, 0xdf, 0x0e, 0xdd, 0x70, 0xe4, 0x60, 0xba, 0xd7, 0xa9, 0x4e, 0x3c, 0x46,
+	0x17, 0xa1, 0xd9, 0xf7, 0x86, 0x07, 0x6c, 0xb2, 0x4a, 0x27, 0x05, 0x80, 0xac, 0xd9, 0xf3, 0x46,
+	0xc3, 0xd0, 0x3f, 0xe6, 0xfb, 0x8c, 0x86, 0x08, 0x41, 0xa5, 0xe7, 0x86, 0xc7, 0x7c, 0x7f, 0xf4,
+	0x37, 0x7a, 0x1e, 0xe6, 0x82, 0xd0, 0x0e, 0x71, 0xf7, 0xd0, 0xf7, 0x1e, 0xbb, 0xc3, 0x1e, 0x6e,
+	0x37, 0xe8, 0xec, 0x2c, 0x85, 0xde, 0xe7, 0xc0, 0x84, 0xea, 0x9b, 0x5a, 0xd5, 0x83, 0x5e, 0xf5,
+	0x2d, 0xbd, 0xea, 0x67, 0xd2, 0xaa, 0xff, 0x4b, 0x15, 0x60, 0x2b, 0x0c, 0x7d, 0xbb, 0x47, 0x95,
+	0xff, 0x1c, 0xcc, 0xda, 0xf1, 0x48, 0xa8, 0x7f, 0x46, 0x00, 0x77, 0x1d, 0x62, 0x8a, 0xde, 0xd1,
+	0x10, 0xfb, 0x42, 0xf1, 0x75, 0x3a, 0xde, 0x75, 0xd0, 0x55, 0x98, 0x97, 0xe8, 0x87, 0xf6, 0x00,
+	0x73, 0xc5, 0xcf, 0x09, 0xf0, 0x07, 0xf6, 0x00, 0xa3, 0x65, 0x68, 0x39, 0x38, 0xe8, 0xf9, 0xee,
+	0x21, 0x01, 0x71, 0x73, 0x93, 0x41, 0xe8, 0x3c, 0xd4, 0x7c, 0x3b, 0x74, 0x87, 0x07, 0xfc, 0x08,
+	0xf8, 0x88, 0x68, 0xb4, 0xe7, 0x0d, 0x43, 0xbb, 0x17, 0x76, 0x87, 0xa3, 0xc1, 0x1e, 0xf6, 0xf9,
+	0x31, 0xcc, 0x72, 0xe8, 0x07, 0x14, 0x48, 0xcd, 0xc8, 0xed, 0xe1, 0x61, 0x8f, 0xd9, 0x7a, 0x9d,
+	0x9b, 0x11, 0x03, 0x11, 0x6b, 0xbf, 0x02, 0xad, 0x23, 0xbc, 0x17, 0xb8, 0x21, 0x43, 0x60, 0xc7,
+	0x02, 0x1c, 0x44, 0x10, 0x36, 0xa1, 0x46, 0x5d, 0x23, 0x68, 0x37, 0x97, 0xa7, 0xaf, 0xb5, 0x36,
+	0x2e, 0xae, 0xe5, 0xfa, 0xe8, 0x1a, 0xf5, 0xcf, 0x0e, 0xc7, 0x45, 0x6f, 0x40, 0x23, 0xb2, 0x55,
+	0x7a, 0x56, 0xad, 0x8d, 0x2b, 0x0a, 0xba, 0xc8, 0xe2, 0x3b, 0x31, 0x41, 0xea, 0xa8, 0x5b, 0xfa,
+	0xa3, 0x9e, 0xd1, 0x1f, 0xf5, 0x6c, 0xea, 0xa8, 0xc9, 0xd9, 0x7a, 0x87, 0x78, 0xe8, 0x0e, 0x0f,
+	0xba, 0x0f, 0xbd, 0x91, 0x1f, 0xb4, 0xe7, 0xd8, 0xd9, 0x72, 0xe0, 0x7b, 0x04, 0x86, 0x5e, 0x86,
+	0x45, 0x4c, 0x8c, 0xb9, 0x7b, 0xe4, 0x0e, 0x1d, 0xef, 0xa8, 0x3b, 0x70, 0x87, 0xa3, 0x10, 0x07,
+	0xed, 0xf9, 0x65, 0xe3, 0xda, 0x74, 0x07, 0xd1, 0xb9, 0xef, 0xd1, 0xa9, 0xbb, 0x6c, 0x86, 0xe8,
+	0xd1, 0xb1, 0xdd, 0xfe, 0x71, 0xf7, 0xf3, 0x91, 0x17, 0xda, 0xed, 0x05, 0x8a, 0x08, 0x14, 0xf4,
+	0x21, 0x81, 0xa0, 0x67, 0x61, 0x86, 0x2f, 0xc6, 0x30, 0xce, 0x50, 0x8c, 0x16, 0x83, 0x31, 0x94,
+	0xdb, 0x30, 0x13, 0xba, 0xbd, 0x47, 0x38, 0xec, 0x86, 0xc7, 0x87, 0x38, 0x68, 0x23, 0xaa, 0xf0,
+	0x67, 0x15, 0x8a, 0xfb, 0x98, 0xa2, 0x7e, 0x7c, 0x7c, 0x88, 0x3b, 0xad, 0x30, 0xfe, 0x1d, 0x58,
+	0x6f, 0xc0, 0xe2, 0xbb, 0x38, 0x14, 0xd6, 0xdc, 0xc1, 0x9f, 0x8f, 0x70, 0x10, 0x96, 0x32, 0x6a,
+	0xeb, 0x07, 0x70, 0x2e, 0x45, 0x1c, 0x1c, 0x7a, 0xc3, 0x00, 0xa3, 0x2d, 0x00, 0x81, 0x48, 0x49,
+	0xd5, 0x92, 0x49, 0xe4, 0x12, 0x91, 0xb5, 0x03, 0xe7, 0xef, 0xb8, 0x81, 0xb4, 0x78, 0x10, 0x89,
+	0x76, 0x1e, 0x6a, 0xde, 0xfe, 0x7e, 0x80, 0x43, 0xba, 0xf0, 0x74, 0x87, 0x8f, 0xd0, 0x22, 0x54,
+	0xfb, 0xee, 0xc0, 0x0d, 0xa9, 0x7f, 0x4d, 0x77, 0xd8, 0xc0, 0xfa, 0x09, 0x2c, 0x65, 0xd6, 0xe1,
+	0x52, 0xde, 0x82, 0x96, 0x60, 0x18, 0xb4, 0x0d, 0xad, 0x02, 0x25, 0x31, 0x65, 0x2a, 0x12, 0xda,
+	0xbc, 0xc7, 0xd8, 0xb7, 0xfb, 0x7d, 0xca, 0xb7, 0xd2, 0x89, 0x86, 0xd6, 0x8f, 0x60, 0xe9, 0x13,
+	0x6a, 0x67, 0x59, 0xed, 0x9e, 0x82, 0x7e, 0x3e, 0x85, 0x76, 0x76, 0xf5, 0xd3, 0x53, 0xff, 0x9b,
+	0xb0, 0x74, 0x9b, 0x7a, 0xc1, 0x84, 0xa6, 0xb1, 0x09, 0xed, 0x2c, 0x3d, 0x17, 0xaf, 0x0d, 0xf5,
+	0x60, 0xd4, 0xeb, 0x91, 0x0c, 0x43, 0x48, 0x1b, 0x9d, 0x68, 0x68, 0xfd, 0xc9, 0x80, 0xe5, 0xd4,
+	0x69, 0x6d, 0x1f, 0xc7, 0x3e, 0x9f, 0x7b, 0xfe, 0x95, 0xfc, 0xf3, 0xaf, 0xf0, 0xf3, 0x97, 0x53,
+	0xcf, 0x74, 0x7e, 0xea, 0xa9, 0x68, 0x53, 0x4f, 0x35, 0x27, 0xf5, 0x58, 0x3f, 0x83, 0x67, 0x35,
+	0x62, 0x0a, 0xf3, 0xda, 0x9a, 0xc8, 0xbc, 0x24, 0x2a, 0xb2, 0x29, 0x2a, 0x6f, 0x64, 0xd4, 0x74,
+	0x60, 0x6d, 0xc0, 0xc5, 0x1d, 0x77, 0xe8, 0x24, 0xf8, 0x93, 0x14, 0x11, 0xa9, 0x08, 0x41, 0x85,
+	0xe6, 0x11, 0x76, 0x32, 0xf4, 0xb7, 0xf5, 0x53, 0xb8, 0xa4, 0xa0, 0x79, 0x6a, 0xf2, 0x56, 0x22,
+	0x79, 0xff, 0x51, 0x01, 0xe8, 0x90, 0x85, 0x46, 0xbe, 0x3d, 0xa4, 0x16, 0xe4, 0xc7, 0x23, 0xc9,
+	0x82, 0x04, 0xb0, 0x30, 0x63, 0x4a, 0xf4, 0x72, 0xc6, 0x14, 0xe0, 0x13, 0x66, 0xcc, 0x4c, 0xe0,
+	0xaf, 0xe5, 0x04, 0xfe, 0x6c, 0x5a, 0xad, 0x97, 0x48, 0xab, 0x8d, 0xa2, 0xb4, 0xda, 0xd4, 0xa4,
+	0x55, 0x98, 0x30, 0xad, 0xb6, 0x4e, 0x96, 0x56, 0x67, 0xf4, 0x69, 0x75, 0x56, 0x9f, 0x56, 0xe7,
+	0x72, 0xd2, 0x6a, 0x80, 0xed, 0xb0, 0xdb, 0xb3, 0x0f, 0x6d, 0xea, 0x83, 0x2c, 0x55, 0xce, 0x10,
+	0xe0, 0x2d, 0x0e, 0x23, 0x39, 0x30, 0xe8, 0x7b, 0x61, 0x9c, 0x4e, 0x59, 0x96, 0x6c, 0x11, 0x18,
+	0xcf, 0xa3, 0x3c, 0x7b, 0x09, 0xcb, 0x92, 0x42, 0x54, 0xa1, 0x81, 0xf1, 0xec, 0x25, 0x13, 0x8b,
+	0xf0, 0x29, 0x10, 0x0b, 0xc2, 0xa7, 0x44, 0x2e, 0x11, 0x45, 0xd9, 0x4b, 0xcc, 0x9e, 0x2c, 0x7b,
+	0x25, 0xd6, 0x11, 0xee, 0x2a, 0x18, 0x16, 0xb9, 0xab, 0x24, 0xa6, 0x4c, 0x55, 0x26, 0x7b, 0x65,
+	0xb5, 0x7b, 0x0a, 0xfa, 0x89, 0xb3, 0xd7, 0xd3, 0x51, 0x7f, 0x9c, 0xbd, 0x26, 0x34, 0x8d, 0x38,
+	0x7b, 0xe5, 0x88, 0x57, 0x9c, 0xbd, 0x04, 0xd1, 0xd7, 0x3a, 0x7b, 0x29, 0xc4, 0x3c, 0x4d, 0xf3,
+	0xd2, 0x66, 0xaf, 0x04, 0xff, 0x92, 0xd9, 0x2b, 0x87, 0xe6, 0xa9, 0xc9, 0x1b, 0x67, 0xaf, 0x2f,
+	0x2a, 0x50, 0x7d, 0xcf, 0x0b, 0x71, 0x9f, 0xe4, 0xa4, 0x87, 0xe4, 0x87, 0x54, 0x50, 0xa0, 0x63,
+	0x7d, 0xba, 0xba, 0x04, 0xc0, 0xa8, 0xa4, 0x4c, 0xd5, 0xa4, 0x90, 0xff, 0x7f, 0xd6, 0xfd, 0x6f,
+	0x3e, 0xeb, 0x5e, 0x81, 0xaa, 0xef, 0x79, 0x03, 0xf2, 0x39, 0x47, 0xb6, 0x73, 0x41, 0x65, 0x26,
+	0x9e, 0x37, 0xe8, 0x30, 0x4c, 0xeb, 0x3a, 0xcc, 0xbf, 0x8b, 0x43, 0x6a, 0x06, 0x91, 0x9d, 0xaa,
+	0xad, 0xc1, 0xda, 0x81, 0x05, 0x81, 0xcd, 0x2d, 0x74, 0x03, 0xaa, 0x74, 0x9a, 0x87, 0x34, 0x95,
+	0x0e, 0x19, 0x11, 0x43, 0xb5, 0xb6, 0xe0, 0x0c, 0x71, 0x55, 0x0a, 0x9b, 0x30, 0x85, 0x38, 0x80,
+	0xe4, 0x25, 0xb8, 0x30, 0x9b, 0x50, 0xa3, 0x1c, 0x22, 0x4f, 0xd1, 0x4b, 0xc3, 0x71, 0x35, 0xe9,
+	0xe2, 0x3d, 0x40, 0x2c, 0xa0, 0x27, 0x34, 0x34, 0xc9, 0x96, 0x77, 0xe1, 0x6c, 0x62, 0xa5, 0x13,
+	0x68, 0x6f, 0x1d, 0x10, 0x0b, 0xe3, 0x65, 0x8f, 0x6d, 0x1d, 0xce, 0x26, 0x08, 0x0a, 0x43, 0xfe,
+	0x1f, 0x0d, 0xb8, 0x20, 0xb4, 0xfb, 0xb5, 0x8c, 0xf6, 0x9f, 0xc1, 0xc5, 0x7c, 0x09, 0x4f, 0x64,
+	0x09, 0xf9, 0x91, 0xf2, 0x25, 0x58, 0x22, 0x51, 0x3a, 0xe2, 0x55, 0x14, 0xd4, 0xf7, 0xa1, 0x9d,
+	0x45, 0x7f, 0x0a, 0x62, 0xfd, 0x73, 0x0a, 0x2a, 0xc4, 0x97, 0xd1, 0x12, 0xd4, 0x89, 0x37, 0x8b,
+	0x93, 0xaf, 0x91, 0x21, 0x8b, 0xde, 0xb1, 0x4d, 0x4c, 0x25, 0x03, 0xfb, 0x22, 0x54, 0x0f, 0x7d,
+	0xb7, 0xc7, 0x02, 0xb7, 0xd1, 0x61, 0x83, 0x12, 0x41, 0xfb, 0x05, 0x98, 0x67, 0x41, 0xb9, 0xeb,
+	0xed, 0x77, 0x59, 0xb4, 0xa9, 0x52, 0xbf, 0x9c, 0x65, 0xe0, 0x7b, 0xfb, 0x44, 0x24, 0x5a, 0x55,
+	0x7d, 0xe8, 0xf5, 0x5d, 0xc7, 0x3e, 0x8e, 0x3e, 0x32, 0xe2, 0x31, 0x99, 0x73, 0xdc, 0x80, 0xed,
+	0xa8, 0x41, 0xd9, 0xc7, 0xe3, 0x54, 0x80, 0x6c, 0xea, 0x03, 0x24, 0xe8, 0x03, 0x64, 0x2b, 0x1d,
+	0x20, 0x69, 0x6d, 0x95, 0xdf, 0xcd, 0x67, 0xa8, 0xd4, 0xf1, 0xf8, 0xfd, 0x4a, 0xa3, 0xbe, 0xd0,
+	0xe8, 0x34, 0xf7, 0x7d, 0x8c, 0xbb, 0x44, 0x4a, 0x6b, 0x05, 0xe6, 0xc8, 0x45, 0x9a, 0x04, 0x4b,
+	0x7e, 0xd8, 0x2a, 0x3d, 0x5b, 0xdb, 0x30, 0x1f, 0xa3, 0xf2, 0x83, 0x5e, 0x87, 0x0a, 0x99, 0xe4,
+	0x7e, 0xad, 0x0d, 0xc5, 0x14, 0xd1, 0xda, 0xe4, 0x77, 0x62, 0xa2, 0xbd, 0xed, 0xe3, 0xb2, 0xae,
+	0xdd, 0x83, 0x76, 0x96, 0x8a, 0x8b, 0x10, 0xa7, 0x03, 0xa3, 0x6c, 0x3a, 0x50, 0x18, 0xda, 0x6d,
+	0x38, 0xc3, 0xaf, 0xb5, 0x92, 0x32, 0xc6, 0xde, 0xe0, 0x3b, 0x51, 0x2c, 0x3d, 0x99, 0x9e, 0xae,
+	0xc3, 0x19, 0x7e, 0x89, 0x2d, 0x73, 0x32, 0x6b, 0x80, 0x64, 0xec, 0xc2, 0xc8, 0xf7, 0x2f, 0x03,
+	0x40, 0x14, 0x15, 0xd1, 0x37, 0x61, 0x4e, 0xaa, 0x46, 0x4a, 0xf7, 0x6a, 0x51, 0x6c, 0xdc, 0x75,
+	0xb2, 0xa5, 0xa3, 0xa9, 0x9c, 0x52, 0x79, 0x14, 0x29, 0xa6, 0x45, 0xa4, 0x10, 0x4e, 0x58, 0x91,
+	0x9d, 0xf0, 0xa9, 0x36, 0x58, 0x76, 0xc1, 0x22, 0x06, 0x23, 0xf6, 0x18, 0x6c, 0x1f, 0x4f, 0x58,
+	0x0c, 0xfb, 0x85, 0x01, 0xcf, 0x69, 0xd7, 0xe2, 0xda, 0x4e, 0x97, 0x74, 0x8d, 0x49, 0x4a, 0xba,
+	0x0a, 0xd3, 0xfc, 0x34, 0xfa, 0x9e, 0x93, 0xc8, 0xf8, 0x1e, 0xb6, 0xa1, 0x25, 0xb1, 0x2d, 0xf8,
+	0xe2, 0x92, 0xc8, 0x41, 0x70, 0xb5, 0x7e, 0x1c, 0x7d, 0xd0, 0xc9, 0xcb, 0xf3, 0x6d, 0x9d, 0xc6,
+	0xfa, 0x6f, 0x45, 0x5f, 0x74, 0x59, 0xf1, 0x4b, 0x99, 0x9e, 0xf8, 0xa4, 0xcb, 0x11, 0x50, 0x6d,
+	0xe5, 0xbf, 0x32, 0x60, 0xe1, 0x96, 0x3d, 0xec, 0xe1, 0x7e, 0x9f, 0x65, 0xcd, 0x51, 0x1f, 0x93,
+	0xc2, 0x04, 0xad, 0x09, 0x75, 0xf7, 0xf0, 0xbe, 0xe7, 0x63, 0x7e, 0x0b, 0x6b, 0x51, 0xd8, 0x36,
+	0x05, 0x91, 0xdc, 0xec, 0xe3, 0xfd, 0xd1, 0xd0, 0xe9, 0x1e, 0x62, 0xbf, 0x87, 0xf9, 0x61, 0x18,
+	0x9d, 0x59, 0x06, 0xbd, 0xcf, 0x80, 0xe8, 0x3a, 0xa0, 0xde, 0x43, 0x7b, 0x78, 0x80, 0xbb, 0xfb,
+	0x18, 0xc7, 0xa8, 0x2c, 0xd1, 0x2c, 0xb0, 0x99, 0x1d, 0x8c, 0x39, 0xb6, 0xf5, 0x7b, 0x03, 0x90,
+	0x2c, 0xcc, 0x7d, 0xaf, 0xef, 0xf6, 0x8e, 0x73, 0x7b, 0x7b, 0x46, 0x7e, 0x6f, 0xef, 0xbb, 0x50,
+	0xf5, 0x47, 0x7d, 0x1c, 0xb4, 0xa7, 0xa8, 0x65, 0x5d, 0x55, 0x9c, 0x41, 0x7a, 0xc7, 0x1d, 0x46,
+	0x95, 0x72, 0xa8, 0xe9, 0x94, 0x43, 0x59, 0xbb, 0x70, 0xf1, 0x5d, 0x1c, 0x66, 0x25, 0x8c, 0x0e,
+	0xaa, 0xbc, 0xa0, 0xd6, 0x1d, 0xb8, 0xc2, 0x4e, 0xeb, 0x54, 0x56, 0xfb, 0x0e, 0x2c, 0xab, 0x57,
+	0x2b, 0xb4, 0x81, 0xbf, 0x1a, 0xd0, 0xdc, 0xb1, 0x1f, 0x7b, 0x23, 0xdf, 0x0d, 0xe9, 0xe1, 0xef,
+	0x47, 0x03, 0xc1, 0xb2, 0x15, 0xc3, 0xc6, 0x6b, 0xb6, 0x2e, 0x41, 0x7d, 0x14, 0xb0, 0x8f, 0x46,
+	0xa6, 0xce, 0xda, 0x28, 0x88, 0xbe, 0x19, 0xa5, 0xd0, 0x56, 0xd1, 0x87, 0xb6, 0xaa, 0x3e, 0xb4,
+	0xd5, 0xd2, 0xa1, 0xed, 0x01, 0x9c, 0xdf, 0x72, 0x9c, 0x8f, 0xbd, 0x78, 0x57, 0xf1, 0xa7, 0xc5,
+	0x9b, 0xd0, 0x8c, 0x77, 0xc2, 0x1d, 0x75, 0x59, 0x61, 0x24, 0x31, 0x71, 0x47, 0x90, 0x58, 0xdf,
+	0x87, 0xa5, 0xcc, 0xca, 0x5c, 0xc1, 0x27, 0x5d, 0xfa, 0x6d, 0xb8, 0xd0, 0xc1, 0x03, 0xef, 0x31,
+	0xde, 0xf1, 0xbd, 0x41, 0x56, 0xf2, 0xe2, 0x73, 0xb1, 0x6e, 0xc2, 0xc5, 0xfc, 0x15, 0x0a, 0x4d,
+	0xe0, 0x26, 0x5c, 0x22, 0xf1, 0x5b, 0xd0, 0x6c, 0x1f, 0x7f, 0x42, 0xcf, 0x49, 0x4a, 0xab, 0xd1,
+	0x39, 0x1a, 0xf2, 0x39, 0x5a, 0x7b, 0x70, 0x59, 0x45, 0xc9, 0xb9, 0xbe, 0x0d, 0x10, 0x0b, 0x19,
+	0x85, 0xfc, 0x62, 0xc5, 0x48, 0x34, 0xd6, 0x57, 0x06, 0xd4, 0x3a, 0xf8, 0xb1, 0x8b, 0x8f, 0xc8,
+	0x5b, 0x05, 0x9f, 0xfe, 0x12, 0x92, 0x34, 0x18, 0xe0, 0x94, 0xec, 0x52, 0x94, 0x22, 0x2a, 0x89,
+	0x52, 0x04, 0xfd, 0x74, 0x19, 0x10, 0x6a, 0x6e, 0x8d, 0xd1, 0x30, 0x65, 0xc9, 0x35, 0xbd, 0x25,
+	0xd7, 0xf5, 0x96, 0xdc, 0x48, 0x5b, 0xf2, 0x1d, 0x38, 0x7b, 0x8b, 0x2e, 0xc5, 0xf6, 0x1f, 0x1d,
+	0xc7, 0xab, 0x50, 0x63, 0xbb, 0xe6, 0x86, 0x76, 0x49, 0x59, 0x07, 0xa2, 0x54, 0x1c, 0xd9, 0xba,
+	0x0b, 0x8b, 0xc9, 0xd5, 0xf8, 0x11, 0x4d, 0xb8, 0xdc, 0x5b, 0xec, 0xcb, 0x9b, 0x41, 0x83, 0x09,
+	0xe2, 0x96, 0x03, 0x67, 0x13, 0x0b, 0x70, 0x71, 0x5e, 0x83, 0x3a, 0xe3, 0x10, 0x99, 0x4b, 0x81,
+	0x3c, 0x11, 0xb6, 0xe2, 0x66, 0xb0, 0x11, 0x7d, 0xf4, 0x26, 0x75, 0xa8, 0x33, 0x25, 0xeb, 0x65,
+	0x58, 0x4c, 0xd2, 0x14, 0xba, 0xd0, 0x35, 0x98, 0x63, 0xba, 0x65, 0x35, 0x22, 0x1c, 0x50, 0x53,
+	0xc2, 0xc1, 0xa8, 0x1f, 0xc6, 0x37, 0x51, 0x3a, 0xb2, 0x1e, 0x41, 0x6b, 0xdb, 0xf3, 0x1e, 0xb9,
+	0xc3, 0x83, 0xbb, 0x9e, 0x83, 0xc7, 0x49, 0x6f, 0x08, 0x2a, 0x03, 0xcf, 0xc1, 0xdc, 0xa8, 0xe9,
+	0xef, 0xa2, 0x9c, 0xb5, 0x4d, 0x9b, 0x00, 0x12, 0xbf, 0x09, 0x8e, 0xe9, 0x3f, 0x06, 0x54, 0xb7,
+	0x1c, 0xe7, 0xde, 0x10, 0x99, 0xd0, 0xb4, 0x1d, 0xa7, 0x2b, 0xdf, 0x04, 0xc9, 0xeb, 0x99, 0x7b,
+	0x63, 0x3e, 0xc1, 0xc9, 0xbb, 0x01, 0x17, 0x7f, 0x70, 0xc6, 0x77, 0xe4, 0xaa, 0xfa, 0x8e, 0x7c,
+	0xca, 0xee, 0xf7, 0x26, 0x2b, 0x4f, 0xd1, 0xcd, 0x4f, 0x62, 0xe0, 0x36, 0x20, 0x99, 0x3e, 0x76,
+	0xb7, 0x3a, 0xd3, 0x62, 0xd1, 0xb7, 0x3f, 0xa5, 0xeb, 0xd4, 0xa8, 0x86, 0x55, 0xd6, 0xbd, 0x1b,
+	0x7d, 0x4c, 0x31, 0x64, 0x2e, 0xe3, 0x0d, 0xa8, 0x31, 0x16, 0x05, 0xe5, 0x24, 0x46, 0x54, 0xa5,
+	0x1c, 0xac, 0xf7, 0xa3, 0xca, 0x14, 0x5f, 0x8a, 0x8b, 0x3b, 0xd1, 0x5a, 0x2f, 0x47, 0x9f, 0x5b,
+	0x09, 0xb1, 0x34, 0xf6, 0x23, 0x6a, 0x53, 0x49, 0xee, 0x6a, 0x8f, 0xfb, 0xc3, 0x14, 0xd4, 0x6f,
+	0xf5, 0xbd, 0x60, 0xe4, 0x33, 0x2b, 0x60, 0x3f, 0xc5, 0xca, 0x4d, 0x0e, 0x19, 0xcf, 0x36, 0x2f,
+	0x01, 0x04, 0xa1, 0xed, 0x87, 0x5d, 0xa2, 0x88, 0xc8, 0x9f, 0x28, 0xe4, 0xb6, 0x1d, 0xd2, 0x27,
+	0x77, 0x78, 0xe8, 0xb0, 0x49, 0x66, 0xa3, 0x75, 0x3c, 0x74, 0xe8, 0x94, 0x09, 0x8d, 0x23, 0x8c,
+	0x1f, 0xd1, 0x42, 0x47, 0x75, 0x79, 0x9a, 0xc4, 0x93, 0x68, 0xcc, 0x62, 0x81, 0x1d, 0x78, 0x43,
+	0x6e, 0xa1, 0x7c, 0x94, 0xb2, 0xde, 0xba, 0xde, 0x7a, 0x1b, 0x7a, 0xeb, 0x6d, 0xa6, 0xad, 0xf7,
+	0x6d, 0x16, 0x5e, 0xb9, 0x8e, 0x26, 0xb1, 0xdf, 0x87, 0xb0, 0x98, 0x5c, 0x81, 0x1f, 0xca, 0xeb,
+	0xd0, 0xe0, 0xca, 0x8d, 0x4c, 0xf8, 0xb2, 0xea, 0xaa, 0xcd, 0xd0, 0x3a, 0x31, 0xbe, 0xc2, 0x8c,
+	0xef, 0xc3, 0x22, 0xb3, 0xbd, 0x88, 0x80, 0x0b, 0x7b, 0x13, 0xea, 0x9c, 0x92, 0x5b, 0x5f, 0x11,
+	0xa3, 0x08, 0xdd, 0xfa, 0x10, 0xce, 0xa5, 0x56, 0xe4, 0xc2, 0x4f, 0xbe, 0xe4, 0xab, 0x51, 0x56,
+	0x48, 0x09, 0xa9, 0xb7, 0x3e, 0xeb, 0x15, 0x38, 0x97, 0x22, 0x2b, 0xb2, 0xed, 0x8d, 0xbf, 0xaf,
+	0xc2, 0xe2, 0x3b, 0xb2, 0x50, 0x1f, 0x31, 0x99, 0xd0, 0x03, 0x58, 0x60, 0x69, 0x46, 0x7a, 0xa0,
+	0x57, 0xfc, 0x86, 0xc1, 0x2c, 0x46, 0x41, 0x9f, 0xc1, 0x6c, 0xe2, 0xb1, 0x13, 0x7a, 0x51, 0x41,
+	0x93, 0xf7, 0x9e, 0xca, 0xbc, 0x5e, 0x0e, 0x99, 0x6f, 0xfc, 0x10, 0xe6, 0x53, 0xef, 0x4b, 0xd0,
+	0x4b, 0xaa, 0xd6, 0x49, 0xee, 0x23, 0x29, 0x73, 0xad, 0x2c, 0x3a, 0xe7, 0x18, 0xc0, 0x42, 0xfa,
+	0x39, 0x11, 0x52, 0xad, 0xa1, 0x78, 0xd5, 0x64, 0xae, 0x97, 0xc6, 0x17, 0x4c, 0xd3, 0x8f, 0x84,
+	0x94, 0x4c, 0x15, 0xaf, 0x91, 0xcc, 0xf5, 0xd2, 0xf8, 0x9c, 0xe9, 0x17, 0x06, 0x9c, 0xcb, 0x7d,
+	0x08, 0x83, 0x6e, 0xa8, 0x6e, 0xdd, 0x9a, 0xa7, 0x36, 0xe6, 0xe6, 0x78, 0x44, 0x5c, 0x88, 0xdf,
+	0x18, 0xf0, 0x8c, 0xf2, 0x05, 0x11, 0x7a, 0xad, 0xdc, 0xe1, 0x65, 0xda, 0x0d, 0xe6, 0xcd, 0xf1,
+	0x09, 0xb9, 0x40, 0xb1, 0xdf, 0x48, 0xcf, 0x74, 0x8a, 0xbb, 0xa7, 0x66, 0x31, 0x0a, 0xf7, 0x1b,
+	0x09, 0xa0, 0xf1, 0x9b, 0x4c, 0xbb, 0xde, 0xbc, 0x5e, 0x0e, 0x39, 0xe9, 0x37, 0x1d, 0xa9, 0xa5,
+	0xab, 0xf3, 0x9b, 0xec, 0xf3, 0x0c, 0x73, 0xad, 0x2c, 0x7a, 0xda, 0x6f, 0xa4, 0x0d, 0xea, 0xfd,
+	0x26, 0xbb, 0xc7, 0xf5, 0xd2, 0xf8, 0x69, 0xbf, 0x29, 0xc1, 0x54, 0xf1, 0x0e, 0xc2, 0x5c, 0x2f,
+	0x8d, 0x9f, 0xf2, 0x9b, 0x4c, 0x0b, 0x5e, 0xeb, 0x37, 0xaa, 0x26, 0xbf, 0xb9, 0x39, 0x1e, 0x51,
+	0xca, 0x6f, 0x72, 0xdf, 0x2e, 0x68, 0xfd, 0x46, 0xf7, 0x28, 0xc3, 0xbc, 0x39, 0x3e, 0x21, 0x17,
+	0x68, 0x17, 0x5a, 0xcc, 0x6f, 0xd8, 0x03, 0x01, 0x6d, 0x97, 0xca, 0xd4, 0xce, 0xa2, 0x1f, 0x42,
+	0x23, 0xea, 0x19, 0xa3, 0x17, 0xd4, 0x66, 0x2f, 0x37, 0x3c, 0xcc, 0xab, 0x85, 0x78, 0x5c, 0x4e,
+	0x1b, 0x40, 0x74, 0x01, 0xd1, 0x35, 0xcd, 0x7e, 0x13, 0xbd, 0x66, 0x73, 0xa5, 0x04, 0x26, 0x67,
+	0xe1, 0x40, 0x4b, 0x6a, 0xdc, 0xa2, 0x15, 0xad, 0x55, 0x27, 0x76, 0xb1, 0x5a, 0x06, 0x55, 0x70,
+	0x91, 0x5a, 0xb4, 0x4a, 0x2e, 0xd9, 0xbe, 0xaf, 0xb9, 0x5a, 0x06, 0x55, 0x78, 0x58, 0xba, 0x33,
+	0xa9, 0xf4, 0x30, 0x45, 0xc7, 0xd3, 0x5c, 0x2f, 0x8d, 0xcf, 0x99, 0xfe, 0x9c, 0xdd, 0x26, 0xd3,
+	0x9d, 0x5a, 0xb4, 0x51, 0x78, 0x06, 0x59, 0x8b, 0xbe, 0x31, 0x16, 0x0d, 0x17, 0x60, 0x07, 0x80,
+	0x27, 0x01, 0xd2, 0x2c, 0xd5, 0xb5, 0x98, 0x4c, 0xdd, 0x24, 0x7a, 0x00, 0x75, 0xde, 0xe5, 0x43,
+	0xcf, 0x6b, 0xe2, 0xb7, 0x68, 0x4b, 0x99, 0x2f, 0x14, 0xa1, 0x89, 0x73, 0x49, 0x77, 0xf1, 0x90,
+	0x36, 0x64, 0x67, 0x9b, 0x84, 0xe6, 0x7a, 0x69, 0x7c, 0xe1, 0x3b, 0xa2, 0x1f, 0xa7, 0xf4, 0x9d,
+	0x4c, 0xe3, 0xcf, 0x5c, 0x29, 0x81, 0x29, 0x58, 0x88, 0xee, 0x9b, 0x92, 0x45, 0xa6, 0x9d, 0x67,
+	0xae, 0x94, 0xc0, 0x4c, 0x67, 0x78, 0xa9, 0x6b, 0x57, 0xdc, 0x84, 0x31, 0x8b, 0x51, 0xd0, 0x6f,
+	0xf9, 0x23, 0x08, 0x45, 0x7b, 0x0b, 0x7d, 0x5b, 0xa3, 0x70, 0x7d, 0x7b, 0xcd, 0x7c, 0x7d, 0x12,
+	0xd2, 0x74, 0x6a, 0x96, 0x44, 0xd5, 0xa7, 0xe6, 0x4c, 0x6f, 0xc9, 0x5c, 0x2f, 0x8d, 0x9f, 0x4e,
+	0xcd, 0x25, 0x98, 0x2a, 0x1a, 0x5a, 0xe6, 0x7a, 0x69, 0x7c, 0xce, 0x74, 0x00, 0xe7, 0x3e, 0xca,
+	0x6b, 0xbc, 0x28, 0xa3, 0x63, 0x16, 0xd5, 0x2c, 0x8f, 0x8a, 0x8e, 0x68, 0xcd, 0x2c, 0x67, 0xe2,
+	0x86, 0xda, 0x8b, 0x95, 0x7d, 0x9c, 0x71, 0x18, 0xff, 0xda, 0x88, 0x9a, 0x78, 0x39, 0x93, 0xdf,
+	0xd2, 0x6a, 0x4d, 0xcd, 0xff, 0xb5, 0xb1, 0xe9, 0xc4, 0x65, 0x33, 0xd5, 0xeb, 0x50, 0x5e, 0x36,
+	0xf3, 0xbb, 0x2d, 0xe6, 0x5a, 0x59, 0x74, 0x91, 0x20, 0xf2, 0x1a, 0x18, 0xca, 0x04, 0xa1, 0xe9,
+	0x97, 0x98, 0x37, 0xc6, 0xa2, 0xe1, 0x02, 0xfc, 0xd2, 0x60, 0xef, 0x9a, 0xb3, 0xed, 0x0c, 0xb4,
+	0xa9, 0xf1, 0x54, 0x65, 0xdf, 0xc4, 0x7c, 0x75, 0x4c, 0x2a, 0x2e, 0xc7, 0x01, 0xcc, 0xc8, 0x85,
+	0x7a, 0xa4, 0x4a, 0xed, 0x39, 0xbd, 0x01, 0xf3, 0xc5, 0x52, 0xb8, 0xe2, 0xb6, 0x21, 0x55, 0xe0,
+	0xd1, 0x8a, 0xf6, 0x9e, 0x28, 0x97, 0xf9, 0xcd, 0xd5, 0x32, 0xa8, 0x62, 0x3b, 0x72, 0x35, 0x1d,
+	0xad, 0x16, 0xdc, 0xcd, 0xcb, 0x6c, 0x27, 0xb7, 0x3c, 0xdf, 0x89, 0x6e, 0xab, 0x77, 0xb1, 0xe3,
+	0xda, 0x48, 0xfb, 0x8c, 0xd3, 0x7c, 0x5e, 0xab, 0xa8, 0xb8, 0x8c, 0xff, 0x00, 0xe6, 0x3e, 0x4a,
+	0x54, 0xd0, 0x91, 0xa5, 0x20, 0x94, 0x70, 0xcc, 0x12, 0x38, 0x68, 0x8f, 0xbe, 0x2b, 0x92, 0x21,
+	0x9a, 0xaf, 0xc1, 0x6c, 0x09, 0xbf, 0x14, 0x8f, 0xf8, 0xfe, 0xce, 0x0a, 0xf8, 0xda, 0xda, 0xad,
+	0xa9, 0x9d, 0x8d, 0xae, 0xd8, 0x5b, 0xac, 0x1a, 0xad, 0xbb, 0x62, 0x27, 0xea, 0xe5, 0xe6, 0x4a,
+	0x09, 0xcc, 0xf4, 0x15, 0x9b, 0x71, 0xd4, 0x5f, 0x30, 0xe4, 0xca, 0xb2, 0xb9, 0x5a, 0x06, 0x35,
+	0x7d, 0xc5, 0xd6, 0x73, 0xc9, 0xd6, 0xaf, 0xcd, 0xd5, 0x32, 0xa8, 0x9c, 0xcb, 0x3d, 0x98, 0x65,
+	0x9a, 0x8f, 0x6a, 0xd4, 0x05, 0x65, 0x46, 0xb3, 0x60, 0x9e, 0x78, 0x91, 0x5c, 0x8c, 0x45, 0x3a,
+	0x0f, 0x4c, 0xd5, 0x7c, 0xcd, 0x17, 0x4b, 0xe1, 0x72, 0xc9, 0x3f, 0x83, 0xd9, 0x44, 0xe5, 0x54,
+	0x59, 0xd1, 0xc8, 0xab, 0xd8, 0x9a, 0xd7, 0xcb, 0x21, 0x0b, 0x5e, 0x89, 0xda, 0x28, 0xd2, 0xfb,
+	0x7b, 0x49, 0x5e, 0xb9, 0xe5, 0xd6, 0xed, 0x85, 0x3f, 0x3f, 0xb9, 0x6c, 0xfc, 0xed, 0xc9, 0x65,
+	0xe3, 0xdf, 0x4f, 0x2e, 0x1b, 0xbf, 0xfb, 0xf2, 0xf2, 0x37, 0xf6, 0x6a, 0xf4, 0x8f, 0xf6, 0x37,
+	0xfe, 0x3b, 0x00, 0xea, 0xcb, 0x38, 0xb8, 0x93, 0x3f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EstablishmentServiceClient is the client API for EstablishmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EstablishmentServiceClient interface {
+	// ATTRACTION
+	CreateAttraction(ctx context.Context, in *Attraction, opts ...grpc.CallOption) (*Attraction, error)
+	GetAttraction(ctx context.Context, in *GetAttractionRequest, opts ...grpc.CallOption) (*GetAttractionResponse, error)
+	ListAttractions(ctx context.Context, in *ListAttractionsRequest, opts ...grpc.CallOption) (*ListAttractionsResponse, error)
+	UpdateAttraction(ctx context.Context, in *UpdateAttractionRequest, opts ...grpc.CallOption) (*UpdateAttractionResponse, error)
+	DeleteAttraction(ctx context.Context, in *DeleteAttractionRequest, opts ...grpc.CallOption) (*DeleteAttractionResponse, error)
+	FindAttractionsByName(ctx context.Context, in *FindAttractionsByNameRequest, opts ...grpc.CallOption) (*FindAttractionsByNameResponse, error)
+	ListAttractionsByLocation(ctx context.Context, in *ListAttractionsByLocationRequest, opts ...grpc.CallOption) (*ListAttractionsByLocationResponse, error)
+	// RESTAURANT
+	CreateRestaurant(ctx context.Context, in *Restaurant, opts ...grpc.CallOption) (*Restaurant, error)
+	GetRestaurant(ctx context.Context, in *GetRestaurantRequest, opts ...grpc.CallOption) (*GetRestaurantResponse, error)
+	ListRestaurants(ctx context.Context, in *ListRestaurantsRequest, opts ...grpc.CallOption) (*ListRestaurantsResponse, error)
+	UpdateRestaurant(ctx context.Context, in *UpdateRestaurantRequest, opts ...grpc.CallOption) (*UpdateRestaurantResponse, error)
+	DeleteRestaurant(ctx context.Context, in *DeleteRestaurantRequest, opts ...grpc.CallOption) (*DeleteRestaurantResponse, error)
+	FindRestaurantsByName(ctx context.Context, in *FindRestaurantsByNameRequest, opts ...grpc.CallOption) (*FindRestaurantsByNameResponse, error)
+	ListRestaurantsByLocation(ctx context.Context, in *ListRestaurantsByLocationRequest, opts ...grpc.CallOption) (*ListRestaurantsByLocationResponse, error)
+	// HOTEL
+	CreateHotel(ctx context.Context, in *Hotel, opts ...grpc.CallOption) (*Hotel, error)
+	GetHotel(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*GetHotelResponse, error)
+	ListHotels(ctx context.Context, in *ListHotelsRequest, opts ...grpc.CallOption) (*ListHotelsResponse, error)
+	UpdateHotel(ctx context.Context, in *UpdateHotelRequest, opts ...grpc.CallOption) (*UpdateHotelResponse, error)
+	DeleteHotel(ctx context.Context, in *DeleteHotelRequest, opts ...grpc.CallOption) (*DeleteHotelResponse, error)
+	FindHotelsByName(ctx context.Context, in *FindHotelsByNameRequest, opts ...grpc.CallOption) (*FindHotelsByNameResponse, error)
+	ListHotelsByLocation(ctx context.Context, in *ListHotelsByLocationRequest, opts ...grpc.CallOption) (*ListHotelsByLocationResponse, error)
+	// ROOM
+	CreateRoom(ctx context.Context, in *Room, opts ...grpc.CallOption) (*Room, error)
+	GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error)
+	ListRoomsByHotel(ctx context.Context, in *ListRoomsByHotelRequest, opts ...grpc.CallOption) (*ListRoomsByHotelResponse, error)
+	UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error)
+	DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error)
+	// TICKET TYPE
+	CreateTicketType(ctx context.Context, in *TicketType, opts ...grpc.CallOption) (*TicketType, error)
+	ListTicketTypesByAttraction(ctx context.Context, in *ListTicketTypesByAttractionRequest, opts ...grpc.CallOption) (*ListTicketTypesByAttractionResponse, error)
+	UpdateTicketType(ctx context.Context, in *UpdateTicketTypeRequest, opts ...grpc.CallOption) (*UpdateTicketTypeResponse, error)
+	DeleteTicketType(ctx context.Context, in *DeleteTicketTypeRequest, opts ...grpc.CallOption) (*DeleteTicketTypeResponse, error)
+	// CANCELLATION POLICY
+	SetCancellationPolicy(ctx context.Context, in *CancellationPolicy, opts ...grpc.CallOption) (*CancellationPolicy, error)
+	GetCancellationPolicy(ctx context.Context, in *GetCancellationPolicyRequest, opts ...grpc.CallOption) (*CancellationPolicy, error)
+	DeleteCancellationPolicy(ctx context.Context, in *DeleteCancellationPolicyRequest, opts ...grpc.CallOption) (*DeleteCancellationPolicyResponse, error)
+	// FAVOURITES
+	AddToFavourites(ctx context.Context, in *AddToFavouritesRequest, opts ...grpc.CallOption) (*AddToFavouritesResponse, error)
+	RemoveFromFavourites(ctx context.Context, in *RemoveFromFavouritesRequest, opts ...grpc.CallOption) (*RemoveFromFavouritesResponse, error)
+	ListFavouritesByUserId(ctx context.Context, in *ListFavouritesByUserIdRequest, opts ...grpc.CallOption) (*ListFavouritesByUserIdResponse, error)
+	// REVIEW
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error)
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error)
+	// MEDIA
+	CreateMedia(ctx context.Context, in *Image, opts ...grpc.CallOption) (*CreateImageRes, error)
+	// BOOKING MODE
+	SetBookingMode(ctx context.Context, in *BookingMode, opts ...grpc.CallOption) (*BookingMode, error)
+	GetBookingMode(ctx context.Context, in *GetBookingModeRequest, opts ...grpc.CallOption) (*BookingMode, error)
+	// ADD-ON
+	CreateAddOn(ctx context.Context, in *AddOn, opts ...grpc.CallOption) (*AddOn, error)
+	ListAddOns(ctx context.Context, in *ListAddOnsRequest, opts ...grpc.CallOption) (*ListAddOnsResponse, error)
+	UpdateAddOn(ctx context.Context, in *UpdateAddOnRequest, opts ...grpc.CallOption) (*UpdateAddOnResponse, error)
+	DeleteAddOn(ctx context.Context, in *DeleteAddOnRequest, opts ...grpc.CallOption) (*DeleteAddOnResponse, error)
+	// CLOSURE
+	CreateClosure(ctx context.Context, in *Closure, opts ...grpc.CallOption) (*Closure, error)
+	ListClosures(ctx context.Context, in *ListClosuresRequest, opts ...grpc.CallOption) (*ListClosuresResponse, error)
+	UpdateClosure(ctx context.Context, in *UpdateClosureRequest, opts ...grpc.CallOption) (*UpdateClosureResponse, error)
+	DeleteClosure(ctx context.Context, in *DeleteClosureRequest, opts ...grpc.CallOption) (*DeleteClosureResponse, error)
+}
+
+type establishmentServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEstablishmentServiceClient(cc *grpc.ClientConn) EstablishmentServiceClient {
+	return &establishmentServiceClient{cc}
+}
+
+func (c *establishmentServiceClient) CreateAttraction(ctx context.Context, in *Attraction, opts ...grpc.CallOption) (*Attraction, error) {
+	out := new(Attraction)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateAttraction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetRestaurantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) GetAttraction(ctx context.Context, in *GetAttractionRequest, opts ...grpc.CallOption) (*GetAttractionResponse, error) {
+	out := new(GetAttractionResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/GetAttraction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetRestaurantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) ListAttractions(ctx context.Context, in *ListAttractionsRequest, opts ...grpc.CallOption) (*ListAttractionsResponse, error) {
+	out := new(ListAttractionsResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListAttractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetRestaurantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Restaurant != nil {
-		{
-			size, err := m.Restaurant.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *establishmentServiceClient) UpdateAttraction(ctx context.Context, in *UpdateAttractionRequest, opts ...grpc.CallOption) (*UpdateAttractionResponse, error) {
+	out := new(UpdateAttractionResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateAttraction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListRestaurantsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) DeleteAttraction(ctx context.Context, in *DeleteAttractionRequest, opts ...grpc.CallOption) (*DeleteAttractionResponse, error) {
+	out := new(DeleteAttractionResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteAttraction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListRestaurantsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) FindAttractionsByName(ctx context.Context, in *FindAttractionsByNameRequest, opts ...grpc.CallOption) (*FindAttractionsByNameResponse, error) {
+	out := new(FindAttractionsByNameResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/FindAttractionsByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListRestaurantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Limit != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Offset != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x8
+func (c *establishmentServiceClient) ListAttractionsByLocation(ctx context.Context, in *ListAttractionsByLocationRequest, opts ...grpc.CallOption) (*ListAttractionsByLocationResponse, error) {
+	out := new(ListAttractionsByLocationResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListAttractionsByLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListRestaurantsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) CreateRestaurant(ctx context.Context, in *Restaurant, opts ...grpc.CallOption) (*Restaurant, error) {
+	out := new(Restaurant)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateRestaurant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListRestaurantsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) GetRestaurant(ctx context.Context, in *GetRestaurantRequest, opts ...grpc.CallOption) (*GetRestaurantResponse, error) {
+	out := new(GetRestaurantResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/GetRestaurant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListRestaurantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Overall != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Overall))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Restaurants) > 0 {
-		for iNdEx := len(m.Restaurants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Restaurants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *establishmentServiceClient) ListRestaurants(ctx context.Context, in *ListRestaurantsRequest, opts ...grpc.CallOption) (*ListRestaurantsResponse, error) {
+	out := new(ListRestaurantsResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListRestaurants", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateRestaurantRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) UpdateRestaurant(ctx context.Context, in *UpdateRestaurantRequest, opts ...grpc.CallOption) (*UpdateRestaurantResponse, error) {
+	out := new(UpdateRestaurantResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateRestaurant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UpdateRestaurantRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) DeleteRestaurant(ctx context.Context, in *DeleteRestaurantRequest, opts ...grpc.CallOption) (*DeleteRestaurantResponse, error) {
+	out := new(DeleteRestaurantResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteRestaurant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UpdateRestaurantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Restaurant != nil {
-		{
-			size, err := m.Restaurant.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *establishmentServiceClient) FindRestaurantsByName(ctx context.Context, in *FindRestaurantsByNameRequest, opts ...grpc.CallOption) (*FindRestaurantsByNameResponse, error) {
+	out := new(FindRestaurantsByNameResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/FindRestaurantsByName", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateRestaurantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) ListRestaurantsByLocation(ctx context.Context, in *ListRestaurantsByLocationRequest, opts ...grpc.CallOption) (*ListRestaurantsByLocationResponse, error) {
+	out := new(ListRestaurantsByLocationResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListRestaurantsByLocation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UpdateRestaurantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) CreateHotel(ctx context.Context, in *Hotel, opts ...grpc.CallOption) (*Hotel, error) {
+	out := new(Hotel)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateHotel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UpdateRestaurantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Restaurant != nil {
-		{
-			size, err := m.Restaurant.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *establishmentServiceClient) GetHotel(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*GetHotelResponse, error) {
+	out := new(GetHotelResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/GetHotel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DeleteRestaurantRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) ListHotels(ctx context.Context, in *ListHotelsRequest, opts ...grpc.CallOption) (*ListHotelsResponse, error) {
+	out := new(ListHotelsResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListHotels", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DeleteRestaurantRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) UpdateHotel(ctx context.Context, in *UpdateHotelRequest, opts ...grpc.CallOption) (*UpdateHotelResponse, error) {
+	out := new(UpdateHotelResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateHotel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DeleteRestaurantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *establishmentServiceClient) DeleteHotel(ctx context.Context, in *DeleteHotelRequest, opts ...grpc.CallOption) (*DeleteHotelResponse, error) {
+	out := new(DeleteHotelResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteHotel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RestaurantId) > 0 {
-		i -= len(m.RestaurantId)
-		copy(dAtA[i:], m.RestaurantId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RestaurantId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DeleteRestaurantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) FindHotelsByName(ctx context.Context, in *FindHotelsByNameRequest, opts ...grpc.CallOption) (*FindHotelsByNameResponse, error) {
+	out := new(FindHotelsByNameResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/FindHotelsByName", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DeleteRestaurantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) ListHotelsByLocation(ctx context.Context, in *ListHotelsByLocationRequest, opts ...grpc.CallOption) (*ListHotelsByLocationResponse, error) {
+	out := new(ListHotelsByLocationResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListHotelsByLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DeleteRestaurantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *establishmentServiceClient) CreateRoom(ctx context.Context, in *Room, opts ...grpc.CallOption) (*Room, error) {
+	out := new(Room)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListRestaurantsByLocationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) GetRoom(ctx context.Context, in *GetRoomRequest, opts ...grpc.CallOption) (*GetRoomResponse, error) {
+	out := new(GetRoomResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/GetRoom", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListRestaurantsByLocationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) ListRoomsByHotel(ctx context.Context, in *ListRoomsByHotelRequest, opts ...grpc.CallOption) (*ListRoomsByHotelResponse, error) {
+	out := new(ListRoomsByHotelResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListRoomsByHotel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListRestaurantsByLocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.StateProvince) > 0 {
-		i -= len(m.StateProvince)
-		copy(dAtA[i:], m.StateProvince)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.StateProvince)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.City) > 0 {
-		i -= len(m.City)
-		copy(dAtA[i:], m.City)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.City)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Limit != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Offset != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x8
+func (c *establishmentServiceClient) UpdateRoom(ctx context.Context, in *UpdateRoomRequest, opts ...grpc.CallOption) (*UpdateRoomResponse, error) {
+	out := new(UpdateRoomResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListRestaurantsByLocationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) DeleteRoom(ctx context.Context, in *DeleteRoomRequest, opts ...grpc.CallOption) (*DeleteRoomResponse, error) {
+	out := new(DeleteRoomResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteRoom", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListRestaurantsByLocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) CreateTicketType(ctx context.Context, in *TicketType, opts ...grpc.CallOption) (*TicketType, error) {
+	out := new(TicketType)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateTicketType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListRestaurantsByLocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Restaurants) > 0 {
-		for iNdEx := len(m.Restaurants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Restaurants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *establishmentServiceClient) ListTicketTypesByAttraction(ctx context.Context, in *ListTicketTypesByAttractionRequest, opts ...grpc.CallOption) (*ListTicketTypesByAttractionResponse, error) {
+	out := new(ListTicketTypesByAttractionResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListTicketTypesByAttraction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *FindRestaurantsByNameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) UpdateTicketType(ctx context.Context, in *UpdateTicketTypeRequest, opts ...grpc.CallOption) (*UpdateTicketTypeResponse, error) {
+	out := new(UpdateTicketTypeResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateTicketType", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *FindRestaurantsByNameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) DeleteTicketType(ctx context.Context, in *DeleteTicketTypeRequest, opts ...grpc.CallOption) (*DeleteTicketTypeResponse, error) {
+	out := new(DeleteTicketTypeResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteTicketType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *FindRestaurantsByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *establishmentServiceClient) SetCancellationPolicy(ctx context.Context, in *CancellationPolicy, opts ...grpc.CallOption) (*CancellationPolicy, error) {
+	out := new(CancellationPolicy)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/SetCancellationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *FindRestaurantsByNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) GetCancellationPolicy(ctx context.Context, in *GetCancellationPolicyRequest, opts ...grpc.CallOption) (*CancellationPolicy, error) {
+	out := new(CancellationPolicy)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/GetCancellationPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *FindRestaurantsByNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) DeleteCancellationPolicy(ctx context.Context, in *DeleteCancellationPolicyRequest, opts ...grpc.CallOption) (*DeleteCancellationPolicyResponse, error) {
+	out := new(DeleteCancellationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteCancellationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *FindRestaurantsByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
+func (c *establishmentServiceClient) AddToFavourites(ctx context.Context, in *AddToFavouritesRequest, opts ...grpc.CallOption) (*AddToFavouritesResponse, error) {
+	out := new(AddToFavouritesResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/AddToFavourites", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Restaurants) > 0 {
-		for iNdEx := len(m.Restaurants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Restaurants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *establishmentServiceClient) RemoveFromFavourites(ctx context.Context, in *RemoveFromFavouritesRequest, opts ...grpc.CallOption) (*RemoveFromFavouritesResponse, error) {
+	out := new(RemoveFromFavouritesResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/RemoveFromFavourites", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Hotel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) ListFavouritesByUserId(ctx context.Context, in *ListFavouritesByUserIdRequest, opts ...grpc.CallOption) (*ListFavouritesByUserIdResponse, error) {
+	out := new(ListFavouritesByUserIdResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListFavouritesByUserId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Hotel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewResponse, error) {
+	out := new(CreateReviewResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Hotel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Location != nil {
-		{
-			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Images) > 0 {
-		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.WebsiteUrl) > 0 {
-		i -= len(m.WebsiteUrl)
-		copy(dAtA[i:], m.WebsiteUrl)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.WebsiteUrl)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.LicenceUrl) > 0 {
-		i -= len(m.LicenceUrl)
-		copy(dAtA[i:], m.LicenceUrl)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.LicenceUrl)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ContactNumber) > 0 {
-		i -= len(m.ContactNumber)
-		copy(dAtA[i:], m.ContactNumber)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ContactNumber)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Rating != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
-		i--
-		dAtA[i] = 0x2d
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.HotelName) > 0 {
-		i -= len(m.HotelName)
-		copy(dAtA[i:], m.HotelName)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OwnerId) > 0 {
-		i -= len(m.OwnerId)
-		copy(dAtA[i:], m.OwnerId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.OwnerId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.HotelId) > 0 {
-		i -= len(m.HotelId)
-		copy(dAtA[i:], m.HotelId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelId)))
-		i--
-		dAtA[i] = 0xa
+func (c *establishmentServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetHotelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error) {
+	out := new(DeleteReviewResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteReview", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetHotelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) CreateMedia(ctx context.Context, in *Image, opts ...grpc.CallOption) (*CreateImageRes, error) {
+	out := new(CreateImageRes)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateMedia", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetHotelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.HotelId) > 0 {
-		i -= len(m.HotelId)
-		copy(dAtA[i:], m.HotelId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelId)))
-		i--
-		dAtA[i] = 0xa
+func (c *establishmentServiceClient) SetBookingMode(ctx context.Context, in *BookingMode, opts ...grpc.CallOption) (*BookingMode, error) {
+	out := new(BookingMode)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/SetBookingMode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetHotelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) GetBookingMode(ctx context.Context, in *GetBookingModeRequest, opts ...grpc.CallOption) (*BookingMode, error) {
+	out := new(BookingMode)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/GetBookingMode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetHotelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) CreateAddOn(ctx context.Context, in *AddOn, opts ...grpc.CallOption) (*AddOn, error) {
+	out := new(AddOn)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateAddOn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetHotelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Hotel != nil {
-		{
-			size, err := m.Hotel.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *establishmentServiceClient) ListAddOns(ctx context.Context, in *ListAddOnsRequest, opts ...grpc.CallOption) (*ListAddOnsResponse, error) {
+	out := new(ListAddOnsResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListAddOns", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListHotelsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) UpdateAddOn(ctx context.Context, in *UpdateAddOnRequest, opts ...grpc.CallOption) (*UpdateAddOnResponse, error) {
+	out := new(UpdateAddOnResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateAddOn", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListHotelsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) DeleteAddOn(ctx context.Context, in *DeleteAddOnRequest, opts ...grpc.CallOption) (*DeleteAddOnResponse, error) {
+	out := new(DeleteAddOnResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteAddOn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListHotelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Limit != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Offset != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x8
+func (c *establishmentServiceClient) CreateClosure(ctx context.Context, in *Closure, opts ...grpc.CallOption) (*Closure, error) {
+	out := new(Closure)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/CreateClosure", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListHotelsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) ListClosures(ctx context.Context, in *ListClosuresRequest, opts ...grpc.CallOption) (*ListClosuresResponse, error) {
+	out := new(ListClosuresResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/ListClosures", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListHotelsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *establishmentServiceClient) UpdateClosure(ctx context.Context, in *UpdateClosureRequest, opts ...grpc.CallOption) (*UpdateClosureResponse, error) {
+	out := new(UpdateClosureResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/UpdateClosure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListHotelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Overall != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Overall))
-		i--
-		dAtA[i] = 0x10
+func (c *establishmentServiceClient) DeleteClosure(ctx context.Context, in *DeleteClosureRequest, opts ...grpc.CallOption) (*DeleteClosureResponse, error) {
+	out := new(DeleteClosureResponse)
+	err := c.cc.Invoke(ctx, "/establishment_service.EstablishmentService/DeleteClosure", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Hotels) > 0 {
-		for iNdEx := len(m.Hotels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Hotels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateHotelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EstablishmentServiceServer is the server API for EstablishmentService service.
+type EstablishmentServiceServer interface {
+	// ATTRACTION
+	CreateAttraction(context.Context, *Attraction) (*Attraction, error)
+	GetAttraction(context.Context, *GetAttractionRequest) (*GetAttractionResponse, error)
+	ListAttractions(context.Context, *ListAttractionsRequest) (*ListAttractionsResponse, error)
+	UpdateAttraction(context.Context, *UpdateAttractionRequest) (*UpdateAttractionResponse, error)
+	DeleteAttraction(context.Context, *DeleteAttractionRequest) (*DeleteAttractionResponse, error)
+	FindAttractionsByName(context.Context, *FindAttractionsByNameRequest) (*FindAttractionsByNameResponse, error)
+	ListAttractionsByLocation(context.Context, *ListAttractionsByLocationRequest) (*ListAttractionsByLocationResponse, error)
+	// RESTAURANT
+	CreateRestaurant(context.Context, *Restaurant) (*Restaurant, error)
+	GetRestaurant(context.Context, *GetRestaurantRequest) (*GetRestaurantResponse, error)
+	ListRestaurants(context.Context, *ListRestaurantsRequest) (*ListRestaurantsResponse, error)
+	UpdateRestaurant(context.Context, *UpdateRestaurantRequest) (*UpdateRestaurantResponse, error)
+	DeleteRestaurant(context.Context, *DeleteRestaurantRequest) (*DeleteRestaurantResponse, error)
+	FindRestaurantsByName(context.Context, *FindRestaurantsByNameRequest) (*FindRestaurantsByNameResponse, error)
+	ListRestaurantsByLocation(context.Context, *ListRestaurantsByLocationRequest) (*ListRestaurantsByLocationResponse, error)
+	// HOTEL
+	CreateHotel(context.Context, *Hotel) (*Hotel, error)
+	GetHotel(context.Context, *GetHotelRequest) (*GetHotelResponse, error)
+	ListHotels(context.Context, *ListHotelsRequest) (*ListHotelsResponse, error)
+	UpdateHotel(context.Context, *UpdateHotelRequest) (*UpdateHotelResponse, error)
+	DeleteHotel(context.Context, *DeleteHotelRequest) (*DeleteHotelResponse, error)
+	FindHotelsByName(context.Context, *FindHotelsByNameRequest) (*FindHotelsByNameResponse, error)
+	ListHotelsByLocation(context.Context, *ListHotelsByLocationRequest) (*ListHotelsByLocationResponse, error)
+	// ROOM
+	CreateRoom(context.Context, *Room) (*Room, error)
+	GetRoom(context.Context, *GetRoomRequest) (*GetRoomResponse, error)
+	ListRoomsByHotel(context.Context, *ListRoomsByHotelRequest) (*ListRoomsByHotelResponse, error)
+	UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error)
+	DeleteRoom(context.Context, *DeleteRoomRequest) (*DeleteRoomResponse, error)
+	// TICKET TYPE
+	CreateTicketType(context.Context, *TicketType) (*TicketType, error)
+	ListTicketTypesByAttraction(context.Context, *ListTicketTypesByAttractionRequest) (*ListTicketTypesByAttractionResponse, error)
+	UpdateTicketType(context.Context, *UpdateTicketTypeRequest) (*UpdateTicketTypeResponse, error)
+	DeleteTicketType(context.Context, *DeleteTicketTypeRequest) (*DeleteTicketTypeResponse, error)
+	// CANCELLATION POLICY
+	SetCancellationPolicy(context.Context, *CancellationPolicy) (*CancellationPolicy, error)
+	GetCancellationPolicy(context.Context, *GetCancellationPolicyRequest) (*CancellationPolicy, error)
+	DeleteCancellationPolicy(context.Context, *DeleteCancellationPolicyRequest) (*DeleteCancellationPolicyResponse, error)
+	// FAVOURITES
+	AddToFavourites(context.Context, *AddToFavouritesRequest) (*AddToFavouritesResponse, error)
+	RemoveFromFavourites(context.Context, *RemoveFromFavouritesRequest) (*RemoveFromFavouritesResponse, error)
+	ListFavouritesByUserId(context.Context, *ListFavouritesByUserIdRequest) (*ListFavouritesByUserIdResponse, error)
+	// REVIEW
+	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewResponse, error)
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
+	// MEDIA
+	CreateMedia(context.Context, *Image) (*CreateImageRes, error)
+	// BOOKING MODE
+	SetBookingMode(context.Context, *BookingMode) (*BookingMode, error)
+	GetBookingMode(context.Context, *GetBookingModeRequest) (*BookingMode, error)
+	// ADD-ON
+	CreateAddOn(context.Context, *AddOn) (*AddOn, error)
+	ListAddOns(context.Context, *ListAddOnsRequest) (*ListAddOnsResponse, error)
+	UpdateAddOn(context.Context, *UpdateAddOnRequest) (*UpdateAddOnResponse, error)
+	DeleteAddOn(context.Context, *DeleteAddOnRequest) (*DeleteAddOnResponse, error)
+	// CLOSURE
+	CreateClosure(context.Context, *Closure) (*Closure, error)
+	ListClosures(context.Context, *ListClosuresRequest) (*ListClosuresResponse, error)
+	UpdateClosure(context.Context, *UpdateClosureRequest) (*UpdateClosureResponse, error)
+	DeleteClosure(context.Context, *DeleteClosureRequest) (*DeleteClosureResponse, error)
 }
 
-func (m *UpdateHotelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedEstablishmentServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedEstablishmentServiceServer struct {
 }
 
-func (m *UpdateHotelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Hotel != nil {
-		{
-			size, err := m.Hotel.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedEstablishmentServiceServer) CreateAttraction(ctx context.Context, req *Attraction) (*Attraction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttraction not implemented")
 }
-
-func (m *UpdateHotelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedEstablishmentServiceServer) GetAttraction(ctx context.Context, req *GetAttractionRequest) (*GetAttractionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttraction not implemented")
 }
-
-func (m *UpdateHotelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedEstablishmentServiceServer) ListAttractions(ctx context.Context, req *ListAttractionsRequest) (*ListAttractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttractions not implemented")
 }
-
-func (m *UpdateHotelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Hotel != nil {
-		{
-			size, err := m.Hotel.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedEstablishmentServiceServer) UpdateAttraction(ctx context.Context, req *UpdateAttractionRequest) (*UpdateAttractionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttraction not implemented")
 }
-
-func (m *DeleteHotelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedEstablishmentServiceServer) DeleteAttraction(ctx context.Context, req *DeleteAttractionRequest) (*DeleteAttractionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttraction not implemented")
 }
-
-func (m *DeleteHotelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedEstablishmentServiceServer) FindAttractionsByName(ctx context.Context, req *FindAttractionsByNameRequest) (*FindAttractionsByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAttractionsByName not implemented")
 }
-
-func (m *DeleteHotelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.HotelId) > 0 {
-		i -= len(m.HotelId)
-		copy(dAtA[i:], m.HotelId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedEstablishmentServiceServer) ListAttractionsByLocation(ctx context.Context, req *ListAttractionsByLocationRequest) (*ListAttractionsByLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttractionsByLocation not implemented")
 }
-
-func (m *DeleteHotelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedEstablishmentServiceServer) CreateRestaurant(ctx context.Context, req *Restaurant) (*Restaurant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRestaurant not implemented")
 }
-
-func (m *DeleteHotelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedEstablishmentServiceServer) GetRestaurant(ctx context.Context, req *GetRestaurantRequest) (*GetRestaurantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRestaurant not implemented")
 }
-
-func (m *DeleteHotelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedEstablishmentServiceServer) ListRestaurants(ctx context.Context, req *ListRestaurantsRequest) (*ListRestaurantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRestaurants not implemented")
 }
-
-func (m *ListHotelsByLocationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedEstablishmentServiceServer) UpdateRestaurant(ctx context.Context, req *UpdateRestaurantRequest) (*UpdateRestaurantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRestaurant not implemented")
 }
-
-func (m *ListHotelsByLocationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedEstablishmentServiceServer) DeleteRestaurant(ctx context.Context, req *DeleteRestaurantRequest) (*DeleteRestaurantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRestaurant not implemented")
 }
-
-func (m *ListHotelsByLocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.StateProvince) > 0 {
-		i -= len(m.StateProvince)
-		copy(dAtA[i:], m.StateProvince)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.StateProvince)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.City) > 0 {
-		i -= len(m.City)
-		copy(dAtA[i:], m.City)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.City)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Country) > 0 {
-		i -= len(m.Country)
-		copy(dAtA[i:], m.Country)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Country)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Limit != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Offset != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedEstablishmentServiceServer) FindRestaurantsByName(ctx context.Context, req *FindRestaurantsByNameRequest) (*FindRestaurantsByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRestaurantsByName not implemented")
 }
-
-func (m *ListHotelsByLocationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedEstablishmentServiceServer) ListRestaurantsByLocation(ctx context.Context, req *ListRestaurantsByLocationRequest) (*ListRestaurantsByLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRestaurantsByLocation not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) CreateHotel(ctx context.Context, req *Hotel) (*Hotel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHotel not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) GetHotel(ctx context.Context, req *GetHotelRequest) (*GetHotelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHotel not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) ListHotels(ctx context.Context, req *ListHotelsRequest) (*ListHotelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHotels not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) UpdateHotel(ctx context.Context, req *UpdateHotelRequest) (*UpdateHotelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHotel not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) DeleteHotel(ctx context.Context, req *DeleteHotelRequest) (*DeleteHotelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHotel not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) FindHotelsByName(ctx context.Context, req *FindHotelsByNameRequest) (*FindHotelsByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindHotelsByName not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) ListHotelsByLocation(ctx context.Context, req *ListHotelsByLocationRequest) (*ListHotelsByLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHotelsByLocation not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) CreateRoom(ctx context.Context, req *Room) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) GetRoom(ctx context.Context, req *GetRoomRequest) (*GetRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoom not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) ListRoomsByHotel(ctx context.Context, req *ListRoomsByHotelRequest) (*ListRoomsByHotelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoomsByHotel not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) UpdateRoom(ctx context.Context, req *UpdateRoomRequest) (*UpdateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) DeleteRoom(ctx context.Context, req *DeleteRoomRequest) (*DeleteRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoom not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) CreateTicketType(ctx context.Context, req *TicketType) (*TicketType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTicketType not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) ListTicketTypesByAttraction(ctx context.Context, req *ListTicketTypesByAttractionRequest) (*ListTicketTypesByAttractionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketTypesByAttraction not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) UpdateTicketType(ctx context.Context, req *UpdateTicketTypeRequest) (*UpdateTicketTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTicketType not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) DeleteTicketType(ctx context.Context, req *DeleteTicketTypeRequest) (*DeleteTicketTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTicketType not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) SetCancellationPolicy(ctx context.Context, req *CancellationPolicy) (*CancellationPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCancellationPolicy not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) GetCancellationPolicy(ctx context.Context, req *GetCancellationPolicyRequest) (*CancellationPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCancellationPolicy not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) DeleteCancellationPolicy(ctx context.Context, req *DeleteCancellationPolicyRequest) (*DeleteCancellationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCancellationPolicy not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) AddToFavourites(ctx context.Context, req *AddToFavouritesRequest) (*AddToFavouritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToFavourites not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) RemoveFromFavourites(ctx context.Context, req *RemoveFromFavouritesRequest) (*RemoveFromFavouritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromFavourites not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) ListFavouritesByUserId(ctx context.Context, req *ListFavouritesByUserIdRequest) (*ListFavouritesByUserIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavouritesByUserId not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) CreateReview(ctx context.Context, req *CreateReviewRequest) (*CreateReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) ListReviews(ctx context.Context, req *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) DeleteReview(ctx context.Context, req *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) CreateMedia(ctx context.Context, req *Image) (*CreateImageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMedia not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) SetBookingMode(ctx context.Context, req *BookingMode) (*BookingMode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBookingMode not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) GetBookingMode(ctx context.Context, req *GetBookingModeRequest) (*BookingMode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingMode not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) CreateAddOn(ctx context.Context, req *AddOn) (*AddOn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAddOn not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) ListAddOns(ctx context.Context, req *ListAddOnsRequest) (*ListAddOnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddOns not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) UpdateAddOn(ctx context.Context, req *UpdateAddOnRequest) (*UpdateAddOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAddOn not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) DeleteAddOn(ctx context.Context, req *DeleteAddOnRequest) (*DeleteAddOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddOn not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) CreateClosure(ctx context.Context, req *Closure) (*Closure, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClosure not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) ListClosures(ctx context.Context, req *ListClosuresRequest) (*ListClosuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClosures not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) UpdateClosure(ctx context.Context, req *UpdateClosureRequest) (*UpdateClosureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClosure not implemented")
+}
+func (*UnimplementedEstablishmentServiceServer) DeleteClosure(ctx context.Context, req *DeleteClosureRequest) (*DeleteClosureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClosure not implemented")
 }
 
-func (m *ListHotelsByLocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterEstablishmentServiceServer(s *grpc.Server, srv EstablishmentServiceServer) {
+	s.RegisterService(&_EstablishmentService_serviceDesc, srv)
 }
 
-func (m *ListHotelsByLocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _EstablishmentService_CreateAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Attraction)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Count != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).CreateAttraction(ctx, in)
 	}
-	if len(m.Hotels) > 0 {
-		for iNdEx := len(m.Hotels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Hotels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/CreateAttraction",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).CreateAttraction(ctx, req.(*Attraction))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FindHotelsByNameRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_GetAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttractionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FindHotelsByNameRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FindHotelsByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).GetAttraction(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/GetAttraction",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).GetAttraction(ctx, req.(*GetAttractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FindHotelsByNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_ListAttractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttractionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FindHotelsByNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListAttractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListAttractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListAttractions(ctx, req.(*ListAttractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FindHotelsByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _EstablishmentService_UpdateAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttractionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Count != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).UpdateAttraction(ctx, in)
 	}
-	if len(m.Hotels) > 0 {
-		for iNdEx := len(m.Hotels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Hotels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/UpdateAttraction",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).UpdateAttraction(ctx, req.(*UpdateAttractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Favourite) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_DeleteAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttractionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).DeleteAttraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/DeleteAttraction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).DeleteAttraction(ctx, req.(*DeleteAttractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Favourite) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Favourite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _EstablishmentService_FindAttractionsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindAttractionsByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).FindAttractionsByName(ctx, in)
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/FindAttractionsByName",
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).FindAttractionsByName(ctx, req.(*FindAttractionsByNameRequest))
 	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstablishmentService_ListAttractionsByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttractionsByLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.EstablishmentId) > 0 {
-		i -= len(m.EstablishmentId)
-		copy(dAtA[i:], m.EstablishmentId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListAttractionsByLocation(ctx, in)
 	}
-	if len(m.FavouriteId) > 0 {
-		i -= len(m.FavouriteId)
-		copy(dAtA[i:], m.FavouriteId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.FavouriteId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListAttractionsByLocation",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListAttractionsByLocation(ctx, req.(*ListAttractionsByLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddToFavouritesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_CreateRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Restaurant)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddToFavouritesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddToFavouritesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).CreateRestaurant(ctx, in)
 	}
-	if m.Favourite != nil {
-		{
-			size, err := m.Favourite.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/CreateRestaurant",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).CreateRestaurant(ctx, req.(*Restaurant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddToFavouritesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_GetRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRestaurantRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddToFavouritesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddToFavouritesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).GetRestaurant(ctx, in)
 	}
-	if m.Favourite != nil {
-		{
-			size, err := m.Favourite.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/GetRestaurant",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).GetRestaurant(ctx, req.(*GetRestaurantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveFromFavouritesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_ListRestaurants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRestaurantsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveFromFavouritesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveFromFavouritesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListRestaurants(ctx, in)
 	}
-	if len(m.FavouriteId) > 0 {
-		i -= len(m.FavouriteId)
-		copy(dAtA[i:], m.FavouriteId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.FavouriteId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListRestaurants",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListRestaurants(ctx, req.(*ListRestaurantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RemoveFromFavouritesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_UpdateRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRestaurantRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveFromFavouritesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveFromFavouritesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).UpdateRestaurant(ctx, in)
 	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/UpdateRestaurant",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).UpdateRestaurant(ctx, req.(*UpdateRestaurantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListFavouritesByUserIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_DeleteRestaurant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRestaurantRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListFavouritesByUserIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListFavouritesByUserIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).DeleteRestaurant(ctx, in)
 	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/DeleteRestaurant",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).DeleteRestaurant(ctx, req.(*DeleteRestaurantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListFavouritesByUserIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_FindRestaurantsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRestaurantsByNameRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListFavouritesByUserIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListFavouritesByUserIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).FindRestaurantsByName(ctx, in)
 	}
-	if len(m.Favourites) > 0 {
-		for iNdEx := len(m.Favourites) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Favourites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/FindRestaurantsByName",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).FindRestaurantsByName(ctx, req.(*FindRestaurantsByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Review) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_ListRestaurantsByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRestaurantsByLocationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Review) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListRestaurantsByLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListRestaurantsByLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListRestaurantsByLocation(ctx, req.(*ListRestaurantsByLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Review) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x42
+func _EstablishmentService_CreateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hotel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).CreateHotel(ctx, in)
 	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/CreateHotel",
 	}
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).CreateHotel(ctx, req.(*Hotel))
 	}
-	if m.Rating != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
-		i--
-		dAtA[i] = 0x25
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstablishmentService_GetHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).GetHotel(ctx, in)
 	}
-	if len(m.EstablishmentId) > 0 {
-		i -= len(m.EstablishmentId)
-		copy(dAtA[i:], m.EstablishmentId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/GetHotel",
 	}
-	if len(m.ReviewId) > 0 {
-		i -= len(m.ReviewId)
-		copy(dAtA[i:], m.ReviewId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ReviewId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).GetHotel(ctx, req.(*GetHotelRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateReviewRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_ListHotels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHotelsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateReviewRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreateReviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListHotels(ctx, in)
 	}
-	if m.Review != nil {
-		{
-			size, err := m.Review.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListHotels",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListHotels(ctx, req.(*ListHotelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateReviewResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_UpdateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHotelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).UpdateHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/UpdateHotel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).UpdateHotel(ctx, req.(*UpdateHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateReviewResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreateReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _EstablishmentService_DeleteHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Review != nil {
-		{
-			size, err := m.Review.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEstablishment(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).DeleteHotel(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/DeleteHotel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).DeleteHotel(ctx, req.(*DeleteHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListReviewsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_FindHotelsByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindHotelsByNameRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).FindHotelsByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/FindHotelsByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).FindHotelsByName(ctx, req.(*FindHotelsByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListReviewsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _EstablishmentService_ListHotelsByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHotelsByLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListHotelsByLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListHotelsByLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListHotelsByLocation(ctx, req.(*ListHotelsByLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListReviewsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _EstablishmentService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Room)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.EstablishmentId) > 0 {
-		i -= len(m.EstablishmentId)
-		copy(dAtA[i:], m.EstablishmentId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).CreateRoom(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/CreateRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).CreateRoom(ctx, req.(*Room))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListReviewsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_GetRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).GetRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/GetRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).GetRoom(ctx, req.(*GetRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListReviewsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _EstablishmentService_ListRoomsByHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomsByHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListRoomsByHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListRoomsByHotel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListRoomsByHotel(ctx, req.(*ListRoomsByHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListReviewsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _EstablishmentService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Count != 0 {
-		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).UpdateRoom(ctx, in)
 	}
-	if len(m.Reviews) > 0 {
-		for iNdEx := len(m.Reviews) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reviews[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEstablishment(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/UpdateRoom",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteReviewRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_DeleteRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).DeleteRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/DeleteRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).DeleteRoom(ctx, req.(*DeleteRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteReviewRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _EstablishmentService_CreateTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).CreateTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/CreateTicketType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).CreateTicketType(ctx, req.(*TicketType))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteReviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _EstablishmentService_ListTicketTypesByAttraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketTypesByAttractionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ReviewId) > 0 {
-		i -= len(m.ReviewId)
-		copy(dAtA[i:], m.ReviewId)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ReviewId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListTicketTypesByAttraction(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListTicketTypesByAttraction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListTicketTypesByAttraction(ctx, req.(*ListTicketTypesByAttractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteReviewResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_UpdateTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketTypeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).UpdateTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/UpdateTicketType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).UpdateTicketType(ctx, req.(*UpdateTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteReviewResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _EstablishmentService_DeleteTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).DeleteTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/DeleteTicketType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).DeleteTicketType(ctx, req.(*DeleteTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _EstablishmentService_SetCancellationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancellationPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).SetCancellationPolicy(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/SetCancellationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).SetCancellationPolicy(ctx, req.(*CancellationPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CreateImageRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _EstablishmentService_GetCancellationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCancellationPolicyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateImageRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CreateImageRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).GetCancellationPolicy(ctx, in)
 	}
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/GetCancellationPolicy",
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintEstablishment(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEstablishment(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).GetCancellationPolicy(ctx, req.(*GetCancellationPolicyRequest))
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return interceptor(ctx, in, info, handler)
 }
-func (m *Image) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ImageId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+
+func _EstablishmentService_DeleteCancellationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCancellationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.EstablishmentId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).DeleteCancellationPolicy(ctx, in)
 	}
-	l = len(m.ImageUrl)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/DeleteCancellationPolicy",
 	}
-	l = len(m.Category)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).DeleteCancellationPolicy(ctx, req.(*DeleteCancellationPolicyRequest))
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstablishmentService_AddToFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToFavouritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).AddToFavourites(ctx, in)
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/AddToFavourites",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).AddToFavourites(ctx, req.(*AddToFavouritesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Location) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LocationId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.EstablishmentId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+func _EstablishmentService_RemoveFromFavourites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromFavouritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).RemoveFromFavourites(ctx, in)
 	}
-	if m.Latitude != 0 {
-		n += 5
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/RemoveFromFavourites",
 	}
-	if m.Longitude != 0 {
-		n += 5
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).RemoveFromFavourites(ctx, req.(*RemoveFromFavouritesRequest))
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstablishmentService_ListFavouritesByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavouritesByUserIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.City)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListFavouritesByUserId(ctx, in)
 	}
-	l = len(m.StateProvince)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListFavouritesByUserId",
 	}
-	l = len(m.Category)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListFavouritesByUserId(ctx, req.(*ListFavouritesByUserIdRequest))
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstablishmentService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).CreateReview(ctx, in)
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/CreateReview",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Attraction) Size() (n int) {
-	if m == nil {
-		return 0
+func _EstablishmentService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.AttractionId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListReviews(ctx, in)
 	}
-	l = len(m.OwnerId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListReviews",
 	}
-	l = len(m.AttractionName)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstablishmentService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Rating != 0 {
-		n += 5
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).DeleteReview(ctx, in)
 	}
-	l = len(m.ContactNumber)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/DeleteReview",
 	}
-	l = len(m.LicenceUrl)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
 	}
-	l = len(m.WebsiteUrl)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EstablishmentService_CreateMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Image)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
-	}
-	if m.Location != nil {
-		l = m.Location.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).CreateMedia(ctx, in)
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/CreateMedia",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).CreateMedia(ctx, req.(*Image))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAttractionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AttractionId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _EstablishmentService_SetBookingMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookingMode)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *GetAttractionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).SetBookingMode(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Attraction != nil {
-		l = m.Attraction.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/SetBookingMode",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).SetBookingMode(ctx, req.(*BookingMode))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListAttractionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _EstablishmentService_GetBookingMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Offset != 0 {
-		n += 1 + sovEstablishment(uint64(m.Offset))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).GetBookingMode(ctx, in)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovEstablishment(uint64(m.Limit))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/GetBookingMode",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).GetBookingMode(ctx, req.(*GetBookingModeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListAttractionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _EstablishmentService_CreateAddOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOn)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Attractions) > 0 {
-		for _, e := range m.Attractions {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).CreateAddOn(ctx, in)
 	}
-	if m.Overall != 0 {
-		n += 1 + sovEstablishment(uint64(m.Overall))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/CreateAddOn",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).CreateAddOn(ctx, req.(*AddOn))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateAttractionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _EstablishmentService_ListAddOns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddOnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Attraction != nil {
-		l = m.Attraction.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListAddOns(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListAddOns",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListAddOns(ctx, req.(*ListAddOnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateAttractionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _EstablishmentService_UpdateAddOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAddOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Attraction != nil {
-		l = m.Attraction.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).UpdateAddOn(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/UpdateAddOn",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).UpdateAddOn(ctx, req.(*UpdateAddOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteAttractionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _EstablishmentService_DeleteAddOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAddOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.AttractionId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).DeleteAddOn(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/DeleteAddOn",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).DeleteAddOn(ctx, req.(*DeleteAddOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteAttractionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _EstablishmentService_CreateClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Closure)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).CreateClosure(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/CreateClosure",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).CreateClosure(ctx, req.(*Closure))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListAttractionsByLocationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Offset != 0 {
-		n += 1 + sovEstablishment(uint64(m.Offset))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovEstablishment(uint64(m.Limit))
-	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+func _EstablishmentService_ListClosures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClosuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.City)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).ListClosures(ctx, in)
 	}
-	l = len(m.StateProvince)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/ListClosures",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).ListClosures(ctx, req.(*ListClosuresRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListAttractionsByLocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _EstablishmentService_UpdateClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Attractions) > 0 {
-		for _, e := range m.Attractions {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).UpdateClosure(ctx, in)
 	}
-	if m.Count != 0 {
-		n += 1 + sovEstablishment(uint64(m.Count))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/UpdateClosure",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).UpdateClosure(ctx, req.(*UpdateClosureRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FindAttractionsByNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _EstablishmentService_DeleteClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if interceptor == nil {
+		return srv.(EstablishmentServiceServer).DeleteClosure(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/establishment_service.EstablishmentService/DeleteClosure",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EstablishmentServiceServer).DeleteClosure(ctx, req.(*DeleteClosureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FindAttractionsByNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Attractions) > 0 {
-		for _, e := range m.Attractions {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
-	}
-	if m.Count != 0 {
-		n += 1 + sovEstablishment(uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var _EstablishmentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "establishment_service.EstablishmentService",
+	HandlerType: (*EstablishmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAttraction",
+			Handler:    _EstablishmentService_CreateAttraction_Handler,
+		},
+		{
+			MethodName: "GetAttraction",
+			Handler:    _EstablishmentService_GetAttraction_Handler,
+		},
+		{
+			MethodName: "ListAttractions",
+			Handler:    _EstablishmentService_ListAttractions_Handler,
+		},
+		{
+			MethodName: "UpdateAttraction",
+			Handler:    _EstablishmentService_UpdateAttraction_Handler,
+		},
+		{
+			MethodName: "DeleteAttraction",
+			Handler:    _EstablishmentService_DeleteAttraction_Handler,
+		},
+		{
+			MethodName: "FindAttractionsByName",
+			Handler:    _EstablishmentService_FindAttractionsByName_Handler,
+		},
+		{
+			MethodName: "ListAttractionsByLocation",
+			Handler:    _EstablishmentService_ListAttractionsByLocation_Handler,
+		},
+		{
+			MethodName: "CreateRestaurant",
+			Handler:    _EstablishmentService_CreateRestaurant_Handler,
+		},
+		{
+			MethodName: "GetRestaurant",
+			Handler:    _EstablishmentService_GetRestaurant_Handler,
+		},
+		{
+			MethodName: "ListRestaurants",
+			Handler:    _EstablishmentService_ListRestaurants_Handler,
+		},
+		{
+			MethodName: "UpdateRestaurant",
+			Handler:    _EstablishmentService_UpdateRestaurant_Handler,
+		},
+		{
+			MethodName: "DeleteRestaurant",
+			Handler:    _EstablishmentService_DeleteRestaurant_Handler,
+		},
+		{
+			MethodName: "FindRestaurantsByName",
+			Handler:    _EstablishmentService_FindRestaurantsByName_Handler,
+		},
+		{
+			MethodName: "ListRestaurantsByLocation",
+			Handler:    _EstablishmentService_ListRestaurantsByLocation_Handler,
+		},
+		{
+			MethodName: "CreateHotel",
+			Handler:    _EstablishmentService_CreateHotel_Handler,
+		},
+		{
+			MethodName: "GetHotel",
+			Handler:    _EstablishmentService_GetHotel_Handler,
+		},
+		{
+			MethodName: "ListHotels",
+			Handler:    _EstablishmentService_ListHotels_Handler,
+		},
+		{
+			MethodName: "UpdateHotel",
+			Handler:    _EstablishmentService_UpdateHotel_Handler,
+		},
+		{
+			MethodName: "DeleteHotel",
+			Handler:    _EstablishmentService_DeleteHotel_Handler,
+		},
+		{
+			MethodName: "FindHotelsByName",
+			Handler:    _EstablishmentService_FindHotelsByName_Handler,
+		},
+		{
+			MethodName: "ListHotelsByLocation",
+			Handler:    _EstablishmentService_ListHotelsByLocation_Handler,
+		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _EstablishmentService_CreateRoom_Handler,
+		},
+		{
+			MethodName: "GetRoom",
+			Handler:    _EstablishmentService_GetRoom_Handler,
+		},
+		{
+			MethodName: "ListRoomsByHotel",
+			Handler:    _EstablishmentService_ListRoomsByHotel_Handler,
+		},
+		{
+			MethodName: "UpdateRoom",
+			Handler:    _EstablishmentService_UpdateRoom_Handler,
+		},
+		{
+			MethodName: "DeleteRoom",
+			Handler:    _EstablishmentService_DeleteRoom_Handler,
+		},
+		{
+			MethodName: "CreateTicketType",
+			Handler:    _EstablishmentService_CreateTicketType_Handler,
+		},
+		{
+			MethodName: "ListTicketTypesByAttraction",
+			Handler:    _EstablishmentService_ListTicketTypesByAttraction_Handler,
+		},
+		{
+			MethodName: "UpdateTicketType",
+			Handler:    _EstablishmentService_UpdateTicketType_Handler,
+		},
+		{
+			MethodName: "DeleteTicketType",
+			Handler:    _EstablishmentService_DeleteTicketType_Handler,
+		},
+		{
+			MethodName: "SetCancellationPolicy",
+			Handler:    _EstablishmentService_SetCancellationPolicy_Handler,
+		},
+		{
+			MethodName: "GetCancellationPolicy",
+			Handler:    _EstablishmentService_GetCancellationPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteCancellationPolicy",
+			Handler:    _EstablishmentService_DeleteCancellationPolicy_Handler,
+		},
+		{
+			MethodName: "AddToFavourites",
+			Handler:    _EstablishmentService_AddToFavourites_Handler,
+		},
+		{
+			MethodName: "RemoveFromFavourites",
+			Handler:    _EstablishmentService_RemoveFromFavourites_Handler,
+		},
+		{
+			MethodName: "ListFavouritesByUserId",
+			Handler:    _EstablishmentService_ListFavouritesByUserId_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _EstablishmentService_CreateReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _EstablishmentService_ListReviews_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _EstablishmentService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "CreateMedia",
+			Handler:    _EstablishmentService_CreateMedia_Handler,
+		},
+		{
+			MethodName: "SetBookingMode",
+			Handler:    _EstablishmentService_SetBookingMode_Handler,
+		},
+		{
+			MethodName: "GetBookingMode",
+			Handler:    _EstablishmentService_GetBookingMode_Handler,
+		},
+		{
+			MethodName: "CreateAddOn",
+			Handler:    _EstablishmentService_CreateAddOn_Handler,
+		},
+		{
+			MethodName: "ListAddOns",
+			Handler:    _EstablishmentService_ListAddOns_Handler,
+		},
+		{
+			MethodName: "UpdateAddOn",
+			Handler:    _EstablishmentService_UpdateAddOn_Handler,
+		},
+		{
+			MethodName: "DeleteAddOn",
+			Handler:    _EstablishmentService_DeleteAddOn_Handler,
+		},
+		{
+			MethodName: "CreateClosure",
+			Handler:    _EstablishmentService_CreateClosure_Handler,
+		},
+		{
+			MethodName: "ListClosures",
+			Handler:    _EstablishmentService_ListClosures_Handler,
+		},
+		{
+			MethodName: "UpdateClosure",
+			Handler:    _EstablishmentService_UpdateClosure_Handler,
+		},
+		{
+			MethodName: "DeleteClosure",
+			Handler:    _EstablishmentService_DeleteClosure_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "establishment-proto/establishment.proto",
 }
 
-func (m *Restaurant) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Image) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Image) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Image) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RestaurantId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.OwnerId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.RestaurantName)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Rating != 0 {
-		n += 5
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OpeningHours)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.ImageUrl) > 0 {
+		i -= len(m.ImageUrl)
+		copy(dAtA[i:], m.ImageUrl)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ImageUrl)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ContactNumber)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.LicenceUrl)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.WebsiteUrl)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
-	}
-	if m.Location != nil {
-		l = m.Location.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ImageId) > 0 {
+		i -= len(m.ImageId)
+		copy(dAtA[i:], m.ImageId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ImageId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRestaurantRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RestaurantId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Location) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRestaurantResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Restaurant != nil {
-		l = m.Restaurant.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *Location) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListRestaurantsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Location) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Offset != 0 {
-		n += 1 + sovEstablishment(uint64(m.Offset))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovEstablishment(uint64(m.Limit))
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func (m *ListRestaurantsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
 	}
-	var l int
-	_ = l
-	if len(m.Restaurants) > 0 {
-		for _, e := range m.Restaurants {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Overall != 0 {
-		n += 1 + sovEstablishment(uint64(m.Overall))
+	if len(m.StateProvince) > 0 {
+		i -= len(m.StateProvince)
+		copy(dAtA[i:], m.StateProvince)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.StateProvince)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *UpdateRestaurantRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	if m.Restaurant != nil {
-		l = m.Restaurant.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.Longitude != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Longitude))))
+		i--
+		dAtA[i] = 0x2d
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Latitude != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Latitude))))
+		i--
+		dAtA[i] = 0x25
 	}
-	return n
-}
-
-func (m *UpdateRestaurantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Restaurant != nil {
-		l = m.Restaurant.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.LocationId) > 0 {
+		i -= len(m.LocationId)
+		copy(dAtA[i:], m.LocationId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.LocationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteRestaurantRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RestaurantId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Attraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteRestaurantResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *Attraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListRestaurantsByLocationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Attraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Offset != 0 {
-		n += 1 + sovEstablishment(uint64(m.Offset))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovEstablishment(uint64(m.Limit))
+	if len(m.TicketTypes) > 0 {
+		for iNdEx := len(m.TicketTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TicketTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.WindowQuota != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.WindowQuota))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	l = len(m.City)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.DailyQuota != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.DailyQuota))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	l = len(m.StateProvince)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.EntryWindowMinutes != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.EntryWindowMinutes))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.OpeningHours) > 0 {
+		i -= len(m.OpeningHours)
+		copy(dAtA[i:], m.OpeningHours)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.OpeningHours)))
+		i--
+		dAtA[i] = 0x72
 	}
-	return n
-}
-
-func (m *ListRestaurantsByLocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	var l int
-	_ = l
-	if len(m.Restaurants) > 0 {
-		for _, e := range m.Restaurants {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Location != nil {
+		{
+			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Count != 0 {
-		n += 1 + sovEstablishment(uint64(m.Count))
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.WebsiteUrl) > 0 {
+		i -= len(m.WebsiteUrl)
+		copy(dAtA[i:], m.WebsiteUrl)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.WebsiteUrl)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	if len(m.LicenceUrl) > 0 {
+		i -= len(m.LicenceUrl)
+		copy(dAtA[i:], m.LicenceUrl)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.LicenceUrl)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ContactNumber) > 0 {
+		i -= len(m.ContactNumber)
+		copy(dAtA[i:], m.ContactNumber)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ContactNumber)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AttractionName) > 0 {
+		i -= len(m.AttractionName)
+		copy(dAtA[i:], m.AttractionName)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OwnerId) > 0 {
+		i -= len(m.OwnerId)
+		copy(dAtA[i:], m.OwnerId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.OwnerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttractionId) > 0 {
+		i -= len(m.AttractionId)
+		copy(dAtA[i:], m.AttractionId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FindRestaurantsByNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAttractionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAttractionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAttractionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.AttractionId) > 0 {
+		i -= len(m.AttractionId)
+		copy(dAtA[i:], m.AttractionId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FindRestaurantsByNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAttractionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAttractionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAttractionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Restaurants) > 0 {
-		for _, e := range m.Restaurants {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
-	}
-	if m.Count != 0 {
-		n += 1 + sovEstablishment(uint64(m.Count))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Attraction != nil {
+		{
+			size, err := m.Attraction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Hotel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListAttractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAttractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAttractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.HotelId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.OwnerId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.HotelName)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.Rating != 0 {
-		n += 5
-	}
-	l = len(m.ContactNumber)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.LicenceUrl)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.WebsiteUrl)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
-	}
-	if m.Location != nil {
-		l = m.Location.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Offset != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetHotelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.HotelId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListAttractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetHotelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Hotel != nil {
-		l = m.Hotel.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *ListAttractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListHotelsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListAttractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Offset != 0 {
-		n += 1 + sovEstablishment(uint64(m.Offset))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovEstablishment(uint64(m.Limit))
+	if m.Overall != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Overall))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Attractions) > 0 {
+		for iNdEx := len(m.Attractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListHotelsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateAttractionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAttractionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAttractionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Hotels) > 0 {
-		for _, e := range m.Hotels {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
-	}
-	if m.Overall != 0 {
-		n += 1 + sovEstablishment(uint64(m.Overall))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Attraction != nil {
+		{
+			size, err := m.Attraction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateHotelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateAttractionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAttractionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAttractionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Hotel != nil {
-		l = m.Hotel.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Attraction != nil {
+		{
+			size, err := m.Attraction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateHotelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteAttractionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAttractionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAttractionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Hotel != nil {
-		l = m.Hotel.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.AttractionId) > 0 {
+		i -= len(m.AttractionId)
+		copy(dAtA[i:], m.AttractionId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteHotelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.HotelId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *DeleteAttractionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteHotelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeleteAttractionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAttractionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListHotelsByLocationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListAttractionsByLocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAttractionsByLocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAttractionsByLocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Offset != 0 {
-		n += 1 + sovEstablishment(uint64(m.Offset))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovEstablishment(uint64(m.Limit))
+	if len(m.StateProvince) > 0 {
+		i -= len(m.StateProvince)
+		copy(dAtA[i:], m.StateProvince)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.StateProvince)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.City)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.StateProvince)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Offset != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListHotelsByLocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListAttractionsByLocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAttractionsByLocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAttractionsByLocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Hotels) > 0 {
-		for _, e := range m.Hotels {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Count != 0 {
-		n += 1 + sovEstablishment(uint64(m.Count))
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Attractions) > 0 {
+		for iNdEx := len(m.Attractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FindHotelsByNameRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FindAttractionsByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FindAttractionsByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindAttractionsByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FindHotelsByNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FindAttractionsByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FindAttractionsByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindAttractionsByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Hotels) > 0 {
-		for _, e := range m.Hotels {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Count != 0 {
-		n += 1 + sovEstablishment(uint64(m.Count))
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Attractions) > 0 {
+		for iNdEx := len(m.Attractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Favourite) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FavouriteId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	l = len(m.EstablishmentId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+func (m *Restaurant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Restaurant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Restaurant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.SlotMinutes != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.SlotMinutes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.SeatCapacity != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.SeatCapacity))
+		i--
+		dAtA[i] = 0x78
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
-}
-
-func (m *AddToFavouritesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x62
 	}
-	var l int
-	_ = l
-	if m.Favourite != nil {
-		l = m.Favourite.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.Location != nil {
+		{
+			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	return n
-}
-
-func (m *AddToFavouritesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.WebsiteUrl) > 0 {
+		i -= len(m.WebsiteUrl)
+		copy(dAtA[i:], m.WebsiteUrl)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.WebsiteUrl)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	if m.Favourite != nil {
-		l = m.Favourite.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.LicenceUrl) > 0 {
+		i -= len(m.LicenceUrl)
+		copy(dAtA[i:], m.LicenceUrl)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.LicenceUrl)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ContactNumber) > 0 {
+		i -= len(m.ContactNumber)
+		copy(dAtA[i:], m.ContactNumber)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ContactNumber)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *RemoveFromFavouritesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.OpeningHours) > 0 {
+		i -= len(m.OpeningHours)
+		copy(dAtA[i:], m.OpeningHours)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.OpeningHours)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.FavouriteId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x2d
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *RemoveFromFavouritesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RestaurantName) > 0 {
+		i -= len(m.RestaurantName)
+		copy(dAtA[i:], m.RestaurantName)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RestaurantName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
+	if len(m.OwnerId) > 0 {
+		i -= len(m.OwnerId)
+		copy(dAtA[i:], m.OwnerId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.OwnerId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.RestaurantId) > 0 {
+		i -= len(m.RestaurantId)
+		copy(dAtA[i:], m.RestaurantId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RestaurantId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListFavouritesByUserIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRestaurantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRestaurantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRestaurantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.RestaurantId) > 0 {
+		i -= len(m.RestaurantId)
+		copy(dAtA[i:], m.RestaurantId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RestaurantId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListFavouritesByUserIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRestaurantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRestaurantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRestaurantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Favourites) > 0 {
-		for _, e := range m.Favourites {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Restaurant != nil {
+		{
+			size, err := m.Restaurant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Review) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListRestaurantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRestaurantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRestaurantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ReviewId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.EstablishmentId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.Offset != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Rating != 0 {
-		n += 5
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRestaurantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ListRestaurantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRestaurantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.Overall != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Overall))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	if len(m.Restaurants) > 0 {
+		for iNdEx := len(m.Restaurants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Restaurants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRestaurantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRestaurantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRestaurantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Restaurant != nil {
+		{
+			size, err := m.Restaurant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateReviewRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateRestaurantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRestaurantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRestaurantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Review != nil {
-		l = m.Review.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Restaurant != nil {
+		{
+			size, err := m.Restaurant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateReviewResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteRestaurantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRestaurantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRestaurantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Review != nil {
-		l = m.Review.Size()
-		n += 1 + l + sovEstablishment(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RestaurantId) > 0 {
+		i -= len(m.RestaurantId)
+		copy(dAtA[i:], m.RestaurantId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RestaurantId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRestaurantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRestaurantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRestaurantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRestaurantsByLocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRestaurantsByLocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRestaurantsByLocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StateProvince) > 0 {
+		i -= len(m.StateProvince)
+		copy(dAtA[i:], m.StateProvince)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.StateProvince)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offset != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRestaurantsByLocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRestaurantsByLocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRestaurantsByLocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Restaurants) > 0 {
+		for iNdEx := len(m.Restaurants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Restaurants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindRestaurantsByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindRestaurantsByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindRestaurantsByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindRestaurantsByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindRestaurantsByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindRestaurantsByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Restaurants) > 0 {
+		for iNdEx := len(m.Restaurants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Restaurants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hotel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hotel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hotel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Rooms) > 0 {
+		for iNdEx := len(m.Rooms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rooms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Location != nil {
+		{
+			size, err := m.Location.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.WebsiteUrl) > 0 {
+		i -= len(m.WebsiteUrl)
+		copy(dAtA[i:], m.WebsiteUrl)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.WebsiteUrl)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LicenceUrl) > 0 {
+		i -= len(m.LicenceUrl)
+		copy(dAtA[i:], m.LicenceUrl)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.LicenceUrl)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ContactNumber) > 0 {
+		i -= len(m.ContactNumber)
+		copy(dAtA[i:], m.ContactNumber)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ContactNumber)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HotelName) > 0 {
+		i -= len(m.HotelName)
+		copy(dAtA[i:], m.HotelName)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OwnerId) > 0 {
+		i -= len(m.OwnerId)
+		copy(dAtA[i:], m.OwnerId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.OwnerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HotelId) > 0 {
+		i -= len(m.HotelId)
+		copy(dAtA[i:], m.HotelId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHotelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHotelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHotelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.HotelId) > 0 {
+		i -= len(m.HotelId)
+		copy(dAtA[i:], m.HotelId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHotelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHotelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHotelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hotel != nil {
+		{
+			size, err := m.Hotel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListHotelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListHotelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListHotelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offset != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListHotelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListHotelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListHotelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Overall != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Overall))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hotels) > 0 {
+		for iNdEx := len(m.Hotels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hotels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateHotelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateHotelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateHotelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hotel != nil {
+		{
+			size, err := m.Hotel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateHotelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateHotelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateHotelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Hotel != nil {
+		{
+			size, err := m.Hotel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteHotelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteHotelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteHotelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.HotelId) > 0 {
+		i -= len(m.HotelId)
+		copy(dAtA[i:], m.HotelId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteHotelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteHotelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteHotelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListHotelsByLocationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListHotelsByLocationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListHotelsByLocationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StateProvince) > 0 {
+		i -= len(m.StateProvince)
+		copy(dAtA[i:], m.StateProvince)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.StateProvince)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.City) > 0 {
+		i -= len(m.City)
+		copy(dAtA[i:], m.City)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.City)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Country) > 0 {
+		i -= len(m.Country)
+		copy(dAtA[i:], m.Country)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Country)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offset != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListHotelsByLocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListHotelsByLocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListHotelsByLocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hotels) > 0 {
+		for iNdEx := len(m.Hotels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hotels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindHotelsByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindHotelsByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindHotelsByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindHotelsByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindHotelsByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindHotelsByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hotels) > 0 {
+		for iNdEx := len(m.Hotels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hotels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Room) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Room) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Room) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Discount != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Discount))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if len(m.Holidays) > 0 {
+		i -= len(m.Holidays)
+		copy(dAtA[i:], m.Holidays)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Holidays)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NumberOfRooms != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.NumberOfRooms))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.HotelId) > 0 {
+		i -= len(m.HotelId)
+		copy(dAtA[i:], m.HotelId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRoomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRoomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRoomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRoomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRoomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRoomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Room != nil {
+		{
+			size, err := m.Room.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRoomsByHotelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRoomsByHotelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRoomsByHotelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.HotelId) > 0 {
+		i -= len(m.HotelId)
+		copy(dAtA[i:], m.HotelId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.HotelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListRoomsByHotelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRoomsByHotelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListRoomsByHotelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rooms) > 0 {
+		for iNdEx := len(m.Rooms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rooms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRoomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRoomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRoomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Room != nil {
+		{
+			size, err := m.Room.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRoomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRoomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRoomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Room != nil {
+		{
+			size, err := m.Room.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRoomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRoomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRoomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RoomId) > 0 {
+		i -= len(m.RoomId)
+		copy(dAtA[i:], m.RoomId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.RoomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRoomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRoomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRoomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TicketType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TicketType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TicketType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AttractionId) > 0 {
+		i -= len(m.AttractionId)
+		copy(dAtA[i:], m.AttractionId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TicketTypeId) > 0 {
+		i -= len(m.TicketTypeId)
+		copy(dAtA[i:], m.TicketTypeId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.TicketTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTicketTypesByAttractionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTicketTypesByAttractionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTicketTypesByAttractionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AttractionId) > 0 {
+		i -= len(m.AttractionId)
+		copy(dAtA[i:], m.AttractionId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AttractionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTicketTypesByAttractionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTicketTypesByAttractionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTicketTypesByAttractionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TicketTypes) > 0 {
+		for iNdEx := len(m.TicketTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TicketTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTicketTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTicketTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTicketTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TicketType != nil {
+		{
+			size, err := m.TicketType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTicketTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTicketTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTicketTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TicketType != nil {
+		{
+			size, err := m.TicketType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteTicketTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTicketTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteTicketTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TicketTypeId) > 0 {
+		i -= len(m.TicketTypeId)
+		copy(dAtA[i:], m.TicketTypeId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.TicketTypeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteTicketTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTicketTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteTicketTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancellationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancellationRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancellationRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangeFeePercent != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ChangeFeePercent))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.RefundPercent != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RefundPercent))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.HoursBefore != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.HoursBefore))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancellationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancellationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancellationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCancellationPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCancellationPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCancellationPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteCancellationPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCancellationPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteCancellationPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteCancellationPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteCancellationPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteCancellationPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Favourite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Favourite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Favourite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FavouriteId) > 0 {
+		i -= len(m.FavouriteId)
+		copy(dAtA[i:], m.FavouriteId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.FavouriteId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToFavouritesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToFavouritesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToFavouritesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Favourite != nil {
+		{
+			size, err := m.Favourite.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddToFavouritesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToFavouritesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToFavouritesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Favourite != nil {
+		{
+			size, err := m.Favourite.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromFavouritesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromFavouritesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromFavouritesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FavouriteId) > 0 {
+		i -= len(m.FavouriteId)
+		copy(dAtA[i:], m.FavouriteId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.FavouriteId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveFromFavouritesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveFromFavouritesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveFromFavouritesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFavouritesByUserIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFavouritesByUserIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFavouritesByUserIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFavouritesByUserIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFavouritesByUserIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFavouritesByUserIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Favourites) > 0 {
+		for iNdEx := len(m.Favourites) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Favourites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Review) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Review) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Review) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Rating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Rating))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReviewId) > 0 {
+		i -= len(m.ReviewId)
+		copy(dAtA[i:], m.ReviewId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ReviewId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateReviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateReviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateReviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Review != nil {
+		{
+			size, err := m.Review.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateReviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateReviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Review != nil {
+		{
+			size, err := m.Review.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListReviewsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReviewsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListReviewsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListReviewsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReviewsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListReviewsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reviews) > 0 {
+		for iNdEx := len(m.Reviews) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reviews[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteReviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteReviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteReviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ReviewId) > 0 {
+		i -= len(m.ReviewId)
+		copy(dAtA[i:], m.ReviewId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ReviewId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteReviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteReviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateImageRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateImageRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateImageRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BookingMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BookingMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BookingMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBookingModeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBookingModeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBookingModeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddOn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddOn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Price != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AddOnId) > 0 {
+		i -= len(m.AddOnId)
+		copy(dAtA[i:], m.AddOnId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AddOnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAddOnsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAddOnsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAddOnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAddOnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAddOnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAddOnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AddOns) > 0 {
+		for iNdEx := len(m.AddOns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddOns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAddOnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAddOnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAddOnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AddOn != nil {
+		{
+			size, err := m.AddOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAddOnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAddOnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAddOnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AddOn != nil {
+		{
+			size, err := m.AddOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAddOnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAddOnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAddOnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AddOnId) > 0 {
+		i -= len(m.AddOnId)
+		copy(dAtA[i:], m.AddOnId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.AddOnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAddOnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAddOnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAddOnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Closure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Closure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Closure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Weekdays) > 0 {
+		for iNdEx := len(m.Weekdays) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Weekdays[iNdEx])
+			copy(dAtA[i:], m.Weekdays[iNdEx])
+			i = encodeVarintEstablishment(dAtA, i, uint64(len(m.Weekdays[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClosureId) > 0 {
+		i -= len(m.ClosureId)
+		copy(dAtA[i:], m.ClosureId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ClosureId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClosuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClosuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClosuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EstablishmentId) > 0 {
+		i -= len(m.EstablishmentId)
+		copy(dAtA[i:], m.EstablishmentId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.EstablishmentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListClosuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClosuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClosuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintEstablishment(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Closures) > 0 {
+		for iNdEx := len(m.Closures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Closures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEstablishment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateClosureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateClosureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateClosureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Closure != nil {
+		{
+			size, err := m.Closure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateClosureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateClosureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateClosureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Closure != nil {
+		{
+			size, err := m.Closure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEstablishment(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteClosureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteClosureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteClosureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ClosureId) > 0 {
+		i -= len(m.ClosureId)
+		copy(dAtA[i:], m.ClosureId)
+		i = encodeVarintEstablishment(dAtA, i, uint64(len(m.ClosureId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteClosureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteClosureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteClosureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEstablishment(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEstablishment(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Image) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ImageId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.ImageUrl)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Location) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LocationId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Latitude != 0 {
+		n += 5
+	}
+	if m.Longitude != 0 {
+		n += 5
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.StateProvince)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Attraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttractionId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.OwnerId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.AttractionName)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	l = len(m.ContactNumber)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.LicenceUrl)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.WebsiteUrl)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Location != nil {
+		l = m.Location.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.OpeningHours)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.EntryWindowMinutes != 0 {
+		n += 1 + sovEstablishment(uint64(m.EntryWindowMinutes))
+	}
+	if m.DailyQuota != 0 {
+		n += 2 + sovEstablishment(uint64(m.DailyQuota))
+	}
+	if m.WindowQuota != 0 {
+		n += 2 + sovEstablishment(uint64(m.WindowQuota))
+	}
+	if len(m.TicketTypes) > 0 {
+		for _, e := range m.TicketTypes {
+			l = e.Size()
+			n += 2 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAttractionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttractionId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAttractionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attraction != nil {
+		l = m.Attraction.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAttractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovEstablishment(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEstablishment(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAttractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attractions) > 0 {
+		for _, e := range m.Attractions {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Overall != 0 {
+		n += 1 + sovEstablishment(uint64(m.Overall))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateAttractionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attraction != nil {
+		l = m.Attraction.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateAttractionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attraction != nil {
+		l = m.Attraction.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAttractionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttractionId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAttractionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAttractionsByLocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovEstablishment(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEstablishment(uint64(m.Limit))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.StateProvince)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAttractionsByLocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attractions) > 0 {
+		for _, e := range m.Attractions {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FindAttractionsByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FindAttractionsByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attractions) > 0 {
+		for _, e := range m.Attractions {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Restaurant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RestaurantId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.OwnerId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.RestaurantName)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	l = len(m.OpeningHours)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.ContactNumber)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.LicenceUrl)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.WebsiteUrl)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Location != nil {
+		l = m.Location.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.SeatCapacity != 0 {
+		n += 1 + sovEstablishment(uint64(m.SeatCapacity))
+	}
+	if m.SlotMinutes != 0 {
+		n += 2 + sovEstablishment(uint64(m.SlotMinutes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRestaurantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RestaurantId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRestaurantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Restaurant != nil {
+		l = m.Restaurant.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRestaurantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovEstablishment(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEstablishment(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRestaurantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Restaurants) > 0 {
+		for _, e := range m.Restaurants {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Overall != 0 {
+		n += 1 + sovEstablishment(uint64(m.Overall))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRestaurantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Restaurant != nil {
+		l = m.Restaurant.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRestaurantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Restaurant != nil {
+		l = m.Restaurant.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRestaurantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RestaurantId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRestaurantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRestaurantsByLocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovEstablishment(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEstablishment(uint64(m.Limit))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.StateProvince)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRestaurantsByLocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Restaurants) > 0 {
+		for _, e := range m.Restaurants {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FindRestaurantsByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FindRestaurantsByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Restaurants) > 0 {
+		for _, e := range m.Restaurants {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Hotel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HotelId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.OwnerId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.HotelName)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	l = len(m.ContactNumber)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.LicenceUrl)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.WebsiteUrl)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Location != nil {
+		l = m.Location.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if len(m.Rooms) > 0 {
+		for _, e := range m.Rooms {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHotelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HotelId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetHotelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hotel != nil {
+		l = m.Hotel.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListHotelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovEstablishment(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEstablishment(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListHotelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hotels) > 0 {
+		for _, e := range m.Hotels {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Overall != 0 {
+		n += 1 + sovEstablishment(uint64(m.Overall))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateHotelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hotel != nil {
+		l = m.Hotel.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateHotelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hotel != nil {
+		l = m.Hotel.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteHotelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HotelId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteHotelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListHotelsByLocationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovEstablishment(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovEstablishment(uint64(m.Limit))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.StateProvince)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListHotelsByLocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hotels) > 0 {
+		for _, e := range m.Hotels {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FindHotelsByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FindHotelsByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hotels) > 0 {
+		for _, e := range m.Hotels {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Room) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.HotelId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.NumberOfRooms != 0 {
+		n += 1 + sovEstablishment(uint64(m.NumberOfRooms))
+	}
+	l = len(m.Holidays)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Discount != 0 {
+		n += 9
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovEstablishment(uint64(m.Capacity))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRoomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRoomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Room != nil {
+		l = m.Room.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRoomsByHotelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HotelId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListRoomsByHotelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rooms) > 0 {
+		for _, e := range m.Rooms {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRoomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Room != nil {
+		l = m.Room.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRoomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Room != nil {
+		l = m.Room.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRoomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RoomId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRoomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TicketType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TicketTypeId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.AttractionId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTicketTypesByAttractionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttractionId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTicketTypesByAttractionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TicketTypes) > 0 {
+		for _, e := range m.TicketTypes {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateTicketTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TicketType != nil {
+		l = m.TicketType.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateTicketTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TicketType != nil {
+		l = m.TicketType.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTicketTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TicketTypeId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTicketTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancellationRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HoursBefore != 0 {
+		n += 1 + sovEstablishment(uint64(m.HoursBefore))
+	}
+	if m.RefundPercent != 0 {
+		n += 9
+	}
+	if m.ChangeFeePercent != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancellationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCancellationPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteCancellationPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteCancellationPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Favourite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FavouriteId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddToFavouritesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Favourite != nil {
+		l = m.Favourite.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddToFavouritesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Favourite != nil {
+		l = m.Favourite.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveFromFavouritesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FavouriteId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveFromFavouritesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListFavouritesByUserIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListFavouritesByUserIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Favourites) > 0 {
+		for _, e := range m.Favourites {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Review) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReviewId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 5
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateReviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Review != nil {
+		l = m.Review.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateReviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Review != nil {
+		l = m.Review.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListReviewsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListReviewsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reviews) > 0 {
+		for _, e := range m.Reviews {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteReviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReviewId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteReviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateImageRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BookingMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetBookingModeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddOn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddOnId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 9
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAddOnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAddOnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddOns) > 0 {
+		for _, e := range m.AddOns {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateAddOnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddOn != nil {
+		l = m.AddOn.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateAddOnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddOn != nil {
+		l = m.AddOn.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAddOnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AddOnId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAddOnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Closure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClosureId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if len(m.Weekdays) > 0 {
+		for _, s := range m.Weekdays {
+			l = len(s)
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListClosuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EstablishmentId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListClosuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Closures) > 0 {
+		for _, e := range m.Closures {
+			l = e.Size()
+			n += 1 + l + sovEstablishment(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovEstablishment(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateClosureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Closure != nil {
+		l = m.Closure.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateClosureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Closure != nil {
+		l = m.Closure.Size()
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteClosureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClosureId)
+	if l > 0 {
+		n += 1 + l + sovEstablishment(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteClosureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovEstablishment(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEstablishment(x uint64) (n int) {
+	return sovEstablishment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Image) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Location) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Location: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Location: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Latitude = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Longitude = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateProvince", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateProvince = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttractionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttractionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttractionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttractionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenceUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LicenceUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebsiteUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, &Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Location == nil {
+				m.Location = &Location{}
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpeningHours", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpeningHours = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryWindowMinutes", wireType)
+			}
+			m.EntryWindowMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryWindowMinutes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyQuota", wireType)
+			}
+			m.DailyQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailyQuota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowQuota", wireType)
+			}
+			m.WindowQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowQuota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TicketTypes = append(m.TicketTypes, &TicketType{})
+			if err := m.TicketTypes[len(m.TicketTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAttractionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAttractionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAttractionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttractionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttractionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAttractionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAttractionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAttractionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attraction == nil {
+				m.Attraction = &Attraction{}
+			}
+			if err := m.Attraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAttractionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttractionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAttractionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttractionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attractions = append(m.Attractions, &Attraction{})
+			if err := m.Attractions[len(m.Attractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overall", wireType)
+			}
+			m.Overall = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Overall |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateAttractionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateAttractionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateAttractionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attraction == nil {
+				m.Attraction = &Attraction{}
+			}
+			if err := m.Attraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateAttractionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateAttractionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateAttractionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attraction == nil {
+				m.Attraction = &Attraction{}
+			}
+			if err := m.Attraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteAttractionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteAttractionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteAttractionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttractionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttractionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteAttractionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteAttractionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteAttractionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAttractionsByLocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttractionsByLocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttractionsByLocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateProvince", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateProvince = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAttractionsByLocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttractionsByLocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttractionsByLocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attractions = append(m.Attractions, &Attraction{})
+			if err := m.Attractions[len(m.Attractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindAttractionsByNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindAttractionsByNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindAttractionsByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindAttractionsByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindAttractionsByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindAttractionsByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attractions = append(m.Attractions, &Attraction{})
+			if err := m.Attractions[len(m.Attractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Restaurant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Restaurant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Restaurant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestaurantId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestaurantId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestaurantName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestaurantName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpeningHours", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpeningHours = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenceUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LicenceUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebsiteUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, &Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Location == nil {
+				m.Location = &Location{}
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeatCapacity", wireType)
+			}
+			m.SeatCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeatCapacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotMinutes", wireType)
+			}
+			m.SlotMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotMinutes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRestaurantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRestaurantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRestaurantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestaurantId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestaurantId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRestaurantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRestaurantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRestaurantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaurant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restaurant == nil {
+				m.Restaurant = &Restaurant{}
+			}
+			if err := m.Restaurant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRestaurantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRestaurantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRestaurantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRestaurantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRestaurantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRestaurantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaurants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restaurants = append(m.Restaurants, &Restaurant{})
+			if err := m.Restaurants[len(m.Restaurants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overall", wireType)
+			}
+			m.Overall = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Overall |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRestaurantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRestaurantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRestaurantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaurant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restaurant == nil {
+				m.Restaurant = &Restaurant{}
+			}
+			if err := m.Restaurant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRestaurantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRestaurantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRestaurantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaurant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Restaurant == nil {
+				m.Restaurant = &Restaurant{}
+			}
+			if err := m.Restaurant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRestaurantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRestaurantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRestaurantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestaurantId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestaurantId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRestaurantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRestaurantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRestaurantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRestaurantsByLocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRestaurantsByLocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRestaurantsByLocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateProvince", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateProvince = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRestaurantsByLocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRestaurantsByLocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRestaurantsByLocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaurants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restaurants = append(m.Restaurants, &Restaurant{})
+			if err := m.Restaurants[len(m.Restaurants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindRestaurantsByNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindRestaurantsByNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindRestaurantsByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindRestaurantsByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindRestaurantsByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindRestaurantsByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restaurants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restaurants = append(m.Restaurants, &Restaurant{})
+			if err := m.Restaurants[len(m.Restaurants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hotel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hotel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hotel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotelName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LicenceUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LicenceUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebsiteUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, &Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Location == nil {
+				m.Location = &Location{}
+			}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rooms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rooms = append(m.Rooms, &Room{})
+			if err := m.Rooms[len(m.Rooms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHotelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHotelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHotelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ListReviewsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EstablishmentId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *ListReviewsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Reviews) > 0 {
-		for _, e := range m.Reviews {
-			l = e.Size()
-			n += 1 + l + sovEstablishment(uint64(l))
+func (m *GetHotelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHotelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHotelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hotel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hotel == nil {
+				m.Hotel = &Hotel{}
+			}
+			if err := m.Hotel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.Count != 0 {
-		n += 1 + sovEstablishment(uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *DeleteReviewRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ReviewId)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *DeleteReviewResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ListHotelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListHotelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListHotelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *CreateImageRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovEstablishment(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEstablishment(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEstablishment(x uint64) (n int) {
-	return sovEstablishment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Image) Unmarshal(dAtA []byte) error {
+func (m *ListHotelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8617,17 +18988,17 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListHotelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListHotelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hotels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -8637,29 +19008,31 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageId = string(dAtA[iNdEx:postIndex])
+			m.Hotels = append(m.Hotels, &Hotel{})
+			if err := m.Hotels[len(m.Hotels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overall", wireType)
 			}
-			var stringLen uint64
+			m.Overall = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -8669,29 +19042,67 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Overall |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateHotelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateHotelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateHotelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hotel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -8701,61 +19112,84 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			if m.Hotel == nil {
+				m.Hotel = &Hotel{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Hotel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Category = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateHotelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateHotelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateHotelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hotel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -8765,59 +19199,82 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			if m.Hotel == nil {
+				m.Hotel = &Hotel{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Hotel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteHotelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteHotelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteHotelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HotelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8845,7 +19302,7 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.HotelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8869,7 +19326,7 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Location) Unmarshal(dAtA []byte) error {
+func (m *DeleteHotelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8892,17 +19349,17 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Location: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteHotelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Location: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteHotelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocationId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -8912,29 +19369,68 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocationId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListHotelsByLocationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListHotelsByLocationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListHotelsByLocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -8944,29 +19440,16 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Offset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -8976,47 +19459,12 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Latitude = float32(math.Float32frombits(v))
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Longitude = float32(math.Float32frombits(v))
-		case 6:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
 			}
@@ -9048,7 +19496,7 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 			}
 			m.Country = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
 			}
@@ -9080,7 +19528,7 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 			}
 			m.City = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StateProvince", wireType)
 			}
@@ -9112,75 +19560,62 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 			}
 			m.StateProvince = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Category = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListHotelsByLocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListHotelsByLocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListHotelsByLocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hotels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -9190,29 +19625,31 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.Hotels = append(m.Hotels, &Hotel{})
+			if err := m.Hotels[len(m.Hotels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -9222,24 +19659,11 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -9262,7 +19686,7 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attraction) Unmarshal(dAtA []byte) error {
+func (m *FindHotelsByNameRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9285,15 +19709,15 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attraction: wiretype end group for non-group")
+			return fmt.Errorf("proto: FindHotelsByNameRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attraction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FindHotelsByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttractionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9321,45 +19745,64 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttractionId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindHotelsByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindHotelsByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindHotelsByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttractionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hotels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -9369,29 +19812,31 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttractionName = string(dAtA[iNdEx:postIndex])
+			m.Hotels = append(m.Hotels, &Hotel{})
+			if err := m.Hotels[len(m.Hotels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -9401,38 +19846,65 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Room) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Rating = float32(math.Float32frombits(v))
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Room: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Room: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9460,11 +19932,11 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactNumber = string(dAtA[iNdEx:postIndex])
+			m.RoomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LicenceUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HotelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9492,11 +19964,22 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LicenceUrl = string(dAtA[iNdEx:postIndex])
+			m.HotelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9524,47 +20007,32 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebsiteUrl = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfRooms", wireType)
 			}
-			var msglen int
+			m.NumberOfRooms = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Images = append(m.Images, &Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfRooms |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holidays", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -9574,29 +20042,36 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Location == nil {
-				m.Location = &Location{}
+			m.Holidays = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
 			}
-			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 11:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Discount = float64(math.Float64frombits(v))
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
@@ -9628,7 +20103,7 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 			}
 			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
@@ -9660,7 +20135,7 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 			}
 			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
 			}
@@ -9692,6 +20167,25 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 			}
 			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -9714,7 +20208,7 @@ func (m *Attraction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAttractionRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRoomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9737,15 +20231,15 @@ func (m *GetAttractionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAttractionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRoomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAttractionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRoomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttractionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9773,7 +20267,7 @@ func (m *GetAttractionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttractionId = string(dAtA[iNdEx:postIndex])
+			m.RoomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9797,7 +20291,7 @@ func (m *GetAttractionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAttractionResponse) Unmarshal(dAtA []byte) error {
+func (m *GetRoomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9820,15 +20314,15 @@ func (m *GetAttractionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAttractionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRoomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAttractionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRoomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Room", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9855,10 +20349,10 @@ func (m *GetAttractionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attraction == nil {
-				m.Attraction = &Attraction{}
+			if m.Room == nil {
+				m.Room = &Room{}
 			}
-			if err := m.Attraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Room.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9884,7 +20378,7 @@ func (m *GetAttractionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAttractionsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListRoomsByHotelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9907,17 +20401,17 @@ func (m *ListAttractionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAttractionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRoomsByHotelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAttractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRoomsByHotelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HotelId", wireType)
 			}
-			m.Offset = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -9927,30 +20421,24 @@ func (m *ListAttractionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HotelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -9973,7 +20461,7 @@ func (m *ListAttractionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAttractionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ListRoomsByHotelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9996,15 +20484,15 @@ func (m *ListAttractionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAttractionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListRoomsByHotelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAttractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListRoomsByHotelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attractions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rooms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10031,16 +20519,16 @@ func (m *ListAttractionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attractions = append(m.Attractions, &Attraction{})
-			if err := m.Attractions[len(m.Attractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rooms = append(m.Rooms, &Room{})
+			if err := m.Rooms[len(m.Rooms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Overall = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -10050,7 +20538,7 @@ func (m *ListAttractionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Overall |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10077,7 +20565,7 @@ func (m *ListAttractionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateAttractionRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateRoomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10100,15 +20588,15 @@ func (m *UpdateAttractionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateAttractionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRoomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateAttractionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRoomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Room", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10135,10 +20623,10 @@ func (m *UpdateAttractionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attraction == nil {
-				m.Attraction = &Attraction{}
+			if m.Room == nil {
+				m.Room = &Room{}
 			}
-			if err := m.Attraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Room.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10164,7 +20652,7 @@ func (m *UpdateAttractionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateAttractionResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateRoomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10187,15 +20675,15 @@ func (m *UpdateAttractionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateAttractionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRoomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateAttractionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRoomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Room", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10222,10 +20710,10 @@ func (m *UpdateAttractionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attraction == nil {
-				m.Attraction = &Attraction{}
+			if m.Room == nil {
+				m.Room = &Room{}
 			}
-			if err := m.Attraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Room.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10251,7 +20739,7 @@ func (m *UpdateAttractionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteAttractionRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteRoomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10274,15 +20762,15 @@ func (m *DeleteAttractionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteAttractionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRoomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteAttractionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRoomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttractionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10310,7 +20798,7 @@ func (m *DeleteAttractionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttractionId = string(dAtA[iNdEx:postIndex])
+			m.RoomId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10334,7 +20822,7 @@ func (m *DeleteAttractionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteAttractionResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteRoomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10357,10 +20845,10 @@ func (m *DeleteAttractionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteAttractionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRoomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteAttractionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRoomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10405,7 +20893,7 @@ func (m *DeleteAttractionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAttractionsByLocationRequest) Unmarshal(dAtA []byte) error {
+func (m *TicketType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10428,17 +20916,17 @@ func (m *ListAttractionsByLocationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAttractionsByLocationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TicketType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAttractionsByLocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TicketType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketTypeId", wireType)
 			}
-			m.Offset = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -10448,33 +20936,27 @@ func (m *ListAttractionsByLocationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TicketTypeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttractionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10502,11 +20984,11 @@ func (m *ListAttractionsByLocationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Country = string(dAtA[iNdEx:postIndex])
+			m.AttractionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10534,11 +21016,22 @@ func (m *ListAttractionsByLocationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.City = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateProvince", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10566,64 +21059,13 @@ func (m *ListAttractionsByLocationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateProvince = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEstablishment(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListAttractionsByLocationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEstablishment
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListAttractionsByLocationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAttractionsByLocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attractions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -10633,31 +21075,29 @@ func (m *ListAttractionsByLocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attractions = append(m.Attractions, &Attraction{})
-			if err := m.Attractions[len(m.Attractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -10667,11 +21107,24 @@ func (m *ListAttractionsByLocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -10694,7 +21147,7 @@ func (m *ListAttractionsByLocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FindAttractionsByNameRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTicketTypesByAttractionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10717,15 +21170,15 @@ func (m *FindAttractionsByNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FindAttractionsByNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTicketTypesByAttractionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FindAttractionsByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTicketTypesByAttractionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttractionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10753,7 +21206,7 @@ func (m *FindAttractionsByNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.AttractionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10777,7 +21230,7 @@ func (m *FindAttractionsByNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FindAttractionsByNameResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTicketTypesByAttractionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10800,15 +21253,15 @@ func (m *FindAttractionsByNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FindAttractionsByNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTicketTypesByAttractionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FindAttractionsByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTicketTypesByAttractionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attractions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketTypes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10835,8 +21288,8 @@ func (m *FindAttractionsByNameResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attractions = append(m.Attractions, &Attraction{})
-			if err := m.Attractions[len(m.Attractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TicketTypes = append(m.TicketTypes, &TicketType{})
+			if err := m.TicketTypes[len(m.TicketTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10881,7 +21334,7 @@ func (m *FindAttractionsByNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Restaurant) Unmarshal(dAtA []byte) error {
+func (m *UpdateTicketTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10904,49 +21357,17 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Restaurant: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTicketTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Restaurant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTicketTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestaurantId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RestaurantId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketType", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -10956,168 +21377,84 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestaurantName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
+			if m.TicketType == nil {
+				m.TicketType = &TicketType{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.TicketType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RestaurantName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Rating = float32(math.Float32frombits(v))
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpeningHours", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTicketTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OpeningHours = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactNumber", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.ContactNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTicketTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTicketTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LicenceUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketType", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11127,27 +21464,82 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LicenceUrl = string(dAtA[iNdEx:postIndex])
+			if m.TicketType == nil {
+				m.TicketType = &TicketType{}
+			}
+			if err := m.TicketType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTicketTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTicketTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTicketTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TicketTypeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11175,13 +21567,64 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebsiteUrl = string(dAtA[iNdEx:postIndex])
+			m.TicketTypeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTicketTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTicketTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTicketTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11191,31 +21634,68 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEstablishment
+			m.Success = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, &Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancellationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoursBefore", wireType)
+			}
+			m.HoursBefore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11225,31 +21705,87 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HoursBefore |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEstablishment
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPercent", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RefundPercent = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeFeePercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ChangeFeePercent = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEstablishment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Location == nil {
-				m.Location = &Location{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancellationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEstablishment
 			}
-			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11277,13 +21813,13 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11293,27 +21829,29 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.Rules = append(m.Rules, &CancellationRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11341,7 +21879,7 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11365,7 +21903,7 @@ func (m *Restaurant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRestaurantRequest) Unmarshal(dAtA []byte) error {
+func (m *GetCancellationPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11388,15 +21926,15 @@ func (m *GetRestaurantRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRestaurantRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCancellationPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRestaurantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCancellationPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestaurantId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11424,7 +21962,7 @@ func (m *GetRestaurantRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RestaurantId = string(dAtA[iNdEx:postIndex])
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11448,7 +21986,7 @@ func (m *GetRestaurantRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRestaurantResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteCancellationPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11471,17 +22009,17 @@ func (m *GetRestaurantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRestaurantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteCancellationPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRestaurantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteCancellationPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restaurant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11491,27 +22029,23 @@ func (m *GetRestaurantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Restaurant == nil {
-				m.Restaurant = &Restaurant{}
-			}
-			if err := m.Restaurant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11535,7 +22069,7 @@ func (m *GetRestaurantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRestaurantsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteCancellationPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11558,36 +22092,17 @@ func (m *ListRestaurantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRestaurantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteCancellationPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRestaurantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteCancellationPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.Limit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11597,11 +22112,12 @@ func (m *ListRestaurantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -11624,7 +22140,7 @@ func (m *ListRestaurantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRestaurantsResponse) Unmarshal(dAtA []byte) error {
+func (m *Favourite) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11647,17 +22163,17 @@ func (m *ListRestaurantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRestaurantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Favourite: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRestaurantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Favourite: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restaurants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FavouriteId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11667,31 +22183,29 @@ func (m *ListRestaurantsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Restaurants = append(m.Restaurants, &Restaurant{})
-			if err := m.Restaurants[len(m.Restaurants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FavouriteId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overall", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
-			m.Overall = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11701,67 +22215,29 @@ func (m *ListRestaurantsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Overall |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEstablishment(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateRestaurantRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEstablishment
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRestaurantRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRestaurantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restaurant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11771,84 +22247,93 @@ func (m *UpdateRestaurantRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Restaurant == nil {
-				m.Restaurant = &Restaurant{}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			if err := m.Restaurant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEstablishment(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateRestaurantResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEstablishment
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRestaurantResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRestaurantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restaurant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11858,27 +22343,23 @@ func (m *UpdateRestaurantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Restaurant == nil {
-				m.Restaurant = &Restaurant{}
-			}
-			if err := m.Restaurant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11902,7 +22383,7 @@ func (m *UpdateRestaurantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRestaurantRequest) Unmarshal(dAtA []byte) error {
+func (m *AddToFavouritesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11925,17 +22406,17 @@ func (m *DeleteRestaurantRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRestaurantRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToFavouritesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRestaurantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToFavouritesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestaurantId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Favourite", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -11945,23 +22426,27 @@ func (m *DeleteRestaurantRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RestaurantId = string(dAtA[iNdEx:postIndex])
+			if m.Favourite == nil {
+				m.Favourite = &Favourite{}
+			}
+			if err := m.Favourite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11985,7 +22470,7 @@ func (m *DeleteRestaurantRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRestaurantResponse) Unmarshal(dAtA []byte) error {
+func (m *AddToFavouritesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12008,17 +22493,17 @@ func (m *DeleteRestaurantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRestaurantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddToFavouritesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRestaurantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddToFavouritesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Favourite", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -12028,12 +22513,28 @@ func (m *DeleteRestaurantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Favourite == nil {
+				m.Favourite = &Favourite{}
+			}
+			if err := m.Favourite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -12056,7 +22557,7 @@ func (m *DeleteRestaurantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRestaurantsByLocationRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromFavouritesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12079,117 +22580,15 @@ func (m *ListRestaurantsByLocationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRestaurantsByLocationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromFavouritesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRestaurantsByLocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromFavouritesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Country = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.City = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateProvince", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FavouriteId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12217,7 +22616,7 @@ func (m *ListRestaurantsByLocationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateProvince = string(dAtA[iNdEx:postIndex])
+			m.FavouriteId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12241,7 +22640,7 @@ func (m *ListRestaurantsByLocationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRestaurantsByLocationResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveFromFavouritesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12264,51 +22663,17 @@ func (m *ListRestaurantsByLocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRestaurantsByLocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveFromFavouritesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRestaurantsByLocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveFromFavouritesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restaurants", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Restaurants = append(m.Restaurants, &Restaurant{})
-			if err := m.Restaurants[len(m.Restaurants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.Count = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -12318,11 +22683,12 @@ func (m *ListRestaurantsByLocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -12345,7 +22711,7 @@ func (m *ListRestaurantsByLocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FindRestaurantsByNameRequest) Unmarshal(dAtA []byte) error {
+func (m *ListFavouritesByUserIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12368,15 +22734,15 @@ func (m *FindRestaurantsByNameRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FindRestaurantsByNameRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFavouritesByUserIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FindRestaurantsByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFavouritesByUserIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12404,7 +22770,7 @@ func (m *FindRestaurantsByNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.UserId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12428,7 +22794,7 @@ func (m *FindRestaurantsByNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FindRestaurantsByNameResponse) Unmarshal(dAtA []byte) error {
+func (m *ListFavouritesByUserIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12451,15 +22817,15 @@ func (m *FindRestaurantsByNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FindRestaurantsByNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListFavouritesByUserIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FindRestaurantsByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListFavouritesByUserIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restaurants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Favourites", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12486,30 +22852,11 @@ func (m *FindRestaurantsByNameResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Restaurants = append(m.Restaurants, &Restaurant{})
-			if err := m.Restaurants[len(m.Restaurants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Favourites = append(m.Favourites, &Favourite{})
+			if err := m.Favourites[len(m.Favourites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -12532,7 +22879,7 @@ func (m *FindRestaurantsByNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Hotel) Unmarshal(dAtA []byte) error {
+func (m *Review) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12555,15 +22902,15 @@ func (m *Hotel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Hotel: wiretype end group for non-group")
+			return fmt.Errorf("proto: Review: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Hotel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Review: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HotelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12591,216 +22938,13 @@ func (m *Hotel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HotelId = string(dAtA[iNdEx:postIndex])
+			m.ReviewId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HotelName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HotelName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Rating = float32(math.Float32frombits(v))
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactNumber", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContactNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LicenceUrl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LicenceUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteUrl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WebsiteUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -12810,31 +22954,29 @@ func (m *Hotel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, &Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -12844,31 +22986,38 @@ func (m *Hotel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Location == nil {
-				m.Location = &Location{}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
 			}
-			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 11:
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Rating = float32(math.Float32frombits(v))
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12896,11 +23045,11 @@ func (m *Hotel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12928,11 +23077,11 @@ func (m *Hotel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12960,62 +23109,11 @@ func (m *Hotel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEstablishment(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetHotelRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEstablishment
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetHotelRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetHotelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HotelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13043,7 +23141,7 @@ func (m *GetHotelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HotelId = string(dAtA[iNdEx:postIndex])
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13067,7 +23165,7 @@ func (m *GetHotelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetHotelResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateReviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13090,15 +23188,15 @@ func (m *GetHotelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetHotelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateReviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetHotelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateReviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hotel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Review", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13125,10 +23223,10 @@ func (m *GetHotelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hotel == nil {
-				m.Hotel = &Hotel{}
+			if m.Review == nil {
+				m.Review = &Review{}
 			}
-			if err := m.Hotel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Review.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13154,96 +23252,7 @@ func (m *GetHotelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListHotelsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEstablishment
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListHotelsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListHotelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEstablishment(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListHotelsResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateReviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13266,15 +23275,15 @@ func (m *ListHotelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListHotelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateReviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListHotelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hotels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Review", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13301,30 +23310,13 @@ func (m *ListHotelsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hotels = append(m.Hotels, &Hotel{})
-			if err := m.Hotels[len(m.Hotels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overall", wireType)
-			}
-			m.Overall = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Overall |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Review == nil {
+				m.Review = &Review{}
 			}
+			if err := m.Review.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -13347,7 +23339,7 @@ func (m *ListHotelsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateHotelRequest) Unmarshal(dAtA []byte) error {
+func (m *ListReviewsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13370,17 +23362,17 @@ func (m *UpdateHotelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateHotelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListReviewsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateHotelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListReviewsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hotel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -13390,27 +23382,23 @@ func (m *UpdateHotelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hotel == nil {
-				m.Hotel = &Hotel{}
-			}
-			if err := m.Hotel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13434,7 +23422,7 @@ func (m *UpdateHotelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateHotelResponse) Unmarshal(dAtA []byte) error {
+func (m *ListReviewsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13457,15 +23445,15 @@ func (m *UpdateHotelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateHotelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListReviewsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateHotelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListReviewsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hotel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviews", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13492,13 +23480,30 @@ func (m *UpdateHotelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hotel == nil {
-				m.Hotel = &Hotel{}
-			}
-			if err := m.Hotel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reviews = append(m.Reviews, &Review{})
+			if err := m.Reviews[len(m.Reviews)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -13521,7 +23526,7 @@ func (m *UpdateHotelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteHotelRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteReviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13544,15 +23549,15 @@ func (m *DeleteHotelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteHotelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteReviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteHotelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteReviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HotelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13580,7 +23585,7 @@ func (m *DeleteHotelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HotelId = string(dAtA[iNdEx:postIndex])
+			m.ReviewId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13604,7 +23609,7 @@ func (m *DeleteHotelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteHotelResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteReviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13627,10 +23632,10 @@ func (m *DeleteHotelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteHotelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteReviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteHotelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13675,7 +23680,7 @@ func (m *DeleteHotelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListHotelsByLocationRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateImageRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13698,117 +23703,15 @@ func (m *ListHotelsByLocationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListHotelsByLocationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateImageRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListHotelsByLocationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateImageRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
-			}
-			m.Offset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Offset |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Country = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.City = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateProvince", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13836,7 +23739,7 @@ func (m *ListHotelsByLocationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateProvince = string(dAtA[iNdEx:postIndex])
+			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13860,7 +23763,7 @@ func (m *ListHotelsByLocationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListHotelsByLocationResponse) Unmarshal(dAtA []byte) error {
+func (m *BookingMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13883,17 +23786,17 @@ func (m *ListHotelsByLocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListHotelsByLocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BookingMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListHotelsByLocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BookingMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hotels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -13903,99 +23806,59 @@ func (m *ListHotelsByLocationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hotels = append(m.Hotels, &Hotel{})
-			if err := m.Hotels[len(m.Hotels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEstablishment(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FindHotelsByNameRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEstablishment
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FindHotelsByNameRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FindHotelsByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14023,7 +23886,7 @@ func (m *FindHotelsByNameRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14047,7 +23910,7 @@ func (m *FindHotelsByNameRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FindHotelsByNameResponse) Unmarshal(dAtA []byte) error {
+func (m *GetBookingModeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14070,17 +23933,17 @@ func (m *FindHotelsByNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FindHotelsByNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBookingModeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FindHotelsByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBookingModeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hotels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -14090,45 +23953,24 @@ func (m *FindHotelsByNameResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hotels = append(m.Hotels, &Hotel{})
-			if err := m.Hotels[len(m.Hotels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -14151,7 +23993,7 @@ func (m *FindHotelsByNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Favourite) Unmarshal(dAtA []byte) error {
+func (m *AddOn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14174,15 +24016,15 @@ func (m *Favourite) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Favourite: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddOn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Favourite: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddOn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FavouriteId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOnId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14210,7 +24052,7 @@ func (m *Favourite) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FavouriteId = string(dAtA[iNdEx:postIndex])
+			m.AddOnId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -14246,7 +24088,7 @@ func (m *Favourite) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14274,9 +24116,52 @@ func (m *Favourite) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
@@ -14308,7 +24193,7 @@ func (m *Favourite) Unmarshal(dAtA []byte) error {
 			}
 			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
@@ -14340,7 +24225,7 @@ func (m *Favourite) Unmarshal(dAtA []byte) error {
 			}
 			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
 			}
@@ -14394,7 +24279,7 @@ func (m *Favourite) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddToFavouritesRequest) Unmarshal(dAtA []byte) error {
+func (m *ListAddOnsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14417,17 +24302,17 @@ func (m *AddToFavouritesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddToFavouritesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAddOnsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddToFavouritesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAddOnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Favourite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -14437,27 +24322,23 @@ func (m *AddToFavouritesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Favourite == nil {
-				m.Favourite = &Favourite{}
-			}
-			if err := m.Favourite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14481,7 +24362,7 @@ func (m *AddToFavouritesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddToFavouritesResponse) Unmarshal(dAtA []byte) error {
+func (m *ListAddOnsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14504,15 +24385,15 @@ func (m *AddToFavouritesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddToFavouritesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAddOnsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddToFavouritesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAddOnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Favourite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14539,13 +24420,30 @@ func (m *AddToFavouritesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Favourite == nil {
-				m.Favourite = &Favourite{}
-			}
-			if err := m.Favourite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AddOns = append(m.AddOns, &AddOn{})
+			if err := m.AddOns[len(m.AddOns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -14568,7 +24466,7 @@ func (m *AddToFavouritesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveFromFavouritesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateAddOnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14591,17 +24489,17 @@ func (m *RemoveFromFavouritesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveFromFavouritesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateAddOnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveFromFavouritesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateAddOnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FavouriteId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -14611,23 +24509,27 @@ func (m *RemoveFromFavouritesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FavouriteId = string(dAtA[iNdEx:postIndex])
+			if m.AddOn == nil {
+				m.AddOn = &AddOn{}
+			}
+			if err := m.AddOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14651,7 +24553,7 @@ func (m *RemoveFromFavouritesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveFromFavouritesResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateAddOnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14674,17 +24576,17 @@ func (m *RemoveFromFavouritesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveFromFavouritesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateAddOnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveFromFavouritesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateAddOnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOn", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -14694,12 +24596,28 @@ func (m *RemoveFromFavouritesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddOn == nil {
+				m.AddOn = &AddOn{}
+			}
+			if err := m.AddOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -14722,7 +24640,7 @@ func (m *RemoveFromFavouritesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListFavouritesByUserIdRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteAddOnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14745,15 +24663,15 @@ func (m *ListFavouritesByUserIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListFavouritesByUserIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteAddOnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListFavouritesByUserIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteAddOnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddOnId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14781,7 +24699,7 @@ func (m *ListFavouritesByUserIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
+			m.AddOnId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14805,7 +24723,7 @@ func (m *ListFavouritesByUserIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListFavouritesByUserIdResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteAddOnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14828,17 +24746,17 @@ func (m *ListFavouritesByUserIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListFavouritesByUserIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteAddOnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListFavouritesByUserIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteAddOnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Favourites", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -14848,26 +24766,12 @@ func (m *ListFavouritesByUserIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Favourites = append(m.Favourites, &Favourite{})
-			if err := m.Favourites[len(m.Favourites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -14890,7 +24794,7 @@ func (m *ListFavouritesByUserIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Review) Unmarshal(dAtA []byte) error {
+func (m *Closure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14913,15 +24817,15 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Review: wiretype end group for non-group")
+			return fmt.Errorf("proto: Closure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Review: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Closure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReviewId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosureId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14949,7 +24853,7 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReviewId = string(dAtA[iNdEx:postIndex])
+			m.ClosureId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -14985,7 +24889,7 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15013,22 +24917,43 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
+			m.StartDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Rating = float32(math.Float32frombits(v))
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekdays", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15056,9 +24981,41 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			m.Weekdays = append(m.Weekdays, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEstablishment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
@@ -15090,7 +25047,7 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 			}
 			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
@@ -15122,7 +25079,7 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 			}
 			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
 			}
@@ -15176,7 +25133,7 @@ func (m *Review) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateReviewRequest) Unmarshal(dAtA []byte) error {
+func (m *ListClosuresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15199,17 +25156,17 @@ func (m *CreateReviewRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateReviewRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListClosuresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateReviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListClosuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Review", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -15219,27 +25176,23 @@ func (m *CreateReviewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Review == nil {
-				m.Review = &Review{}
-			}
-			if err := m.Review.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15263,7 +25216,7 @@ func (m *CreateReviewRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateReviewResponse) Unmarshal(dAtA []byte) error {
+func (m *ListClosuresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15286,15 +25239,15 @@ func (m *CreateReviewResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateReviewResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListClosuresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListClosuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Review", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Closures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15321,13 +25274,30 @@ func (m *CreateReviewResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Review == nil {
-				m.Review = &Review{}
-			}
-			if err := m.Review.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Closures = append(m.Closures, &Closure{})
+			if err := m.Closures[len(m.Closures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEstablishment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -15350,7 +25320,7 @@ func (m *CreateReviewResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListReviewsRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateClosureRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15373,17 +25343,17 @@ func (m *ListReviewsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListReviewsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateClosureRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListReviewsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateClosureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstablishmentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Closure", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEstablishment
@@ -15393,23 +25363,27 @@ func (m *ListReviewsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEstablishment
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEstablishment
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EstablishmentId = string(dAtA[iNdEx:postIndex])
+			if m.Closure == nil {
+				m.Closure = &Closure{}
+			}
+			if err := m.Closure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15433,7 +25407,7 @@ func (m *ListReviewsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListReviewsResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateClosureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15456,15 +25430,15 @@ func (m *ListReviewsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListReviewsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateClosureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListReviewsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateClosureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reviews", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Closure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15491,30 +25465,13 @@ func (m *ListReviewsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reviews = append(m.Reviews, &Review{})
-			if err := m.Reviews[len(m.Reviews)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Closure == nil {
+				m.Closure = &Closure{}
+			}
+			if err := m.Closure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEstablishment(dAtA[iNdEx:])
@@ -15537,7 +25494,7 @@ func (m *ListReviewsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteReviewRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteClosureRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15560,15 +25517,15 @@ func (m *DeleteReviewRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteReviewRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteClosureRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteReviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteClosureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReviewId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosureId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15596,7 +25553,7 @@ func (m *DeleteReviewRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReviewId = string(dAtA[iNdEx:postIndex])
+			m.ClosureId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15620,7 +25577,7 @@ func (m *DeleteReviewRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteReviewResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteClosureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15643,10 +25600,10 @@ func (m *DeleteReviewResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteReviewResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteClosureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteClosureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15691,89 +25648,6 @@ func (m *DeleteReviewResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateImageRes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEstablishment
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateImageRes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateImageRes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEstablishment
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEstablishment(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEstablishment
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipEstablishment(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
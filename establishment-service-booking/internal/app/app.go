@@ -99,6 +99,12 @@ func (a *App) Run() error {
 	attractionRepo := repo.NewAttractionRepo(a.DB)
 	restaurantRepo := repo.NewRestaurantRepo(a.DB)
 	hotelRepo := repo.NewHotelRepo(a.DB)
+	roomRepo := repo.NewRoomRepo(a.DB)
+	ticketTypeRepo := repo.NewTicketTypeRepo(a.DB)
+	cancellationPolicyRepo := repo.NewCancellationPolicyRepo(a.DB)
+	bookingModeRepo := repo.NewBookingModeRepo(a.DB)
+	addOnRepo := repo.NewAddOnRepo(a.DB)
+	closureRepo := repo.NewClosureRepo(a.DB)
 	favouriteRepo := repo.NewFavouriteRepo(a.DB)
 	reviewRepo := repo.NewReviewRepo(a.DB)
 	imageRepo := repo.NewImageRepo(a.DB)
@@ -107,11 +113,17 @@ func (a *App) Run() error {
 	attracationUsecase := usecase.NewAttractionService(contextTimeout, attractionRepo)
 	restaurantUsecase := usecase.NewRestaurantService(contextTimeout, restaurantRepo)
 	hotelUsecase := usecase.NewHotelService(contextTimeout, hotelRepo)
+	roomUsecase := usecase.NewRoomService(contextTimeout, roomRepo)
+	ticketTypeUsecase := usecase.NewTicketTypeService(contextTimeout, ticketTypeRepo)
+	cancellationPolicyUsecase := usecase.NewCancellationPolicyService(contextTimeout, cancellationPolicyRepo)
+	bookingModeUsecase := usecase.NewBookingModeService(contextTimeout, bookingModeRepo)
+	addOnUsecase := usecase.NewAddOnService(contextTimeout, addOnRepo)
+	closureUsecase := usecase.NewClosureService(contextTimeout, closureRepo)
 	favouriteUsecase := usecase.NewFavouriteService(contextTimeout, favouriteRepo)
 	reviewUsecase := usecase.NewReviewService(contextTimeout, reviewRepo)
 	imageUsecase := usecase.NewImageService(contextTimeout, imageRepo)
 
-	pb.RegisterEstablishmentServiceServer(a.GrpcServer, invest_grpc.NewRPC(a.Logger, attracationUsecase, restaurantUsecase, hotelUsecase, favouriteUsecase,imageUsecase, reviewUsecase, a.BrokerProducer))
+	pb.RegisterEstablishmentServiceServer(a.GrpcServer, invest_grpc.NewRPC(a.Logger, attracationUsecase, restaurantUsecase, hotelUsecase, roomUsecase, ticketTypeUsecase, cancellationPolicyUsecase, bookingModeUsecase, addOnUsecase, closureUsecase, favouriteUsecase,imageUsecase, reviewUsecase, a.BrokerProducer))
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
@@ -2,6 +2,7 @@ package services
 
 import (
 	pb "Booking/establishment-service-booking/genproto/establishment-proto"
+	"Booking/establishment-service-booking/internal/delivery/grpc"
 	"Booking/establishment-service-booking/internal/entity"
 	"Booking/establishment-service-booking/internal/pkg/otlp"
 	"Booking/establishment-service-booking/internal/usecase"
@@ -20,18 +21,30 @@ type establishmentRPC struct {
 	attracationUsecase usecase.Attraction
 	restaurantUsecase  usecase.Restaurant
 	hotelUsecase       usecase.Hotel
+	roomUsecase        usecase.Room
+	ticketTypeUsecase  usecase.TicketType
+	policyUsecase      usecase.CancellationPolicy
+	bookingModeUsecase usecase.BookingMode
+	addOnUsecase       usecase.AddOn
+	closureUsecase     usecase.Closure
 	favouriteUsecase   usecase.Favourite
 	imageUsecase       usecase.Image
 	reviewUsecase      usecase.Review
 	brokerProducer     event.BrokerProducer
 }
 
-func NewRPC(logger *zap.Logger, attracationUsecase usecase.Attraction, restaurantUsecase usecase.Restaurant, hotelUsecase usecase.Hotel, favouriteUsecase usecase.Favourite, imageUsecase usecase.Image, reviewUsecase usecase.Review, brokerProducer event.BrokerProducer) pb.EstablishmentServiceServer {
+func NewRPC(logger *zap.Logger, attracationUsecase usecase.Attraction, restaurantUsecase usecase.Restaurant, hotelUsecase usecase.Hotel, roomUsecase usecase.Room, ticketTypeUsecase usecase.TicketType, policyUsecase usecase.CancellationPolicy, bookingModeUsecase usecase.BookingMode, addOnUsecase usecase.AddOn, closureUsecase usecase.Closure, favouriteUsecase usecase.Favourite, imageUsecase usecase.Image, reviewUsecase usecase.Review, brokerProducer event.BrokerProducer) pb.EstablishmentServiceServer {
 	return &establishmentRPC{
 		logger:             logger,
 		attracationUsecase: attracationUsecase,
 		restaurantUsecase:  restaurantUsecase,
 		hotelUsecase:       hotelUsecase,
+		roomUsecase:        roomUsecase,
+		ticketTypeUsecase:  ticketTypeUsecase,
+		policyUsecase:      policyUsecase,
+		bookingModeUsecase: bookingModeUsecase,
+		addOnUsecase:       addOnUsecase,
+		closureUsecase:     closureUsecase,
 		favouriteUsecase:   favouriteUsecase,
 		reviewUsecase:      reviewUsecase,
 		imageUsecase:       imageUsecase,
@@ -64,15 +77,19 @@ func (s establishmentRPC) CreateAttraction(ctx context.Context, attraction *pb.A
 		images = append(images, &image)
 	}
 	response, err := s.attracationUsecase.CreateAttraction(ctx, &entity.Attraction{
-		AttractionId:   attraction.AttractionId,
-		OwnerId:        attraction.OwnerId,
-		AttractionName: attraction.AttractionName,
-		Description:    attraction.Description,
-		Rating:         attraction.Rating,
-		ContactNumber:  attraction.ContactNumber,
-		LicenceUrl:     attraction.LicenceUrl,
-		WebsiteUrl:     attraction.WebsiteUrl,
-		Images:         images,
+		AttractionId:       attraction.AttractionId,
+		OwnerId:            attraction.OwnerId,
+		AttractionName:     attraction.AttractionName,
+		Description:        attraction.Description,
+		Rating:             attraction.Rating,
+		OpeningHours:       attraction.OpeningHours,
+		EntryWindowMinutes: attraction.EntryWindowMinutes,
+		DailyQuota:         attraction.DailyQuota,
+		WindowQuota:        attraction.WindowQuota,
+		ContactNumber:      attraction.ContactNumber,
+		LicenceUrl:         attraction.LicenceUrl,
+		WebsiteUrl:         attraction.WebsiteUrl,
+		Images:             images,
 		Location: entity.Location{
 			LocationId:      attraction.Location.LocationId,
 			EstablishmentId: attraction.Location.EstablishmentId,
@@ -108,15 +125,19 @@ func (s establishmentRPC) CreateAttraction(ctx context.Context, attraction *pb.A
 	}
 
 	return &pb.Attraction{
-		AttractionId:   response.AttractionId,
-		OwnerId:        response.OwnerId,
-		AttractionName: response.AttractionName,
-		Description:    response.Description,
-		Rating:         response.Rating,
-		ContactNumber:  response.ContactNumber,
-		LicenceUrl:     response.LicenceUrl,
-		WebsiteUrl:     response.WebsiteUrl,
-		Images:         respImages,
+		AttractionId:       response.AttractionId,
+		OwnerId:            response.OwnerId,
+		AttractionName:     response.AttractionName,
+		Description:        response.Description,
+		Rating:             response.Rating,
+		OpeningHours:       response.OpeningHours,
+		EntryWindowMinutes: response.EntryWindowMinutes,
+		DailyQuota:         response.DailyQuota,
+		WindowQuota:        response.WindowQuota,
+		ContactNumber:      response.ContactNumber,
+		LicenceUrl:         response.LicenceUrl,
+		WebsiteUrl:         response.WebsiteUrl,
+		Images:             respImages,
 		Location: &pb.Location{
 			LocationId:      response.Location.LocationId,
 			EstablishmentId: response.Location.EstablishmentId,
@@ -160,17 +181,34 @@ func (s establishmentRPC) GetAttraction(ctx context.Context, request *pb.GetAttr
 		images = append(images, &image)
 	}
 
+	var ticketTypes []*pb.TicketType
+
+	for _, t := range attraction.TicketTypes {
+		ticketTypes = append(ticketTypes, &pb.TicketType{
+			TicketTypeId: t.TicketTypeId,
+			AttractionId: t.AttractionId,
+			Name:         t.Name,
+			Price:        t.Price,
+			CreatedAt:    t.CreatedAt.String(),
+			UpdatedAt:    t.UpdatedAt.String(),
+		})
+	}
+
 	return &pb.GetAttractionResponse{
 		Attraction: &pb.Attraction{
-			AttractionId:   attraction.AttractionId,
-			OwnerId:        attraction.OwnerId,
-			AttractionName: attraction.AttractionName,
-			Description:    attraction.Description,
-			Rating:         attraction.Rating,
-			ContactNumber:  attraction.ContactNumber,
-			LicenceUrl:     attraction.LicenceUrl,
-			WebsiteUrl:     attraction.WebsiteUrl,
-			Images:         images,
+			AttractionId:       attraction.AttractionId,
+			OwnerId:            attraction.OwnerId,
+			AttractionName:     attraction.AttractionName,
+			Description:        attraction.Description,
+			Rating:             attraction.Rating,
+			OpeningHours:       attraction.OpeningHours,
+			EntryWindowMinutes: attraction.EntryWindowMinutes,
+			DailyQuota:         attraction.DailyQuota,
+			WindowQuota:        attraction.WindowQuota,
+			ContactNumber:      attraction.ContactNumber,
+			LicenceUrl:         attraction.LicenceUrl,
+			WebsiteUrl:         attraction.WebsiteUrl,
+			Images:             images,
 			Location: &pb.Location{
 				LocationId:      attraction.Location.LocationId,
 				EstablishmentId: attraction.Location.EstablishmentId,
@@ -183,8 +221,9 @@ func (s establishmentRPC) GetAttraction(ctx context.Context, request *pb.GetAttr
 				CreatedAt:       attraction.CreatedAt.String(),
 				UpdatedAt:       attraction.UpdatedAt.String(),
 			},
-			CreatedAt: attraction.CreatedAt.String(),
-			UpdatedAt: attraction.UpdatedAt.String(),
+			TicketTypes: ticketTypes,
+			CreatedAt:   attraction.CreatedAt.String(),
+			UpdatedAt:   attraction.UpdatedAt.String(),
 		},
 	}, nil
 }
@@ -215,15 +254,19 @@ func (s establishmentRPC) ListAttractions(ctx context.Context, request *pb.ListA
 		}
 
 		pbAttractions = append(pbAttractions, &pb.Attraction{
-			AttractionId:   attraction.AttractionId,
-			OwnerId:        attraction.OwnerId,
-			AttractionName: attraction.AttractionName,
-			Description:    attraction.Description,
-			Rating:         attraction.Rating,
-			ContactNumber:  attraction.ContactNumber,
-			LicenceUrl:     attraction.LicenceUrl,
-			WebsiteUrl:     attraction.WebsiteUrl,
-			Images:         images,
+			AttractionId:       attraction.AttractionId,
+			OwnerId:            attraction.OwnerId,
+			AttractionName:     attraction.AttractionName,
+			Description:        attraction.Description,
+			Rating:             attraction.Rating,
+			OpeningHours:       attraction.OpeningHours,
+			EntryWindowMinutes: attraction.EntryWindowMinutes,
+			DailyQuota:         attraction.DailyQuota,
+			WindowQuota:        attraction.WindowQuota,
+			ContactNumber:      attraction.ContactNumber,
+			LicenceUrl:         attraction.LicenceUrl,
+			WebsiteUrl:         attraction.WebsiteUrl,
+			Images:             images,
 			Location: &pb.Location{
 				LocationId:      attraction.Location.LocationId,
 				EstablishmentId: attraction.Location.EstablishmentId,
@@ -267,14 +310,18 @@ func (s establishmentRPC) UpdateAttraction(ctx context.Context, request *pb.Upda
 	// 	imagesS = append(imagesS, &image)
 	// }
 	attraction, err := s.attracationUsecase.UpdateAttraction(ctx, &entity.Attraction{
-		AttractionId:   request.Attraction.AttractionId,
-		OwnerId:        request.Attraction.OwnerId,
-		AttractionName: request.Attraction.AttractionName,
-		Description:    request.Attraction.Description,
-		Rating:         request.Attraction.Rating,
-		ContactNumber:  request.Attraction.ContactNumber,
-		LicenceUrl:     request.Attraction.LicenceUrl,
-		WebsiteUrl:     request.Attraction.WebsiteUrl,
+		AttractionId:       request.Attraction.AttractionId,
+		OwnerId:            request.Attraction.OwnerId,
+		AttractionName:     request.Attraction.AttractionName,
+		Description:        request.Attraction.Description,
+		Rating:             request.Attraction.Rating,
+		OpeningHours:       request.Attraction.OpeningHours,
+		EntryWindowMinutes: request.Attraction.EntryWindowMinutes,
+		DailyQuota:         request.Attraction.DailyQuota,
+		WindowQuota:        request.Attraction.WindowQuota,
+		ContactNumber:      request.Attraction.ContactNumber,
+		LicenceUrl:         request.Attraction.LicenceUrl,
+		WebsiteUrl:         request.Attraction.WebsiteUrl,
 		// Images:         imagesS,
 		Location: entity.Location{
 			LocationId:      request.Attraction.Location.LocationId,
@@ -307,15 +354,19 @@ func (s establishmentRPC) UpdateAttraction(ctx context.Context, request *pb.Upda
 
 	return &pb.UpdateAttractionResponse{
 		Attraction: &pb.Attraction{
-			AttractionId:   attraction.AttractionId,
-			OwnerId:        attraction.OwnerId,
-			AttractionName: attraction.AttractionName,
-			Description:    attraction.Description,
-			Rating:         attraction.Rating,
-			ContactNumber:  attraction.ContactNumber,
-			LicenceUrl:     attraction.LicenceUrl,
-			WebsiteUrl:     attraction.WebsiteUrl,
-			Images:         images,
+			AttractionId:       attraction.AttractionId,
+			OwnerId:            attraction.OwnerId,
+			AttractionName:     attraction.AttractionName,
+			Description:        attraction.Description,
+			Rating:             attraction.Rating,
+			OpeningHours:       attraction.OpeningHours,
+			EntryWindowMinutes: attraction.EntryWindowMinutes,
+			DailyQuota:         attraction.DailyQuota,
+			WindowQuota:        attraction.WindowQuota,
+			ContactNumber:      attraction.ContactNumber,
+			LicenceUrl:         attraction.LicenceUrl,
+			WebsiteUrl:         attraction.WebsiteUrl,
+			Images:             images,
 			Location: &pb.Location{
 				LocationId:      attraction.Location.LocationId,
 				EstablishmentId: attraction.Location.EstablishmentId,
@@ -378,15 +429,19 @@ func (s establishmentRPC) ListAttractionsByLocation(ctx context.Context, request
 		}
 
 		pbAttractions = append(pbAttractions, &pb.Attraction{
-			AttractionId:   attraction.AttractionId,
-			OwnerId:        attraction.OwnerId,
-			AttractionName: attraction.AttractionName,
-			Description:    attraction.Description,
-			Rating:         attraction.Rating,
-			ContactNumber:  attraction.ContactNumber,
-			LicenceUrl:     attraction.LicenceUrl,
-			WebsiteUrl:     attraction.WebsiteUrl,
-			Images:         images,
+			AttractionId:       attraction.AttractionId,
+			OwnerId:            attraction.OwnerId,
+			AttractionName:     attraction.AttractionName,
+			Description:        attraction.Description,
+			Rating:             attraction.Rating,
+			OpeningHours:       attraction.OpeningHours,
+			EntryWindowMinutes: attraction.EntryWindowMinutes,
+			DailyQuota:         attraction.DailyQuota,
+			WindowQuota:        attraction.WindowQuota,
+			ContactNumber:      attraction.ContactNumber,
+			LicenceUrl:         attraction.LicenceUrl,
+			WebsiteUrl:         attraction.WebsiteUrl,
+			Images:             images,
 			Location: &pb.Location{
 				LocationId:      attraction.Location.LocationId,
 				EstablishmentId: attraction.Location.EstablishmentId,
@@ -436,15 +491,19 @@ func (s establishmentRPC) FindAttractionsByName(ctx context.Context, request *pb
 		}
 
 		pbAttractions = append(pbAttractions, &pb.Attraction{
-			AttractionId:   attraction.AttractionId,
-			OwnerId:        attraction.OwnerId,
-			AttractionName: attraction.AttractionName,
-			Description:    attraction.Description,
-			Rating:         attraction.Rating,
-			ContactNumber:  attraction.ContactNumber,
-			LicenceUrl:     attraction.LicenceUrl,
-			WebsiteUrl:     attraction.WebsiteUrl,
-			Images:         images,
+			AttractionId:       attraction.AttractionId,
+			OwnerId:            attraction.OwnerId,
+			AttractionName:     attraction.AttractionName,
+			Description:        attraction.Description,
+			Rating:             attraction.Rating,
+			OpeningHours:       attraction.OpeningHours,
+			EntryWindowMinutes: attraction.EntryWindowMinutes,
+			DailyQuota:         attraction.DailyQuota,
+			WindowQuota:        attraction.WindowQuota,
+			ContactNumber:      attraction.ContactNumber,
+			LicenceUrl:         attraction.LicenceUrl,
+			WebsiteUrl:         attraction.WebsiteUrl,
+			Images:             images,
 			Location: &pb.Location{
 				LocationId:      attraction.Location.LocationId,
 				EstablishmentId: attraction.Location.EstablishmentId,
@@ -497,6 +556,8 @@ func (s establishmentRPC) CreateRestaurant(ctx context.Context, restaurant *pb.R
 		Description:    restaurant.Description,
 		Rating:         restaurant.Rating,
 		OpeningHours:   restaurant.OpeningHours,
+		SeatCapacity:   restaurant.SeatCapacity,
+		SlotMinutes:    restaurant.SlotMinutes,
 		ContactNumber:  restaurant.ContactNumber,
 		LicenceUrl:     restaurant.LicenceUrl,
 		WebsiteUrl:     restaurant.WebsiteUrl,
@@ -542,6 +603,8 @@ func (s establishmentRPC) CreateRestaurant(ctx context.Context, restaurant *pb.R
 		Description:    response.Description,
 		Rating:         response.Rating,
 		OpeningHours:   response.OpeningHours,
+		SeatCapacity:   response.SeatCapacity,
+		SlotMinutes:    response.SlotMinutes,
 		ContactNumber:  response.ContactNumber,
 		LicenceUrl:     response.LicenceUrl,
 		WebsiteUrl:     response.WebsiteUrl,
@@ -597,6 +660,8 @@ func (s establishmentRPC) GetRestaurant(ctx context.Context, request *pb.GetRest
 			Description:    restaurant.Description,
 			Rating:         restaurant.Rating,
 			OpeningHours:   restaurant.OpeningHours,
+			SeatCapacity:   restaurant.SeatCapacity,
+			SlotMinutes:    restaurant.SlotMinutes,
 			ContactNumber:  restaurant.ContactNumber,
 			LicenceUrl:     restaurant.LicenceUrl,
 			WebsiteUrl:     restaurant.WebsiteUrl,
@@ -651,6 +716,8 @@ func (s establishmentRPC) ListRestaurants(ctx context.Context, request *pb.ListR
 			Description:    restaurant.Description,
 			Rating:         restaurant.Rating,
 			OpeningHours:   restaurant.OpeningHours,
+			SeatCapacity:   restaurant.SeatCapacity,
+			SlotMinutes:    restaurant.SlotMinutes,
 			ContactNumber:  restaurant.ContactNumber,
 			LicenceUrl:     restaurant.LicenceUrl,
 			WebsiteUrl:     restaurant.WebsiteUrl,
@@ -704,6 +771,8 @@ func (s establishmentRPC) UpdateRestaurant(ctx context.Context, request *pb.Upda
 		Description:    request.Restaurant.Description,
 		Rating:         request.Restaurant.Rating,
 		OpeningHours:   request.Restaurant.OpeningHours,
+		SeatCapacity:   request.Restaurant.SeatCapacity,
+		SlotMinutes:    request.Restaurant.SlotMinutes,
 		ContactNumber:  request.Restaurant.ContactNumber,
 		LicenceUrl:     request.Restaurant.LicenceUrl,
 		WebsiteUrl:     request.Restaurant.WebsiteUrl,
@@ -816,6 +885,8 @@ func (s establishmentRPC) ListRestaurantsByLocation(ctx context.Context, request
 			Description:    restaurant.Description,
 			Rating:         restaurant.Rating,
 			OpeningHours:   restaurant.OpeningHours,
+			SeatCapacity:   restaurant.SeatCapacity,
+			SlotMinutes:    restaurant.SlotMinutes,
 			ContactNumber:  restaurant.ContactNumber,
 			LicenceUrl:     restaurant.LicenceUrl,
 			WebsiteUrl:     restaurant.WebsiteUrl,
@@ -875,6 +946,8 @@ func (s establishmentRPC) FindRestaurantsByName(ctx context.Context, request *pb
 			Description:    restaurant.Description,
 			Rating:         restaurant.Rating,
 			OpeningHours:   restaurant.OpeningHours,
+			SeatCapacity:   restaurant.SeatCapacity,
+			SlotMinutes:    restaurant.SlotMinutes,
 			ContactNumber:  restaurant.ContactNumber,
 			LicenceUrl:     restaurant.LicenceUrl,
 			WebsiteUrl:     restaurant.WebsiteUrl,
@@ -1021,6 +1094,23 @@ func (s establishmentRPC) GetHotel(ctx context.Context, request *pb.GetHotelRequ
 		images = append(images, &image)
 	}
 
+	var rooms []*pb.Room
+
+	for _, r := range hotel.Rooms {
+		rooms = append(rooms, &pb.Room{
+			RoomId:        r.RoomId,
+			HotelId:       r.HotelId,
+			Price:         r.Price,
+			Description:   r.Description,
+			NumberOfRooms: r.NumberOfRooms,
+			Capacity:      r.Capacity,
+			Holidays:      r.Holidays,
+			Discount:      r.Discount,
+			CreatedAt:     r.CreatedAt.String(),
+			UpdatedAt:     r.UpdatedAt.String(),
+		})
+	}
+
 	return &pb.GetHotelResponse{
 		Hotel: &pb.Hotel{
 			HotelId:       hotel.HotelId,
@@ -1044,6 +1134,7 @@ func (s establishmentRPC) GetHotel(ctx context.Context, request *pb.GetHotelRequ
 				CreatedAt:       hotel.CreatedAt.String(),
 				UpdatedAt:       hotel.UpdatedAt.String(),
 			},
+			Rooms:     rooms,
 			CreatedAt: hotel.CreatedAt.String(),
 			UpdatedAt: hotel.UpdatedAt.String(),
 		},
@@ -1330,6 +1421,560 @@ func (s establishmentRPC) FindHotelsByName(ctx context.Context, request *pb.Find
 	}, nil
 }
 
+// ROOM
+func (s establishmentRPC) CreateRoom(ctx context.Context, room *pb.Room) (*pb.Room, error) {
+	ctx, span := otlp.Start(ctx, "room_grpc_delivery", "Create")
+	span.SetAttributes(
+		attribute.Key("room_id").String(room.RoomId),
+	)
+	defer span.End()
+
+	response, err := s.roomUsecase.CreateRoom(ctx, &entity.Room{
+		RoomId:        room.RoomId,
+		HotelId:       room.HotelId,
+		Price:         room.Price,
+		Description:   room.Description,
+		NumberOfRooms: room.NumberOfRooms,
+		Capacity:      room.Capacity,
+		Holidays:      room.Holidays,
+		Discount:      room.Discount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Room{
+		RoomId:        response.RoomId,
+		HotelId:       response.HotelId,
+		Price:         response.Price,
+		Description:   response.Description,
+		NumberOfRooms: response.NumberOfRooms,
+		Capacity:      response.Capacity,
+		Holidays:      response.Holidays,
+		Discount:      response.Discount,
+		CreatedAt:     response.CreatedAt.String(),
+		UpdatedAt:     response.UpdatedAt.String(),
+	}, nil
+}
+
+func (s establishmentRPC) GetRoom(ctx context.Context, request *pb.GetRoomRequest) (*pb.GetRoomResponse, error) {
+	ctx, span := otlp.Start(ctx, "room_grpc_delivery", "Get")
+	span.SetAttributes(
+		attribute.Key("room_id").String(request.RoomId),
+	)
+	defer span.End()
+
+	room, err := s.roomUsecase.GetRoom(ctx, request.RoomId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.GetRoomResponse{
+		Room: &pb.Room{
+			RoomId:        room.RoomId,
+			HotelId:       room.HotelId,
+			Price:         room.Price,
+			Description:   room.Description,
+			NumberOfRooms: room.NumberOfRooms,
+			Capacity:      room.Capacity,
+			Holidays:      room.Holidays,
+			Discount:      room.Discount,
+			CreatedAt:     room.CreatedAt.String(),
+			UpdatedAt:     room.UpdatedAt.String(),
+		},
+	}, nil
+}
+
+func (s establishmentRPC) ListRoomsByHotel(ctx context.Context, request *pb.ListRoomsByHotelRequest) (*pb.ListRoomsByHotelResponse, error) {
+	ctx, span := otlp.Start(ctx, "room_grpc_delivery", "ListByHotel")
+	span.SetAttributes(
+		attribute.Key("hotel_id").String(request.HotelId),
+	)
+	defer span.End()
+
+	rooms, count, err := s.roomUsecase.ListRoomsByHotel(ctx, request.HotelId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch rooms: %v", err)
+	}
+
+	var pbRooms []*pb.Room
+	for _, room := range rooms {
+		pbRooms = append(pbRooms, &pb.Room{
+			RoomId:        room.RoomId,
+			HotelId:       room.HotelId,
+			Price:         room.Price,
+			Description:   room.Description,
+			NumberOfRooms: room.NumberOfRooms,
+			Capacity:      room.Capacity,
+			Holidays:      room.Holidays,
+			Discount:      room.Discount,
+			CreatedAt:     room.CreatedAt.String(),
+			UpdatedAt:     room.UpdatedAt.String(),
+		})
+	}
+
+	return &pb.ListRoomsByHotelResponse{
+		Rooms: pbRooms,
+		Count: count,
+	}, nil
+}
+
+func (s establishmentRPC) UpdateRoom(ctx context.Context, request *pb.UpdateRoomRequest) (*pb.UpdateRoomResponse, error) {
+	ctx, span := otlp.Start(ctx, "room_grpc_delivery", "Update")
+	span.SetAttributes(
+		attribute.Key("room_id").String(request.Room.RoomId),
+	)
+	defer span.End()
+
+	room, err := s.roomUsecase.UpdateRoom(ctx, &entity.Room{
+		RoomId:        request.Room.RoomId,
+		Price:         request.Room.Price,
+		Description:   request.Room.Description,
+		NumberOfRooms: request.Room.NumberOfRooms,
+		Capacity:      request.Room.Capacity,
+		Holidays:      request.Room.Holidays,
+		Discount:      request.Room.Discount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateRoomResponse{
+		Room: &pb.Room{
+			RoomId:        room.RoomId,
+			HotelId:       room.HotelId,
+			Price:         room.Price,
+			Description:   room.Description,
+			NumberOfRooms: room.NumberOfRooms,
+			Capacity:      room.Capacity,
+			Holidays:      room.Holidays,
+			Discount:      room.Discount,
+			CreatedAt:     room.CreatedAt.String(),
+			UpdatedAt:     room.UpdatedAt.String(),
+		},
+	}, nil
+}
+
+func (s establishmentRPC) DeleteRoom(ctx context.Context, request *pb.DeleteRoomRequest) (*pb.DeleteRoomResponse, error) {
+	ctx, span := otlp.Start(ctx, "room_grpc_delivery", "Delete")
+	span.SetAttributes(
+		attribute.Key("room_id").String(request.RoomId),
+	)
+	defer span.End()
+
+	if err := s.roomUsecase.DeleteRoom(ctx, request.RoomId); err != nil {
+		return &pb.DeleteRoomResponse{Success: false}, err
+	}
+
+	return &pb.DeleteRoomResponse{Success: true}, nil
+}
+
+// TICKET TYPE
+func (s establishmentRPC) CreateTicketType(ctx context.Context, ticketType *pb.TicketType) (*pb.TicketType, error) {
+	ctx, span := otlp.Start(ctx, "ticket_type_grpc_delivery", "Create")
+	span.SetAttributes(
+		attribute.Key("ticket_type_id").String(ticketType.TicketTypeId),
+	)
+	defer span.End()
+
+	response, err := s.ticketTypeUsecase.CreateTicketType(ctx, &entity.TicketType{
+		TicketTypeId: ticketType.TicketTypeId,
+		AttractionId: ticketType.AttractionId,
+		Name:         ticketType.Name,
+		Price:        ticketType.Price,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.TicketType{
+		TicketTypeId: response.TicketTypeId,
+		AttractionId: response.AttractionId,
+		Name:         response.Name,
+		Price:        response.Price,
+		CreatedAt:    response.CreatedAt.String(),
+		UpdatedAt:    response.UpdatedAt.String(),
+	}, nil
+}
+
+func (s establishmentRPC) ListTicketTypesByAttraction(ctx context.Context, request *pb.ListTicketTypesByAttractionRequest) (*pb.ListTicketTypesByAttractionResponse, error) {
+	ctx, span := otlp.Start(ctx, "ticket_type_grpc_delivery", "ListByAttraction")
+	span.SetAttributes(
+		attribute.Key("attraction_id").String(request.AttractionId),
+	)
+	defer span.End()
+
+	ticketTypes, count, err := s.ticketTypeUsecase.ListTicketTypesByAttraction(ctx, request.AttractionId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch ticket types: %v", err)
+	}
+
+	var pbTicketTypes []*pb.TicketType
+	for _, ticketType := range ticketTypes {
+		pbTicketTypes = append(pbTicketTypes, &pb.TicketType{
+			TicketTypeId: ticketType.TicketTypeId,
+			AttractionId: ticketType.AttractionId,
+			Name:         ticketType.Name,
+			Price:        ticketType.Price,
+			CreatedAt:    ticketType.CreatedAt.String(),
+			UpdatedAt:    ticketType.UpdatedAt.String(),
+		})
+	}
+
+	return &pb.ListTicketTypesByAttractionResponse{
+		TicketTypes: pbTicketTypes,
+		Count:       count,
+	}, nil
+}
+
+func (s establishmentRPC) UpdateTicketType(ctx context.Context, request *pb.UpdateTicketTypeRequest) (*pb.UpdateTicketTypeResponse, error) {
+	ctx, span := otlp.Start(ctx, "ticket_type_grpc_delivery", "Update")
+	span.SetAttributes(
+		attribute.Key("ticket_type_id").String(request.TicketType.TicketTypeId),
+	)
+	defer span.End()
+
+	ticketType, err := s.ticketTypeUsecase.UpdateTicketType(ctx, &entity.TicketType{
+		TicketTypeId: request.TicketType.TicketTypeId,
+		Price:        request.TicketType.Price,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.UpdateTicketTypeResponse{
+		TicketType: &pb.TicketType{
+			TicketTypeId: ticketType.TicketTypeId,
+			AttractionId: ticketType.AttractionId,
+			Name:         ticketType.Name,
+			Price:        ticketType.Price,
+			CreatedAt:    ticketType.CreatedAt.String(),
+			UpdatedAt:    ticketType.UpdatedAt.String(),
+		},
+	}, nil
+}
+
+func (s establishmentRPC) DeleteTicketType(ctx context.Context, request *pb.DeleteTicketTypeRequest) (*pb.DeleteTicketTypeResponse, error) {
+	ctx, span := otlp.Start(ctx, "ticket_type_grpc_delivery", "Delete")
+	span.SetAttributes(
+		attribute.Key("ticket_type_id").String(request.TicketTypeId),
+	)
+	defer span.End()
+
+	if err := s.ticketTypeUsecase.DeleteTicketType(ctx, request.TicketTypeId); err != nil {
+		return &pb.DeleteTicketTypeResponse{Success: false}, grpc.Error(ctx, err)
+	}
+
+	return &pb.DeleteTicketTypeResponse{Success: true}, nil
+}
+
+// CANCELLATION POLICY
+func (s establishmentRPC) SetCancellationPolicy(ctx context.Context, policy *pb.CancellationPolicy) (*pb.CancellationPolicy, error) {
+	ctx, span := otlp.Start(ctx, "cancellation_policy_grpc_delivery", "Set")
+	span.SetAttributes(
+		attribute.Key("establishment_id").String(policy.EstablishmentId),
+	)
+	defer span.End()
+
+	request := &entity.CancellationPolicy{
+		EstablishmentId: policy.EstablishmentId,
+	}
+	for _, rule := range policy.Rules {
+		request.Rules = append(request.Rules, &entity.CancellationRule{
+			HoursBefore:      rule.HoursBefore,
+			RefundPercent:    rule.RefundPercent,
+			ChangeFeePercent: rule.ChangeFeePercent,
+		})
+	}
+
+	response, err := s.policyUsecase.SetCancellationPolicy(ctx, request)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return cancellationPolicyToPb(response), nil
+}
+
+func (s establishmentRPC) GetCancellationPolicy(ctx context.Context, request *pb.GetCancellationPolicyRequest) (*pb.CancellationPolicy, error) {
+	ctx, span := otlp.Start(ctx, "cancellation_policy_grpc_delivery", "Get")
+	span.SetAttributes(
+		attribute.Key("establishment_id").String(request.EstablishmentId),
+	)
+	defer span.End()
+
+	response, err := s.policyUsecase.GetCancellationPolicy(ctx, request.EstablishmentId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return cancellationPolicyToPb(response), nil
+}
+
+func (s establishmentRPC) DeleteCancellationPolicy(ctx context.Context, request *pb.DeleteCancellationPolicyRequest) (*pb.DeleteCancellationPolicyResponse, error) {
+	ctx, span := otlp.Start(ctx, "cancellation_policy_grpc_delivery", "Delete")
+	span.SetAttributes(
+		attribute.Key("establishment_id").String(request.EstablishmentId),
+	)
+	defer span.End()
+
+	if err := s.policyUsecase.DeleteCancellationPolicy(ctx, request.EstablishmentId); err != nil {
+		return &pb.DeleteCancellationPolicyResponse{Success: false}, grpc.Error(ctx, err)
+	}
+
+	return &pb.DeleteCancellationPolicyResponse{Success: true}, nil
+}
+
+func cancellationPolicyToPb(policy *entity.CancellationPolicy) *pb.CancellationPolicy {
+	response := &pb.CancellationPolicy{
+		EstablishmentId: policy.EstablishmentId,
+	}
+	if !policy.UpdatedAt.IsZero() {
+		response.UpdatedAt = policy.UpdatedAt.String()
+	}
+	for _, rule := range policy.Rules {
+		response.Rules = append(response.Rules, &pb.CancellationRule{
+			HoursBefore:      rule.HoursBefore,
+			RefundPercent:    rule.RefundPercent,
+			ChangeFeePercent: rule.ChangeFeePercent,
+		})
+	}
+
+	return response
+}
+
+// BOOKING MODE
+func (s establishmentRPC) SetBookingMode(ctx context.Context, mode *pb.BookingMode) (*pb.BookingMode, error) {
+	ctx, span := otlp.Start(ctx, "booking_mode_grpc_delivery", "Set")
+	span.SetAttributes(
+		attribute.Key("establishment_id").String(mode.EstablishmentId),
+	)
+	defer span.End()
+
+	response, err := s.bookingModeUsecase.SetBookingMode(ctx, &entity.BookingMode{
+		EstablishmentId: mode.EstablishmentId,
+		Mode:            mode.Mode,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return bookingModeToPb(response), nil
+}
+
+func (s establishmentRPC) GetBookingMode(ctx context.Context, request *pb.GetBookingModeRequest) (*pb.BookingMode, error) {
+	ctx, span := otlp.Start(ctx, "booking_mode_grpc_delivery", "Get")
+	span.SetAttributes(
+		attribute.Key("establishment_id").String(request.EstablishmentId),
+	)
+	defer span.End()
+
+	response, err := s.bookingModeUsecase.GetBookingMode(ctx, request.EstablishmentId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return bookingModeToPb(response), nil
+}
+
+func bookingModeToPb(mode *entity.BookingMode) *pb.BookingMode {
+	response := &pb.BookingMode{
+		EstablishmentId: mode.EstablishmentId,
+		Mode:            mode.Mode,
+	}
+	if !mode.UpdatedAt.IsZero() {
+		response.UpdatedAt = mode.UpdatedAt.String()
+	}
+
+	return response
+}
+
+// ADD-ON
+func (s establishmentRPC) CreateAddOn(ctx context.Context, addOn *pb.AddOn) (*pb.AddOn, error) {
+	ctx, span := otlp.Start(ctx, "add_on_grpc_delivery", "Create")
+	span.SetAttributes(
+		attribute.Key("add_on_id").String(addOn.AddOnId),
+	)
+	defer span.End()
+
+	response, err := s.addOnUsecase.CreateAddOn(ctx, &entity.AddOn{
+		AddOnId:         addOn.AddOnId,
+		EstablishmentId: addOn.EstablishmentId,
+		Name:            addOn.Name,
+		Description:     addOn.Description,
+		Price:           addOn.Price,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return addOnToPb(response), nil
+}
+
+func (s establishmentRPC) ListAddOns(ctx context.Context, request *pb.ListAddOnsRequest) (*pb.ListAddOnsResponse, error) {
+	ctx, span := otlp.Start(ctx, "add_on_grpc_delivery", "ListByEstablishment")
+	span.SetAttributes(
+		attribute.Key("establishment_id").String(request.EstablishmentId),
+	)
+	defer span.End()
+
+	addOns, count, err := s.addOnUsecase.ListAddOnsByEstablishment(ctx, request.EstablishmentId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	response := &pb.ListAddOnsResponse{
+		Count: count,
+	}
+	for _, addOn := range addOns {
+		response.AddOns = append(response.AddOns, addOnToPb(addOn))
+	}
+
+	return response, nil
+}
+
+func (s establishmentRPC) UpdateAddOn(ctx context.Context, request *pb.UpdateAddOnRequest) (*pb.UpdateAddOnResponse, error) {
+	ctx, span := otlp.Start(ctx, "add_on_grpc_delivery", "Update")
+	span.SetAttributes(
+		attribute.Key("add_on_id").String(request.AddOn.AddOnId),
+	)
+	defer span.End()
+
+	addOn, err := s.addOnUsecase.UpdateAddOn(ctx, &entity.AddOn{
+		AddOnId:     request.AddOn.AddOnId,
+		Name:        request.AddOn.Name,
+		Description: request.AddOn.Description,
+		Price:       request.AddOn.Price,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.UpdateAddOnResponse{
+		AddOn: addOnToPb(addOn),
+	}, nil
+}
+
+func (s establishmentRPC) DeleteAddOn(ctx context.Context, request *pb.DeleteAddOnRequest) (*pb.DeleteAddOnResponse, error) {
+	ctx, span := otlp.Start(ctx, "add_on_grpc_delivery", "Delete")
+	span.SetAttributes(
+		attribute.Key("add_on_id").String(request.AddOnId),
+	)
+	defer span.End()
+
+	if err := s.addOnUsecase.DeleteAddOn(ctx, request.AddOnId); err != nil {
+		return &pb.DeleteAddOnResponse{Success: false}, grpc.Error(ctx, err)
+	}
+
+	return &pb.DeleteAddOnResponse{Success: true}, nil
+}
+
+func addOnToPb(addOn *entity.AddOn) *pb.AddOn {
+	return &pb.AddOn{
+		AddOnId:         addOn.AddOnId,
+		EstablishmentId: addOn.EstablishmentId,
+		Name:            addOn.Name,
+		Description:     addOn.Description,
+		Price:           addOn.Price,
+		CreatedAt:       addOn.CreatedAt.String(),
+		UpdatedAt:       addOn.UpdatedAt.String(),
+	}
+}
+
+// CLOSURE
+func (s establishmentRPC) CreateClosure(ctx context.Context, closure *pb.Closure) (*pb.Closure, error) {
+	ctx, span := otlp.Start(ctx, "closure_grpc_delivery", "Create")
+	span.SetAttributes(
+		attribute.Key("closure_id").String(closure.ClosureId),
+	)
+	defer span.End()
+
+	response, err := s.closureUsecase.CreateClosure(ctx, &entity.Closure{
+		ClosureId:       closure.ClosureId,
+		EstablishmentId: closure.EstablishmentId,
+		StartDate:       closure.StartDate,
+		EndDate:         closure.EndDate,
+		Weekdays:        closure.Weekdays,
+		Reason:          closure.Reason,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return closureToPb(response), nil
+}
+
+func (s establishmentRPC) ListClosures(ctx context.Context, request *pb.ListClosuresRequest) (*pb.ListClosuresResponse, error) {
+	ctx, span := otlp.Start(ctx, "closure_grpc_delivery", "ListByEstablishment")
+	span.SetAttributes(
+		attribute.Key("establishment_id").String(request.EstablishmentId),
+	)
+	defer span.End()
+
+	closures, count, err := s.closureUsecase.ListClosuresByEstablishment(ctx, request.EstablishmentId)
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	response := &pb.ListClosuresResponse{
+		Count: count,
+	}
+	for _, closure := range closures {
+		response.Closures = append(response.Closures, closureToPb(closure))
+	}
+
+	return response, nil
+}
+
+func (s establishmentRPC) UpdateClosure(ctx context.Context, request *pb.UpdateClosureRequest) (*pb.UpdateClosureResponse, error) {
+	ctx, span := otlp.Start(ctx, "closure_grpc_delivery", "Update")
+	span.SetAttributes(
+		attribute.Key("closure_id").String(request.Closure.ClosureId),
+	)
+	defer span.End()
+
+	closure, err := s.closureUsecase.UpdateClosure(ctx, &entity.Closure{
+		ClosureId: request.Closure.ClosureId,
+		StartDate: request.Closure.StartDate,
+		EndDate:   request.Closure.EndDate,
+		Weekdays:  request.Closure.Weekdays,
+		Reason:    request.Closure.Reason,
+	})
+	if err != nil {
+		return nil, grpc.Error(ctx, err)
+	}
+
+	return &pb.UpdateClosureResponse{
+		Closure: closureToPb(closure),
+	}, nil
+}
+
+func (s establishmentRPC) DeleteClosure(ctx context.Context, request *pb.DeleteClosureRequest) (*pb.DeleteClosureResponse, error) {
+	ctx, span := otlp.Start(ctx, "closure_grpc_delivery", "Delete")
+	span.SetAttributes(
+		attribute.Key("closure_id").String(request.ClosureId),
+	)
+	defer span.End()
+
+	if err := s.closureUsecase.DeleteClosure(ctx, request.ClosureId); err != nil {
+		return &pb.DeleteClosureResponse{Success: false}, grpc.Error(ctx, err)
+	}
+
+	return &pb.DeleteClosureResponse{Success: true}, nil
+}
+
+func closureToPb(closure *entity.Closure) *pb.Closure {
+	return &pb.Closure{
+		ClosureId:       closure.ClosureId,
+		EstablishmentId: closure.EstablishmentId,
+		StartDate:       closure.StartDate,
+		EndDate:         closure.EndDate,
+		Weekdays:        closure.Weekdays,
+		Reason:          closure.Reason,
+		CreatedAt:       closure.CreatedAt.String(),
+		UpdatedAt:       closure.UpdatedAt.String(),
+	}
+}
+
 // FAVOURITE
 func (s establishmentRPC) AddToFavourites(ctx context.Context, request *pb.AddToFavouritesRequest) (*pb.AddToFavouritesResponse, error) {
 	ctx, span := otlp.Start(ctx, "favourite_grpc_delivery", "Create")
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// AddOn is an extra an establishment sells alongside its bookings, such as
+// breakfast, parking or an airport transfer. Guests pick a quantity of it and
+// pay Price for each.
+type AddOn struct {
+	AddOnId         string
+	EstablishmentId string
+	Name            string
+	Description     string
+	Price           float64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       time.Time
+}
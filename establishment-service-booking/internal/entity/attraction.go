@@ -3,19 +3,24 @@ package entity
 import "time"
 
 type Attraction struct {
-	AttractionId   string
-	OwnerId        string
-	AttractionName string
-	Description    string
-	Rating         float32
-	ContactNumber  string
-	LicenceUrl     string
-	WebsiteUrl     string
-	Images         []*Image
-	Location       Location
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	DeletedAt      time.Time
+	AttractionId       string
+	OwnerId            string
+	AttractionName     string
+	Description        string
+	Rating             float32
+	OpeningHours       string
+	EntryWindowMinutes int64
+	DailyQuota         int64
+	WindowQuota        int64
+	ContactNumber      string
+	LicenceUrl         string
+	WebsiteUrl         string
+	Images             []*Image
+	Location           Location
+	TicketTypes        []*TicketType
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	DeletedAt          time.Time
 }
 
 type Image struct {
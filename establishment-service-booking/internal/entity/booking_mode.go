@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// booking modes
+const (
+	// bookings are taken as soon as they fit
+	BookingModeInstant = "instant"
+	// bookings wait for the owner to accept them
+	BookingModeRequest = "request"
+)
+
+type BookingMode struct {
+	EstablishmentId string
+	Mode            string
+	UpdatedAt       time.Time
+}
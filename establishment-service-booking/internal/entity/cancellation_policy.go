@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// CancellationRule refunds RefundPercent of the price to guests who cancel at
+// least HoursBefore hours before their arrival. Guests moving their booking
+// that early pay ChangeFeePercent of the price instead.
+type CancellationRule struct {
+	HoursBefore      int64
+	RefundPercent    float64
+	ChangeFeePercent float64
+}
+
+type CancellationPolicy struct {
+	EstablishmentId string
+	Rules           []*CancellationRule
+	UpdatedAt       time.Time
+}
+
+// DefaultCancellationRules apply to establishments without a policy of their
+// own: bookings can be cancelled and changed for free until the arrival
+var DefaultCancellationRules = []*CancellationRule{
+	{HoursBefore: 0, RefundPercent: 100},
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// ClosureDateLayout is the layout of the first and last day of a closure
+const ClosureDateLayout = "2006-01-02"
+
+// ClosureWeekdays are the days of the week a closure can recur on
+var ClosureWeekdays = map[string]bool{
+	"monday":    true,
+	"tuesday":   true,
+	"wednesday": true,
+	"thursday":  true,
+	"friday":    true,
+	"saturday":  true,
+	"sunday":    true,
+}
+
+// Closure keeps an establishment closed for bookings. Without Weekdays it
+// closes every day from StartDate to EndDate. With Weekdays it closes on
+// those days of every week, between StartDate and EndDate when they are set.
+type Closure struct {
+	ClosureId       string
+	EstablishmentId string
+	StartDate       string
+	EndDate         string
+	Weekdays        []string
+	Reason          string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       time.Time
+}
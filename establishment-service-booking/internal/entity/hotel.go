@@ -13,6 +13,7 @@ type Hotel struct {
 	WebsiteUrl    string
 	Images        []*Image
 	Location      Location
+	Rooms         []*Room
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	DeletedAt     time.Time
@@ -9,6 +9,8 @@ type Restaurant struct {
 	Description    string
 	Rating         float32
 	OpeningHours   string
+	SeatCapacity   int64
+	SlotMinutes    int64
 	ContactNumber  string
 	LicenceUrl     string
 	WebsiteUrl     string
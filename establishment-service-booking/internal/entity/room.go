@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type Room struct {
+	RoomId        string
+	HotelId       string
+	Price         float64
+	Description   string
+	NumberOfRooms int64
+	Capacity      int64
+	Holidays      string
+	Discount      float64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     time.Time
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+const (
+	TicketAdult = "adult"
+	TicketChild = "child"
+)
+
+type TicketType struct {
+	TicketTypeId string
+	AttractionId string
+	Name         string
+	Price        float64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    time.Time
+}
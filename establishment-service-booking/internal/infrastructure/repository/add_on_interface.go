@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"context"
+)
+
+type AddOn interface {
+	CreateAddOn(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error)
+	GetAddOn(ctx context.Context, add_on_id string) (*entity.AddOn, error)
+	ListAddOnsByEstablishment(ctx context.Context, establishment_id string) ([]*entity.AddOn, uint64, error)
+	UpdateAddOn(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error)
+	DeleteAddOn(ctx context.Context, add_on_id string) error
+}
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"context"
+)
+
+type BookingMode interface {
+	SetBookingMode(ctx context.Context, mode *entity.BookingMode) (*entity.BookingMode, error)
+	GetBookingMode(ctx context.Context, establishment_id string) (*entity.BookingMode, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"context"
+)
+
+type CancellationPolicy interface {
+	SetCancellationPolicy(ctx context.Context, policy *entity.CancellationPolicy) (*entity.CancellationPolicy, error)
+	GetCancellationPolicy(ctx context.Context, establishment_id string) (*entity.CancellationPolicy, error)
+	DeleteCancellationPolicy(ctx context.Context, establishment_id string) error
+}
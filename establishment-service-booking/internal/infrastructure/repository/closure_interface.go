@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"context"
+)
+
+type Closure interface {
+	CreateClosure(ctx context.Context, closure *entity.Closure) (*entity.Closure, error)
+	GetClosure(ctx context.Context, closure_id string) (*entity.Closure, error)
+	ListClosuresByEstablishment(ctx context.Context, establishment_id string) ([]*entity.Closure, uint64, error)
+	UpdateClosure(ctx context.Context, closure *entity.Closure) (*entity.Closure, error)
+	DeleteClosure(ctx context.Context, closure_id string) error
+}
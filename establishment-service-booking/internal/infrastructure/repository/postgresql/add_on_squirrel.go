@@ -0,0 +1,207 @@
+package postgresql
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const (
+	addOnTableName      = "add_on_table"
+	addOnServiceName    = "addOnService"
+	addOnSpanRepoPrefix = "addOnRepo"
+)
+
+type addOnRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewAddOnRepo(db *postgres.PostgresDB) *addOnRepo {
+	return &addOnRepo{
+		tableName: addOnTableName,
+		db:        db,
+	}
+}
+
+func (p *addOnRepo) AddOnSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.Select(
+		"add_on_id",
+		"establishment_id",
+		"name",
+		"description",
+		"price",
+		"created_at",
+		"updated_at",
+	).From(p.tableName)
+}
+
+// create a new add-on of an establishment
+func (p addOnRepo) CreateAddOn(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error) {
+
+	ctx, span := otlp.Start(ctx, addOnServiceName, addOnSpanRepoPrefix+"Create")
+	defer span.End()
+
+	data := map[string]interface{}{
+		"add_on_id":        addOn.AddOnId,
+		"establishment_id": addOn.EstablishmentId,
+		"name":             addOn.Name,
+		"description":      addOn.Description,
+		"price":            addOn.Price,
+		"created_at":       addOn.CreatedAt,
+		"updated_at":       addOn.UpdatedAt,
+	}
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for creating add-on: %v", err)
+	}
+
+	_, err = p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return addOn, nil
+}
+
+// get an add-on
+func (p addOnRepo) GetAddOn(ctx context.Context, add_on_id string) (*entity.AddOn, error) {
+
+	ctx, span := otlp.Start(ctx, addOnServiceName, addOnSpanRepoPrefix+"Get")
+	defer span.End()
+
+	var addOn entity.AddOn
+
+	query, args, err := p.AddOnSelectQueryPrefix().
+		Where(p.db.Sq.Equal("add_on_id", add_on_id)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting add-on: %v", err)
+	}
+
+	if err := p.db.QueryRow(ctx, query, args...).Scan(
+		&addOn.AddOnId,
+		&addOn.EstablishmentId,
+		&addOn.Name,
+		&addOn.Description,
+		&addOn.Price,
+		&addOn.CreatedAt,
+		&addOn.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &addOn, nil
+}
+
+// list add-ons of an establishment
+func (p addOnRepo) ListAddOnsByEstablishment(ctx context.Context, establishment_id string) ([]*entity.AddOn, uint64, error) {
+
+	ctx, span := otlp.Start(ctx, addOnServiceName, addOnSpanRepoPrefix+"ListByEstablishment")
+	defer span.End()
+
+	query, args, err := p.AddOnSelectQueryPrefix().
+		Where(p.db.Sq.Equal("establishment_id", establishment_id)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		OrderBy("name").
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build SQL query for listing add-ons: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get add-ons for establishment: %v", err)
+	}
+	defer rows.Close()
+
+	var addOns []*entity.AddOn
+
+	for rows.Next() {
+		var addOn entity.AddOn
+		if err := rows.Scan(
+			&addOn.AddOnId,
+			&addOn.EstablishmentId,
+			&addOn.Name,
+			&addOn.Description,
+			&addOn.Price,
+			&addOn.CreatedAt,
+			&addOn.UpdatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan add-on row: %v", err)
+		}
+		addOns = append(addOns, &addOn)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error encountered while iterating over add-on rows: %v", err)
+	}
+
+	return addOns, uint64(len(addOns)), nil
+}
+
+// update the name, description and price of an add-on, bookings made before
+// keep the price they were made at
+func (p addOnRepo) UpdateAddOn(ctx context.Context, request *entity.AddOn) (*entity.AddOn, error) {
+
+	ctx, span := otlp.Start(ctx, addOnServiceName, addOnSpanRepoPrefix+"Update")
+	defer span.End()
+
+	clauses := map[string]interface{}{
+		"name":        request.Name,
+		"description": request.Description,
+		"price":       request.Price,
+		"updated_at":  time.Now().Local(),
+	}
+
+	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("add_on_id", request.AddOnId), p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for updating add-on: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, entity.NewErrNotFound("add-on")
+	}
+
+	return p.GetAddOn(ctx, request.AddOnId)
+}
+
+// delete an add-on softly
+func (p addOnRepo) DeleteAddOn(ctx context.Context, add_on_id string) error {
+
+	ctx, span := otlp.Start(ctx, addOnServiceName, addOnSpanRepoPrefix+"Delete")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
+		Set("deleted_at", time.Now().Local()).
+		Where(p.db.Sq.Equal("add_on_id", add_on_id), p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for deleting add-on: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for deleting add-on: %v", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("add-on")
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/config"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddOnCRUD(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewAddOnRepo(db)
+
+	establishment_id := uuid.New().String()
+
+	breakfast := &entity.AddOn{
+		AddOnId:         uuid.New().String(),
+		EstablishmentId: establishment_id,
+		Name:            "Breakfast",
+		Description:     "buffet breakfast from 7 to 10",
+		Price:           12,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*7)
+	defer cancel()
+
+	// Test Method Create
+	createdAddOn, err := repo.CreateAddOn(ctx, breakfast)
+	assert.NoError(t, err)
+	assert.Equal(t, breakfast.AddOnId, createdAddOn.AddOnId)
+
+	// an establishment sells an add-on under one name only
+	_, err = repo.CreateAddOn(ctx, &entity.AddOn{
+		AddOnId:         uuid.New().String(),
+		EstablishmentId: establishment_id,
+		Name:            "Breakfast",
+		Price:           15,
+	})
+	assert.ErrorIs(t, err, entity.ErrorConflict)
+
+	_, err = repo.CreateAddOn(ctx, &entity.AddOn{
+		AddOnId:         uuid.New().String(),
+		EstablishmentId: establishment_id,
+		Name:            "Parking",
+		Price:           8.5,
+	})
+	assert.NoError(t, err)
+
+	// Test Method ListByEstablishment
+	addOns, count, err := repo.ListAddOnsByEstablishment(ctx, establishment_id)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), count)
+	assert.Equal(t, "Breakfast", addOns[0].Name)
+
+	// Test Method Update
+	breakfast.Price = 14
+	breakfast.Description = "buffet breakfast from 7 to 11"
+	updatedAddOn, err := repo.UpdateAddOn(ctx, breakfast)
+	assert.NoError(t, err)
+	assert.Equal(t, breakfast.Price, updatedAddOn.Price)
+	assert.Equal(t, breakfast.Description, updatedAddOn.Description)
+
+	// Test Method Delete
+	err = repo.DeleteAddOn(ctx, breakfast.AddOnId)
+	assert.NoError(t, err)
+
+	_, err = repo.GetAddOn(ctx, breakfast.AddOnId)
+	assert.Error(t, err)
+
+	err = repo.DeleteAddOn(ctx, breakfast.AddOnId)
+	assert.Error(t, err)
+}
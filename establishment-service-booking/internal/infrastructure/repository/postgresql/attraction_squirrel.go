@@ -38,6 +38,10 @@ func (p *attractionRepo) AttractionSelectQueryPrefix() squirrel.SelectBuilder {
 		"owner_id",
 		"description",
 		"rating",
+		"opening_hours",
+		"entry_window_minutes",
+		"daily_quota",
+		"window_quota",
 		"contact_number",
 		"licence_url",
 		"website_url",
@@ -101,16 +105,20 @@ func (p attractionRepo) CreateAttraction(ctx context.Context, attraction *entity
 
 	// insert general info of attraction
 	data := map[string]interface{}{
-		"attraction_id":   attraction.AttractionId,
-		"attraction_name": attraction.AttractionName,
-		"owner_id":        attraction.OwnerId,
-		"description":     attraction.Description,
-		"rating":          attraction.Rating,
-		"contact_number":  attraction.ContactNumber,
-		"licence_url":     attraction.LicenceUrl,
-		"website_url":     attraction.WebsiteUrl,
-		"created_at":      attraction.CreatedAt,
-		"updated_at":      attraction.UpdatedAt,
+		"attraction_id":        attraction.AttractionId,
+		"attraction_name":      attraction.AttractionName,
+		"owner_id":             attraction.OwnerId,
+		"description":          attraction.Description,
+		"rating":               attraction.Rating,
+		"opening_hours":        attraction.OpeningHours,
+		"entry_window_minutes": attraction.EntryWindowMinutes,
+		"daily_quota":          attraction.DailyQuota,
+		"window_quota":         attraction.WindowQuota,
+		"contact_number":       attraction.ContactNumber,
+		"licence_url":          attraction.LicenceUrl,
+		"website_url":          attraction.WebsiteUrl,
+		"created_at":           attraction.CreatedAt,
+		"updated_at":           attraction.UpdatedAt,
 	}
 	query, args, err = p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
 	if err != nil {
@@ -149,6 +157,10 @@ func (p attractionRepo) GetAttraction(ctx context.Context, attraction_id string)
 		&attraction.OwnerId,
 		&attraction.Description,
 		&attraction.Rating,
+		&attraction.OpeningHours,
+		&attraction.EntryWindowMinutes,
+		&attraction.DailyQuota,
+		&attraction.WindowQuota,
 		&attraction.ContactNumber,
 		&attraction.LicenceUrl,
 		&attraction.WebsiteUrl,
@@ -201,6 +213,13 @@ func (p attractionRepo) GetAttraction(ctx context.Context, attraction_id string)
 		return nil, fmt.Errorf("error encountered while iterating over image rows: %v", err)
 	}
 
+	// Fetch ticket types of the attraction
+	ticketTypes, _, err := NewTicketTypeRepo(p.db).ListTicketTypesByAttraction(ctx, attraction.AttractionId)
+	if err != nil {
+		return nil, err
+	}
+	attraction.TicketTypes = ticketTypes
+
 	return &attraction, nil
 }
 
@@ -238,6 +257,10 @@ func (p attractionRepo) ListAttractions(ctx context.Context, offset, limit int64
 			&attraction.OwnerId,
 			&attraction.Description,
 			&attraction.Rating,
+			&attraction.OpeningHours,
+			&attraction.EntryWindowMinutes,
+			&attraction.DailyQuota,
+			&attraction.WindowQuota,
 			&attraction.ContactNumber,
 			&attraction.LicenceUrl,
 			&attraction.WebsiteUrl,
@@ -312,13 +335,17 @@ func (p attractionRepo) UpdateAttraction(ctx context.Context, request *entity.At
 	defer span.End()
 
 	clauses := map[string]interface{}{
-		"attraction_name": request.AttractionName,
-		"description":     request.Description,
-		"rating":          request.Rating,
-		"contact_number":  request.ContactNumber,
-		"licence_url":     request.LicenceUrl,
-		"website_url":     request.WebsiteUrl,
-		"updated_at":      time.Now().Local(),
+		"attraction_name":      request.AttractionName,
+		"description":          request.Description,
+		"rating":               request.Rating,
+		"opening_hours":        request.OpeningHours,
+		"entry_window_minutes": request.EntryWindowMinutes,
+		"daily_quota":          request.DailyQuota,
+		"window_quota":         request.WindowQuota,
+		"contact_number":       request.ContactNumber,
+		"licence_url":          request.LicenceUrl,
+		"website_url":          request.WebsiteUrl,
+		"updated_at":           time.Now().Local(),
 	}
 
 	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
@@ -383,6 +410,10 @@ func (p attractionRepo) UpdateAttraction(ctx context.Context, request *entity.At
 		&attraction.OwnerId,
 		&attraction.Description,
 		&attraction.Rating,
+		&attraction.OpeningHours,
+		&attraction.EntryWindowMinutes,
+		&attraction.DailyQuota,
+		&attraction.WindowQuota,
 		&attraction.ContactNumber,
 		&attraction.LicenceUrl,
 		&attraction.WebsiteUrl,
@@ -473,11 +504,11 @@ func (p attractionRepo) ListAttractionsByLocation(ctx context.Context, offset, l
 	ctx, span := otlp.Start(ctx, attractionServiceName, attractionSpanRepoPrefix+"ListL")
 	defer span.End()
 
-	countryStr := "%"+country+"%"
-	cityStr := "%"+city+"%"
-	stateStr := "%"+state_province+"%"
+	countryStr := "%" + country + "%"
+	cityStr := "%" + city + "%"
+	stateStr := "%" + state_province + "%"
 
-	queryL := fmt.Sprintf("SELECT establishment_id FROM location_table WHERE country LIKE '%s' and city LIKE '%s' and state_province LIKE '%s' and category = 'attraction' and deleted_at IS NULL LIMIT $1 OFFSET $2",countryStr, cityStr, stateStr)
+	queryL := fmt.Sprintf("SELECT establishment_id FROM location_table WHERE country LIKE '%s' and city LIKE '%s' and state_province LIKE '%s' and category = 'attraction' and deleted_at IS NULL LIMIT $1 OFFSET $2", countryStr, cityStr, stateStr)
 	rows, err := p.db.Query(ctx, queryL, limit, offset)
 	if err != nil {
 		return nil, 0, err
@@ -496,7 +527,7 @@ func (p attractionRepo) ListAttractionsByLocation(ctx context.Context, offset, l
 
 		var attraction entity.Attraction
 
-		queryA := `SELECT attraction_id, owner_id, attraction_name, description, rating, contact_number, licence_url, website_url, created_at, updated_at FROM attraction_table WHERE attraction_id = $1`
+		queryA := `SELECT attraction_id, owner_id, attraction_name, description, rating, opening_hours, entry_window_minutes, daily_quota, window_quota, contact_number, licence_url, website_url, created_at, updated_at FROM attraction_table WHERE attraction_id = $1`
 
 		if err := p.db.QueryRow(ctx, queryA, establishment_id).Scan(
 			&attraction.AttractionId,
@@ -504,6 +535,10 @@ func (p attractionRepo) ListAttractionsByLocation(ctx context.Context, offset, l
 			&attraction.AttractionName,
 			&attraction.Description,
 			&attraction.Rating,
+			&attraction.OpeningHours,
+			&attraction.EntryWindowMinutes,
+			&attraction.DailyQuota,
+			&attraction.WindowQuota,
 			&attraction.ContactNumber,
 			&attraction.LicenceUrl,
 			&attraction.WebsiteUrl,
@@ -590,6 +625,10 @@ func (p attractionRepo) FindAttractionsByName(ctx context.Context, name string)
   owner_id,
   description,
   rating,
+  opening_hours,
+  entry_window_minutes,
+  daily_quota,
+  window_quota,
   contact_number,
   licence_url,
   website_url,
@@ -615,6 +654,10 @@ func (p attractionRepo) FindAttractionsByName(ctx context.Context, name string)
 			&attraction.OwnerId,
 			&attraction.Description,
 			&attraction.Rating,
+			&attraction.OpeningHours,
+			&attraction.EntryWindowMinutes,
+			&attraction.DailyQuota,
+			&attraction.WindowQuota,
 			&attraction.ContactNumber,
 			&attraction.LicenceUrl,
 			&attraction.WebsiteUrl,
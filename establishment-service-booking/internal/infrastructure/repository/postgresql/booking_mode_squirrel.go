@@ -0,0 +1,79 @@
+package postgresql
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+	"context"
+	"fmt"
+)
+
+const (
+	bookingModeTableName      = "booking_mode_table"
+	bookingModeServiceName    = "bookingModeService"
+	bookingModeSpanRepoPrefix = "bookingModeRepo"
+)
+
+type bookingModeRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewBookingModeRepo(db *postgres.PostgresDB) *bookingModeRepo {
+	return &bookingModeRepo{
+		tableName: bookingModeTableName,
+		db:        db,
+	}
+}
+
+// set the booking mode of the establishment, replacing the one it had
+func (p bookingModeRepo) SetBookingMode(ctx context.Context, mode *entity.BookingMode) (*entity.BookingMode, error) {
+
+	ctx, span := otlp.Start(ctx, bookingModeServiceName, bookingModeSpanRepoPrefix+"Set")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(map[string]interface{}{
+		"establishment_id": mode.EstablishmentId,
+		"mode":             mode.Mode,
+		"updated_at":       mode.UpdatedAt,
+	}).Suffix("ON CONFLICT (establishment_id) DO UPDATE SET mode = EXCLUDED.mode, updated_at = EXCLUDED.updated_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for setting booking mode: %v", err)
+	}
+
+	if _, err = p.db.Exec(ctx, sqlStr, args...); err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for setting booking mode: %v", err)
+	}
+
+	return mode, nil
+}
+
+// get the booking mode of the establishment
+func (p bookingModeRepo) GetBookingMode(ctx context.Context, establishment_id string) (*entity.BookingMode, error) {
+
+	ctx, span := otlp.Start(ctx, bookingModeServiceName, bookingModeSpanRepoPrefix+"Get")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Select(
+		"establishment_id",
+		"mode",
+		"updated_at",
+	).From(p.tableName).
+		Where(p.db.Sq.Equal("establishment_id", establishment_id)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting booking mode: %v", err)
+	}
+
+	var mode entity.BookingMode
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&mode.EstablishmentId,
+		&mode.Mode,
+		&mode.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &mode, nil
+}
@@ -0,0 +1,57 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/config"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingModeSetGet(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewBookingModeRepo(db)
+
+	mode := &entity.BookingMode{
+		EstablishmentId: uuid.New().String(),
+		Mode:            entity.BookingModeRequest,
+		UpdatedAt:       time.Now().UTC().Truncate(time.Second),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*7)
+	defer cancel()
+
+	// an establishment that never set a mode has none stored
+	_, err = repo.GetBookingMode(ctx, mode.EstablishmentId)
+	assert.ErrorIs(t, err, entity.ErrorNotFound)
+
+	// Test Method Set
+	_, err = repo.SetBookingMode(ctx, mode)
+	assert.NoError(t, err)
+
+	// Test Method Get
+	gotMode, err := repo.GetBookingMode(ctx, mode.EstablishmentId)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.BookingModeRequest, gotMode.Mode)
+
+	// setting the mode again replaces it
+	mode.Mode = entity.BookingModeInstant
+	_, err = repo.SetBookingMode(ctx, mode)
+	assert.NoError(t, err)
+
+	gotMode, err = repo.GetBookingMode(ctx, mode.EstablishmentId)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.BookingModeInstant, gotMode.Mode)
+}
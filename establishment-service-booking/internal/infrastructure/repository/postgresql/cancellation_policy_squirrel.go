@@ -0,0 +1,152 @@
+package postgresql
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const (
+	cancellationRuleTableName        = "cancellation_rule_table"
+	cancellationPolicyServiceName    = "cancellationPolicyService"
+	cancellationPolicySpanRepoPrefix = "cancellationPolicyRepo"
+)
+
+type cancellationPolicyRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewCancellationPolicyRepo(db *postgres.PostgresDB) *cancellationPolicyRepo {
+	return &cancellationPolicyRepo{
+		tableName: cancellationRuleTableName,
+		db:        db,
+	}
+}
+
+// replace all rules of the establishment with the rules of the policy
+func (p cancellationPolicyRepo) SetCancellationPolicy(ctx context.Context, policy *entity.CancellationPolicy) (*entity.CancellationPolicy, error) {
+
+	ctx, span := otlp.Start(ctx, cancellationPolicyServiceName, cancellationPolicySpanRepoPrefix+"Set")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction for setting cancellation policy: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sqlStr, args, err := p.db.Sq.Builder.Delete(p.tableName).
+		Where(p.db.Sq.Equal("establishment_id", policy.EstablishmentId)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for clearing cancellation policy: %v", err)
+	}
+	if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for clearing cancellation policy: %v", err)
+	}
+
+	for _, rule := range policy.Rules {
+		sqlStr, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(map[string]interface{}{
+			"rule_id":            uuid.New().String(),
+			"establishment_id":   policy.EstablishmentId,
+			"hours_before":       rule.HoursBefore,
+			"refund_percent":     rule.RefundPercent,
+			"change_fee_percent": rule.ChangeFeePercent,
+			"created_at":         policy.UpdatedAt,
+			"updated_at":         policy.UpdatedAt,
+		}).ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build SQL query for creating cancellation rule: %v", err)
+		}
+		if _, err = tx.Exec(ctx, sqlStr, args...); err != nil {
+			return nil, p.db.Error(err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit cancellation policy: %v", err)
+	}
+
+	return policy, nil
+}
+
+// get the rules of the establishment, the latest deadline first
+func (p cancellationPolicyRepo) GetCancellationPolicy(ctx context.Context, establishment_id string) (*entity.CancellationPolicy, error) {
+
+	ctx, span := otlp.Start(ctx, cancellationPolicyServiceName, cancellationPolicySpanRepoPrefix+"Get")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.Select(
+		"hours_before",
+		"refund_percent",
+		"change_fee_percent",
+		"updated_at",
+	).From(p.tableName).
+		Where(p.db.Sq.Equal("establishment_id", establishment_id)).
+		OrderBy("hours_before DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting cancellation policy: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cancellation policy: %v", err)
+	}
+	defer rows.Close()
+
+	policy := entity.CancellationPolicy{
+		EstablishmentId: establishment_id,
+	}
+	for rows.Next() {
+		var rule entity.CancellationRule
+		if err := rows.Scan(
+			&rule.HoursBefore,
+			&rule.RefundPercent,
+			&rule.ChangeFeePercent,
+			&policy.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan cancellation rule row: %v", err)
+		}
+		policy.Rules = append(policy.Rules, &rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error encountered while iterating over cancellation rule rows: %v", err)
+	}
+
+	if len(policy.Rules) == 0 {
+		return nil, entity.NewErrNotFound("cancellation policy")
+	}
+
+	return &policy, nil
+}
+
+// delete all rules of the establishment
+func (p cancellationPolicyRepo) DeleteCancellationPolicy(ctx context.Context, establishment_id string) error {
+
+	ctx, span := otlp.Start(ctx, cancellationPolicyServiceName, cancellationPolicySpanRepoPrefix+"Delete")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.Delete(p.tableName).
+		Where(p.db.Sq.Equal("establishment_id", establishment_id)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for deleting cancellation policy: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for deleting cancellation policy: %v", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("cancellation policy")
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/config"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancellationPolicyCRUD(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewCancellationPolicyRepo(db)
+
+	policy := &entity.CancellationPolicy{
+		EstablishmentId: uuid.New().String(),
+		Rules: []*entity.CancellationRule{
+			{HoursBefore: 48, RefundPercent: 100},
+			{HoursBefore: 0, RefundPercent: 50, ChangeFeePercent: 10},
+		},
+		UpdatedAt: time.Now(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*7)
+	defer cancel()
+
+	// an establishment without rules has no policy of its own
+	_, err = repo.GetCancellationPolicy(ctx, policy.EstablishmentId)
+	assert.Error(t, err)
+
+	// Test Method Set
+	_, err = repo.SetCancellationPolicy(ctx, policy)
+	assert.NoError(t, err)
+
+	// Test Method Get
+	gotPolicy, err := repo.GetCancellationPolicy(ctx, policy.EstablishmentId)
+	assert.NoError(t, err)
+	assert.Equal(t, policy.Rules, gotPolicy.Rules)
+
+	// setting the policy again replaces its rules
+	policy.Rules = []*entity.CancellationRule{
+		{HoursBefore: 24, RefundPercent: 80},
+	}
+	_, err = repo.SetCancellationPolicy(ctx, policy)
+	assert.NoError(t, err)
+
+	gotPolicy, err = repo.GetCancellationPolicy(ctx, policy.EstablishmentId)
+	assert.NoError(t, err)
+	assert.Equal(t, policy.Rules, gotPolicy.Rules)
+
+	// Test Method Delete
+	err = repo.DeleteCancellationPolicy(ctx, policy.EstablishmentId)
+	assert.NoError(t, err)
+
+	err = repo.DeleteCancellationPolicy(ctx, policy.EstablishmentId)
+	assert.Error(t, err)
+}
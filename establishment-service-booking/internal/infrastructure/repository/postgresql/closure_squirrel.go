@@ -0,0 +1,225 @@
+package postgresql
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	closureTableName      = "closure_table"
+	closureServiceName    = "closureService"
+	closureSpanRepoPrefix = "closureRepo"
+)
+
+type closureRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewClosureRepo(db *postgres.PostgresDB) *closureRepo {
+	return &closureRepo{
+		tableName: closureTableName,
+		db:        db,
+	}
+}
+
+func (p *closureRepo) ClosureSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.Select(
+		"closure_id",
+		"establishment_id",
+		"COALESCE(TO_CHAR(start_date, 'YYYY-MM-DD'), '')",
+		"COALESCE(TO_CHAR(end_date, 'YYYY-MM-DD'), '')",
+		"weekdays",
+		"reason",
+		"created_at",
+		"updated_at",
+	).From(p.tableName)
+}
+
+func scanClosure(row pgx.Row) (*entity.Closure, error) {
+	var closure entity.Closure
+	if err := row.Scan(
+		&closure.ClosureId,
+		&closure.EstablishmentId,
+		&closure.StartDate,
+		&closure.EndDate,
+		&closure.Weekdays,
+		&closure.Reason,
+		&closure.CreatedAt,
+		&closure.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &closure, nil
+}
+
+// closureDate stores an empty date as NULL, which leaves that side of the closure open
+func closureDate(date string) squirrel.Sqlizer {
+	return squirrel.Expr("CAST(NULLIF(?, '') AS DATE)", date)
+}
+
+// closureWeekdays keeps a closure without weekdays from being stored as NULL
+func closureWeekdays(closure *entity.Closure) []string {
+	if closure.Weekdays == nil {
+		return []string{}
+	}
+	return closure.Weekdays
+}
+
+// create a new closure of an establishment
+func (p closureRepo) CreateClosure(ctx context.Context, closure *entity.Closure) (*entity.Closure, error) {
+
+	ctx, span := otlp.Start(ctx, closureServiceName, closureSpanRepoPrefix+"Create")
+	defer span.End()
+
+	data := map[string]interface{}{
+		"closure_id":       closure.ClosureId,
+		"establishment_id": closure.EstablishmentId,
+		"start_date":       closureDate(closure.StartDate),
+		"end_date":         closureDate(closure.EndDate),
+		"weekdays":         closureWeekdays(closure),
+		"reason":           closure.Reason,
+		"created_at":       closure.CreatedAt,
+		"updated_at":       closure.UpdatedAt,
+	}
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for creating closure: %v", err)
+	}
+
+	_, err = p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return closure, nil
+}
+
+// get a closure
+func (p closureRepo) GetClosure(ctx context.Context, closure_id string) (*entity.Closure, error) {
+
+	ctx, span := otlp.Start(ctx, closureServiceName, closureSpanRepoPrefix+"Get")
+	defer span.End()
+
+	query, args, err := p.ClosureSelectQueryPrefix().
+		Where(p.db.Sq.Equal("closure_id", closure_id)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting closure: %v", err)
+	}
+
+	closure, err := scanClosure(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return closure, nil
+}
+
+// list closures of an establishment, the earliest first and recurring ones
+// without a start after them
+func (p closureRepo) ListClosuresByEstablishment(ctx context.Context, establishment_id string) ([]*entity.Closure, uint64, error) {
+
+	ctx, span := otlp.Start(ctx, closureServiceName, closureSpanRepoPrefix+"ListByEstablishment")
+	defer span.End()
+
+	query, args, err := p.ClosureSelectQueryPrefix().
+		Where(p.db.Sq.Equal("establishment_id", establishment_id)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		OrderBy("start_date NULLS LAST", "created_at").
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build SQL query for listing closures: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get closures for establishment: %v", err)
+	}
+	defer rows.Close()
+
+	var closures []*entity.Closure
+
+	for rows.Next() {
+		closure, err := scanClosure(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan closure row: %v", err)
+		}
+		closures = append(closures, closure)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error encountered while iterating over closure rows: %v", err)
+	}
+
+	return closures, uint64(len(closures)), nil
+}
+
+// update the dates, weekdays and reason of a closure
+func (p closureRepo) UpdateClosure(ctx context.Context, request *entity.Closure) (*entity.Closure, error) {
+
+	ctx, span := otlp.Start(ctx, closureServiceName, closureSpanRepoPrefix+"Update")
+	defer span.End()
+
+	clauses := map[string]interface{}{
+		"start_date": closureDate(request.StartDate),
+		"end_date":   closureDate(request.EndDate),
+		"weekdays":   closureWeekdays(request),
+		"reason":     request.Reason,
+		"updated_at": time.Now().Local(),
+	}
+
+	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("closure_id", request.ClosureId), p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for updating closure: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for updating closure: %v", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, entity.NewErrNotFound("closure")
+	}
+
+	return p.GetClosure(ctx, request.ClosureId)
+}
+
+// delete a closure softly
+func (p closureRepo) DeleteClosure(ctx context.Context, closure_id string) error {
+
+	ctx, span := otlp.Start(ctx, closureServiceName, closureSpanRepoPrefix+"Delete")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
+		Set("deleted_at", time.Now().Local()).
+		Where(p.db.Sq.Equal("closure_id", closure_id), p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for deleting closure: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for deleting closure: %v", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("closure")
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/config"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosureCRUD(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewClosureRepo(db)
+
+	establishment_id := uuid.New().String()
+
+	renovation := &entity.Closure{
+		ClosureId:       uuid.New().String(),
+		EstablishmentId: establishment_id,
+		StartDate:       "2030-02-01",
+		EndDate:         "2030-02-14",
+		Reason:          "renovation",
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*7)
+	defer cancel()
+
+	// Test Method Create
+	createdClosure, err := repo.CreateClosure(ctx, renovation)
+	assert.NoError(t, err)
+	assert.Equal(t, renovation.ClosureId, createdClosure.ClosureId)
+
+	// a recurring closure without dates is open on both sides
+	mondays := &entity.Closure{
+		ClosureId:       uuid.New().String(),
+		EstablishmentId: establishment_id,
+		Weekdays:        []string{"monday"},
+		Reason:          "day off",
+	}
+	_, err = repo.CreateClosure(ctx, mondays)
+	assert.NoError(t, err)
+
+	// Test Method Get
+	gotClosure, err := repo.GetClosure(ctx, mondays.ClosureId)
+	assert.NoError(t, err)
+	assert.Equal(t, "", gotClosure.StartDate)
+	assert.Equal(t, "", gotClosure.EndDate)
+	assert.Equal(t, []string{"monday"}, gotClosure.Weekdays)
+
+	// Test Method ListByEstablishment
+	closures, count, err := repo.ListClosuresByEstablishment(ctx, establishment_id)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), count)
+	assert.Equal(t, renovation.ClosureId, closures[0].ClosureId)
+	assert.Equal(t, "2030-02-01", closures[0].StartDate)
+	assert.Empty(t, closures[0].Weekdays)
+
+	// Test Method Update
+	renovation.EndDate = "2030-02-20"
+	renovation.Reason = "renovation of the lobby"
+	updatedClosure, err := repo.UpdateClosure(ctx, renovation)
+	assert.NoError(t, err)
+	assert.Equal(t, renovation.EndDate, updatedClosure.EndDate)
+	assert.Equal(t, renovation.Reason, updatedClosure.Reason)
+
+	// Test Method Delete
+	err = repo.DeleteClosure(ctx, renovation.ClosureId)
+	assert.NoError(t, err)
+
+	_, err = repo.GetClosure(ctx, renovation.ClosureId)
+	assert.Error(t, err)
+
+	err = repo.DeleteClosure(ctx, renovation.ClosureId)
+	assert.Error(t, err)
+}
@@ -201,6 +201,13 @@ func (p hotelRepo) GetHotel(ctx context.Context, hotel_id string) (*entity.Hotel
 		return nil, fmt.Errorf("error encountered while iterating over image rows: %v", err)
 	}
 
+	// Fetch rooms of the hotel
+	rooms, _, err := NewRoomRepo(p.db).ListRoomsByHotel(ctx, hotel.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	hotel.Rooms = rooms
+
 	return &hotel, nil
 }
 
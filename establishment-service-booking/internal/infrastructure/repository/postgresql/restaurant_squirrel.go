@@ -39,6 +39,8 @@ func (p *restaurantRepo) RestaurantSelectQueryPrefix() squirrel.SelectBuilder {
 		"description",
 		"rating",
 		"opening_hours",
+		"seat_capacity",
+		"slot_minutes",
 		"contact_number",
 		"licence_url",
 		"website_url",
@@ -108,6 +110,8 @@ func (p restaurantRepo) CreateRestaurant(ctx context.Context, restaurant *entity
 		"description":     restaurant.Description,
 		"rating":          restaurant.Rating,
 		"opening_hours":   restaurant.OpeningHours,
+		"seat_capacity":   restaurant.SeatCapacity,
+		"slot_minutes":    restaurant.SlotMinutes,
 		"contact_number":  restaurant.ContactNumber,
 		"licence_url":     restaurant.LicenceUrl,
 		"website_url":     restaurant.WebsiteUrl,
@@ -152,6 +156,8 @@ func (p restaurantRepo) GetRestaurant(ctx context.Context, restaurant_id string)
 		&restaurant.Description,
 		&restaurant.Rating,
 		&restaurant.OpeningHours,
+		&restaurant.SeatCapacity,
+		&restaurant.SlotMinutes,
 		&restaurant.ContactNumber,
 		&restaurant.LicenceUrl,
 		&restaurant.WebsiteUrl,
@@ -242,6 +248,8 @@ func (p restaurantRepo) ListRestaurants(ctx context.Context, offset, limit int64
 			&restaurant.Description,
 			&restaurant.Rating,
 			&restaurant.OpeningHours,
+			&restaurant.SeatCapacity,
+			&restaurant.SlotMinutes,
 			&restaurant.ContactNumber,
 			&restaurant.LicenceUrl,
 			&restaurant.WebsiteUrl,
@@ -320,6 +328,8 @@ func (p restaurantRepo) UpdateRestaurant(ctx context.Context, request *entity.Re
 		"description":     request.Description,
 		"rating":          request.Rating,
 		"opening_hours":   request.OpeningHours,
+		"seat_capacity":   request.SeatCapacity,
+		"slot_minutes":    request.SlotMinutes,
 		"contact_number":  request.ContactNumber,
 		"licence_url":     request.LicenceUrl,
 		"website_url":     request.WebsiteUrl,
@@ -389,6 +399,8 @@ func (p restaurantRepo) UpdateRestaurant(ctx context.Context, request *entity.Re
 		&restaurant.Description,
 		&restaurant.Rating,
 		&restaurant.OpeningHours,
+		&restaurant.SeatCapacity,
+		&restaurant.SlotMinutes,
 		&restaurant.ContactNumber,
 		&restaurant.LicenceUrl,
 		&restaurant.WebsiteUrl,
@@ -502,7 +514,7 @@ func (p restaurantRepo) ListRestaurantsByLocation(ctx context.Context, offset, l
 
 		var restaurant entity.Restaurant
 
-		queryA := `SELECT restaurant_id, owner_id, restaurant_name, description, rating, opening_hours, contact_number, licence_url, website_url, created_at, updated_at FROM restaurant_table WHERE restaurant_id = $1`
+		queryA := `SELECT restaurant_id, owner_id, restaurant_name, description, rating, opening_hours, seat_capacity, slot_minutes, contact_number, licence_url, website_url, created_at, updated_at FROM restaurant_table WHERE restaurant_id = $1`
 
 		if err := p.db.QueryRow(ctx, queryA, establishment_id).Scan(
 			&restaurant.RestaurantId,
@@ -511,6 +523,8 @@ func (p restaurantRepo) ListRestaurantsByLocation(ctx context.Context, offset, l
 			&restaurant.Description,
 			&restaurant.Rating,
 			&restaurant.OpeningHours,
+			&restaurant.SeatCapacity,
+			&restaurant.SlotMinutes,
 			&restaurant.ContactNumber,
 			&restaurant.LicenceUrl,
 			&restaurant.WebsiteUrl,
@@ -598,6 +612,8 @@ func (p restaurantRepo) FindRestaurantsByName(ctx context.Context, name string)
   description,
   rating,
 	opening_hours,
+  seat_capacity,
+  slot_minutes,
   contact_number,
   licence_url,
   website_url,
@@ -624,6 +640,8 @@ func (p restaurantRepo) FindRestaurantsByName(ctx context.Context, name string)
 			&restaurant.Description,
 			&restaurant.Rating,
 			&restaurant.OpeningHours,
+			&restaurant.SeatCapacity,
+			&restaurant.SlotMinutes,
 			&restaurant.ContactNumber,
 			&restaurant.LicenceUrl,
 			&restaurant.WebsiteUrl,
@@ -0,0 +1,221 @@
+package postgresql
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const (
+	roomTableName      = "room_table"
+	roomServiceName    = "roomService"
+	roomSpanRepoPrefix = "roomRepo"
+)
+
+type roomRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewRoomRepo(db *postgres.PostgresDB) *roomRepo {
+	return &roomRepo{
+		tableName: roomTableName,
+		db:        db,
+	}
+}
+
+func (p *roomRepo) RoomSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.Select(
+		"room_id",
+		"hotel_id",
+		"price",
+		"description",
+		"number_of_rooms",
+		"capacity",
+		"holidays",
+		"discount",
+		"created_at",
+		"updated_at",
+	).From(p.tableName)
+}
+
+// create a new room of a hotel
+func (p roomRepo) CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+
+	ctx, span := otlp.Start(ctx, roomServiceName, roomSpanRepoPrefix+"Create")
+	defer span.End()
+
+	data := map[string]interface{}{
+		"room_id":         room.RoomId,
+		"hotel_id":        room.HotelId,
+		"price":           room.Price,
+		"description":     room.Description,
+		"number_of_rooms": room.NumberOfRooms,
+		"capacity":        room.Capacity,
+		"holidays":        room.Holidays,
+		"discount":        room.Discount,
+		"created_at":      room.CreatedAt,
+		"updated_at":      room.UpdatedAt,
+	}
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for creating room: %v", err)
+	}
+
+	_, err = p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for creating room: %v", err)
+	}
+
+	return room, nil
+}
+
+// get a room
+func (p roomRepo) GetRoom(ctx context.Context, room_id string) (*entity.Room, error) {
+
+	ctx, span := otlp.Start(ctx, roomServiceName, roomSpanRepoPrefix+"Get")
+	defer span.End()
+
+	var room entity.Room
+
+	query, args, err := p.RoomSelectQueryPrefix().
+		Where(p.db.Sq.Equal("room_id", room_id)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting room: %v", err)
+	}
+
+	if err := p.db.QueryRow(ctx, query, args...).Scan(
+		&room.RoomId,
+		&room.HotelId,
+		&room.Price,
+		&room.Description,
+		&room.NumberOfRooms,
+		&room.Capacity,
+		&room.Holidays,
+		&room.Discount,
+		&room.CreatedAt,
+		&room.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &room, nil
+}
+
+// list rooms of a hotel
+func (p roomRepo) ListRoomsByHotel(ctx context.Context, hotel_id string) ([]*entity.Room, uint64, error) {
+
+	ctx, span := otlp.Start(ctx, roomServiceName, roomSpanRepoPrefix+"ListByHotel")
+	defer span.End()
+
+	query, args, err := p.RoomSelectQueryPrefix().
+		Where(p.db.Sq.Equal("hotel_id", hotel_id)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		OrderBy("price").
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build SQL query for listing rooms: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get rooms for hotel: %v", err)
+	}
+	defer rows.Close()
+
+	var rooms []*entity.Room
+
+	for rows.Next() {
+		var room entity.Room
+		if err := rows.Scan(
+			&room.RoomId,
+			&room.HotelId,
+			&room.Price,
+			&room.Description,
+			&room.NumberOfRooms,
+			&room.Capacity,
+			&room.Holidays,
+			&room.Discount,
+			&room.CreatedAt,
+			&room.UpdatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan room row: %v", err)
+		}
+		rooms = append(rooms, &room)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error encountered while iterating over room rows: %v", err)
+	}
+
+	return rooms, uint64(len(rooms)), nil
+}
+
+// update a room
+func (p roomRepo) UpdateRoom(ctx context.Context, request *entity.Room) (*entity.Room, error) {
+
+	ctx, span := otlp.Start(ctx, roomServiceName, roomSpanRepoPrefix+"Update")
+	defer span.End()
+
+	clauses := map[string]interface{}{
+		"price":           request.Price,
+		"description":     request.Description,
+		"number_of_rooms": request.NumberOfRooms,
+		"capacity":        request.Capacity,
+		"holidays":        request.Holidays,
+		"discount":        request.Discount,
+		"updated_at":      time.Now().Local(),
+	}
+
+	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("room_id", request.RoomId), p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for updating room: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for updating room: %v", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, entity.NewErrNotFound("room")
+	}
+
+	return p.GetRoom(ctx, request.RoomId)
+}
+
+// delete a room softly
+func (p roomRepo) DeleteRoom(ctx context.Context, room_id string) error {
+
+	ctx, span := otlp.Start(ctx, roomServiceName, roomSpanRepoPrefix+"Delete")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
+		Set("deleted_at", time.Now().Local()).
+		Where(p.db.Sq.Equal("room_id", room_id), p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for deleting room: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for deleting room: %v", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("room")
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/config"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoomCRUD(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewRoomRepo(db)
+
+	hotel_id := uuid.New().String()
+
+	room := &entity.Room{
+		RoomId:        uuid.New().String(),
+		HotelId:       hotel_id,
+		Price:         120.5,
+		Description:   "Test double room",
+		NumberOfRooms: 4,
+		Capacity:      2,
+		Holidays:      "test holidays",
+		Discount:      10,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*7)
+	defer cancel()
+
+	// Test Method Create
+	createdRoom, err := repo.CreateRoom(ctx, room)
+	assert.NoError(t, err)
+	assert.Equal(t, room.RoomId, createdRoom.RoomId)
+	assert.Equal(t, room.HotelId, createdRoom.HotelId)
+
+	// Test Method Get
+	gotRoom, err := repo.GetRoom(ctx, room.RoomId)
+	assert.NoError(t, err)
+	assert.Equal(t, room.RoomId, gotRoom.RoomId)
+	assert.Equal(t, room.HotelId, gotRoom.HotelId)
+	assert.Equal(t, room.Price, gotRoom.Price)
+	assert.Equal(t, room.Description, gotRoom.Description)
+	assert.Equal(t, room.NumberOfRooms, gotRoom.NumberOfRooms)
+	assert.Equal(t, room.Capacity, gotRoom.Capacity)
+	assert.Equal(t, room.Discount, gotRoom.Discount)
+
+	// Test Method ListByHotel
+	rooms, count, err := repo.ListRoomsByHotel(ctx, hotel_id)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+	assert.Len(t, rooms, 1)
+
+	// Test Method Update
+	room.Price = 99.9
+	room.NumberOfRooms = 6
+	room.Description = "Updated description"
+	updatedRoom, err := repo.UpdateRoom(ctx, room)
+	assert.NoError(t, err)
+	assert.Equal(t, room.Price, updatedRoom.Price)
+	assert.Equal(t, room.NumberOfRooms, updatedRoom.NumberOfRooms)
+	assert.Equal(t, room.Description, updatedRoom.Description)
+
+	// Test Method Delete
+	err = repo.DeleteRoom(ctx, room.RoomId)
+	assert.NoError(t, err)
+
+	_, err = repo.GetRoom(ctx, room.RoomId)
+	assert.Error(t, err)
+
+	err = repo.DeleteRoom(ctx, room.RoomId)
+	assert.Error(t, err)
+}
@@ -0,0 +1,200 @@
+package postgresql
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const (
+	ticketTypeTableName      = "ticket_type_table"
+	ticketTypeServiceName    = "ticketTypeService"
+	ticketTypeSpanRepoPrefix = "ticketTypeRepo"
+)
+
+type ticketTypeRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewTicketTypeRepo(db *postgres.PostgresDB) *ticketTypeRepo {
+	return &ticketTypeRepo{
+		tableName: ticketTypeTableName,
+		db:        db,
+	}
+}
+
+func (p *ticketTypeRepo) TicketTypeSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.Select(
+		"ticket_type_id",
+		"attraction_id",
+		"name",
+		"price",
+		"created_at",
+		"updated_at",
+	).From(p.tableName)
+}
+
+// create a new ticket type of an attraction
+func (p ticketTypeRepo) CreateTicketType(ctx context.Context, ticketType *entity.TicketType) (*entity.TicketType, error) {
+
+	ctx, span := otlp.Start(ctx, ticketTypeServiceName, ticketTypeSpanRepoPrefix+"Create")
+	defer span.End()
+
+	data := map[string]interface{}{
+		"ticket_type_id": ticketType.TicketTypeId,
+		"attraction_id":  ticketType.AttractionId,
+		"name":           ticketType.Name,
+		"price":          ticketType.Price,
+		"created_at":     ticketType.CreatedAt,
+		"updated_at":     ticketType.UpdatedAt,
+	}
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for creating ticket type: %v", err)
+	}
+
+	_, err = p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return ticketType, nil
+}
+
+// get a ticket type
+func (p ticketTypeRepo) GetTicketType(ctx context.Context, ticket_type_id string) (*entity.TicketType, error) {
+
+	ctx, span := otlp.Start(ctx, ticketTypeServiceName, ticketTypeSpanRepoPrefix+"Get")
+	defer span.End()
+
+	var ticketType entity.TicketType
+
+	query, args, err := p.TicketTypeSelectQueryPrefix().
+		Where(p.db.Sq.Equal("ticket_type_id", ticket_type_id)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for getting ticket type: %v", err)
+	}
+
+	if err := p.db.QueryRow(ctx, query, args...).Scan(
+		&ticketType.TicketTypeId,
+		&ticketType.AttractionId,
+		&ticketType.Name,
+		&ticketType.Price,
+		&ticketType.CreatedAt,
+		&ticketType.UpdatedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &ticketType, nil
+}
+
+// list ticket types of an attraction
+func (p ticketTypeRepo) ListTicketTypesByAttraction(ctx context.Context, attraction_id string) ([]*entity.TicketType, uint64, error) {
+
+	ctx, span := otlp.Start(ctx, ticketTypeServiceName, ticketTypeSpanRepoPrefix+"ListByAttraction")
+	defer span.End()
+
+	query, args, err := p.TicketTypeSelectQueryPrefix().
+		Where(p.db.Sq.Equal("attraction_id", attraction_id)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		OrderBy("name").
+		ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build SQL query for listing ticket types: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get ticket types for attraction: %v", err)
+	}
+	defer rows.Close()
+
+	var ticketTypes []*entity.TicketType
+
+	for rows.Next() {
+		var ticketType entity.TicketType
+		if err := rows.Scan(
+			&ticketType.TicketTypeId,
+			&ticketType.AttractionId,
+			&ticketType.Name,
+			&ticketType.Price,
+			&ticketType.CreatedAt,
+			&ticketType.UpdatedAt,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan ticket type row: %v", err)
+		}
+		ticketTypes = append(ticketTypes, &ticketType)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error encountered while iterating over ticket type rows: %v", err)
+	}
+
+	return ticketTypes, uint64(len(ticketTypes)), nil
+}
+
+// update the price of a ticket type
+func (p ticketTypeRepo) UpdateTicketType(ctx context.Context, request *entity.TicketType) (*entity.TicketType, error) {
+
+	ctx, span := otlp.Start(ctx, ticketTypeServiceName, ticketTypeSpanRepoPrefix+"Update")
+	defer span.End()
+
+	clauses := map[string]interface{}{
+		"price":      request.Price,
+		"updated_at": time.Now().Local(),
+	}
+
+	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(clauses).
+		Where(p.db.Sq.Equal("ticket_type_id", request.TicketTypeId), p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for updating ticket type: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for updating ticket type: %v", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, entity.NewErrNotFound("ticket type")
+	}
+
+	return p.GetTicketType(ctx, request.TicketTypeId)
+}
+
+// delete a ticket type softly
+func (p ticketTypeRepo) DeleteTicketType(ctx context.Context, ticket_type_id string) error {
+
+	ctx, span := otlp.Start(ctx, ticketTypeServiceName, ticketTypeSpanRepoPrefix+"Delete")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.Update(p.tableName).
+		Set("deleted_at", time.Now().Local()).
+		Where(p.db.Sq.Equal("ticket_type_id", ticket_type_id), p.db.Sq.Equal("deleted_at", nil)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build SQL query for deleting ticket type: %v", err)
+	}
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query for deleting ticket type: %v", err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("ticket type")
+	}
+
+	return nil
+}
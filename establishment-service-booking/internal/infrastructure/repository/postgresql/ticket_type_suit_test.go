@@ -0,0 +1,83 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/pkg/config"
+	"Booking/establishment-service-booking/internal/pkg/postgres"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicketTypeCRUD(t *testing.T) {
+	// Connect to database
+	cfg := config.New()
+
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return
+	}
+
+	repo := NewTicketTypeRepo(db)
+
+	attraction_id := uuid.New().String()
+
+	adult := &entity.TicketType{
+		TicketTypeId: uuid.New().String(),
+		AttractionId: attraction_id,
+		Name:         entity.TicketAdult,
+		Price:        15,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*7)
+	defer cancel()
+
+	// Test Method Create
+	createdTicketType, err := repo.CreateTicketType(ctx, adult)
+	assert.NoError(t, err)
+	assert.Equal(t, adult.TicketTypeId, createdTicketType.TicketTypeId)
+
+	// an attraction sells a single adult ticket type
+	_, err = repo.CreateTicketType(ctx, &entity.TicketType{
+		TicketTypeId: uuid.New().String(),
+		AttractionId: attraction_id,
+		Name:         entity.TicketAdult,
+		Price:        20,
+	})
+	assert.ErrorIs(t, err, entity.ErrorConflict)
+
+	_, err = repo.CreateTicketType(ctx, &entity.TicketType{
+		TicketTypeId: uuid.New().String(),
+		AttractionId: attraction_id,
+		Name:         entity.TicketChild,
+		Price:        7.5,
+	})
+	assert.NoError(t, err)
+
+	// Test Method ListByAttraction
+	ticketTypes, count, err := repo.ListTicketTypesByAttraction(ctx, attraction_id)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), count)
+	assert.Equal(t, entity.TicketAdult, ticketTypes[0].Name)
+
+	// Test Method Update
+	adult.Price = 17.5
+	updatedTicketType, err := repo.UpdateTicketType(ctx, adult)
+	assert.NoError(t, err)
+	assert.Equal(t, adult.Price, updatedTicketType.Price)
+	assert.Equal(t, entity.TicketAdult, updatedTicketType.Name)
+
+	// Test Method Delete
+	err = repo.DeleteTicketType(ctx, adult.TicketTypeId)
+	assert.NoError(t, err)
+
+	_, err = repo.GetTicketType(ctx, adult.TicketTypeId)
+	assert.Error(t, err)
+
+	err = repo.DeleteTicketType(ctx, adult.TicketTypeId)
+	assert.Error(t, err)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"context"
+)
+
+type Room interface {
+	CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error)
+	GetRoom(ctx context.Context, room_id string) (*entity.Room, error)
+	ListRoomsByHotel(ctx context.Context, hotel_id string) ([]*entity.Room, uint64, error)
+	UpdateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error)
+	DeleteRoom(ctx context.Context, room_id string) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"context"
+)
+
+type TicketType interface {
+	CreateTicketType(ctx context.Context, ticketType *entity.TicketType) (*entity.TicketType, error)
+	GetTicketType(ctx context.Context, ticket_type_id string) (*entity.TicketType, error)
+	ListTicketTypesByAttraction(ctx context.Context, attraction_id string) ([]*entity.TicketType, uint64, error)
+	UpdateTicketType(ctx context.Context, ticketType *entity.TicketType) (*entity.TicketType, error)
+	DeleteTicketType(ctx context.Context, ticket_type_id string) error
+}
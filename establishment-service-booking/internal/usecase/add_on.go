@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/infrastructure/repository"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	addOnServiceName = "addOnService"
+	spanNameAddOn    = "addOnUsecase"
+)
+
+type AddOn interface {
+	CreateAddOn(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error)
+	ListAddOnsByEstablishment(ctx context.Context, establishment_id string) ([]*entity.AddOn, uint64, error)
+	UpdateAddOn(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error)
+	DeleteAddOn(ctx context.Context, add_on_id string) error
+}
+
+type AddOnService struct {
+	BaseUseCase
+	repo       repository.AddOn
+	ctxTimeout time.Duration
+}
+
+func NewAddOnService(ctxTimeout time.Duration, repo repository.AddOn) AddOnService {
+	return AddOnService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (r AddOnService) CreateAddOn(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, addOnServiceName, spanNameAddOn+"Create")
+	defer span.End()
+
+	if err := validateAddOn(addOn); err != nil {
+		return nil, err
+	}
+
+	r.beforeRequest(nil, &addOn.CreatedAt, &addOn.UpdatedAt, nil)
+
+	return r.repo.CreateAddOn(ctx, addOn)
+}
+
+func (r AddOnService) ListAddOnsByEstablishment(ctx context.Context, establishment_id string) ([]*entity.AddOn, uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, addOnServiceName, spanNameAddOn+"ListByEstablishment")
+	defer span.End()
+
+	return r.repo.ListAddOnsByEstablishment(ctx, establishment_id)
+}
+
+func (r AddOnService) UpdateAddOn(ctx context.Context, addOn *entity.AddOn) (*entity.AddOn, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, addOnServiceName, spanNameAddOn+"Update")
+	defer span.End()
+
+	if err := validateAddOn(addOn); err != nil {
+		return nil, err
+	}
+
+	return r.repo.UpdateAddOn(ctx, addOn)
+}
+
+func (r AddOnService) DeleteAddOn(ctx context.Context, add_on_id string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, addOnServiceName, spanNameAddOn+"Delete")
+	defer span.End()
+
+	return r.repo.DeleteAddOn(ctx, add_on_id)
+}
+
+func validateAddOn(addOn *entity.AddOn) error {
+	errValidation := entity.NewErrValidation()
+	if strings.TrimSpace(addOn.Name) == "" {
+		errValidation.Errors["name"] = "must not be empty"
+	}
+	if addOn.Price < 0 {
+		errValidation.Errors["price"] = "must not be negative"
+	}
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid add-on")
+		return errValidation
+	}
+
+	return nil
+}
@@ -11,6 +11,9 @@ import (
 const (
 	attractionServiceName = "attractionService"
 	spanNameAttraction    = "attractionUsecase"
+
+	defaultEntryWindowMinutes = 60
+	defaultDailyQuota         = 500
 )
 
 type Attraction interface {
@@ -43,6 +46,8 @@ func (a AttractionService) CreateAttraction(ctx context.Context, attracation *en
 	ctx, span := otlp.Start(ctx, attractionServiceName, spanNameAttraction+"Create")
 	defer span.End()
 
+	setTicketingDefaults(attracation)
+
 	return a.repo.CreateAttraction(ctx, attracation)
 }
 
@@ -73,6 +78,8 @@ func (a AttractionService) UpdateAttraction(ctx context.Context, attracation *en
 	ctx, span := otlp.Start(ctx, attractionServiceName, spanNameAttraction+"Update")
 	defer span.End()
 
+	setTicketingDefaults(attracation)
+
 	return a.repo.UpdateAttraction(ctx, attracation)
 }
 
@@ -105,3 +112,16 @@ func (a AttractionService) FindAttractionsByName(ctx context.Context, name strin
 
 	return a.repo.FindAttractionsByName(ctx, name)
 }
+
+// setTicketingDefaults fills entry window length and daily quota left unset by the owner
+func setTicketingDefaults(attraction *entity.Attraction) {
+	if attraction.EntryWindowMinutes <= 0 {
+		attraction.EntryWindowMinutes = defaultEntryWindowMinutes
+	}
+	if attraction.DailyQuota <= 0 {
+		attraction.DailyQuota = defaultDailyQuota
+	}
+	if attraction.WindowQuota < 0 {
+		attraction.WindowQuota = 0
+	}
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/infrastructure/repository"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	bookingModeServiceName = "bookingModeService"
+	spanNameBookingMode    = "bookingModeUsecase"
+)
+
+type BookingMode interface {
+	SetBookingMode(ctx context.Context, mode *entity.BookingMode) (*entity.BookingMode, error)
+	GetBookingMode(ctx context.Context, establishment_id string) (*entity.BookingMode, error)
+}
+
+type BookingModeService struct {
+	BaseUseCase
+	repo       repository.BookingMode
+	ctxTimeout time.Duration
+}
+
+func NewBookingModeService(ctxTimeout time.Duration, repo repository.BookingMode) BookingModeService {
+	return BookingModeService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (r BookingModeService) SetBookingMode(ctx context.Context, mode *entity.BookingMode) (*entity.BookingMode, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, bookingModeServiceName, spanNameBookingMode+"Set")
+	defer span.End()
+
+	if mode.Mode != entity.BookingModeInstant && mode.Mode != entity.BookingModeRequest {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["mode"] = fmt.Sprintf("must be %s or %s", entity.BookingModeInstant, entity.BookingModeRequest)
+		errValidation.Err = fmt.Errorf("invalid booking mode")
+		return nil, errValidation
+	}
+
+	r.beforeRequest(nil, nil, &mode.UpdatedAt, nil)
+
+	return r.repo.SetBookingMode(ctx, mode)
+}
+
+// GetBookingMode falls back to instant booking for establishments that did
+// not set a mode
+func (r BookingModeService) GetBookingMode(ctx context.Context, establishment_id string) (*entity.BookingMode, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, bookingModeServiceName, spanNameBookingMode+"Get")
+	defer span.End()
+
+	mode, err := r.repo.GetBookingMode(ctx, establishment_id)
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return &entity.BookingMode{
+			EstablishmentId: establishment_id,
+			Mode:            entity.BookingModeInstant,
+		}, nil
+	}
+
+	return mode, err
+}
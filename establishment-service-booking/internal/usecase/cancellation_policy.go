@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/infrastructure/repository"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	cancellationPolicyServiceName = "cancellationPolicyService"
+	spanNameCancellationPolicy    = "cancellationPolicyUsecase"
+)
+
+type CancellationPolicy interface {
+	SetCancellationPolicy(ctx context.Context, policy *entity.CancellationPolicy) (*entity.CancellationPolicy, error)
+	GetCancellationPolicy(ctx context.Context, establishment_id string) (*entity.CancellationPolicy, error)
+	DeleteCancellationPolicy(ctx context.Context, establishment_id string) error
+}
+
+type CancellationPolicyService struct {
+	BaseUseCase
+	repo       repository.CancellationPolicy
+	ctxTimeout time.Duration
+}
+
+func NewCancellationPolicyService(ctxTimeout time.Duration, repo repository.CancellationPolicy) CancellationPolicyService {
+	return CancellationPolicyService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (r CancellationPolicyService) SetCancellationPolicy(ctx context.Context, policy *entity.CancellationPolicy) (*entity.CancellationPolicy, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, cancellationPolicyServiceName, spanNameCancellationPolicy+"Set")
+	defer span.End()
+
+	errValidation := entity.NewErrValidation()
+	if len(policy.Rules) == 0 {
+		errValidation.Errors["rules"] = "at least one rule is required"
+	}
+	seen := make(map[int64]bool)
+	for _, rule := range policy.Rules {
+		if rule.HoursBefore < 0 {
+			errValidation.Errors["hours_before"] = "must not be negative"
+		}
+		if rule.RefundPercent < 0 || rule.RefundPercent > 100 {
+			errValidation.Errors["refund_percent"] = "must be between 0 and 100"
+		}
+		if rule.ChangeFeePercent < 0 || rule.ChangeFeePercent > 100 {
+			errValidation.Errors["change_fee_percent"] = "must be between 0 and 100"
+		}
+		if seen[rule.HoursBefore] {
+			errValidation.Errors["hours_before"] = "must be unique within the policy"
+		}
+		seen[rule.HoursBefore] = true
+	}
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid cancellation policy")
+		return nil, errValidation
+	}
+
+	sort.Slice(policy.Rules, func(i, j int) bool {
+		return policy.Rules[i].HoursBefore > policy.Rules[j].HoursBefore
+	})
+	r.beforeRequest(nil, nil, &policy.UpdatedAt, nil)
+
+	return r.repo.SetCancellationPolicy(ctx, policy)
+}
+
+// GetCancellationPolicy falls back to entity.DefaultCancellationRules for
+// establishments that did not set a policy
+func (r CancellationPolicyService) GetCancellationPolicy(ctx context.Context, establishment_id string) (*entity.CancellationPolicy, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, cancellationPolicyServiceName, spanNameCancellationPolicy+"Get")
+	defer span.End()
+
+	policy, err := r.repo.GetCancellationPolicy(ctx, establishment_id)
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return &entity.CancellationPolicy{
+			EstablishmentId: establishment_id,
+			Rules:           entity.DefaultCancellationRules,
+		}, nil
+	}
+
+	return policy, err
+}
+
+func (r CancellationPolicyService) DeleteCancellationPolicy(ctx context.Context, establishment_id string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, cancellationPolicyServiceName, spanNameCancellationPolicy+"Delete")
+	defer span.End()
+
+	return r.repo.DeleteCancellationPolicy(ctx, establishment_id)
+}
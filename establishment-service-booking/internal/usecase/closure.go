@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/infrastructure/repository"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	closureServiceName = "closureService"
+	spanNameClosure    = "closureUsecase"
+)
+
+type Closure interface {
+	CreateClosure(ctx context.Context, closure *entity.Closure) (*entity.Closure, error)
+	ListClosuresByEstablishment(ctx context.Context, establishment_id string) ([]*entity.Closure, uint64, error)
+	UpdateClosure(ctx context.Context, closure *entity.Closure) (*entity.Closure, error)
+	DeleteClosure(ctx context.Context, closure_id string) error
+}
+
+type ClosureService struct {
+	BaseUseCase
+	repo       repository.Closure
+	ctxTimeout time.Duration
+}
+
+func NewClosureService(ctxTimeout time.Duration, repo repository.Closure) ClosureService {
+	return ClosureService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (r ClosureService) CreateClosure(ctx context.Context, closure *entity.Closure) (*entity.Closure, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, closureServiceName, spanNameClosure+"Create")
+	defer span.End()
+
+	if err := validateClosure(closure); err != nil {
+		return nil, err
+	}
+
+	r.beforeRequest(nil, &closure.CreatedAt, &closure.UpdatedAt, nil)
+
+	return r.repo.CreateClosure(ctx, closure)
+}
+
+func (r ClosureService) ListClosuresByEstablishment(ctx context.Context, establishment_id string) ([]*entity.Closure, uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, closureServiceName, spanNameClosure+"ListByEstablishment")
+	defer span.End()
+
+	return r.repo.ListClosuresByEstablishment(ctx, establishment_id)
+}
+
+func (r ClosureService) UpdateClosure(ctx context.Context, closure *entity.Closure) (*entity.Closure, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, closureServiceName, spanNameClosure+"Update")
+	defer span.End()
+
+	if err := validateClosure(closure); err != nil {
+		return nil, err
+	}
+
+	return r.repo.UpdateClosure(ctx, closure)
+}
+
+func (r ClosureService) DeleteClosure(ctx context.Context, closure_id string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, closureServiceName, spanNameClosure+"Delete")
+	defer span.End()
+
+	return r.repo.DeleteClosure(ctx, closure_id)
+}
+
+// validateClosure checks the dates and weekdays of a closure and lowercases
+// the weekdays so they are stored the same way whatever the owner typed
+func validateClosure(closure *entity.Closure) error {
+	errValidation := entity.NewErrValidation()
+
+	var start, end time.Time
+	if closure.StartDate != "" {
+		date, err := time.Parse(entity.ClosureDateLayout, closure.StartDate)
+		if err != nil {
+			errValidation.Errors["start_date"] = "must be a date like 2006-01-02"
+		}
+		start = date
+	}
+	if closure.EndDate != "" {
+		date, err := time.Parse(entity.ClosureDateLayout, closure.EndDate)
+		if err != nil {
+			errValidation.Errors["end_date"] = "must be a date like 2006-01-02"
+		}
+		end = date
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		errValidation.Errors["end_date"] = "must not be before start_date"
+	}
+
+	seen := make(map[string]bool, len(closure.Weekdays))
+	for i, weekday := range closure.Weekdays {
+		weekday = strings.ToLower(strings.TrimSpace(weekday))
+		if !entity.ClosureWeekdays[weekday] {
+			errValidation.Errors["weekdays"] = fmt.Sprintf("unknown weekday %q", closure.Weekdays[i])
+			break
+		}
+		if seen[weekday] {
+			errValidation.Errors["weekdays"] = fmt.Sprintf("weekday %q is repeated", weekday)
+			break
+		}
+		seen[weekday] = true
+		closure.Weekdays[i] = weekday
+	}
+	if len(closure.Weekdays) == 0 && (closure.StartDate == "" || closure.EndDate == "") {
+		errValidation.Errors["start_date"] = "start_date and end_date are required when no weekdays are given"
+	}
+
+	if utf8.RuneCountInString(closure.Reason) > 255 {
+		errValidation.Errors["reason"] = "must not be longer than 255 characters"
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid closure")
+		return errValidation
+	}
+
+	return nil
+}
@@ -11,6 +11,9 @@ import (
 const (
 	restaurantServiceName = "restaurantService"
 	spanNameRestaurant    = "restaurantUsecase"
+
+	defaultSeatCapacity = 50
+	defaultSlotMinutes  = 120
 )
 
 type Restaurant interface {
@@ -44,6 +47,7 @@ func (r RestaurantService) CreateRestaurant(ctx context.Context, restaurant *ent
 	defer span.End()
 
 	r.beforeRequest(nil, &restaurant.CreatedAt, &restaurant.UpdatedAt, nil)
+	setReservationDefaults(restaurant)
 
 	return r.repo.CreateRestaurant(ctx, restaurant)
 }
@@ -75,6 +79,8 @@ func (r RestaurantService) UpdateRestaurant(ctx context.Context, restaurant *ent
 	ctx, span := otlp.Start(ctx, restaurantServiceName, spanNameRestaurant+"Update")
 	defer span.End()
 
+	setReservationDefaults(restaurant)
+
 	return r.repo.UpdateRestaurant(ctx, restaurant)
 }
 
@@ -107,3 +113,13 @@ func (r RestaurantService) FindRestaurantsByName(ctx context.Context, name strin
 
 	return r.repo.FindRestaurantsByName(ctx, name)
 }
+
+// setReservationDefaults fills seat capacity and slot length left unset by the owner
+func setReservationDefaults(restaurant *entity.Restaurant) {
+	if restaurant.SeatCapacity <= 0 {
+		restaurant.SeatCapacity = defaultSeatCapacity
+	}
+	if restaurant.SlotMinutes <= 0 {
+		restaurant.SlotMinutes = defaultSlotMinutes
+	}
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/infrastructure/repository"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"context"
+	"time"
+)
+
+const (
+	roomServiceName = "roomService"
+	spanNameRoom    = "roomUsecase"
+)
+
+type Room interface {
+	CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error)
+	GetRoom(ctx context.Context, room_id string) (*entity.Room, error)
+	ListRoomsByHotel(ctx context.Context, hotel_id string) ([]*entity.Room, uint64, error)
+	UpdateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error)
+	DeleteRoom(ctx context.Context, room_id string) error
+}
+
+type RoomService struct {
+	BaseUseCase
+	repo       repository.Room
+	ctxTimeout time.Duration
+}
+
+func NewRoomService(ctxTimeout time.Duration, repo repository.Room) RoomService {
+	return RoomService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (r RoomService) CreateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, roomServiceName, spanNameRoom+"Create")
+	defer span.End()
+
+	r.beforeRequest(nil, &room.CreatedAt, &room.UpdatedAt, nil)
+
+	return r.repo.CreateRoom(ctx, room)
+}
+
+func (r RoomService) GetRoom(ctx context.Context, room_id string) (*entity.Room, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, roomServiceName, spanNameRoom+"Get")
+	defer span.End()
+
+	return r.repo.GetRoom(ctx, room_id)
+}
+
+func (r RoomService) ListRoomsByHotel(ctx context.Context, hotel_id string) ([]*entity.Room, uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, roomServiceName, spanNameRoom+"ListByHotel")
+	defer span.End()
+
+	return r.repo.ListRoomsByHotel(ctx, hotel_id)
+}
+
+func (r RoomService) UpdateRoom(ctx context.Context, room *entity.Room) (*entity.Room, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, roomServiceName, spanNameRoom+"Update")
+	defer span.End()
+
+	return r.repo.UpdateRoom(ctx, room)
+}
+
+func (r RoomService) DeleteRoom(ctx context.Context, room_id string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, roomServiceName, spanNameRoom+"Delete")
+	defer span.End()
+
+	return r.repo.DeleteRoom(ctx, room_id)
+}
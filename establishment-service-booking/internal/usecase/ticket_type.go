@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"Booking/establishment-service-booking/internal/entity"
+	"Booking/establishment-service-booking/internal/infrastructure/repository"
+	"Booking/establishment-service-booking/internal/pkg/otlp"
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	ticketTypeServiceName = "ticketTypeService"
+	spanNameTicketType    = "ticketTypeUsecase"
+)
+
+type TicketType interface {
+	CreateTicketType(ctx context.Context, ticketType *entity.TicketType) (*entity.TicketType, error)
+	ListTicketTypesByAttraction(ctx context.Context, attraction_id string) ([]*entity.TicketType, uint64, error)
+	UpdateTicketType(ctx context.Context, ticketType *entity.TicketType) (*entity.TicketType, error)
+	DeleteTicketType(ctx context.Context, ticket_type_id string) error
+}
+
+type TicketTypeService struct {
+	BaseUseCase
+	repo       repository.TicketType
+	ctxTimeout time.Duration
+}
+
+func NewTicketTypeService(ctxTimeout time.Duration, repo repository.TicketType) TicketTypeService {
+	return TicketTypeService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+	}
+}
+
+func (r TicketTypeService) CreateTicketType(ctx context.Context, ticketType *entity.TicketType) (*entity.TicketType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ticketTypeServiceName, spanNameTicketType+"Create")
+	defer span.End()
+
+	errValidation := entity.NewErrValidation()
+	if ticketType.Name != entity.TicketAdult && ticketType.Name != entity.TicketChild {
+		errValidation.Errors["name"] = "must be either adult or child"
+	}
+	if ticketType.Price < 0 {
+		errValidation.Errors["price"] = "must not be negative"
+	}
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid ticket type")
+		return nil, errValidation
+	}
+
+	r.beforeRequest(nil, &ticketType.CreatedAt, &ticketType.UpdatedAt, nil)
+
+	return r.repo.CreateTicketType(ctx, ticketType)
+}
+
+func (r TicketTypeService) ListTicketTypesByAttraction(ctx context.Context, attraction_id string) ([]*entity.TicketType, uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ticketTypeServiceName, spanNameTicketType+"ListByAttraction")
+	defer span.End()
+
+	return r.repo.ListTicketTypesByAttraction(ctx, attraction_id)
+}
+
+func (r TicketTypeService) UpdateTicketType(ctx context.Context, ticketType *entity.TicketType) (*entity.TicketType, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ticketTypeServiceName, spanNameTicketType+"Update")
+	defer span.End()
+
+	if ticketType.Price < 0 {
+		errValidation := entity.NewErrValidation()
+		errValidation.Errors["price"] = "must not be negative"
+		errValidation.Err = fmt.Errorf("invalid ticket type")
+		return nil, errValidation
+	}
+
+	return r.repo.UpdateTicketType(ctx, ticketType)
+}
+
+func (r TicketTypeService) DeleteTicketType(ctx context.Context, ticket_type_id string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ticketTypeServiceName, spanNameTicketType+"Delete")
+	defer span.End()
+
+	return r.repo.DeleteTicketType(ctx, ticket_type_id)
+}
@@ -0,0 +1 @@
+ALTER TABLE "room_table" ADD COLUMN IF NOT EXISTS "capacity" BIGINT DEFAULT 2;
@@ -0,0 +1,2 @@
+ALTER TABLE "restaurant_table" DROP COLUMN IF EXISTS "slot_minutes";
+ALTER TABLE "restaurant_table" DROP COLUMN IF EXISTS "seat_capacity";
@@ -0,0 +1,2 @@
+ALTER TABLE "restaurant_table" ADD COLUMN IF NOT EXISTS "seat_capacity" BIGINT DEFAULT 50;
+ALTER TABLE "restaurant_table" ADD COLUMN IF NOT EXISTS "slot_minutes" BIGINT DEFAULT 120;
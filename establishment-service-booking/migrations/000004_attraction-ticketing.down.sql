@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "ticket_type_table";
+
+ALTER TABLE "attraction_table" DROP COLUMN IF EXISTS "window_quota";
+ALTER TABLE "attraction_table" DROP COLUMN IF EXISTS "daily_quota";
+ALTER TABLE "attraction_table" DROP COLUMN IF EXISTS "entry_window_minutes";
+ALTER TABLE "attraction_table" DROP COLUMN IF EXISTS "opening_hours";
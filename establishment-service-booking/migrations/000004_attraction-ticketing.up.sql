@@ -0,0 +1,17 @@
+ALTER TABLE "attraction_table" ADD COLUMN IF NOT EXISTS "opening_hours" VARCHAR(255) DEFAULT '';
+ALTER TABLE "attraction_table" ADD COLUMN IF NOT EXISTS "entry_window_minutes" BIGINT DEFAULT 60;
+ALTER TABLE "attraction_table" ADD COLUMN IF NOT EXISTS "daily_quota" BIGINT DEFAULT 500;
+ALTER TABLE "attraction_table" ADD COLUMN IF NOT EXISTS "window_quota" BIGINT DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "ticket_type_table"(
+    "ticket_type_id" UUID PRIMARY KEY NOT NULL,
+    "attraction_id" UUID NOT NULL,
+    "name" VARCHAR(16) NOT NULL,
+    "price" FLOAT DEFAULT 0,
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP(0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "ticket_type_table_attraction_id_name_idx"
+    ON "ticket_type_table" ("attraction_id", "name") WHERE "deleted_at" IS NULL;
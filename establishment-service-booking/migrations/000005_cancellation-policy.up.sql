@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "cancellation_rule_table"(
+    "rule_id" UUID PRIMARY KEY NOT NULL,
+    "establishment_id" UUID NOT NULL,
+    "hours_before" BIGINT NOT NULL DEFAULT 0,
+    "refund_percent" FLOAT NOT NULL DEFAULT 100,
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "cancellation_rule_table_establishment_id_hours_before_idx"
+    ON "cancellation_rule_table" ("establishment_id", "hours_before");
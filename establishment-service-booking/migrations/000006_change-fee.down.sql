@@ -0,0 +1 @@
+ALTER TABLE cancellation_rule_table DROP COLUMN IF EXISTS change_fee_percent;
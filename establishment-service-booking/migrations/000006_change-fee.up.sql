@@ -0,0 +1 @@
+ALTER TABLE cancellation_rule_table ADD COLUMN IF NOT EXISTS change_fee_percent FLOAT NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS "booking_mode_table"(
+    "establishment_id" UUID PRIMARY KEY NOT NULL,
+    "mode" VARCHAR(16) NOT NULL DEFAULT 'instant',
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS "add_on_table";
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "add_on_table"(
+    "add_on_id" UUID PRIMARY KEY NOT NULL,
+    "establishment_id" UUID NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "description" TEXT DEFAULT '',
+    "price" FLOAT DEFAULT 0,
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP(0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "add_on_table_establishment_id_name_idx"
+    ON "add_on_table" ("establishment_id", "name") WHERE "deleted_at" IS NULL;
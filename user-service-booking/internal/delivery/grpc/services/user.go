@@ -286,6 +286,22 @@ func (s userRPC) UserEstablishmentDelete(ctx context.Context, req *pb.Filter) (*
 	return &pb.DelRes{}, nil
 }
 
+func (s userRPC) UserEstablishmentList(ctx context.Context, req *pb.Id) (*pb.UEList, error) {
+	ctx, span := otlp.Start(ctx, "Delivery", "UEList")
+	span.SetAttributes(
+		attribute.Key("deliveryUEListUId").String(req.Id),
+	)
+	defer span.End()
+
+	establishment_ids, err := s.userUsecase.UserEstablishmentList(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UEList{
+		EstablishmentIds: establishment_ids,
+	}, nil
+}
+
 func (s userRPC) CheckUniquess(ctx context.Context, req *pb.FV) (*pb.Status, error) {
 	ctx, span := otlp.Start(ctx, "Delivery", "CheckUniquess")
     span.SetAttributes(
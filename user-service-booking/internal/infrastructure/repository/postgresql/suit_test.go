@@ -117,6 +117,16 @@ func TestUserPostgres(t *testing.T) {
 	assert.NotEmpty(t, listedUsers)
 	assert.NotNil(t, count)
 
+	// Test Method UserEstablishmentList
+	establishmentIds := []string{uuid.NewString(), uuid.NewString()}
+	for _, establishmentId := range establishmentIds {
+		_, _, err = repo.UserEstablishmentCreate(ctx, user.Id, establishmentId)
+		assert.NoError(t, err)
+	}
+	ownedIds, err := repo.UserEstablishmentList(ctx, user.Id)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, establishmentIds, ownedIds)
+
 	// Test Method SoftDelete User BY ID
 	err = repo.SoftDelete(ctx, user.Id)
 	assert.NoError(t, err)
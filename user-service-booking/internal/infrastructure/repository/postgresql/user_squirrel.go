@@ -474,6 +474,38 @@ func (p userRepo) UserEstablishmentDelete(ctx context.Context, params map[string
     return nil
 }
 
+// UserEstablishmentList lists the ids of the establishments the user owns
+func (p userRepo) UserEstablishmentList(ctx context.Context, user_id string) ([]string, error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"UEList")
+	defer span.End()
+
+	sqlStr, args, err := p.db.Sq.Builder.Select("establishment_id").
+		From(p.userEstablishmentTableName).
+		Where(p.db.Sq.Equal("user_id", user_id)).
+		OrderBy("establishment_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build SQL query for listing user establishments: %v", err)
+	}
+
+	rows, err := p.db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query for listing user establishments: %v", err)
+	}
+	defer rows.Close()
+
+	establishment_ids := make([]string, 0)
+	for rows.Next() {
+		var establishment_id string
+		if err = rows.Scan(&establishment_id); err != nil {
+			return nil, fmt.Errorf("failed to scan row while listing user establishments: %v", err)
+		}
+		establishment_ids = append(establishment_ids, establishment_id)
+	}
+
+	return establishment_ids, rows.Err()
+}
+
 func (p userRepo) CheckUniquess(ctx context.Context, field, value string) (int32, error) {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"CheckUniquess")
 	defer span.End()
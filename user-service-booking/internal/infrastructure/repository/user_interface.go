@@ -16,6 +16,7 @@ type User interface {
 	UserEstablishmentCreate(ctx context.Context, user_id, establishment_id string) (string, string, error)
 	UserEstablishmentGet(ctx context.Context, params map[string]string) (*entity.User, string, error)
 	UserEstablishmentDelete(ctx context.Context, params map[string]string) error
+	UserEstablishmentList(ctx context.Context, user_id string) ([]string, error)
 
 	CheckUniquess(ctx context.Context, field, value string) (int32, error)
 	Exists(ctx context.Context, field, value string) (*entity.User, error)
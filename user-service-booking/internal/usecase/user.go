@@ -24,6 +24,7 @@ type User interface {
 	UserEstablishmentCreate(ctx context.Context, user_id, establishment_id string) (string, string, error)
 	UserEstablishmentGet(ctx context.Context, params map[string]string) (*entity.User, string, error)
 	UserEstablishmentDelete(ctx context.Context, params map[string]string) error
+	UserEstablishmentList(ctx context.Context, user_id string) ([]string, error)
 
 	CheckUniquess(ctx context.Context, field, value string) (int32, error)
 	Exists(ctx context.Context, field, value string) (*entity.User, error)
@@ -141,6 +142,16 @@ func (u UserService) UserEstablishmentDelete(ctx context.Context, params map[str
     return u.repo.UserEstablishmentDelete(ctx, params)
 }
 
+func (u UserService) UserEstablishmentList(ctx context.Context, user_id string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, serviceNameUser, spanNameUser+"UEList")
+	defer span.End()
+
+	return u.repo.UserEstablishmentList(ctx, user_id)
+}
+
 func (u UserService) CheckUniquess(ctx context.Context, field, value string) (int32, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
     defer cancel()
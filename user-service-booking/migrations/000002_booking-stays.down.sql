@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS users_hotels_booking_hra_id_stay_idx;
+
+ALTER TABLE users_hotels_booking RENAME COLUMN hra_id TO room_id;
+ALTER TABLE users_restaurants_booking RENAME COLUMN hra_id TO restaurant_id;
+ALTER TABLE users_attractions_booking RENAME COLUMN hra_id TO attraction_id;
@@ -0,0 +1,19 @@
+-- booking tables are created by user-service migrations with per-type
+-- reference columns; the booking repository addresses all of them as hra_id
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'users_hotels_booking' AND column_name = 'room_id') THEN
+        ALTER TABLE users_hotels_booking RENAME COLUMN room_id TO hra_id;
+    END IF;
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'users_restaurants_booking' AND column_name = 'restaurant_id') THEN
+        ALTER TABLE users_restaurants_booking RENAME COLUMN restaurant_id TO hra_id;
+    END IF;
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'users_attractions_booking' AND column_name = 'attraction_id') THEN
+        ALTER TABLE users_attractions_booking RENAME COLUMN attraction_id TO hra_id;
+    END IF;
+END $$;
+
+-- overlapping stays of a room are counted on every hotel booking
+CREATE INDEX IF NOT EXISTS users_hotels_booking_hra_id_stay_idx
+    ON users_hotels_booking (hra_id, will_arrive, will_leave)
+    WHERE is_canceled = false AND deleted_at IS NULL;
@@ -0,0 +1 @@
+ALTER TABLE "room_table" DROP COLUMN IF EXISTS "capacity";
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS users_attractions_booking_hra_id_entry_idx;
+
+ALTER TABLE users_attractions_booking DROP COLUMN IF EXISTS child_tickets;
+ALTER TABLE users_attractions_booking DROP COLUMN IF EXISTS adult_tickets;
+
+DROP TABLE IF EXISTS "ticket_type_table";
+
+ALTER TABLE "attraction_table" DROP COLUMN IF EXISTS "window_quota";
+ALTER TABLE "attraction_table" DROP COLUMN IF EXISTS "daily_quota";
+ALTER TABLE "attraction_table" DROP COLUMN IF EXISTS "entry_window_minutes";
+ALTER TABLE "attraction_table" DROP COLUMN IF EXISTS "opening_hours";
@@ -0,0 +1,2 @@
+ALTER TABLE users_hotels_booking DROP COLUMN IF EXISTS currency;
+ALTER TABLE users_hotels_booking DROP COLUMN IF EXISTS total_price;
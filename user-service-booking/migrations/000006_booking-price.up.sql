@@ -0,0 +1,2 @@
+ALTER TABLE users_hotels_booking ADD COLUMN IF NOT EXISTS total_price FLOAT DEFAULT 0;
+ALTER TABLE users_hotels_booking ADD COLUMN IF NOT EXISTS currency VARCHAR(3) DEFAULT '';
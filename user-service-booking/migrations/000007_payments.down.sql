@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "payment_attempt_table";
+DROP TABLE IF EXISTS "payment_table";
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS "payment_table"(
+    "payment_id" UUID PRIMARY KEY NOT NULL,
+    "booking_id" UUID NOT NULL,
+    "user_id" UUID NOT NULL,
+    "amount" FLOAT DEFAULT 0,
+    "currency" VARCHAR(3) DEFAULT '',
+    "status" VARCHAR(16) NOT NULL DEFAULT 'pending',
+    "provider" VARCHAR(32) NOT NULL,
+    "provider_ref" VARCHAR(255) DEFAULT '',
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "payment_table_booking_id_idx" ON "payment_table" ("booking_id");
+CREATE INDEX IF NOT EXISTS "payment_table_provider_ref_idx" ON "payment_table" ("provider_ref");
+
+CREATE TABLE IF NOT EXISTS "payment_attempt_table"(
+    "attempt_id" UUID PRIMARY KEY NOT NULL,
+    "payment_id" UUID NOT NULL REFERENCES "payment_table" ("payment_id"),
+    "action" VARCHAR(16) NOT NULL,
+    "status" VARCHAR(16) NOT NULL,
+    "error" TEXT DEFAULT '',
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "payment_attempt_table_payment_id_idx" ON "payment_attempt_table" ("payment_id");
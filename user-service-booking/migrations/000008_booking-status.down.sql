@@ -0,0 +1,19 @@
+DROP TABLE IF EXISTS "booking_status_history";
+
+DROP INDEX IF EXISTS users_hotels_booking_hra_id_stay_idx;
+
+ALTER TABLE users_hotels_booking ADD COLUMN IF NOT EXISTS is_canceled BOOLEAN DEFAULT false;
+ALTER TABLE users_restaurants_booking ADD COLUMN IF NOT EXISTS is_canceled BOOLEAN DEFAULT false;
+ALTER TABLE users_attractions_booking ADD COLUMN IF NOT EXISTS is_canceled BOOLEAN DEFAULT false;
+
+UPDATE users_hotels_booking SET is_canceled = status IN ('cancelled', 'no_show');
+UPDATE users_restaurants_booking SET is_canceled = status IN ('cancelled', 'no_show');
+UPDATE users_attractions_booking SET is_canceled = status IN ('cancelled', 'no_show');
+
+ALTER TABLE users_hotels_booking DROP COLUMN IF EXISTS status;
+ALTER TABLE users_restaurants_booking DROP COLUMN IF EXISTS status;
+ALTER TABLE users_attractions_booking DROP COLUMN IF EXISTS status;
+
+CREATE INDEX IF NOT EXISTS users_hotels_booking_hra_id_stay_idx
+    ON users_hotels_booking (hra_id, will_arrive, will_leave)
+    WHERE is_canceled = false AND deleted_at IS NULL;
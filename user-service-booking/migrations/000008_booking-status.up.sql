@@ -0,0 +1,30 @@
+ALTER TABLE users_hotels_booking ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'pending';
+ALTER TABLE users_restaurants_booking ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'confirmed';
+ALTER TABLE users_attractions_booking ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'confirmed';
+
+UPDATE users_hotels_booking SET status = CASE WHEN is_canceled THEN 'cancelled' ELSE 'confirmed' END;
+UPDATE users_restaurants_booking SET status = CASE WHEN is_canceled THEN 'cancelled' ELSE 'confirmed' END;
+UPDATE users_attractions_booking SET status = CASE WHEN is_canceled THEN 'cancelled' ELSE 'confirmed' END;
+
+DROP INDEX IF EXISTS users_hotels_booking_hra_id_stay_idx;
+
+ALTER TABLE users_hotels_booking DROP COLUMN IF EXISTS is_canceled;
+ALTER TABLE users_restaurants_booking DROP COLUMN IF EXISTS is_canceled;
+ALTER TABLE users_attractions_booking DROP COLUMN IF EXISTS is_canceled;
+
+CREATE INDEX IF NOT EXISTS users_hotels_booking_hra_id_stay_idx
+    ON users_hotels_booking (hra_id, will_arrive, will_leave)
+    WHERE status NOT IN ('cancelled', 'no_show') AND deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS "booking_status_history"(
+    "id" UUID PRIMARY KEY NOT NULL,
+    "booking_id" UUID NOT NULL,
+    "booking_type" VARCHAR(16) NOT NULL,
+    "from_status" VARCHAR(16) DEFAULT '',
+    "to_status" VARCHAR(16) NOT NULL,
+    "reason" TEXT DEFAULT '',
+    "changed_by" VARCHAR(64) DEFAULT '',
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "booking_status_history_booking_id_idx" ON "booking_status_history" ("booking_id", "created_at");
@@ -0,0 +1,5 @@
+ALTER TABLE booking_status_history DROP COLUMN IF EXISTS currency;
+ALTER TABLE booking_status_history DROP COLUMN IF EXISTS refund_amount;
+ALTER TABLE booking_status_history DROP COLUMN IF EXISTS refund_percent;
+
+DROP TABLE IF EXISTS "cancellation_rule_table";
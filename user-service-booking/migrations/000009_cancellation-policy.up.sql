@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "cancellation_rule_table"(
+    "rule_id" UUID PRIMARY KEY NOT NULL,
+    "establishment_id" UUID NOT NULL,
+    "hours_before" BIGINT NOT NULL DEFAULT 0,
+    "refund_percent" FLOAT NOT NULL DEFAULT 100,
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "cancellation_rule_table_establishment_id_hours_before_idx"
+    ON "cancellation_rule_table" ("establishment_id", "hours_before");
+
+ALTER TABLE booking_status_history ADD COLUMN IF NOT EXISTS refund_percent FLOAT DEFAULT 0;
+ALTER TABLE booking_status_history ADD COLUMN IF NOT EXISTS refund_amount FLOAT DEFAULT 0;
+ALTER TABLE booking_status_history ADD COLUMN IF NOT EXISTS currency VARCHAR(3) DEFAULT '';
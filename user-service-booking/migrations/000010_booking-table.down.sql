@@ -0,0 +1,69 @@
+CREATE TABLE IF NOT EXISTS users_hotels_booking
+(
+    id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    hra_id UUID NOT NULL,
+    will_arrive TIMESTAMP,
+    will_leave TIMESTAMP,
+    number_of_people INTEGER,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    reason TEXT,
+    total_price FLOAT DEFAULT 0,
+    currency VARCHAR(3) DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS users_restaurants_booking
+(
+    id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    hra_id UUID NOT NULL,
+    will_arrive TIMESTAMP,
+    will_leave TIMESTAMP DEFAULT NULL,
+    number_of_people INTEGER,
+    status VARCHAR(16) NOT NULL DEFAULT 'confirmed',
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS users_attractions_booking
+(
+    id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    hra_id UUID NOT NULL,
+    will_arrive TIMESTAMP,
+    will_leave TIMESTAMP DEFAULT NULL,
+    number_of_people INTEGER,
+    status VARCHAR(16) NOT NULL DEFAULT 'confirmed',
+    reason TEXT,
+    adult_tickets INTEGER DEFAULT 0,
+    child_tickets INTEGER DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+INSERT INTO users_hotels_booking (id, user_id, hra_id, will_arrive, will_leave, number_of_people, status, reason, total_price, currency, created_at, updated_at, deleted_at)
+SELECT id, user_id, hra_id, will_arrive, will_leave, number_of_people, status, reason, total_price, currency, created_at, updated_at, deleted_at
+FROM booking_table WHERE booking_type = 'hotel';
+
+INSERT INTO users_restaurants_booking (id, user_id, hra_id, will_arrive, will_leave, number_of_people, status, reason, created_at, updated_at, deleted_at)
+SELECT id, user_id, hra_id, will_arrive, will_leave, number_of_people, status, reason, created_at, updated_at, deleted_at
+FROM booking_table WHERE booking_type = 'restaurant';
+
+INSERT INTO users_attractions_booking (id, user_id, hra_id, will_arrive, will_leave, number_of_people, status, reason, adult_tickets, child_tickets, created_at, updated_at, deleted_at)
+SELECT id, user_id, hra_id, will_arrive, will_leave, number_of_people, status, reason, adult_tickets, child_tickets, created_at, updated_at, deleted_at
+FROM booking_table WHERE booking_type = 'attraction';
+
+CREATE INDEX IF NOT EXISTS users_hotels_booking_hra_id_stay_idx
+    ON users_hotels_booking (hra_id, will_arrive, will_leave)
+    WHERE status NOT IN ('cancelled', 'no_show') AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS users_attractions_booking_hra_id_entry_idx
+    ON users_attractions_booking (hra_id, will_arrive);
+
+DROP TABLE IF EXISTS "booking_table";
@@ -0,0 +1,46 @@
+-- hotel, restaurant and attraction bookings live in one table told apart by
+-- booking_type, columns a type does not use keep their defaults
+CREATE TABLE IF NOT EXISTS "booking_table"(
+    "id" UUID PRIMARY KEY NOT NULL,
+    "booking_type" VARCHAR(16) NOT NULL,
+    "user_id" UUID NOT NULL,
+    "hra_id" UUID NOT NULL,
+    "will_arrive" TIMESTAMP,
+    "will_leave" TIMESTAMP,
+    "number_of_people" INTEGER,
+    "status" VARCHAR(16) NOT NULL,
+    "reason" TEXT DEFAULT '',
+    "adult_tickets" INTEGER DEFAULT 0,
+    "child_tickets" INTEGER DEFAULT 0,
+    "total_price" FLOAT DEFAULT 0,
+    "currency" VARCHAR(3) DEFAULT '',
+    "created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP,
+    "deleted_at" TIMESTAMP
+);
+
+INSERT INTO booking_table (id, booking_type, user_id, hra_id, will_arrive, will_leave, number_of_people, status, reason, total_price, currency, created_at, updated_at, deleted_at)
+SELECT id, 'hotel', user_id, hra_id, will_arrive, will_leave, number_of_people, status, COALESCE(reason, ''), COALESCE(total_price, 0), COALESCE(currency, ''), created_at, updated_at, deleted_at
+FROM users_hotels_booking
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO booking_table (id, booking_type, user_id, hra_id, will_arrive, will_leave, number_of_people, status, reason, created_at, updated_at, deleted_at)
+SELECT id, 'restaurant', user_id, hra_id, will_arrive, will_leave, number_of_people, status, COALESCE(reason, ''), created_at, updated_at, deleted_at
+FROM users_restaurants_booking
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO booking_table (id, booking_type, user_id, hra_id, will_arrive, will_leave, number_of_people, status, reason, adult_tickets, child_tickets, created_at, updated_at, deleted_at)
+SELECT id, 'attraction', user_id, hra_id, will_arrive, will_leave, number_of_people, status, COALESCE(reason, ''), COALESCE(adult_tickets, 0), COALESCE(child_tickets, 0), created_at, updated_at, deleted_at
+FROM users_attractions_booking
+ON CONFLICT (id) DO NOTHING;
+
+DROP TABLE IF EXISTS users_hotels_booking;
+DROP TABLE IF EXISTS users_restaurants_booking;
+DROP TABLE IF EXISTS users_attractions_booking;
+
+CREATE INDEX IF NOT EXISTS "booking_table_user_id_idx" ON "booking_table" ("user_id", "booking_type");
+
+-- overlapping bookings of an establishment are counted on every booking
+CREATE INDEX IF NOT EXISTS "booking_table_hra_id_stay_idx"
+    ON "booking_table" ("hra_id", "will_arrive", "will_leave")
+    WHERE status NOT IN ('cancelled', 'no_show') AND deleted_at IS NULL;
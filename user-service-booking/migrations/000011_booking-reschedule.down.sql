@@ -0,0 +1,8 @@
+ALTER TABLE booking_status_history DROP COLUMN IF EXISTS change_fee;
+ALTER TABLE booking_status_history DROP COLUMN IF EXISTS price_difference;
+ALTER TABLE booking_status_history DROP COLUMN IF EXISTS to_will_leave;
+ALTER TABLE booking_status_history DROP COLUMN IF EXISTS to_will_arrive;
+ALTER TABLE booking_status_history DROP COLUMN IF EXISTS from_will_leave;
+ALTER TABLE booking_status_history DROP COLUMN IF EXISTS from_will_arrive;
+
+ALTER TABLE cancellation_rule_table DROP COLUMN IF EXISTS change_fee_percent;
@@ -0,0 +1,8 @@
+ALTER TABLE cancellation_rule_table ADD COLUMN IF NOT EXISTS change_fee_percent FLOAT NOT NULL DEFAULT 0;
+
+ALTER TABLE booking_status_history ADD COLUMN IF NOT EXISTS from_will_arrive VARCHAR(16) DEFAULT '';
+ALTER TABLE booking_status_history ADD COLUMN IF NOT EXISTS from_will_leave VARCHAR(16) DEFAULT '';
+ALTER TABLE booking_status_history ADD COLUMN IF NOT EXISTS to_will_arrive VARCHAR(16) DEFAULT '';
+ALTER TABLE booking_status_history ADD COLUMN IF NOT EXISTS to_will_leave VARCHAR(16) DEFAULT '';
+ALTER TABLE booking_status_history ADD COLUMN IF NOT EXISTS price_difference FLOAT DEFAULT 0;
+ALTER TABLE booking_status_history ADD COLUMN IF NOT EXISTS change_fee FLOAT DEFAULT 0;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS "booking_waitlist"(
+    "id" UUID PRIMARY KEY NOT NULL,
+    "booking_type" VARCHAR(16) NOT NULL,
+    "user_id" UUID NOT NULL,
+    "hra_id" UUID NOT NULL,
+    "will_arrive" TIMESTAMP NOT NULL,
+    "will_leave" TIMESTAMP NOT NULL,
+    "number_of_people" BIGINT NOT NULL DEFAULT 1,
+    "reason" TEXT DEFAULT '',
+    "status" VARCHAR(16) NOT NULL DEFAULT 'waiting',
+    "offer_expires_at" TIMESTAMP,
+    "booking_id" UUID,
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "booking_waitlist_hra_id_idx"
+    ON "booking_waitlist" ("hra_id", "booking_type", "created_at") WHERE status = 'waiting';
+CREATE INDEX IF NOT EXISTS "booking_waitlist_user_id_idx" ON "booking_waitlist" ("user_id");
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "booking_table_itinerary_id_idx";
+ALTER TABLE "booking_table" DROP COLUMN IF EXISTS "itinerary_id";
+DROP TABLE IF EXISTS "booking_itinerary";
@@ -0,0 +1,16 @@
+-- an itinerary ties the hotel, restaurant and attraction bookings of one trip
+-- together, they are booked and cancelled as a whole
+CREATE TABLE IF NOT EXISTS "booking_itinerary"(
+    "id" UUID PRIMARY KEY NOT NULL,
+    "user_id" UUID NOT NULL,
+    "status" VARCHAR(16) NOT NULL DEFAULT 'pending',
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "booking_itinerary_user_id_idx" ON "booking_itinerary" ("user_id");
+
+ALTER TABLE "booking_table" ADD COLUMN IF NOT EXISTS "itinerary_id" UUID REFERENCES "booking_itinerary" ("id");
+
+CREATE INDEX IF NOT EXISTS "booking_table_itinerary_id_idx"
+    ON "booking_table" ("itinerary_id") WHERE itinerary_id IS NOT NULL;
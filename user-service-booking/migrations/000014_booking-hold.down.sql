@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS "booking_table_hra_id_stay_idx";
+CREATE INDEX IF NOT EXISTS "booking_table_hra_id_stay_idx"
+    ON "booking_table" ("hra_id", "will_arrive", "will_leave")
+    WHERE status NOT IN ('cancelled', 'no_show') AND deleted_at IS NULL;
+
+DROP INDEX IF EXISTS "booking_table_hold_expires_at_idx";
+DELETE FROM "booking_status_history" WHERE booking_id IN (SELECT id FROM "booking_table" WHERE status IN ('held', 'expired'));
+DELETE FROM "booking_table" WHERE status IN ('held', 'expired');
+ALTER TABLE "booking_table" DROP COLUMN IF EXISTS "hold_expires_at";
@@ -0,0 +1,11 @@
+-- held bookings keep their place only until hold_expires_at
+ALTER TABLE "booking_table" ADD COLUMN IF NOT EXISTS "hold_expires_at" TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS "booking_table_hold_expires_at_idx"
+    ON "booking_table" ("hold_expires_at") WHERE status = 'held';
+
+-- expired holds no longer count against the establishment
+DROP INDEX IF EXISTS "booking_table_hra_id_stay_idx";
+CREATE INDEX IF NOT EXISTS "booking_table_hra_id_stay_idx"
+    ON "booking_table" ("hra_id", "will_arrive", "will_leave")
+    WHERE status NOT IN ('cancelled', 'no_show', 'expired') AND deleted_at IS NULL;
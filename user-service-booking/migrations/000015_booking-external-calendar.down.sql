@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "booking_external_block";
+DROP TABLE IF EXISTS "booking_calendar_source";
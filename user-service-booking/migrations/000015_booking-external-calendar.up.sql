@@ -0,0 +1,28 @@
+-- calendars of other platforms a hotel room is also listed on, their events
+-- block the room here so it is not booked twice
+CREATE TABLE IF NOT EXISTS "booking_calendar_source"(
+    "id" UUID PRIMARY KEY NOT NULL,
+    "room_id" UUID NOT NULL,
+    "name" VARCHAR(128) NOT NULL DEFAULT '',
+    "url" TEXT NOT NULL DEFAULT '',
+    "last_synced_at" TIMESTAMP(0),
+    "last_error" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "booking_calendar_source_room_id_idx" ON "booking_calendar_source" ("room_id");
+
+-- each block takes one room for the nights from starts_on up to ends_on
+CREATE TABLE IF NOT EXISTS "booking_external_block"(
+    "id" UUID PRIMARY KEY NOT NULL,
+    "source_id" UUID NOT NULL REFERENCES "booking_calendar_source" ("id") ON DELETE CASCADE,
+    "room_id" UUID NOT NULL,
+    "uid" TEXT NOT NULL DEFAULT '',
+    "summary" TEXT NOT NULL DEFAULT '',
+    "starts_on" DATE NOT NULL,
+    "ends_on" DATE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "booking_external_block_source_id_idx" ON "booking_external_block" ("source_id");
+CREATE INDEX IF NOT EXISTS "booking_external_block_room_stay_idx" ON "booking_external_block" ("room_id", "starts_on", "ends_on");
@@ -0,0 +1,2 @@
+ALTER TABLE "booking_table" DROP CONSTRAINT IF EXISTS "booking_table_confirmation_code_key";
+ALTER TABLE "booking_table" DROP COLUMN IF EXISTS "confirmation_code";
@@ -0,0 +1,24 @@
+-- short codes guests read out to identify a booking, they leave out 0, O, 1,
+-- I and L which are easily mixed up
+ALTER TABLE "booking_table" ADD COLUMN IF NOT EXISTS "confirmation_code" VARCHAR(8);
+
+-- bookings made before get a code too, a clash with another one is retried
+DO $$
+DECLARE
+    alphabet CONSTANT TEXT := 'ABCDEFGHJKMNPQRSTUVWXYZ23456789';
+    booking RECORD;
+    code TEXT;
+BEGIN
+    FOR booking IN SELECT id FROM booking_table WHERE confirmation_code IS NULL LOOP
+        LOOP
+            code := '';
+            FOR i IN 1..8 LOOP
+                code := code || substr(alphabet, 1 + floor(random() * length(alphabet))::INTEGER, 1);
+            END LOOP;
+            EXIT WHEN NOT EXISTS (SELECT 1 FROM booking_table WHERE confirmation_code = code);
+        END LOOP;
+        UPDATE booking_table SET confirmation_code = code WHERE id = booking.id;
+    END LOOP;
+END $$;
+
+ALTER TABLE "booking_table" ADD CONSTRAINT "booking_table_confirmation_code_key" UNIQUE ("confirmation_code");
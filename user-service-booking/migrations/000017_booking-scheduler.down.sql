@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "booking_table_status_will_arrive_idx";
+ALTER TABLE "booking_table" DROP COLUMN IF EXISTS "reminded_at";
@@ -0,0 +1,7 @@
+-- a reminder is sent once per booking, reminded_at records when
+ALTER TABLE "booking_table" ADD COLUMN IF NOT EXISTS "reminded_at" TIMESTAMP;
+
+-- the scheduler looks bookings up by status and the time they start
+CREATE INDEX IF NOT EXISTS "booking_table_status_will_arrive_idx"
+    ON "booking_table" ("status", "will_arrive")
+    WHERE status IN ('confirmed', 'checked_in') AND deleted_at IS NULL;
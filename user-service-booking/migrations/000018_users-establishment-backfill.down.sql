@@ -0,0 +1,2 @@
+-- the backfilled links can not be told apart from the ones made by the api
+DROP INDEX IF EXISTS "users_establishment_user_id_idx";
@@ -0,0 +1,15 @@
+-- establishments created before the api recorded their owners get linked to
+-- the owner_id they were created with
+INSERT INTO "users_establishment" ("user_id", "establishment_id")
+SELECT e.owner_id, e.establishment_id
+FROM (
+    SELECT "owner_id", "hotel_id" AS establishment_id FROM "hotel_table" WHERE "deleted_at" IS NULL
+    UNION ALL
+    SELECT "owner_id", "restaurant_id" FROM "restaurant_table" WHERE "deleted_at" IS NULL
+    UNION ALL
+    SELECT "owner_id", "attraction_id" FROM "attraction_table" WHERE "deleted_at" IS NULL
+) e
+JOIN "users" u ON u.id = e.owner_id
+ON CONFLICT ("establishment_id") DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS "users_establishment_user_id_idx" ON "users_establishment" ("user_id");
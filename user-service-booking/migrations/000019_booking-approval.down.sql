@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "booking_table_approval_due_at_idx";
+ALTER TABLE "booking_table" DROP COLUMN IF EXISTS "approval_due_at";
+DROP TABLE IF EXISTS "booking_mode_table";
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "booking_mode_table"(
+    "establishment_id" UUID PRIMARY KEY NOT NULL,
+    "mode" VARCHAR(16) NOT NULL DEFAULT 'instant',
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP
+);
+
+-- bookings of establishments in request mode wait for the owner until
+-- approval_due_at and are declined when nobody answered by then
+ALTER TABLE "booking_table" ADD COLUMN IF NOT EXISTS "approval_due_at" TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS "booking_table_approval_due_at_idx"
+    ON "booking_table" ("approval_due_at")
+    WHERE status = 'pending' AND approval_due_at IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE "booking_table" DROP COLUMN IF EXISTS "special_request_note";
+ALTER TABLE "booking_table" DROP COLUMN IF EXISTS "special_requests";
+DROP TABLE IF EXISTS "booking_guest_table";
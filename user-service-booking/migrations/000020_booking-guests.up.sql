@@ -0,0 +1,13 @@
+-- the guests named for a booking, hotels register them by name, age and
+-- document number
+CREATE TABLE IF NOT EXISTS "booking_guest_table"(
+    "booking_id" UUID NOT NULL REFERENCES "booking_table" ("id") ON DELETE CASCADE,
+    "position" INT NOT NULL,
+    "full_name" VARCHAR(255) NOT NULL,
+    "age" INT NOT NULL,
+    "document_number" VARCHAR(64) NOT NULL DEFAULT '',
+    PRIMARY KEY ("booking_id", "position")
+);
+
+ALTER TABLE "booking_table" ADD COLUMN IF NOT EXISTS "special_requests" TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE "booking_table" ADD COLUMN IF NOT EXISTS "special_request_note" TEXT NOT NULL DEFAULT '';
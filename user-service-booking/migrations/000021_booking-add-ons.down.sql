@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "booking_add_on_table";
+DROP TABLE IF EXISTS "add_on_table";
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "add_on_table"(
+    "add_on_id" UUID PRIMARY KEY NOT NULL,
+    "establishment_id" UUID NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "description" TEXT DEFAULT '',
+    "price" FLOAT DEFAULT 0,
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP(0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "add_on_table_establishment_id_name_idx"
+    ON "add_on_table" ("establishment_id", "name") WHERE "deleted_at" IS NULL;
+
+-- the add-ons booked along with a booking, name and price are copied from
+-- the catalogue so that later changes to it leave the booking alone
+CREATE TABLE IF NOT EXISTS "booking_add_on_table"(
+    "booking_id" UUID NOT NULL REFERENCES "booking_table" ("id") ON DELETE CASCADE,
+    "add_on_id" UUID NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "quantity" BIGINT NOT NULL,
+    "unit_price" FLOAT NOT NULL DEFAULT 0,
+    "total" FLOAT NOT NULL DEFAULT 0,
+    PRIMARY KEY ("booking_id", "add_on_id")
+);
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS "closure_table"(
+    "closure_id" UUID PRIMARY KEY NOT NULL,
+    "establishment_id" UUID NOT NULL,
+    "start_date" DATE,
+    "end_date" DATE,
+    "weekdays" TEXT[] NOT NULL DEFAULT '{}',
+    "reason" VARCHAR(255) DEFAULT '',
+    "created_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMP(0) DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMP(0)
+);
+
+CREATE INDEX IF NOT EXISTS "closure_table_establishment_id_idx"
+    ON "closure_table" ("establishment_id") WHERE "deleted_at" IS NULL;
+
+-- the free_days of rooms become a weekly closure of their hotel. A weekday is
+-- closed only when every room of the hotel is free on it, so no room that
+-- could be booked that day closes. Words that are not weekdays are left out
+INSERT INTO "closure_table" ("closure_id", "establishment_id", "weekdays", "reason")
+SELECT md5(d.hotel_id::TEXT || ':free_days')::UUID, d.hotel_id, array_agg(d.day ORDER BY d.day), 'free days'
+FROM (
+    SELECT r.hotel_id, lower(day) AS day, count(DISTINCT r.room_id) AS rooms
+    FROM "room_table" r
+    CROSS JOIN LATERAL regexp_split_to_table(r.free_days, '[\s,;]+') AS day
+    WHERE r.deleted_at IS NULL
+        AND lower(day) IN ('monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday', 'sunday')
+    GROUP BY r.hotel_id, lower(day)
+) d
+JOIN (
+    SELECT "hotel_id", count(*) AS rooms FROM "room_table" WHERE "deleted_at" IS NULL GROUP BY "hotel_id"
+) h ON h.hotel_id = d.hotel_id AND h.rooms = d.rooms
+GROUP BY d.hotel_id
+ON CONFLICT ("closure_id") DO NOTHING;
@@ -0,0 +1 @@
+ALTER TABLE payment_table DROP COLUMN IF EXISTS refunded_amount;
@@ -0,0 +1 @@
+ALTER TABLE payment_table ADD COLUMN IF NOT EXISTS refunded_amount FLOAT DEFAULT 0;